@@ -1,4 +1,4 @@
-import type { Action } from "../../../rpc/config/v1/config_pb";
+import type { Action } from "../../../rpc/anticheat/v1/anticheat_pb";
 import SelectField from "./SelectField";
 
 export const SelectActionField = SelectField<Action>;
@@ -0,0 +1,230 @@
+import { useMutation } from "@connectrpc/connect-query";
+import NiceModal, { muiDialogV5, useModal } from "@ebay/nice-modal-react";
+import PolicyIcon from "@mui/icons-material/Policy";
+import ButtonGroup from "@mui/material/ButtonGroup";
+import Dialog from "@mui/material/Dialog";
+import DialogActions from "@mui/material/DialogActions";
+import DialogContent from "@mui/material/DialogContent";
+import DialogTitle from "@mui/material/DialogTitle";
+import Grid from "@mui/material/Grid";
+import MenuItem from "@mui/material/MenuItem";
+import Typography from "@mui/material/Typography";
+import { z } from "zod/v4";
+import { useAppForm } from "../../contexts/formContext.tsx";
+import { useUserFlashCtx } from "../../hooks/useUserFlashCtx.ts";
+import { Action, Detection, type Rule } from "../../rpc/anticheat/v1/anticheat_pb.ts";
+import { ruleSave } from "../../rpc/anticheat/v1/anticheat-AnticheatService_connectquery.ts";
+import { enumValues } from "../../util/lists.ts";
+import { detectionString } from "../../util/strings.ts";
+import { Heading } from "../Heading";
+
+const schema = z.object({
+	name: z.string({ message: "Must provide a name" }).min(1).max(64),
+	enabled: z.boolean(),
+	dryRun: z.boolean(),
+	action: z.enum(Action).refine((action) => action !== Action.UNSPECIFIED, { message: "Must select an action" }),
+	threshold: z.number().gt(0),
+	windowMinutes: z.number().min(0),
+	durationMinutes: z.number().min(0),
+	conditions: z
+		.array(
+			z.object({
+				detection: z.enum(Detection),
+				weight: z.number().min(0),
+				minCount: z.number().int().min(0),
+			}),
+		)
+		.refine((conditions) => conditions.some((c) => c.weight > 0 || c.minCount > 0), {
+			message: "At least one detection must have a weight or minimum count",
+		}),
+});
+
+const detectionLabel = (detection: Detection) =>
+	detection === Detection.UNSPECIFIED ? "Any Detection" : detectionString(detection);
+
+export const AnticheatRuleEditModal = NiceModal.create(({ rule }: { rule?: Rule }) => {
+	const modal = useModal();
+	const { sendError } = useUserFlashCtx();
+
+	// Every detection gets a row, detections left at zero are not part of the rule.
+	const defaultValues: z.input<typeof schema> = {
+		name: rule?.name ?? "",
+		enabled: rule?.enabled ?? true,
+		dryRun: rule?.dryRun ?? true,
+		action: rule?.action ?? Action.NOTIFY,
+		threshold: rule?.threshold ?? 3,
+		windowMinutes: rule?.window ? Number(rule.window.seconds) / 60 : 10,
+		durationMinutes: rule?.duration ? Number(rule.duration.seconds) / 60 : 0,
+		conditions: enumValues(Detection).map((detection) => {
+			const condition = rule?.conditions.find((c) => c.detection === detection);
+			return {
+				detection,
+				weight: condition?.weight ?? 0,
+				minCount: condition?.minCount ?? 0,
+			};
+		}),
+	};
+
+	const saveMutation = useMutation(ruleSave, {
+		onSuccess: async (result) => {
+			modal.resolve(result.rule);
+			await modal.hide();
+		},
+		onError: sendError,
+	});
+
+	const form = useAppForm({
+		onSubmit: async ({ value }) => {
+			saveMutation.mutate({
+				rule: {
+					ruleId: rule?.ruleId ?? 0,
+					name: value.name,
+					enabled: value.enabled,
+					dryRun: value.dryRun,
+					action: value.action,
+					threshold: value.threshold,
+					window: { seconds: BigInt(Math.round(value.windowMinutes * 60)) },
+					duration: { seconds: BigInt(Math.round(value.durationMinutes * 60)) },
+					conditions: value.conditions.filter((c) => c.weight > 0 || c.minCount > 0),
+				},
+			});
+		},
+		defaultValues,
+		validators: {
+			onSubmit: schema,
+		},
+	});
+
+	return (
+		<Dialog {...muiDialogV5(modal)} fullWidth maxWidth={"md"}>
+			<form
+				onSubmit={async (e) => {
+					e.preventDefault();
+					e.stopPropagation();
+					await form.handleSubmit();
+				}}
+			>
+				<DialogTitle component={Heading} iconLeft={<PolicyIcon />}>
+					Anticheat Rule Editor
+				</DialogTitle>
+				<DialogContent>
+					<Grid container spacing={2}>
+						<Grid size={{ xs: 6 }}>
+							<form.AppField
+								name={"name"}
+								children={(field) => {
+									return <field.TextField label={"Name"} />;
+								}}
+							/>
+						</Grid>
+						<Grid size={{ xs: 3 }}>
+							<form.AppField
+								name={"enabled"}
+								children={(field) => {
+									return <field.CheckboxField label={"Is Enabled"} />;
+								}}
+							/>
+						</Grid>
+						<Grid size={{ xs: 3 }}>
+							<form.AppField
+								name={"dryRun"}
+								children={(field) => {
+									return <field.CheckboxField label={"Dry Run"} />;
+								}}
+							/>
+						</Grid>
+						<Grid size={{ xs: 3 }}>
+							<form.AppField
+								name={"action"}
+								children={(field) => {
+									return (
+										<field.SelectActionField
+											label={"Action"}
+											items={enumValues(Action).filter((a) => a !== Action.UNSPECIFIED)}
+											renderItem={(action) => {
+												return (
+													<MenuItem value={action} key={`action-${action}`}>
+														{Action[action]}
+													</MenuItem>
+												);
+											}}
+										/>
+									);
+								}}
+							/>
+						</Grid>
+						<Grid size={{ xs: 3 }}>
+							<form.AppField
+								name={"threshold"}
+								children={(field) => {
+									return <field.NumberField label={"Score Threshold"} min={0} />;
+								}}
+							/>
+						</Grid>
+						<Grid size={{ xs: 3 }}>
+							<form.AppField
+								name={"windowMinutes"}
+								children={(field) => {
+									return <field.NumberField label={"Window (minutes)"} min={0} />;
+								}}
+							/>
+						</Grid>
+						<Grid size={{ xs: 3 }}>
+							<form.AppField
+								name={"durationMinutes"}
+								children={(field) => {
+									return <field.NumberField label={"Duration (minutes)"} min={0} />;
+								}}
+							/>
+						</Grid>
+						<Grid size={{ xs: 12 }}>
+							<Typography variant={"body2"}>
+								Each matching detection within the window adds its weight to the score, the rule fires
+								once the score reaches the threshold. A window of 0 counts every detection and a
+								duration of 0 is permanent. Once actioned, the rule will not act against the same player
+								again until the window has passed. Dry run rules only record their triggers.
+							</Typography>
+						</Grid>
+						{defaultValues.conditions.map((condition, index) => (
+							<Grid container size={{ xs: 6 }} spacing={1} key={`condition-${condition.detection}`}>
+								<Grid size={{ xs: 4 }}>
+									<Typography variant={"body1"} paddingTop={1}>
+										{detectionLabel(condition.detection)}
+									</Typography>
+								</Grid>
+								<Grid size={{ xs: 4 }}>
+									<form.AppField
+										name={`conditions[${index}].weight`}
+										children={(field) => {
+											return <field.NumberField label={"Weight"} min={0} />;
+										}}
+									/>
+								</Grid>
+								<Grid size={{ xs: 4 }}>
+									<form.AppField
+										name={`conditions[${index}].minCount`}
+										children={(field) => {
+											return <field.NumberField label={"Min Count"} min={0} />;
+										}}
+									/>
+								</Grid>
+							</Grid>
+						))}
+					</Grid>
+				</DialogContent>
+				<DialogActions>
+					<Grid container>
+						<Grid size={{ xs: 12 }}>
+							<form.AppForm>
+								<ButtonGroup>
+									<form.ResetButton />
+									<form.SubmitButton />
+								</ButtonGroup>
+							</form.AppForm>
+						</Grid>
+					</Grid>
+				</DialogActions>
+			</form>
+		</Dialog>
+	);
+});
@@ -1,6 +1,6 @@
 import { create } from "@bufbuild/protobuf";
 import { timestampFromDate } from "@bufbuild/protobuf/wkt";
-import { createConnectQueryKey, useMutation, useTransport } from "@connectrpc/connect-query";
+import { createConnectQueryKey, useMutation, useQuery, useTransport } from "@connectrpc/connect-query";
 import NiceModal, { muiDialogV5, useModal } from "@ebay/nice-modal-react";
 import DirectionsRunIcon from "@mui/icons-material/DirectionsRun";
 import ButtonGroup from "@mui/material/ButtonGroup";
@@ -10,13 +10,16 @@ import DialogContent from "@mui/material/DialogContent";
 import DialogTitle from "@mui/material/DialogTitle";
 import Grid from "@mui/material/Grid";
 import MenuItem from "@mui/material/MenuItem";
+import Typography from "@mui/material/Typography";
+import { useStore } from "@tanstack/react-form";
 import { useQueryClient } from "@tanstack/react-query";
 import { useAppForm } from "../../contexts/formContext.tsx";
 import { useUserFlashCtx } from "../../hooks/useUserFlashCtx.ts";
 import { BanReason, BanService, BanType, CreateRequestSchema, Origin } from "../../rpc/ban/v1/ban_pb.ts";
-import { create as createBan } from "../../rpc/ban/v1/ban-BanService_connectquery.ts";
+import { create as createBan, suggest } from "../../rpc/ban/v1/ban-BanService_connectquery.ts";
 import { enumValues } from "../../util/lists.ts";
 import { banTypeString } from "../../util/strings.ts";
+import { renderTimestamp } from "../../util/time.ts";
 import { emptyOrNullString, zeroStringUndefined } from "../../util/types.ts";
 import { MarkdownField } from "../form/field/MarkdownField.tsx";
 import { Heading } from "../Heading.tsx";
@@ -68,6 +71,7 @@ export const BanCreateModal = NiceModal.create(({ reportId, steamId, demoId, dem
 		demoTick: demoTick,
 		origin: Origin.REPORTED,
 		validUntil: new Date(),
+		useEscalation: true,
 	};
 
 	const form = useAppForm({
@@ -78,7 +82,8 @@ export const BanCreateModal = NiceModal.create(({ reportId, steamId, demoId, dem
 				reasonText: zeroStringUndefined(value.note),
 				note: zeroStringUndefined(value.note),
 				evadeOk: value.evadeOk,
-				validUntil: timestampFromDate(value.validUntil),
+				// Leaving the expiry unset lets the server apply the escalation ladder of the reason.
+				validUntil: escalate ? undefined : timestampFromDate(value.validUntil),
 				cidr: zeroStringUndefined(value.cidr),
 				origin: Origin.WEB,
 				targetId: value.targetId,
@@ -97,6 +102,19 @@ export const BanCreateModal = NiceModal.create(({ reportId, steamId, demoId, dem
 		defaultValues,
 	});
 
+	const targetId = useStore(form.store, (state) => state.values.targetId);
+	const banReason = useStore(form.store, (state) => state.values.reason);
+	const useEscalation = useStore(form.store, (state) => state.values.useEscalation);
+
+	// Resolved ids are always numeric, anything else is still being typed or is a group.
+	const { data: suggestion } = useQuery(
+		suggest,
+		{ targetId: /^\d{17}$/.test(targetId) ? BigInt(targetId) : undefined, reason: banReason },
+		{ enabled: /^\d{17}$/.test(targetId) && banReason !== BanReason.UNSPECIFIED },
+	);
+
+	const escalate = useEscalation && Boolean(suggestion?.matched);
+
 	return (
 		<Dialog fullWidth {...muiDialogV5(modal)}>
 			<form
@@ -179,14 +197,35 @@ export const BanCreateModal = NiceModal.create(({ reportId, steamId, demoId, dem
 								}}
 							/>
 						</Grid>
-						<Grid>
-							<form.AppField
-								name={"validUntil"}
-								children={(field) => {
-									return <field.DateTimeField label={"Expires At"} />;
-								}}
-							/>
-						</Grid>
+						{suggestion?.matched && (
+							<>
+								<Grid size={{ xs: 12 }}>
+									<Typography variant={"body2"}>
+										Offense #{suggestion.offense} with {suggestion.priorBans} prior ban(s) for this
+										reason. Escalation ladder: {banTypeString(suggestion.step?.banType ?? BanType.BANNED)}{" "}
+										until {renderTimestamp(suggestion.validUntil)}.
+									</Typography>
+								</Grid>
+								<Grid size={{ xs: 12 }}>
+									<form.AppField
+										name={"useEscalation"}
+										children={(field) => {
+											return <field.CheckboxField label={"Use Escalation Ladder"} />;
+										}}
+									/>
+								</Grid>
+							</>
+						)}
+						{!escalate && (
+							<Grid>
+								<form.AppField
+									name={"validUntil"}
+									children={(field) => {
+										return <field.DateTimeField label={"Expires At"} />;
+									}}
+								/>
+							</Grid>
+						)}
 
 						<Grid size={{ xs: 12 }}>
 							<form.AppField
@@ -7,6 +7,7 @@ import BugReportIcon from "@mui/icons-material/BugReport";
 import CleaningServicesIcon from "@mui/icons-material/CleaningServices";
 import DeveloperBoardIcon from "@mui/icons-material/DeveloperBoard";
 import EmergencyRecordingIcon from "@mui/icons-material/EmergencyRecording";
+import GavelIcon from "@mui/icons-material/Gavel";
 import GradingIcon from "@mui/icons-material/Grading";
 import HeadsetMicIcon from "@mui/icons-material/HeadsetMic";
 import LanIcon from "@mui/icons-material/Lan";
@@ -38,7 +39,7 @@ import { TabSection } from "../component/TabSection.tsx";
 import { useAppForm } from "../contexts/formContext.tsx";
 import { useUserFlashCtx } from "../hooks/useUserFlashCtx.ts";
 import { type Asset, AssetService } from "../rpc/asset/v1/asset_pb.ts";
-import { Action, ConfigService, DemoStrategy, Level, UpdateRequestSchema } from "../rpc/config/v1/config_pb.ts";
+import { ConfigService, DemoStrategy, Level, UpdateRequestSchema } from "../rpc/config/v1/config_pb.ts";
 import { get, update } from "../rpc/config/v1/config-ConfigService_connectquery.ts";
 import { DemoService } from "../rpc/demo/v1/demo_pb.ts";
 import { NetworkService } from "../rpc/network/v1/network_pb.ts";
@@ -64,6 +65,7 @@ const settingsSchema = z.object({
 			"network",
 			"ssh",
 			"exports",
+			"appeals",
 		])
 		.optional()
 		.default("general"),
@@ -104,7 +106,8 @@ type tabs =
 	| "network"
 	| "localStore"
 	| "ssh"
-	| "exports";
+	| "exports"
+	| "appeals";
 
 const ConfigContainer = ({ children }: { children: ReactNode[] }) => {
 	return (
@@ -304,6 +307,13 @@ function AdminSettings() {
 							currentTab={tab}
 							label={"Exports"}
 						/>
+						<TabButton
+							tab={"appeals"}
+							onClick={onTabClick}
+							icon={<GavelIcon />}
+							currentTab={tab}
+							label={"Appeals"}
+						/>
 
 						<Typography padding={1}>
 							Note that many settings will not take effect until app restart.
@@ -629,42 +639,6 @@ function AdminSettings() {
 									}}
 								/>
 							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>
-									When the sum of warning weights issued to a user is greater than this value, take
-									action against the user.
-								</SubHeading>
-								<form.AppField
-									name={"filters.maxWeight"}
-									children={(field) => {
-										return <field.NumberField label={"Max Weight"} />;
-									}}
-								/>
-							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>
-									How frequent warnings will be checked for users exceeding limits.
-								</SubHeading>
-								<form.AppField
-									name={"filters.checkTimeout"}
-									children={(field) => {
-										return <field.NumberField label={"Check Frequency (seconds)"} />;
-									}}
-								/>
-							</Grid>
-
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>
-									How long it takes for a users warning to expire after being matched.
-								</SubHeading>
-								<form.AppField
-									name={"filters.matchTimeout"}
-									children={(field) => {
-										return <field.NumberField label={"Match Timeout"} />;
-									}}
-								/>
-							</Grid>
-
 							<Grid size={{ xs: 12 }}>
 								<form.AppForm>
 									<ButtonGroup>
@@ -783,14 +757,14 @@ function AdminSettings() {
 
 							<Grid size={{ xs: 12 }}>
 								<SubHeading>
-									This url should point to an instance of
-									https://github.com/leighmacdonald/tf2_demostats. This is used to pull stats & player
-									steamids out of demos that are fetched.
+									Demos are parsed by the built-in parser when this is left empty. Optionally, this url
+									can point to an instance of https://github.com/leighmacdonald/tf2_demostats to use it
+									for pulling stats & player steamids out of demos that are fetched instead.
 								</SubHeading>
 								<form.AppField
 									name={"demo.parserUrl"}
 									children={(field) => {
-										return <field.TextField label={"URL for demo parsing submissions"} />;
+										return <field.TextField label={"URL for demo parsing submissions (optional)"} />;
 									}}
 								/>
 							</Grid>
@@ -1392,120 +1366,6 @@ function AdminSettings() {
 									}}
 								/>
 							</Grid>
-
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Action to take when a trigger occurs</SubHeading>
-								<form.AppField
-									name={"anticheat.action"}
-									children={(field) => {
-										return (
-											<field.SelectActionField
-												label={"Punishment Strategy"}
-												items={enumValues(Action)}
-												renderItem={(item) => {
-													return (
-														<MenuItem key={item} value={item}>
-															{Action[item]}
-														</MenuItem>
-													);
-												}}
-											/>
-										);
-									}}
-								/>
-							</Grid>
-
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Duration of the punishment</SubHeading>
-								<form.AppField
-									name={"anticheat.duration"}
-									children={(field) => {
-										return <field.NumberField label={"Duration"} />;
-									}}
-								/>
-							</Grid>
-
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Maximum number of silent aim detections</SubHeading>
-								<form.AppField
-									name={"anticheat.maxAimSnaps"}
-									children={(field) => {
-										return <field.NumberField label={"max_aim_snap"} />;
-									}}
-								/>
-							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Maximum number of psilent detections</SubHeading>
-								<form.AppField
-									name={"anticheat.maxPsilent"}
-									children={(field) => {
-										return <field.NumberField label={"max_psilent"} />;
-									}}
-								/>
-							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Maximum number of consectutive perfect bhop detections</SubHeading>
-								<form.AppField
-									name={"anticheat.maxBhop"}
-									children={(field) => {
-										return <field.NumberField label={"max_bhop"} />;
-									}}
-								/>
-							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Maximum number of fake angle detections</SubHeading>
-								<form.AppField
-									name={"anticheat.maxFakeAng"}
-									children={(field) => {
-										return <field.NumberField label={"max_fake_ang"} />;
-									}}
-								/>
-							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Maximum cmds spam</SubHeading>
-								<form.AppField
-									name={"anticheat.maxCmdNum"}
-									children={(field) => {
-										return <field.NumberField label={"max_cmd_num"} />;
-									}}
-								/>
-							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Maximum number of connections from the same ip.</SubHeading>
-								<form.AppField
-									name={"anticheat.maxTooManyConnections"}
-									children={(field) => {
-										return <field.NumberField label={"max_too_many_connections"} />;
-									}}
-								/>
-							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Maximum number of cheat cvars</SubHeading>
-								<form.AppField
-									name={"anticheat.maxCheatCvar"}
-									children={(field) => {
-										return <field.NumberField label={"max_cheat_cvar"} />;
-									}}
-								/>
-							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Maximum number of out-of-bounds vars</SubHeading>
-								<form.AppField
-									name={"anticheat.maxOobVar"}
-									children={(field) => {
-										return <field.NumberField label={"max_oob_var"} />;
-									}}
-								/>
-							</Grid>
-							<Grid size={{ xs: 12 }}>
-								<SubHeading>Maximum number of invalid user commands.</SubHeading>
-								<form.AppField
-									name={"anticheat.maxInvalidUserCmd"}
-									children={(field) => {
-										return <field.NumberField label={"max_invalid_user_cmd"} />;
-									}}
-								/>
-							</Grid>
 							<Grid size={{ xs: 12 }}>
 								<form.AppForm>
 									<ButtonGroup>
@@ -1712,6 +1572,38 @@ function AdminSettings() {
 						</ConfigContainer>
 					</form>
 				</TabSection>
+				<TabSection tab={"appeals"} currentTab={tab} label={"Appeals"} description={"Ban appeal handling"}>
+					<form
+						onSubmit={async (e) => {
+							e.preventDefault();
+							e.stopPropagation();
+							await form.handleSubmit();
+						}}
+					>
+						<ConfigContainer>
+							<Grid size={{ xs: 12 }}>
+								<SubHeading>
+									Remind moderators of open appeals that have not had a staff reply within this many
+									days. Set to 0 to disable reminders.
+								</SubHeading>
+								<form.AppField
+									name={"appeals.reminderDays"}
+									children={(field) => {
+										return <field.NumberField label={"Reminder after (days)"} />;
+									}}
+								/>
+							</Grid>
+							<Grid size={{ xs: 12 }}>
+								<form.AppForm>
+									<ButtonGroup>
+										<form.ResetButton />
+										<form.SubmitButton />
+									</ButtonGroup>
+								</form.AppForm>
+							</Grid>
+						</ConfigContainer>
+					</form>
+				</TabSection>
 				<TabSection
 					tab={"debug"}
 					currentTab={tab}
@@ -1,6 +1,11 @@
 /** biome-ignore-all lint/correctness/noChildrenProp: form needs it */
-import { useQuery } from "@connectrpc/connect-query";
+import { useMutation, useQuery } from "@connectrpc/connect-query";
+import NiceModal from "@ebay/nice-modal-react";
+import AddIcon from "@mui/icons-material/Add";
+import DeleteIcon from "@mui/icons-material/Delete";
+import EditIcon from "@mui/icons-material/Edit";
 import Grid from "@mui/material/Grid";
+import IconButton from "@mui/material/IconButton";
 import { useTheme } from "@mui/material/styles";
 import Tooltip from "@mui/material/Tooltip";
 import { createFileRoute, useNavigate } from "@tanstack/react-router";
@@ -12,19 +17,27 @@ import {
 	useMaterialReactTable,
 } from "material-react-table";
 import { useCallback, useMemo } from "react";
+import { AnticheatRuleEditModal } from "../component/modal/AnticheatRuleEditModal.tsx";
+import { ConfirmationModal } from "../component/modal/ConfirmationModal.tsx";
 import { PersonCell } from "../component/PersonCell.tsx";
+import { RowActionContainer } from "../component/RowActionContainer.tsx";
 import RouterLink from "../component/RouterLink.tsx";
 import { TextLink } from "../component/TextLink.tsx";
+import { BoolCell } from "../component/table/BoolCell.tsx";
 import {
 	createDefaultTableOptions,
+	makeRowActionsDefOptions,
 	makeSchemaState,
 	type OnChangeFn,
 	setColumnFilter,
 } from "../component/table/options.ts";
 import { SortableTable } from "../component/table/SortableTable.tsx";
 import { TableCellString } from "../component/table/TableCellString.tsx";
-import { Detection, type Entry } from "../rpc/anticheat/v1/anticheat_pb.ts";
-import { query } from "../rpc/anticheat/v1/anticheat-AnticheatService_connectquery.ts";
+import { useAuth } from "../hooks/useAuth.ts";
+import { useUserFlashCtx } from "../hooks/useUserFlashCtx.ts";
+import { Action, Detection, type Entry, type Rule } from "../rpc/anticheat/v1/anticheat_pb.ts";
+import { query, ruleDelete, rules } from "../rpc/anticheat/v1/anticheat-AnticheatService_connectquery.ts";
+import { Privilege } from "../rpc/person/v1/privilege_pb.ts";
 import { servers } from "../rpc/servers/v1/servers-ServersService_connectquery.ts";
 import { stringToColour } from "../util/colours.ts";
 import { enumValues } from "../util/lists.ts";
@@ -232,6 +245,165 @@ function AdminAnticheat() {
 			<Grid size={{ xs: 12 }}>
 				<SortableTable table={table} title={"Anti-Cheat Log Entries"} />
 			</Grid>
+			<Grid size={{ xs: 12 }}>
+				<AnticheatRulesTable />
+			</Grid>
 		</Grid>
 	);
 }
+
+const columnHelperRule = createMRTColumnHelper<Rule>();
+const defaultOptionsRule = createDefaultTableOptions<Rule>();
+
+const AnticheatRulesTable = () => {
+	const { sendFlash, sendError } = useUserFlashCtx();
+	const { hasPermission } = useAuth();
+	const { data, isLoading, isError, refetch } = useQuery(rules);
+	// Only admins may change the rules.
+	const isAdmin = hasPermission(Privilege.ADMIN);
+
+	const onEdit = useCallback(
+		async (rule?: Rule) => {
+			try {
+				await NiceModal.show(AnticheatRuleEditModal, { rule });
+				await refetch();
+			} catch (e) {
+				sendFlash("error", `${e}`);
+			}
+		},
+		[refetch, sendFlash],
+	);
+
+	const deleteMutation = useMutation(ruleDelete, {
+		onSuccess: async () => {
+			sendFlash("success", "Deleted rule");
+			await refetch();
+		},
+		onError: sendError,
+	});
+
+	const onDelete = useCallback(
+		async (rule: Rule) => {
+			const confirmed = (await NiceModal.show(ConfirmationModal, {
+				title: `Are you sure you want to delete the rule: ${rule.name}?`,
+			})) as boolean;
+
+			if (!confirmed) {
+				return;
+			}
+
+			deleteMutation.mutate({ ruleId: rule.ruleId });
+		},
+		[deleteMutation],
+	);
+
+	const columns = useMemo(
+		() => [
+			columnHelperRule.accessor("name", {
+				header: "Name",
+				grow: true,
+			}),
+			columnHelperRule.accessor("conditions", {
+				header: "Detections",
+				grow: true,
+				enableSorting: false,
+				Cell: ({ cell }) => (
+					<TableCellString>
+						{cell
+							.getValue()
+							.map((c) =>
+								c.detection === Detection.UNSPECIFIED ? "Any" : detectionString(c.detection),
+							)
+							.join(", ")}
+					</TableCellString>
+				),
+			}),
+			columnHelperRule.accessor("threshold", {
+				header: "Threshold",
+				grow: false,
+			}),
+			columnHelperRule.accessor("window", {
+				header: "Window",
+				grow: false,
+				enableSorting: false,
+				Cell: ({ cell }) => {
+					const seconds = Number(cell.getValue()?.seconds ?? 0);
+					return seconds > 0 ? `${seconds / 60}m` : "All";
+				},
+			}),
+			columnHelperRule.accessor("action", {
+				header: "Action",
+				grow: false,
+				Cell: ({ cell }) => Action[cell.getValue()],
+			}),
+			columnHelperRule.accessor("dryRun", {
+				header: "Dry Run",
+				grow: false,
+				Cell: ({ cell }) => <BoolCell enabled={cell.getValue()} />,
+			}),
+			columnHelperRule.accessor("enabled", {
+				header: "Enabled",
+				grow: false,
+				Cell: ({ cell }) => <BoolCell enabled={cell.getValue()} />,
+			}),
+		],
+		[],
+	);
+
+	const table = useMaterialReactTable({
+		...defaultOptionsRule,
+		columns,
+		data: data?.rules ?? [],
+		state: {
+			isLoading,
+			showAlertBanner: isError,
+		},
+		displayColumnDefOptions: makeRowActionsDefOptions(2),
+		enableRowActions: isAdmin,
+		renderRowActions: ({ row }) => (
+			<RowActionContainer>
+				<IconButton
+					key={"delete"}
+					color={"error"}
+					onClick={async () => {
+						await onDelete(row.original);
+					}}
+				>
+					<DeleteIcon />
+				</IconButton>
+				<IconButton
+					key={"edit"}
+					color={"warning"}
+					onClick={async () => {
+						await onEdit(row.original);
+					}}
+				>
+					<EditIcon />
+				</IconButton>
+			</RowActionContainer>
+		),
+	});
+
+	return (
+		<SortableTable
+			table={table}
+			title={"Anti-Cheat Rules"}
+			buttons={
+				isAdmin
+					? [
+							<Tooltip title="Create new rule" key="1">
+								<IconButton
+									onClick={async () => {
+										await onEdit();
+									}}
+									sx={{ color: "primary.contrastText" }}
+								>
+									<AddIcon />
+								</IconButton>
+							</Tooltip>,
+						]
+					: []
+			}
+		/>
+	);
+};
@@ -333,5 +333,5 @@ export const WarningStateTable = () => {
 		},
 	});
 
-	return <SortableTable table={table} title={`Current Warning State (Max Weight: ${data?.maxWeight ?? "..."})`} />;
+	return <SortableTable table={table} title={"Current Warning State"} />;
 };
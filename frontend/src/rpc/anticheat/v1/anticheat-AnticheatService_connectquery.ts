@@ -8,3 +8,23 @@ import { AnticheatService } from "./anticheat_pb";
  * @generated from rpc anticheat.v1.AnticheatService.Query
  */
 export const query = AnticheatService.method.query;
+
+/**
+ * @generated from rpc anticheat.v1.AnticheatService.Rules
+ */
+export const rules = AnticheatService.method.rules;
+
+/**
+ * @generated from rpc anticheat.v1.AnticheatService.RuleSave
+ */
+export const ruleSave = AnticheatService.method.ruleSave;
+
+/**
+ * @generated from rpc anticheat.v1.AnticheatService.RuleDelete
+ */
+export const ruleDelete = AnticheatService.method.ruleDelete;
+
+/**
+ * @generated from rpc anticheat.v1.AnticheatService.Triggers
+ */
+export const triggers = AnticheatService.method.triggers;
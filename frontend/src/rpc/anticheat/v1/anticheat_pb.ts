@@ -7,15 +7,15 @@ import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Filter } from "../../database/query/v1/filter_pb";
 import { file_database_query_v1_filter } from "../../database/query/v1/filter_pb";
-import type { Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Duration, EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file anticheat/v1/anticheat.proto.
  */
 export const file_anticheat_v1_anticheat: GenFile = /*@__PURE__*/
-  fileDesc("ChxhbnRpY2hlYXQvdjEvYW50aWNoZWF0LnByb3RvEgxhbnRpY2hlYXQudjEiuAEKDFF1ZXJ5UmVxdWVzdBIpCgZmaWx0ZXIYASABKAsyGS5kYXRhYmFzZS5xdWVyeS52MS5GaWx0ZXISGgoEbmFtZRgCIAEoCUIMukgJyAEAcgQQAhggEhoKCHN0ZWFtX2lkGAMgASgDQggwAbpIA8gBABIPCgdzdW1tYXJ5GAQgASgJEjQKCWRldGVjdGlvbhgFIAEoDjIXLmFudGljaGVhdC52MS5EZXRlY3Rpb25CCLpIBYIBAhABIj0KDVF1ZXJ5UmVzcG9uc2USLAoHZW50cmllcxgBIAMoCzITLmFudGljaGVhdC52MS5FbnRyeUIGukgDyAEBIuYDCgVFbnRyeRIeCgxhbnRpY2hlYXRfaWQYASABKANCCDABukgDyAEBEh4KCHN0ZWFtX2lkGAIgASgDQgwwAbpIB8gBASICIAASHQoJc2VydmVyX2lkGAMgASgFQgq6SAfIAQEaAiAAEhsKC3NlcnZlcl9uYW1lGAQgASgJQga6SAPIAQESDwoHZGVtb19pZBgFIAEoBRIRCglkZW1vX25hbWUYBiABKAkSEQoJZGVtb190aWNrGAcgASgFEhQKBG5hbWUYCCABKAlCBrpIA8gBARI3CglkZXRlY3Rpb24YCSABKA4yFy5hbnRpY2hlYXQudjEuRGV0ZWN0aW9uQgu6SAjIAQGCAQIQARIXCgdzdW1tYXJ5GAogASgJQga6SAPIAQESGwoHcmF3X2xvZxgLIAEoCUIKukgHyAEBcgIQARJJCgpjcmVhdGVkX29uGAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIZukgWyAEBsgEQGgYIgP/4pAcqBgiAnuGsBBIgCgxwZXJzb25hX25hbWUYDSABKAlCCrpIB8gBAXICGCASHQoLYXZhdGFyX2hhc2gYDiABKAlCCLpIBXIDmAEoEhkKCXRyaWdnZXJlZBgPIAEoBUIGukgDyAEBKq8CCglEZXRlY3Rpb24SGQoVREVURUNUSU9OX1VOU1BFQ0lGSUVEEAASGAoUREVURUNUSU9OX1NJTEVOVF9BSU0QARIWChJERVRFQ1RJT05fQUlNX1NOQVAQAhIiCh5ERVRFQ1RJT05fVE9PX01BTllfQ09OTkVDVElPTlMQAxIUChBERVRFQ1RJT05fSU5URVJQEAQSEgoOREVURUNUSU9OX0JIT1AQBRIbChdERVRFQ1RJT05fQ01EX05VTV9TUElLRRAGEhgKFERFVEVDVElPTl9FWUVfQU5HTEVTEAcSHgoaREVURUNUSU9OX0lOVkFMSURfVVNFUl9DTUQQCBIWChJERVRFQ1RJT05fT09CX0NWQVIQCRIYChRERVRFQ1RJT05fQ0hFQVRfQ1ZBUhAKMlYKEEFudGljaGVhdFNlcnZpY2USQgoFUXVlcnkSGi5hbnRpY2hlYXQudjEuUXVlcnlSZXF1ZXN0GhsuYW50aWNoZWF0LnYxLlF1ZXJ5UmVzcG9uc2UiAEK2AQoQY29tLmFudGljaGVhdC52MUIOQW50aWNoZWF0UHJvdG9QAVpBZ2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9hbnRpY2hlYXQvdjE7YW50aWNoZWF0djGiAgNBWFiqAgxBbnRpY2hlYXQuVjHKAgxBbnRpY2hlYXRcVjHiAhhBbnRpY2hlYXRcVjFcR1BCTWV0YWRhdGHqAg1BbnRpY2hlYXQ6OlYxYghlZGl0aW9uc3DoBw", [file_buf_validate_validate, file_database_query_v1_filter, file_google_protobuf_timestamp]);
+  fileDesc("ChxhbnRpY2hlYXQvdjEvYW50aWNoZWF0LnByb3RvEgxhbnRpY2hlYXQudjEiuAEKDFF1ZXJ5UmVxdWVzdBIpCgZmaWx0ZXIYASABKAsyGS5kYXRhYmFzZS5xdWVyeS52MS5GaWx0ZXISGgoEbmFtZRgCIAEoCUIMukgJyAEAcgQQAhggEhoKCHN0ZWFtX2lkGAMgASgDQggwAbpIA8gBABIPCgdzdW1tYXJ5GAQgASgJEjQKCWRldGVjdGlvbhgFIAEoDjIXLmFudGljaGVhdC52MS5EZXRlY3Rpb25CCLpIBYIBAhABIj0KDVF1ZXJ5UmVzcG9uc2USLAoHZW50cmllcxgBIAMoCzITLmFudGljaGVhdC52MS5FbnRyeUIGukgDyAEBIuYDCgVFbnRyeRIeCgxhbnRpY2hlYXRfaWQYASABKANCCDABukgDyAEBEh4KCHN0ZWFtX2lkGAIgASgDQgwwAbpIB8gBASICIAASHQoJc2VydmVyX2lkGAMgASgFQgq6SAfIAQEaAiAAEhsKC3NlcnZlcl9uYW1lGAQgASgJQga6SAPIAQESDwoHZGVtb19pZBgFIAEoBRIRCglkZW1vX25hbWUYBiABKAkSEQoJZGVtb190aWNrGAcgASgFEhQKBG5hbWUYCCABKAlCBrpIA8gBARI3CglkZXRlY3Rpb24YCSABKA4yFy5hbnRpY2hlYXQudjEuRGV0ZWN0aW9uQgu6SAjIAQGCAQIQARIXCgdzdW1tYXJ5GAogASgJQga6SAPIAQESGwoHcmF3X2xvZxgLIAEoCUIKukgHyAEBcgIQARJJCgpjcmVhdGVkX29uGAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIZukgWyAEBsgEQGgYIgP/4pAcqBgiAnuGsBBIgCgxwZXJzb25hX25hbWUYDSABKAlCCrpIB8gBAXICGCASHQoLYXZhdGFyX2hhc2gYDiABKAlCCLpIBXIDmAEoEhkKCXRyaWdnZXJlZBgPIAEoBUIGukgDyAEBInkKDVJ1bGVDb25kaXRpb24SNAoJZGV0ZWN0aW9uGAEgASgOMhcuYW50aWNoZWF0LnYxLkRldGVjdGlvbkIIukgFggECEAESFgoGd2VpZ2h0GAIgASgBQga6SAPIAQESGgoJbWluX2NvdW50GAMgASgFQge6SAQaAigAIq4DCgRSdWxlEg8KB3J1bGVfaWQYASABKAUSGgoEbmFtZRgCIAEoCUIMukgJyAEBcgQQARhAEhcKB2VuYWJsZWQYAyABKAhCBrpIA8gBARIXCgdkcnlfcnVuGAQgASgIQga6SAPIAQESOQoKY29uZGl0aW9ucxgFIAMoCzIbLmFudGljaGVhdC52MS5SdWxlQ29uZGl0aW9uQgi6SAWSAQIIARIhCgl0aHJlc2hvbGQYBiABKAFCDrpICxIJIQAAAAAAAAAAEikKBndpbmRvdxgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIxCgZhY3Rpb24YCCABKA4yFC5hbnRpY2hlYXQudjEuQWN0aW9uQgu6SAjIAQGCAQIQARIrCghkdXJhdGlvbhgJIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIuCgpjcmVhdGVkX29uGAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX29uGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI6Cg1SdWxlc1Jlc3BvbnNlEikKBXJ1bGVzGAEgAygLMhIuYW50aWNoZWF0LnYxLlJ1bGVCBrpIA8gBASI7Cg9SdWxlU2F2ZVJlcXVlc3QSKAoEcnVsZRgBIAEoCzISLmFudGljaGVhdC52MS5SdWxlQga6SAPIAQEiPAoQUnVsZVNhdmVSZXNwb25zZRIoCgRydWxlGAEgASgLMhIuYW50aWNoZWF0LnYxLlJ1bGVCBrpIA8gBASIwChFSdWxlRGVsZXRlUmVxdWVzdBIbCgdydWxlX2lkGAEgASgFQgq6SAfIAQEaAiAAIlMKD1RyaWdnZXJzUmVxdWVzdBIUCghzdGVhbV9pZBgBIAEoA0ICMAESDwoHcnVsZV9pZBgCIAEoBRIZCgVsaW1pdBgDIAEoBEIKMAG6SAUyAxjoByJDChBUcmlnZ2Vyc1Jlc3BvbnNlEi8KCHRyaWdnZXJzGAEgAygLMhUuYW50aWNoZWF0LnYxLlRyaWdnZXJCBrpIA8gBASKrAwoHVHJpZ2dlchIcCgp0cmlnZ2VyX2lkGAEgASgDQggwAbpIA8gBARIPCgdydWxlX2lkGAIgASgFEhkKCXJ1bGVfbmFtZRgDIAEoCUIGukgDyAEBEhoKCHN0ZWFtX2lkGAQgASgDQggwAbpIA8gBARIUCgxwZXJzb25hX25hbWUYBSABKAkSLAoGYWN0aW9uGAYgASgOMhQuYW50aWNoZWF0LnYxLkFjdGlvbkIGukgDyAEBEisKCGR1cmF0aW9uGAcgASgLMhkuZ29vZ2xlLnByb3RvYnVmLkR1cmF0aW9uEhcKB2RyeV9ydW4YCCABKAhCBrpIA8gBARIVCgVzY29yZRgJIAEoAUIGukgDyAEBEhkKDWFudGljaGVhdF9pZHMYCiADKANCAjABEg4KBmJhbl9pZBgLIAEoBRI2Cgp3aW5kb3dfZW5kGAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQEqrwIKCURldGVjdGlvbhIZChVERVRFQ1RJT05fVU5TUEVDSUZJRUQQABIYChRERVRFQ1RJT05fU0lMRU5UX0FJTRABEhYKEkRFVEVDVElPTl9BSU1fU05BUBACEiIKHkRFVEVDVElPTl9UT09fTUFOWV9DT05ORUNUSU9OUxADEhQKEERFVEVDVElPTl9JTlRFUlAQBBISCg5ERVRFQ1RJT05fQkhPUBAFEhsKF0RFVEVDVElPTl9DTURfTlVNX1NQSUtFEAYSGAoUREVURUNUSU9OX0VZRV9BTkdMRVMQBxIeChpERVRFQ1RJT05fSU5WQUxJRF9VU0VSX0NNRBAIEhYKEkRFVEVDVElPTl9PT0JfQ1ZBUhAJEhgKFERFVEVDVElPTl9DSEVBVF9DVkFSEAoqZAoGQWN0aW9uEhYKEkFDVElPTl9VTlNQRUNJRklFRBAAEhEKDUFDVElPTl9OT1RJRlkQARIOCgpBQ1RJT05fR0FHEAISDwoLQUNUSU9OX0tJQ0sQAxIOCgpBQ1RJT05fQkFOEAQy+QIKEEFudGljaGVhdFNlcnZpY2USQgoFUXVlcnkSGi5hbnRpY2hlYXQudjEuUXVlcnlSZXF1ZXN0GhsuYW50aWNoZWF0LnYxLlF1ZXJ5UmVzcG9uc2UiABI+CgVSdWxlcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRobLmFudGljaGVhdC52MS5SdWxlc1Jlc3BvbnNlIgASSwoIUnVsZVNhdmUSHS5hbnRpY2hlYXQudjEuUnVsZVNhdmVSZXF1ZXN0Gh4uYW50aWNoZWF0LnYxLlJ1bGVTYXZlUmVzcG9uc2UiABJHCgpSdWxlRGVsZXRlEh8uYW50aWNoZWF0LnYxLlJ1bGVEZWxldGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASSwoIVHJpZ2dlcnMSHS5hbnRpY2hlYXQudjEuVHJpZ2dlcnNSZXF1ZXN0Gh4uYW50aWNoZWF0LnYxLlRyaWdnZXJzUmVzcG9uc2UiAEK2AQoQY29tLmFudGljaGVhdC52MUIOQW50aWNoZWF0UHJvdG9QAVpBZ2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9hbnRpY2hlYXQvdjE7YW50aWNoZWF0djGiAgNBWFiqAgxBbnRpY2hlYXQuVjHKAgxBbnRpY2hlYXRcVjHiAhhBbnRpY2hlYXRcVjFcR1BCTWV0YWRhdGHqAg1BbnRpY2hlYXQ6OlYxYghlZGl0aW9uc3DoBw", [file_buf_validate_validate, file_database_query_v1_filter, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message anticheat.v1.QueryRequest
@@ -158,6 +158,303 @@ export type Entry = Message<"anticheat.v1.Entry"> & {
 export const EntrySchema: GenMessage<Entry> = /*@__PURE__*/
   messageDesc(file_anticheat_v1_anticheat, 2);
 
+/**
+ * @generated from message anticheat.v1.RuleCondition
+ */
+export type RuleCondition = Message<"anticheat.v1.RuleCondition"> & {
+  /**
+   * DETECTION_UNSPECIFIED matches any detection type.
+   *
+   * @generated from field: anticheat.v1.Detection detection = 1;
+   */
+  detection: Detection;
+
+  /**
+   * Weight is added to the rule score for each matching detection.
+   *
+   * @generated from field: double weight = 2;
+   */
+  weight: number;
+
+  /**
+   * MinCount is the minimum number of matching detections required within the window.
+   *
+   * @generated from field: int32 min_count = 3;
+   */
+  minCount: number;
+};
+
+/**
+ * Describes the message anticheat.v1.RuleCondition.
+ * Use `create(RuleConditionSchema)` to create a new message.
+ */
+export const RuleConditionSchema: GenMessage<RuleCondition> = /*@__PURE__*/
+  messageDesc(file_anticheat_v1_anticheat, 3);
+
+/**
+ * @generated from message anticheat.v1.Rule
+ */
+export type Rule = Message<"anticheat.v1.Rule"> & {
+  /**
+   * @generated from field: int32 rule_id = 1;
+   */
+  ruleId: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool enabled = 3;
+   */
+  enabled: boolean;
+
+  /**
+   * DryRun rules record triggers without performing their action.
+   *
+   * @generated from field: bool dry_run = 4;
+   */
+  dryRun: boolean;
+
+  /**
+   * @generated from field: repeated anticheat.v1.RuleCondition conditions = 5;
+   */
+  conditions: RuleCondition[];
+
+  /**
+   * @generated from field: double threshold = 6;
+   */
+  threshold: number;
+
+  /**
+   * Window is the sliding window over which detections are counted. Unset or zero counts all detections.
+   *
+   * @generated from field: google.protobuf.Duration window = 7;
+   */
+  window?: Duration | undefined;
+
+  /**
+   * @generated from field: anticheat.v1.Action action = 8;
+   */
+  action: Action;
+
+  /**
+   * Duration of gags and bans. Unset or zero is permanent.
+   *
+   * @generated from field: google.protobuf.Duration duration = 9;
+   */
+  duration?: Duration | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 10;
+   */
+  createdOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 11;
+   */
+  updatedOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message anticheat.v1.Rule.
+ * Use `create(RuleSchema)` to create a new message.
+ */
+export const RuleSchema: GenMessage<Rule> = /*@__PURE__*/
+  messageDesc(file_anticheat_v1_anticheat, 4);
+
+/**
+ * @generated from message anticheat.v1.RulesResponse
+ */
+export type RulesResponse = Message<"anticheat.v1.RulesResponse"> & {
+  /**
+   * @generated from field: repeated anticheat.v1.Rule rules = 1;
+   */
+  rules: Rule[];
+};
+
+/**
+ * Describes the message anticheat.v1.RulesResponse.
+ * Use `create(RulesResponseSchema)` to create a new message.
+ */
+export const RulesResponseSchema: GenMessage<RulesResponse> = /*@__PURE__*/
+  messageDesc(file_anticheat_v1_anticheat, 5);
+
+/**
+ * @generated from message anticheat.v1.RuleSaveRequest
+ */
+export type RuleSaveRequest = Message<"anticheat.v1.RuleSaveRequest"> & {
+  /**
+   * @generated from field: anticheat.v1.Rule rule = 1;
+   */
+  rule?: Rule | undefined;
+};
+
+/**
+ * Describes the message anticheat.v1.RuleSaveRequest.
+ * Use `create(RuleSaveRequestSchema)` to create a new message.
+ */
+export const RuleSaveRequestSchema: GenMessage<RuleSaveRequest> = /*@__PURE__*/
+  messageDesc(file_anticheat_v1_anticheat, 6);
+
+/**
+ * @generated from message anticheat.v1.RuleSaveResponse
+ */
+export type RuleSaveResponse = Message<"anticheat.v1.RuleSaveResponse"> & {
+  /**
+   * @generated from field: anticheat.v1.Rule rule = 1;
+   */
+  rule?: Rule | undefined;
+};
+
+/**
+ * Describes the message anticheat.v1.RuleSaveResponse.
+ * Use `create(RuleSaveResponseSchema)` to create a new message.
+ */
+export const RuleSaveResponseSchema: GenMessage<RuleSaveResponse> = /*@__PURE__*/
+  messageDesc(file_anticheat_v1_anticheat, 7);
+
+/**
+ * @generated from message anticheat.v1.RuleDeleteRequest
+ */
+export type RuleDeleteRequest = Message<"anticheat.v1.RuleDeleteRequest"> & {
+  /**
+   * @generated from field: int32 rule_id = 1;
+   */
+  ruleId: number;
+};
+
+/**
+ * Describes the message anticheat.v1.RuleDeleteRequest.
+ * Use `create(RuleDeleteRequestSchema)` to create a new message.
+ */
+export const RuleDeleteRequestSchema: GenMessage<RuleDeleteRequest> = /*@__PURE__*/
+  messageDesc(file_anticheat_v1_anticheat, 8);
+
+/**
+ * @generated from message anticheat.v1.TriggersRequest
+ */
+export type TriggersRequest = Message<"anticheat.v1.TriggersRequest"> & {
+  /**
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+
+  /**
+   * @generated from field: int32 rule_id = 2;
+   */
+  ruleId: number;
+
+  /**
+   * @generated from field: uint64 limit = 3 [jstype = JS_STRING];
+   */
+  limit: string;
+};
+
+/**
+ * Describes the message anticheat.v1.TriggersRequest.
+ * Use `create(TriggersRequestSchema)` to create a new message.
+ */
+export const TriggersRequestSchema: GenMessage<TriggersRequest> = /*@__PURE__*/
+  messageDesc(file_anticheat_v1_anticheat, 9);
+
+/**
+ * @generated from message anticheat.v1.TriggersResponse
+ */
+export type TriggersResponse = Message<"anticheat.v1.TriggersResponse"> & {
+  /**
+   * @generated from field: repeated anticheat.v1.Trigger triggers = 1;
+   */
+  triggers: Trigger[];
+};
+
+/**
+ * Describes the message anticheat.v1.TriggersResponse.
+ * Use `create(TriggersResponseSchema)` to create a new message.
+ */
+export const TriggersResponseSchema: GenMessage<TriggersResponse> = /*@__PURE__*/
+  messageDesc(file_anticheat_v1_anticheat, 10);
+
+/**
+ * @generated from message anticheat.v1.Trigger
+ */
+export type Trigger = Message<"anticheat.v1.Trigger"> & {
+  /**
+   * @generated from field: int64 trigger_id = 1 [jstype = JS_STRING];
+   */
+  triggerId: string;
+
+  /**
+   * @generated from field: int32 rule_id = 2;
+   */
+  ruleId: number;
+
+  /**
+   * @generated from field: string rule_name = 3;
+   */
+  ruleName: string;
+
+  /**
+   * @generated from field: int64 steam_id = 4 [jstype = JS_STRING];
+   */
+  steamId: string;
+
+  /**
+   * @generated from field: string persona_name = 5;
+   */
+  personaName: string;
+
+  /**
+   * @generated from field: anticheat.v1.Action action = 6;
+   */
+  action: Action;
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 7;
+   */
+  duration?: Duration | undefined;
+
+  /**
+   * @generated from field: bool dry_run = 8;
+   */
+  dryRun: boolean;
+
+  /**
+   * @generated from field: double score = 9;
+   */
+  score: number;
+
+  /**
+   * AnticheatIds are the detections within the window when the rule fired.
+   *
+   * @generated from field: repeated int64 anticheat_ids = 10 [jstype = JS_STRING];
+   */
+  anticheatIds: string[];
+
+  /**
+   * @generated from field: int32 ban_id = 11;
+   */
+  banId: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_end = 12;
+   */
+  windowEnd?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 13;
+   */
+  createdOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message anticheat.v1.Trigger.
+ * Use `create(TriggerSchema)` to create a new message.
+ */
+export const TriggerSchema: GenMessage<Trigger> = /*@__PURE__*/
+  messageDesc(file_anticheat_v1_anticheat, 11);
+
 /**
  * @generated from enum anticheat.v1.Detection
  */
@@ -224,6 +521,42 @@ export enum Detection {
 export const DetectionSchema: GenEnum<Detection> = /*@__PURE__*/
   enumDesc(file_anticheat_v1_anticheat, 0);
 
+/**
+ * @generated from enum anticheat.v1.Action
+ */
+export enum Action {
+  /**
+   * @generated from enum value: ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ACTION_NOTIFY = 1;
+   */
+  NOTIFY = 1,
+
+  /**
+   * @generated from enum value: ACTION_GAG = 2;
+   */
+  GAG = 2,
+
+  /**
+   * @generated from enum value: ACTION_KICK = 3;
+   */
+  KICK = 3,
+
+  /**
+   * @generated from enum value: ACTION_BAN = 4;
+   */
+  BAN = 4,
+}
+
+/**
+ * Describes the enum anticheat.v1.Action.
+ */
+export const ActionSchema: GenEnum<Action> = /*@__PURE__*/
+  enumDesc(file_anticheat_v1_anticheat, 1);
+
 /**
  * @generated from service anticheat.v1.AnticheatService
  */
@@ -236,6 +569,38 @@ export const AnticheatService: GenService<{
     input: typeof QueryRequestSchema;
     output: typeof QueryResponseSchema;
   },
+  /**
+   * @generated from rpc anticheat.v1.AnticheatService.Rules
+   */
+  rules: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof RulesResponseSchema;
+  },
+  /**
+   * @generated from rpc anticheat.v1.AnticheatService.RuleSave
+   */
+  ruleSave: {
+    methodKind: "unary";
+    input: typeof RuleSaveRequestSchema;
+    output: typeof RuleSaveResponseSchema;
+  },
+  /**
+   * @generated from rpc anticheat.v1.AnticheatService.RuleDelete
+   */
+  ruleDelete: {
+    methodKind: "unary";
+    input: typeof RuleDeleteRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc anticheat.v1.AnticheatService.Triggers
+   */
+  triggers: {
+    methodKind: "unary";
+    input: typeof TriggersRequestSchema;
+    output: typeof TriggersResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_anticheat_v1_anticheat, 0);
 
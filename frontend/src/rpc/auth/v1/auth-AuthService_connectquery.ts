@@ -8,3 +8,41 @@ import { AuthService } from "./auth_pb";
  * @generated from rpc auth.v1.AuthService.Logout
  */
 export const logout = AuthService.method.logout;
+
+/**
+ * APITokens lists the personal api tokens of the current user, or of any user for admins.
+ *
+ * @generated from rpc auth.v1.AuthService.APITokens
+ */
+export const aPITokens = AuthService.method.aPITokens;
+
+/**
+ * APITokenCreate mints a new token. The secret is only ever returned in this response.
+ *
+ * @generated from rpc auth.v1.AuthService.APITokenCreate
+ */
+export const aPITokenCreate = AuthService.method.aPITokenCreate;
+
+/**
+ * @generated from rpc auth.v1.AuthService.APITokenRevoke
+ */
+export const aPITokenRevoke = AuthService.method.aPITokenRevoke;
+
+/**
+ * Sessions lists the active browser logins of the current user, or of any user for admins.
+ *
+ * @generated from rpc auth.v1.AuthService.Sessions
+ */
+export const sessions = AuthService.method.sessions;
+
+/**
+ * @generated from rpc auth.v1.AuthService.SessionRevoke
+ */
+export const sessionRevoke = AuthService.method.sessionRevoke;
+
+/**
+ * ForceLogout ends every session and revokes every api token of a user.
+ *
+ * @generated from rpc auth.v1.AuthService.ForceLogout
+ */
+export const forceLogout = AuthService.method.forceLogout;
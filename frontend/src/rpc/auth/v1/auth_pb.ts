@@ -2,16 +2,327 @@
 // @generated from file auth/v1/auth.proto (package auth.v1, edition 2023)
 /* eslint-disable */
 
-import type { GenFile, GenService } from "@bufbuild/protobuf/codegenv2";
-import { fileDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
-import type { EmptySchema } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_empty } from "@bufbuild/protobuf/wkt";
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Privilege } from "../../person/v1/privilege_pb";
+import { file_person_v1_privilege } from "../../person/v1/privilege_pb";
+import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file auth/v1/auth.proto.
  */
 export const file_auth_v1_auth: GenFile = /*@__PURE__*/
-  fileDesc("ChJhdXRoL3YxL2F1dGgucHJvdG8SB2F1dGgudjEySQoLQXV0aFNlcnZpY2USOgoGTG9nb3V0EhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgBCjgEKC2NvbS5hdXRoLnYxQglBdXRoUHJvdG9QAVo3Z2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9hdXRoL3YxO2F1dGh2MaICA0FYWKoCB0F1dGguVjHKAgdBdXRoXFYx4gITQXV0aFxWMVxHUEJNZXRhZGF0YeoCCEF1dGg6OlYxYghlZGl0aW9uc3DoBw", [file_google_protobuf_empty]);
+  fileDesc("ChJhdXRoL3YxL2F1dGgucHJvdG8SB2F1dGgudjEi1wIKCEFQSVRva2VuEhoKCHRva2VuX2lkGAEgASgDQggwAbpIA8gBARIaCghzdGVhbV9pZBgCIAEoA0IIMAG6SAPIAQESFAoEbmFtZRgDIAEoCUIGukgDyAEBEhYKBnByZWZpeBgEIAEoCUIGukgDyAEBEi8KCXByaXZpbGVnZRgFIAEoDjIULnBlcnNvbi52MS5Qcml2aWxlZ2VCBrpIA8gBARISCgpwcm9jZWR1cmVzGAYgAygJEjYKCmV4cGlyZXNfb24YByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESMAoMbGFzdF91c2VkX29uGAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI2CgpjcmVhdGVkX29uGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBIigKEEFQSVRva2Vuc1JlcXVlc3QSFAoIc3RlYW1faWQYASABKANCAjABIjYKEUFQSVRva2Vuc1Jlc3BvbnNlEiEKBnRva2VucxgBIAMoCzIRLmF1dGgudjEuQVBJVG9rZW4izgEKFUFQSVRva2VuQ3JlYXRlUmVxdWVzdBIaCgRuYW1lGAEgASgJQgy6SAnIAQFyBBABGEASNAoJcHJpdmlsZWdlGAIgASgOMhQucGVyc29uLnYxLlByaXZpbGVnZUILukgIyAEBggECEAESJgoKcHJvY2VkdXJlcxgDIAMoCUISukgPkgEMEGQiCHIGGIACOgEvEjsKCmV4cGlyZXNfb24YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgu6SAjIAQGyAQJAASJaChZBUElUb2tlbkNyZWF0ZVJlc3BvbnNlEigKBXRva2VuGAEgASgLMhEuYXV0aC52MS5BUElUb2tlbkIGukgDyAEBEhYKBnNlY3JldBgCIAEoCUIGukgDyAEBIjcKFUFQSVRva2VuUmV2b2tlUmVxdWVzdBIeCgh0b2tlbl9pZBgBIAEoA0IMMAG6SAfIAQEiAiAAIqcCCgdTZXNzaW9uEhwKCnNlc3Npb25faWQYASABKANCCDABukgDyAEBEhcKB2lwX2FkZHIYAiABKAlCBrpIA8gBARISCgp1c2VyX2FnZW50GAMgASgJEhQKDGNvdW50cnlfY29kZRgEIAEoCRIUCgxjb3VudHJ5X25hbWUYBSABKAkSEQoJY2l0eV9uYW1lGAYgASgJEg8KB2FzX25hbWUYByABKAkSNgoKY3JlYXRlZF9vbhgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARI4CgxsYXN0X3NlZW5fb24YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESDwoHY3VycmVudBgKIAEoCCInCg9TZXNzaW9uc1JlcXVlc3QSFAoIc3RlYW1faWQYASABKANCAjABIjYKEFNlc3Npb25zUmVzcG9uc2USIgoIc2Vzc2lvbnMYASADKAsyEC5hdXRoLnYxLlNlc3Npb24iOAoUU2Vzc2lvblJldm9rZVJlcXVlc3QSIAoKc2Vzc2lvbl9pZBgBIAEoA0IMMAG6SAfIAQEiAiAAIjQKEkZvcmNlTG9nb3V0UmVxdWVzdBIeCghzdGVhbV9pZBgBIAEoA0IMMAG6SAfIAQEiAiAAMoMECgtBdXRoU2VydmljZRI6CgZMb2dvdXQSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJECglBUElUb2tlbnMSGS5hdXRoLnYxLkFQSVRva2Vuc1JlcXVlc3QaGi5hdXRoLnYxLkFQSVRva2Vuc1Jlc3BvbnNlIgASUwoOQVBJVG9rZW5DcmVhdGUSHi5hdXRoLnYxLkFQSVRva2VuQ3JlYXRlUmVxdWVzdBofLmF1dGgudjEuQVBJVG9rZW5DcmVhdGVSZXNwb25zZSIAEkoKDkFQSVRva2VuUmV2b2tlEh4uYXV0aC52MS5BUElUb2tlblJldm9rZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJBCghTZXNzaW9ucxIYLmF1dGgudjEuU2Vzc2lvbnNSZXF1ZXN0GhkuYXV0aC52MS5TZXNzaW9uc1Jlc3BvbnNlIgASSAoNU2Vzc2lvblJldm9rZRIdLmF1dGgudjEuU2Vzc2lvblJldm9rZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJECgtGb3JjZUxvZ291dBIbLmF1dGgudjEuRm9yY2VMb2dvdXRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgBCjgEKC2NvbS5hdXRoLnYxQglBdXRoUHJvdG9QAVo3Z2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9hdXRoL3YxO2F1dGh2MaICA0FYWKoCB0F1dGguVjHKAgdBdXRoXFYx4gITQXV0aFxWMVxHUEJNZXRhZGF0YeoCCEF1dGg6OlYxYghlZGl0aW9uc3DoBw", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_person_v1_privilege]);
+
+/**
+ * @generated from message auth.v1.APIToken
+ */
+export type APIToken = Message<"auth.v1.APIToken"> & {
+  /**
+   * @generated from field: int64 token_id = 1 [jstype = JS_STRING];
+   */
+  tokenId: string;
+
+  /**
+   * @generated from field: int64 steam_id = 2 [jstype = JS_STRING];
+   */
+  steamId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * Prefix is the start of the secret, used to identify the token.
+   *
+   * @generated from field: string prefix = 4;
+   */
+  prefix: string;
+
+  /**
+   * Privilege is the highest privilege the token acts with, it never exceeds the privilege of the owner.
+   *
+   * @generated from field: person.v1.Privilege privilege = 5;
+   */
+  privilege: Privilege;
+
+  /**
+   * Procedures limits the token to these procedures, eg: /ban.v1.BanService/Query, or whole services when
+   * ending with a slash, eg: /ban.v1.BanService/. Empty allows every procedure permitted by the privilege.
+   *
+   * @generated from field: repeated string procedures = 6;
+   */
+  procedures: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_on = 7;
+   */
+  expiresOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_on = 8;
+   */
+  lastUsedOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 9;
+   */
+  createdOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message auth.v1.APIToken.
+ * Use `create(APITokenSchema)` to create a new message.
+ */
+export const APITokenSchema: GenMessage<APIToken> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 0);
+
+/**
+ * @generated from message auth.v1.APITokensRequest
+ */
+export type APITokensRequest = Message<"auth.v1.APITokensRequest"> & {
+  /**
+   * Admins may list the tokens of another user.
+   *
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+};
+
+/**
+ * Describes the message auth.v1.APITokensRequest.
+ * Use `create(APITokensRequestSchema)` to create a new message.
+ */
+export const APITokensRequestSchema: GenMessage<APITokensRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 1);
+
+/**
+ * @generated from message auth.v1.APITokensResponse
+ */
+export type APITokensResponse = Message<"auth.v1.APITokensResponse"> & {
+  /**
+   * @generated from field: repeated auth.v1.APIToken tokens = 1;
+   */
+  tokens: APIToken[];
+};
+
+/**
+ * Describes the message auth.v1.APITokensResponse.
+ * Use `create(APITokensResponseSchema)` to create a new message.
+ */
+export const APITokensResponseSchema: GenMessage<APITokensResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 2);
+
+/**
+ * @generated from message auth.v1.APITokenCreateRequest
+ */
+export type APITokenCreateRequest = Message<"auth.v1.APITokenCreateRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: person.v1.Privilege privilege = 2;
+   */
+  privilege: Privilege;
+
+  /**
+   * @generated from field: repeated string procedures = 3;
+   */
+  procedures: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_on = 4;
+   */
+  expiresOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message auth.v1.APITokenCreateRequest.
+ * Use `create(APITokenCreateRequestSchema)` to create a new message.
+ */
+export const APITokenCreateRequestSchema: GenMessage<APITokenCreateRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 3);
+
+/**
+ * @generated from message auth.v1.APITokenCreateResponse
+ */
+export type APITokenCreateResponse = Message<"auth.v1.APITokenCreateResponse"> & {
+  /**
+   * @generated from field: auth.v1.APIToken token = 1;
+   */
+  token?: APIToken | undefined;
+
+  /**
+   * Secret is the bearer token to authenticate with.
+   *
+   * @generated from field: string secret = 2;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message auth.v1.APITokenCreateResponse.
+ * Use `create(APITokenCreateResponseSchema)` to create a new message.
+ */
+export const APITokenCreateResponseSchema: GenMessage<APITokenCreateResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 4);
+
+/**
+ * @generated from message auth.v1.APITokenRevokeRequest
+ */
+export type APITokenRevokeRequest = Message<"auth.v1.APITokenRevokeRequest"> & {
+  /**
+   * @generated from field: int64 token_id = 1 [jstype = JS_STRING];
+   */
+  tokenId: string;
+};
+
+/**
+ * Describes the message auth.v1.APITokenRevokeRequest.
+ * Use `create(APITokenRevokeRequestSchema)` to create a new message.
+ */
+export const APITokenRevokeRequestSchema: GenMessage<APITokenRevokeRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 5);
+
+/**
+ * @generated from message auth.v1.Session
+ */
+export type Session = Message<"auth.v1.Session"> & {
+  /**
+   * @generated from field: int64 session_id = 1 [jstype = JS_STRING];
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string ip_addr = 2;
+   */
+  ipAddr: string;
+
+  /**
+   * @generated from field: string user_agent = 3;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: string country_code = 4;
+   */
+  countryCode: string;
+
+  /**
+   * @generated from field: string country_name = 5;
+   */
+  countryName: string;
+
+  /**
+   * @generated from field: string city_name = 6;
+   */
+  cityName: string;
+
+  /**
+   * @generated from field: string as_name = 7;
+   */
+  asName: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 8;
+   */
+  createdOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen_on = 9;
+   */
+  lastSeenOn?: Timestamp | undefined;
+
+  /**
+   * Current is set for the session making the request.
+   *
+   * @generated from field: bool current = 10;
+   */
+  current: boolean;
+};
+
+/**
+ * Describes the message auth.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema: GenMessage<Session> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 6);
+
+/**
+ * @generated from message auth.v1.SessionsRequest
+ */
+export type SessionsRequest = Message<"auth.v1.SessionsRequest"> & {
+  /**
+   * Admins may list the sessions of another user.
+   *
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+};
+
+/**
+ * Describes the message auth.v1.SessionsRequest.
+ * Use `create(SessionsRequestSchema)` to create a new message.
+ */
+export const SessionsRequestSchema: GenMessage<SessionsRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 7);
+
+/**
+ * @generated from message auth.v1.SessionsResponse
+ */
+export type SessionsResponse = Message<"auth.v1.SessionsResponse"> & {
+  /**
+   * @generated from field: repeated auth.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message auth.v1.SessionsResponse.
+ * Use `create(SessionsResponseSchema)` to create a new message.
+ */
+export const SessionsResponseSchema: GenMessage<SessionsResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 8);
+
+/**
+ * @generated from message auth.v1.SessionRevokeRequest
+ */
+export type SessionRevokeRequest = Message<"auth.v1.SessionRevokeRequest"> & {
+  /**
+   * @generated from field: int64 session_id = 1 [jstype = JS_STRING];
+   */
+  sessionId: string;
+};
+
+/**
+ * Describes the message auth.v1.SessionRevokeRequest.
+ * Use `create(SessionRevokeRequestSchema)` to create a new message.
+ */
+export const SessionRevokeRequestSchema: GenMessage<SessionRevokeRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 9);
+
+/**
+ * @generated from message auth.v1.ForceLogoutRequest
+ */
+export type ForceLogoutRequest = Message<"auth.v1.ForceLogoutRequest"> & {
+  /**
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+};
+
+/**
+ * Describes the message auth.v1.ForceLogoutRequest.
+ * Use `create(ForceLogoutRequestSchema)` to create a new message.
+ */
+export const ForceLogoutRequestSchema: GenMessage<ForceLogoutRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_auth, 10);
 
 /**
  * @generated from service auth.v1.AuthService
@@ -25,6 +336,62 @@ export const AuthService: GenService<{
     input: typeof EmptySchema;
     output: typeof EmptySchema;
   },
+  /**
+   * APITokens lists the personal api tokens of the current user, or of any user for admins.
+   *
+   * @generated from rpc auth.v1.AuthService.APITokens
+   */
+  aPITokens: {
+    methodKind: "unary";
+    input: typeof APITokensRequestSchema;
+    output: typeof APITokensResponseSchema;
+  },
+  /**
+   * APITokenCreate mints a new token. The secret is only ever returned in this response.
+   *
+   * @generated from rpc auth.v1.AuthService.APITokenCreate
+   */
+  aPITokenCreate: {
+    methodKind: "unary";
+    input: typeof APITokenCreateRequestSchema;
+    output: typeof APITokenCreateResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.APITokenRevoke
+   */
+  aPITokenRevoke: {
+    methodKind: "unary";
+    input: typeof APITokenRevokeRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Sessions lists the active browser logins of the current user, or of any user for admins.
+   *
+   * @generated from rpc auth.v1.AuthService.Sessions
+   */
+  sessions: {
+    methodKind: "unary";
+    input: typeof SessionsRequestSchema;
+    output: typeof SessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.AuthService.SessionRevoke
+   */
+  sessionRevoke: {
+    methodKind: "unary";
+    input: typeof SessionRevokeRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * ForceLogout ends every session and revokes every api token of a user.
+   *
+   * @generated from rpc auth.v1.AuthService.ForceLogout
+   */
+  forceLogout: {
+    methodKind: "unary";
+    input: typeof ForceLogoutRequestSchema;
+    output: typeof EmptySchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_auth_v1_auth, 0);
 
@@ -0,0 +1,41 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file auth/v1/role.proto (package auth.v1, edition 2023)
+/* eslint-disable */
+
+import { RoleService } from "./role_pb";
+
+/**
+ * Capabilities lists every known capability along with those granted to the current user.
+ *
+ * @generated from rpc auth.v1.RoleService.Capabilities
+ */
+export const capabilities = RoleService.method.capabilities;
+
+/**
+ * @generated from rpc auth.v1.RoleService.Roles
+ */
+export const roles = RoleService.method.roles;
+
+/**
+ * RoleSave creates a role when role_id is 0, otherwise updates it.
+ *
+ * @generated from rpc auth.v1.RoleService.RoleSave
+ */
+export const roleSave = RoleService.method.roleSave;
+
+/**
+ * @generated from rpc auth.v1.RoleService.RoleDelete
+ */
+export const roleDelete = RoleService.method.roleDelete;
+
+/**
+ * @generated from rpc auth.v1.RoleService.PersonRoles
+ */
+export const personRoles = RoleService.method.personRoles;
+
+/**
+ * PersonRolesSet replaces the roles assigned to a person.
+ *
+ * @generated from rpc auth.v1.RoleService.PersonRolesSet
+ */
+export const personRolesSet = RoleService.method.personRolesSet;
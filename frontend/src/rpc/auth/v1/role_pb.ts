@@ -0,0 +1,299 @@
+// @generated by protoc-gen-es v2.12.1 with parameter "target=ts"
+// @generated from file auth/v1/role.proto (package auth.v1, edition 2023)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Privilege } from "../../person/v1/privilege_pb";
+import { file_person_v1_privilege } from "../../person/v1/privilege_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file auth/v1/role.proto.
+ */
+export const file_auth_v1_role: GenFile = /*@__PURE__*/
+  fileDesc("ChJhdXRoL3YxL3JvbGUucHJvdG8SB2F1dGgudjEiWwoKQ2FwYWJpbGl0eRIUCgRuYW1lGAEgASgJQga6SAPIAQESNwoRZGVmYXVsdF9wcml2aWxlZ2UYAiABKA4yFC5wZXJzb24udjEuUHJpdmlsZWdlQga6SAPIAQEiUgoUQ2FwYWJpbGl0aWVzUmVzcG9uc2USKQoMY2FwYWJpbGl0aWVzGAEgAygLMhMuYXV0aC52MS5DYXBhYmlsaXR5Eg8KB2dyYW50ZWQYAiADKAki+wEKBFJvbGUSDwoHcm9sZV9pZBgBIAEoBRIaCgRuYW1lGAIgASgJQgy6SAnIAQFyBBABGEASHQoLZGVzY3JpcHRpb24YAyABKAlCCLpIBXIDGOgHEh4KDGNhcGFiaWxpdGllcxgEIAMoCUIIukgFkgECEGQSJwoJcHJpdmlsZWdlGAUgASgOMhQucGVyc29uLnYxLlByaXZpbGVnZRIuCgpjcmVhdGVkX29uGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX29uGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCItCg1Sb2xlc1Jlc3BvbnNlEhwKBXJvbGVzGAEgAygLMg0uYXV0aC52MS5Sb2xlIjYKD1JvbGVTYXZlUmVxdWVzdBIjCgRyb2xlGAEgASgLMg0uYXV0aC52MS5Sb2xlQga6SAPIAQEiNwoQUm9sZVNhdmVSZXNwb25zZRIjCgRyb2xlGAEgASgLMg0uYXV0aC52MS5Sb2xlQga6SAPIAQEiMAoRUm9sZURlbGV0ZVJlcXVlc3QSGwoHcm9sZV9pZBgBIAEoBUIKukgHyAEBGgIgACI0ChJQZXJzb25Sb2xlc1JlcXVlc3QSHgoIc3RlYW1faWQYASABKANCDDABukgHyAEBIgIgACIzChNQZXJzb25Sb2xlc1Jlc3BvbnNlEhwKBXJvbGVzGAEgAygLMg0uYXV0aC52MS5Sb2xlIlMKFVBlcnNvblJvbGVzU2V0UmVxdWVzdBIeCghzdGVhbV9pZBgBIAEoA0IMMAG6SAfIAQEiAiAAEhoKCHJvbGVfaWRzGAIgAygFQgi6SAWSAQIQZDKwAwoLUm9sZVNlcnZpY2USRwoMQ2FwYWJpbGl0aWVzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh0uYXV0aC52MS5DYXBhYmlsaXRpZXNSZXNwb25zZSIAEjkKBVJvbGVzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhYuYXV0aC52MS5Sb2xlc1Jlc3BvbnNlIgASQQoIUm9sZVNhdmUSGC5hdXRoLnYxLlJvbGVTYXZlUmVxdWVzdBoZLmF1dGgudjEuUm9sZVNhdmVSZXNwb25zZSIAEkIKClJvbGVEZWxldGUSGi5hdXRoLnYxLlJvbGVEZWxldGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASSgoLUGVyc29uUm9sZXMSGy5hdXRoLnYxLlBlcnNvblJvbGVzUmVxdWVzdBocLmF1dGgudjEuUGVyc29uUm9sZXNSZXNwb25zZSIAEkoKDlBlcnNvblJvbGVzU2V0Eh4uYXV0aC52MS5QZXJzb25Sb2xlc1NldFJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiAEKOAQoLY29tLmF1dGgudjFCCVJvbGVQcm90b1ABWjdnaXRodWIuY29tL2xlaWdobWFjZG9uYWxkL2diYW5zL2ludGVybmFsL2F1dGgvdjE7YXV0aHYxogIDQVhYqgIHQXV0aC5WMcoCB0F1dGhcVjHiAhNBdXRoXFYxXEdQQk1ldGFkYXRh6gIIQXV0aDo6VjFiCGVkaXRpb25zcOgH", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_person_v1_privilege]);
+
+/**
+ * @generated from message auth.v1.Capability
+ */
+export type Capability = Message<"auth.v1.Capability"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * DefaultPrivilege grants the capability when no roles have been configured.
+   *
+   * @generated from field: person.v1.Privilege default_privilege = 2;
+   */
+  defaultPrivilege: Privilege;
+};
+
+/**
+ * Describes the message auth.v1.Capability.
+ * Use `create(CapabilitySchema)` to create a new message.
+ */
+export const CapabilitySchema: GenMessage<Capability> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 0);
+
+/**
+ * @generated from message auth.v1.CapabilitiesResponse
+ */
+export type CapabilitiesResponse = Message<"auth.v1.CapabilitiesResponse"> & {
+  /**
+   * @generated from field: repeated auth.v1.Capability capabilities = 1;
+   */
+  capabilities: Capability[];
+
+  /**
+   * @generated from field: repeated string granted = 2;
+   */
+  granted: string[];
+};
+
+/**
+ * Describes the message auth.v1.CapabilitiesResponse.
+ * Use `create(CapabilitiesResponseSchema)` to create a new message.
+ */
+export const CapabilitiesResponseSchema: GenMessage<CapabilitiesResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 1);
+
+/**
+ * @generated from message auth.v1.Role
+ */
+export type Role = Message<"auth.v1.Role"> & {
+  /**
+   * @generated from field: int32 role_id = 1;
+   */
+  roleId: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated string capabilities = 4;
+   */
+  capabilities: string[];
+
+  /**
+   * Privilege is set for default roles, which are held by everyone at or above it and cannot be assigned.
+   *
+   * @generated from field: person.v1.Privilege privilege = 5;
+   */
+  privilege: Privilege;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 6;
+   */
+  createdOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 7;
+   */
+  updatedOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message auth.v1.Role.
+ * Use `create(RoleSchema)` to create a new message.
+ */
+export const RoleSchema: GenMessage<Role> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 2);
+
+/**
+ * @generated from message auth.v1.RolesResponse
+ */
+export type RolesResponse = Message<"auth.v1.RolesResponse"> & {
+  /**
+   * @generated from field: repeated auth.v1.Role roles = 1;
+   */
+  roles: Role[];
+};
+
+/**
+ * Describes the message auth.v1.RolesResponse.
+ * Use `create(RolesResponseSchema)` to create a new message.
+ */
+export const RolesResponseSchema: GenMessage<RolesResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 3);
+
+/**
+ * @generated from message auth.v1.RoleSaveRequest
+ */
+export type RoleSaveRequest = Message<"auth.v1.RoleSaveRequest"> & {
+  /**
+   * @generated from field: auth.v1.Role role = 1;
+   */
+  role?: Role | undefined;
+};
+
+/**
+ * Describes the message auth.v1.RoleSaveRequest.
+ * Use `create(RoleSaveRequestSchema)` to create a new message.
+ */
+export const RoleSaveRequestSchema: GenMessage<RoleSaveRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 4);
+
+/**
+ * @generated from message auth.v1.RoleSaveResponse
+ */
+export type RoleSaveResponse = Message<"auth.v1.RoleSaveResponse"> & {
+  /**
+   * @generated from field: auth.v1.Role role = 1;
+   */
+  role?: Role | undefined;
+};
+
+/**
+ * Describes the message auth.v1.RoleSaveResponse.
+ * Use `create(RoleSaveResponseSchema)` to create a new message.
+ */
+export const RoleSaveResponseSchema: GenMessage<RoleSaveResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 5);
+
+/**
+ * @generated from message auth.v1.RoleDeleteRequest
+ */
+export type RoleDeleteRequest = Message<"auth.v1.RoleDeleteRequest"> & {
+  /**
+   * @generated from field: int32 role_id = 1;
+   */
+  roleId: number;
+};
+
+/**
+ * Describes the message auth.v1.RoleDeleteRequest.
+ * Use `create(RoleDeleteRequestSchema)` to create a new message.
+ */
+export const RoleDeleteRequestSchema: GenMessage<RoleDeleteRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 6);
+
+/**
+ * @generated from message auth.v1.PersonRolesRequest
+ */
+export type PersonRolesRequest = Message<"auth.v1.PersonRolesRequest"> & {
+  /**
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+};
+
+/**
+ * Describes the message auth.v1.PersonRolesRequest.
+ * Use `create(PersonRolesRequestSchema)` to create a new message.
+ */
+export const PersonRolesRequestSchema: GenMessage<PersonRolesRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 7);
+
+/**
+ * @generated from message auth.v1.PersonRolesResponse
+ */
+export type PersonRolesResponse = Message<"auth.v1.PersonRolesResponse"> & {
+  /**
+   * @generated from field: repeated auth.v1.Role roles = 1;
+   */
+  roles: Role[];
+};
+
+/**
+ * Describes the message auth.v1.PersonRolesResponse.
+ * Use `create(PersonRolesResponseSchema)` to create a new message.
+ */
+export const PersonRolesResponseSchema: GenMessage<PersonRolesResponse> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 8);
+
+/**
+ * @generated from message auth.v1.PersonRolesSetRequest
+ */
+export type PersonRolesSetRequest = Message<"auth.v1.PersonRolesSetRequest"> & {
+  /**
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+
+  /**
+   * @generated from field: repeated int32 role_ids = 2;
+   */
+  roleIds: number[];
+};
+
+/**
+ * Describes the message auth.v1.PersonRolesSetRequest.
+ * Use `create(PersonRolesSetRequestSchema)` to create a new message.
+ */
+export const PersonRolesSetRequestSchema: GenMessage<PersonRolesSetRequest> = /*@__PURE__*/
+  messageDesc(file_auth_v1_role, 9);
+
+/**
+ * @generated from service auth.v1.RoleService
+ */
+export const RoleService: GenService<{
+  /**
+   * Capabilities lists every known capability along with those granted to the current user.
+   *
+   * @generated from rpc auth.v1.RoleService.Capabilities
+   */
+  capabilities: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof CapabilitiesResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.RoleService.Roles
+   */
+  roles: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof RolesResponseSchema;
+  },
+  /**
+   * RoleSave creates a role when role_id is 0, otherwise updates it.
+   *
+   * @generated from rpc auth.v1.RoleService.RoleSave
+   */
+  roleSave: {
+    methodKind: "unary";
+    input: typeof RoleSaveRequestSchema;
+    output: typeof RoleSaveResponseSchema;
+  },
+  /**
+   * @generated from rpc auth.v1.RoleService.RoleDelete
+   */
+  roleDelete: {
+    methodKind: "unary";
+    input: typeof RoleDeleteRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc auth.v1.RoleService.PersonRoles
+   */
+  personRoles: {
+    methodKind: "unary";
+    input: typeof PersonRolesRequestSchema;
+    output: typeof PersonRolesResponseSchema;
+  },
+  /**
+   * PersonRolesSet replaces the roles assigned to a person.
+   *
+   * @generated from rpc auth.v1.RoleService.PersonRolesSet
+   */
+  personRolesSet: {
+    methodKind: "unary";
+    input: typeof PersonRolesSetRequestSchema;
+    output: typeof EmptySchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_auth_v1_role, 0);
+
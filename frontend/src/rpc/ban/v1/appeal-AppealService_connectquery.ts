@@ -30,6 +30,46 @@ export const editAppealMessage = AppealService.method.editAppealMessage;
 export const deleteAppealMessage = AppealService.method.deleteAppealMessage;
 
 /**
+ * SetAppealState updates the outcome of an appeal. Reduced outcomes also shorten the ban to valid_until.
+ *
  * @generated from rpc ban.v1.AppealService.SetAppealState
  */
 export const setAppealState = AppealService.method.setAppealState;
+
+/**
+ * AppealAssign assigns an appeal to a moderator, an assignee_id of 0 unassigns it.
+ *
+ * @generated from rpc ban.v1.AppealService.AppealAssign
+ */
+export const appealAssign = AppealService.method.appealAssign;
+
+/**
+ * AppealDashboard counts the appeals assigned to a moderator, defaulting to the current user.
+ *
+ * @generated from rpc ban.v1.AppealService.AppealDashboard
+ */
+export const appealDashboard = AppealService.method.appealDashboard;
+
+/**
+ * @generated from rpc ban.v1.AppealService.AppealTemplates
+ */
+export const appealTemplates = AppealService.method.appealTemplates;
+
+/**
+ * AppealTemplateSave creates a template when appeal_template_id is 0, otherwise updates it.
+ *
+ * @generated from rpc ban.v1.AppealService.AppealTemplateSave
+ */
+export const appealTemplateSave = AppealService.method.appealTemplateSave;
+
+/**
+ * @generated from rpc ban.v1.AppealService.AppealTemplateDelete
+ */
+export const appealTemplateDelete = AppealService.method.appealTemplateDelete;
+
+/**
+ * AppealTemplateRender fills in a template with the details of a ban, ready to be used as a reply.
+ *
+ * @generated from rpc ban.v1.AppealService.AppealTemplateRender
+ */
+export const appealTemplateRender = AppealService.method.appealTemplateRender;
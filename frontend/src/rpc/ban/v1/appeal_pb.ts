@@ -17,7 +17,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file ban/v1/appeal.proto.
  */
 export const file_ban_v1_appeal: GenFile = /*@__PURE__*/
-  fileDesc("ChNiYW4vdjEvYXBwZWFsLnByb3RvEgZiYW4udjEiZwoVU2V0QXBwZWFsU3RhdGVSZXF1ZXN0EhYKBmJhbl9pZBgBIAEoBUIGukgDyAEBEjYKDGFwcGVhbF9zdGF0ZRgCIAEoDjITLmJhbi52MS5BcHBlYWxTdGF0ZUILukgIyAEBggECEAEiMgoWU2V0QXBwZWFsU3RhdGVSZXNwb25zZRIYCgNiYW4YASABKAsyCy5iYW4udjEuQmFuIiEKDkFwcGVhbHNSZXF1ZXN0Eg8KB2RlbGV0ZWQYASABKAgiQgoPQXBwZWFsc1Jlc3BvbnNlEi8KB2FwcGVhbHMYASADKAsyFi5iYW4udjEuQXBwZWFsT3ZlcnZpZXdCBrpIA8gBASIpCg9NZXNzYWdlc1JlcXVlc3QSFgoGYmFuX2lkGAEgASgFQga6SAPIAQEiQwoQTWVzc2FnZXNSZXNwb25zZRIvCghtZXNzYWdlcxgBIAMoCzIVLmJhbi52MS5BcHBlYWxNZXNzYWdlQga6SAPIAQEi1gEKDkFwcGVhbE92ZXJ2aWV3EiAKA2JhbhgBIAEoCzILLmJhbi52MS5CYW5CBrpIA8gBARInChNzb3VyY2VfcGVyc29uYV9uYW1lGAIgASgJQgq6SAfIAQFyAhggEicKEnNvdXJjZV9hdmF0YXJfaGFzaBgDIAEoCUILukgIyAEBcgOYASgSJwoTdGFyZ2V0X3BlcnNvbmFfbmFtZRgEIAEoCUIKukgHyAEBcgIYIBInChJ0YXJnZXRfYXZhdGFyX2hhc2gYBSABKAlCC7pICMgBAXIDmAEoIqMDCg1BcHBlYWxNZXNzYWdlEhoKBmJhbl9pZBgBIAEoBUIKukgHyAEBGgIgABIkCg5iYW5fbWVzc2FnZV9pZBgCIAEoA0IMMAG6SAfIAQEiAiAAEicKCWF1dGhvcl9pZBgDIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAESIgoKbWVzc2FnZV9tZBgEIAEoCUIOukgLyAEBcgYQARjQhgMSFwoHZGVsZXRlZBgFIAEoCEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIgCgthdmF0YXJfaGFzaBgIIAEoCUILukgIyAEBcgOYASgSIgoMcGVyc29uYV9uYW1lGAkgASgJQgy6SAnIAQFyBBACGCASNAoJcHJpdmlsZWdlGAogASgOMhQucGVyc29uLnYxLlByaXZpbGVnZUILukgIyAEBggECEAEiSwoMUmVwbHlSZXF1ZXN0EhoKBmJhbl9pZBgBIAEoBUIKukgHyAEBGgIgABIfCgdib2R5X21kGAIgASgJQg66SAvIAQFyBhABGNCGAyI/Cg1SZXBseVJlc3BvbnNlEi4KB21lc3NhZ2UYASABKAsyFS5iYW4udjEuQXBwZWFsTWVzc2FnZUIGukgDyAEBImEKGEVkaXRBcHBlYWxNZXNzYWdlUmVxdWVzdBIkCg5iYW5fbWVzc2FnZV9pZBgBIAEoA0IMMAG6SAfIAQEiAiAAEh8KB2JvZHlfbWQYAiABKAlCDrpIC8gBAXIGEAEY0IYDIksKGUVkaXRBcHBlYWxNZXNzYWdlUmVzcG9uc2USLgoHbWVzc2FnZRgBIAEoCzIVLmJhbi52MS5BcHBlYWxNZXNzYWdlQga6SAPIAQEiQgoaRGVsZXRlQXBwZWFsTWVzc2FnZVJlcXVlc3QSJAoOYmFuX21lc3NhZ2VfaWQYASABKANCDDABukgHyAEBIgIgADLKAwoNQXBwZWFsU2VydmljZRI8CgdBcHBlYWxzEhYuYmFuLnYxLkFwcGVhbHNSZXF1ZXN0GhcuYmFuLnYxLkFwcGVhbHNSZXNwb25zZSIAEj8KCE1lc3NhZ2VzEhcuYmFuLnYxLk1lc3NhZ2VzUmVxdWVzdBoYLmJhbi52MS5NZXNzYWdlc1Jlc3BvbnNlIgASNgoFUmVwbHkSFC5iYW4udjEuUmVwbHlSZXF1ZXN0GhUuYmFuLnYxLlJlcGx5UmVzcG9uc2UiABJaChFFZGl0QXBwZWFsTWVzc2FnZRIgLmJhbi52MS5FZGl0QXBwZWFsTWVzc2FnZVJlcXVlc3QaIS5iYW4udjEuRWRpdEFwcGVhbE1lc3NhZ2VSZXNwb25zZSIAElMKE0RlbGV0ZUFwcGVhbE1lc3NhZ2USIi5iYW4udjEuRGVsZXRlQXBwZWFsTWVzc2FnZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJRCg5TZXRBcHBlYWxTdGF0ZRIdLmJhbi52MS5TZXRBcHBlYWxTdGF0ZVJlcXVlc3QaHi5iYW4udjEuU2V0QXBwZWFsU3RhdGVSZXNwb25zZSIAQokBCgpjb20uYmFuLnYxQgtBcHBlYWxQcm90b1ABWjVnaXRodWIuY29tL2xlaWdobWFjZG9uYWxkL2diYW5zL2ludGVybmFsL2Jhbi92MTtiYW52MaICA0JYWKoCBkJhbi5WMcoCBkJhblxWMeICEkJhblxWMVxHUEJNZXRhZGF0YeoCB0Jhbjo6VjFiCGVkaXRpb25zcOgH", [file_ban_v1_ban, file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_person_v1_privilege]);
+  fileDesc("ChNiYW4vdjEvYXBwZWFsLnByb3RvEgZiYW4udjEimAEKFVNldEFwcGVhbFN0YXRlUmVxdWVzdBIWCgZiYW5faWQYASABKAVCBrpIA8gBARI2CgxhcHBlYWxfc3RhdGUYAiABKA4yEy5iYW4udjEuQXBwZWFsU3RhdGVCC7pICMgBAYIBAhABEi8KC3ZhbGlkX3VudGlsGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCIyChZTZXRBcHBlYWxTdGF0ZVJlc3BvbnNlEhgKA2JhbhgBIAEoCzILLmJhbi52MS5CYW4iIQoOQXBwZWFsc1JlcXVlc3QSDwoHZGVsZXRlZBgBIAEoCCJCCg9BcHBlYWxzUmVzcG9uc2USLwoHYXBwZWFscxgBIAMoCzIWLmJhbi52MS5BcHBlYWxPdmVydmlld0IGukgDyAEBIikKD01lc3NhZ2VzUmVxdWVzdBIWCgZiYW5faWQYASABKAVCBrpIA8gBASJDChBNZXNzYWdlc1Jlc3BvbnNlEi8KCG1lc3NhZ2VzGAEgAygLMhUuYmFuLnYxLkFwcGVhbE1lc3NhZ2VCBrpIA8gBASLvAQoOQXBwZWFsT3ZlcnZpZXcSIAoDYmFuGAEgASgLMgsuYmFuLnYxLkJhbkIGukgDyAEBEicKE3NvdXJjZV9wZXJzb25hX25hbWUYAiABKAlCCrpIB8gBAXICGCASJwoSc291cmNlX2F2YXRhcl9oYXNoGAMgASgJQgu6SAjIAQFyA5gBKBInChN0YXJnZXRfcGVyc29uYV9uYW1lGAQgASgJQgq6SAfIAQFyAhggEicKEnRhcmdldF9hdmF0YXJfaGFzaBgFIAEoCUILukgIyAEBcgOYASgSFwoLYXNzaWduZWVfaWQYBiABKANCAjABIqMDCg1BcHBlYWxNZXNzYWdlEhoKBmJhbl9pZBgBIAEoBUIKukgHyAEBGgIgABIkCg5iYW5fbWVzc2FnZV9pZBgCIAEoA0IMMAG6SAfIAQEiAiAAEicKCWF1dGhvcl9pZBgDIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAESIgoKbWVzc2FnZV9tZBgEIAEoCUIOukgLyAEBcgYQARjQhgMSFwoHZGVsZXRlZBgFIAEoCEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIgCgthdmF0YXJfaGFzaBgIIAEoCUILukgIyAEBcgOYASgSIgoMcGVyc29uYV9uYW1lGAkgASgJQgy6SAnIAQFyBBACGCASNAoJcHJpdmlsZWdlGAogASgOMhQucGVyc29uLnYxLlByaXZpbGVnZUILukgIyAEBggECEAEiSwoMUmVwbHlSZXF1ZXN0EhoKBmJhbl9pZBgBIAEoBUIKukgHyAEBGgIgABIfCgdib2R5X21kGAIgASgJQg66SAvIAQFyBhABGNCGAyI/Cg1SZXBseVJlc3BvbnNlEi4KB21lc3NhZ2UYASABKAsyFS5iYW4udjEuQXBwZWFsTWVzc2FnZUIGukgDyAEBImEKGEVkaXRBcHBlYWxNZXNzYWdlUmVxdWVzdBIkCg5iYW5fbWVzc2FnZV9pZBgBIAEoA0IMMAG6SAfIAQEiAiAAEh8KB2JvZHlfbWQYAiABKAlCDrpIC8gBAXIGEAEY0IYDIksKGUVkaXRBcHBlYWxNZXNzYWdlUmVzcG9uc2USLgoHbWVzc2FnZRgBIAEoCzIVLmJhbi52MS5BcHBlYWxNZXNzYWdlQga6SAPIAQEiQgoaRGVsZXRlQXBwZWFsTWVzc2FnZVJlcXVlc3QSJAoOYmFuX21lc3NhZ2VfaWQYASABKANCDDABukgHyAEBIgIgACJRChNBcHBlYWxBc3NpZ25SZXF1ZXN0EhoKBmJhbl9pZBgBIAEoBUIKukgHyAEBGgIgABIeCgthc3NpZ25lZV9pZBgCIAEoA0IJMAG6SAQiAigAIjUKFkFwcGVhbERhc2hib2FyZFJlcXVlc3QSGwoIc3RlYW1faWQYASABKANCCTABukgEIgIoACJcChBBcHBlYWxTdGF0ZUNvdW50EjEKDGFwcGVhbF9zdGF0ZRgBIAEoDjITLmJhbi52MS5BcHBlYWxTdGF0ZUIGukgDyAEBEhUKBWNvdW50GAIgASgFQga6SAPIAQEiVwoXQXBwZWFsRGFzaGJvYXJkUmVzcG9uc2USKAoGc3RhdGVzGAEgAygLMhguYmFuLnYxLkFwcGVhbFN0YXRlQ291bnQSEgoKdW5hc3NpZ25lZBgCIAEoBSLJAQoOQXBwZWFsVGVtcGxhdGUSGgoSYXBwZWFsX3RlbXBsYXRlX2lkGAEgASgFEhoKBG5hbWUYAiABKAlCDLpICcgBAXIEEAEYQBIfCgdib2R5X21kGAMgASgJQg66SAvIAQFyBhABGNCGAxIuCgpjcmVhdGVkX29uGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX29uGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJEChdBcHBlYWxUZW1wbGF0ZXNSZXNwb25zZRIpCgl0ZW1wbGF0ZXMYASADKAsyFi5iYW4udjEuQXBwZWFsVGVtcGxhdGUiTQoZQXBwZWFsVGVtcGxhdGVTYXZlUmVxdWVzdBIwCgh0ZW1wbGF0ZRgBIAEoCzIWLmJhbi52MS5BcHBlYWxUZW1wbGF0ZUIGukgDyAEBIk4KGkFwcGVhbFRlbXBsYXRlU2F2ZVJlc3BvbnNlEjAKCHRlbXBsYXRlGAEgASgLMhYuYmFuLnYxLkFwcGVhbFRlbXBsYXRlQga6SAPIAQEiRQobQXBwZWFsVGVtcGxhdGVEZWxldGVSZXF1ZXN0EiYKEmFwcGVhbF90ZW1wbGF0ZV9pZBgBIAEoBUIKukgHyAEBGgIgACJhChtBcHBlYWxUZW1wbGF0ZVJlbmRlclJlcXVlc3QSGgoGYmFuX2lkGAEgASgFQgq6SAfIAQEaAiAAEiYKEmFwcGVhbF90ZW1wbGF0ZV9pZBgCIAEoBUIKukgHyAEBGgIgACI3ChxBcHBlYWxUZW1wbGF0ZVJlbmRlclJlc3BvbnNlEhcKB2JvZHlfbWQYASABKAlCBrpIA8gBATLQBwoNQXBwZWFsU2VydmljZRI8CgdBcHBlYWxzEhYuYmFuLnYxLkFwcGVhbHNSZXF1ZXN0GhcuYmFuLnYxLkFwcGVhbHNSZXNwb25zZSIAEj8KCE1lc3NhZ2VzEhcuYmFuLnYxLk1lc3NhZ2VzUmVxdWVzdBoYLmJhbi52MS5NZXNzYWdlc1Jlc3BvbnNlIgASNgoFUmVwbHkSFC5iYW4udjEuUmVwbHlSZXF1ZXN0GhUuYmFuLnYxLlJlcGx5UmVzcG9uc2UiABJaChFFZGl0QXBwZWFsTWVzc2FnZRIgLmJhbi52MS5FZGl0QXBwZWFsTWVzc2FnZVJlcXVlc3QaIS5iYW4udjEuRWRpdEFwcGVhbE1lc3NhZ2VSZXNwb25zZSIAElMKE0RlbGV0ZUFwcGVhbE1lc3NhZ2USIi5iYW4udjEuRGVsZXRlQXBwZWFsTWVzc2FnZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJRCg5TZXRBcHBlYWxTdGF0ZRIdLmJhbi52MS5TZXRBcHBlYWxTdGF0ZVJlcXVlc3QaHi5iYW4udjEuU2V0QXBwZWFsU3RhdGVSZXNwb25zZSIAEkUKDEFwcGVhbEFzc2lnbhIbLmJhbi52MS5BcHBlYWxBc3NpZ25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASVAoPQXBwZWFsRGFzaGJvYXJkEh4uYmFuLnYxLkFwcGVhbERhc2hib2FyZFJlcXVlc3QaHy5iYW4udjEuQXBwZWFsRGFzaGJvYXJkUmVzcG9uc2UiABJMCg9BcHBlYWxUZW1wbGF0ZXMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHy5iYW4udjEuQXBwZWFsVGVtcGxhdGVzUmVzcG9uc2UiABJdChJBcHBlYWxUZW1wbGF0ZVNhdmUSIS5iYW4udjEuQXBwZWFsVGVtcGxhdGVTYXZlUmVxdWVzdBoiLmJhbi52MS5BcHBlYWxUZW1wbGF0ZVNhdmVSZXNwb25zZSIAElUKFEFwcGVhbFRlbXBsYXRlRGVsZXRlEiMuYmFuLnYxLkFwcGVhbFRlbXBsYXRlRGVsZXRlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEmMKFEFwcGVhbFRlbXBsYXRlUmVuZGVyEiMuYmFuLnYxLkFwcGVhbFRlbXBsYXRlUmVuZGVyUmVxdWVzdBokLmJhbi52MS5BcHBlYWxUZW1wbGF0ZVJlbmRlclJlc3BvbnNlIgBCiQEKCmNvbS5iYW4udjFCC0FwcGVhbFByb3RvUAFaNWdpdGh1Yi5jb20vbGVpZ2htYWNkb25hbGQvZ2JhbnMvaW50ZXJuYWwvYmFuL3YxO2JhbnYxogIDQlhYqgIGQmFuLlYxygIGQmFuXFYx4gISQmFuXFYxXEdQQk1ldGFkYXRh6gIHQmFuOjpWMWIIZWRpdGlvbnNw6Ac", [file_ban_v1_ban, file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_person_v1_privilege]);
 
 /**
  * @generated from message ban.v1.SetAppealStateRequest
@@ -32,6 +32,13 @@ export type SetAppealStateRequest = Message<"ban.v1.SetAppealStateRequest"> & {
    * @generated from field: ban.v1.AppealState appeal_state = 2;
    */
   appealState: AppealState;
+
+  /**
+   * ValidUntil is the new, earlier, expiry of the ban. It is required for Reduced outcomes.
+   *
+   * @generated from field: google.protobuf.Timestamp valid_until = 3;
+   */
+  validUntil?: Timestamp | undefined;
 };
 
 /**
@@ -154,6 +161,11 @@ export type AppealOverview = Message<"ban.v1.AppealOverview"> & {
    * @generated from field: string target_avatar_hash = 5;
    */
   targetAvatarHash: string;
+
+  /**
+   * @generated from field: int64 assignee_id = 6 [jstype = JS_STRING];
+   */
+  assigneeId: string;
 };
 
 /**
@@ -320,6 +332,235 @@ export type DeleteAppealMessageRequest = Message<"ban.v1.DeleteAppealMessageRequ
 export const DeleteAppealMessageRequestSchema: GenMessage<DeleteAppealMessageRequest> = /*@__PURE__*/
   messageDesc(file_ban_v1_appeal, 12);
 
+/**
+ * @generated from message ban.v1.AppealAssignRequest
+ */
+export type AppealAssignRequest = Message<"ban.v1.AppealAssignRequest"> & {
+  /**
+   * @generated from field: int32 ban_id = 1;
+   */
+  banId: number;
+
+  /**
+   * @generated from field: int64 assignee_id = 2 [jstype = JS_STRING];
+   */
+  assigneeId: string;
+};
+
+/**
+ * Describes the message ban.v1.AppealAssignRequest.
+ * Use `create(AppealAssignRequestSchema)` to create a new message.
+ */
+export const AppealAssignRequestSchema: GenMessage<AppealAssignRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 13);
+
+/**
+ * @generated from message ban.v1.AppealDashboardRequest
+ */
+export type AppealDashboardRequest = Message<"ban.v1.AppealDashboardRequest"> & {
+  /**
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+};
+
+/**
+ * Describes the message ban.v1.AppealDashboardRequest.
+ * Use `create(AppealDashboardRequestSchema)` to create a new message.
+ */
+export const AppealDashboardRequestSchema: GenMessage<AppealDashboardRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 14);
+
+/**
+ * @generated from message ban.v1.AppealStateCount
+ */
+export type AppealStateCount = Message<"ban.v1.AppealStateCount"> & {
+  /**
+   * @generated from field: ban.v1.AppealState appeal_state = 1;
+   */
+  appealState: AppealState;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message ban.v1.AppealStateCount.
+ * Use `create(AppealStateCountSchema)` to create a new message.
+ */
+export const AppealStateCountSchema: GenMessage<AppealStateCount> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 15);
+
+/**
+ * @generated from message ban.v1.AppealDashboardResponse
+ */
+export type AppealDashboardResponse = Message<"ban.v1.AppealDashboardResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.AppealStateCount states = 1;
+   */
+  states: AppealStateCount[];
+
+  /**
+   * Unassigned is the number of open appeals which nobody has been assigned to.
+   *
+   * @generated from field: int32 unassigned = 2;
+   */
+  unassigned: number;
+};
+
+/**
+ * Describes the message ban.v1.AppealDashboardResponse.
+ * Use `create(AppealDashboardResponseSchema)` to create a new message.
+ */
+export const AppealDashboardResponseSchema: GenMessage<AppealDashboardResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 16);
+
+/**
+ * @generated from message ban.v1.AppealTemplate
+ */
+export type AppealTemplate = Message<"ban.v1.AppealTemplate"> & {
+  /**
+   * @generated from field: int32 appeal_template_id = 1;
+   */
+  appealTemplateId: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string body_md = 3;
+   */
+  bodyMd: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 4;
+   */
+  createdOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 5;
+   */
+  updatedOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message ban.v1.AppealTemplate.
+ * Use `create(AppealTemplateSchema)` to create a new message.
+ */
+export const AppealTemplateSchema: GenMessage<AppealTemplate> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 17);
+
+/**
+ * @generated from message ban.v1.AppealTemplatesResponse
+ */
+export type AppealTemplatesResponse = Message<"ban.v1.AppealTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.AppealTemplate templates = 1;
+   */
+  templates: AppealTemplate[];
+};
+
+/**
+ * Describes the message ban.v1.AppealTemplatesResponse.
+ * Use `create(AppealTemplatesResponseSchema)` to create a new message.
+ */
+export const AppealTemplatesResponseSchema: GenMessage<AppealTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 18);
+
+/**
+ * @generated from message ban.v1.AppealTemplateSaveRequest
+ */
+export type AppealTemplateSaveRequest = Message<"ban.v1.AppealTemplateSaveRequest"> & {
+  /**
+   * @generated from field: ban.v1.AppealTemplate template = 1;
+   */
+  template?: AppealTemplate | undefined;
+};
+
+/**
+ * Describes the message ban.v1.AppealTemplateSaveRequest.
+ * Use `create(AppealTemplateSaveRequestSchema)` to create a new message.
+ */
+export const AppealTemplateSaveRequestSchema: GenMessage<AppealTemplateSaveRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 19);
+
+/**
+ * @generated from message ban.v1.AppealTemplateSaveResponse
+ */
+export type AppealTemplateSaveResponse = Message<"ban.v1.AppealTemplateSaveResponse"> & {
+  /**
+   * @generated from field: ban.v1.AppealTemplate template = 1;
+   */
+  template?: AppealTemplate | undefined;
+};
+
+/**
+ * Describes the message ban.v1.AppealTemplateSaveResponse.
+ * Use `create(AppealTemplateSaveResponseSchema)` to create a new message.
+ */
+export const AppealTemplateSaveResponseSchema: GenMessage<AppealTemplateSaveResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 20);
+
+/**
+ * @generated from message ban.v1.AppealTemplateDeleteRequest
+ */
+export type AppealTemplateDeleteRequest = Message<"ban.v1.AppealTemplateDeleteRequest"> & {
+  /**
+   * @generated from field: int32 appeal_template_id = 1;
+   */
+  appealTemplateId: number;
+};
+
+/**
+ * Describes the message ban.v1.AppealTemplateDeleteRequest.
+ * Use `create(AppealTemplateDeleteRequestSchema)` to create a new message.
+ */
+export const AppealTemplateDeleteRequestSchema: GenMessage<AppealTemplateDeleteRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 21);
+
+/**
+ * @generated from message ban.v1.AppealTemplateRenderRequest
+ */
+export type AppealTemplateRenderRequest = Message<"ban.v1.AppealTemplateRenderRequest"> & {
+  /**
+   * @generated from field: int32 ban_id = 1;
+   */
+  banId: number;
+
+  /**
+   * @generated from field: int32 appeal_template_id = 2;
+   */
+  appealTemplateId: number;
+};
+
+/**
+ * Describes the message ban.v1.AppealTemplateRenderRequest.
+ * Use `create(AppealTemplateRenderRequestSchema)` to create a new message.
+ */
+export const AppealTemplateRenderRequestSchema: GenMessage<AppealTemplateRenderRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 22);
+
+/**
+ * @generated from message ban.v1.AppealTemplateRenderResponse
+ */
+export type AppealTemplateRenderResponse = Message<"ban.v1.AppealTemplateRenderResponse"> & {
+  /**
+   * @generated from field: string body_md = 1;
+   */
+  bodyMd: string;
+};
+
+/**
+ * Describes the message ban.v1.AppealTemplateRenderResponse.
+ * Use `create(AppealTemplateRenderResponseSchema)` to create a new message.
+ */
+export const AppealTemplateRenderResponseSchema: GenMessage<AppealTemplateRenderResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_appeal, 23);
+
 /**
  * @generated from service ban.v1.AppealService
  */
@@ -365,6 +606,8 @@ export const AppealService: GenService<{
     output: typeof EmptySchema;
   },
   /**
+   * SetAppealState updates the outcome of an appeal. Reduced outcomes also shorten the ban to valid_until.
+   *
    * @generated from rpc ban.v1.AppealService.SetAppealState
    */
   setAppealState: {
@@ -372,6 +615,62 @@ export const AppealService: GenService<{
     input: typeof SetAppealStateRequestSchema;
     output: typeof SetAppealStateResponseSchema;
   },
+  /**
+   * AppealAssign assigns an appeal to a moderator, an assignee_id of 0 unassigns it.
+   *
+   * @generated from rpc ban.v1.AppealService.AppealAssign
+   */
+  appealAssign: {
+    methodKind: "unary";
+    input: typeof AppealAssignRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * AppealDashboard counts the appeals assigned to a moderator, defaulting to the current user.
+   *
+   * @generated from rpc ban.v1.AppealService.AppealDashboard
+   */
+  appealDashboard: {
+    methodKind: "unary";
+    input: typeof AppealDashboardRequestSchema;
+    output: typeof AppealDashboardResponseSchema;
+  },
+  /**
+   * @generated from rpc ban.v1.AppealService.AppealTemplates
+   */
+  appealTemplates: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof AppealTemplatesResponseSchema;
+  },
+  /**
+   * AppealTemplateSave creates a template when appeal_template_id is 0, otherwise updates it.
+   *
+   * @generated from rpc ban.v1.AppealService.AppealTemplateSave
+   */
+  appealTemplateSave: {
+    methodKind: "unary";
+    input: typeof AppealTemplateSaveRequestSchema;
+    output: typeof AppealTemplateSaveResponseSchema;
+  },
+  /**
+   * @generated from rpc ban.v1.AppealService.AppealTemplateDelete
+   */
+  appealTemplateDelete: {
+    methodKind: "unary";
+    input: typeof AppealTemplateDeleteRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * AppealTemplateRender fills in a template with the details of a ban, ready to be used as a reply.
+   *
+   * @generated from rpc ban.v1.AppealService.AppealTemplateRender
+   */
+  appealTemplateRender: {
+    methodKind: "unary";
+    input: typeof AppealTemplateRenderRequestSchema;
+    output: typeof AppealTemplateRenderResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_ban_v1_appeal, 0);
 
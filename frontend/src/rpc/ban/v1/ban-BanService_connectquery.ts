@@ -40,3 +40,36 @@ export const update = BanService.method.update;
  * @generated from rpc ban.v1.BanService.Create
  */
 export const create = BanService.method.create;
+
+/**
+ * History returns the ordered list of changes made to a ban.
+ *
+ * @generated from rpc ban.v1.BanService.History
+ */
+export const history = BanService.method.history;
+
+/**
+ * Audit returns the automated steps, such as expiry notifications, performed against a ban.
+ *
+ * @generated from rpc ban.v1.BanService.Audit
+ */
+export const audit = BanService.method.audit;
+
+/**
+ * Suggest returns the penalty the escalation ladder suggests for the next ban of a player.
+ *
+ * @generated from rpc ban.v1.BanService.Suggest
+ */
+export const suggest = BanService.method.suggest;
+
+/**
+ * @generated from rpc ban.v1.BanService.Escalations
+ */
+export const escalations = BanService.method.escalations;
+
+/**
+ * EscalationSave replaces the ladder of a reason. An empty ladder disables escalation for the reason.
+ *
+ * @generated from rpc ban.v1.BanService.EscalationSave
+ */
+export const escalationSave = BanService.method.escalationSave;
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file ban/v1/ban.proto.
  */
 export const file_ban_v1_ban: GenFile = /*@__PURE__*/
-  fileDesc("ChBiYW4vdjEvYmFuLnByb3RvEgZiYW4udjEiNAoXR2V0QmFuQnlSZXBvcnRJRFJlcXVlc3QSGQoJcmVwb3J0X2lkGAEgASgFQga6SAPIAQEiPAoYR2V0QmFuQnlSZXBvcnRJRFJlc3BvbnNlEiAKA2JhbhgBIAEoCzILLmJhbi52MS5CYW5CBrpIA8gBASLqAwoNQ3JlYXRlUmVxdWVzdBInCgl0YXJnZXRfaWQYASABKANCFDABukgPyAEBIgoogYCAgJCAgIgBEiQKCXNvdXJjZV9pZBgCIAEoA0IRMAG6SAwiCiiBgICAkICAiAESPAoLdmFsaWRfdW50aWwYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgu6SAjIAQGyAQJAARIuCghiYW5fdHlwZRgEIAEoDjIPLmJhbi52MS5CYW5UeXBlQgu6SAjIAQGCAQIQARIuCgZyZWFzb24YBSABKA4yES5iYW4udjEuQmFuUmVhc29uQgu6SAjIAQGCAQIQARITCgtyZWFzb25fdGV4dBgGIAEoCRImCgZvcmlnaW4YByABKA4yDi5iYW4udjEuT3JpZ2luQga6SAPIAQESGgoJcmVwb3J0X2lkGAggASgFQge6SAQaAiAAEhYKBGNpZHIYCSABKAlCCLpIBXID2AEBEhAKCGV2YWRlX29rGAogASgIEhUKBG5hbWUYCyABKAlCB7pIBHICGCASGgoJZGVtb190aWNrGAwgASgFQge6SAQaAigAEhgKB2RlbW9faWQYDSABKAVCB7pIBBoCKAASHAoEbm90ZRgOIAEoCUIOukgLyAEBcgYQChigjQYiKgoOQ3JlYXRlUmVzcG9uc2USGAoDYmFuGAEgASgLMgsuYmFuLnYxLkJhbiLLAgoNVXBkYXRlUmVxdWVzdBIuCghiYW5fdHlwZRgBIAEoDjIPLmJhbi52MS5CYW5UeXBlQgu6SAjIAQGCAQIQARIuCgZyZWFzb24YAiABKA4yES5iYW4udjEuQmFuUmVhc29uQgu6SAjIAQGCAQIQARITCgtyZWFzb25fdGV4dBgDIAEoCRIMCgRub3RlGAQgASgJEhAKCGV2YWRlX29rGAUgASgIEjkKC3ZhbGlkX3VudGlsGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIIukgFsgECQAESFgoEY2lkchgHIAEoCUIIukgFcgPQAQESGgoGYmFuX2lkGAggASgFQgq6SAfIAQEaAiAAEjYKDGFwcGVhbF9zdGF0ZRgJIAEoDjITLmJhbi52MS5BcHBlYWxTdGF0ZUILukgIyAEBggECEAEiMgoOVXBkYXRlUmVzcG9uc2USIAoDYmFuGAEgASgLMgsuYmFuLnYxLkJhbkIGukgDyAEBIkAKFlF1ZXJ5U291cmNlQmFuc1JlcXVlc3QSJgoIc3RlYW1faWQYASABKANCFDABukgPyAEBIgoogYCAgJCAgIgBIsMCCg9Tb3VyY2VCYW5SZWNvcmQSFgoGYmFuX2lkGAEgASgFQga6SAPIAQESGQoJc2l0ZV9uYW1lGAIgASgJQga6SAPIAQESFwoHc2l0ZV9pZBgDIAEoBUIGukgDyAEBEhwKDHBlcnNvbmFfbmFtZRgEIAEoCUIGukgDyAEBEiYKCHN0ZWFtX2lkGAUgASgDQhQwAbpID8gBASIKKIGAgICQgICIARIWCgZyZWFzb24YBiABKAlCBrpIA8gBARIzCghkdXJhdGlvbhgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbkIGukgDyAEBEhkKCXBlcm1hbmVudBgIIAEoCEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQEiSAoXUXVlcnlTb3VyY2VCYW5zUmVzcG9uc2USLQoEYmFucxgBIAMoCzIXLmJhbi52MS5Tb3VyY2VCYW5SZWNvcmRCBrpIA8gBASIoCgpHZXRSZXF1ZXN0EhoKBmJhbl9pZBgBIAEoBUIKukgHyAEBGgIgACIvCgtHZXRSZXNwb25zZRIgCgNiYW4YASABKAsyCy5iYW4udjEuQmFuQga6SAPIAQEiRwoNRGVsZXRlUmVxdWVzdBIaCgZiYW5faWQYASABKAVCCrpIB8gBARoCIAASGgoGcmVhc29uGAIgASgJQgq6SAfIAQFyAhAEIoMCCgxRdWVyeVJlcXVlc3QSJAoJc291cmNlX2lkGAEgASgDQhEwAbpIDCIKKIGAgICQgICIARIkCgl0YXJnZXRfaWQYAiABKANCETABukgMIgoogYCAgJCAgIgBEhMKC2dyb3Vwc19vbmx5GAMgASgIEg8KB2RlbGV0ZWQYBCABKAgSFgoEY2lkchgFIAEoCUIIukgFcgPYAQESEQoJY2lkcl9vbmx5GAYgASgIEiEKBnJlYXNvbhgHIAMoDjIRLmJhbi52MS5CYW5SZWFzb24SMwoMYXBwZWFsX3N0YXRlGAggASgOMhMuYmFuLnYxLkFwcGVhbFN0YXRlQgi6SAWCAQIQASIyCg1RdWVyeVJlc3BvbnNlEiEKBGJhbnMYASADKAsyCy5iYW4udjEuQmFuQga6SAPIAQEisQgKA0JhbhInCgl0YXJnZXRfaWQYASABKANCFDABukgPyAEBIgoogYCAgJCAgIgBEiMKE3RhcmdldF9wZXJzb25hX25hbWUYAiABKAlCBrpIA8gBARIiChJ0YXJnZXRfYXZhdGFyX2hhc2gYAyABKAlCBrpIA8gBARInCglzb3VyY2VfaWQYBCABKANCFDABukgPyAEBIgoogYCAgJCAgIgBEiMKE3NvdXJjZV9wZXJzb25hX25hbWUYBSABKAlCBrpIA8gBARIiChJzb3VyY2VfYXZhdGFyX2hhc2gYBiABKAlCBrpIA8gBARIaCgZiYW5faWQYByABKAVCCrpIB8gBARoCIAASEQoJcmVwb3J0X2lkGAggASgFEg8KB2xhc3RfaXAYCSABKAkSGAoIZXZhZGVfb2sYCiABKAhCBrpIA8gBARIuCghiYW5fdHlwZRgLIAEoDjIPLmJhbi52MS5CYW5UeXBlQgu6SAjIAQGCAQIQARIuCgZyZWFzb24YDCABKA4yES5iYW4udjEuQmFuUmVhc29uQgu6SAjIAQGCAQIQARITCgtyZWFzb25fdGV4dBgNIAEoCRIhChF1bmJhbl9yZWFzb25fdGV4dBgOIAEoCUIGukgDyAEBEhQKBG5vdGUYDyABKAlCBrpIA8gBARIrCgZvcmlnaW4YECABKA4yDi5iYW4udjEuT3JpZ2luQgu6SAjIAQGCAQIQARIWCgRjaWRyGBEgASgJQgi6SAVyA9gBARI2CgxhcHBlYWxfc3RhdGUYEiABKA4yEy5iYW4udjEuQXBwZWFsU3RhdGVCC7pICMgBAYIBAhABEhQKBG5hbWUYEyABKAlCBrpIA8gBARIXCgdkZWxldGVkGBQgASgIQga6SAPIAQESGgoKaXNfZW5hYmxlZBgVIAEoCEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YFiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgXIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARI3Cgt2YWxpZF91bnRpbBgYIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIYCgdkZW1vX2lkGBkgASgFQge6SAQaAigAOrIBukiuARqrAQoUc3RyaW5nLmN1c3RvbV9yZWFzb24SQHJlYXNvbl90ZXh0IG11c3QgYmUgYXQgbGVhc3QgMTAgY2hhcmFjdGVycyB3aGVuIHJlYXNvbiBpcyBDVVNUT00aUXRoaXMucmVhc29uICE9IGJhbi52MS5CYW5SZWFzb24uQkFOX1JFQVNPTl9DVVNUT00gfHwgc2l6ZSh0aGlzLnJlYXNvbl90ZXh0KSA+PSAxMCpnCgdCYW5UeXBlEhsKF0JBTl9UWVBFX09LX1VOU1BFQ0lGSUVEEAASFAoQQkFOX1RZUEVfTk9fQ09NTRABEhMKD0JBTl9UWVBFX0JBTk5FRBACEhQKEEJBTl9UWVBFX05FVFdPUksQAyqaAQoLQXBwZWFsU3RhdGUSIQodQVBQRUFMX1NUQVRFX09QRU5fVU5TUEVDSUZJRUQQABIXChNBUFBFQUxfU1RBVEVfREVOSUVEEAESGQoVQVBQRUFMX1NUQVRFX0FDQ0VQVEVEEAISGAoUQVBQRUFMX1NUQVRFX1JFRFVDRUQQAxIaChZBUFBFQUxfU1RBVEVfTk9fQVBQRUFMEAQqkQMKCUJhblJlYXNvbhIaChZCQU5fUkVBU09OX1VOU1BFQ0lGSUVEEAASFQoRQkFOX1JFQVNPTl9DVVNUT00QARIXChNCQU5fUkVBU09OX0VYVEVSTkFMEAISFwoTQkFOX1JFQVNPTl9DSEVBVElORxADEhUKEUJBTl9SRUFTT05fUkFDSVNNEAQSGQoVQkFOX1JFQVNPTl9IQVJBU1NNRU5UEAUSGQoVQkFOX1JFQVNPTl9FWFBMT0lUSU5HEAYSIAocQkFOX1JFQVNPTl9XQVJOSU5HU19FWENFRURFRBAHEhMKD0JBTl9SRUFTT05fU1BBTRAIEhcKE0JBTl9SRUFTT05fTEFOR1VBR0UQCRIWChJCQU5fUkVBU09OX1BST0ZJTEUQChIgChxCQU5fUkVBU09OX0lURU1fREVTQ1JJUFRJT05TEAsSFwoTQkFOX1JFQVNPTl9CT1RfSE9TVBAMEhYKEkJBTl9SRUFTT05fRVZBRElORxANEhcKE0JBTl9SRUFTT05fVVNFUk5BTUUQDipwCgZPcmlnaW4SHQoZT1JJR0lOX1NZU1RFTV9VTlNQRUNJRklFRBAAEg4KCk9SSUdJTl9CT1QQARIOCgpPUklHSU5fV0VCEAISEgoOT1JJR0lOX0lOX0dBTUUQAxITCg9PUklHSU5fUkVQT1JURUQQBDLWAwoKQmFuU2VydmljZRI2CgVRdWVyeRIULmJhbi52MS5RdWVyeVJlcXVlc3QaFS5iYW4udjEuUXVlcnlSZXNwb25zZSIAEjkKBkRlbGV0ZRIVLmJhbi52MS5EZWxldGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASMAoDR2V0EhIuYmFuLnYxLkdldFJlcXVlc3QaEy5iYW4udjEuR2V0UmVzcG9uc2UiABJXChBHZXRCYW5CeVJlcG9ydElEEh8uYmFuLnYxLkdldEJhbkJ5UmVwb3J0SURSZXF1ZXN0GiAuYmFuLnYxLkdldEJhbkJ5UmVwb3J0SURSZXNwb25zZSIAElQKD1F1ZXJ5U291cmNlQmFucxIeLmJhbi52MS5RdWVyeVNvdXJjZUJhbnNSZXF1ZXN0Gh8uYmFuLnYxLlF1ZXJ5U291cmNlQmFuc1Jlc3BvbnNlIgASOQoGVXBkYXRlEhUuYmFuLnYxLlVwZGF0ZVJlcXVlc3QaFi5iYW4udjEuVXBkYXRlUmVzcG9uc2UiABI5CgZDcmVhdGUSFS5iYW4udjEuQ3JlYXRlUmVxdWVzdBoWLmJhbi52MS5DcmVhdGVSZXNwb25zZSIAQoYBCgpjb20uYmFuLnYxQghCYW5Qcm90b1ABWjVnaXRodWIuY29tL2xlaWdobWFjZG9uYWxkL2diYW5zL2ludGVybmFsL2Jhbi92MTtiYW52MaICA0JYWKoCBkJhbi5WMcoCBkJhblxWMeICEkJhblxWMVxHUEJNZXRhZGF0YeoCB0Jhbjo6VjFiCGVkaXRpb25zcOgH", [file_buf_validate_validate, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+  fileDesc("ChBiYW4vdjEvYmFuLnByb3RvEgZiYW4udjEiNAoXR2V0QmFuQnlSZXBvcnRJRFJlcXVlc3QSGQoJcmVwb3J0X2lkGAEgASgFQga6SAPIAQEiPAoYR2V0QmFuQnlSZXBvcnRJRFJlc3BvbnNlEiAKA2JhbhgBIAEoCzILLmJhbi52MS5CYW5CBrpIA8gBASLnAwoNQ3JlYXRlUmVxdWVzdBInCgl0YXJnZXRfaWQYASABKANCFDABukgPyAEBIgoogYCAgJCAgIgBEiQKCXNvdXJjZV9pZBgCIAEoA0IRMAG6SAwiCiiBgICAkICAiAESOQoLdmFsaWRfdW50aWwYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgi6SAWyAQJAARIuCghiYW5fdHlwZRgEIAEoDjIPLmJhbi52MS5CYW5UeXBlQgu6SAjIAQGCAQIQARIuCgZyZWFzb24YBSABKA4yES5iYW4udjEuQmFuUmVhc29uQgu6SAjIAQGCAQIQARITCgtyZWFzb25fdGV4dBgGIAEoCRImCgZvcmlnaW4YByABKA4yDi5iYW4udjEuT3JpZ2luQga6SAPIAQESGgoJcmVwb3J0X2lkGAggASgFQge6SAQaAiAAEhYKBGNpZHIYCSABKAlCCLpIBXID2AEBEhAKCGV2YWRlX29rGAogASgIEhUKBG5hbWUYCyABKAlCB7pIBHICGCASGgoJZGVtb190aWNrGAwgASgFQge6SAQaAigAEhgKB2RlbW9faWQYDSABKAVCB7pIBBoCKAASHAoEbm90ZRgOIAEoCUIOukgLyAEBcgYQChigjQYiKgoOQ3JlYXRlUmVzcG9uc2USGAoDYmFuGAEgASgLMgsuYmFuLnYxLkJhbiLLAgoNVXBkYXRlUmVxdWVzdBIuCghiYW5fdHlwZRgBIAEoDjIPLmJhbi52MS5CYW5UeXBlQgu6SAjIAQGCAQIQARIuCgZyZWFzb24YAiABKA4yES5iYW4udjEuQmFuUmVhc29uQgu6SAjIAQGCAQIQARITCgtyZWFzb25fdGV4dBgDIAEoCRIMCgRub3RlGAQgASgJEhAKCGV2YWRlX29rGAUgASgIEjkKC3ZhbGlkX3VudGlsGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIIukgFsgECQAESFgoEY2lkchgHIAEoCUIIukgFcgPQAQESGgoGYmFuX2lkGAggASgFQgq6SAfIAQEaAiAAEjYKDGFwcGVhbF9zdGF0ZRgJIAEoDjITLmJhbi52MS5BcHBlYWxTdGF0ZUILukgIyAEBggECEAEiMgoOVXBkYXRlUmVzcG9uc2USIAoDYmFuGAEgASgLMgsuYmFuLnYxLkJhbkIGukgDyAEBIkAKFlF1ZXJ5U291cmNlQmFuc1JlcXVlc3QSJgoIc3RlYW1faWQYASABKANCFDABukgPyAEBIgoogYCAgJCAgIgBIsMCCg9Tb3VyY2VCYW5SZWNvcmQSFgoGYmFuX2lkGAEgASgFQga6SAPIAQESGQoJc2l0ZV9uYW1lGAIgASgJQga6SAPIAQESFwoHc2l0ZV9pZBgDIAEoBUIGukgDyAEBEhwKDHBlcnNvbmFfbmFtZRgEIAEoCUIGukgDyAEBEiYKCHN0ZWFtX2lkGAUgASgDQhQwAbpID8gBASIKKIGAgICQgICIARIWCgZyZWFzb24YBiABKAlCBrpIA8gBARIzCghkdXJhdGlvbhgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbkIGukgDyAEBEhkKCXBlcm1hbmVudBgIIAEoCEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQEiSAoXUXVlcnlTb3VyY2VCYW5zUmVzcG9uc2USLQoEYmFucxgBIAMoCzIXLmJhbi52MS5Tb3VyY2VCYW5SZWNvcmRCBrpIA8gBASIoCgpHZXRSZXF1ZXN0EhoKBmJhbl9pZBgBIAEoBUIKukgHyAEBGgIgACIvCgtHZXRSZXNwb25zZRIgCgNiYW4YASABKAsyCy5iYW4udjEuQmFuQga6SAPIAQEiRwoNRGVsZXRlUmVxdWVzdBIaCgZiYW5faWQYASABKAVCCrpIB8gBARoCIAASGgoGcmVhc29uGAIgASgJQgq6SAfIAQFyAhAEIsMBCg5Fc2NhbGF0aW9uU3RlcBIrCgZyZWFzb24YASABKA4yES5iYW4udjEuQmFuUmVhc29uQgi6SAWCAQIQARIbCgdvZmZlbnNlGAIgASgFQgq6SAfIAQEaAiAAEjAKCGJhbl90eXBlGAMgASgOMg8uYmFuLnYxLkJhblR5cGVCDbpICsgBAYIBBBgBGAISNQoIZHVyYXRpb24YBCABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb25CCLpIBaoBAjIAIjwKE0VzY2FsYXRpb25zUmVzcG9uc2USJQoFc3RlcHMYASADKAsyFi5iYW4udjEuRXNjYWxhdGlvblN0ZXAibgoVRXNjYWxhdGlvblNhdmVSZXF1ZXN0Ei4KBnJlYXNvbhgBIAEoDjIRLmJhbi52MS5CYW5SZWFzb25CC7pICMgBAYIBAhABEiUKBXN0ZXBzGAIgAygLMhYuYmFuLnYxLkVzY2FsYXRpb25TdGVwIj8KFkVzY2FsYXRpb25TYXZlUmVzcG9uc2USJQoFc3RlcHMYASADKAsyFi5iYW4udjEuRXNjYWxhdGlvblN0ZXAiaQoOU3VnZ2VzdFJlcXVlc3QSJwoJdGFyZ2V0X2lkGAEgASgDQhQwAbpID8gBASIKKIGAgICQgICIARIuCgZyZWFzb24YAiABKA4yES5iYW4udjEuQmFuUmVhc29uQgu6SAjIAQGCAQIQASKeAQoPU3VnZ2VzdFJlc3BvbnNlEhIKCnByaW9yX2JhbnMYASABKAUSDwoHb2ZmZW5zZRgCIAEoBRIPCgdtYXRjaGVkGAMgASgIEiQKBHN0ZXAYBCABKAsyFi5iYW4udjEuRXNjYWxhdGlvblN0ZXASLwoLdmFsaWRfdW50aWwYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIiwKDkhpc3RvcnlSZXF1ZXN0EhoKBmJhbl9pZBgBIAEoBUIKukgHyAEBGgIgACI2Cg9IaXN0b3J5UmVzcG9uc2USIwoHaGlzdG9yeRgBIAMoCzISLmJhbi52MS5CYW5IaXN0b3J5IkgKCUJhbkNoYW5nZRIVCgVmaWVsZBgBIAEoCUIGukgDyAEBEhEKCW9sZF92YWx1ZRgCIAEoCRIRCgluZXdfdmFsdWUYAyABKAki0gEKCkJhbkhpc3RvcnkSHAoKaGlzdG9yeV9pZBgBIAEoA0IIMAG6SAPIAQESFgoGYmFuX2lkGAIgASgFQga6SAPIAQESFQoJYXV0aG9yX2lkGAMgASgDQgIwARIbChNhdXRob3JfcGVyc29uYV9uYW1lGAQgASgJEiIKB2NoYW5nZXMYBSADKAsyES5iYW4udjEuQmFuQ2hhbmdlEjYKCmNyZWF0ZWRfb24YBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQEiKgoMQXVkaXRSZXF1ZXN0EhoKBmJhbl9pZBgBIAEoBUIKukgHyAEBGgIgACIwCg1BdWRpdFJlc3BvbnNlEh8KBWF1ZGl0GAEgAygLMhAuYmFuLnYxLkJhbkF1ZGl0IswBCghCYW5BdWRpdBIaCghhdWRpdF9pZBgBIAEoA0IIMAG6SAPIAQESFgoGYmFuX2lkGAIgASgFQga6SAPIAQESFgoGYWN0aW9uGAMgASgJQga6SAPIAQESKwoGc3RhdHVzGAQgASgOMhMuYmFuLnYxLkF1ZGl0U3RhdHVzQga6SAPIAQESDwoHbWVzc2FnZRgFIAEoCRI2CgpjcmVhdGVkX29uGAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBIoMCCgxRdWVyeVJlcXVlc3QSJAoJc291cmNlX2lkGAEgASgDQhEwAbpIDCIKKIGAgICQgICIARIkCgl0YXJnZXRfaWQYAiABKANCETABukgMIgoogYCAgJCAgIgBEhMKC2dyb3Vwc19vbmx5GAMgASgIEg8KB2RlbGV0ZWQYBCABKAgSFgoEY2lkchgFIAEoCUIIukgFcgPYAQESEQoJY2lkcl9vbmx5GAYgASgIEiEKBnJlYXNvbhgHIAMoDjIRLmJhbi52MS5CYW5SZWFzb24SMwoMYXBwZWFsX3N0YXRlGAggASgOMhMuYmFuLnYxLkFwcGVhbFN0YXRlQgi6SAWCAQIQASIyCg1RdWVyeVJlc3BvbnNlEiEKBGJhbnMYASADKAsyCy5iYW4udjEuQmFuQga6SAPIAQEisQgKA0JhbhInCgl0YXJnZXRfaWQYASABKANCFDABukgPyAEBIgoogYCAgJCAgIgBEiMKE3RhcmdldF9wZXJzb25hX25hbWUYAiABKAlCBrpIA8gBARIiChJ0YXJnZXRfYXZhdGFyX2hhc2gYAyABKAlCBrpIA8gBARInCglzb3VyY2VfaWQYBCABKANCFDABukgPyAEBIgoogYCAgJCAgIgBEiMKE3NvdXJjZV9wZXJzb25hX25hbWUYBSABKAlCBrpIA8gBARIiChJzb3VyY2VfYXZhdGFyX2hhc2gYBiABKAlCBrpIA8gBARIaCgZiYW5faWQYByABKAVCCrpIB8gBARoCIAASEQoJcmVwb3J0X2lkGAggASgFEg8KB2xhc3RfaXAYCSABKAkSGAoIZXZhZGVfb2sYCiABKAhCBrpIA8gBARIuCghiYW5fdHlwZRgLIAEoDjIPLmJhbi52MS5CYW5UeXBlQgu6SAjIAQGCAQIQARIuCgZyZWFzb24YDCABKA4yES5iYW4udjEuQmFuUmVhc29uQgu6SAjIAQGCAQIQARITCgtyZWFzb25fdGV4dBgNIAEoCRIhChF1bmJhbl9yZWFzb25fdGV4dBgOIAEoCUIGukgDyAEBEhQKBG5vdGUYDyABKAlCBrpIA8gBARIrCgZvcmlnaW4YECABKA4yDi5iYW4udjEuT3JpZ2luQgu6SAjIAQGCAQIQARIWCgRjaWRyGBEgASgJQgi6SAVyA9gBARI2CgxhcHBlYWxfc3RhdGUYEiABKA4yEy5iYW4udjEuQXBwZWFsU3RhdGVCC7pICMgBAYIBAhABEhQKBG5hbWUYEyABKAlCBrpIA8gBARIXCgdkZWxldGVkGBQgASgIQga6SAPIAQESGgoKaXNfZW5hYmxlZBgVIAEoCEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YFiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgXIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARI3Cgt2YWxpZF91bnRpbBgYIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIYCgdkZW1vX2lkGBkgASgFQge6SAQaAigAOrIBukiuARqrAQoUc3RyaW5nLmN1c3RvbV9yZWFzb24SQHJlYXNvbl90ZXh0IG11c3QgYmUgYXQgbGVhc3QgMTAgY2hhcmFjdGVycyB3aGVuIHJlYXNvbiBpcyBDVVNUT00aUXRoaXMucmVhc29uICE9IGJhbi52MS5CYW5SZWFzb24uQkFOX1JFQVNPTl9DVVNUT00gfHwgc2l6ZSh0aGlzLnJlYXNvbl90ZXh0KSA+PSAxMCpnCgdCYW5UeXBlEhsKF0JBTl9UWVBFX09LX1VOU1BFQ0lGSUVEEAASFAoQQkFOX1RZUEVfTk9fQ09NTRABEhMKD0JBTl9UWVBFX0JBTk5FRBACEhQKEEJBTl9UWVBFX05FVFdPUksQAyqaAQoLQXBwZWFsU3RhdGUSIQodQVBQRUFMX1NUQVRFX09QRU5fVU5TUEVDSUZJRUQQABIXChNBUFBFQUxfU1RBVEVfREVOSUVEEAESGQoVQVBQRUFMX1NUQVRFX0FDQ0VQVEVEEAISGAoUQVBQRUFMX1NUQVRFX1JFRFVDRUQQAxIaChZBUFBFQUxfU1RBVEVfTk9fQVBQRUFMEAQqkQMKCUJhblJlYXNvbhIaChZCQU5fUkVBU09OX1VOU1BFQ0lGSUVEEAASFQoRQkFOX1JFQVNPTl9DVVNUT00QARIXChNCQU5fUkVBU09OX0VYVEVSTkFMEAISFwoTQkFOX1JFQVNPTl9DSEVBVElORxADEhUKEUJBTl9SRUFTT05fUkFDSVNNEAQSGQoVQkFOX1JFQVNPTl9IQVJBU1NNRU5UEAUSGQoVQkFOX1JFQVNPTl9FWFBMT0lUSU5HEAYSIAocQkFOX1JFQVNPTl9XQVJOSU5HU19FWENFRURFRBAHEhMKD0JBTl9SRUFTT05fU1BBTRAIEhcKE0JBTl9SRUFTT05fTEFOR1VBR0UQCRIWChJCQU5fUkVBU09OX1BST0ZJTEUQChIgChxCQU5fUkVBU09OX0lURU1fREVTQ1JJUFRJT05TEAsSFwoTQkFOX1JFQVNPTl9CT1RfSE9TVBAMEhYKEkJBTl9SRUFTT05fRVZBRElORxANEhcKE0JBTl9SRUFTT05fVVNFUk5BTUUQDip3CgtBdWRpdFN0YXR1cxIcChhBVURJVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIYChRBVURJVF9TVEFUVVNfU1VDQ0VTUxABEhcKE0FVRElUX1NUQVRVU19GQUlMRUQQAhIXChNBVURJVF9TVEFUVVNfUVVFVUVEEAMqcAoGT3JpZ2luEh0KGU9SSUdJTl9TWVNURU1fVU5TUEVDSUZJRUQQABIOCgpPUklHSU5fQk9UEAESDgoKT1JJR0lOX1dFQhACEhIKDk9SSUdJTl9JTl9HQU1FEAMSEwoPT1JJR0lOX1JFUE9SVEVEEAQyowYKCkJhblNlcnZpY2USNgoFUXVlcnkSFC5iYW4udjEuUXVlcnlSZXF1ZXN0GhUuYmFuLnYxLlF1ZXJ5UmVzcG9uc2UiABI5CgZEZWxldGUSFS5iYW4udjEuRGVsZXRlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEjAKA0dldBISLmJhbi52MS5HZXRSZXF1ZXN0GhMuYmFuLnYxLkdldFJlc3BvbnNlIgASVwoQR2V0QmFuQnlSZXBvcnRJRBIfLmJhbi52MS5HZXRCYW5CeVJlcG9ydElEUmVxdWVzdBogLmJhbi52MS5HZXRCYW5CeVJlcG9ydElEUmVzcG9uc2UiABJUCg9RdWVyeVNvdXJjZUJhbnMSHi5iYW4udjEuUXVlcnlTb3VyY2VCYW5zUmVxdWVzdBofLmJhbi52MS5RdWVyeVNvdXJjZUJhbnNSZXNwb25zZSIAEjkKBlVwZGF0ZRIVLmJhbi52MS5VcGRhdGVSZXF1ZXN0GhYuYmFuLnYxLlVwZGF0ZVJlc3BvbnNlIgASOQoGQ3JlYXRlEhUuYmFuLnYxLkNyZWF0ZVJlcXVlc3QaFi5iYW4udjEuQ3JlYXRlUmVzcG9uc2UiABI8CgdIaXN0b3J5EhYuYmFuLnYxLkhpc3RvcnlSZXF1ZXN0GhcuYmFuLnYxLkhpc3RvcnlSZXNwb25zZSIAEjYKBUF1ZGl0EhQuYmFuLnYxLkF1ZGl0UmVxdWVzdBoVLmJhbi52MS5BdWRpdFJlc3BvbnNlIgASPAoHU3VnZ2VzdBIWLmJhbi52MS5TdWdnZXN0UmVxdWVzdBoXLmJhbi52MS5TdWdnZXN0UmVzcG9uc2UiABJECgtFc2NhbGF0aW9ucxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRobLmJhbi52MS5Fc2NhbGF0aW9uc1Jlc3BvbnNlIgASUQoORXNjYWxhdGlvblNhdmUSHS5iYW4udjEuRXNjYWxhdGlvblNhdmVSZXF1ZXN0Gh4uYmFuLnYxLkVzY2FsYXRpb25TYXZlUmVzcG9uc2UiAEKGAQoKY29tLmJhbi52MUIIQmFuUHJvdG9QAVo1Z2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9iYW4vdjE7YmFudjGiAgNCWFiqAgZCYW4uVjHKAgZCYW5cVjHiAhJCYW5cVjFcR1BCTWV0YWRhdGHqAgdCYW46OlYxYghlZGl0aW9uc3DoBw", [file_buf_validate_validate, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message ban.v1.GetBanByReportIDRequest
@@ -68,6 +68,9 @@ export type CreateRequest = Message<"ban.v1.CreateRequest"> & {
   sourceId: string;
 
   /**
+   * When valid_until is left unset, the escalation ladder of the reason decides both the duration and
+   * ban_type. The request fails when no escalation step applies.
+   *
    * @generated from field: google.protobuf.Timestamp valid_until = 3;
    */
   validUntil?: Timestamp | undefined;
@@ -373,6 +376,342 @@ export type DeleteRequest = Message<"ban.v1.DeleteRequest"> & {
 export const DeleteRequestSchema: GenMessage<DeleteRequest> = /*@__PURE__*/
   messageDesc(file_ban_v1_ban, 11);
 
+/**
+ * @generated from message ban.v1.EscalationStep
+ */
+export type EscalationStep = Message<"ban.v1.EscalationStep"> & {
+  /**
+   * @generated from field: ban.v1.BanReason reason = 1;
+   */
+  reason: BanReason;
+
+  /**
+   * offense is the 1-based offense number the step applies to.
+   *
+   * @generated from field: int32 offense = 2;
+   */
+  offense: number;
+
+  /**
+   * @generated from field: ban.v1.BanType ban_type = 3;
+   */
+  banType: BanType;
+
+  /**
+   * duration of the ban, unset or zero is permanent.
+   *
+   * @generated from field: google.protobuf.Duration duration = 4;
+   */
+  duration?: Duration | undefined;
+};
+
+/**
+ * Describes the message ban.v1.EscalationStep.
+ * Use `create(EscalationStepSchema)` to create a new message.
+ */
+export const EscalationStepSchema: GenMessage<EscalationStep> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 12);
+
+/**
+ * @generated from message ban.v1.EscalationsResponse
+ */
+export type EscalationsResponse = Message<"ban.v1.EscalationsResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.EscalationStep steps = 1;
+   */
+  steps: EscalationStep[];
+};
+
+/**
+ * Describes the message ban.v1.EscalationsResponse.
+ * Use `create(EscalationsResponseSchema)` to create a new message.
+ */
+export const EscalationsResponseSchema: GenMessage<EscalationsResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 13);
+
+/**
+ * @generated from message ban.v1.EscalationSaveRequest
+ */
+export type EscalationSaveRequest = Message<"ban.v1.EscalationSaveRequest"> & {
+  /**
+   * @generated from field: ban.v1.BanReason reason = 1;
+   */
+  reason: BanReason;
+
+  /**
+   * @generated from field: repeated ban.v1.EscalationStep steps = 2;
+   */
+  steps: EscalationStep[];
+};
+
+/**
+ * Describes the message ban.v1.EscalationSaveRequest.
+ * Use `create(EscalationSaveRequestSchema)` to create a new message.
+ */
+export const EscalationSaveRequestSchema: GenMessage<EscalationSaveRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 14);
+
+/**
+ * @generated from message ban.v1.EscalationSaveResponse
+ */
+export type EscalationSaveResponse = Message<"ban.v1.EscalationSaveResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.EscalationStep steps = 1;
+   */
+  steps: EscalationStep[];
+};
+
+/**
+ * Describes the message ban.v1.EscalationSaveResponse.
+ * Use `create(EscalationSaveResponseSchema)` to create a new message.
+ */
+export const EscalationSaveResponseSchema: GenMessage<EscalationSaveResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 15);
+
+/**
+ * @generated from message ban.v1.SuggestRequest
+ */
+export type SuggestRequest = Message<"ban.v1.SuggestRequest"> & {
+  /**
+   * @generated from field: int64 target_id = 1 [jstype = JS_STRING];
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: ban.v1.BanReason reason = 2;
+   */
+  reason: BanReason;
+};
+
+/**
+ * Describes the message ban.v1.SuggestRequest.
+ * Use `create(SuggestRequestSchema)` to create a new message.
+ */
+export const SuggestRequestSchema: GenMessage<SuggestRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 16);
+
+/**
+ * @generated from message ban.v1.SuggestResponse
+ */
+export type SuggestResponse = Message<"ban.v1.SuggestResponse"> & {
+  /**
+   * prior_bans includes expired and deleted bans for the same reason.
+   *
+   * @generated from field: int32 prior_bans = 1;
+   */
+  priorBans: number;
+
+  /**
+   * @generated from field: int32 offense = 2;
+   */
+  offense: number;
+
+  /**
+   * matched is false when no escalation step applies, in which case the moderator must pick the duration.
+   *
+   * @generated from field: bool matched = 3;
+   */
+  matched: boolean;
+
+  /**
+   * @generated from field: ban.v1.EscalationStep step = 4;
+   */
+  step?: EscalationStep | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp valid_until = 5;
+   */
+  validUntil?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message ban.v1.SuggestResponse.
+ * Use `create(SuggestResponseSchema)` to create a new message.
+ */
+export const SuggestResponseSchema: GenMessage<SuggestResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 17);
+
+/**
+ * @generated from message ban.v1.HistoryRequest
+ */
+export type HistoryRequest = Message<"ban.v1.HistoryRequest"> & {
+  /**
+   * @generated from field: int32 ban_id = 1;
+   */
+  banId: number;
+};
+
+/**
+ * Describes the message ban.v1.HistoryRequest.
+ * Use `create(HistoryRequestSchema)` to create a new message.
+ */
+export const HistoryRequestSchema: GenMessage<HistoryRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 18);
+
+/**
+ * @generated from message ban.v1.HistoryResponse
+ */
+export type HistoryResponse = Message<"ban.v1.HistoryResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.BanHistory history = 1;
+   */
+  history: BanHistory[];
+};
+
+/**
+ * Describes the message ban.v1.HistoryResponse.
+ * Use `create(HistoryResponseSchema)` to create a new message.
+ */
+export const HistoryResponseSchema: GenMessage<HistoryResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 19);
+
+/**
+ * @generated from message ban.v1.BanChange
+ */
+export type BanChange = Message<"ban.v1.BanChange"> & {
+  /**
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * @generated from field: string old_value = 2;
+   */
+  oldValue: string;
+
+  /**
+   * @generated from field: string new_value = 3;
+   */
+  newValue: string;
+};
+
+/**
+ * Describes the message ban.v1.BanChange.
+ * Use `create(BanChangeSchema)` to create a new message.
+ */
+export const BanChangeSchema: GenMessage<BanChange> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 20);
+
+/**
+ * @generated from message ban.v1.BanHistory
+ */
+export type BanHistory = Message<"ban.v1.BanHistory"> & {
+  /**
+   * @generated from field: int64 history_id = 1 [jstype = JS_STRING];
+   */
+  historyId: string;
+
+  /**
+   * @generated from field: int32 ban_id = 2;
+   */
+  banId: number;
+
+  /**
+   * author_id is unset for changes made by the system.
+   *
+   * @generated from field: int64 author_id = 3 [jstype = JS_STRING];
+   */
+  authorId: string;
+
+  /**
+   * @generated from field: string author_persona_name = 4;
+   */
+  authorPersonaName: string;
+
+  /**
+   * @generated from field: repeated ban.v1.BanChange changes = 5;
+   */
+  changes: BanChange[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 6;
+   */
+  createdOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message ban.v1.BanHistory.
+ * Use `create(BanHistorySchema)` to create a new message.
+ */
+export const BanHistorySchema: GenMessage<BanHistory> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 21);
+
+/**
+ * @generated from message ban.v1.AuditRequest
+ */
+export type AuditRequest = Message<"ban.v1.AuditRequest"> & {
+  /**
+   * @generated from field: int32 ban_id = 1;
+   */
+  banId: number;
+};
+
+/**
+ * Describes the message ban.v1.AuditRequest.
+ * Use `create(AuditRequestSchema)` to create a new message.
+ */
+export const AuditRequestSchema: GenMessage<AuditRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 22);
+
+/**
+ * @generated from message ban.v1.AuditResponse
+ */
+export type AuditResponse = Message<"ban.v1.AuditResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.BanAudit audit = 1;
+   */
+  audit: BanAudit[];
+};
+
+/**
+ * Describes the message ban.v1.AuditResponse.
+ * Use `create(AuditResponseSchema)` to create a new message.
+ */
+export const AuditResponseSchema: GenMessage<AuditResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 23);
+
+/**
+ * @generated from message ban.v1.BanAudit
+ */
+export type BanAudit = Message<"ban.v1.BanAudit"> & {
+  /**
+   * @generated from field: int64 audit_id = 1 [jstype = JS_STRING];
+   */
+  auditId: string;
+
+  /**
+   * @generated from field: int32 ban_id = 2;
+   */
+  banId: number;
+
+  /**
+   * @generated from field: string action = 3;
+   */
+  action: string;
+
+  /**
+   * @generated from field: ban.v1.AuditStatus status = 4;
+   */
+  status: AuditStatus;
+
+  /**
+   * @generated from field: string message = 5;
+   */
+  message: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 6;
+   */
+  createdOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message ban.v1.BanAudit.
+ * Use `create(BanAuditSchema)` to create a new message.
+ */
+export const BanAuditSchema: GenMessage<BanAudit> = /*@__PURE__*/
+  messageDesc(file_ban_v1_ban, 24);
+
 /**
  * @generated from message ban.v1.QueryRequest
  */
@@ -423,7 +762,7 @@ export type QueryRequest = Message<"ban.v1.QueryRequest"> & {
  * Use `create(QueryRequestSchema)` to create a new message.
  */
 export const QueryRequestSchema: GenMessage<QueryRequest> = /*@__PURE__*/
-  messageDesc(file_ban_v1_ban, 12);
+  messageDesc(file_ban_v1_ban, 25);
 
 /**
  * @generated from message ban.v1.QueryResponse
@@ -440,7 +779,7 @@ export type QueryResponse = Message<"ban.v1.QueryResponse"> & {
  * Use `create(QueryResponseSchema)` to create a new message.
  */
 export const QueryResponseSchema: GenMessage<QueryResponse> = /*@__PURE__*/
-  messageDesc(file_ban_v1_ban, 13);
+  messageDesc(file_ban_v1_ban, 26);
 
 /**
  * @generated from message ban.v1.Ban
@@ -577,7 +916,7 @@ export type Ban = Message<"ban.v1.Ban"> & {
  * Use `create(BanSchema)` to create a new message.
  */
 export const BanSchema: GenMessage<Ban> = /*@__PURE__*/
-  messageDesc(file_ban_v1_ban, 14);
+  messageDesc(file_ban_v1_ban, 27);
 
 /**
  * @generated from enum ban.v1.BanType
@@ -732,6 +1071,39 @@ export enum BanReason {
 export const BanReasonSchema: GenEnum<BanReason> = /*@__PURE__*/
   enumDesc(file_ban_v1_ban, 2);
 
+/**
+ * @generated from enum ban.v1.AuditStatus
+ */
+export enum AuditStatus {
+  /**
+   * @generated from enum value: AUDIT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: AUDIT_STATUS_SUCCESS = 1;
+   */
+  SUCCESS = 1,
+
+  /**
+   * @generated from enum value: AUDIT_STATUS_FAILED = 2;
+   */
+  FAILED = 2,
+
+  /**
+   * The step was handed off, eg. a notification, and its delivery is not tracked.
+   *
+   * @generated from enum value: AUDIT_STATUS_QUEUED = 3;
+   */
+  QUEUED = 3,
+}
+
+/**
+ * Describes the enum ban.v1.AuditStatus.
+ */
+export const AuditStatusSchema: GenEnum<AuditStatus> = /*@__PURE__*/
+  enumDesc(file_ban_v1_ban, 3);
+
 /**
  * @generated from enum ban.v1.Origin
  */
@@ -766,7 +1138,7 @@ export enum Origin {
  * Describes the enum ban.v1.Origin.
  */
 export const OriginSchema: GenEnum<Origin> = /*@__PURE__*/
-  enumDesc(file_ban_v1_ban, 3);
+  enumDesc(file_ban_v1_ban, 4);
 
 /**
  * @generated from service ban.v1.BanService
@@ -830,6 +1202,54 @@ export const BanService: GenService<{
     input: typeof CreateRequestSchema;
     output: typeof CreateResponseSchema;
   },
+  /**
+   * History returns the ordered list of changes made to a ban.
+   *
+   * @generated from rpc ban.v1.BanService.History
+   */
+  history: {
+    methodKind: "unary";
+    input: typeof HistoryRequestSchema;
+    output: typeof HistoryResponseSchema;
+  },
+  /**
+   * Audit returns the automated steps, such as expiry notifications, performed against a ban.
+   *
+   * @generated from rpc ban.v1.BanService.Audit
+   */
+  audit: {
+    methodKind: "unary";
+    input: typeof AuditRequestSchema;
+    output: typeof AuditResponseSchema;
+  },
+  /**
+   * Suggest returns the penalty the escalation ladder suggests for the next ban of a player.
+   *
+   * @generated from rpc ban.v1.BanService.Suggest
+   */
+  suggest: {
+    methodKind: "unary";
+    input: typeof SuggestRequestSchema;
+    output: typeof SuggestResponseSchema;
+  },
+  /**
+   * @generated from rpc ban.v1.BanService.Escalations
+   */
+  escalations: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof EscalationsResponseSchema;
+  },
+  /**
+   * EscalationSave replaces the ladder of a reason. An empty ladder disables escalation for the reason.
+   *
+   * @generated from rpc ban.v1.BanService.EscalationSave
+   */
+  escalationSave: {
+    methodKind: "unary";
+    input: typeof EscalationSaveRequestSchema;
+    output: typeof EscalationSaveResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_ban_v1_ban, 0);
 
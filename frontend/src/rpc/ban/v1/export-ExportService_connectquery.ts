@@ -13,3 +13,46 @@ export const getTF2BD = ExportService.method.getTF2BD;
  * @generated from rpc ban.v1.ExportService.GetValveSteamID
  */
 export const getValveSteamID = ExportService.method.getValveSteamID;
+
+/**
+ * Feeds lists the configured export feeds.
+ *
+ * @generated from rpc ban.v1.ExportService.Feeds
+ */
+export const feeds = ExportService.method.feeds;
+
+/**
+ * FeedSave creates a new feed, or updates the existing one when a feed_id is set.
+ *
+ * @generated from rpc ban.v1.ExportService.FeedSave
+ */
+export const feedSave = ExportService.method.feedSave;
+
+/**
+ * @generated from rpc ban.v1.ExportService.FeedDelete
+ */
+export const feedDelete = ExportService.method.feedDelete;
+
+/**
+ * @generated from rpc ban.v1.ExportService.Consumers
+ */
+export const consumers = ExportService.method.consumers;
+
+/**
+ * ConsumerCreate issues a new api key for a feed. The key is only returned once.
+ *
+ * @generated from rpc ban.v1.ExportService.ConsumerCreate
+ */
+export const consumerCreate = ExportService.method.consumerCreate;
+
+/**
+ * @generated from rpc ban.v1.ExportService.ConsumerDelete
+ */
+export const consumerDelete = ExportService.method.consumerDelete;
+
+/**
+ * PublicKey returns the PEM encoded ed25519 key used to sign the X-Signature header of feeds.
+ *
+ * @generated from rpc ban.v1.ExportService.PublicKey
+ */
+export const publicKey = ExportService.method.publicKey;
@@ -4,13 +4,18 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { BanReason, BanType } from "./ban_pb";
+import { file_ban_v1_ban } from "./ban_pb";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Duration, EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file ban/v1/export.proto.
  */
 export const file_ban_v1_export: GenFile = /*@__PURE__*/
-  fileDesc("ChNiYW4vdjEvZXhwb3J0LnByb3RvEgZiYW4udjEiLAoXR2V0VmFsdmVTdGVhbUlEUmVzcG9uc2USEQoJYmFuX2xpbmVzGAEgAygJIiUKFkdldFZhbHZlU3RlYW1JRFJlcXVlc3QSCwoDa2V5GAEgASgJIh4KD0dldFRGMkJEUmVxdWVzdBILCgNrZXkYASABKAkifAoQR2V0VEYyQkRSZXNwb25zZRIXCgZzY2hlbWEYASABKAlSByRzY2hlbWESLgoJZmlsZV9pbmZvGAIgASgLMhAuYmFuLnYxLkZpbGVJbmZvUglmaWxlX2luZm8SHwoHcGxheWVycxgDIAMoCzIOLmJhbi52MS5QbGF5ZXIidgoGUGxheWVyEhIKCmF0dHJpYnV0ZXMYASADKAkSLgoJbGFzdF9zZWVuGAIgASgLMhAuYmFuLnYxLkxhc3RTZWVuUglsYXN0X3NlZW4SGQoIc3RlYW1faWQYAyABKAlSB3N0ZWFtaWQSDQoFcHJvb2YYBCADKAkiOgoITGFzdFNlZW4SIAoLcGxheWVyX25hbWUYASABKAlSC3BsYXllcl9uYW1lEgwKBHRpbWUYAiABKAUiXwoIRmlsZUluZm8SDwoHYXV0aG9ycxgBIAMoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRINCgV0aXRsZRgDIAEoCRIeCgp1cGRhdGVfdXJsGAQgASgJUgp1cGRhdGVfdXJsMqYBCg1FeHBvcnRTZXJ2aWNlEj8KCEdldFRGMkJEEhcuYmFuLnYxLkdldFRGMkJEUmVxdWVzdBoYLmJhbi52MS5HZXRURjJCRFJlc3BvbnNlIgASVAoPR2V0VmFsdmVTdGVhbUlEEh4uYmFuLnYxLkdldFZhbHZlU3RlYW1JRFJlcXVlc3QaHy5iYW4udjEuR2V0VmFsdmVTdGVhbUlEUmVzcG9uc2UiAEKJAQoKY29tLmJhbi52MUILRXhwb3J0UHJvdG9QAVo1Z2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9iYW4vdjE7YmFudjGiAgNCWFiqAgZCYW4uVjHKAgZCYW5cVjHiAhJCYW5cVjFcR1BCTWV0YWRhdGHqAgdCYW46OlYxYghlZGl0aW9uc3DoBw");
+  fileDesc("ChNiYW4vdjEvZXhwb3J0LnByb3RvEgZiYW4udjEiLAoXR2V0VmFsdmVTdGVhbUlEUmVzcG9uc2USEQoJYmFuX2xpbmVzGAEgAygJIiUKFkdldFZhbHZlU3RlYW1JRFJlcXVlc3QSCwoDa2V5GAEgASgJIh4KD0dldFRGMkJEUmVxdWVzdBILCgNrZXkYASABKAkifAoQR2V0VEYyQkRSZXNwb25zZRIXCgZzY2hlbWEYASABKAlSByRzY2hlbWESLgoJZmlsZV9pbmZvGAIgASgLMhAuYmFuLnYxLkZpbGVJbmZvUglmaWxlX2luZm8SHwoHcGxheWVycxgDIAMoCzIOLmJhbi52MS5QbGF5ZXIidgoGUGxheWVyEhIKCmF0dHJpYnV0ZXMYASADKAkSLgoJbGFzdF9zZWVuGAIgASgLMhAuYmFuLnYxLkxhc3RTZWVuUglsYXN0X3NlZW4SGQoIc3RlYW1faWQYAyABKAlSB3N0ZWFtaWQSDQoFcHJvb2YYBCADKAkiOgoITGFzdFNlZW4SIAoLcGxheWVyX25hbWUYASABKAlSC3BsYXllcl9uYW1lEgwKBHRpbWUYAiABKAUiXwoIRmlsZUluZm8SDwoHYXV0aG9ycxgBIAMoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRINCgV0aXRsZRgDIAEoCRIeCgp1cGRhdGVfdXJsGAQgASgJUgp1cGRhdGVfdXJsIsQDCgRGZWVkEg8KB2ZlZWRfaWQYASABKAUSJQoEbmFtZRgCIAEoCUIXukgUyAEBcg8yDV5bYS16MC05Xy1dKyQSFQoFdGl0bGUYAyABKAlCBrpIA8gBARITCgtkZXNjcmlwdGlvbhgEIAEoCRIxCgdyZWFzb25zGAUgAygOMhEuYmFuLnYxLkJhblJlYXNvbkINukgKkgEHIgWCAQIQARIzCgliYW5fdHlwZXMYBiADKA4yDy5iYW4udjEuQmFuVHlwZUIPukgMkgEJIgeCAQQYARgCEjkKDG1pbl9kdXJhdGlvbhgHIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbkIIukgFqgECMgASNAoHbWF4X2FnZRgIIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbkIIukgFqgECMgASDgoGcHVibGljGAkgASgIEg8KB2VuYWJsZWQYCiABKAgSLgoKY3JlYXRlZF9vbhgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9vbhgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiPQoNRmVlZHNSZXNwb25zZRIbCgVmZWVkcxgBIAMoCzIMLmJhbi52MS5GZWVkEg8KB2Zvcm1hdHMYAiADKAkiNQoPRmVlZFNhdmVSZXF1ZXN0EiIKBGZlZWQYASABKAsyDC5iYW4udjEuRmVlZEIGukgDyAEBIi4KEEZlZWRTYXZlUmVzcG9uc2USGgoEZmVlZBgBIAEoCzIMLmJhbi52MS5GZWVkIi0KEUZlZWREZWxldGVSZXF1ZXN0EhgKB2ZlZWRfaWQYASABKAVCB7pIBBoCIAAisQEKCENvbnN1bWVyEhMKC2NvbnN1bWVyX2lkGAEgASgFEg8KB2ZlZWRfaWQYAiABKAUSDAoEbmFtZRgDIAEoCRIPCgdlbmFibGVkGAQgASgIEi4KCmNyZWF0ZWRfb24YBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfdXNlZF9vbhgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiLAoQQ29uc3VtZXJzUmVxdWVzdBIYCgdmZWVkX2lkGAEgASgFQge6SAQaAiAAIjgKEUNvbnN1bWVyc1Jlc3BvbnNlEiMKCWNvbnN1bWVycxgBIAMoCzIQLmJhbi52MS5Db25zdW1lciJIChVDb25zdW1lckNyZWF0ZVJlcXVlc3QSGAoHZmVlZF9pZBgBIAEoBUIHukgEGgIgABIVCgRuYW1lGAIgASgJQge6SARyAhABIk0KFkNvbnN1bWVyQ3JlYXRlUmVzcG9uc2USIgoIY29uc3VtZXIYASABKAsyEC5iYW4udjEuQ29uc3VtZXISDwoHYXBpX2tleRgCIAEoCSI1ChVDb25zdW1lckRlbGV0ZVJlcXVlc3QSHAoLY29uc3VtZXJfaWQYASABKAVCB7pIBBoCIAAiJwoRUHVibGljS2V5UmVzcG9uc2USEgoKcHVibGljX2tleRgBIAEoCTKIBQoNRXhwb3J0U2VydmljZRI/CghHZXRURjJCRBIXLmJhbi52MS5HZXRURjJCRFJlcXVlc3QaGC5iYW4udjEuR2V0VEYyQkRSZXNwb25zZSIAElQKD0dldFZhbHZlU3RlYW1JRBIeLmJhbi52MS5HZXRWYWx2ZVN0ZWFtSURSZXF1ZXN0Gh8uYmFuLnYxLkdldFZhbHZlU3RlYW1JRFJlc3BvbnNlIgASOAoFRmVlZHMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFS5iYW4udjEuRmVlZHNSZXNwb25zZSIAEj8KCEZlZWRTYXZlEhcuYmFuLnYxLkZlZWRTYXZlUmVxdWVzdBoYLmJhbi52MS5GZWVkU2F2ZVJlc3BvbnNlIgASQQoKRmVlZERlbGV0ZRIZLmJhbi52MS5GZWVkRGVsZXRlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEkIKCUNvbnN1bWVycxIYLmJhbi52MS5Db25zdW1lcnNSZXF1ZXN0GhkuYmFuLnYxLkNvbnN1bWVyc1Jlc3BvbnNlIgASUQoOQ29uc3VtZXJDcmVhdGUSHS5iYW4udjEuQ29uc3VtZXJDcmVhdGVSZXF1ZXN0Gh4uYmFuLnYxLkNvbnN1bWVyQ3JlYXRlUmVzcG9uc2UiABJJCg5Db25zdW1lckRlbGV0ZRIdLmJhbi52MS5Db25zdW1lckRlbGV0ZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJACglQdWJsaWNLZXkSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGS5iYW4udjEuUHVibGljS2V5UmVzcG9uc2UiAEKJAQoKY29tLmJhbi52MUILRXhwb3J0UHJvdG9QAVo1Z2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9iYW4vdjE7YmFudjGiAgNCWFiqAgZCYW4uVjHKAgZCYW5cVjHiAhJCYW5cVjFcR1BCTWV0YWRhdGHqAgdCYW46OlYxYghlZGl0aW9uc3DoBw", [file_ban_v1_ban, file_buf_validate_validate, file_google_protobuf_duration, file_google_protobuf_empty, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message ban.v1.GetValveSteamIDResponse
@@ -176,6 +181,323 @@ export type FileInfo = Message<"ban.v1.FileInfo"> & {
 export const FileInfoSchema: GenMessage<FileInfo> = /*@__PURE__*/
   messageDesc(file_ban_v1_export, 6);
 
+/**
+ * @generated from message ban.v1.Feed
+ */
+export type Feed = Message<"ban.v1.Feed"> & {
+  /**
+   * @generated from field: int32 feed_id = 1;
+   */
+  feedId: number;
+
+  /**
+   * name is used in the feed url, /export/feeds/{name}/{format}.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * reasons limits the feed to these reasons, empty includes all.
+   *
+   * @generated from field: repeated ban.v1.BanReason reasons = 5;
+   */
+  reasons: BanReason[];
+
+  /**
+   * ban_types limits the feed to these types, empty includes all.
+   *
+   * @generated from field: repeated ban.v1.BanType ban_types = 6;
+   */
+  banTypes: BanType[];
+
+  /**
+   * min_duration excludes bans shorter than the duration. Permanent bans are always included.
+   *
+   * @generated from field: google.protobuf.Duration min_duration = 7;
+   */
+  minDuration?: Duration | undefined;
+
+  /**
+   * max_age excludes bans created before now - max_age. Unset includes bans of any age.
+   *
+   * @generated from field: google.protobuf.Duration max_age = 8;
+   */
+  maxAge?: Duration | undefined;
+
+  /**
+   * public feeds do not require an api key.
+   *
+   * @generated from field: bool public = 9;
+   */
+  public: boolean;
+
+  /**
+   * @generated from field: bool enabled = 10;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 11;
+   */
+  createdOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 12;
+   */
+  updatedOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message ban.v1.Feed.
+ * Use `create(FeedSchema)` to create a new message.
+ */
+export const FeedSchema: GenMessage<Feed> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 7);
+
+/**
+ * @generated from message ban.v1.FeedsResponse
+ */
+export type FeedsResponse = Message<"ban.v1.FeedsResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.Feed feeds = 1;
+   */
+  feeds: Feed[];
+
+  /**
+   * formats lists the format names supported by every feed.
+   *
+   * @generated from field: repeated string formats = 2;
+   */
+  formats: string[];
+};
+
+/**
+ * Describes the message ban.v1.FeedsResponse.
+ * Use `create(FeedsResponseSchema)` to create a new message.
+ */
+export const FeedsResponseSchema: GenMessage<FeedsResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 8);
+
+/**
+ * @generated from message ban.v1.FeedSaveRequest
+ */
+export type FeedSaveRequest = Message<"ban.v1.FeedSaveRequest"> & {
+  /**
+   * @generated from field: ban.v1.Feed feed = 1;
+   */
+  feed?: Feed | undefined;
+};
+
+/**
+ * Describes the message ban.v1.FeedSaveRequest.
+ * Use `create(FeedSaveRequestSchema)` to create a new message.
+ */
+export const FeedSaveRequestSchema: GenMessage<FeedSaveRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 9);
+
+/**
+ * @generated from message ban.v1.FeedSaveResponse
+ */
+export type FeedSaveResponse = Message<"ban.v1.FeedSaveResponse"> & {
+  /**
+   * @generated from field: ban.v1.Feed feed = 1;
+   */
+  feed?: Feed | undefined;
+};
+
+/**
+ * Describes the message ban.v1.FeedSaveResponse.
+ * Use `create(FeedSaveResponseSchema)` to create a new message.
+ */
+export const FeedSaveResponseSchema: GenMessage<FeedSaveResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 10);
+
+/**
+ * @generated from message ban.v1.FeedDeleteRequest
+ */
+export type FeedDeleteRequest = Message<"ban.v1.FeedDeleteRequest"> & {
+  /**
+   * @generated from field: int32 feed_id = 1;
+   */
+  feedId: number;
+};
+
+/**
+ * Describes the message ban.v1.FeedDeleteRequest.
+ * Use `create(FeedDeleteRequestSchema)` to create a new message.
+ */
+export const FeedDeleteRequestSchema: GenMessage<FeedDeleteRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 11);
+
+/**
+ * @generated from message ban.v1.Consumer
+ */
+export type Consumer = Message<"ban.v1.Consumer"> & {
+  /**
+   * @generated from field: int32 consumer_id = 1;
+   */
+  consumerId: number;
+
+  /**
+   * @generated from field: int32 feed_id = 2;
+   */
+  feedId: number;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool enabled = 4;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   */
+  createdOn?: Timestamp | undefined;
+
+  /**
+   * last_used_on is unset when the key has never been used.
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_on = 6;
+   */
+  lastUsedOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message ban.v1.Consumer.
+ * Use `create(ConsumerSchema)` to create a new message.
+ */
+export const ConsumerSchema: GenMessage<Consumer> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 12);
+
+/**
+ * @generated from message ban.v1.ConsumersRequest
+ */
+export type ConsumersRequest = Message<"ban.v1.ConsumersRequest"> & {
+  /**
+   * @generated from field: int32 feed_id = 1;
+   */
+  feedId: number;
+};
+
+/**
+ * Describes the message ban.v1.ConsumersRequest.
+ * Use `create(ConsumersRequestSchema)` to create a new message.
+ */
+export const ConsumersRequestSchema: GenMessage<ConsumersRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 13);
+
+/**
+ * @generated from message ban.v1.ConsumersResponse
+ */
+export type ConsumersResponse = Message<"ban.v1.ConsumersResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.Consumer consumers = 1;
+   */
+  consumers: Consumer[];
+};
+
+/**
+ * Describes the message ban.v1.ConsumersResponse.
+ * Use `create(ConsumersResponseSchema)` to create a new message.
+ */
+export const ConsumersResponseSchema: GenMessage<ConsumersResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 14);
+
+/**
+ * @generated from message ban.v1.ConsumerCreateRequest
+ */
+export type ConsumerCreateRequest = Message<"ban.v1.ConsumerCreateRequest"> & {
+  /**
+   * @generated from field: int32 feed_id = 1;
+   */
+  feedId: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message ban.v1.ConsumerCreateRequest.
+ * Use `create(ConsumerCreateRequestSchema)` to create a new message.
+ */
+export const ConsumerCreateRequestSchema: GenMessage<ConsumerCreateRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 15);
+
+/**
+ * @generated from message ban.v1.ConsumerCreateResponse
+ */
+export type ConsumerCreateResponse = Message<"ban.v1.ConsumerCreateResponse"> & {
+  /**
+   * @generated from field: ban.v1.Consumer consumer = 1;
+   */
+  consumer?: Consumer | undefined;
+
+  /**
+   * api_key is sent by the consumer as the X-API-Key header or key query parameter.
+   *
+   * @generated from field: string api_key = 2;
+   */
+  apiKey: string;
+};
+
+/**
+ * Describes the message ban.v1.ConsumerCreateResponse.
+ * Use `create(ConsumerCreateResponseSchema)` to create a new message.
+ */
+export const ConsumerCreateResponseSchema: GenMessage<ConsumerCreateResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 16);
+
+/**
+ * @generated from message ban.v1.ConsumerDeleteRequest
+ */
+export type ConsumerDeleteRequest = Message<"ban.v1.ConsumerDeleteRequest"> & {
+  /**
+   * @generated from field: int32 consumer_id = 1;
+   */
+  consumerId: number;
+};
+
+/**
+ * Describes the message ban.v1.ConsumerDeleteRequest.
+ * Use `create(ConsumerDeleteRequestSchema)` to create a new message.
+ */
+export const ConsumerDeleteRequestSchema: GenMessage<ConsumerDeleteRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 17);
+
+/**
+ * @generated from message ban.v1.PublicKeyResponse
+ */
+export type PublicKeyResponse = Message<"ban.v1.PublicKeyResponse"> & {
+  /**
+   * @generated from field: string public_key = 1;
+   */
+  publicKey: string;
+};
+
+/**
+ * Describes the message ban.v1.PublicKeyResponse.
+ * Use `create(PublicKeyResponseSchema)` to create a new message.
+ */
+export const PublicKeyResponseSchema: GenMessage<PublicKeyResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_export, 18);
+
 /**
  * @generated from service ban.v1.ExportService
  */
@@ -196,6 +518,70 @@ export const ExportService: GenService<{
     input: typeof GetValveSteamIDRequestSchema;
     output: typeof GetValveSteamIDResponseSchema;
   },
+  /**
+   * Feeds lists the configured export feeds.
+   *
+   * @generated from rpc ban.v1.ExportService.Feeds
+   */
+  feeds: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof FeedsResponseSchema;
+  },
+  /**
+   * FeedSave creates a new feed, or updates the existing one when a feed_id is set.
+   *
+   * @generated from rpc ban.v1.ExportService.FeedSave
+   */
+  feedSave: {
+    methodKind: "unary";
+    input: typeof FeedSaveRequestSchema;
+    output: typeof FeedSaveResponseSchema;
+  },
+  /**
+   * @generated from rpc ban.v1.ExportService.FeedDelete
+   */
+  feedDelete: {
+    methodKind: "unary";
+    input: typeof FeedDeleteRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc ban.v1.ExportService.Consumers
+   */
+  consumers: {
+    methodKind: "unary";
+    input: typeof ConsumersRequestSchema;
+    output: typeof ConsumersResponseSchema;
+  },
+  /**
+   * ConsumerCreate issues a new api key for a feed. The key is only returned once.
+   *
+   * @generated from rpc ban.v1.ExportService.ConsumerCreate
+   */
+  consumerCreate: {
+    methodKind: "unary";
+    input: typeof ConsumerCreateRequestSchema;
+    output: typeof ConsumerCreateResponseSchema;
+  },
+  /**
+   * @generated from rpc ban.v1.ExportService.ConsumerDelete
+   */
+  consumerDelete: {
+    methodKind: "unary";
+    input: typeof ConsumerDeleteRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * PublicKey returns the PEM encoded ed25519 key used to sign the X-Signature header of feeds.
+   *
+   * @generated from rpc ban.v1.ExportService.PublicKey
+   */
+  publicKey: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof PublicKeyResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_ban_v1_export, 0);
 
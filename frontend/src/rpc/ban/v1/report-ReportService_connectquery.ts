@@ -48,3 +48,17 @@ export const reportMessageDelete = ReportService.method.reportMessageDelete;
  * @generated from rpc ban.v1.ReportService.Reports
  */
 export const reports = ReportService.method.reports;
+
+/**
+ * ReportEvidence returns the evidence attached to a report when it was created.
+ *
+ * @generated from rpc ban.v1.ReportService.ReportEvidence
+ */
+export const reportEvidence = ReportService.method.reportEvidence;
+
+/**
+ * ReportTriage returns the open reports clustered by their target, most severe first.
+ *
+ * @generated from rpc ban.v1.ReportService.ReportTriage
+ */
+export const reportTriage = ReportService.method.reportTriage;
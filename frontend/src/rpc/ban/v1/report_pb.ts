@@ -19,7 +19,160 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file ban/v1/report.proto.
  */
 export const file_ban_v1_report: GenFile = /*@__PURE__*/
-  fileDesc("ChNiYW4vdjEvcmVwb3J0LnByb3RvEgZiYW4udjEiRAoPUmVwb3J0c1Jlc3BvbnNlEjEKB3JlcG9ydHMYASADKAsyGC5iYW4udjEuUmVwb3J0V2l0aEF1dGhvckIGukgDyAEBIkMKGlJlcG9ydE1lc3NhZ2VEZWxldGVSZXF1ZXN0EiUKEXJlcG9ydF9tZXNzYWdlX2lkGAEgASgFQgq6SAfIAQEaAiAAImIKGFJlcG9ydE1lc3NhZ2VFZGl0UmVxdWVzdBIlChFyZXBvcnRfbWVzc2FnZV9pZBgBIAEoBUIKukgHyAEBGgIgABIfCgdib2R5X21kGAIgASgJQg66SAvIAQFyBhABGNCGAyJLChlSZXBvcnRNZXNzYWdlRWRpdFJlc3BvbnNlEi4KB21lc3NhZ2UYASABKAsyFS5iYW4udjEuUmVwb3J0TWVzc2FnZUIGukgDyAEBIjYKFVJlcG9ydE1lc3NhZ2VzUmVxdWVzdBIdCglyZXBvcnRfaWQYASABKAVCCrpIB8gBARoCIAAiPAoSVXNlclJlcG9ydHNSZXF1ZXN0EiYKCHN0ZWFtX2lkGAEgASgDQhQwAbpID8gBASIKKIGAgICQgICIASJJChZSZXBvcnRNZXNzYWdlc1Jlc3BvbnNlEi8KCG1lc3NhZ2VzGAEgAygLMhUuYmFuLnYxLlJlcG9ydE1lc3NhZ2VCBrpIA8gBASJIChNVc2VyUmVwb3J0c1Jlc3BvbnNlEjEKB3JlcG9ydHMYASADKAsyGC5iYW4udjEuUmVwb3J0V2l0aEF1dGhvckIGukgDyAEBIi4KDVJlcG9ydFJlcXVlc3QSHQoJcmVwb3J0X2lkGAEgASgFQgq6SAfIAQEaAiAAIkIKDlJlcG9ydFJlc3BvbnNlEjAKBnJlcG9ydBgBIAEoCzIYLmJhbi52MS5SZXBvcnRXaXRoQXV0aG9yQga6SAPIAQEiqQMKDVJlcG9ydE1lc3NhZ2USHQoJcmVwb3J0X2lkGAEgASgFQgq6SAfIAQEaAiAAEiUKEXJlcG9ydF9tZXNzYWdlX2lkGAIgASgFQgq6SAfIAQEaAiAAEicKCWF1dGhvcl9pZBgDIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAESIgoKbWVzc2FnZV9tZBgEIAEoCUIOukgLyAEBcgYQARjQhgMSFwoHZGVsZXRlZBgFIAEoCEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIiCgxwZXJzb25hX25hbWUYCCABKAlCDLpICcgBAXIEEAIYIBIbCgthdmF0YXJfaGFzaBgJIAEoCUIGukgDyAEBEjsKEHBlcm1pc3Npb25fbGV2ZWwYCiABKA4yFC5wZXJzb24udjEuUHJpdmlsZWdlQgu6SAjIAQGCAQIQASLpAwoGUmVwb3J0Eh0KCXJlcG9ydF9pZBgBIAEoBUIKukgHyAEBGgIgABInCglzb3VyY2VfaWQYAiABKANCFDABukgPyAEBIgoogYCAgJCAgIgBEicKCXRhcmdldF9pZBgDIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAESIwoLZGVzY3JpcHRpb24YBCABKAlCDrpIC8gBAXIGEAEY0IYDEjgKDXJlcG9ydF9zdGF0dXMYBSABKA4yFC5iYW4udjEuUmVwb3J0U3RhdHVzQgu6SAjIAQGCAQIQARIuCgZyZWFzb24YBiABKA4yES5iYW4udjEuQmFuUmVhc29uQgu6SAjIAQGCAQIQARITCgtyZWFzb25fdGV4dBgHIAEoCRIXCgdkZWxldGVkGAggASgIQga6SAPIAQESEQoJZGVtb190aWNrGAkgASgFEg8KB2RlbW9faWQYCiABKAUSHQoRcGVyc29uX21lc3NhZ2VfaWQYCyABKANCAjABEjYKCmNyZWF0ZWRfb24YDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASKZAQoQUmVwb3J0V2l0aEF1dGhvchImCgZyZXBvcnQYASABKAsyDi5iYW4udjEuUmVwb3J0Qga6SAPIAQESLQoGYXV0aG9yGAIgASgLMhUucGVyc29uLnYxLlBlcnNvbkNvcmVCBrpIA8gBARIuCgdzdWJqZWN0GAMgASgLMhUucGVyc29uLnYxLlBlcnNvbkNvcmVCBrpIA8gBASJtChdSZXBvcnRTdGF0dXNFZGl0UmVxdWVzdBIdCglyZXBvcnRfaWQYASABKAVCCrpIB8gBARoCIAASMwoNcmVwb3J0X3N0YXR1cxgCIAEoDjIULmJhbi52MS5SZXBvcnRTdGF0dXNCBrpIA8gBASKaAgoTUmVwb3J0Q3JlYXRlUmVxdWVzdBIkCglzb3VyY2VfaWQYASABKANCETABukgMIgoogYCAgJCAgIgBEicKCXRhcmdldF9pZBgCIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAESIwoLZGVzY3JpcHRpb24YAyABKAlCDrpIC8gBAXIGEAEY0IYDEi4KBnJlYXNvbhgEIAEoDjIRLmJhbi52MS5CYW5SZWFzb25CC7pICMgBAYIBAhABEhwKC3JlYXNvbl90ZXh0GAUgASgJQge6SARyAhAKEg8KB2RlbW9faWQYBiABKAUSEQoJZGVtb190aWNrGAcgASgFEh0KEXBlcnNvbl9tZXNzYWdlX2lkGAggASgDQgIwASJIChRSZXBvcnRDcmVhdGVSZXNwb25zZRIwCgZyZXBvcnQYASABKAsyGC5iYW4udjEuUmVwb3J0V2l0aEF1dGhvckIGukgDyAEBIlwKGlJlcG9ydE1lc3NhZ2VDcmVhdGVSZXF1ZXN0Eh0KCXJlcG9ydF9pZBgBIAEoBUIKukgHyAEBGgIgABIfCgdib2R5X21kGAIgASgJQg66SAvIAQFyBhABGNCGAyJUChtSZXBvcnRNZXNzYWdlQ3JlYXRlUmVzcG9uc2USNQoOcmVwb3J0X21lc3NhZ2UYASABKAsyFS5iYW4udjEuUmVwb3J0TWVzc2FnZUIGukgDyAEBKqUBCgxSZXBvcnRTdGF0dXMSJAogUkVQT1JUX1NUQVRVU19PUEVORURfVU5TUEVDSUZJRUQQABIgChxSRVBPUlRfU1RBVFVTX05FRURfTU9SRV9JTkZPEAESJwojUkVQT1JUX1NUQVRVU19DTE9TRURfV0lUSE9VVF9BQ1RJT04QAhIkCiBSRVBPUlRfU1RBVFVTX0NMT1NFRF9XSVRIX0FDVElPThADMtQFCg1SZXBvcnRTZXJ2aWNlEksKDFJlcG9ydENyZWF0ZRIbLmJhbi52MS5SZXBvcnRDcmVhdGVSZXF1ZXN0GhwuYmFuLnYxLlJlcG9ydENyZWF0ZVJlc3BvbnNlIgASOQoGUmVwb3J0EhUuYmFuLnYxLlJlcG9ydFJlcXVlc3QaFi5iYW4udjEuUmVwb3J0UmVzcG9uc2UiABJNChBSZXBvcnRTdGF0dXNFZGl0Eh8uYmFuLnYxLlJlcG9ydFN0YXR1c0VkaXRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASSAoLVXNlclJlcG9ydHMSGi5iYW4udjEuVXNlclJlcG9ydHNSZXF1ZXN0GhsuYmFuLnYxLlVzZXJSZXBvcnRzUmVzcG9uc2UiABJRCg5SZXBvcnRNZXNzYWdlcxIdLmJhbi52MS5SZXBvcnRNZXNzYWdlc1JlcXVlc3QaHi5iYW4udjEuUmVwb3J0TWVzc2FnZXNSZXNwb25zZSIAEmAKE1JlcG9ydE1lc3NhZ2VDcmVhdGUSIi5iYW4udjEuUmVwb3J0TWVzc2FnZUNyZWF0ZVJlcXVlc3QaIy5iYW4udjEuUmVwb3J0TWVzc2FnZUNyZWF0ZVJlc3BvbnNlIgASWgoRUmVwb3J0TWVzc2FnZUVkaXQSIC5iYW4udjEuUmVwb3J0TWVzc2FnZUVkaXRSZXF1ZXN0GiEuYmFuLnYxLlJlcG9ydE1lc3NhZ2VFZGl0UmVzcG9uc2UiABJTChNSZXBvcnRNZXNzYWdlRGVsZXRlEiIuYmFuLnYxLlJlcG9ydE1lc3NhZ2VEZWxldGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASPAoHUmVwb3J0cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoXLmJhbi52MS5SZXBvcnRzUmVzcG9uc2UiAEKJAQoKY29tLmJhbi52MUILUmVwb3J0UHJvdG9QAVo1Z2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9iYW4vdjE7YmFudjGiAgNCWFiqAgZCYW4uVjHKAgZCYW5cVjHiAhJCYW5cVjFcR1BCTWV0YWRhdGHqAgdCYW46OlYxYghlZGl0aW9uc3DoBw", [file_ban_v1_ban, file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_person_v1_person_core, file_person_v1_privilege]);
+  fileDesc("ChNiYW4vdjEvcmVwb3J0LnByb3RvEgZiYW4udjEinwIKDlJlcG9ydEV2aWRlbmNlEiQKEnJlcG9ydF9ldmlkZW5jZV9pZBgBIAEoA0IIMAG6SAPIAQESGQoJcmVwb3J0X2lkGAIgASgFQga6SAPIAQESLwoEa2luZBgDIAEoDjIULmJhbi52MS5FdmlkZW5jZUtpbmRCC7pICMgBAYIBAhABEhgKBnJlZl9pZBgEIAEoA0IIMAG6SAPIAQESFAoIc3RlYW1faWQYBSABKANCAjABEhEKCXNlcnZlcl9pZBgGIAEoBRIPCgdzdW1tYXJ5GAcgASgJEg8KB2ZsYWdnZWQYCCABKAgSNgoKY3JlYXRlZF9vbhgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASI2ChVSZXBvcnRFdmlkZW5jZVJlcXVlc3QSHQoJcmVwb3J0X2lkGAEgASgFQgq6SAfIAQEaAiAAIkIKFlJlcG9ydEV2aWRlbmNlUmVzcG9uc2USKAoIZXZpZGVuY2UYASADKAsyFi5iYW4udjEuUmVwb3J0RXZpZGVuY2Ui8QEKDVJlcG9ydENsdXN0ZXISLgoHc3ViamVjdBgBIAEoCzIVLnBlcnNvbi52MS5QZXJzb25Db3JlQga6SAPIAQESMQoHcmVwb3J0cxgCIAMoCzIYLmJhbi52MS5SZXBvcnRXaXRoQXV0aG9yQga6SAPIAQESKAoIZXZpZGVuY2UYAyADKAsyFi5iYW4udjEuUmVwb3J0RXZpZGVuY2USGAoIc2V2ZXJpdHkYBCABKAFCBrpIA8gBARI5Cg1sYXN0X3JlcG9ydGVkGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBIj8KFFJlcG9ydFRyaWFnZVJlc3BvbnNlEicKCGNsdXN0ZXJzGAEgAygLMhUuYmFuLnYxLlJlcG9ydENsdXN0ZXIiRAoPUmVwb3J0c1Jlc3BvbnNlEjEKB3JlcG9ydHMYASADKAsyGC5iYW4udjEuUmVwb3J0V2l0aEF1dGhvckIGukgDyAEBIkMKGlJlcG9ydE1lc3NhZ2VEZWxldGVSZXF1ZXN0EiUKEXJlcG9ydF9tZXNzYWdlX2lkGAEgASgFQgq6SAfIAQEaAiAAImIKGFJlcG9ydE1lc3NhZ2VFZGl0UmVxdWVzdBIlChFyZXBvcnRfbWVzc2FnZV9pZBgBIAEoBUIKukgHyAEBGgIgABIfCgdib2R5X21kGAIgASgJQg66SAvIAQFyBhABGNCGAyJLChlSZXBvcnRNZXNzYWdlRWRpdFJlc3BvbnNlEi4KB21lc3NhZ2UYASABKAsyFS5iYW4udjEuUmVwb3J0TWVzc2FnZUIGukgDyAEBIjYKFVJlcG9ydE1lc3NhZ2VzUmVxdWVzdBIdCglyZXBvcnRfaWQYASABKAVCCrpIB8gBARoCIAAiPAoSVXNlclJlcG9ydHNSZXF1ZXN0EiYKCHN0ZWFtX2lkGAEgASgDQhQwAbpID8gBASIKKIGAgICQgICIASJJChZSZXBvcnRNZXNzYWdlc1Jlc3BvbnNlEi8KCG1lc3NhZ2VzGAEgAygLMhUuYmFuLnYxLlJlcG9ydE1lc3NhZ2VCBrpIA8gBASJIChNVc2VyUmVwb3J0c1Jlc3BvbnNlEjEKB3JlcG9ydHMYASADKAsyGC5iYW4udjEuUmVwb3J0V2l0aEF1dGhvckIGukgDyAEBIi4KDVJlcG9ydFJlcXVlc3QSHQoJcmVwb3J0X2lkGAEgASgFQgq6SAfIAQEaAiAAIkIKDlJlcG9ydFJlc3BvbnNlEjAKBnJlcG9ydBgBIAEoCzIYLmJhbi52MS5SZXBvcnRXaXRoQXV0aG9yQga6SAPIAQEiqQMKDVJlcG9ydE1lc3NhZ2USHQoJcmVwb3J0X2lkGAEgASgFQgq6SAfIAQEaAiAAEiUKEXJlcG9ydF9tZXNzYWdlX2lkGAIgASgFQgq6SAfIAQEaAiAAEicKCWF1dGhvcl9pZBgDIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAESIgoKbWVzc2FnZV9tZBgEIAEoCUIOukgLyAEBcgYQARjQhgMSFwoHZGVsZXRlZBgFIAEoCEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIiCgxwZXJzb25hX25hbWUYCCABKAlCDLpICcgBAXIEEAIYIBIbCgthdmF0YXJfaGFzaBgJIAEoCUIGukgDyAEBEjsKEHBlcm1pc3Npb25fbGV2ZWwYCiABKA4yFC5wZXJzb24udjEuUHJpdmlsZWdlQgu6SAjIAQGCAQIQASLpAwoGUmVwb3J0Eh0KCXJlcG9ydF9pZBgBIAEoBUIKukgHyAEBGgIgABInCglzb3VyY2VfaWQYAiABKANCFDABukgPyAEBIgoogYCAgJCAgIgBEicKCXRhcmdldF9pZBgDIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAESIwoLZGVzY3JpcHRpb24YBCABKAlCDrpIC8gBAXIGEAEY0IYDEjgKDXJlcG9ydF9zdGF0dXMYBSABKA4yFC5iYW4udjEuUmVwb3J0U3RhdHVzQgu6SAjIAQGCAQIQARIuCgZyZWFzb24YBiABKA4yES5iYW4udjEuQmFuUmVhc29uQgu6SAjIAQGCAQIQARITCgtyZWFzb25fdGV4dBgHIAEoCRIXCgdkZWxldGVkGAggASgIQga6SAPIAQESEQoJZGVtb190aWNrGAkgASgFEg8KB2RlbW9faWQYCiABKAUSHQoRcGVyc29uX21lc3NhZ2VfaWQYCyABKANCAjABEjYKCmNyZWF0ZWRfb24YDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASKZAQoQUmVwb3J0V2l0aEF1dGhvchImCgZyZXBvcnQYASABKAsyDi5iYW4udjEuUmVwb3J0Qga6SAPIAQESLQoGYXV0aG9yGAIgASgLMhUucGVyc29uLnYxLlBlcnNvbkNvcmVCBrpIA8gBARIuCgdzdWJqZWN0GAMgASgLMhUucGVyc29uLnYxLlBlcnNvbkNvcmVCBrpIA8gBASJtChdSZXBvcnRTdGF0dXNFZGl0UmVxdWVzdBIdCglyZXBvcnRfaWQYASABKAVCCrpIB8gBARoCIAASMwoNcmVwb3J0X3N0YXR1cxgCIAEoDjIULmJhbi52MS5SZXBvcnRTdGF0dXNCBrpIA8gBASKaAgoTUmVwb3J0Q3JlYXRlUmVxdWVzdBIkCglzb3VyY2VfaWQYASABKANCETABukgMIgoogYCAgJCAgIgBEicKCXRhcmdldF9pZBgCIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAESIwoLZGVzY3JpcHRpb24YAyABKAlCDrpIC8gBAXIGEAEY0IYDEi4KBnJlYXNvbhgEIAEoDjIRLmJhbi52MS5CYW5SZWFzb25CC7pICMgBAYIBAhABEhwKC3JlYXNvbl90ZXh0GAUgASgJQge6SARyAhAKEg8KB2RlbW9faWQYBiABKAUSEQoJZGVtb190aWNrGAcgASgFEh0KEXBlcnNvbl9tZXNzYWdlX2lkGAggASgDQgIwASJIChRSZXBvcnRDcmVhdGVSZXNwb25zZRIwCgZyZXBvcnQYASABKAsyGC5iYW4udjEuUmVwb3J0V2l0aEF1dGhvckIGukgDyAEBIlwKGlJlcG9ydE1lc3NhZ2VDcmVhdGVSZXF1ZXN0Eh0KCXJlcG9ydF9pZBgBIAEoBUIKukgHyAEBGgIgABIfCgdib2R5X21kGAIgASgJQg66SAvIAQFyBhABGNCGAyJUChtSZXBvcnRNZXNzYWdlQ3JlYXRlUmVzcG9uc2USNQoOcmVwb3J0X21lc3NhZ2UYASABKAsyFS5iYW4udjEuUmVwb3J0TWVzc2FnZUIGukgDyAEBKpEBCgxFdmlkZW5jZUtpbmQSHQoZRVZJREVOQ0VfS0lORF9VTlNQRUNJRklFRBAAEhYKEkVWSURFTkNFX0tJTkRfQ0hBVBABEhsKF0VWSURFTkNFX0tJTkRfREVURUNUSU9OEAISFQoRRVZJREVOQ0VfS0lORF9CQU4QAxIWChJFVklERU5DRV9LSU5EX0RFTU8QBCqlAQoMUmVwb3J0U3RhdHVzEiQKIFJFUE9SVF9TVEFUVVNfT1BFTkVEX1VOU1BFQ0lGSUVEEAASIAocUkVQT1JUX1NUQVRVU19ORUVEX01PUkVfSU5GTxABEicKI1JFUE9SVF9TVEFUVVNfQ0xPU0VEX1dJVEhPVVRfQUNUSU9OEAISJAogUkVQT1JUX1NUQVRVU19DTE9TRURfV0lUSF9BQ1RJT04QAzLvBgoNUmVwb3J0U2VydmljZRJLCgxSZXBvcnRDcmVhdGUSGy5iYW4udjEuUmVwb3J0Q3JlYXRlUmVxdWVzdBocLmJhbi52MS5SZXBvcnRDcmVhdGVSZXNwb25zZSIAEjkKBlJlcG9ydBIVLmJhbi52MS5SZXBvcnRSZXF1ZXN0GhYuYmFuLnYxLlJlcG9ydFJlc3BvbnNlIgASTQoQUmVwb3J0U3RhdHVzRWRpdBIfLmJhbi52MS5SZXBvcnRTdGF0dXNFZGl0UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEkgKC1VzZXJSZXBvcnRzEhouYmFuLnYxLlVzZXJSZXBvcnRzUmVxdWVzdBobLmJhbi52MS5Vc2VyUmVwb3J0c1Jlc3BvbnNlIgASUQoOUmVwb3J0TWVzc2FnZXMSHS5iYW4udjEuUmVwb3J0TWVzc2FnZXNSZXF1ZXN0Gh4uYmFuLnYxLlJlcG9ydE1lc3NhZ2VzUmVzcG9uc2UiABJgChNSZXBvcnRNZXNzYWdlQ3JlYXRlEiIuYmFuLnYxLlJlcG9ydE1lc3NhZ2VDcmVhdGVSZXF1ZXN0GiMuYmFuLnYxLlJlcG9ydE1lc3NhZ2VDcmVhdGVSZXNwb25zZSIAEloKEVJlcG9ydE1lc3NhZ2VFZGl0EiAuYmFuLnYxLlJlcG9ydE1lc3NhZ2VFZGl0UmVxdWVzdBohLmJhbi52MS5SZXBvcnRNZXNzYWdlRWRpdFJlc3BvbnNlIgASUwoTUmVwb3J0TWVzc2FnZURlbGV0ZRIiLmJhbi52MS5SZXBvcnRNZXNzYWdlRGVsZXRlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEjwKB1JlcG9ydHMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFy5iYW4udjEuUmVwb3J0c1Jlc3BvbnNlIgASUQoOUmVwb3J0RXZpZGVuY2USHS5iYW4udjEuUmVwb3J0RXZpZGVuY2VSZXF1ZXN0Gh4uYmFuLnYxLlJlcG9ydEV2aWRlbmNlUmVzcG9uc2UiABJGCgxSZXBvcnRUcmlhZ2USFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHC5iYW4udjEuUmVwb3J0VHJpYWdlUmVzcG9uc2UiAEKJAQoKY29tLmJhbi52MUILUmVwb3J0UHJvdG9QAVo1Z2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9iYW4vdjE7YmFudjGiAgNCWFiqAgZCYW4uVjHKAgZCYW5cVjHiAhJCYW5cVjFcR1BCTWV0YWRhdGHqAgdCYW46OlYxYghlZGl0aW9uc3DoBw", [file_ban_v1_ban, file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_person_v1_person_core, file_person_v1_privilege]);
+
+/**
+ * @generated from message ban.v1.ReportEvidence
+ */
+export type ReportEvidence = Message<"ban.v1.ReportEvidence"> & {
+  /**
+   * @generated from field: int64 report_evidence_id = 1 [jstype = JS_STRING];
+   */
+  reportEvidenceId: string;
+
+  /**
+   * @generated from field: int32 report_id = 2;
+   */
+  reportId: number;
+
+  /**
+   * @generated from field: ban.v1.EvidenceKind kind = 3;
+   */
+  kind: EvidenceKind;
+
+  /**
+   * RefId is the id of the source record, eg: the person_message_id of chat evidence.
+   *
+   * @generated from field: int64 ref_id = 4 [jstype = JS_STRING];
+   */
+  refId: string;
+
+  /**
+   * @generated from field: int64 steam_id = 5 [jstype = JS_STRING];
+   */
+  steamId: string;
+
+  /**
+   * @generated from field: int32 server_id = 6;
+   */
+  serverId: number;
+
+  /**
+   * Summary is a snapshot of the source record at the time it was attached.
+   *
+   * @generated from field: string summary = 7;
+   */
+  summary: string;
+
+  /**
+   * Flagged is set for chat messages matched by a word filter and for bans that are still active.
+   *
+   * @generated from field: bool flagged = 8;
+   */
+  flagged: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 9;
+   */
+  createdOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message ban.v1.ReportEvidence.
+ * Use `create(ReportEvidenceSchema)` to create a new message.
+ */
+export const ReportEvidenceSchema: GenMessage<ReportEvidence> = /*@__PURE__*/
+  messageDesc(file_ban_v1_report, 0);
+
+/**
+ * @generated from message ban.v1.ReportEvidenceRequest
+ */
+export type ReportEvidenceRequest = Message<"ban.v1.ReportEvidenceRequest"> & {
+  /**
+   * @generated from field: int32 report_id = 1;
+   */
+  reportId: number;
+};
+
+/**
+ * Describes the message ban.v1.ReportEvidenceRequest.
+ * Use `create(ReportEvidenceRequestSchema)` to create a new message.
+ */
+export const ReportEvidenceRequestSchema: GenMessage<ReportEvidenceRequest> = /*@__PURE__*/
+  messageDesc(file_ban_v1_report, 1);
+
+/**
+ * @generated from message ban.v1.ReportEvidenceResponse
+ */
+export type ReportEvidenceResponse = Message<"ban.v1.ReportEvidenceResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.ReportEvidence evidence = 1;
+   */
+  evidence: ReportEvidence[];
+};
+
+/**
+ * Describes the message ban.v1.ReportEvidenceResponse.
+ * Use `create(ReportEvidenceResponseSchema)` to create a new message.
+ */
+export const ReportEvidenceResponseSchema: GenMessage<ReportEvidenceResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_report, 2);
+
+/**
+ * @generated from message ban.v1.ReportCluster
+ */
+export type ReportCluster = Message<"ban.v1.ReportCluster"> & {
+  /**
+   * @generated from field: person.v1.PersonCore subject = 1;
+   */
+  subject?: PersonCore | undefined;
+
+  /**
+   * @generated from field: repeated ban.v1.ReportWithAuthor reports = 2;
+   */
+  reports: ReportWithAuthor[];
+
+  /**
+   * Evidence is the combined evidence of all reports in the cluster, without duplicates.
+   *
+   * @generated from field: repeated ban.v1.ReportEvidence evidence = 3;
+   */
+  evidence: ReportEvidence[];
+
+  /**
+   * @generated from field: double severity = 4;
+   */
+  severity: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_reported = 5;
+   */
+  lastReported?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message ban.v1.ReportCluster.
+ * Use `create(ReportClusterSchema)` to create a new message.
+ */
+export const ReportClusterSchema: GenMessage<ReportCluster> = /*@__PURE__*/
+  messageDesc(file_ban_v1_report, 3);
+
+/**
+ * @generated from message ban.v1.ReportTriageResponse
+ */
+export type ReportTriageResponse = Message<"ban.v1.ReportTriageResponse"> & {
+  /**
+   * @generated from field: repeated ban.v1.ReportCluster clusters = 1;
+   */
+  clusters: ReportCluster[];
+};
+
+/**
+ * Describes the message ban.v1.ReportTriageResponse.
+ * Use `create(ReportTriageResponseSchema)` to create a new message.
+ */
+export const ReportTriageResponseSchema: GenMessage<ReportTriageResponse> = /*@__PURE__*/
+  messageDesc(file_ban_v1_report, 4);
 
 /**
  * @generated from message ban.v1.ReportsResponse
@@ -36,7 +189,7 @@ export type ReportsResponse = Message<"ban.v1.ReportsResponse"> & {
  * Use `create(ReportsResponseSchema)` to create a new message.
  */
 export const ReportsResponseSchema: GenMessage<ReportsResponse> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 0);
+  messageDesc(file_ban_v1_report, 5);
 
 /**
  * @generated from message ban.v1.ReportMessageDeleteRequest
@@ -53,7 +206,7 @@ export type ReportMessageDeleteRequest = Message<"ban.v1.ReportMessageDeleteRequ
  * Use `create(ReportMessageDeleteRequestSchema)` to create a new message.
  */
 export const ReportMessageDeleteRequestSchema: GenMessage<ReportMessageDeleteRequest> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 1);
+  messageDesc(file_ban_v1_report, 6);
 
 /**
  * @generated from message ban.v1.ReportMessageEditRequest
@@ -75,7 +228,7 @@ export type ReportMessageEditRequest = Message<"ban.v1.ReportMessageEditRequest"
  * Use `create(ReportMessageEditRequestSchema)` to create a new message.
  */
 export const ReportMessageEditRequestSchema: GenMessage<ReportMessageEditRequest> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 2);
+  messageDesc(file_ban_v1_report, 7);
 
 /**
  * @generated from message ban.v1.ReportMessageEditResponse
@@ -92,7 +245,7 @@ export type ReportMessageEditResponse = Message<"ban.v1.ReportMessageEditRespons
  * Use `create(ReportMessageEditResponseSchema)` to create a new message.
  */
 export const ReportMessageEditResponseSchema: GenMessage<ReportMessageEditResponse> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 3);
+  messageDesc(file_ban_v1_report, 8);
 
 /**
  * @generated from message ban.v1.ReportMessagesRequest
@@ -109,7 +262,7 @@ export type ReportMessagesRequest = Message<"ban.v1.ReportMessagesRequest"> & {
  * Use `create(ReportMessagesRequestSchema)` to create a new message.
  */
 export const ReportMessagesRequestSchema: GenMessage<ReportMessagesRequest> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 4);
+  messageDesc(file_ban_v1_report, 9);
 
 /**
  * @generated from message ban.v1.UserReportsRequest
@@ -126,7 +279,7 @@ export type UserReportsRequest = Message<"ban.v1.UserReportsRequest"> & {
  * Use `create(UserReportsRequestSchema)` to create a new message.
  */
 export const UserReportsRequestSchema: GenMessage<UserReportsRequest> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 5);
+  messageDesc(file_ban_v1_report, 10);
 
 /**
  * @generated from message ban.v1.ReportMessagesResponse
@@ -143,7 +296,7 @@ export type ReportMessagesResponse = Message<"ban.v1.ReportMessagesResponse"> &
  * Use `create(ReportMessagesResponseSchema)` to create a new message.
  */
 export const ReportMessagesResponseSchema: GenMessage<ReportMessagesResponse> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 6);
+  messageDesc(file_ban_v1_report, 11);
 
 /**
  * @generated from message ban.v1.UserReportsResponse
@@ -160,7 +313,7 @@ export type UserReportsResponse = Message<"ban.v1.UserReportsResponse"> & {
  * Use `create(UserReportsResponseSchema)` to create a new message.
  */
 export const UserReportsResponseSchema: GenMessage<UserReportsResponse> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 7);
+  messageDesc(file_ban_v1_report, 12);
 
 /**
  * @generated from message ban.v1.ReportRequest
@@ -177,7 +330,7 @@ export type ReportRequest = Message<"ban.v1.ReportRequest"> & {
  * Use `create(ReportRequestSchema)` to create a new message.
  */
 export const ReportRequestSchema: GenMessage<ReportRequest> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 8);
+  messageDesc(file_ban_v1_report, 13);
 
 /**
  * @generated from message ban.v1.ReportResponse
@@ -194,7 +347,7 @@ export type ReportResponse = Message<"ban.v1.ReportResponse"> & {
  * Use `create(ReportResponseSchema)` to create a new message.
  */
 export const ReportResponseSchema: GenMessage<ReportResponse> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 9);
+  messageDesc(file_ban_v1_report, 14);
 
 /**
  * @generated from message ban.v1.ReportMessage
@@ -256,7 +409,7 @@ export type ReportMessage = Message<"ban.v1.ReportMessage"> & {
  * Use `create(ReportMessageSchema)` to create a new message.
  */
 export const ReportMessageSchema: GenMessage<ReportMessage> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 10);
+  messageDesc(file_ban_v1_report, 15);
 
 /**
  * @generated from message ban.v1.Report
@@ -333,7 +486,7 @@ export type Report = Message<"ban.v1.Report"> & {
  * Use `create(ReportSchema)` to create a new message.
  */
 export const ReportSchema: GenMessage<Report> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 11);
+  messageDesc(file_ban_v1_report, 16);
 
 /**
  * @generated from message ban.v1.ReportWithAuthor
@@ -360,7 +513,7 @@ export type ReportWithAuthor = Message<"ban.v1.ReportWithAuthor"> & {
  * Use `create(ReportWithAuthorSchema)` to create a new message.
  */
 export const ReportWithAuthorSchema: GenMessage<ReportWithAuthor> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 12);
+  messageDesc(file_ban_v1_report, 17);
 
 /**
  * @generated from message ban.v1.ReportStatusEditRequest
@@ -382,7 +535,7 @@ export type ReportStatusEditRequest = Message<"ban.v1.ReportStatusEditRequest">
  * Use `create(ReportStatusEditRequestSchema)` to create a new message.
  */
 export const ReportStatusEditRequestSchema: GenMessage<ReportStatusEditRequest> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 13);
+  messageDesc(file_ban_v1_report, 18);
 
 /**
  * @generated from message ban.v1.ReportCreateRequest
@@ -434,7 +587,7 @@ export type ReportCreateRequest = Message<"ban.v1.ReportCreateRequest"> & {
  * Use `create(ReportCreateRequestSchema)` to create a new message.
  */
 export const ReportCreateRequestSchema: GenMessage<ReportCreateRequest> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 14);
+  messageDesc(file_ban_v1_report, 19);
 
 /**
  * @generated from message ban.v1.ReportCreateResponse
@@ -451,7 +604,7 @@ export type ReportCreateResponse = Message<"ban.v1.ReportCreateResponse"> & {
  * Use `create(ReportCreateResponseSchema)` to create a new message.
  */
 export const ReportCreateResponseSchema: GenMessage<ReportCreateResponse> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 15);
+  messageDesc(file_ban_v1_report, 20);
 
 /**
  * @generated from message ban.v1.ReportMessageCreateRequest
@@ -473,7 +626,7 @@ export type ReportMessageCreateRequest = Message<"ban.v1.ReportMessageCreateRequ
  * Use `create(ReportMessageCreateRequestSchema)` to create a new message.
  */
 export const ReportMessageCreateRequestSchema: GenMessage<ReportMessageCreateRequest> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 16);
+  messageDesc(file_ban_v1_report, 21);
 
 /**
  * @generated from message ban.v1.ReportMessageCreateResponse
@@ -490,7 +643,43 @@ export type ReportMessageCreateResponse = Message<"ban.v1.ReportMessageCreateRes
  * Use `create(ReportMessageCreateResponseSchema)` to create a new message.
  */
 export const ReportMessageCreateResponseSchema: GenMessage<ReportMessageCreateResponse> = /*@__PURE__*/
-  messageDesc(file_ban_v1_report, 17);
+  messageDesc(file_ban_v1_report, 22);
+
+/**
+ * @generated from enum ban.v1.EvidenceKind
+ */
+export enum EvidenceKind {
+  /**
+   * @generated from enum value: EVIDENCE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EVIDENCE_KIND_CHAT = 1;
+   */
+  CHAT = 1,
+
+  /**
+   * @generated from enum value: EVIDENCE_KIND_DETECTION = 2;
+   */
+  DETECTION = 2,
+
+  /**
+   * @generated from enum value: EVIDENCE_KIND_BAN = 3;
+   */
+  BAN = 3,
+
+  /**
+   * @generated from enum value: EVIDENCE_KIND_DEMO = 4;
+   */
+  DEMO = 4,
+}
+
+/**
+ * Describes the enum ban.v1.EvidenceKind.
+ */
+export const EvidenceKindSchema: GenEnum<EvidenceKind> = /*@__PURE__*/
+  enumDesc(file_ban_v1_report, 0);
 
 /**
  * @generated from enum ban.v1.ReportStatus
@@ -521,7 +710,7 @@ export enum ReportStatus {
  * Describes the enum ban.v1.ReportStatus.
  */
 export const ReportStatusSchema: GenEnum<ReportStatus> = /*@__PURE__*/
-  enumDesc(file_ban_v1_report, 0);
+  enumDesc(file_ban_v1_report, 1);
 
 /**
  * @generated from service ban.v1.ReportService
@@ -599,6 +788,26 @@ export const ReportService: GenService<{
     input: typeof EmptySchema;
     output: typeof ReportsResponseSchema;
   },
+  /**
+   * ReportEvidence returns the evidence attached to a report when it was created.
+   *
+   * @generated from rpc ban.v1.ReportService.ReportEvidence
+   */
+  reportEvidence: {
+    methodKind: "unary";
+    input: typeof ReportEvidenceRequestSchema;
+    output: typeof ReportEvidenceResponseSchema;
+  },
+  /**
+   * ReportTriage returns the open reports clustered by their target, most severe first.
+   *
+   * @generated from rpc ban.v1.ReportService.ReportTriage
+   */
+  reportTriage: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ReportTriageResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_ban_v1_report, 0);
 
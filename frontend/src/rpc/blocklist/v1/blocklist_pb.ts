@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file blocklist/v1/blocklist.proto.
  */
 export const file_blocklist_v1_blocklist: GenFile = /*@__PURE__*/
-  fileDesc("ChxibG9ja2xpc3QvdjEvYmxvY2tsaXN0LnByb3RvEgxibG9ja2xpc3QudjEiRQobV2hpdGVsaXN0U3RlYW1DcmVhdGVSZXF1ZXN0EiYKCHN0ZWFtX2lkGAEgASgDQhQwAbpID8gBASIKKIGAgICQgICIASJXChxXaGl0ZWxpc3RTdGVhbUNyZWF0ZVJlc3BvbnNlEjcKCXdoaXRlbGlzdBgBIAEoCzIcLmJsb2NrbGlzdC52MS5XaGl0ZWxpc3RTdGVhbUIGukgDyAEBIkUKG1doaXRlbGlzdFN0ZWFtRGVsZXRlUmVxdWVzdBImCghzdGVhbV9pZBgBIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAEiUgoWV2hpdGVsaXN0U3RlYW1SZXNwb25zZRI4Cgp3aGl0ZWxpc3RzGAEgAygLMhwuYmxvY2tsaXN0LnYxLldoaXRlbGlzdFN0ZWFtQga6SAPIAQEi4wEKDldoaXRlbGlzdFN0ZWFtEiYKCHN0ZWFtX2lkGAEgASgDQhQwAbpID8gBASIKKIGAgICQgICIARIcCgxwZXJzb25hX25hbWUYAiABKAlCBrpIA8gBARIbCgthdmF0YXJfaGFzaBgDIAEoCUIGukgDyAEBEjYKCmNyZWF0ZWRfb24YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASJfChtXaGl0ZWxpc3RBZGRyZXNzRWRpdFJlcXVlc3QSJwoXY2lkcl9ibG9ja193aGl0ZWxpc3RfaWQYASABKAVCBrpIA8gBARIXCgdhZGRyZXNzGAIgASgJQga6SAPIAQEiVAocV2hpdGVsaXN0QWRkcmVzc0VkaXRSZXNwb25zZRI0Cgl3aGl0ZWxpc3QYASABKAsyGS5ibG9ja2xpc3QudjEuV2hpdGVsaXN0SVBCBrpIA8gBASJICh1XaGl0ZWxpc3RBZGRyZXNzRGVsZXRlUmVxdWVzdBInChdjaWRyX2Jsb2NrX3doaXRlbGlzdF9pZBgBIAEoBUIGukgDyAEBIjgKHVdoaXRlbGlzdEFkZHJlc3NDcmVhdGVSZXF1ZXN0EhcKB2FkZHJlc3MYASABKAlCBrpIA8gBASJdCh5XaGl0ZWxpc3RBZGRyZXNzQ3JlYXRlUmVzcG9uc2USOwoJd2hpdGVsaXN0GAEgASgLMiAuYmxvY2tsaXN0LnYxLkNJRFJCbG9ja1doaXRlbGlzdEIGukgDyAEBIjAKEUNoZWNrQmxvY2tSZXF1ZXN0EhsKB2FkZHJlc3MYASABKAlCCrpIB8gBAXICeAEiRQoSQ2hlY2tCbG9ja1Jlc3BvbnNlEhcKB2Jsb2NrZWQYASABKAhCBrpIA8gBARIWCgZzb3VyY2UYAiABKAlCBrpIA8gBASJFCh1CbG9ja2xpc3RTb3VyY2VzRGVsZXRlUmVxdWVzdBIkChRjaWRyX2Jsb2NrX3NvdXJjZV9pZBgBIAEoBUIGukgDyAEBIocBChtCbG9ja2xpc3RTb3VyY2VzRWRpdFJlcXVlc3QSJAoUY2lkcl9ibG9ja19zb3VyY2VfaWQYASABKAVCBrpIA8gBARIUCgRuYW1lGAIgASgJQga6SAPIAQESEwoDdXJsGAMgASgJQga6SAPIAQESFwoHZW5hYmxlZBgEIAEoCEIGukgDyAEBIlsKHEJsb2NrbGlzdFNvdXJjZXNFZGl0UmVzcG9uc2USOwoMYmxvY2tfc291cmNlGAEgASgLMh0uYmxvY2tsaXN0LnYxLkNJRFJCbG9ja1NvdXJjZUIGukgDyAEBImMKHUJsb2NrbGlzdFNvdXJjZXNDcmVhdGVSZXF1ZXN0EhQKBG5hbWUYASABKAlCBrpIA8gBARITCgN1cmwYAiABKAlCBrpIA8gBARIXCgdlbmFibGVkGAMgASgIQga6SAPIAQEiXQoeQmxvY2tsaXN0U291cmNlc0NyZWF0ZVJlc3BvbnNlEjsKDGJsb2NrX3NvdXJjZRgBIAEoCzIdLmJsb2NrbGlzdC52MS5DSURSQmxvY2tTb3VyY2VCBrpIA8gBASJZChhXaGl0ZWxpc3RBZGRyZXNzUmVzcG9uc2USPQoLd2hpdGVsaXN0ZWQYASADKAsyIC5ibG9ja2xpc3QudjEuQ0lEUkJsb2NrV2hpdGVsaXN0Qga6SAPIAQEixgEKEkNJRFJCbG9ja1doaXRlbGlzdBInChdjaWRyX2Jsb2NrX3doaXRlbGlzdF9pZBgBIAEoBUIGukgDyAEBEhcKB2FkZHJlc3MYAiABKAlCBrpIA8gBARI2CgpjcmVhdGVkX29uGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEjYKCnVwZGF0ZWRfb24YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQEiWwoYQmxvY2tsaXN0U291cmNlc1Jlc3BvbnNlEj8KEGJsb2NrbGlzdF9zb3VyY2UYASADKAsyHS5ibG9ja2xpc3QudjEuQ0lEUkJsb2NrU291cmNlQga6SAPIAQEi+AEKD0NJRFJCbG9ja1NvdXJjZRIoChRjaWRyX2Jsb2NrX3NvdXJjZV9pZBgBIAEoBUIKukgHyAEBGgIgABIYCgRuYW1lGAIgASgJQgq6SAfIAQFyAhABEhgKA3VybBgDIAEoCUILukgIyAEBcgOIAQESFwoHZW5hYmxlZBgEIAEoCEIGukgDyAEBEjYKCmNyZWF0ZWRfb24YBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASLIAQoLV2hpdGVsaXN0SVASKwoXY2lkcl9ibG9ja193aGl0ZWxpc3RfaWQYASABKAVCCrpIB8gBARoCIAASHAoHYWRkcmVzcxgCIAEoCUILukgIyAEBcgPYAQESNgoKY3JlYXRlZF9vbhgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARI2Cgp1cGRhdGVkX29uGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBMsMJChBCbG9ja2xpc3RTZXJ2aWNlElQKEEJsb2NrbGlzdFNvdXJjZXMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaJi5ibG9ja2xpc3QudjEuQmxvY2tsaXN0U291cmNlc1Jlc3BvbnNlIgASdQoWQmxvY2tsaXN0U291cmNlc0NyZWF0ZRIrLmJsb2NrbGlzdC52MS5CbG9ja2xpc3RTb3VyY2VzQ3JlYXRlUmVxdWVzdBosLmJsb2NrbGlzdC52MS5CbG9ja2xpc3RTb3VyY2VzQ3JlYXRlUmVzcG9uc2UiABJvChRCbG9ja2xpc3RTb3VyY2VzRWRpdBIpLmJsb2NrbGlzdC52MS5CbG9ja2xpc3RTb3VyY2VzRWRpdFJlcXVlc3QaKi5ibG9ja2xpc3QudjEuQmxvY2tsaXN0U291cmNlc0VkaXRSZXNwb25zZSIAEl8KFkJsb2NrbGlzdFNvdXJjZXNEZWxldGUSKy5ibG9ja2xpc3QudjEuQmxvY2tsaXN0U291cmNlc0RlbGV0ZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJUChBXaGl0ZWxpc3RBZGRyZXNzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiYuYmxvY2tsaXN0LnYxLldoaXRlbGlzdEFkZHJlc3NSZXNwb25zZSIAEnUKFldoaXRlbGlzdEFkZHJlc3NDcmVhdGUSKy5ibG9ja2xpc3QudjEuV2hpdGVsaXN0QWRkcmVzc0NyZWF0ZVJlcXVlc3QaLC5ibG9ja2xpc3QudjEuV2hpdGVsaXN0QWRkcmVzc0NyZWF0ZVJlc3BvbnNlIgASXwoWV2hpdGVsaXN0QWRkcmVzc0RlbGV0ZRIrLmJsb2NrbGlzdC52MS5XaGl0ZWxpc3RBZGRyZXNzRGVsZXRlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEm8KFFdoaXRlbGlzdEFkZHJlc3NFZGl0EikuYmxvY2tsaXN0LnYxLldoaXRlbGlzdEFkZHJlc3NFZGl0UmVxdWVzdBoqLmJsb2NrbGlzdC52MS5XaGl0ZWxpc3RBZGRyZXNzRWRpdFJlc3BvbnNlIgASUAoOV2hpdGVsaXN0U3RlYW0SFi5nb29nbGUucHJvdG9idWYuRW1wdHkaJC5ibG9ja2xpc3QudjEuV2hpdGVsaXN0U3RlYW1SZXNwb25zZSIAElsKFFdoaXRlbGlzdFN0ZWFtRGVsZXRlEikuYmxvY2tsaXN0LnYxLldoaXRlbGlzdFN0ZWFtRGVsZXRlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEm8KFFdoaXRlbGlzdFN0ZWFtQ3JlYXRlEikuYmxvY2tsaXN0LnYxLldoaXRlbGlzdFN0ZWFtQ3JlYXRlUmVxdWVzdBoqLmJsb2NrbGlzdC52MS5XaGl0ZWxpc3RTdGVhbUNyZWF0ZVJlc3BvbnNlIgASUQoKQ2hlY2tCbG9jaxIfLmJsb2NrbGlzdC52MS5DaGVja0Jsb2NrUmVxdWVzdBogLmJsb2NrbGlzdC52MS5DaGVja0Jsb2NrUmVzcG9uc2UiAEK2AQoQY29tLmJsb2NrbGlzdC52MUIOQmxvY2tsaXN0UHJvdG9QAVpBZ2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9ibG9ja2xpc3QvdjE7YmxvY2tsaXN0djGiAgNCWFiqAgxCbG9ja2xpc3QuVjHKAgxCbG9ja2xpc3RcVjHiAhhCbG9ja2xpc3RcVjFcR1BCTWV0YWRhdGHqAg1CbG9ja2xpc3Q6OlYxYghlZGl0aW9uc3DoBw", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+  fileDesc("ChxibG9ja2xpc3QvdjEvYmxvY2tsaXN0LnByb3RvEgxibG9ja2xpc3QudjEiRQobV2hpdGVsaXN0U3RlYW1DcmVhdGVSZXF1ZXN0EiYKCHN0ZWFtX2lkGAEgASgDQhQwAbpID8gBASIKKIGAgICQgICIASJXChxXaGl0ZWxpc3RTdGVhbUNyZWF0ZVJlc3BvbnNlEjcKCXdoaXRlbGlzdBgBIAEoCzIcLmJsb2NrbGlzdC52MS5XaGl0ZWxpc3RTdGVhbUIGukgDyAEBIkUKG1doaXRlbGlzdFN0ZWFtRGVsZXRlUmVxdWVzdBImCghzdGVhbV9pZBgBIAEoA0IUMAG6SA/IAQEiCiiBgICAkICAiAEiUgoWV2hpdGVsaXN0U3RlYW1SZXNwb25zZRI4Cgp3aGl0ZWxpc3RzGAEgAygLMhwuYmxvY2tsaXN0LnYxLldoaXRlbGlzdFN0ZWFtQga6SAPIAQEi4wEKDldoaXRlbGlzdFN0ZWFtEiYKCHN0ZWFtX2lkGAEgASgDQhQwAbpID8gBASIKKIGAgICQgICIARIcCgxwZXJzb25hX25hbWUYAiABKAlCBrpIA8gBARIbCgthdmF0YXJfaGFzaBgDIAEoCUIGukgDyAEBEjYKCmNyZWF0ZWRfb24YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASJfChtXaGl0ZWxpc3RBZGRyZXNzRWRpdFJlcXVlc3QSJwoXY2lkcl9ibG9ja193aGl0ZWxpc3RfaWQYASABKAVCBrpIA8gBARIXCgdhZGRyZXNzGAIgASgJQga6SAPIAQEiVAocV2hpdGVsaXN0QWRkcmVzc0VkaXRSZXNwb25zZRI0Cgl3aGl0ZWxpc3QYASABKAsyGS5ibG9ja2xpc3QudjEuV2hpdGVsaXN0SVBCBrpIA8gBASJICh1XaGl0ZWxpc3RBZGRyZXNzRGVsZXRlUmVxdWVzdBInChdjaWRyX2Jsb2NrX3doaXRlbGlzdF9pZBgBIAEoBUIGukgDyAEBIjgKHVdoaXRlbGlzdEFkZHJlc3NDcmVhdGVSZXF1ZXN0EhcKB2FkZHJlc3MYASABKAlCBrpIA8gBASJdCh5XaGl0ZWxpc3RBZGRyZXNzQ3JlYXRlUmVzcG9uc2USOwoJd2hpdGVsaXN0GAEgASgLMiAuYmxvY2tsaXN0LnYxLkNJRFJCbG9ja1doaXRlbGlzdEIGukgDyAEBIlUKEUNoZWNrQmxvY2tSZXF1ZXN0EhsKB2FkZHJlc3MYASABKAlCCrpIB8gBAXICeAESIwoIc3RlYW1faWQYAiABKANCETABukgMIgoogYCAgJCAgIgBIqcBChJDaGVja0Jsb2NrUmVzcG9uc2USFwoHYmxvY2tlZBgBIAEoCEIGukgDyAEBEhYKBnNvdXJjZRgCIAEoCUIGukgDyAEBEhwKFGNpZHJfYmxvY2tfc291cmNlX2lkGAMgASgFEg4KBnByZWZpeBgEIAEoCRIZChF3aGl0ZWxpc3RfYWRkcmVzcxgFIAEoCRIXCg93aGl0ZWxpc3Rfc3RlYW0YBiABKAgiRQodQmxvY2tsaXN0U291cmNlc0RlbGV0ZVJlcXVlc3QSJAoUY2lkcl9ibG9ja19zb3VyY2VfaWQYASABKAVCBrpIA8gBASKHAQobQmxvY2tsaXN0U291cmNlc0VkaXRSZXF1ZXN0EiQKFGNpZHJfYmxvY2tfc291cmNlX2lkGAEgASgFQga6SAPIAQESFAoEbmFtZRgCIAEoCUIGukgDyAEBEhMKA3VybBgDIAEoCUIGukgDyAEBEhcKB2VuYWJsZWQYBCABKAhCBrpIA8gBASJbChxCbG9ja2xpc3RTb3VyY2VzRWRpdFJlc3BvbnNlEjsKDGJsb2NrX3NvdXJjZRgBIAEoCzIdLmJsb2NrbGlzdC52MS5DSURSQmxvY2tTb3VyY2VCBrpIA8gBASJjCh1CbG9ja2xpc3RTb3VyY2VzQ3JlYXRlUmVxdWVzdBIUCgRuYW1lGAEgASgJQga6SAPIAQESEwoDdXJsGAIgASgJQga6SAPIAQESFwoHZW5hYmxlZBgDIAEoCEIGukgDyAEBIl0KHkJsb2NrbGlzdFNvdXJjZXNDcmVhdGVSZXNwb25zZRI7CgxibG9ja19zb3VyY2UYASABKAsyHS5ibG9ja2xpc3QudjEuQ0lEUkJsb2NrU291cmNlQga6SAPIAQEiWQoYV2hpdGVsaXN0QWRkcmVzc1Jlc3BvbnNlEj0KC3doaXRlbGlzdGVkGAEgAygLMiAuYmxvY2tsaXN0LnYxLkNJRFJCbG9ja1doaXRlbGlzdEIGukgDyAEBIsYBChJDSURSQmxvY2tXaGl0ZWxpc3QSJwoXY2lkcl9ibG9ja193aGl0ZWxpc3RfaWQYASABKAVCBrpIA8gBARIXCgdhZGRyZXNzGAIgASgJQga6SAPIAQESNgoKY3JlYXRlZF9vbhgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARI2Cgp1cGRhdGVkX29uGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBIlsKGEJsb2NrbGlzdFNvdXJjZXNSZXNwb25zZRI/ChBibG9ja2xpc3Rfc291cmNlGAEgAygLMh0uYmxvY2tsaXN0LnYxLkNJRFJCbG9ja1NvdXJjZUIGukgDyAEBIogDCg9DSURSQmxvY2tTb3VyY2USKAoUY2lkcl9ibG9ja19zb3VyY2VfaWQYASABKAVCCrpIB8gBARoCIAASGAoEbmFtZRgCIAEoCUIKukgHyAEBcgIQARIYCgN1cmwYAyABKAlCC7pICMgBAXIDiAEBEhcKB2VuYWJsZWQYBCABKAhCBrpIA8gBARI2CgpjcmVhdGVkX29uGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEjYKCnVwZGF0ZWRfb24YBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESMAoMbGFzdF9zeW5jX29uGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIzCg9sYXN0X3N1Y2Nlc3Nfb24YCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhIKCmxhc3RfZXJyb3IYCSABKAkSEwoLZW50cnlfY291bnQYCiABKAUiyAEKC1doaXRlbGlzdElQEisKF2NpZHJfYmxvY2tfd2hpdGVsaXN0X2lkGAEgASgFQgq6SAfIAQEaAiAAEhwKB2FkZHJlc3MYAiABKAlCC7pICMgBAXID2AEBEjYKCmNyZWF0ZWRfb24YAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBATLDCQoQQmxvY2tsaXN0U2VydmljZRJUChBCbG9ja2xpc3RTb3VyY2VzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiYuYmxvY2tsaXN0LnYxLkJsb2NrbGlzdFNvdXJjZXNSZXNwb25zZSIAEnUKFkJsb2NrbGlzdFNvdXJjZXNDcmVhdGUSKy5ibG9ja2xpc3QudjEuQmxvY2tsaXN0U291cmNlc0NyZWF0ZVJlcXVlc3QaLC5ibG9ja2xpc3QudjEuQmxvY2tsaXN0U291cmNlc0NyZWF0ZVJlc3BvbnNlIgASbwoUQmxvY2tsaXN0U291cmNlc0VkaXQSKS5ibG9ja2xpc3QudjEuQmxvY2tsaXN0U291cmNlc0VkaXRSZXF1ZXN0GiouYmxvY2tsaXN0LnYxLkJsb2NrbGlzdFNvdXJjZXNFZGl0UmVzcG9uc2UiABJfChZCbG9ja2xpc3RTb3VyY2VzRGVsZXRlEisuYmxvY2tsaXN0LnYxLkJsb2NrbGlzdFNvdXJjZXNEZWxldGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASVAoQV2hpdGVsaXN0QWRkcmVzcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRomLmJsb2NrbGlzdC52MS5XaGl0ZWxpc3RBZGRyZXNzUmVzcG9uc2UiABJ1ChZXaGl0ZWxpc3RBZGRyZXNzQ3JlYXRlEisuYmxvY2tsaXN0LnYxLldoaXRlbGlzdEFkZHJlc3NDcmVhdGVSZXF1ZXN0GiwuYmxvY2tsaXN0LnYxLldoaXRlbGlzdEFkZHJlc3NDcmVhdGVSZXNwb25zZSIAEl8KFldoaXRlbGlzdEFkZHJlc3NEZWxldGUSKy5ibG9ja2xpc3QudjEuV2hpdGVsaXN0QWRkcmVzc0RlbGV0ZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJvChRXaGl0ZWxpc3RBZGRyZXNzRWRpdBIpLmJsb2NrbGlzdC52MS5XaGl0ZWxpc3RBZGRyZXNzRWRpdFJlcXVlc3QaKi5ibG9ja2xpc3QudjEuV2hpdGVsaXN0QWRkcmVzc0VkaXRSZXNwb25zZSIAElAKDldoaXRlbGlzdFN0ZWFtEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiQuYmxvY2tsaXN0LnYxLldoaXRlbGlzdFN0ZWFtUmVzcG9uc2UiABJbChRXaGl0ZWxpc3RTdGVhbURlbGV0ZRIpLmJsb2NrbGlzdC52MS5XaGl0ZWxpc3RTdGVhbURlbGV0ZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJvChRXaGl0ZWxpc3RTdGVhbUNyZWF0ZRIpLmJsb2NrbGlzdC52MS5XaGl0ZWxpc3RTdGVhbUNyZWF0ZVJlcXVlc3QaKi5ibG9ja2xpc3QudjEuV2hpdGVsaXN0U3RlYW1DcmVhdGVSZXNwb25zZSIAElEKCkNoZWNrQmxvY2sSHy5ibG9ja2xpc3QudjEuQ2hlY2tCbG9ja1JlcXVlc3QaIC5ibG9ja2xpc3QudjEuQ2hlY2tCbG9ja1Jlc3BvbnNlIgBCtgEKEGNvbS5ibG9ja2xpc3QudjFCDkJsb2NrbGlzdFByb3RvUAFaQWdpdGh1Yi5jb20vbGVpZ2htYWNkb25hbGQvZ2JhbnMvaW50ZXJuYWwvYmxvY2tsaXN0L3YxO2Jsb2NrbGlzdHYxogIDQlhYqgIMQmxvY2tsaXN0LlYxygIMQmxvY2tsaXN0XFYx4gIYQmxvY2tsaXN0XFYxXEdQQk1ldGFkYXRh6gINQmxvY2tsaXN0OjpWMWIIZWRpdGlvbnNw6Ac", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message blocklist.v1.WhitelistSteamCreateRequest
@@ -218,6 +218,13 @@ export type CheckBlockRequest = Message<"blocklist.v1.CheckBlockRequest"> & {
    * @generated from field: string address = 1;
    */
   address: string;
+
+  /**
+   * Optional steam id used to also check the steam whitelist.
+   *
+   * @generated from field: int64 steam_id = 2 [jstype = JS_STRING];
+   */
+  steamId: string;
 };
 
 /**
@@ -232,14 +239,42 @@ export const CheckBlockRequestSchema: GenMessage<CheckBlockRequest> = /*@__PURE_
  */
 export type CheckBlockResponse = Message<"blocklist.v1.CheckBlockResponse"> & {
   /**
+   * True when the address matched a source and was not whitelisted.
+   *
    * @generated from field: bool blocked = 1;
    */
   blocked: boolean;
 
   /**
+   * Name of the source containing the matched prefix.
+   *
    * @generated from field: string source = 2;
    */
   source: string;
+
+  /**
+   * @generated from field: int32 cidr_block_source_id = 3;
+   */
+  cidrBlockSourceId: number;
+
+  /**
+   * Most specific matching prefix.
+   *
+   * @generated from field: string prefix = 4;
+   */
+  prefix: string;
+
+  /**
+   * Matching address whitelist entry, if any.
+   *
+   * @generated from field: string whitelist_address = 5;
+   */
+  whitelistAddress: string;
+
+  /**
+   * @generated from field: bool whitelist_steam = 6;
+   */
+  whitelistSteam: boolean;
 };
 
 /**
@@ -458,6 +493,34 @@ export type CIDRBlockSource = Message<"blocklist.v1.CIDRBlockSource"> & {
    * @generated from field: google.protobuf.Timestamp updated_on = 6;
    */
   updatedOn?: Timestamp | undefined;
+
+  /**
+   * Time of the most recent sync attempt, unset if never synced.
+   *
+   * @generated from field: google.protobuf.Timestamp last_sync_on = 7;
+   */
+  lastSyncOn?: Timestamp | undefined;
+
+  /**
+   * Time of the most recent sync which completed without error.
+   *
+   * @generated from field: google.protobuf.Timestamp last_success_on = 8;
+   */
+  lastSuccessOn?: Timestamp | undefined;
+
+  /**
+   * Error text from the most recent sync attempt, empty on success.
+   *
+   * @generated from field: string last_error = 9;
+   */
+  lastError: string;
+
+  /**
+   * Number of ranges currently cached for this source.
+   *
+   * @generated from field: int32 entry_count = 10;
+   */
+  entryCount: number;
 };
 
 /**
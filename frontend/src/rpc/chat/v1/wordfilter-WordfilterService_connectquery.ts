@@ -33,3 +33,43 @@ export const filterDelete = WordfilterService.method.filterDelete;
  * @generated from rpc chat.v1.WordfilterService.FilterMatch
  */
 export const filterMatch = WordfilterService.method.filterMatch;
+
+/**
+ * Run a filter against the most recent messages to estimate how often it would trigger before enabling it.
+ *
+ * @generated from rpc chat.v1.WordfilterService.FilterTest
+ */
+export const filterTest = WordfilterService.method.filterTest;
+
+/**
+ * Full warning history of a player, including expired and pardoned warnings.
+ *
+ * @generated from rpc chat.v1.WordfilterService.Warnings
+ */
+export const warnings = WordfilterService.method.warnings;
+
+/**
+ * Stop a warning from counting towards the players total while keeping it in their history.
+ *
+ * @generated from rpc chat.v1.WordfilterService.WarningPardon
+ */
+export const warningPardon = WordfilterService.method.warningPardon;
+
+/**
+ * Remove all warnings of a player.
+ *
+ * @generated from rpc chat.v1.WordfilterService.WarningsClear
+ */
+export const warningsClear = WordfilterService.method.warningsClear;
+
+/**
+ * @generated from rpc chat.v1.WordfilterService.EscalationSteps
+ */
+export const escalationSteps = WordfilterService.method.escalationSteps;
+
+/**
+ * Replace the escalation steps.
+ *
+ * @generated from rpc chat.v1.WordfilterService.EscalationStepsSave
+ */
+export const escalationStepsSave = WordfilterService.method.escalationStepsSave;
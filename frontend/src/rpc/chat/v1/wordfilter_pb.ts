@@ -15,7 +15,140 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file chat/v1/wordfilter.proto.
  */
 export const file_chat_v1_wordfilter: GenFile = /*@__PURE__*/
-  fileDesc("ChhjaGF0L3YxL3dvcmRmaWx0ZXIucHJvdG8SB2NoYXQudjEiKwoSRmlsdGVyTWF0Y2hSZXF1ZXN0EhUKBXF1ZXJ5GAEgASgJQga6SAPIAQEiPwoTRmlsdGVyTWF0Y2hSZXNwb25zZRIoCgdmaWx0ZXJzGAEgAygLMg8uY2hhdC52MS5GaWx0ZXJCBrpIA8gBASIyChNGaWx0ZXJEZWxldGVSZXF1ZXN0EhsKCWZpbHRlcl9pZBgBIAEoA0IIMAG6SAPIAQEiPgoTRmlsdGVyQ3JlYXRlUmVxdWVzdBInCgZmaWx0ZXIYASABKAsyDy5jaGF0LnYxLkZpbHRlckIGukgDyAEBIj8KFEZpbHRlckNyZWF0ZVJlc3BvbnNlEicKBmZpbHRlchgBIAEoCzIPLmNoYXQudjEuRmlsdGVyQga6SAPIAQEiPAoRRmlsdGVyRWRpdFJlcXVlc3QSJwoGZmlsdGVyGAEgASgLMg8uY2hhdC52MS5GaWx0ZXJCBrpIA8gBASI9ChJGaWx0ZXJFZGl0UmVzcG9uc2USJwoGZmlsdGVyGAEgASgLMg8uY2hhdC52MS5GaWx0ZXJCBrpIA8gBASKDAwoGRmlsdGVyEhsKCWZpbHRlcl9pZBgBIAEoA0IIMAG6SAPIAQESGwoJYXV0aG9yX2lkGAIgASgDQggwAbpIA8gBARIXCgdwYXR0ZXJuGAMgASgJQga6SAPIAQESGAoIaXNfcmVnZXgYBCABKAhCBrpIA8gBARIaCgppc19lbmFibGVkGAUgASgIQga6SAPIAQESLQoGYWN0aW9uGAYgASgOMhUuY2hhdC52MS5GaWx0ZXJBY3Rpb25CBrpIA8gBARIYCghkdXJhdGlvbhgHIAEoCUIGukgDyAEBEh8KDXRyaWdnZXJfY291bnQYCCABKANCCDABukgDyAEBEhYKBndlaWdodBgJIAEoBUIGukgDyAEBEjYKCmNyZWF0ZWRfb24YCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESNgoKdXBkYXRlZF9vbhgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASI7Cg9GaWx0ZXJzUmVzcG9uc2USKAoHZmlsdGVycxgBIAMoCzIPLmNoYXQudjEuRmlsdGVyQga6SAPIAQEi+QIKC1VzZXJXYXJuaW5nEikKBnJlYXNvbhgBIAEoDjIRLmJhbi52MS5CYW5SZWFzb25CBrpIA8gBARIXCgdtZXNzYWdlGAIgASgJQga6SAPIAQESFwoHbWF0Y2hlZBgDIAEoCUIGukgDyAEBEicKBmZpbHRlchgEIAEoCzIPLmNoYXQudjEuRmlsdGVyQga6SAPIAQESNgoKY3JlYXRlZF9vbhgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIcCgxwZXJzb25hX25hbWUYBiABKAlCBrpIA8gBARIbCgthdmF0YXJfaGFzaBgHIAEoCUIGukgDyAEBEhsKC3NlcnZlcl9uYW1lGAggASgJQga6SAPIAQESGQoJc2VydmVyX2lkGAkgASgFQga6SAPIAQESGgoIc3RlYW1faWQYCiABKANCCDABukgDyAEBEh0KDWN1cnJlbnRfdG90YWwYCyABKAVCBrpIA8gBASJhChRXYXJuaW5nU3RhdGVSZXNwb25zZRIaCgptYXhfd2VpZ2h0GAEgASgFQga6SAPIAQESLQoHY3VycmVudBgCIAMoCzIULmNoYXQudjEuVXNlcldhcm5pbmdCBrpIA8gBASphCgxGaWx0ZXJBY3Rpb24SIgoeRklMVEVSX0FDVElPTl9LSUNLX1VOU1BFQ0lGSUVEEAASFgoSRklMVEVSX0FDVElPTl9NVVRFEAESFQoRRklMVEVSX0FDVElPTl9CQU4QAjLHAwoRV29yZGZpbHRlclNlcnZpY2USPQoHRmlsdGVycxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoYLmNoYXQudjEuRmlsdGVyc1Jlc3BvbnNlIgASRwoMV2FybmluZ1N0YXRlEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh0uY2hhdC52MS5XYXJuaW5nU3RhdGVSZXNwb25zZSIAEk0KDEZpbHRlckNyZWF0ZRIcLmNoYXQudjEuRmlsdGVyQ3JlYXRlUmVxdWVzdBodLmNoYXQudjEuRmlsdGVyQ3JlYXRlUmVzcG9uc2UiABJHCgpGaWx0ZXJFZGl0EhouY2hhdC52MS5GaWx0ZXJFZGl0UmVxdWVzdBobLmNoYXQudjEuRmlsdGVyRWRpdFJlc3BvbnNlIgASRgoMRmlsdGVyRGVsZXRlEhwuY2hhdC52MS5GaWx0ZXJEZWxldGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASSgoLRmlsdGVyTWF0Y2gSGy5jaGF0LnYxLkZpbHRlck1hdGNoUmVxdWVzdBocLmNoYXQudjEuRmlsdGVyTWF0Y2hSZXNwb25zZSIAQpQBCgtjb20uY2hhdC52MUIPV29yZGZpbHRlclByb3RvUAFaN2dpdGh1Yi5jb20vbGVpZ2htYWNkb25hbGQvZ2JhbnMvaW50ZXJuYWwvY2hhdC92MTtjaGF0djGiAgNDWFiqAgdDaGF0LlYxygIHQ2hhdFxWMeICE0NoYXRcVjFcR1BCTWV0YWRhdGHqAghDaGF0OjpWMWIIZWRpdGlvbnNw6Ac", [file_ban_v1_ban, file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+  fileDesc("ChhjaGF0L3YxL3dvcmRmaWx0ZXIucHJvdG8SB2NoYXQudjEiLQoPV2FybmluZ3NSZXF1ZXN0EhoKCHN0ZWFtX2lkGAEgASgDQggwAbpIA8gBASI6ChBXYXJuaW5nc1Jlc3BvbnNlEiYKCHdhcm5pbmdzGAEgAygLMhQuY2hhdC52MS5Vc2VyV2FybmluZyI0ChRXYXJuaW5nUGFyZG9uUmVxdWVzdBIcCgp3YXJuaW5nX2lkGAEgASgDQggwAbpIA8gBASIyChRXYXJuaW5nc0NsZWFyUmVxdWVzdBIaCghzdGVhbV9pZBgBIAEoA0IIMAG6SAPIAQEiZgoORXNjYWxhdGlvblN0ZXASFwoGd2VpZ2h0GAEgASgFQge6SAQaAiAAEikKBmFjdGlvbhgCIAEoDjIZLmNoYXQudjEuRXNjYWxhdGlvbkFjdGlvbhIQCghkdXJhdGlvbhgDIAEoCSJBChdFc2NhbGF0aW9uU3RlcHNSZXNwb25zZRImCgVzdGVwcxgBIAMoCzIXLmNoYXQudjEuRXNjYWxhdGlvblN0ZXAiRAoaRXNjYWxhdGlvblN0ZXBzU2F2ZVJlcXVlc3QSJgoFc3RlcHMYASADKAsyFy5jaGF0LnYxLkVzY2FsYXRpb25TdGVwIisKEkZpbHRlck1hdGNoUmVxdWVzdBIVCgVxdWVyeRgBIAEoCUIGukgDyAEBImUKE0ZpbHRlck1hdGNoUmVzcG9uc2USKAoHZmlsdGVycxgBIAMoCzIPLmNoYXQudjEuRmlsdGVyQga6SAPIAQESJAoHbWF0Y2hlcxgCIAMoCzITLmNoYXQudjEuRmlsdGVyU3BhbiJWCgpGaWx0ZXJTcGFuEhsKCWZpbHRlcl9pZBgBIAEoA0IIMAG6SAPIAQESDQoFc3RhcnQYAiABKAUSCwoDZW5kGAMgASgFEg8KB21hdGNoZWQYBCABKAkiWgoRRmlsdGVyVGVzdFJlcXVlc3QSJwoGZmlsdGVyGAEgASgLMg8uY2hhdC52MS5GaWx0ZXJCBrpIA8gBARIcCgVsaW1pdBgCIAEoBEINMAG6SAgyBhigjQYoASJ7ChBGaWx0ZXJUZXN0U2FtcGxlEh0KEXBlcnNvbl9tZXNzYWdlX2lkGAEgASgDQgIwARIUCghzdGVhbV9pZBgCIAEoA0ICMAESDAoEYm9keRgDIAEoCRIkCgdtYXRjaGVzGAQgAygLMhMuY2hhdC52MS5GaWx0ZXJTcGFuImoKEkZpbHRlclRlc3RSZXNwb25zZRITCgdjaGVja2VkGAEgASgDQgIwARITCgdtYXRjaGVkGAIgASgDQgIwARIqCgdzYW1wbGVzGAMgAygLMhkuY2hhdC52MS5GaWx0ZXJUZXN0U2FtcGxlIjIKE0ZpbHRlckRlbGV0ZVJlcXVlc3QSGwoJZmlsdGVyX2lkGAEgASgDQggwAbpIA8gBASI+ChNGaWx0ZXJDcmVhdGVSZXF1ZXN0EicKBmZpbHRlchgBIAEoCzIPLmNoYXQudjEuRmlsdGVyQga6SAPIAQEiPwoURmlsdGVyQ3JlYXRlUmVzcG9uc2USJwoGZmlsdGVyGAEgASgLMg8uY2hhdC52MS5GaWx0ZXJCBrpIA8gBASI8ChFGaWx0ZXJFZGl0UmVxdWVzdBInCgZmaWx0ZXIYASABKAsyDy5jaGF0LnYxLkZpbHRlckIGukgDyAEBIj0KEkZpbHRlckVkaXRSZXNwb25zZRInCgZmaWx0ZXIYASABKAsyDy5jaGF0LnYxLkZpbHRlckIGukgDyAEBIoMDCgZGaWx0ZXISGwoJZmlsdGVyX2lkGAEgASgDQggwAbpIA8gBARIbCglhdXRob3JfaWQYAiABKANCCDABukgDyAEBEhcKB3BhdHRlcm4YAyABKAlCBrpIA8gBARIYCghpc19yZWdleBgEIAEoCEIGukgDyAEBEhoKCmlzX2VuYWJsZWQYBSABKAhCBrpIA8gBARItCgZhY3Rpb24YBiABKA4yFS5jaGF0LnYxLkZpbHRlckFjdGlvbkIGukgDyAEBEhgKCGR1cmF0aW9uGAcgASgJQga6SAPIAQESHwoNdHJpZ2dlcl9jb3VudBgIIAEoA0IIMAG6SAPIAQESFgoGd2VpZ2h0GAkgASgFQga6SAPIAQESNgoKY3JlYXRlZF9vbhgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARI2Cgp1cGRhdGVkX29uGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBIjsKD0ZpbHRlcnNSZXNwb25zZRIoCgdmaWx0ZXJzGAEgAygLMg8uY2hhdC52MS5GaWx0ZXJCBrpIA8gBASL9AwoLVXNlcldhcm5pbmcSKQoGcmVhc29uGAEgASgOMhEuYmFuLnYxLkJhblJlYXNvbkIGukgDyAEBEhcKB21lc3NhZ2UYAiABKAlCBrpIA8gBARIXCgdtYXRjaGVkGAMgASgJQga6SAPIAQESJwoGZmlsdGVyGAQgASgLMg8uY2hhdC52MS5GaWx0ZXJCBrpIA8gBARI2CgpjcmVhdGVkX29uGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEhwKDHBlcnNvbmFfbmFtZRgGIAEoCUIGukgDyAEBEhsKC2F2YXRhcl9oYXNoGAcgASgJQga6SAPIAQESGwoLc2VydmVyX25hbWUYCCABKAlCBrpIA8gBARIZCglzZXJ2ZXJfaWQYCSABKAVCBrpIA8gBARIaCghzdGVhbV9pZBgKIAEoA0IIMAG6SAPIAQESHQoNY3VycmVudF90b3RhbBgLIAEoBUIGukgDyAEBEhYKCndhcm5pbmdfaWQYDCABKANCAjABEh0KEXBlcnNvbl9tZXNzYWdlX2lkGA0gASgDQgIwARIOCgZ3ZWlnaHQYDiABKAUSKQoGYWN0aW9uGA8gASgOMhkuY2hhdC52MS5Fc2NhbGF0aW9uQWN0aW9uEhAKCHBhcmRvbmVkGBAgASgIIlcKFFdhcm5pbmdTdGF0ZVJlc3BvbnNlEi0KB2N1cnJlbnQYAiADKAsyFC5jaGF0LnYxLlVzZXJXYXJuaW5nQga6SAPIAQFKBAgBEAJSCm1heF93ZWlnaHQqqAEKEEVzY2FsYXRpb25BY3Rpb24SJgoiRVNDQUxBVElPTl9BQ1RJT05fV0FSTl9VTlNQRUNJRklFRBAAEhkKFUVTQ0FMQVRJT05fQUNUSU9OX0dBRxABEhoKFkVTQ0FMQVRJT05fQUNUSU9OX0tJQ0sQAhIaChZFU0NBTEFUSU9OX0FDVElPTl9NVVRFEAMSGQoVRVNDQUxBVElPTl9BQ1RJT05fQkFOEAQqYQoMRmlsdGVyQWN0aW9uEiIKHkZJTFRFUl9BQ1RJT05fS0lDS19VTlNQRUNJRklFRBAAEhYKEkZJTFRFUl9BQ1RJT05fTVVURRABEhUKEUZJTFRFUl9BQ1RJT05fQkFOEAIylgcKEVdvcmRmaWx0ZXJTZXJ2aWNlEj0KB0ZpbHRlcnMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGC5jaGF0LnYxLkZpbHRlcnNSZXNwb25zZSIAEkcKDFdhcm5pbmdTdGF0ZRIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRodLmNoYXQudjEuV2FybmluZ1N0YXRlUmVzcG9uc2UiABJNCgxGaWx0ZXJDcmVhdGUSHC5jaGF0LnYxLkZpbHRlckNyZWF0ZVJlcXVlc3QaHS5jaGF0LnYxLkZpbHRlckNyZWF0ZVJlc3BvbnNlIgASRwoKRmlsdGVyRWRpdBIaLmNoYXQudjEuRmlsdGVyRWRpdFJlcXVlc3QaGy5jaGF0LnYxLkZpbHRlckVkaXRSZXNwb25zZSIAEkYKDEZpbHRlckRlbGV0ZRIcLmNoYXQudjEuRmlsdGVyRGVsZXRlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEkoKC0ZpbHRlck1hdGNoEhsuY2hhdC52MS5GaWx0ZXJNYXRjaFJlcXVlc3QaHC5jaGF0LnYxLkZpbHRlck1hdGNoUmVzcG9uc2UiABJHCgpGaWx0ZXJUZXN0EhouY2hhdC52MS5GaWx0ZXJUZXN0UmVxdWVzdBobLmNoYXQudjEuRmlsdGVyVGVzdFJlc3BvbnNlIgASQQoIV2FybmluZ3MSGC5jaGF0LnYxLldhcm5pbmdzUmVxdWVzdBoZLmNoYXQudjEuV2FybmluZ3NSZXNwb25zZSIAEkgKDVdhcm5pbmdQYXJkb24SHS5jaGF0LnYxLldhcm5pbmdQYXJkb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5IgASSAoNV2FybmluZ3NDbGVhchIdLmNoYXQudjEuV2FybmluZ3NDbGVhclJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJNCg9Fc2NhbGF0aW9uU3RlcHMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaIC5jaGF0LnYxLkVzY2FsYXRpb25TdGVwc1Jlc3BvbnNlIgASXgoTRXNjYWxhdGlvblN0ZXBzU2F2ZRIjLmNoYXQudjEuRXNjYWxhdGlvblN0ZXBzU2F2ZVJlcXVlc3QaIC5jaGF0LnYxLkVzY2FsYXRpb25TdGVwc1Jlc3BvbnNlIgBClAEKC2NvbS5jaGF0LnYxQg9Xb3JkZmlsdGVyUHJvdG9QAVo3Z2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9jaGF0L3YxO2NoYXR2MaICA0NYWKoCB0NoYXQuVjHKAgdDaGF0XFYx4gITQ2hhdFxWMVxHUEJNZXRhZGF0YeoCCENoYXQ6OlYxYghlZGl0aW9uc3DoBw", [file_ban_v1_ban, file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message chat.v1.WarningsRequest
+ */
+export type WarningsRequest = Message<"chat.v1.WarningsRequest"> & {
+  /**
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+};
+
+/**
+ * Describes the message chat.v1.WarningsRequest.
+ * Use `create(WarningsRequestSchema)` to create a new message.
+ */
+export const WarningsRequestSchema: GenMessage<WarningsRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 0);
+
+/**
+ * @generated from message chat.v1.WarningsResponse
+ */
+export type WarningsResponse = Message<"chat.v1.WarningsResponse"> & {
+  /**
+   * @generated from field: repeated chat.v1.UserWarning warnings = 1;
+   */
+  warnings: UserWarning[];
+};
+
+/**
+ * Describes the message chat.v1.WarningsResponse.
+ * Use `create(WarningsResponseSchema)` to create a new message.
+ */
+export const WarningsResponseSchema: GenMessage<WarningsResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 1);
+
+/**
+ * @generated from message chat.v1.WarningPardonRequest
+ */
+export type WarningPardonRequest = Message<"chat.v1.WarningPardonRequest"> & {
+  /**
+   * @generated from field: int64 warning_id = 1 [jstype = JS_STRING];
+   */
+  warningId: string;
+};
+
+/**
+ * Describes the message chat.v1.WarningPardonRequest.
+ * Use `create(WarningPardonRequestSchema)` to create a new message.
+ */
+export const WarningPardonRequestSchema: GenMessage<WarningPardonRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 2);
+
+/**
+ * @generated from message chat.v1.WarningsClearRequest
+ */
+export type WarningsClearRequest = Message<"chat.v1.WarningsClearRequest"> & {
+  /**
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+};
+
+/**
+ * Describes the message chat.v1.WarningsClearRequest.
+ * Use `create(WarningsClearRequestSchema)` to create a new message.
+ */
+export const WarningsClearRequestSchema: GenMessage<WarningsClearRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 3);
+
+/**
+ * Applied once the decayed total weight of a players warnings reaches the weight.
+ *
+ * @generated from message chat.v1.EscalationStep
+ */
+export type EscalationStep = Message<"chat.v1.EscalationStep"> & {
+  /**
+   * @generated from field: int32 weight = 1;
+   */
+  weight: number;
+
+  /**
+   * @generated from field: chat.v1.EscalationAction action = 2;
+   */
+  action: EscalationAction;
+
+  /**
+   * Duration of mute and ban actions.
+   *
+   * @generated from field: string duration = 3;
+   */
+  duration: string;
+};
+
+/**
+ * Describes the message chat.v1.EscalationStep.
+ * Use `create(EscalationStepSchema)` to create a new message.
+ */
+export const EscalationStepSchema: GenMessage<EscalationStep> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 4);
+
+/**
+ * @generated from message chat.v1.EscalationStepsResponse
+ */
+export type EscalationStepsResponse = Message<"chat.v1.EscalationStepsResponse"> & {
+  /**
+   * @generated from field: repeated chat.v1.EscalationStep steps = 1;
+   */
+  steps: EscalationStep[];
+};
+
+/**
+ * Describes the message chat.v1.EscalationStepsResponse.
+ * Use `create(EscalationStepsResponseSchema)` to create a new message.
+ */
+export const EscalationStepsResponseSchema: GenMessage<EscalationStepsResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 5);
+
+/**
+ * @generated from message chat.v1.EscalationStepsSaveRequest
+ */
+export type EscalationStepsSaveRequest = Message<"chat.v1.EscalationStepsSaveRequest"> & {
+  /**
+   * @generated from field: repeated chat.v1.EscalationStep steps = 1;
+   */
+  steps: EscalationStep[];
+};
+
+/**
+ * Describes the message chat.v1.EscalationStepsSaveRequest.
+ * Use `create(EscalationStepsSaveRequestSchema)` to create a new message.
+ */
+export const EscalationStepsSaveRequestSchema: GenMessage<EscalationStepsSaveRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 6);
 
 /**
  * @generated from message chat.v1.FilterMatchRequest
@@ -32,7 +165,7 @@ export type FilterMatchRequest = Message<"chat.v1.FilterMatchRequest"> & {
  * Use `create(FilterMatchRequestSchema)` to create a new message.
  */
 export const FilterMatchRequestSchema: GenMessage<FilterMatchRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 0);
+  messageDesc(file_chat_v1_wordfilter, 7);
 
 /**
  * @generated from message chat.v1.FilterMatchResponse
@@ -42,6 +175,11 @@ export type FilterMatchResponse = Message<"chat.v1.FilterMatchResponse"> & {
    * @generated from field: repeated chat.v1.Filter filters = 1;
    */
   filters: Filter[];
+
+  /**
+   * @generated from field: repeated chat.v1.FilterSpan matches = 2;
+   */
+  matches: FilterSpan[];
 };
 
 /**
@@ -49,7 +187,122 @@ export type FilterMatchResponse = Message<"chat.v1.FilterMatchResponse"> & {
  * Use `create(FilterMatchResponseSchema)` to create a new message.
  */
 export const FilterMatchResponseSchema: GenMessage<FilterMatchResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 1);
+  messageDesc(file_chat_v1_wordfilter, 8);
+
+/**
+ * A single match of a filter. Offsets are byte offsets of the original message.
+ *
+ * @generated from message chat.v1.FilterSpan
+ */
+export type FilterSpan = Message<"chat.v1.FilterSpan"> & {
+  /**
+   * @generated from field: int64 filter_id = 1 [jstype = JS_STRING];
+   */
+  filterId: string;
+
+  /**
+   * @generated from field: int32 start = 2;
+   */
+  start: number;
+
+  /**
+   * @generated from field: int32 end = 3;
+   */
+  end: number;
+
+  /**
+   * @generated from field: string matched = 4;
+   */
+  matched: string;
+};
+
+/**
+ * Describes the message chat.v1.FilterSpan.
+ * Use `create(FilterSpanSchema)` to create a new message.
+ */
+export const FilterSpanSchema: GenMessage<FilterSpan> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 9);
+
+/**
+ * @generated from message chat.v1.FilterTestRequest
+ */
+export type FilterTestRequest = Message<"chat.v1.FilterTestRequest"> & {
+  /**
+   * @generated from field: chat.v1.Filter filter = 1;
+   */
+  filter?: Filter | undefined;
+
+  /**
+   * @generated from field: uint64 limit = 2 [jstype = JS_STRING];
+   */
+  limit: string;
+};
+
+/**
+ * Describes the message chat.v1.FilterTestRequest.
+ * Use `create(FilterTestRequestSchema)` to create a new message.
+ */
+export const FilterTestRequestSchema: GenMessage<FilterTestRequest> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 10);
+
+/**
+ * @generated from message chat.v1.FilterTestSample
+ */
+export type FilterTestSample = Message<"chat.v1.FilterTestSample"> & {
+  /**
+   * @generated from field: int64 person_message_id = 1 [jstype = JS_STRING];
+   */
+  personMessageId: string;
+
+  /**
+   * @generated from field: int64 steam_id = 2 [jstype = JS_STRING];
+   */
+  steamId: string;
+
+  /**
+   * @generated from field: string body = 3;
+   */
+  body: string;
+
+  /**
+   * @generated from field: repeated chat.v1.FilterSpan matches = 4;
+   */
+  matches: FilterSpan[];
+};
+
+/**
+ * Describes the message chat.v1.FilterTestSample.
+ * Use `create(FilterTestSampleSchema)` to create a new message.
+ */
+export const FilterTestSampleSchema: GenMessage<FilterTestSample> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 11);
+
+/**
+ * @generated from message chat.v1.FilterTestResponse
+ */
+export type FilterTestResponse = Message<"chat.v1.FilterTestResponse"> & {
+  /**
+   * @generated from field: int64 checked = 1 [jstype = JS_STRING];
+   */
+  checked: string;
+
+  /**
+   * @generated from field: int64 matched = 2 [jstype = JS_STRING];
+   */
+  matched: string;
+
+  /**
+   * @generated from field: repeated chat.v1.FilterTestSample samples = 3;
+   */
+  samples: FilterTestSample[];
+};
+
+/**
+ * Describes the message chat.v1.FilterTestResponse.
+ * Use `create(FilterTestResponseSchema)` to create a new message.
+ */
+export const FilterTestResponseSchema: GenMessage<FilterTestResponse> = /*@__PURE__*/
+  messageDesc(file_chat_v1_wordfilter, 12);
 
 /**
  * @generated from message chat.v1.FilterDeleteRequest
@@ -66,7 +319,7 @@ export type FilterDeleteRequest = Message<"chat.v1.FilterDeleteRequest"> & {
  * Use `create(FilterDeleteRequestSchema)` to create a new message.
  */
 export const FilterDeleteRequestSchema: GenMessage<FilterDeleteRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 2);
+  messageDesc(file_chat_v1_wordfilter, 13);
 
 /**
  * @generated from message chat.v1.FilterCreateRequest
@@ -83,7 +336,7 @@ export type FilterCreateRequest = Message<"chat.v1.FilterCreateRequest"> & {
  * Use `create(FilterCreateRequestSchema)` to create a new message.
  */
 export const FilterCreateRequestSchema: GenMessage<FilterCreateRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 3);
+  messageDesc(file_chat_v1_wordfilter, 14);
 
 /**
  * @generated from message chat.v1.FilterCreateResponse
@@ -100,7 +353,7 @@ export type FilterCreateResponse = Message<"chat.v1.FilterCreateResponse"> & {
  * Use `create(FilterCreateResponseSchema)` to create a new message.
  */
 export const FilterCreateResponseSchema: GenMessage<FilterCreateResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 4);
+  messageDesc(file_chat_v1_wordfilter, 15);
 
 /**
  * @generated from message chat.v1.FilterEditRequest
@@ -117,7 +370,7 @@ export type FilterEditRequest = Message<"chat.v1.FilterEditRequest"> & {
  * Use `create(FilterEditRequestSchema)` to create a new message.
  */
 export const FilterEditRequestSchema: GenMessage<FilterEditRequest> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 5);
+  messageDesc(file_chat_v1_wordfilter, 16);
 
 /**
  * @generated from message chat.v1.FilterEditResponse
@@ -134,7 +387,7 @@ export type FilterEditResponse = Message<"chat.v1.FilterEditResponse"> & {
  * Use `create(FilterEditResponseSchema)` to create a new message.
  */
 export const FilterEditResponseSchema: GenMessage<FilterEditResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 6);
+  messageDesc(file_chat_v1_wordfilter, 17);
 
 /**
  * @generated from message chat.v1.Filter
@@ -201,7 +454,7 @@ export type Filter = Message<"chat.v1.Filter"> & {
  * Use `create(FilterSchema)` to create a new message.
  */
 export const FilterSchema: GenMessage<Filter> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 7);
+  messageDesc(file_chat_v1_wordfilter, 18);
 
 /**
  * @generated from message chat.v1.FiltersResponse
@@ -218,7 +471,7 @@ export type FiltersResponse = Message<"chat.v1.FiltersResponse"> & {
  * Use `create(FiltersResponseSchema)` to create a new message.
  */
 export const FiltersResponseSchema: GenMessage<FiltersResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 8);
+  messageDesc(file_chat_v1_wordfilter, 19);
 
 /**
  * @generated from message chat.v1.UserWarning
@@ -278,6 +531,33 @@ export type UserWarning = Message<"chat.v1.UserWarning"> & {
    * @generated from field: int32 current_total = 11;
    */
   currentTotal: number;
+
+  /**
+   * @generated from field: int64 warning_id = 12 [jstype = JS_STRING];
+   */
+  warningId: string;
+
+  /**
+   * @generated from field: int64 person_message_id = 13 [jstype = JS_STRING];
+   */
+  personMessageId: string;
+
+  /**
+   * @generated from field: int32 weight = 14;
+   */
+  weight: number;
+
+  /**
+   * Unset when no escalation step was applied.
+   *
+   * @generated from field: chat.v1.EscalationAction action = 15;
+   */
+  action: EscalationAction;
+
+  /**
+   * @generated from field: bool pardoned = 16;
+   */
+  pardoned: boolean;
 };
 
 /**
@@ -285,17 +565,12 @@ export type UserWarning = Message<"chat.v1.UserWarning"> & {
  * Use `create(UserWarningSchema)` to create a new message.
  */
 export const UserWarningSchema: GenMessage<UserWarning> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 9);
+  messageDesc(file_chat_v1_wordfilter, 20);
 
 /**
  * @generated from message chat.v1.WarningStateResponse
  */
 export type WarningStateResponse = Message<"chat.v1.WarningStateResponse"> & {
-  /**
-   * @generated from field: int32 max_weight = 1;
-   */
-  maxWeight: number;
-
   /**
    * @generated from field: repeated chat.v1.UserWarning current = 2;
    */
@@ -307,7 +582,45 @@ export type WarningStateResponse = Message<"chat.v1.WarningStateResponse"> & {
  * Use `create(WarningStateResponseSchema)` to create a new message.
  */
 export const WarningStateResponseSchema: GenMessage<WarningStateResponse> = /*@__PURE__*/
-  messageDesc(file_chat_v1_wordfilter, 10);
+  messageDesc(file_chat_v1_wordfilter, 21);
+
+/**
+ * Ordered from least to most severe.
+ *
+ * @generated from enum chat.v1.EscalationAction
+ */
+export enum EscalationAction {
+  /**
+   * @generated from enum value: ESCALATION_ACTION_WARN_UNSPECIFIED = 0;
+   */
+  WARN_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ESCALATION_ACTION_GAG = 1;
+   */
+  GAG = 1,
+
+  /**
+   * @generated from enum value: ESCALATION_ACTION_KICK = 2;
+   */
+  KICK = 2,
+
+  /**
+   * @generated from enum value: ESCALATION_ACTION_MUTE = 3;
+   */
+  MUTE = 3,
+
+  /**
+   * @generated from enum value: ESCALATION_ACTION_BAN = 4;
+   */
+  BAN = 4,
+}
+
+/**
+ * Describes the enum chat.v1.EscalationAction.
+ */
+export const EscalationActionSchema: GenEnum<EscalationAction> = /*@__PURE__*/
+  enumDesc(file_chat_v1_wordfilter, 0);
 
 /**
  * @generated from enum chat.v1.FilterAction
@@ -333,7 +646,7 @@ export enum FilterAction {
  * Describes the enum chat.v1.FilterAction.
  */
 export const FilterActionSchema: GenEnum<FilterAction> = /*@__PURE__*/
-  enumDesc(file_chat_v1_wordfilter, 0);
+  enumDesc(file_chat_v1_wordfilter, 1);
 
 /**
  * @generated from service chat.v1.WordfilterService
@@ -387,6 +700,64 @@ export const WordfilterService: GenService<{
     input: typeof FilterMatchRequestSchema;
     output: typeof FilterMatchResponseSchema;
   },
+  /**
+   * Run a filter against the most recent messages to estimate how often it would trigger before enabling it.
+   *
+   * @generated from rpc chat.v1.WordfilterService.FilterTest
+   */
+  filterTest: {
+    methodKind: "unary";
+    input: typeof FilterTestRequestSchema;
+    output: typeof FilterTestResponseSchema;
+  },
+  /**
+   * Full warning history of a player, including expired and pardoned warnings.
+   *
+   * @generated from rpc chat.v1.WordfilterService.Warnings
+   */
+  warnings: {
+    methodKind: "unary";
+    input: typeof WarningsRequestSchema;
+    output: typeof WarningsResponseSchema;
+  },
+  /**
+   * Stop a warning from counting towards the players total while keeping it in their history.
+   *
+   * @generated from rpc chat.v1.WordfilterService.WarningPardon
+   */
+  warningPardon: {
+    methodKind: "unary";
+    input: typeof WarningPardonRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Remove all warnings of a player.
+   *
+   * @generated from rpc chat.v1.WordfilterService.WarningsClear
+   */
+  warningsClear: {
+    methodKind: "unary";
+    input: typeof WarningsClearRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc chat.v1.WordfilterService.EscalationSteps
+   */
+  escalationSteps: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof EscalationStepsResponseSchema;
+  },
+  /**
+   * Replace the escalation steps.
+   *
+   * @generated from rpc chat.v1.WordfilterService.EscalationStepsSave
+   */
+  escalationStepsSave: {
+    methodKind: "unary";
+    input: typeof EscalationStepsSaveRequestSchema;
+    output: typeof EscalationStepsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_chat_v1_wordfilter, 0);
 
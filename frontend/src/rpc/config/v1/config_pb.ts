@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file config/v1/config.proto.
  */
 export const file_config_v1_config: GenFile = /*@__PURE__*/
-  fileDesc("ChZjb25maWcvdjEvY29uZmlnLnByb3RvEgljb25maWcudjEiSAoRQ2hhbmdlbG9nUmVzcG9uc2USMwoJY2hhbmdlbG9nGAEgAygLMhguY29uZmlnLnYxLkdpdGh1YlJlbGVhc2VCBrpIA8gBASL9BQoMSW5mb1Jlc3BvbnNlEhkKCXNpdGVfbmFtZRgBIAEoCUIGukgDyAEBEiAKEHNpdGVfZGVzY3JpcHRpb24YAiABKAlCBrpIA8gBARIZCglhc3NldF91cmwYAyABKAlCBrpIA8gBARIXCgdmYXZpY29uGAQgASgJQga6SAPIAQESFwoHbGlua19pZBgFIAEoCUIGukgDyAEBEhsKC2FwcF92ZXJzaW9uGAYgASgJQga6SAPIAQESHgoOc2VudHJ5X2Rzbl93ZWIYByABKAlCBrpIA8gBARIfCg9kb2N1bWVudF9wb2xpY3kYCCABKAlCBrpIA8gBARIhChFwYXRyZW9uX2NsaWVudF9pZBgJIAEoCUIGukgDyAEBEiEKEWRpc2NvcmRfY2xpZW50X2lkGAogASgJQga6SAPIAQESHwoPZGlzY29yZF9lbmFibGVkGAsgASgIQga6SAPIAQESHwoPcGF0cmVvbl9lbmFibGVkGAwgASgIQga6SAPIAQESHQoNZGVmYXVsdF9yb3V0ZRgNIAEoCUIGukgDyAEBEhwKDG5ld3NfZW5hYmxlZBgOIAEoCEIGukgDyAEBEiAKEGNvbnRlc3RzX2VuYWJsZWQYDyABKAhCBrpIA8gBARIcCgx3aWtpX2VuYWJsZWQYECABKAhCBrpIA8gBARIdCg1zdGF0c19lbmFibGVkGBEgASgIQga6SAPIAQESHwoPc2VydmVyc19lbmFibGVkGBIgASgIQga6SAPIAQESHwoPcmVwb3J0c19lbmFibGVkGBMgASgIQga6SAPIAQESIAoQY2hhdGxvZ3NfZW5hYmxlZBgUIAEoCEIGukgDyAEBEh0KDWRlbW9zX2VuYWJsZWQYFSABKAhCBrpIA8gBARIhChFzcGVlZHJ1bnNfZW5hYmxlZBgWIAEoCEIGukgDyAEBEh4KDmZvcnVtc19lbmFibGVkGBcgASgIQga6SAPIAQESGwoLbWdlX2VuYWJsZWQYGCABKAhCBrpIA8gBASI4CgtHZXRSZXNwb25zZRIpCgZjb25maWcYASABKAsyES5jb25maWcudjEuQ29uZmlnQga6SAPIAQEiOgoNVXBkYXRlUmVxdWVzdBIpCgZjb25maWcYASABKAsyES5jb25maWcudjEuQ29uZmlnQga6SAPIAQEiOwoOVXBkYXRlUmVzcG9uc2USKQoGY29uZmlnGAEgASgLMhEuY29uZmlnLnYxLkNvbmZpZ0IGukgDyAEBIsQFCgdHZW5lcmFsEhkKCXNpdGVfbmFtZRgBIAEoCUIGukgDyAEBEiAKEHNpdGVfZGVzY3JpcHRpb24YAiABKAlCBrpIA8gBARItCgRtb2RlGAMgASgOMhIuY29uZmlnLnYxLlJ1bk1vZGVCC7pICMgBAYIBAhABEj4KD2ZpbGVfc2VydmVfbW9kZRgEIAEoDjIYLmNvbmZpZy52MS5GaWxlU2VydmVNb2RlQgu6SAjIAQGCAQIQARIeCg5zcmNkc19sb2dfYWRkchgFIAEoCUIGukgDyAEBEhkKCWFzc2V0X3VybBgGIAEoCUIGukgDyAEBEhcKB2Zhdmljb24YByABKAlCBrpIA8gBARIdCg1kZWZhdWx0X3JvdXRlGAggASgJQga6SAPIAQESHAoMbmV3c19lbmFibGVkGAkgASgIQga6SAPIAQESHgoOZm9ydW1zX2VuYWJsZWQYCiABKAhCBrpIA8gBARIgChBjb250ZXN0c19lbmFibGVkGAsgASgIQga6SAPIAQESHAoMd2lraV9lbmFibGVkGAwgASgIQga6SAPIAQESHQoNc3RhdHNfZW5hYmxlZBgNIAEoCEIGukgDyAEBEh8KD3NlcnZlcnNfZW5hYmxlZBgOIAEoCEIGukgDyAEBEh8KD3JlcG9ydHNfZW5hYmxlZBgPIAEoCEIGukgDyAEBEiAKEGNoYXRsb2dzX2VuYWJsZWQYECABKAhCBrpIA8gBARIdCg1kZW1vc19lbmFibGVkGBEgASgIQga6SAPIAQESIQoRc3BlZWRydW5zX2VuYWJsZWQYEiABKAhCBrpIA8gBARIbCgttZ2VfZW5hYmxlZBgTIAEoCEIGukgDyAEBEhoKCnNlbnRyeV9kc24YFCABKAlCBrpIA8gBARIeCg5zZW50cnlfZHNuX3dlYhgVIAEoCUIGukgDyAEBIlYKBURlYnVnEicKF3NraXBfb3Blbl9pZF92YWxpZGF0aW9uGAEgASgIQga6SAPIAQESJAoUYWRkX3Jjb25fbG9nX2FkZHJlc3MYAiABKAlCBrpIA8gBASLaAQoERGVtbxIfCg9jbGVhbnVwX2VuYWJsZWQYASABKAhCBrpIA8gBARI2CghzdHJhdGVneRgCIAEoDjIXLmNvbmZpZy52MS5EZW1vU3RyYXRlZ3lCC7pICMgBAYIBAhABEh8KD2NsZWFudXBfbWluX3BjdBgDIAEoAkIGukgDyAEBEh0KDWNsZWFudXBfbW91bnQYBCABKAlCBrpIA8gBARIdCgtjb3VudF9saW1pdBgFIAEoA0IIMAG6SAPIAQESGgoKcGFyc2VyX3VybBgGIAEoCUIGukgDyAEBIu8BCgdGaWx0ZXJzEhcKB2VuYWJsZWQYASABKAhCBrpIA8gBARIfCg93YXJuaW5nX3RpbWVvdXQYAiABKAVCBrpIA8gBARIdCg13YXJuaW5nX2xpbWl0GAMgASgFQga6SAPIAQESEwoDZHJ5GAQgASgIQga6SAPIAQESHAoMcGluZ19kaXNjb3JkGAUgASgIQga6SAPIAQESGgoKbWF4X3dlaWdodBgGIAEoBUIGukgDyAEBEh0KDWNoZWNrX3RpbWVvdXQYByABKAVCBrpIA8gBARIdCg1tYXRjaF90aW1lb3V0GAggASgFQga6SAPIAQEi0AUKB0Rpc2NvcmQSFwoHZW5hYmxlZBgBIAEoCEIGukgDyAEBEhsKC2JvdF9lbmFibGVkGAIgASgIQga6SAPIAQESJAoUaW50ZWdyYXRpb25zX2VuYWJsZWQYAyABKAhCBrpIA8gBARIWCgZhcHBfaWQYBCABKAlCBrpIA8gBARIaCgphcHBfc2VjcmV0GAUgASgJQga6SAPIAQESFwoHbGlua19pZBgGIAEoCUIGukgDyAEBEhUKBXRva2VuGAcgASgJQga6SAPIAQESGAoIZ3VpbGRfaWQYCCABKAlCBrpIA8gBARIpChlwdWJsaWNfbG9nX2NoYW5uZWxfZW5hYmxlGAkgASgIQga6SAPIAQESHgoObG9nX2NoYW5uZWxfaWQYCiABKAlCBrpIA8gBARIrChtwdWJsaWNfbWF0Y2hfbG9nX2NoYW5uZWxfaWQYCyABKAlCBrpIA8gBARIjChN2b3RlX2xvZ19jaGFubmVsX2lkGAwgASgJQga6SAPIAQESJQoVYXBwZWFsX2xvZ19jaGFubmVsX2lkGA0gASgJQga6SAPIAQESIgoSYmFuX2xvZ19jaGFubmVsX2lkGA4gASgJQga6SAPIAQESJAoUZm9ydW1fbG9nX2NoYW5uZWxfaWQYDyABKAlCBrpIA8gBARIjChNraWNrX2xvZ19jaGFubmVsX2lkGBAgASgJQga6SAPIAQESIAoQbW9kX3Bpbmdfcm9sZV9pZBgRIAEoCUIGukgDyAEBEiQKFGFudGljaGVhdF9jaGFubmVsX2lkGBIgASgJQga6SAPIAQESHwoPc2VlZF9jaGFubmVsX2lkGBMgASgJQga6SAPIAQESKgoad29yZF9maWx0ZXJfbG9nX2NoYW5uZWxfaWQYFCABKAlCBrpIA8gBARIjChNjaGF0X2xvZ19jaGFubmVsX2lkGBUgASgJQga6SAPIAQEiLwoJU291cmNlbW9kEiIKEmNlbnRlcl9wcm9qZWN0aWxlcxgBIAEoCEIGukgDyAEBIr0BCgNMb2cSLAoFbGV2ZWwYASABKA4yEC5jb25maWcudjEuTGV2ZWxCC7pICMgBAYIBAhABEhQKBGZpbGUYAiABKAlCBrpIA8gBARIcCgxodHRwX2VuYWJsZWQYAyABKAhCBrpIA8gBARIhChFodHRwX290ZWxfZW5hYmxlZBgEIAEoCEIGukgDyAEBEjEKCmh0dHBfbGV2ZWwYBSABKA4yEC5jb25maWcudjEuTGV2ZWxCC7pICMgBAYIBAhABIlkKC0dlb0xvY2F0aW9uEhcKB2VuYWJsZWQYASABKAhCBrpIA8gBARIaCgpjYWNoZV9wYXRoGAIgASgJQga6SAPIAQESFQoFdG9rZW4YAyABKAlCBrpIA8gBASLPAQoHUGF0cmVvbhIXCgdlbmFibGVkGAEgASgIQga6SAPIAQESJAoUaW50ZWdyYXRpb25zX2VuYWJsZWQYAiABKAhCBrpIA8gBARIZCgljbGllbnRfaWQYAyABKAlCBrpIA8gBARIdCg1jbGllbnRfc2VjcmV0GAQgASgJQga6SAPIAQESJAoUY3JlYXRvcl9hY2Nlc3NfdG9rZW4YBSABKAlCBrpIA8gBARIlChVjcmVhdG9yX3JlZnJlc2hfdG9rZW4YBiABKAlCBrpIA8gBASLGAgoDU1NIEhcKB2VuYWJsZWQYASABKAhCBrpIA8gBARIYCgh1c2VybmFtZRgCIAEoCUIGukgDyAEBEhQKBHBvcnQYAyABKAVCBrpIA8gBARIgChBwcml2YXRlX2tleV9wYXRoGAQgASgJQga6SAPIAQESQgoRaG9zdF9rZXlfc3RyYXRlZ3kYBSABKA4yGi5jb25maWcudjEuSG9zdEtleVN0cmF0ZWd5Qgu6SAjIAQGCAQIQARIYCghwYXNzd29yZBgGIAEoCUIGukgDyAEBEh8KD3VwZGF0ZV9pbnRlcnZhbBgHIAEoBUIGukgDyAEBEhcKB3RpbWVvdXQYCCABKAVCBrpIA8gBARIdCg1kZW1vX3BhdGhfZm10GAkgASgJQga6SAPIAQESHQoNc3RhY19wYXRoX2ZtdBgKIAEoCUIGukgDyAEBIiYKB05ldHdvcmsSGwoLc2RyX2VuYWJsZWQYASABKAhCBrpIA8gBASInCgpMb2NhbFN0b3JlEhkKCXBhdGhfcm9vdBgBIAEoCUIGukgDyAEBImUKB0V4cG9ydHMSGgoKYmRfZW5hYmxlZBgBIAEoCEIGukgDyAEBEh0KDXZhbHZlX2VuYWJsZWQYAiABKAhCBrpIA8gBARIfCg9hdXRob3JpemVkX2tleXMYAyADKAlCBrpIA8gBASKMAwoJQW50aWNoZWF0EhcKB2VuYWJsZWQYASABKAhCBrpIA8gBARIuCgZhY3Rpb24YAiABKA4yES5jb25maWcudjEuQWN0aW9uQgu6SAjIAQGCAQIQARIYCghkdXJhdGlvbhgDIAEoBUIGukgDyAEBEh0KDW1heF9haW1fc25hcHMYBCABKAVCBrpIA8gBARIbCgttYXhfcHNpbGVudBgFIAEoBUIGukgDyAEBEhgKCG1heF9iaG9wGAYgASgFQga6SAPIAQESHAoMbWF4X2Zha2VfYW5nGAcgASgFQga6SAPIAQESGwoLbWF4X2NtZF9udW0YCCABKAVCBrpIA8gBARIoChhtYXhfdG9vX21hbnlfY29ubmVjdGlvbnMYCSABKAVCBrpIA8gBARIbCgttYXhfb29iX3ZhchgKIAEoBUIGukgDyAEBEiQKFG1heF9pbnZhbGlkX3VzZXJfY21kGAsgASgFQga6SAPIAQESHgoObWF4X2NoZWF0X2N2YXIYDCABKAVCBrpIA8gBASIxCgtDbGllbnRwcmVmcxIiChJjZW50ZXJfcHJvamVjdGlsZXMYASABKAhCBrpIA8gBASLjAwoGQ29uZmlnEiMKB2dlbmVyYWwYASABKAsyEi5jb25maWcudjEuR2VuZXJhbBIfCgVkZWJ1ZxgCIAEoCzIQLmNvbmZpZy52MS5EZWJ1ZxIdCgRkZW1vGAMgASgLMg8uY29uZmlnLnYxLkRlbW8SIwoHZmlsdGVycxgEIAEoCzISLmNvbmZpZy52MS5GaWx0ZXJzEiMKB2Rpc2NvcmQYBSABKAsyEi5jb25maWcudjEuRGlzY29yZBIbCgNsb2cYByABKAsyDi5jb25maWcudjEuTG9nEiwKDGdlb19sb2NhdGlvbhgIIAEoCzIWLmNvbmZpZy52MS5HZW9Mb2NhdGlvbhIjCgdwYXRyZW9uGAkgASgLMhIuY29uZmlnLnYxLlBhdHJlb24SGwoDc3NoGAogASgLMg4uY29uZmlnLnYxLlNTSBIjCgduZXR3b3JrGAsgASgLMhIuY29uZmlnLnYxLk5ldHdvcmsSKgoLbG9jYWxfc3RvcmUYDCABKAsyFS5jb25maWcudjEuTG9jYWxTdG9yZRIjCgdleHBvcnRzGA0gASgLMhIuY29uZmlnLnYxLkV4cG9ydHMSJwoJYW50aWNoZWF0GA4gASgLMhQuY29uZmlnLnYxLkFudGljaGVhdCLICAoNR2l0aHViUmVsZWFzZRILCgN1cmwYASABKAkSEAoIaHRtbF91cmwYAiABKAkSEQoJYXNzZXRfdXJsGAMgASgJEhIKCnVwbG9hZF91cmwYBCABKAkSEwoLdGFyYmFsbF91cmwYBSABKAkSCgoCaWQYBiABKAUSDwoHbm9kZV9pZBgHIAEoCRIQCgh0YWdfbmFtZRgIIAEoCRIYChB0YXJnZXRfY29tbWl0aXNoGAkgASgJEgwKBG5hbWUYCiABKAkSDAoEYm9keRgLIAEoCRINCgVkcmFmdBgMIAEoCBISCgpwcmVyZWxlYXNlGA0gASgIEi4KCmNyZWF0ZWRfYXQYDiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDHB1Ymxpc2hlZF9hdBgPIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoGYXV0aG9yGBAgASgLMh8uY29uZmlnLnYxLkdpdGh1YlJlbGVhc2UuQXV0aG9yGu8CCgZBdXRob3ISDQoFbG9naW4YASABKAkSCgoCaWQYAiABKAUSDwoHbm9kZV9pZBgDIAEoCRISCgphdmF0YXJfdXJsGAQgASgJEhQKDGdyYXZhdGFyX3VybBgFIAEoCRILCgN1cmwYBiABKAkSEAoIaHRtbF91cmwYByABKAkSFQoNZm9sbG93ZXJzX3VybBgIIAEoCRIVCg1mb2xsb3dpbmdfdXJsGAkgASgJEhEKCWdpc3RzX3VybBgKIAEoCRITCgtzdGFydGVkX3VybBgLIAEoCRIZChFzdWJzY3JpcHRpb25zX3VybBgMIAEoCRIZChFvcmdhbml6YXRpb25zX3VybBgNIAEoCRIRCglyZXBvc191cmwYDiABKAkSEgoKZXZlbnRzX3VybBgPIAEoCRIbChNyZWNlaXZlZF9ldmVudHNfdXJsGBAgASgJEgwKBHR5cGUYESABKAkSEgoKc2l0ZV9hZG1pbhgSIAEoCBrOAgoFQXNzZXQSCwoDdXJsGAEgASgJEhwKFGJyb3dzZXJfZG93bmxvYWRfdXJsGAIgASgJEgoKAmlkGAMgASgFEg8KB25vZGVfaWQYBCABKAkSDAoEbmFtZRgFIAEoCRINCgVsYWJlbBgGIAEoCRINCgVzdGF0ZRgHIAEoCRIUCgxjb250ZW50X3R5cGUYCCABKAkSEAoEc2l6ZRgJIAEoA0ICMAESFgoOZG93bmxvYWRfY291bnQYCiABKAUSLgoKY3JlYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMQoIdXBsb2FkZXIYDSABKAsyHy5jb25maWcudjEuR2l0aHViUmVsZWFzZS5BdXRob3IqUgoHUnVuTW9kZRIgChxSVU5fTU9ERV9SRUxFQVNFX1VOU1BFQ0lGSUVEEAASEgoOUlVOX01PREVfREVCVUcQARIRCg1SVU5fTU9ERV9URVNUEAMqNgoNRmlsZVNlcnZlTW9kZRIlCiFGSUxFX1NFUlZFX01PREVfTE9DQUxfVU5TUEVDSUZJRUQQACpOCgxEZW1vU3RyYXRlZ3kSJQohREVNT19TVFJBVEVHWV9QQ1RGUkVFX1VOU1BFQ0lGSUVEEAASFwoTREVNT19TVFJBVEVHWV9DT1VOVBABKlgKBUxldmVsEhsKF0xFVkVMX0VSUk9SX1VOU1BFQ0lGSUVEEAASEQoNTEVWRUxfV0FSTklORxABEg4KCkxFVkVMX0lORk8QAhIPCgtMRVZFTF9ERUJVRxADKoYBCg9Ib3N0S2V5U3RyYXRlZ3kSLQopSE9TVF9LRVlfU1RSQVRFR1lfQVVUT19BQ0NFUFRfVU5TUEVDSUZJRUQQABIiCh5IT1NUX0tFWV9TVFJBVEVHWV9BQ0NFUFRfRklSU1QQARIgChxIT1NUX0tFWV9TVFJBVEVHWV9JR05PUkVfQUxMEAIqRQoGQWN0aW9uEhsKF0FDVElPTl9LSUNLX1VOU1BFQ0lGSUVEEAASDgoKQUNUSU9OX0dBRxABEg4KCkFDVElPTl9CQU4QAjKMAgoNQ29uZmlnU2VydmljZRI8CgRJbmZvEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhcuY29uZmlnLnYxLkluZm9SZXNwb25zZSIDkAIBEjcKA0dldBIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoWLmNvbmZpZy52MS5HZXRSZXNwb25zZSIAEj8KBlVwZGF0ZRIYLmNvbmZpZy52MS5VcGRhdGVSZXF1ZXN0GhkuY29uZmlnLnYxLlVwZGF0ZVJlc3BvbnNlIgASQwoJQ2hhbmdlbG9nEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GhwuY29uZmlnLnYxLkNoYW5nZWxvZ1Jlc3BvbnNlIgBCngEKDWNvbS5jb25maWcudjFCC0NvbmZpZ1Byb3RvUAFaO2dpdGh1Yi5jb20vbGVpZ2htYWNkb25hbGQvZ2JhbnMvaW50ZXJuYWwvY29uZmlnL3YxO2NvbmZpZ3YxogIDQ1hYqgIJQ29uZmlnLlYxygIJQ29uZmlnXFYx4gIVQ29uZmlnXFYxXEdQQk1ldGFkYXRh6gIKQ29uZmlnOjpWMWIIZWRpdGlvbnNw6Ac", [file_buf_validate_validate, file_google_protobuf_descriptor, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+  fileDesc("ChZjb25maWcvdjEvY29uZmlnLnByb3RvEgljb25maWcudjEiSAoRQ2hhbmdlbG9nUmVzcG9uc2USMwoJY2hhbmdlbG9nGAEgAygLMhguY29uZmlnLnYxLkdpdGh1YlJlbGVhc2VCBrpIA8gBASL9BQoMSW5mb1Jlc3BvbnNlEhkKCXNpdGVfbmFtZRgBIAEoCUIGukgDyAEBEiAKEHNpdGVfZGVzY3JpcHRpb24YAiABKAlCBrpIA8gBARIZCglhc3NldF91cmwYAyABKAlCBrpIA8gBARIXCgdmYXZpY29uGAQgASgJQga6SAPIAQESFwoHbGlua19pZBgFIAEoCUIGukgDyAEBEhsKC2FwcF92ZXJzaW9uGAYgASgJQga6SAPIAQESHgoOc2VudHJ5X2Rzbl93ZWIYByABKAlCBrpIA8gBARIfCg9kb2N1bWVudF9wb2xpY3kYCCABKAlCBrpIA8gBARIhChFwYXRyZW9uX2NsaWVudF9pZBgJIAEoCUIGukgDyAEBEiEKEWRpc2NvcmRfY2xpZW50X2lkGAogASgJQga6SAPIAQESHwoPZGlzY29yZF9lbmFibGVkGAsgASgIQga6SAPIAQESHwoPcGF0cmVvbl9lbmFibGVkGAwgASgIQga6SAPIAQESHQoNZGVmYXVsdF9yb3V0ZRgNIAEoCUIGukgDyAEBEhwKDG5ld3NfZW5hYmxlZBgOIAEoCEIGukgDyAEBEiAKEGNvbnRlc3RzX2VuYWJsZWQYDyABKAhCBrpIA8gBARIcCgx3aWtpX2VuYWJsZWQYECABKAhCBrpIA8gBARIdCg1zdGF0c19lbmFibGVkGBEgASgIQga6SAPIAQESHwoPc2VydmVyc19lbmFibGVkGBIgASgIQga6SAPIAQESHwoPcmVwb3J0c19lbmFibGVkGBMgASgIQga6SAPIAQESIAoQY2hhdGxvZ3NfZW5hYmxlZBgUIAEoCEIGukgDyAEBEh0KDWRlbW9zX2VuYWJsZWQYFSABKAhCBrpIA8gBARIhChFzcGVlZHJ1bnNfZW5hYmxlZBgWIAEoCEIGukgDyAEBEh4KDmZvcnVtc19lbmFibGVkGBcgASgIQga6SAPIAQESGwoLbWdlX2VuYWJsZWQYGCABKAhCBrpIA8gBASI4CgtHZXRSZXNwb25zZRIpCgZjb25maWcYASABKAsyES5jb25maWcudjEuQ29uZmlnQga6SAPIAQEiOgoNVXBkYXRlUmVxdWVzdBIpCgZjb25maWcYASABKAsyES5jb25maWcudjEuQ29uZmlnQga6SAPIAQEiOwoOVXBkYXRlUmVzcG9uc2USKQoGY29uZmlnGAEgASgLMhEuY29uZmlnLnYxLkNvbmZpZ0IGukgDyAEBIsQFCgdHZW5lcmFsEhkKCXNpdGVfbmFtZRgBIAEoCUIGukgDyAEBEiAKEHNpdGVfZGVzY3JpcHRpb24YAiABKAlCBrpIA8gBARItCgRtb2RlGAMgASgOMhIuY29uZmlnLnYxLlJ1bk1vZGVCC7pICMgBAYIBAhABEj4KD2ZpbGVfc2VydmVfbW9kZRgEIAEoDjIYLmNvbmZpZy52MS5GaWxlU2VydmVNb2RlQgu6SAjIAQGCAQIQARIeCg5zcmNkc19sb2dfYWRkchgFIAEoCUIGukgDyAEBEhkKCWFzc2V0X3VybBgGIAEoCUIGukgDyAEBEhcKB2Zhdmljb24YByABKAlCBrpIA8gBARIdCg1kZWZhdWx0X3JvdXRlGAggASgJQga6SAPIAQESHAoMbmV3c19lbmFibGVkGAkgASgIQga6SAPIAQESHgoOZm9ydW1zX2VuYWJsZWQYCiABKAhCBrpIA8gBARIgChBjb250ZXN0c19lbmFibGVkGAsgASgIQga6SAPIAQESHAoMd2lraV9lbmFibGVkGAwgASgIQga6SAPIAQESHQoNc3RhdHNfZW5hYmxlZBgNIAEoCEIGukgDyAEBEh8KD3NlcnZlcnNfZW5hYmxlZBgOIAEoCEIGukgDyAEBEh8KD3JlcG9ydHNfZW5hYmxlZBgPIAEoCEIGukgDyAEBEiAKEGNoYXRsb2dzX2VuYWJsZWQYECABKAhCBrpIA8gBARIdCg1kZW1vc19lbmFibGVkGBEgASgIQga6SAPIAQESIQoRc3BlZWRydW5zX2VuYWJsZWQYEiABKAhCBrpIA8gBARIbCgttZ2VfZW5hYmxlZBgTIAEoCEIGukgDyAEBEhoKCnNlbnRyeV9kc24YFCABKAlCBrpIA8gBARIeCg5zZW50cnlfZHNuX3dlYhgVIAEoCUIGukgDyAEBIlYKBURlYnVnEicKF3NraXBfb3Blbl9pZF92YWxpZGF0aW9uGAEgASgIQga6SAPIAQESJAoUYWRkX3Jjb25fbG9nX2FkZHJlc3MYAiABKAlCBrpIA8gBASLaAQoERGVtbxIfCg9jbGVhbnVwX2VuYWJsZWQYASABKAhCBrpIA8gBARI2CghzdHJhdGVneRgCIAEoDjIXLmNvbmZpZy52MS5EZW1vU3RyYXRlZ3lCC7pICMgBAYIBAhABEh8KD2NsZWFudXBfbWluX3BjdBgDIAEoAkIGukgDyAEBEh0KDWNsZWFudXBfbW91bnQYBCABKAlCBrpIA8gBARIdCgtjb3VudF9saW1pdBgFIAEoA0IIMAG6SAPIAQESGgoKcGFyc2VyX3VybBgGIAEoCUIGukgDyAEBIsUBCgdGaWx0ZXJzEhcKB2VuYWJsZWQYASABKAhCBrpIA8gBARIfCg93YXJuaW5nX3RpbWVvdXQYAiABKAVCBrpIA8gBARIdCg13YXJuaW5nX2xpbWl0GAMgASgFQga6SAPIAQESEwoDZHJ5GAQgASgIQga6SAPIAQESHAoMcGluZ19kaXNjb3JkGAUgASgIQga6SAPIAQFKBAgGEAlSCm1heF93ZWlnaHRSDWNoZWNrX3RpbWVvdXRSDW1hdGNoX3RpbWVvdXQi0AUKB0Rpc2NvcmQSFwoHZW5hYmxlZBgBIAEoCEIGukgDyAEBEhsKC2JvdF9lbmFibGVkGAIgASgIQga6SAPIAQESJAoUaW50ZWdyYXRpb25zX2VuYWJsZWQYAyABKAhCBrpIA8gBARIWCgZhcHBfaWQYBCABKAlCBrpIA8gBARIaCgphcHBfc2VjcmV0GAUgASgJQga6SAPIAQESFwoHbGlua19pZBgGIAEoCUIGukgDyAEBEhUKBXRva2VuGAcgASgJQga6SAPIAQESGAoIZ3VpbGRfaWQYCCABKAlCBrpIA8gBARIpChlwdWJsaWNfbG9nX2NoYW5uZWxfZW5hYmxlGAkgASgIQga6SAPIAQESHgoObG9nX2NoYW5uZWxfaWQYCiABKAlCBrpIA8gBARIrChtwdWJsaWNfbWF0Y2hfbG9nX2NoYW5uZWxfaWQYCyABKAlCBrpIA8gBARIjChN2b3RlX2xvZ19jaGFubmVsX2lkGAwgASgJQga6SAPIAQESJQoVYXBwZWFsX2xvZ19jaGFubmVsX2lkGA0gASgJQga6SAPIAQESIgoSYmFuX2xvZ19jaGFubmVsX2lkGA4gASgJQga6SAPIAQESJAoUZm9ydW1fbG9nX2NoYW5uZWxfaWQYDyABKAlCBrpIA8gBARIjChNraWNrX2xvZ19jaGFubmVsX2lkGBAgASgJQga6SAPIAQESIAoQbW9kX3Bpbmdfcm9sZV9pZBgRIAEoCUIGukgDyAEBEiQKFGFudGljaGVhdF9jaGFubmVsX2lkGBIgASgJQga6SAPIAQESHwoPc2VlZF9jaGFubmVsX2lkGBMgASgJQga6SAPIAQESKgoad29yZF9maWx0ZXJfbG9nX2NoYW5uZWxfaWQYFCABKAlCBrpIA8gBARIjChNjaGF0X2xvZ19jaGFubmVsX2lkGBUgASgJQga6SAPIAQEiLwoJU291cmNlbW9kEiIKEmNlbnRlcl9wcm9qZWN0aWxlcxgBIAEoCEIGukgDyAEBIr0BCgNMb2cSLAoFbGV2ZWwYASABKA4yEC5jb25maWcudjEuTGV2ZWxCC7pICMgBAYIBAhABEhQKBGZpbGUYAiABKAlCBrpIA8gBARIcCgxodHRwX2VuYWJsZWQYAyABKAhCBrpIA8gBARIhChFodHRwX290ZWxfZW5hYmxlZBgEIAEoCEIGukgDyAEBEjEKCmh0dHBfbGV2ZWwYBSABKA4yEC5jb25maWcudjEuTGV2ZWxCC7pICMgBAYIBAhABIsIBCgtHZW9Mb2NhdGlvbhIXCgdlbmFibGVkGAEgASgIQga6SAPIAQESGgoKY2FjaGVfcGF0aBgCIAEoCUIGukgDyAEBEhUKBXRva2VuGAMgASgJQga6SAPIAQESMgoIcHJvdmlkZXIYBCABKA4yFi5jb25maWcudjEuR2VvUHJvdmlkZXJCCLpIBYIBAhABEhkKEW1heG1pbmRfY2l0eV9wYXRoGAUgASgJEhgKEG1heG1pbmRfYXNuX3BhdGgYBiABKAkizwEKB1BhdHJlb24SFwoHZW5hYmxlZBgBIAEoCEIGukgDyAEBEiQKFGludGVncmF0aW9uc19lbmFibGVkGAIgASgIQga6SAPIAQESGQoJY2xpZW50X2lkGAMgASgJQga6SAPIAQESHQoNY2xpZW50X3NlY3JldBgEIAEoCUIGukgDyAEBEiQKFGNyZWF0b3JfYWNjZXNzX3Rva2VuGAUgASgJQga6SAPIAQESJQoVY3JlYXRvcl9yZWZyZXNoX3Rva2VuGAYgASgJQga6SAPIAQEixgIKA1NTSBIXCgdlbmFibGVkGAEgASgIQga6SAPIAQESGAoIdXNlcm5hbWUYAiABKAlCBrpIA8gBARIUCgRwb3J0GAMgASgFQga6SAPIAQESIAoQcHJpdmF0ZV9rZXlfcGF0aBgEIAEoCUIGukgDyAEBEkIKEWhvc3Rfa2V5X3N0cmF0ZWd5GAUgASgOMhouY29uZmlnLnYxLkhvc3RLZXlTdHJhdGVneUILukgIyAEBggECEAESGAoIcGFzc3dvcmQYBiABKAlCBrpIA8gBARIfCg91cGRhdGVfaW50ZXJ2YWwYByABKAVCBrpIA8gBARIXCgd0aW1lb3V0GAggASgFQga6SAPIAQESHQoNZGVtb19wYXRoX2ZtdBgJIAEoCUIGukgDyAEBEh0KDXN0YWNfcGF0aF9mbXQYCiABKAlCBrpIA8gBASJcCgdOZXR3b3JrEhsKC3Nkcl9lbmFibGVkGAEgASgIQga6SAPIAQESNAoTYWx0X3Njb3JlX3RocmVzaG9sZBgCIAEoAUIXukgUEhIZAAAAAAAA8D8pAAAAAAAAAAAiJwoKTG9jYWxTdG9yZRIZCglwYXRoX3Jvb3QYASABKAlCBrpIA8gBASJlCgdFeHBvcnRzEhoKCmJkX2VuYWJsZWQYASABKAhCBrpIA8gBARIdCg12YWx2ZV9lbmFibGVkGAIgASgIQga6SAPIAQESHwoPYXV0aG9yaXplZF9rZXlzGAMgAygJQga6SAPIAQEiKQoHQXBwZWFscxIeCg1yZW1pbmRlcl9kYXlzGAEgASgFQge6SAQaAigAIsoBCglBbnRpY2hlYXQSFwoHZW5hYmxlZBgBIAEoCEIGukgDyAEBSgQIAhANUgZhY3Rpb25SCGR1cmF0aW9uUg1tYXhfYWltX3NuYXBzUgttYXhfcHNpbGVudFIIbWF4X2Job3BSDG1heF9mYWtlX2FuZ1ILbWF4X2NtZF9udW1SGG1heF90b29fbWFueV9jb25uZWN0aW9uc1ILbWF4X29vYl92YXJSFG1heF9pbnZhbGlkX3VzZXJfY21kUg5tYXhfY2hlYXRfY3ZhciIxCgtDbGllbnRwcmVmcxIiChJjZW50ZXJfcHJvamVjdGlsZXMYASABKAhCBrpIA8gBASKIBAoGQ29uZmlnEiMKB2dlbmVyYWwYASABKAsyEi5jb25maWcudjEuR2VuZXJhbBIfCgVkZWJ1ZxgCIAEoCzIQLmNvbmZpZy52MS5EZWJ1ZxIdCgRkZW1vGAMgASgLMg8uY29uZmlnLnYxLkRlbW8SIwoHZmlsdGVycxgEIAEoCzISLmNvbmZpZy52MS5GaWx0ZXJzEiMKB2Rpc2NvcmQYBSABKAsyEi5jb25maWcudjEuRGlzY29yZBIbCgNsb2cYByABKAsyDi5jb25maWcudjEuTG9nEiwKDGdlb19sb2NhdGlvbhgIIAEoCzIWLmNvbmZpZy52MS5HZW9Mb2NhdGlvbhIjCgdwYXRyZW9uGAkgASgLMhIuY29uZmlnLnYxLlBhdHJlb24SGwoDc3NoGAogASgLMg4uY29uZmlnLnYxLlNTSBIjCgduZXR3b3JrGAsgASgLMhIuY29uZmlnLnYxLk5ldHdvcmsSKgoLbG9jYWxfc3RvcmUYDCABKAsyFS5jb25maWcudjEuTG9jYWxTdG9yZRIjCgdleHBvcnRzGA0gASgLMhIuY29uZmlnLnYxLkV4cG9ydHMSJwoJYW50aWNoZWF0GA4gASgLMhQuY29uZmlnLnYxLkFudGljaGVhdBIjCgdhcHBlYWxzGA8gASgLMhIuY29uZmlnLnYxLkFwcGVhbHMiyAgKDUdpdGh1YlJlbGVhc2USCwoDdXJsGAEgASgJEhAKCGh0bWxfdXJsGAIgASgJEhEKCWFzc2V0X3VybBgDIAEoCRISCgp1cGxvYWRfdXJsGAQgASgJEhMKC3RhcmJhbGxfdXJsGAUgASgJEgoKAmlkGAYgASgFEg8KB25vZGVfaWQYByABKAkSEAoIdGFnX25hbWUYCCABKAkSGAoQdGFyZ2V0X2NvbW1pdGlzaBgJIAEoCRIMCgRuYW1lGAogASgJEgwKBGJvZHkYCyABKAkSDQoFZHJhZnQYDCABKAgSEgoKcHJlcmVsZWFzZRgNIAEoCBIuCgpjcmVhdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxwdWJsaXNoZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi8KBmF1dGhvchgQIAEoCzIfLmNvbmZpZy52MS5HaXRodWJSZWxlYXNlLkF1dGhvchrvAgoGQXV0aG9yEg0KBWxvZ2luGAEgASgJEgoKAmlkGAIgASgFEg8KB25vZGVfaWQYAyABKAkSEgoKYXZhdGFyX3VybBgEIAEoCRIUCgxncmF2YXRhcl91cmwYBSABKAkSCwoDdXJsGAYgASgJEhAKCGh0bWxfdXJsGAcgASgJEhUKDWZvbGxvd2Vyc191cmwYCCABKAkSFQoNZm9sbG93aW5nX3VybBgJIAEoCRIRCglnaXN0c191cmwYCiABKAkSEwoLc3RhcnRlZF91cmwYCyABKAkSGQoRc3Vic2NyaXB0aW9uc191cmwYDCABKAkSGQoRb3JnYW5pemF0aW9uc191cmwYDSABKAkSEQoJcmVwb3NfdXJsGA4gASgJEhIKCmV2ZW50c191cmwYDyABKAkSGwoTcmVjZWl2ZWRfZXZlbnRzX3VybBgQIAEoCRIMCgR0eXBlGBEgASgJEhIKCnNpdGVfYWRtaW4YEiABKAgazgIKBUFzc2V0EgsKA3VybBgBIAEoCRIcChRicm93c2VyX2Rvd25sb2FkX3VybBgCIAEoCRIKCgJpZBgDIAEoBRIPCgdub2RlX2lkGAQgASgJEgwKBG5hbWUYBSABKAkSDQoFbGFiZWwYBiABKAkSDQoFc3RhdGUYByABKAkSFAoMY29udGVudF90eXBlGAggASgJEhAKBHNpemUYCSABKANCAjABEhYKDmRvd25sb2FkX2NvdW50GAogASgFEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKCHVwbG9hZGVyGA0gASgLMh8uY29uZmlnLnYxLkdpdGh1YlJlbGVhc2UuQXV0aG9yKlIKB1J1bk1vZGUSIAocUlVOX01PREVfUkVMRUFTRV9VTlNQRUNJRklFRBAAEhIKDlJVTl9NT0RFX0RFQlVHEAESEQoNUlVOX01PREVfVEVTVBADKjYKDUZpbGVTZXJ2ZU1vZGUSJQohRklMRV9TRVJWRV9NT0RFX0xPQ0FMX1VOU1BFQ0lGSUVEEAAqTgoMRGVtb1N0cmF0ZWd5EiUKIURFTU9fU1RSQVRFR1lfUENURlJFRV9VTlNQRUNJRklFRBAAEhcKE0RFTU9fU1RSQVRFR1lfQ09VTlQQASpYCgVMZXZlbBIbChdMRVZFTF9FUlJPUl9VTlNQRUNJRklFRBAAEhEKDUxFVkVMX1dBUk5JTkcQARIOCgpMRVZFTF9JTkZPEAISDwoLTEVWRUxfREVCVUcQAypRCgtHZW9Qcm92aWRlchIoCiRHRU9fUFJPVklERVJfSVAyTE9DQVRJT05fVU5TUEVDSUZJRUQQABIYChRHRU9fUFJPVklERVJfTUFYTUlORBABKoYBCg9Ib3N0S2V5U3RyYXRlZ3kSLQopSE9TVF9LRVlfU1RSQVRFR1lfQVVUT19BQ0NFUFRfVU5TUEVDSUZJRUQQABIiCh5IT1NUX0tFWV9TVFJBVEVHWV9BQ0NFUFRfRklSU1QQARIgChxIT1NUX0tFWV9TVFJBVEVHWV9JR05PUkVfQUxMEAIyjAIKDUNvbmZpZ1NlcnZpY2USPAoESW5mbxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoXLmNvbmZpZy52MS5JbmZvUmVzcG9uc2UiA5ACARI3CgNHZXQSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5jb25maWcudjEuR2V0UmVzcG9uc2UiABI/CgZVcGRhdGUSGC5jb25maWcudjEuVXBkYXRlUmVxdWVzdBoZLmNvbmZpZy52MS5VcGRhdGVSZXNwb25zZSIAEkMKCUNoYW5nZWxvZxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRocLmNvbmZpZy52MS5DaGFuZ2Vsb2dSZXNwb25zZSIAQp4BCg1jb20uY29uZmlnLnYxQgtDb25maWdQcm90b1ABWjtnaXRodWIuY29tL2xlaWdobWFjZG9uYWxkL2diYW5zL2ludGVybmFsL2NvbmZpZy92MTtjb25maWd2MaICA0NYWKoCCUNvbmZpZy5WMcoCCUNvbmZpZ1xWMeICFUNvbmZpZ1xWMVxHUEJNZXRhZGF0YeoCCkNvbmZpZzo6VjFiCGVkaXRpb25zcOgH", [file_buf_validate_validate, file_google_protobuf_descriptor, file_google_protobuf_empty, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message config.v1.ChangelogResponse
@@ -424,21 +424,6 @@ export type Filters = Message<"config.v1.Filters"> & {
    * @generated from field: bool ping_discord = 5;
    */
   pingDiscord: boolean;
-
-  /**
-   * @generated from field: int32 max_weight = 6;
-   */
-  maxWeight: number;
-
-  /**
-   * @generated from field: int32 check_timeout = 7;
-   */
-  checkTimeout: number;
-
-  /**
-   * @generated from field: int32 match_timeout = 8;
-   */
-  matchTimeout: number;
 };
 
 /**
@@ -637,6 +622,23 @@ export type GeoLocation = Message<"config.v1.GeoLocation"> & {
    * @generated from field: string token = 3;
    */
   token: string;
+
+  /**
+   * @generated from field: config.v1.GeoProvider provider = 4;
+   */
+  provider: GeoProvider;
+
+  /**
+   * Paths to the GeoLite2-City.mmdb and GeoLite2-ASN.mmdb files used by the maxmind provider.
+   *
+   * @generated from field: string maxmind_city_path = 5;
+   */
+  maxmindCityPath: string;
+
+  /**
+   * @generated from field: string maxmind_asn_path = 6;
+   */
+  maxmindAsnPath: string;
 };
 
 /**
@@ -758,6 +760,13 @@ export type Network = Message<"config.v1.Network"> & {
    * @generated from field: bool sdr_enabled = 1;
    */
   sdrEnabled: boolean;
+
+  /**
+   * Minimum alt account score for a connecting player to inherit the ban of a linked account, 0 to disable.
+   *
+   * @generated from field: double alt_score_threshold = 2;
+   */
+  altScoreThreshold: number;
 };
 
 /**
@@ -812,68 +821,32 @@ export const ExportsSchema: GenMessage<Exports> = /*@__PURE__*/
   messageDesc(file_config_v1_config, 17);
 
 /**
- * @generated from message config.v1.Anticheat
+ * @generated from message config.v1.Appeals
  */
-export type Anticheat = Message<"config.v1.Anticheat"> & {
-  /**
-   * @generated from field: bool enabled = 1;
-   */
-  enabled: boolean;
-
-  /**
-   * @generated from field: config.v1.Action action = 2;
-   */
-  action: Action;
-
-  /**
-   * @generated from field: int32 duration = 3;
-   */
-  duration: number;
-
-  /**
-   * @generated from field: int32 max_aim_snaps = 4;
-   */
-  maxAimSnaps: number;
-
-  /**
-   * @generated from field: int32 max_psilent = 5;
-   */
-  maxPsilent: number;
-
-  /**
-   * @generated from field: int32 max_bhop = 6;
-   */
-  maxBhop: number;
-
-  /**
-   * @generated from field: int32 max_fake_ang = 7;
-   */
-  maxFakeAng: number;
-
-  /**
-   * @generated from field: int32 max_cmd_num = 8;
-   */
-  maxCmdNum: number;
-
-  /**
-   * @generated from field: int32 max_too_many_connections = 9;
-   */
-  maxTooManyConnections: number;
-
+export type Appeals = Message<"config.v1.Appeals"> & {
   /**
-   * @generated from field: int32 max_oob_var = 10;
+   * Days an open appeal may wait for a staff reply before moderators are reminded, 0 disables reminders.
+   *
+   * @generated from field: int32 reminder_days = 1;
    */
-  maxOobVar: number;
+  reminderDays: number;
+};
 
-  /**
-   * @generated from field: int32 max_invalid_user_cmd = 11;
-   */
-  maxInvalidUserCmd: number;
+/**
+ * Describes the message config.v1.Appeals.
+ * Use `create(AppealsSchema)` to create a new message.
+ */
+export const AppealsSchema: GenMessage<Appeals> = /*@__PURE__*/
+  messageDesc(file_config_v1_config, 18);
 
+/**
+ * @generated from message config.v1.Anticheat
+ */
+export type Anticheat = Message<"config.v1.Anticheat"> & {
   /**
-   * @generated from field: int32 max_cheat_cvar = 12;
+   * @generated from field: bool enabled = 1;
    */
-  maxCheatCvar: number;
+  enabled: boolean;
 };
 
 /**
@@ -881,7 +854,7 @@ export type Anticheat = Message<"config.v1.Anticheat"> & {
  * Use `create(AnticheatSchema)` to create a new message.
  */
 export const AnticheatSchema: GenMessage<Anticheat> = /*@__PURE__*/
-  messageDesc(file_config_v1_config, 18);
+  messageDesc(file_config_v1_config, 19);
 
 /**
  * @generated from message config.v1.Clientprefs
@@ -898,7 +871,7 @@ export type Clientprefs = Message<"config.v1.Clientprefs"> & {
  * Use `create(ClientprefsSchema)` to create a new message.
  */
 export const ClientprefsSchema: GenMessage<Clientprefs> = /*@__PURE__*/
-  messageDesc(file_config_v1_config, 19);
+  messageDesc(file_config_v1_config, 20);
 
 /**
  * @generated from message config.v1.Config
@@ -970,6 +943,11 @@ export type Config = Message<"config.v1.Config"> & {
    * @generated from field: config.v1.Anticheat anticheat = 14;
    */
   anticheat?: Anticheat | undefined;
+
+  /**
+   * @generated from field: config.v1.Appeals appeals = 15;
+   */
+  appeals?: Appeals | undefined;
 };
 
 /**
@@ -977,7 +955,7 @@ export type Config = Message<"config.v1.Config"> & {
  * Use `create(ConfigSchema)` to create a new message.
  */
 export const ConfigSchema: GenMessage<Config> = /*@__PURE__*/
-  messageDesc(file_config_v1_config, 20);
+  messageDesc(file_config_v1_config, 21);
 
 /**
  * @generated from message config.v1.GithubRelease
@@ -1069,7 +1047,7 @@ export type GithubRelease = Message<"config.v1.GithubRelease"> & {
  * Use `create(GithubReleaseSchema)` to create a new message.
  */
 export const GithubReleaseSchema: GenMessage<GithubRelease> = /*@__PURE__*/
-  messageDesc(file_config_v1_config, 21);
+  messageDesc(file_config_v1_config, 22);
 
 /**
  * @generated from message config.v1.GithubRelease.Author
@@ -1171,7 +1149,7 @@ export type GithubRelease_Author = Message<"config.v1.GithubRelease.Author"> & {
  * Use `create(GithubRelease_AuthorSchema)` to create a new message.
  */
 export const GithubRelease_AuthorSchema: GenMessage<GithubRelease_Author> = /*@__PURE__*/
-  messageDesc(file_config_v1_config, 21, 0);
+  messageDesc(file_config_v1_config, 22, 0);
 
 /**
  * @generated from message config.v1.GithubRelease.Asset
@@ -1248,7 +1226,7 @@ export type GithubRelease_Asset = Message<"config.v1.GithubRelease.Asset"> & {
  * Use `create(GithubRelease_AssetSchema)` to create a new message.
  */
 export const GithubRelease_AssetSchema: GenMessage<GithubRelease_Asset> = /*@__PURE__*/
-  messageDesc(file_config_v1_config, 21, 1);
+  messageDesc(file_config_v1_config, 22, 1);
 
 /**
  * @generated from enum config.v1.RunMode
@@ -1345,55 +1323,50 @@ export const LevelSchema: GenEnum<Level> = /*@__PURE__*/
   enumDesc(file_config_v1_config, 3);
 
 /**
- * @generated from enum config.v1.HostKeyStrategy
+ * @generated from enum config.v1.GeoProvider
  */
-export enum HostKeyStrategy {
+export enum GeoProvider {
   /**
-   * @generated from enum value: HOST_KEY_STRATEGY_AUTO_ACCEPT_UNSPECIFIED = 0;
+   * @generated from enum value: GEO_PROVIDER_IP2LOCATION_UNSPECIFIED = 0;
    */
-  AUTO_ACCEPT_UNSPECIFIED = 0,
+  IP2LOCATION_UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: HOST_KEY_STRATEGY_ACCEPT_FIRST = 1;
+   * @generated from enum value: GEO_PROVIDER_MAXMIND = 1;
    */
-  ACCEPT_FIRST = 1,
-
-  /**
-   * @generated from enum value: HOST_KEY_STRATEGY_IGNORE_ALL = 2;
-   */
-  IGNORE_ALL = 2,
+  MAXMIND = 1,
 }
 
 /**
- * Describes the enum config.v1.HostKeyStrategy.
+ * Describes the enum config.v1.GeoProvider.
  */
-export const HostKeyStrategySchema: GenEnum<HostKeyStrategy> = /*@__PURE__*/
+export const GeoProviderSchema: GenEnum<GeoProvider> = /*@__PURE__*/
   enumDesc(file_config_v1_config, 4);
 
 /**
- * @generated from enum config.v1.Action
+ * @generated from enum config.v1.HostKeyStrategy
  */
-export enum Action {
+export enum HostKeyStrategy {
   /**
-   * @generated from enum value: ACTION_KICK_UNSPECIFIED = 0;
+   * @generated from enum value: HOST_KEY_STRATEGY_AUTO_ACCEPT_UNSPECIFIED = 0;
    */
-  KICK_UNSPECIFIED = 0,
+  AUTO_ACCEPT_UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: ACTION_GAG = 1;
+   * @generated from enum value: HOST_KEY_STRATEGY_ACCEPT_FIRST = 1;
    */
-  GAG = 1,
+  ACCEPT_FIRST = 1,
 
   /**
-   * @generated from enum value: ACTION_BAN = 2;
+   * @generated from enum value: HOST_KEY_STRATEGY_IGNORE_ALL = 2;
    */
-  BAN = 2,
+  IGNORE_ALL = 2,
 }
 
 /**
- * Describes the enum config.v1.Action.
+ * Describes the enum config.v1.HostKeyStrategy.
  */
-export const ActionSchema: GenEnum<Action> = /*@__PURE__*/
+export const HostKeyStrategySchema: GenEnum<HostKeyStrategy> = /*@__PURE__*/
   enumDesc(file_config_v1_config, 5);
 
 /**
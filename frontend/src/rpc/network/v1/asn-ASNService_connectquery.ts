@@ -0,0 +1,20 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file network/v1/asn.proto (package network.v1, edition 2023)
+/* eslint-disable */
+
+import { ASNService } from "./asn_pb";
+
+/**
+ * @generated from rpc network.v1.ASNService.ASNBlocks
+ */
+export const aSNBlocks = ASNService.method.aSNBlocks;
+
+/**
+ * @generated from rpc network.v1.ASNService.ASNBlockSave
+ */
+export const aSNBlockSave = ASNService.method.aSNBlockSave;
+
+/**
+ * @generated from rpc network.v1.ASNService.ASNBlockDelete
+ */
+export const aSNBlockDelete = ASNService.method.aSNBlockDelete;
@@ -0,0 +1,172 @@
+// @generated by protoc-gen-es v2.12.1 with parameter "target=ts"
+// @generated from file network/v1/asn.proto (package network.v1, edition 2023)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file network/v1/asn.proto.
+ */
+export const file_network_v1_asn: GenFile = /*@__PURE__*/
+  fileDesc("ChRuZXR3b3JrL3YxL2Fzbi5wcm90bxIKbmV0d29yay52MSLWAQoIQVNOQmxvY2sSHAoGYXNfbnVtGAEgASgDQgwwAbpIB8gBASICIAASFgoGcmVhc29uGAIgASgJQga6SAPIAQESDQoFbm90ZXMYAyABKAkSFQoJYXV0aG9yX2lkGAQgASgDQgIwARI2CgpjcmVhdGVkX29uGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEjYKCnVwZGF0ZWRfb24YBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQEiQQoRQVNOQmxvY2tzUmVzcG9uc2USLAoGYmxvY2tzGAEgAygLMhQubmV0d29yay52MS5BU05CbG9ja0IGukgDyAEBIl4KE0FTTkJsb2NrU2F2ZVJlcXVlc3QSHAoGYXNfbnVtGAEgASgDQgwwAbpIB8gBASICIAASGgoGcmVhc29uGAIgASgJQgq6SAfIAQFyAhABEg0KBW5vdGVzGAMgASgJIkMKFEFTTkJsb2NrU2F2ZVJlc3BvbnNlEisKBWJsb2NrGAEgASgLMhQubmV0d29yay52MS5BU05CbG9ja0IGukgDyAEBIjUKFUFTTkJsb2NrRGVsZXRlUmVxdWVzdBIcCgZhc19udW0YASABKANCDDABukgHyAEBIgIgADL2AQoKQVNOU2VydmljZRJECglBU05CbG9ja3MSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaHS5uZXR3b3JrLnYxLkFTTkJsb2Nrc1Jlc3BvbnNlIgASUwoMQVNOQmxvY2tTYXZlEh8ubmV0d29yay52MS5BU05CbG9ja1NhdmVSZXF1ZXN0GiAubmV0d29yay52MS5BU05CbG9ja1NhdmVSZXNwb25zZSIAEk0KDkFTTkJsb2NrRGVsZXRlEiEubmV0d29yay52MS5BU05CbG9ja0RlbGV0ZVJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiAEKiAQoOY29tLm5ldHdvcmsudjFCCEFzblByb3RvUAFaPWdpdGh1Yi5jb20vbGVpZ2htYWNkb25hbGQvZ2JhbnMvaW50ZXJuYWwvbmV0d29yay92MTtuZXR3b3JrdjGiAgNOWFiqAgpOZXR3b3JrLlYxygIKTmV0d29ya1xWMeICFk5ldHdvcmtcVjFcR1BCTWV0YWRhdGHqAgtOZXR3b3JrOjpWMWIIZWRpdGlvbnNw6Ac", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message network.v1.ASNBlock
+ */
+export type ASNBlock = Message<"network.v1.ASNBlock"> & {
+  /**
+   * @generated from field: int64 as_num = 1 [jstype = JS_STRING];
+   */
+  asNum: string;
+
+  /**
+   * Reason is shown to players who are denied a connection.
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  /**
+   * Notes are only visible to moderators.
+   *
+   * @generated from field: string notes = 3;
+   */
+  notes: string;
+
+  /**
+   * @generated from field: int64 author_id = 4 [jstype = JS_STRING];
+   */
+  authorId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   */
+  createdOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 6;
+   */
+  updatedOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message network.v1.ASNBlock.
+ * Use `create(ASNBlockSchema)` to create a new message.
+ */
+export const ASNBlockSchema: GenMessage<ASNBlock> = /*@__PURE__*/
+  messageDesc(file_network_v1_asn, 0);
+
+/**
+ * @generated from message network.v1.ASNBlocksResponse
+ */
+export type ASNBlocksResponse = Message<"network.v1.ASNBlocksResponse"> & {
+  /**
+   * @generated from field: repeated network.v1.ASNBlock blocks = 1;
+   */
+  blocks: ASNBlock[];
+};
+
+/**
+ * Describes the message network.v1.ASNBlocksResponse.
+ * Use `create(ASNBlocksResponseSchema)` to create a new message.
+ */
+export const ASNBlocksResponseSchema: GenMessage<ASNBlocksResponse> = /*@__PURE__*/
+  messageDesc(file_network_v1_asn, 1);
+
+/**
+ * @generated from message network.v1.ASNBlockSaveRequest
+ */
+export type ASNBlockSaveRequest = Message<"network.v1.ASNBlockSaveRequest"> & {
+  /**
+   * @generated from field: int64 as_num = 1 [jstype = JS_STRING];
+   */
+  asNum: string;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: string notes = 3;
+   */
+  notes: string;
+};
+
+/**
+ * Describes the message network.v1.ASNBlockSaveRequest.
+ * Use `create(ASNBlockSaveRequestSchema)` to create a new message.
+ */
+export const ASNBlockSaveRequestSchema: GenMessage<ASNBlockSaveRequest> = /*@__PURE__*/
+  messageDesc(file_network_v1_asn, 2);
+
+/**
+ * @generated from message network.v1.ASNBlockSaveResponse
+ */
+export type ASNBlockSaveResponse = Message<"network.v1.ASNBlockSaveResponse"> & {
+  /**
+   * @generated from field: network.v1.ASNBlock block = 1;
+   */
+  block?: ASNBlock | undefined;
+};
+
+/**
+ * Describes the message network.v1.ASNBlockSaveResponse.
+ * Use `create(ASNBlockSaveResponseSchema)` to create a new message.
+ */
+export const ASNBlockSaveResponseSchema: GenMessage<ASNBlockSaveResponse> = /*@__PURE__*/
+  messageDesc(file_network_v1_asn, 3);
+
+/**
+ * @generated from message network.v1.ASNBlockDeleteRequest
+ */
+export type ASNBlockDeleteRequest = Message<"network.v1.ASNBlockDeleteRequest"> & {
+  /**
+   * @generated from field: int64 as_num = 1 [jstype = JS_STRING];
+   */
+  asNum: string;
+};
+
+/**
+ * Describes the message network.v1.ASNBlockDeleteRequest.
+ * Use `create(ASNBlockDeleteRequestSchema)` to create a new message.
+ */
+export const ASNBlockDeleteRequestSchema: GenMessage<ASNBlockDeleteRequest> = /*@__PURE__*/
+  messageDesc(file_network_v1_asn, 4);
+
+/**
+ * @generated from service network.v1.ASNService
+ */
+export const ASNService: GenService<{
+  /**
+   * @generated from rpc network.v1.ASNService.ASNBlocks
+   */
+  aSNBlocks: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ASNBlocksResponseSchema;
+  },
+  /**
+   * @generated from rpc network.v1.ASNService.ASNBlockSave
+   */
+  aSNBlockSave: {
+    methodKind: "unary";
+    input: typeof ASNBlockSaveRequestSchema;
+    output: typeof ASNBlockSaveResponseSchema;
+  },
+  /**
+   * @generated from rpc network.v1.ASNService.ASNBlockDelete
+   */
+  aSNBlockDelete: {
+    methodKind: "unary";
+    input: typeof ASNBlockDeleteRequestSchema;
+    output: typeof EmptySchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_network_v1_asn, 0);
+
@@ -18,3 +18,10 @@ export const queryNetwork = NetworkService.method.queryNetwork;
  * @generated from rpc network.v1.NetworkService.UpdateDB
  */
 export const updateDB = NetworkService.method.updateDB;
+
+/**
+ * Accounts likely operated by the same person, based on shared connection addresses.
+ *
+ * @generated from rpc network.v1.NetworkService.AltAccounts
+ */
+export const altAccounts = NetworkService.method.altAccounts;
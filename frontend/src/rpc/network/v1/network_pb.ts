@@ -15,7 +15,94 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file network/v1/network.proto.
  */
 export const file_network_v1_network: GenFile = /*@__PURE__*/
-  fileDesc("ChhuZXR3b3JrL3YxL25ldHdvcmsucHJvdG8SCm5ldHdvcmsudjEiTAoTUXVlcnlOZXR3b3JrUmVxdWVzdBIpCgZmaWx0ZXIYASABKAsyGS5kYXRhYmFzZS5xdWVyeS52MS5GaWx0ZXISCgoCaXAYAiABKAkiiQEKB0RldGFpbHMSLgoIbG9jYXRpb24YASABKAsyFC5uZXR3b3JrLnYxLkxvY2F0aW9uQga6SAPIAQESJAoDYXNuGAIgASgLMg8ubmV0d29yay52MS5BU05CBrpIA8gBARIoCgVwcm94eRgDIAEoCzIRLm5ldHdvcmsudjEuUHJveHlCBrpIA8gBASLDAQoITG9jYXRpb24SFAoEY2lkchgBIAEoCUIGukgDyAEBEhwKDGNvdW50cnlfY29kZRgCIAEoCUIGukgDyAEBEhwKDGNvdW50cnlfbmFtZRgDIAEoCUIGukgDyAEBEhsKC3JlZ2lvbl9uYW1lGAQgASgJQga6SAPIAQESGQoJY2l0eV9uYW1lGAUgASgJQga6SAPIAQESLQoIbGF0X2xvbmcYBiABKAsyEy5uZXR3b3JrLnYxLkxhdExvbmdCBrpIA8gBASJOCgNBU04SFAoEY2lkchgBIAEoCUIGukgDyAEBEhgKBmFzX251bRgCIAEoBEIIMAG6SAPIAQESFwoHYXNfbmFtZRgDIAEoCUIGukgDyAEBIuoCCgVQcm94eRIUCgRjaWRyGAEgASgJQga6SAPIAQESMQoKcHJveHlfdHlwZRgCIAEoDjIVLm5ldHdvcmsudjEuUHJveHlUeXBlQga6SAPIAQESFAoMY291bnRyeV9jb2RlGAMgASgJEhQKDGNvdW50cnlfbmFtZRgEIAEoCRITCgtyZWdpb25fbmFtZRgFIAEoCRIRCgljaXR5X25hbWUYBiABKAkSCwoDaXNwGAcgASgJEg4KBmRvbWFpbhgIIAEoCRIpCgp1c2FnZV90eXBlGAkgASgOMhUubmV0d29yay52MS5Vc2FnZVR5cGUSDwoDYXNuGAogASgDQgIwARIPCgdhc19uYW1lGAsgASgJEi0KCWxhc3Rfc2VlbhgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKwoLdGhyZWF0X3R5cGUYDSABKA4yFi5uZXR3b3JrLnYxLlRocmVhdFR5cGUiVgoHTGF0TG9uZxIkCghsYXRpdHVkZRgBIAEoAkISukgPyAEBCgodAAC0Qi0AALTCEiUKCWxvbmdpdHVkZRgCIAEoAkISukgPyAEBCgodAAA0Qy0AADTDIkQKFFF1ZXJ5TmV0d29ya1Jlc3BvbnNlEiwKB2RldGFpbHMYASABKAsyEy5uZXR3b3JrLnYxLkRldGFpbHNCBrpIA8gBASLdAQoXUXVlcnlDb25uZWN0aW9uc1JlcXVlc3QSKQoGZmlsdGVyGAEgASgLMhkuZGF0YWJhc2UucXVlcnkudjEuRmlsdGVyEiMKCHN0ZWFtX2lkGAIgASgDQhEwAbpIDCIKKIGAgICQgICIARIWCgRjaWRyGAMgASgJQgi6SAVyA9gBARIeCgxjb3VudHJ5X2NvZGUYBCABKAlCCLpIBXIDmAECEhQKDGNvdW50cnlfbmFtZRgFIAEoCRIRCgljaXR5X25hbWUYBiABKAkSEQoJc2VydmVyX2lkGAcgAygFIlQKGFF1ZXJ5Q29ubmVjdGlvbnNSZXNwb25zZRI4Cgpjb25uZWN0aW9uGAEgAygLMhwubmV0d29yay52MS5QZXJzb25Db25uZWN0aW9uQga6SAPIAQEi0gMKEFBlcnNvbkNvbm5lY3Rpb24SKgoUcGVyc29uX2Nvbm5lY3Rpb25faWQYASABKANCDDABukgHyAEBIgIgABIbCgdpcF9hZGRyGAIgASgJQgq6SAfIAQFyAngBEiYKCHN0ZWFtX2lkGAMgASgDQhQwAbpID8gBASIKKIGAgICQgICIARIcCgxwZXJzb25hX25hbWUYBCABKAlCBrpIA8gBARIdCglzZXJ2ZXJfaWQYBSABKAVCCrpIB8gBARoCIAASIQoRc2VydmVyX25hbWVfc2hvcnQYBiABKAlCBrpIA8gBARIWCgZhc19udW0YByABKAVCBrpIA8gBARIXCgdhc19uYW1lGAggASgJQga6SAPIAQESHAoMY291bnRyeV9jb2RlGAkgASgJQga6SAPIAQESHAoMY291bnRyeV9uYW1lGAogASgJQga6SAPIAQESGQoJY2l0eV9uYW1lGAsgASgJQga6SAPIAQESLQoIbG9jYXRpb24YDCABKAsyEy5uZXR3b3JrLnYxLkxhdExvbmdCBrpIA8gBARI2CgpjcmVhdGVkX29uGA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBKtABCgpUaHJlYXRUeXBlEhsKF1RIUkVBVF9UWVBFX1VOU1BFQ0lGSUVEEAASFAoQVEhSRUFUX1RZUEVfU1BBTRABEhYKElRIUkVBVF9UWVBFX0JPVE5FVBACEhcKE1RIUkVBVF9UWVBFX1NDQU5ORVIQAxIbChdUSFJFQVRfVFlQRV9TUEFNX0JPVE5FVBAEEhwKGFRIUkVBVF9UWVBFX1NQQU1fU0NBTk5FUhAFEiMKH1RIUkVBVF9UWVBFX1NQQU1fU0NBTk5FUl9CT1RORVQQBirLAgoJVXNhZ2VUeXBlEhoKFlVTQUdFX1RZUEVfVU5TUEVDSUZJRUQQABISCg5VU0FHRV9UWVBFX0NEThABEh8KG1VTQUdFX1RZUEVfSVNQX0ZJWEVEX01PQklMRRACEhkKFVVTQUdFX1RZUEVfQ09NTUVSQ0lBTBADEhkKFVVTQUdFX1RZUEVfSVNQX01PQklMRRAEEhYKElVTQUdFX1RZUEVfTElCUkFSWRAFEhoKFlVTQUdFX1RZUEVfREFUQV9DRU5URVIQBhIXChNVU0FHRV9UWVBFX01JTElUQVJZEAcSGQoVVVNBR0VfVFlQRV9HT1ZFUk5NRU5UEAgSGAoUVVNBR0VfVFlQRV9JU1BfRklYRUQQCRIbChdVU0FHRV9UWVBFX09SR0FOSVpBVElPThAKEhgKFFVTQUdFX1RZUEVfRURVQ0FUSU9OEAsqKwoJUHJveHlUeXBlEh4KGlBST1hZX1RZUEVfUFVCX1VOU1BFQ0lGSUVEEAAyhAIKDk5ldHdvcmtTZXJ2aWNlEl8KEFF1ZXJ5Q29ubmVjdGlvbnMSIy5uZXR3b3JrLnYxLlF1ZXJ5Q29ubmVjdGlvbnNSZXF1ZXN0GiQubmV0d29yay52MS5RdWVyeUNvbm5lY3Rpb25zUmVzcG9uc2UiABJTCgxRdWVyeU5ldHdvcmsSHy5uZXR3b3JrLnYxLlF1ZXJ5TmV0d29ya1JlcXVlc3QaIC5uZXR3b3JrLnYxLlF1ZXJ5TmV0d29ya1Jlc3BvbnNlIgASPAoIVXBkYXRlREISFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiAEKmAQoOY29tLm5ldHdvcmsudjFCDE5ldHdvcmtQcm90b1ABWj1naXRodWIuY29tL2xlaWdobWFjZG9uYWxkL2diYW5zL2ludGVybmFsL25ldHdvcmsvdjE7bmV0d29ya3YxogIDTlhYqgIKTmV0d29yay5WMcoCCk5ldHdvcmtcVjHiAhZOZXR3b3JrXFYxXEdQQk1ldGFkYXRh6gILTmV0d29yazo6VjFiCGVkaXRpb25zcOgH", [file_buf_validate_validate, file_database_query_v1_filter, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+  fileDesc("ChhuZXR3b3JrL3YxL25ldHdvcmsucHJvdG8SCm5ldHdvcmsudjEiMAoSQWx0QWNjb3VudHNSZXF1ZXN0EhoKCHN0ZWFtX2lkGAEgASgDQggwAbpIA8gBASK5AQoKQWx0QWNjb3VudBIUCghzdGVhbV9pZBgBIAEoA0ICMAESFAoMcGVyc29uYV9uYW1lGAIgASgJEg0KBXNjb3JlGAMgASgBEhgKEHNoYXJlZF9hZGRyZXNzZXMYBCABKAUSLwoLbGFzdF9zaGFyZWQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWRlcHRoGAYgASgFEhYKCmxpbmtlZF92aWEYByABKANCAjABIj8KE0FsdEFjY291bnRzUmVzcG9uc2USKAoIYWNjb3VudHMYASADKAsyFi5uZXR3b3JrLnYxLkFsdEFjY291bnQiTAoTUXVlcnlOZXR3b3JrUmVxdWVzdBIpCgZmaWx0ZXIYASABKAsyGS5kYXRhYmFzZS5xdWVyeS52MS5GaWx0ZXISCgoCaXAYAiABKAkiiQEKB0RldGFpbHMSLgoIbG9jYXRpb24YASABKAsyFC5uZXR3b3JrLnYxLkxvY2F0aW9uQga6SAPIAQESJAoDYXNuGAIgASgLMg8ubmV0d29yay52MS5BU05CBrpIA8gBARIoCgVwcm94eRgDIAEoCzIRLm5ldHdvcmsudjEuUHJveHlCBrpIA8gBASLDAQoITG9jYXRpb24SFAoEY2lkchgBIAEoCUIGukgDyAEBEhwKDGNvdW50cnlfY29kZRgCIAEoCUIGukgDyAEBEhwKDGNvdW50cnlfbmFtZRgDIAEoCUIGukgDyAEBEhsKC3JlZ2lvbl9uYW1lGAQgASgJQga6SAPIAQESGQoJY2l0eV9uYW1lGAUgASgJQga6SAPIAQESLQoIbGF0X2xvbmcYBiABKAsyEy5uZXR3b3JrLnYxLkxhdExvbmdCBrpIA8gBASJOCgNBU04SFAoEY2lkchgBIAEoCUIGukgDyAEBEhgKBmFzX251bRgCIAEoBEIIMAG6SAPIAQESFwoHYXNfbmFtZRgDIAEoCUIGukgDyAEBIuoCCgVQcm94eRIUCgRjaWRyGAEgASgJQga6SAPIAQESMQoKcHJveHlfdHlwZRgCIAEoDjIVLm5ldHdvcmsudjEuUHJveHlUeXBlQga6SAPIAQESFAoMY291bnRyeV9jb2RlGAMgASgJEhQKDGNvdW50cnlfbmFtZRgEIAEoCRITCgtyZWdpb25fbmFtZRgFIAEoCRIRCgljaXR5X25hbWUYBiABKAkSCwoDaXNwGAcgASgJEg4KBmRvbWFpbhgIIAEoCRIpCgp1c2FnZV90eXBlGAkgASgOMhUubmV0d29yay52MS5Vc2FnZVR5cGUSDwoDYXNuGAogASgDQgIwARIPCgdhc19uYW1lGAsgASgJEi0KCWxhc3Rfc2VlbhgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKwoLdGhyZWF0X3R5cGUYDSABKA4yFi5uZXR3b3JrLnYxLlRocmVhdFR5cGUiVgoHTGF0TG9uZxIkCghsYXRpdHVkZRgBIAEoAkISukgPyAEBCgodAAC0Qi0AALTCEiUKCWxvbmdpdHVkZRgCIAEoAkISukgPyAEBCgodAAA0Qy0AADTDIkQKFFF1ZXJ5TmV0d29ya1Jlc3BvbnNlEiwKB2RldGFpbHMYASABKAsyEy5uZXR3b3JrLnYxLkRldGFpbHNCBrpIA8gBASLdAQoXUXVlcnlDb25uZWN0aW9uc1JlcXVlc3QSKQoGZmlsdGVyGAEgASgLMhkuZGF0YWJhc2UucXVlcnkudjEuRmlsdGVyEiMKCHN0ZWFtX2lkGAIgASgDQhEwAbpIDCIKKIGAgICQgICIARIWCgRjaWRyGAMgASgJQgi6SAVyA9gBARIeCgxjb3VudHJ5X2NvZGUYBCABKAlCCLpIBXIDmAECEhQKDGNvdW50cnlfbmFtZRgFIAEoCRIRCgljaXR5X25hbWUYBiABKAkSEQoJc2VydmVyX2lkGAcgAygFIlQKGFF1ZXJ5Q29ubmVjdGlvbnNSZXNwb25zZRI4Cgpjb25uZWN0aW9uGAEgAygLMhwubmV0d29yay52MS5QZXJzb25Db25uZWN0aW9uQga6SAPIAQEi0gMKEFBlcnNvbkNvbm5lY3Rpb24SKgoUcGVyc29uX2Nvbm5lY3Rpb25faWQYASABKANCDDABukgHyAEBIgIgABIbCgdpcF9hZGRyGAIgASgJQgq6SAfIAQFyAngBEiYKCHN0ZWFtX2lkGAMgASgDQhQwAbpID8gBASIKKIGAgICQgICIARIcCgxwZXJzb25hX25hbWUYBCABKAlCBrpIA8gBARIdCglzZXJ2ZXJfaWQYBSABKAVCCrpIB8gBARoCIAASIQoRc2VydmVyX25hbWVfc2hvcnQYBiABKAlCBrpIA8gBARIWCgZhc19udW0YByABKAVCBrpIA8gBARIXCgdhc19uYW1lGAggASgJQga6SAPIAQESHAoMY291bnRyeV9jb2RlGAkgASgJQga6SAPIAQESHAoMY291bnRyeV9uYW1lGAogASgJQga6SAPIAQESGQoJY2l0eV9uYW1lGAsgASgJQga6SAPIAQESLQoIbG9jYXRpb24YDCABKAsyEy5uZXR3b3JrLnYxLkxhdExvbmdCBrpIA8gBARI2CgpjcmVhdGVkX29uGA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBKtABCgpUaHJlYXRUeXBlEhsKF1RIUkVBVF9UWVBFX1VOU1BFQ0lGSUVEEAASFAoQVEhSRUFUX1RZUEVfU1BBTRABEhYKElRIUkVBVF9UWVBFX0JPVE5FVBACEhcKE1RIUkVBVF9UWVBFX1NDQU5ORVIQAxIbChdUSFJFQVRfVFlQRV9TUEFNX0JPVE5FVBAEEhwKGFRIUkVBVF9UWVBFX1NQQU1fU0NBTk5FUhAFEiMKH1RIUkVBVF9UWVBFX1NQQU1fU0NBTk5FUl9CT1RORVQQBirLAgoJVXNhZ2VUeXBlEhoKFlVTQUdFX1RZUEVfVU5TUEVDSUZJRUQQABISCg5VU0FHRV9UWVBFX0NEThABEh8KG1VTQUdFX1RZUEVfSVNQX0ZJWEVEX01PQklMRRACEhkKFVVTQUdFX1RZUEVfQ09NTUVSQ0lBTBADEhkKFVVTQUdFX1RZUEVfSVNQX01PQklMRRAEEhYKElVTQUdFX1RZUEVfTElCUkFSWRAFEhoKFlVTQUdFX1RZUEVfREFUQV9DRU5URVIQBhIXChNVU0FHRV9UWVBFX01JTElUQVJZEAcSGQoVVVNBR0VfVFlQRV9HT1ZFUk5NRU5UEAgSGAoUVVNBR0VfVFlQRV9JU1BfRklYRUQQCRIbChdVU0FHRV9UWVBFX09SR0FOSVpBVElPThAKEhgKFFVTQUdFX1RZUEVfRURVQ0FUSU9OEAsqKwoJUHJveHlUeXBlEh4KGlBST1hZX1RZUEVfUFVCX1VOU1BFQ0lGSUVEEAAy1gIKDk5ldHdvcmtTZXJ2aWNlEl8KEFF1ZXJ5Q29ubmVjdGlvbnMSIy5uZXR3b3JrLnYxLlF1ZXJ5Q29ubmVjdGlvbnNSZXF1ZXN0GiQubmV0d29yay52MS5RdWVyeUNvbm5lY3Rpb25zUmVzcG9uc2UiABJTCgxRdWVyeU5ldHdvcmsSHy5uZXR3b3JrLnYxLlF1ZXJ5TmV0d29ya1JlcXVlc3QaIC5uZXR3b3JrLnYxLlF1ZXJ5TmV0d29ya1Jlc3BvbnNlIgASPAoIVXBkYXRlREISFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5nb29nbGUucHJvdG9idWYuRW1wdHkiABJQCgtBbHRBY2NvdW50cxIeLm5ldHdvcmsudjEuQWx0QWNjb3VudHNSZXF1ZXN0Gh8ubmV0d29yay52MS5BbHRBY2NvdW50c1Jlc3BvbnNlIgBCpgEKDmNvbS5uZXR3b3JrLnYxQgxOZXR3b3JrUHJvdG9QAVo9Z2l0aHViLmNvbS9sZWlnaG1hY2RvbmFsZC9nYmFucy9pbnRlcm5hbC9uZXR3b3JrL3YxO25ldHdvcmt2MaICA05YWKoCCk5ldHdvcmsuVjHKAgpOZXR3b3JrXFYx4gIWTmV0d29ya1xWMVxHUEJNZXRhZGF0YeoCC05ldHdvcms6OlYxYghlZGl0aW9uc3DoBw", [file_buf_validate_validate, file_database_query_v1_filter, file_google_protobuf_empty, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message network.v1.AltAccountsRequest
+ */
+export type AltAccountsRequest = Message<"network.v1.AltAccountsRequest"> & {
+  /**
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+};
+
+/**
+ * Describes the message network.v1.AltAccountsRequest.
+ * Use `create(AltAccountsRequestSchema)` to create a new message.
+ */
+export const AltAccountsRequestSchema: GenMessage<AltAccountsRequest> = /*@__PURE__*/
+  messageDesc(file_network_v1_network, 0);
+
+/**
+ * @generated from message network.v1.AltAccount
+ */
+export type AltAccount = Message<"network.v1.AltAccount"> & {
+  /**
+   * @generated from field: int64 steam_id = 1 [jstype = JS_STRING];
+   */
+  steamId: string;
+
+  /**
+   * @generated from field: string persona_name = 2;
+   */
+  personaName: string;
+
+  /**
+   * Likelihood between 0 and 1 of the account being an alt.
+   *
+   * @generated from field: double score = 3;
+   */
+  score: number;
+
+  /**
+   * @generated from field: int32 shared_addresses = 4;
+   */
+  sharedAddresses: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_shared = 5;
+   */
+  lastShared?: Timestamp | undefined;
+
+  /**
+   * 1 for accounts sharing addresses directly with the player, 2 when linked through another alt.
+   *
+   * @generated from field: int32 depth = 6;
+   */
+  depth: number;
+
+  /**
+   * The account that linked this one when depth > 1.
+   *
+   * @generated from field: int64 linked_via = 7 [jstype = JS_STRING];
+   */
+  linkedVia: string;
+};
+
+/**
+ * Describes the message network.v1.AltAccount.
+ * Use `create(AltAccountSchema)` to create a new message.
+ */
+export const AltAccountSchema: GenMessage<AltAccount> = /*@__PURE__*/
+  messageDesc(file_network_v1_network, 1);
+
+/**
+ * @generated from message network.v1.AltAccountsResponse
+ */
+export type AltAccountsResponse = Message<"network.v1.AltAccountsResponse"> & {
+  /**
+   * @generated from field: repeated network.v1.AltAccount accounts = 1;
+   */
+  accounts: AltAccount[];
+};
+
+/**
+ * Describes the message network.v1.AltAccountsResponse.
+ * Use `create(AltAccountsResponseSchema)` to create a new message.
+ */
+export const AltAccountsResponseSchema: GenMessage<AltAccountsResponse> = /*@__PURE__*/
+  messageDesc(file_network_v1_network, 2);
 
 /**
  * @generated from message network.v1.QueryNetworkRequest
@@ -37,7 +124,7 @@ export type QueryNetworkRequest = Message<"network.v1.QueryNetworkRequest"> & {
  * Use `create(QueryNetworkRequestSchema)` to create a new message.
  */
 export const QueryNetworkRequestSchema: GenMessage<QueryNetworkRequest> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 0);
+  messageDesc(file_network_v1_network, 3);
 
 /**
  * @generated from message network.v1.Details
@@ -64,7 +151,7 @@ export type Details = Message<"network.v1.Details"> & {
  * Use `create(DetailsSchema)` to create a new message.
  */
 export const DetailsSchema: GenMessage<Details> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 1);
+  messageDesc(file_network_v1_network, 4);
 
 /**
  * @generated from message network.v1.Location
@@ -106,7 +193,7 @@ export type Location = Message<"network.v1.Location"> & {
  * Use `create(LocationSchema)` to create a new message.
  */
 export const LocationSchema: GenMessage<Location> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 2);
+  messageDesc(file_network_v1_network, 5);
 
 /**
  * @generated from message network.v1.ASN
@@ -133,7 +220,7 @@ export type ASN = Message<"network.v1.ASN"> & {
  * Use `create(ASNSchema)` to create a new message.
  */
 export const ASNSchema: GenMessage<ASN> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 3);
+  messageDesc(file_network_v1_network, 6);
 
 /**
  * @generated from message network.v1.Proxy
@@ -210,7 +297,7 @@ export type Proxy = Message<"network.v1.Proxy"> & {
  * Use `create(ProxySchema)` to create a new message.
  */
 export const ProxySchema: GenMessage<Proxy> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 4);
+  messageDesc(file_network_v1_network, 7);
 
 /**
  * @generated from message network.v1.LatLong
@@ -232,7 +319,7 @@ export type LatLong = Message<"network.v1.LatLong"> & {
  * Use `create(LatLongSchema)` to create a new message.
  */
 export const LatLongSchema: GenMessage<LatLong> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 5);
+  messageDesc(file_network_v1_network, 8);
 
 /**
  * @generated from message network.v1.QueryNetworkResponse
@@ -249,7 +336,7 @@ export type QueryNetworkResponse = Message<"network.v1.QueryNetworkResponse"> &
  * Use `create(QueryNetworkResponseSchema)` to create a new message.
  */
 export const QueryNetworkResponseSchema: GenMessage<QueryNetworkResponse> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 6);
+  messageDesc(file_network_v1_network, 9);
 
 /**
  * @generated from message network.v1.QueryConnectionsRequest
@@ -296,7 +383,7 @@ export type QueryConnectionsRequest = Message<"network.v1.QueryConnectionsReques
  * Use `create(QueryConnectionsRequestSchema)` to create a new message.
  */
 export const QueryConnectionsRequestSchema: GenMessage<QueryConnectionsRequest> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 7);
+  messageDesc(file_network_v1_network, 10);
 
 /**
  * @generated from message network.v1.QueryConnectionsResponse
@@ -313,7 +400,7 @@ export type QueryConnectionsResponse = Message<"network.v1.QueryConnectionsRespo
  * Use `create(QueryConnectionsResponseSchema)` to create a new message.
  */
 export const QueryConnectionsResponseSchema: GenMessage<QueryConnectionsResponse> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 8);
+  messageDesc(file_network_v1_network, 11);
 
 /**
  * @generated from message network.v1.PersonConnection
@@ -390,7 +477,7 @@ export type PersonConnection = Message<"network.v1.PersonConnection"> & {
  * Use `create(PersonConnectionSchema)` to create a new message.
  */
 export const PersonConnectionSchema: GenMessage<PersonConnection> = /*@__PURE__*/
-  messageDesc(file_network_v1_network, 9);
+  messageDesc(file_network_v1_network, 12);
 
 /**
  * @generated from enum network.v1.ThreatType
@@ -553,6 +640,16 @@ export const NetworkService: GenService<{
     input: typeof EmptySchema;
     output: typeof EmptySchema;
   },
+  /**
+   * Accounts likely operated by the same person, based on shared connection addresses.
+   *
+   * @generated from rpc network.v1.NetworkService.AltAccounts
+   */
+  altAccounts: {
+    methodKind: "unary";
+    input: typeof AltAccountsRequestSchema;
+    output: typeof AltAccountsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_network_v1_network, 0);
 
@@ -33,3 +33,25 @@ export const serversAdmin = ServersService.method.serversAdmin;
  * @generated from rpc servers.v1.ServersService.QueryLogs
  */
 export const queryLogs = ServersService.method.queryLogs;
+
+/**
+ * DownloadLog returns a full day of a servers log in the standard srcds log format.
+ *
+ * @generated from rpc servers.v1.ServersService.DownloadLog
+ */
+export const downloadLog = ServersService.method.downloadLog;
+
+/**
+ * @generated from rpc servers.v1.ServersService.LogArchives
+ */
+export const logArchives = ServersService.method.logArchives;
+
+/**
+ * @generated from rpc servers.v1.ServersService.LogPolicies
+ */
+export const logPolicies = ServersService.method.logPolicies;
+
+/**
+ * @generated from rpc servers.v1.ServersService.SaveLogPolicy
+ */
+export const saveLogPolicy = ServersService.method.saveLogPolicy;
@@ -5,8 +5,10 @@
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
-import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_empty, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Filter } from "../../database/query/v1/filter_pb";
+import { file_database_query_v1_filter } from "../../database/query/v1/filter_pb";
+import type { EmptySchema, Struct, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_empty, file_google_protobuf_struct, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { LatLong } from "../../network/v1/network_pb";
 import { file_network_v1_network } from "../../network/v1/network_pb";
 import type { Message } from "@bufbuild/protobuf";
@@ -15,7 +17,101 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file servers/v1/servers.proto.
  */
 export const file_servers_v1_servers: GenFile = /*@__PURE__*/
-  fileDesc("ChhzZXJ2ZXJzL3YxL3NlcnZlcnMucHJvdG8SCnNlcnZlcnMudjEiMgoQUXVlcnlMb2dzUmVxdWVzdBIeCglzZXJ2ZXJfaWQYASADKAVCC7pICMgBAZIBAggBIp0BCglTZXJ2ZXJMb2cSHQoJc2VydmVyX2lkGAEgASgFQgq6SAfIAQEaAiAAEh8KC3NlcnZlcl9uYW1lGAIgASgJQgq6SAfIAQFyAhABEhgKBGJvZHkYAyABKAlCCrpIB8gBAXICEAESNgoKY3JlYXRlZF9vbhgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASJHChFRdWVyeUxvZ3NSZXNwb25zZRIjCgRsb2dzGAEgAygLMhUuc2VydmVycy52MS5TZXJ2ZXJMb2cSDQoFY291bnQYAiABKAUi/gMKClNhZmVTZXJ2ZXISHQoJc2VydmVyX2lkGAEgASgFQgq6SAfIAQEaAiAAEhkKBGhvc3QYAiABKAlCC7pICMgBAXIDqAEBEhoKBHBvcnQYAyABKA1CDLpICcgBASoEGP//AxIWCgJpcBgEIAEoCUIKukgHyAEBcgJ4ARIYCgRuYW1lGAUgASgJQgq6SAfIAQFyAhABEh4KCm5hbWVfc2hvcnQYBiABKAlCCrpIB8gBAXICEAESFgoGcmVnaW9uGAcgASgJQga6SAPIAQESFAoCY2MYCCABKAlCCLpIBXIDmAECEhsKB3BsYXllcnMYCSABKAVCCrpIB8gBARoCKAASHwoLbWF4X3BsYXllcnMYCiABKAVCCrpIB8gBARoCKAASFwoDYm90GAsgASgFQgq6SAfIAQEaAigAEhMKA21hcBgMIAEoCUIGukgDyAEBEhoKCmdhbWVfdHlwZXMYDSADKAlCBrpIA8gBARItCghsYXRfbG9uZxgOIAEoCzITLm5ldHdvcmsudjEuTGF0TG9uZ0IGukgDyAEBEhgKCGRpc3RhbmNlGA8gASgCQga6SAPIAQESGgoGaHVtYW5zGBAgASgFQgq6SAfIAQEaAigAEhQKBHRhZ3MYESADKAlCBrpIA8gBARIXCg9zdGF0c19idWNrZXRfaWQYEiABKAUixwUKBlNlcnZlchIdCglzZXJ2ZXJfaWQYASABKAVCCrpIB8gBARoCKAASHgoKc2hvcnRfbmFtZRgCIAEoCUIKukgHyAEBcgIQARIYCgRuYW1lGAMgASgJQgq6SAfIAQFyAhABEhwKB2FkZHJlc3MYBCABKAlCC7pICMgBAXIDqAEBEiIKEGFkZHJlc3NfaW50ZXJuYWwYBSABKAlCCLpIBXIDqAEBEhMKC3Nkcl9lbmFibGVkGAYgASgIEhoKBHBvcnQYByABKA1CDLpICcgBASoEGP//AxIYCgRyY29uGAggASgJQgq6SAfIAQFyAhABEhkKCHBhc3N3b3JkGAkgASgJQge6SARyAhABEhoKCmlzX2VuYWJsZWQYCiABKAhCBrpIA8gBARIPCgdkZWxldGVkGAsgASgIEhYKBnJlZ2lvbhgMIAEoCUIGukgDyAEBEhQKAmNjGA0gASgJQgi6SAVyA5gBAhIlCghsYXRfbG9uZxgOIAEoCzITLm5ldHdvcmsudjEuTGF0TG9uZxIgCgpsb2dfc2VjcmV0GA8gASgNQgy6SAnIAQEqBCCgjQYSFAoMZW5hYmxlX3N0YXRzGBAgASgIEjQKEHRva2VuX2NyZWF0ZWRfb24YESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfb24YEiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfb24YEyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEh8KF2Rpc2NvcmRfc2VlZF9jaGFubmVsX2lkGBQgASgJEh0KFWRpc2NvcmRfc2VlZF9yb2xlX2lkcxgVIAMoCRITCgJpcBgWIAEoCUIHukgEcgJ4ARIXCg9zdGF0c19idWNrZXRfaWQYFyABKAUibwoNU3RhdGVSZXNwb25zZRIvCgdzZXJ2ZXJzGAEgAygLMhYuc2VydmVycy52MS5TYWZlU2VydmVyQga6SAPIAQESLQoIbGF0X2xvbmcYAiABKAsyEy5uZXR3b3JrLnYxLkxhdExvbmdCBrpIA8gBASKGAQoOU2VydmVySW5mb1NhZmUSJAoQc2VydmVyX25hbWVfbG9uZxgBIAEoCUIKukgHyAEBcgIQARIfCgtzZXJ2ZXJfbmFtZRgCIAEoCUIKukgHyAEBcgIQARIdCglzZXJ2ZXJfaWQYAyABKAVCCrpIB8gBARoCIAASDgoGY29sb3VyGAQgASgJIkYKD1NlcnZlcnNSZXNwb25zZRIzCgdzZXJ2ZXJzGAEgAygLMhouc2VydmVycy52MS5TZXJ2ZXJJbmZvU2FmZUIGukgDyAEBIj8KEUVkaXRTZXJ2ZXJSZXF1ZXN0EioKBnNlcnZlchgBIAEoCzISLnNlcnZlcnMudjEuU2VydmVyQga6SAPIAQEiQAoSRWRpdFNlcnZlclJlc3BvbnNlEioKBnNlcnZlchgBIAEoCzISLnNlcnZlcnMudjEuU2VydmVyQga6SAPIAQEiNAoTRGVsZXRlU2VydmVyUmVxdWVzdBIdCglzZXJ2ZXJfaWQYASABKAVCCrpIB8gBARoCIAAiQwoUU2VydmVyc0FkbWluUmVzcG9uc2USKwoHc2VydmVycxgBIAMoCzISLnNlcnZlcnMudjEuU2VydmVyQga6SAPIAQEyuAMKDlNlcnZlcnNTZXJ2aWNlEjoKBVN0YXRlEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ghkuc2VydmVycy52MS5TdGF0ZVJlc3BvbnNlEj4KB1NlcnZlcnMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGy5zZXJ2ZXJzLnYxLlNlcnZlcnNSZXNwb25zZRJLCgpFZGl0U2VydmVyEh0uc2VydmVycy52MS5FZGl0U2VydmVyUmVxdWVzdBoeLnNlcnZlcnMudjEuRWRpdFNlcnZlclJlc3BvbnNlEkcKDERlbGV0ZVNlcnZlchIfLnNlcnZlcnMudjEuRGVsZXRlU2VydmVyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJICgxTZXJ2ZXJzQWRtaW4SFi5nb29nbGUucHJvdG9idWYuRW1wdHkaIC5zZXJ2ZXJzLnYxLlNlcnZlcnNBZG1pblJlc3BvbnNlEkoKCVF1ZXJ5TG9ncxIcLnNlcnZlcnMudjEuUXVlcnlMb2dzUmVxdWVzdBodLnNlcnZlcnMudjEuUXVlcnlMb2dzUmVzcG9uc2UiAEKmAQoOY29tLnNlcnZlcnMudjFCDFNlcnZlcnNQcm90b1ABWj1naXRodWIuY29tL2xlaWdobWFjZG9uYWxkL2diYW5zL2ludGVybmFsL3NlcnZlcnMvdjE7c2VydmVyc3YxogIDU1hYqgIKU2VydmVycy5WMcoCClNlcnZlcnNcVjHiAhZTZXJ2ZXJzXFYxXEdQQk1ldGFkYXRh6gILU2VydmVyczo6VjFiCGVkaXRpb25zcOgH", [file_buf_validate_validate, file_google_protobuf_empty, file_google_protobuf_timestamp, file_network_v1_network]);
+  fileDesc("ChhzZXJ2ZXJzL3YxL3NlcnZlcnMucHJvdG8SCnNlcnZlcnMudjEiWgoTU3RyZWFtRXZlbnRzUmVxdWVzdBIgCgpzZXJ2ZXJfaWRzGAEgAygFQgy6SAmSAQYiBBoCIAASIQoLZXZlbnRfdHlwZXMYAiADKAVCDLpICZIBBiIEGgIoACKuAQoLU2VydmVyRXZlbnQSEQoJc2VydmVyX2lkGAEgASgFEhMKC3NlcnZlcl9uYW1lGAIgASgJEhIKCmV2ZW50X3R5cGUYAyABKAUSLgoKY3JlYXRlZF9vbhgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASCwoDcmF3GAUgASgJEiYKBWV2ZW50GAYgASgLMhcuZ29vZ2xlLnByb3RvYnVmLlN0cnVjdCJTChRTdHJlYW1FdmVudHNSZXNwb25zZRImCgVldmVudBgBIAEoCzIXLnNlcnZlcnMudjEuU2VydmVyRXZlbnQSEwoHZHJvcHBlZBgCIAEoBEICMAEi+AEKEFF1ZXJ5TG9nc1JlcXVlc3QSHgoJc2VydmVyX2lkGAEgAygFQgu6SAjIAQGSAQIIARIpCgZmaWx0ZXIYAiABKAsyGS5kYXRhYmFzZS5xdWVyeS52MS5GaWx0ZXISDQoFcXVlcnkYAyABKAkSIQoLZXZlbnRfdHlwZXMYBCADKAVCDLpICZIBBiIEGgIoABIVCglzdGVhbV9pZHMYBSADKANCAjABEigKBGZyb20YBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiYKAnRvGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLMAQoJU2VydmVyTG9nEh0KCXNlcnZlcl9pZBgBIAEoBUIKukgHyAEBGgIgABIfCgtzZXJ2ZXJfbmFtZRgCIAEoCUIKukgHyAEBcgIQARIYCgRib2R5GAMgASgJQgq6SAfIAQFyAhABEjYKCmNyZWF0ZWRfb24YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESGQoNc2VydmVyX2xvZ19pZBgFIAEoA0ICMAESEgoKZXZlbnRfdHlwZRgGIAEoBSJHChFRdWVyeUxvZ3NSZXNwb25zZRIjCgRsb2dzGAEgAygLMhUuc2VydmVycy52MS5TZXJ2ZXJMb2cSDQoFY291bnQYAiABKAUiaAoSRG93bmxvYWRMb2dSZXF1ZXN0Eh0KCXNlcnZlcl9pZBgBIAEoBUIKukgHyAEBGgIgABIzCgNkYXkYAiABKAlCJrpII8gBAXIeMhxeWzAtOV17NH0tWzAtOV17Mn0tWzAtOV17Mn0kIkoKE0Rvd25sb2FkTG9nUmVzcG9uc2USEAoIZmlsZW5hbWUYASABKAkSDwoHY29udGVudBgCIAEoDBIQCghhcmNoaXZlZBgDIAEoCCIzChJMb2dBcmNoaXZlc1JlcXVlc3QSHQoJc2VydmVyX2lkGAEgASgFQgq6SAfIAQEaAiAAIn0KCkxvZ0FyY2hpdmUSEQoJc2VydmVyX2lkGAEgASgFEgsKA2RheRgCIAEoCRIQCghhc3NldF9pZBgDIAEoCRINCgVsaW5lcxgEIAEoBRIuCgpjcmVhdGVkX29uGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI/ChNMb2dBcmNoaXZlc1Jlc3BvbnNlEigKCGFyY2hpdmVzGAEgAygLMhYuc2VydmVycy52MS5Mb2dBcmNoaXZlIrUBCglMb2dQb2xpY3kSHQoJc2VydmVyX2lkGAEgASgFQgq6SAfIAQEaAiAAEh0KDG1heF9hZ2VfZGF5cxgCIAEoBUIHukgEGgIoABIhCg5tYXhfc2l6ZV9ieXRlcxgDIAEoA0IJMAG6SAQiAigAEhcKD2FyY2hpdmVfZW5hYmxlZBgEIAEoCBIuCgp1cGRhdGVkX29uGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCI+ChNMb2dQb2xpY2llc1Jlc3BvbnNlEicKCHBvbGljaWVzGAEgAygLMhUuc2VydmVycy52MS5Mb2dQb2xpY3kiRQoUU2F2ZUxvZ1BvbGljeVJlcXVlc3QSLQoGcG9saWN5GAEgASgLMhUuc2VydmVycy52MS5Mb2dQb2xpY3lCBrpIA8gBASI+ChVTYXZlTG9nUG9saWN5UmVzcG9uc2USJQoGcG9saWN5GAEgASgLMhUuc2VydmVycy52MS5Mb2dQb2xpY3ki/gMKClNhZmVTZXJ2ZXISHQoJc2VydmVyX2lkGAEgASgFQgq6SAfIAQEaAiAAEhkKBGhvc3QYAiABKAlCC7pICMgBAXIDqAEBEhoKBHBvcnQYAyABKA1CDLpICcgBASoEGP//AxIWCgJpcBgEIAEoCUIKukgHyAEBcgJ4ARIYCgRuYW1lGAUgASgJQgq6SAfIAQFyAhABEh4KCm5hbWVfc2hvcnQYBiABKAlCCrpIB8gBAXICEAESFgoGcmVnaW9uGAcgASgJQga6SAPIAQESFAoCY2MYCCABKAlCCLpIBXIDmAECEhsKB3BsYXllcnMYCSABKAVCCrpIB8gBARoCKAASHwoLbWF4X3BsYXllcnMYCiABKAVCCrpIB8gBARoCKAASFwoDYm90GAsgASgFQgq6SAfIAQEaAigAEhMKA21hcBgMIAEoCUIGukgDyAEBEhoKCmdhbWVfdHlwZXMYDSADKAlCBrpIA8gBARItCghsYXRfbG9uZxgOIAEoCzITLm5ldHdvcmsudjEuTGF0TG9uZ0IGukgDyAEBEhgKCGRpc3RhbmNlGA8gASgCQga6SAPIAQESGgoGaHVtYW5zGBAgASgFQgq6SAfIAQEaAigAEhQKBHRhZ3MYESADKAlCBrpIA8gBARIXCg9zdGF0c19idWNrZXRfaWQYEiABKAUixwUKBlNlcnZlchIdCglzZXJ2ZXJfaWQYASABKAVCCrpIB8gBARoCKAASHgoKc2hvcnRfbmFtZRgCIAEoCUIKukgHyAEBcgIQARIYCgRuYW1lGAMgASgJQgq6SAfIAQFyAhABEhwKB2FkZHJlc3MYBCABKAlCC7pICMgBAXIDqAEBEiIKEGFkZHJlc3NfaW50ZXJuYWwYBSABKAlCCLpIBXIDqAEBEhMKC3Nkcl9lbmFibGVkGAYgASgIEhoKBHBvcnQYByABKA1CDLpICcgBASoEGP//AxIYCgRyY29uGAggASgJQgq6SAfIAQFyAhABEhkKCHBhc3N3b3JkGAkgASgJQge6SARyAhABEhoKCmlzX2VuYWJsZWQYCiABKAhCBrpIA8gBARIPCgdkZWxldGVkGAsgASgIEhYKBnJlZ2lvbhgMIAEoCUIGukgDyAEBEhQKAmNjGA0gASgJQgi6SAVyA5gBAhIlCghsYXRfbG9uZxgOIAEoCzITLm5ldHdvcmsudjEuTGF0TG9uZxIgCgpsb2dfc2VjcmV0GA8gASgNQgy6SAnIAQEqBCCgjQYSFAoMZW5hYmxlX3N0YXRzGBAgASgIEjQKEHRva2VuX2NyZWF0ZWRfb24YESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfb24YEiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfb24YEyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEh8KF2Rpc2NvcmRfc2VlZF9jaGFubmVsX2lkGBQgASgJEh0KFWRpc2NvcmRfc2VlZF9yb2xlX2lkcxgVIAMoCRITCgJpcBgWIAEoCUIHukgEcgJ4ARIXCg9zdGF0c19idWNrZXRfaWQYFyABKAUibwoNU3RhdGVSZXNwb25zZRIvCgdzZXJ2ZXJzGAEgAygLMhYuc2VydmVycy52MS5TYWZlU2VydmVyQga6SAPIAQESLQoIbGF0X2xvbmcYAiABKAsyEy5uZXR3b3JrLnYxLkxhdExvbmdCBrpIA8gBASKGAQoOU2VydmVySW5mb1NhZmUSJAoQc2VydmVyX25hbWVfbG9uZxgBIAEoCUIKukgHyAEBcgIQARIfCgtzZXJ2ZXJfbmFtZRgCIAEoCUIKukgHyAEBcgIQARIdCglzZXJ2ZXJfaWQYAyABKAVCCrpIB8gBARoCIAASDgoGY29sb3VyGAQgASgJIkYKD1NlcnZlcnNSZXNwb25zZRIzCgdzZXJ2ZXJzGAEgAygLMhouc2VydmVycy52MS5TZXJ2ZXJJbmZvU2FmZUIGukgDyAEBIj8KEUVkaXRTZXJ2ZXJSZXF1ZXN0EioKBnNlcnZlchgBIAEoCzISLnNlcnZlcnMudjEuU2VydmVyQga6SAPIAQEiQAoSRWRpdFNlcnZlclJlc3BvbnNlEioKBnNlcnZlchgBIAEoCzISLnNlcnZlcnMudjEuU2VydmVyQga6SAPIAQEiNAoTRGVsZXRlU2VydmVyUmVxdWVzdBIdCglzZXJ2ZXJfaWQYASABKAVCCrpIB8gBARoCIAAiQwoUU2VydmVyc0FkbWluUmVzcG9uc2USKwoHc2VydmVycxgBIAMoCzISLnNlcnZlcnMudjEuU2VydmVyQga6SAPIAQEy1QYKDlNlcnZlcnNTZXJ2aWNlEjoKBVN0YXRlEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Ghkuc2VydmVycy52MS5TdGF0ZVJlc3BvbnNlEj4KB1NlcnZlcnMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGy5zZXJ2ZXJzLnYxLlNlcnZlcnNSZXNwb25zZRJLCgpFZGl0U2VydmVyEh0uc2VydmVycy52MS5FZGl0U2VydmVyUmVxdWVzdBoeLnNlcnZlcnMudjEuRWRpdFNlcnZlclJlc3BvbnNlEkcKDERlbGV0ZVNlcnZlchIfLnNlcnZlcnMudjEuRGVsZXRlU2VydmVyUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJICgxTZXJ2ZXJzQWRtaW4SFi5nb29nbGUucHJvdG9idWYuRW1wdHkaIC5zZXJ2ZXJzLnYxLlNlcnZlcnNBZG1pblJlc3BvbnNlEkoKCVF1ZXJ5TG9ncxIcLnNlcnZlcnMudjEuUXVlcnlMb2dzUmVxdWVzdBodLnNlcnZlcnMudjEuUXVlcnlMb2dzUmVzcG9uc2UiABJQCgtEb3dubG9hZExvZxIeLnNlcnZlcnMudjEuRG93bmxvYWRMb2dSZXF1ZXN0Gh8uc2VydmVycy52MS5Eb3dubG9hZExvZ1Jlc3BvbnNlIgASUAoLTG9nQXJjaGl2ZXMSHi5zZXJ2ZXJzLnYxLkxvZ0FyY2hpdmVzUmVxdWVzdBofLnNlcnZlcnMudjEuTG9nQXJjaGl2ZXNSZXNwb25zZSIAEkgKC0xvZ1BvbGljaWVzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh8uc2VydmVycy52MS5Mb2dQb2xpY2llc1Jlc3BvbnNlIgASVgoNU2F2ZUxvZ1BvbGljeRIgLnNlcnZlcnMudjEuU2F2ZUxvZ1BvbGljeVJlcXVlc3QaIS5zZXJ2ZXJzLnYxLlNhdmVMb2dQb2xpY3lSZXNwb25zZSIAElUKDFN0cmVhbUV2ZW50cxIfLnNlcnZlcnMudjEuU3RyZWFtRXZlbnRzUmVxdWVzdBogLnNlcnZlcnMudjEuU3RyZWFtRXZlbnRzUmVzcG9uc2UiADABQqYBCg5jb20uc2VydmVycy52MUIMU2VydmVyc1Byb3RvUAFaPWdpdGh1Yi5jb20vbGVpZ2htYWNkb25hbGQvZ2JhbnMvaW50ZXJuYWwvc2VydmVycy92MTtzZXJ2ZXJzdjGiAgNTWFiqAgpTZXJ2ZXJzLlYxygIKU2VydmVyc1xWMeICFlNlcnZlcnNcVjFcR1BCTWV0YWRhdGHqAgtTZXJ2ZXJzOjpWMWIIZWRpdGlvbnNw6Ac", [file_buf_validate_validate, file_database_query_v1_filter, file_google_protobuf_empty, file_google_protobuf_struct, file_google_protobuf_timestamp, file_network_v1_network]);
+
+/**
+ * @generated from message servers.v1.StreamEventsRequest
+ */
+export type StreamEventsRequest = Message<"servers.v1.StreamEventsRequest"> & {
+  /**
+   * Servers to follow, all servers when empty.
+   *
+   * @generated from field: repeated int32 server_ids = 1;
+   */
+  serverIds: number[];
+
+  /**
+   * Log event types to follow, all events when empty.
+   *
+   * @generated from field: repeated int32 event_types = 2;
+   */
+  eventTypes: number[];
+};
+
+/**
+ * Describes the message servers.v1.StreamEventsRequest.
+ * Use `create(StreamEventsRequestSchema)` to create a new message.
+ */
+export const StreamEventsRequestSchema: GenMessage<StreamEventsRequest> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 0);
+
+/**
+ * @generated from message servers.v1.ServerEvent
+ */
+export type ServerEvent = Message<"servers.v1.ServerEvent"> & {
+  /**
+   * @generated from field: int32 server_id = 1;
+   */
+  serverId: number;
+
+  /**
+   * @generated from field: string server_name = 2;
+   */
+  serverName: string;
+
+  /**
+   * @generated from field: int32 event_type = 3;
+   */
+  eventType: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 4;
+   */
+  createdOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: string raw = 5;
+   */
+  raw: string;
+
+  /**
+   * The parsed event fields
+   *
+   * @generated from field: google.protobuf.Struct event = 6;
+   */
+  event?: Struct | undefined;
+};
+
+/**
+ * Describes the message servers.v1.ServerEvent.
+ * Use `create(ServerEventSchema)` to create a new message.
+ */
+export const ServerEventSchema: GenMessage<ServerEvent> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 1);
+
+/**
+ * @generated from message servers.v1.StreamEventsResponse
+ */
+export type StreamEventsResponse = Message<"servers.v1.StreamEventsResponse"> & {
+  /**
+   * @generated from field: servers.v1.ServerEvent event = 1;
+   */
+  event?: ServerEvent | undefined;
+
+  /**
+   * Events dropped since the previous message because the client was not keeping up.
+   *
+   * @generated from field: uint64 dropped = 2 [jstype = JS_STRING];
+   */
+  dropped: string;
+};
+
+/**
+ * Describes the message servers.v1.StreamEventsResponse.
+ * Use `create(StreamEventsResponseSchema)` to create a new message.
+ */
+export const StreamEventsResponseSchema: GenMessage<StreamEventsResponse> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 2);
 
 /**
  * @generated from message servers.v1.QueryLogsRequest
@@ -25,6 +121,40 @@ export type QueryLogsRequest = Message<"servers.v1.QueryLogsRequest"> & {
    * @generated from field: repeated int32 server_id = 1;
    */
   serverId: number[];
+
+  /**
+   * @generated from field: database.query.v1.Filter filter = 2;
+   */
+  filter?: Filter | undefined;
+
+  /**
+   * Full text search of the log lines, using websearch syntax.
+   *
+   * @generated from field: string query = 3;
+   */
+  query: string;
+
+  /**
+   * @generated from field: repeated int32 event_types = 4;
+   */
+  eventTypes: number[];
+
+  /**
+   * Only include lines referencing any of these players.
+   *
+   * @generated from field: repeated int64 steam_ids = 5 [jstype = JS_STRING];
+   */
+  steamIds: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 6;
+   */
+  from?: Timestamp | undefined;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 7;
+   */
+  to?: Timestamp | undefined;
 };
 
 /**
@@ -32,7 +162,7 @@ export type QueryLogsRequest = Message<"servers.v1.QueryLogsRequest"> & {
  * Use `create(QueryLogsRequestSchema)` to create a new message.
  */
 export const QueryLogsRequestSchema: GenMessage<QueryLogsRequest> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 0);
+  messageDesc(file_servers_v1_servers, 3);
 
 /**
  * @generated from message servers.v1.ServerLog
@@ -57,6 +187,16 @@ export type ServerLog = Message<"servers.v1.ServerLog"> & {
    * @generated from field: google.protobuf.Timestamp created_on = 4;
    */
   createdOn?: Timestamp | undefined;
+
+  /**
+   * @generated from field: int64 server_log_id = 5 [jstype = JS_STRING];
+   */
+  serverLogId: string;
+
+  /**
+   * @generated from field: int32 event_type = 6;
+   */
+  eventType: number;
 };
 
 /**
@@ -64,7 +204,7 @@ export type ServerLog = Message<"servers.v1.ServerLog"> & {
  * Use `create(ServerLogSchema)` to create a new message.
  */
 export const ServerLogSchema: GenMessage<ServerLog> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 1);
+  messageDesc(file_servers_v1_servers, 4);
 
 /**
  * @generated from message servers.v1.QueryLogsResponse
@@ -86,7 +226,227 @@ export type QueryLogsResponse = Message<"servers.v1.QueryLogsResponse"> & {
  * Use `create(QueryLogsResponseSchema)` to create a new message.
  */
 export const QueryLogsResponseSchema: GenMessage<QueryLogsResponse> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 2);
+  messageDesc(file_servers_v1_servers, 5);
+
+/**
+ * @generated from message servers.v1.DownloadLogRequest
+ */
+export type DownloadLogRequest = Message<"servers.v1.DownloadLogRequest"> & {
+  /**
+   * @generated from field: int32 server_id = 1;
+   */
+  serverId: number;
+
+  /**
+   * UTC day to download, formatted as YYYY-MM-DD.
+   *
+   * @generated from field: string day = 2;
+   */
+  day: string;
+};
+
+/**
+ * Describes the message servers.v1.DownloadLogRequest.
+ * Use `create(DownloadLogRequestSchema)` to create a new message.
+ */
+export const DownloadLogRequestSchema: GenMessage<DownloadLogRequest> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 6);
+
+/**
+ * @generated from message servers.v1.DownloadLogResponse
+ */
+export type DownloadLogResponse = Message<"servers.v1.DownloadLogResponse"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: bytes content = 2;
+   */
+  content: Uint8Array;
+
+  /**
+   * Whether the log was read from the cold archive instead of the live table.
+   *
+   * @generated from field: bool archived = 3;
+   */
+  archived: boolean;
+};
+
+/**
+ * Describes the message servers.v1.DownloadLogResponse.
+ * Use `create(DownloadLogResponseSchema)` to create a new message.
+ */
+export const DownloadLogResponseSchema: GenMessage<DownloadLogResponse> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 7);
+
+/**
+ * @generated from message servers.v1.LogArchivesRequest
+ */
+export type LogArchivesRequest = Message<"servers.v1.LogArchivesRequest"> & {
+  /**
+   * @generated from field: int32 server_id = 1;
+   */
+  serverId: number;
+};
+
+/**
+ * Describes the message servers.v1.LogArchivesRequest.
+ * Use `create(LogArchivesRequestSchema)` to create a new message.
+ */
+export const LogArchivesRequestSchema: GenMessage<LogArchivesRequest> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 8);
+
+/**
+ * @generated from message servers.v1.LogArchive
+ */
+export type LogArchive = Message<"servers.v1.LogArchive"> & {
+  /**
+   * @generated from field: int32 server_id = 1;
+   */
+  serverId: number;
+
+  /**
+   * @generated from field: string day = 2;
+   */
+  day: string;
+
+  /**
+   * @generated from field: string asset_id = 3;
+   */
+  assetId: string;
+
+  /**
+   * @generated from field: int32 lines = 4;
+   */
+  lines: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_on = 5;
+   */
+  createdOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message servers.v1.LogArchive.
+ * Use `create(LogArchiveSchema)` to create a new message.
+ */
+export const LogArchiveSchema: GenMessage<LogArchive> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 9);
+
+/**
+ * @generated from message servers.v1.LogArchivesResponse
+ */
+export type LogArchivesResponse = Message<"servers.v1.LogArchivesResponse"> & {
+  /**
+   * @generated from field: repeated servers.v1.LogArchive archives = 1;
+   */
+  archives: LogArchive[];
+};
+
+/**
+ * Describes the message servers.v1.LogArchivesResponse.
+ * Use `create(LogArchivesResponseSchema)` to create a new message.
+ */
+export const LogArchivesResponseSchema: GenMessage<LogArchivesResponse> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 10);
+
+/**
+ * LogPolicy controls how long the logs of a server are retained.
+ *
+ * @generated from message servers.v1.LogPolicy
+ */
+export type LogPolicy = Message<"servers.v1.LogPolicy"> & {
+  /**
+   * @generated from field: int32 server_id = 1;
+   */
+  serverId: number;
+
+  /**
+   * Delete lines older than this many days, 0 to disable.
+   *
+   * @generated from field: int32 max_age_days = 2;
+   */
+  maxAgeDays: number;
+
+  /**
+   * Delete the oldest lines once the servers logs exceed this size, 0 to disable.
+   *
+   * @generated from field: int64 max_size_bytes = 3 [jstype = JS_STRING];
+   */
+  maxSizeBytes: string;
+
+  /**
+   * Write each completed day to a compressed archive before it is removed.
+   *
+   * @generated from field: bool archive_enabled = 4;
+   */
+  archiveEnabled: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_on = 5;
+   */
+  updatedOn?: Timestamp | undefined;
+};
+
+/**
+ * Describes the message servers.v1.LogPolicy.
+ * Use `create(LogPolicySchema)` to create a new message.
+ */
+export const LogPolicySchema: GenMessage<LogPolicy> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 11);
+
+/**
+ * @generated from message servers.v1.LogPoliciesResponse
+ */
+export type LogPoliciesResponse = Message<"servers.v1.LogPoliciesResponse"> & {
+  /**
+   * @generated from field: repeated servers.v1.LogPolicy policies = 1;
+   */
+  policies: LogPolicy[];
+};
+
+/**
+ * Describes the message servers.v1.LogPoliciesResponse.
+ * Use `create(LogPoliciesResponseSchema)` to create a new message.
+ */
+export const LogPoliciesResponseSchema: GenMessage<LogPoliciesResponse> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 12);
+
+/**
+ * @generated from message servers.v1.SaveLogPolicyRequest
+ */
+export type SaveLogPolicyRequest = Message<"servers.v1.SaveLogPolicyRequest"> & {
+  /**
+   * @generated from field: servers.v1.LogPolicy policy = 1;
+   */
+  policy?: LogPolicy | undefined;
+};
+
+/**
+ * Describes the message servers.v1.SaveLogPolicyRequest.
+ * Use `create(SaveLogPolicyRequestSchema)` to create a new message.
+ */
+export const SaveLogPolicyRequestSchema: GenMessage<SaveLogPolicyRequest> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 13);
+
+/**
+ * @generated from message servers.v1.SaveLogPolicyResponse
+ */
+export type SaveLogPolicyResponse = Message<"servers.v1.SaveLogPolicyResponse"> & {
+  /**
+   * @generated from field: servers.v1.LogPolicy policy = 1;
+   */
+  policy?: LogPolicy | undefined;
+};
+
+/**
+ * Describes the message servers.v1.SaveLogPolicyResponse.
+ * Use `create(SaveLogPolicyResponseSchema)` to create a new message.
+ */
+export const SaveLogPolicyResponseSchema: GenMessage<SaveLogPolicyResponse> = /*@__PURE__*/
+  messageDesc(file_servers_v1_servers, 14);
 
 /**
  * @generated from message servers.v1.SafeServer
@@ -188,7 +548,7 @@ export type SafeServer = Message<"servers.v1.SafeServer"> & {
  * Use `create(SafeServerSchema)` to create a new message.
  */
 export const SafeServerSchema: GenMessage<SafeServer> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 3);
+  messageDesc(file_servers_v1_servers, 15);
 
 /**
  * @generated from message servers.v1.Server
@@ -315,7 +675,7 @@ export type Server = Message<"servers.v1.Server"> & {
  * Use `create(ServerSchema)` to create a new message.
  */
 export const ServerSchema: GenMessage<Server> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 4);
+  messageDesc(file_servers_v1_servers, 16);
 
 /**
  * @generated from message servers.v1.StateResponse
@@ -337,7 +697,7 @@ export type StateResponse = Message<"servers.v1.StateResponse"> & {
  * Use `create(StateResponseSchema)` to create a new message.
  */
 export const StateResponseSchema: GenMessage<StateResponse> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 5);
+  messageDesc(file_servers_v1_servers, 17);
 
 /**
  * @generated from message servers.v1.ServerInfoSafe
@@ -369,7 +729,7 @@ export type ServerInfoSafe = Message<"servers.v1.ServerInfoSafe"> & {
  * Use `create(ServerInfoSafeSchema)` to create a new message.
  */
 export const ServerInfoSafeSchema: GenMessage<ServerInfoSafe> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 6);
+  messageDesc(file_servers_v1_servers, 18);
 
 /**
  * @generated from message servers.v1.ServersResponse
@@ -386,7 +746,7 @@ export type ServersResponse = Message<"servers.v1.ServersResponse"> & {
  * Use `create(ServersResponseSchema)` to create a new message.
  */
 export const ServersResponseSchema: GenMessage<ServersResponse> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 7);
+  messageDesc(file_servers_v1_servers, 19);
 
 /**
  * @generated from message servers.v1.EditServerRequest
@@ -403,7 +763,7 @@ export type EditServerRequest = Message<"servers.v1.EditServerRequest"> & {
  * Use `create(EditServerRequestSchema)` to create a new message.
  */
 export const EditServerRequestSchema: GenMessage<EditServerRequest> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 8);
+  messageDesc(file_servers_v1_servers, 20);
 
 /**
  * @generated from message servers.v1.EditServerResponse
@@ -420,7 +780,7 @@ export type EditServerResponse = Message<"servers.v1.EditServerResponse"> & {
  * Use `create(EditServerResponseSchema)` to create a new message.
  */
 export const EditServerResponseSchema: GenMessage<EditServerResponse> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 9);
+  messageDesc(file_servers_v1_servers, 21);
 
 /**
  * @generated from message servers.v1.DeleteServerRequest
@@ -437,7 +797,7 @@ export type DeleteServerRequest = Message<"servers.v1.DeleteServerRequest"> & {
  * Use `create(DeleteServerRequestSchema)` to create a new message.
  */
 export const DeleteServerRequestSchema: GenMessage<DeleteServerRequest> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 10);
+  messageDesc(file_servers_v1_servers, 22);
 
 /**
  * @generated from message servers.v1.ServersAdminResponse
@@ -454,7 +814,7 @@ export type ServersAdminResponse = Message<"servers.v1.ServersAdminResponse"> &
  * Use `create(ServersAdminResponseSchema)` to create a new message.
  */
 export const ServersAdminResponseSchema: GenMessage<ServersAdminResponse> = /*@__PURE__*/
-  messageDesc(file_servers_v1_servers, 11);
+  messageDesc(file_servers_v1_servers, 23);
 
 /**
  * @generated from service servers.v1.ServersService
@@ -508,6 +868,50 @@ export const ServersService: GenService<{
     input: typeof QueryLogsRequestSchema;
     output: typeof QueryLogsResponseSchema;
   },
+  /**
+   * DownloadLog returns a full day of a servers log in the standard srcds log format.
+   *
+   * @generated from rpc servers.v1.ServersService.DownloadLog
+   */
+  downloadLog: {
+    methodKind: "unary";
+    input: typeof DownloadLogRequestSchema;
+    output: typeof DownloadLogResponseSchema;
+  },
+  /**
+   * @generated from rpc servers.v1.ServersService.LogArchives
+   */
+  logArchives: {
+    methodKind: "unary";
+    input: typeof LogArchivesRequestSchema;
+    output: typeof LogArchivesResponseSchema;
+  },
+  /**
+   * @generated from rpc servers.v1.ServersService.LogPolicies
+   */
+  logPolicies: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof LogPoliciesResponseSchema;
+  },
+  /**
+   * @generated from rpc servers.v1.ServersService.SaveLogPolicy
+   */
+  saveLogPolicy: {
+    methodKind: "unary";
+    input: typeof SaveLogPolicyRequestSchema;
+    output: typeof SaveLogPolicyResponseSchema;
+  },
+  /**
+   * StreamEvents follows the parsed log events of the servers as they are received.
+   *
+   * @generated from rpc servers.v1.ServersService.StreamEvents
+   */
+  streamEvents: {
+    methodKind: "server_streaming";
+    input: typeof StreamEventsRequestSchema;
+    output: typeof StreamEventsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_servers_v1_servers, 0);
 
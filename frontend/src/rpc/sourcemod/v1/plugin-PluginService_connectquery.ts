@@ -40,3 +40,10 @@ export const sMSeed = PluginService.method.sMSeed;
  * @generated from rpc sourcemod.v1.PluginService.SMPingMod
  */
 export const sMPingMod = PluginService.method.sMPingMod;
+
+/**
+ * Push StAC log entries as they are written so they can be acted upon immediately.
+ *
+ * @generated from rpc sourcemod.v1.PluginService.SMStacLog
+ */
+export const sMStacLog = PluginService.method.sMStacLog;
@@ -17,7 +17,53 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file sourcemod/v1/plugin.proto.
  */
 export const file_sourcemod_v1_plugin: GenFile = /*@__PURE__*/
-  fileDesc("Chlzb3VyY2Vtb2QvdjEvcGx1Z2luLnByb3RvEgxzb3VyY2Vtb2QudjEiVQoQU01QaW5nTW9kUmVxdWVzdBIQCghzdGVhbV9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBnJlYXNvbhgDIAEoCRIRCgljbGllbnRfaWQYBCABKAUiEwoRU01QaW5nTW9kUmVzcG9uc2UiNwoVU01BdXRoZW50aWNhdGVSZXF1ZXN0Eh4KCHBhc3N3b3JkGAEgASgJQgy6SAnIAQFyBBAIGBQiMwoWU01BdXRoZW50aWNhdGVSZXNwb25zZRIZCgV0b2tlbhgBIAEoCUIKukgHyAEBcgIQASItCg1TTVNlZWRSZXF1ZXN0EhwKCHN0ZWFtX2lkGAEgASgJQgq6SAfIAQFyAhABIikKDlNNU2VlZFJlc3BvbnNlEhcKB21lc3NhZ2UYASABKAlCBrpIA8gBASKBAQoKU01PdmVycmlkZRI+Cg1vdmVycmlkZV90eXBlGAEgASgOMhouc291cmNlbW9kLnYxLk92ZXJyaWRlVHlwZUILukgIyAEBggECEAESGAoEbmFtZRgCIAEoCUIKukgHyAEBcgIQARIZCgVmbGFncxgDIAEoCUIKukgHyAEBcgIQASJKChNTTU92ZXJyaWRlc1Jlc3BvbnNlEjMKCW92ZXJyaWRlcxgBIAMoCzIYLnNvdXJjZW1vZC52MS5TTU92ZXJyaWRlQga6SAPIAQEihQEKDlNNQ2hlY2tSZXF1ZXN0EhwKCHN0ZWFtX2lkGAEgASgJQgq6SAfIAQFyAhABEiEKCWNsaWVudF9pZBgCIAEoBUIOukgLyAEBGgYY//8DKAASFgoCaXAYAyABKAlCCrpIB8gBAXICeAESGgoEbmFtZRgEIAEoCUIMukgJyAEBcgQQARggImwKD1NNQ2hlY2tSZXNwb25zZRIZCgljbGllbnRfaWQYASABKAVCBrpIA8gBARIpCghiYW5fdHlwZRgCIAEoDjIPLmJhbi52MS5CYW5UeXBlQga6SAPIAQESEwoDbXNnGAMgASgJQga6SAPIAQEiSQoPU01Hcm91cEltbXVuaXR5EhoKCmdyb3VwX25hbWUYASABKAlCBrpIA8gBARIaCgpvdGhlcl9uYW1lGAIgASgJQga6SAPIAQEiegoQU01Hcm91cHNSZXNwb25zZRIrCgZncm91cHMYASADKAsyEy5zb3VyY2Vtb2QudjEuR3JvdXBCBrpIA8gBARI5CgppbW11bml0aWVzGAIgAygLMh0uc291cmNlbW9kLnYxLlNNR3JvdXBJbW11bml0eUIGukgDyAEBInYKD1NNVXNlcnNSZXNwb25zZRIrCgV1c2VycxgBIAMoCzIULnNvdXJjZW1vZC52MS5TTVVzZXJCBrpIA8gBARI2Cgt1c2VyX2dyb3VwcxgCIAMoCzIZLnNvdXJjZW1vZC52MS5TTVVzZXJHcm91cEIGukgDyAEBIscBCgZTTVVzZXISFgoCaWQYASABKAVCCrpIB8gBARoCIAASLAoJYXV0aF90eXBlGAIgASgJQhm6SBbIAQFyEVIFc3RlYW1SBG5hbWVSAmlwEhgKCGlkZW50aXR5GAMgASgJQga6SAPIAQESEAoIcGFzc3dvcmQYBCABKAkSFQoFZmxhZ3MYBSABKAlCBrpIA8gBARIUCgRuYW1lGAYgASgJQga6SAPIAQESHgoIaW1tdW5pdHkYByABKAVCDLpICcgBARoEGGQoACJLCgtTTVVzZXJHcm91cBIcCghhZG1pbl9pZBgBIAEoBUIKukgHyAEBGgIgABIeCgpncm91cF9uYW1lGAIgASgJQgq6SAfIAQFyAhABMpwECg1QbHVnaW5TZXJ2aWNlEl0KDlNNQXV0aGVudGljYXRlEiMuc291cmNlbW9kLnYxLlNNQXV0aGVudGljYXRlUmVxdWVzdBokLnNvdXJjZW1vZC52MS5TTUF1dGhlbnRpY2F0ZVJlc3BvbnNlIgASSAoHU01DaGVjaxIcLnNvdXJjZW1vZC52MS5TTUNoZWNrUmVxdWVzdBodLnNvdXJjZW1vZC52MS5TTUNoZWNrUmVzcG9uc2UiABJKCgtTTU92ZXJyaWRlcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRohLnNvdXJjZW1vZC52MS5TTU92ZXJyaWRlc1Jlc3BvbnNlIgASQgoHU01Vc2VycxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRodLnNvdXJjZW1vZC52MS5TTVVzZXJzUmVzcG9uc2UiABJECghTTUdyb3VwcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoeLnNvdXJjZW1vZC52MS5TTUdyb3Vwc1Jlc3BvbnNlIgASRQoGU01TZWVkEhsuc291cmNlbW9kLnYxLlNNU2VlZFJlcXVlc3QaHC5zb3VyY2Vtb2QudjEuU01TZWVkUmVzcG9uc2UiABJFCglTTVBpbmdNb2QSHi5zb3VyY2Vtb2QudjEuU01QaW5nTW9kUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAQrMBChBjb20uc291cmNlbW9kLnYxQgtQbHVnaW5Qcm90b1ABWkFnaXRodWIuY29tL2xlaWdobWFjZG9uYWxkL2diYW5zL2ludGVybmFsL3NvdXJjZW1vZC92MTtzb3VyY2Vtb2R2MaICA1NYWKoCDFNvdXJjZW1vZC5WMcoCDFNvdXJjZW1vZFxWMeICGFNvdXJjZW1vZFxWMVxHUEJNZXRhZGF0YeoCDVNvdXJjZW1vZDo6VjFiCGVkaXRpb25zcOgH", [file_ban_v1_ban, file_buf_validate_validate, file_google_protobuf_empty, file_sourcemod_v1_sourcemod]);
+  fileDesc("Chlzb3VyY2Vtb2QvdjEvcGx1Z2luLnByb3RvEgxzb3VyY2Vtb2QudjEiXwoQU01TdGFjTG9nUmVxdWVzdBIuCglmaWxlX25hbWUYASABKAlCG7pIGMgBAXITMhFec3RhY19cZHs2fVwubG9nJBIbCgNsb2cYAiABKAlCDrpIC8gBAXIGEAEYgIBAIi0KEVNNU3RhY0xvZ1Jlc3BvbnNlEhgKCGltcG9ydGVkGAEgASgFQga6SAPIAQEiVQoQU01QaW5nTW9kUmVxdWVzdBIQCghzdGVhbV9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBnJlYXNvbhgDIAEoCRIRCgljbGllbnRfaWQYBCABKAUiEwoRU01QaW5nTW9kUmVzcG9uc2UiNwoVU01BdXRoZW50aWNhdGVSZXF1ZXN0Eh4KCHBhc3N3b3JkGAEgASgJQgy6SAnIAQFyBBAIGBQiMwoWU01BdXRoZW50aWNhdGVSZXNwb25zZRIZCgV0b2tlbhgBIAEoCUIKukgHyAEBcgIQASItCg1TTVNlZWRSZXF1ZXN0EhwKCHN0ZWFtX2lkGAEgASgJQgq6SAfIAQFyAhABIikKDlNNU2VlZFJlc3BvbnNlEhcKB21lc3NhZ2UYASABKAlCBrpIA8gBASKBAQoKU01PdmVycmlkZRI+Cg1vdmVycmlkZV90eXBlGAEgASgOMhouc291cmNlbW9kLnYxLk92ZXJyaWRlVHlwZUILukgIyAEBggECEAESGAoEbmFtZRgCIAEoCUIKukgHyAEBcgIQARIZCgVmbGFncxgDIAEoCUIKukgHyAEBcgIQASJKChNTTU92ZXJyaWRlc1Jlc3BvbnNlEjMKCW92ZXJyaWRlcxgBIAMoCzIYLnNvdXJjZW1vZC52MS5TTU92ZXJyaWRlQga6SAPIAQEihQEKDlNNQ2hlY2tSZXF1ZXN0EhwKCHN0ZWFtX2lkGAEgASgJQgq6SAfIAQFyAhABEiEKCWNsaWVudF9pZBgCIAEoBUIOukgLyAEBGgYY//8DKAASFgoCaXAYAyABKAlCCrpIB8gBAXICeAESGgoEbmFtZRgEIAEoCUIMukgJyAEBcgQQARggImwKD1NNQ2hlY2tSZXNwb25zZRIZCgljbGllbnRfaWQYASABKAVCBrpIA8gBARIpCghiYW5fdHlwZRgCIAEoDjIPLmJhbi52MS5CYW5UeXBlQga6SAPIAQESEwoDbXNnGAMgASgJQga6SAPIAQEiSQoPU01Hcm91cEltbXVuaXR5EhoKCmdyb3VwX25hbWUYASABKAlCBrpIA8gBARIaCgpvdGhlcl9uYW1lGAIgASgJQga6SAPIAQEiegoQU01Hcm91cHNSZXNwb25zZRIrCgZncm91cHMYASADKAsyEy5zb3VyY2Vtb2QudjEuR3JvdXBCBrpIA8gBARI5CgppbW11bml0aWVzGAIgAygLMh0uc291cmNlbW9kLnYxLlNNR3JvdXBJbW11bml0eUIGukgDyAEBInYKD1NNVXNlcnNSZXNwb25zZRIrCgV1c2VycxgBIAMoCzIULnNvdXJjZW1vZC52MS5TTVVzZXJCBrpIA8gBARI2Cgt1c2VyX2dyb3VwcxgCIAMoCzIZLnNvdXJjZW1vZC52MS5TTVVzZXJHcm91cEIGukgDyAEBIscBCgZTTVVzZXISFgoCaWQYASABKAVCCrpIB8gBARoCIAASLAoJYXV0aF90eXBlGAIgASgJQhm6SBbIAQFyEVIFc3RlYW1SBG5hbWVSAmlwEhgKCGlkZW50aXR5GAMgASgJQga6SAPIAQESEAoIcGFzc3dvcmQYBCABKAkSFQoFZmxhZ3MYBSABKAlCBrpIA8gBARIUCgRuYW1lGAYgASgJQga6SAPIAQESHgoIaW1tdW5pdHkYByABKAVCDLpICcgBARoEGGQoACJLCgtTTVVzZXJHcm91cBIcCghhZG1pbl9pZBgBIAEoBUIKukgHyAEBGgIgABIeCgpncm91cF9uYW1lGAIgASgJQgq6SAfIAQFyAhABMuwECg1QbHVnaW5TZXJ2aWNlEl0KDlNNQXV0aGVudGljYXRlEiMuc291cmNlbW9kLnYxLlNNQXV0aGVudGljYXRlUmVxdWVzdBokLnNvdXJjZW1vZC52MS5TTUF1dGhlbnRpY2F0ZVJlc3BvbnNlIgASSAoHU01DaGVjaxIcLnNvdXJjZW1vZC52MS5TTUNoZWNrUmVxdWVzdBodLnNvdXJjZW1vZC52MS5TTUNoZWNrUmVzcG9uc2UiABJKCgtTTU92ZXJyaWRlcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRohLnNvdXJjZW1vZC52MS5TTU92ZXJyaWRlc1Jlc3BvbnNlIgASQgoHU01Vc2VycxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRodLnNvdXJjZW1vZC52MS5TTVVzZXJzUmVzcG9uc2UiABJECghTTUdyb3VwcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoeLnNvdXJjZW1vZC52MS5TTUdyb3Vwc1Jlc3BvbnNlIgASRQoGU01TZWVkEhsuc291cmNlbW9kLnYxLlNNU2VlZFJlcXVlc3QaHC5zb3VyY2Vtb2QudjEuU01TZWVkUmVzcG9uc2UiABJFCglTTVBpbmdNb2QSHi5zb3VyY2Vtb2QudjEuU01QaW5nTW9kUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eSIAEk4KCVNNU3RhY0xvZxIeLnNvdXJjZW1vZC52MS5TTVN0YWNMb2dSZXF1ZXN0Gh8uc291cmNlbW9kLnYxLlNNU3RhY0xvZ1Jlc3BvbnNlIgBCswEKEGNvbS5zb3VyY2Vtb2QudjFCC1BsdWdpblByb3RvUAFaQWdpdGh1Yi5jb20vbGVpZ2htYWNkb25hbGQvZ2JhbnMvaW50ZXJuYWwvc291cmNlbW9kL3YxO3NvdXJjZW1vZHYxogIDU1hYqgIMU291cmNlbW9kLlYxygIMU291cmNlbW9kXFYx4gIYU291cmNlbW9kXFYxXEdQQk1ldGFkYXRh6gINU291cmNlbW9kOjpWMWIIZWRpdGlvbnNw6Ac", [file_ban_v1_ban, file_buf_validate_validate, file_google_protobuf_empty, file_sourcemod_v1_sourcemod]);
+
+/**
+ * @generated from message sourcemod.v1.SMStacLogRequest
+ */
+export type SMStacLogRequest = Message<"sourcemod.v1.SMStacLogRequest"> & {
+  /**
+   * Name of the log file the entries were written to, eg: stac_052224.log. The date of the entries is derived
+   * from this.
+   *
+   * @generated from field: string file_name = 1;
+   */
+  fileName: string;
+
+  /**
+   * One or more complete log entries.
+   *
+   * @generated from field: string log = 2;
+   */
+  log: string;
+};
+
+/**
+ * Describes the message sourcemod.v1.SMStacLogRequest.
+ * Use `create(SMStacLogRequestSchema)` to create a new message.
+ */
+export const SMStacLogRequestSchema: GenMessage<SMStacLogRequest> = /*@__PURE__*/
+  messageDesc(file_sourcemod_v1_plugin, 0);
+
+/**
+ * @generated from message sourcemod.v1.SMStacLogResponse
+ */
+export type SMStacLogResponse = Message<"sourcemod.v1.SMStacLogResponse"> & {
+  /**
+   * Count of new entries. Entries that were previously imported are not counted.
+   *
+   * @generated from field: int32 imported = 1;
+   */
+  imported: number;
+};
+
+/**
+ * Describes the message sourcemod.v1.SMStacLogResponse.
+ * Use `create(SMStacLogResponseSchema)` to create a new message.
+ */
+export const SMStacLogResponseSchema: GenMessage<SMStacLogResponse> = /*@__PURE__*/
+  messageDesc(file_sourcemod_v1_plugin, 1);
 
 /**
  * @generated from message sourcemod.v1.SMPingModRequest
@@ -49,7 +95,7 @@ export type SMPingModRequest = Message<"sourcemod.v1.SMPingModRequest"> & {
  * Use `create(SMPingModRequestSchema)` to create a new message.
  */
 export const SMPingModRequestSchema: GenMessage<SMPingModRequest> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 0);
+  messageDesc(file_sourcemod_v1_plugin, 2);
 
 /**
  * @generated from message sourcemod.v1.SMPingModResponse
@@ -62,7 +108,7 @@ export type SMPingModResponse = Message<"sourcemod.v1.SMPingModResponse"> & {
  * Use `create(SMPingModResponseSchema)` to create a new message.
  */
 export const SMPingModResponseSchema: GenMessage<SMPingModResponse> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 1);
+  messageDesc(file_sourcemod_v1_plugin, 3);
 
 /**
  * @generated from message sourcemod.v1.SMAuthenticateRequest
@@ -79,7 +125,7 @@ export type SMAuthenticateRequest = Message<"sourcemod.v1.SMAuthenticateRequest"
  * Use `create(SMAuthenticateRequestSchema)` to create a new message.
  */
 export const SMAuthenticateRequestSchema: GenMessage<SMAuthenticateRequest> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 2);
+  messageDesc(file_sourcemod_v1_plugin, 4);
 
 /**
  * @generated from message sourcemod.v1.SMAuthenticateResponse
@@ -96,7 +142,7 @@ export type SMAuthenticateResponse = Message<"sourcemod.v1.SMAuthenticateRespons
  * Use `create(SMAuthenticateResponseSchema)` to create a new message.
  */
 export const SMAuthenticateResponseSchema: GenMessage<SMAuthenticateResponse> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 3);
+  messageDesc(file_sourcemod_v1_plugin, 5);
 
 /**
  * @generated from message sourcemod.v1.SMSeedRequest
@@ -113,7 +159,7 @@ export type SMSeedRequest = Message<"sourcemod.v1.SMSeedRequest"> & {
  * Use `create(SMSeedRequestSchema)` to create a new message.
  */
 export const SMSeedRequestSchema: GenMessage<SMSeedRequest> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 4);
+  messageDesc(file_sourcemod_v1_plugin, 6);
 
 /**
  * @generated from message sourcemod.v1.SMSeedResponse
@@ -130,7 +176,7 @@ export type SMSeedResponse = Message<"sourcemod.v1.SMSeedResponse"> & {
  * Use `create(SMSeedResponseSchema)` to create a new message.
  */
 export const SMSeedResponseSchema: GenMessage<SMSeedResponse> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 5);
+  messageDesc(file_sourcemod_v1_plugin, 7);
 
 /**
  * @generated from message sourcemod.v1.SMOverride
@@ -157,7 +203,7 @@ export type SMOverride = Message<"sourcemod.v1.SMOverride"> & {
  * Use `create(SMOverrideSchema)` to create a new message.
  */
 export const SMOverrideSchema: GenMessage<SMOverride> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 6);
+  messageDesc(file_sourcemod_v1_plugin, 8);
 
 /**
  * @generated from message sourcemod.v1.SMOverridesResponse
@@ -174,7 +220,7 @@ export type SMOverridesResponse = Message<"sourcemod.v1.SMOverridesResponse"> &
  * Use `create(SMOverridesResponseSchema)` to create a new message.
  */
 export const SMOverridesResponseSchema: GenMessage<SMOverridesResponse> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 7);
+  messageDesc(file_sourcemod_v1_plugin, 9);
 
 /**
  * @generated from message sourcemod.v1.SMCheckRequest
@@ -206,7 +252,7 @@ export type SMCheckRequest = Message<"sourcemod.v1.SMCheckRequest"> & {
  * Use `create(SMCheckRequestSchema)` to create a new message.
  */
 export const SMCheckRequestSchema: GenMessage<SMCheckRequest> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 8);
+  messageDesc(file_sourcemod_v1_plugin, 10);
 
 /**
  * @generated from message sourcemod.v1.SMCheckResponse
@@ -233,7 +279,7 @@ export type SMCheckResponse = Message<"sourcemod.v1.SMCheckResponse"> & {
  * Use `create(SMCheckResponseSchema)` to create a new message.
  */
 export const SMCheckResponseSchema: GenMessage<SMCheckResponse> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 9);
+  messageDesc(file_sourcemod_v1_plugin, 11);
 
 /**
  * @generated from message sourcemod.v1.SMGroupImmunity
@@ -255,7 +301,7 @@ export type SMGroupImmunity = Message<"sourcemod.v1.SMGroupImmunity"> & {
  * Use `create(SMGroupImmunitySchema)` to create a new message.
  */
 export const SMGroupImmunitySchema: GenMessage<SMGroupImmunity> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 10);
+  messageDesc(file_sourcemod_v1_plugin, 12);
 
 /**
  * @generated from message sourcemod.v1.SMGroupsResponse
@@ -277,7 +323,7 @@ export type SMGroupsResponse = Message<"sourcemod.v1.SMGroupsResponse"> & {
  * Use `create(SMGroupsResponseSchema)` to create a new message.
  */
 export const SMGroupsResponseSchema: GenMessage<SMGroupsResponse> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 11);
+  messageDesc(file_sourcemod_v1_plugin, 13);
 
 /**
  * @generated from message sourcemod.v1.SMUsersResponse
@@ -299,7 +345,7 @@ export type SMUsersResponse = Message<"sourcemod.v1.SMUsersResponse"> & {
  * Use `create(SMUsersResponseSchema)` to create a new message.
  */
 export const SMUsersResponseSchema: GenMessage<SMUsersResponse> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 12);
+  messageDesc(file_sourcemod_v1_plugin, 14);
 
 /**
  * @generated from message sourcemod.v1.SMUser
@@ -346,7 +392,7 @@ export type SMUser = Message<"sourcemod.v1.SMUser"> & {
  * Use `create(SMUserSchema)` to create a new message.
  */
 export const SMUserSchema: GenMessage<SMUser> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 13);
+  messageDesc(file_sourcemod_v1_plugin, 15);
 
 /**
  * @generated from message sourcemod.v1.SMUserGroup
@@ -368,7 +414,7 @@ export type SMUserGroup = Message<"sourcemod.v1.SMUserGroup"> & {
  * Use `create(SMUserGroupSchema)` to create a new message.
  */
 export const SMUserGroupSchema: GenMessage<SMUserGroup> = /*@__PURE__*/
-  messageDesc(file_sourcemod_v1_plugin, 14);
+  messageDesc(file_sourcemod_v1_plugin, 16);
 
 /**
  * Provides the API used to communicate with the game servers.
@@ -434,6 +480,16 @@ export const PluginService: GenService<{
     input: typeof SMPingModRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * Push StAC log entries as they are written so they can be acted upon immediately.
+   *
+   * @generated from rpc sourcemod.v1.PluginService.SMStacLog
+   */
+  sMStacLog: {
+    methodKind: "unary";
+    input: typeof SMStacLogRequestSchema;
+    output: typeof SMStacLogResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_sourcemod_v1_plugin, 0);
 
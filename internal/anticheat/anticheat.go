@@ -25,30 +25,22 @@ import (
 type Action string
 
 const (
-	ActionGag  Action = "gag"
-	ActionKick Action = "kick"
-	ActionBan  Action = "ban"
+	ActionNotify Action = "notify"
+	ActionGag    Action = "gag"
+	ActionKick   Action = "kick"
+	ActionBan    Action = "ban"
 )
 
-type OnEntry func(ctx context.Context, entry logparse.StacEntry, duration time.Duration, count int32) error
+// OnTrigger is called to perform the action of a rule that has fired. Entry is the detection that caused
+// the rule to fire. If the action results in a ban being created, its id is returned.
+type OnTrigger func(ctx context.Context, trigger Trigger, entry logparse.StacEntry) (int32, error)
 
 var ErrOpenClient = errors.New("failed to open client")
 
 type Config struct {
 	sync.RWMutex
 
-	Enabled               bool   `mapstructure:"enabled"`
-	Action                Action `mapstructure:"action"`
-	Duration              int32  `mapstructure:"duration"`
-	MaxAimSnap            int32  `mapstructure:"max_aim_snap"`
-	MaxPsilent            int32  `mapstructure:"max_psilent"`
-	MaxBhop               int32  `mapstructure:"max_bhop"`
-	MaxFakeAng            int32  `mapstructure:"max_fake_ang"`
-	MaxCmdNum             int32  `mapstructure:"max_cmd_num"`
-	MaxTooManyConnections int32  `mapstructure:"max_too_many_connections"`
-	MaxCheatCvar          int32  `mapstructure:"max_cheat_cvar"`
-	MaxOOBVar             int32  `mapstructure:"max_oob_var"`
-	MaxInvalidUserCmd     int32  `mapstructure:"max_invalid_user_cmd"`
+	Enabled bool `mapstructure:"enabled"`
 }
 
 type ConfigStore struct {
@@ -82,10 +74,10 @@ type AntiCheat struct {
 	repo    Repository
 	notif   notification.Notifier
 	persons person.Provider
-	handler OnEntry
+	handler OnTrigger
 }
 
-func New(repo Repository, config *Config, notif notification.Notifier, handler OnEntry, persons person.Provider) AntiCheat {
+func New(repo Repository, config *Config, notif notification.Notifier, handler OnTrigger, persons person.Provider) AntiCheat {
 	return AntiCheat{
 		Config:  config,
 		parser:  logparse.NewStacParser(),
@@ -96,6 +88,8 @@ func New(repo Repository, config *Config, notif notification.Notifier, handler O
 	}
 }
 
+// DownloadHandler sweeps completed stac logs from the servers. Entries which were already pushed by the server
+// through Ingest are skipped when imported, so this acts as a fallback for servers that are not streaming.
 func (a AntiCheat) DownloadHandler(ctx context.Context, client storage.Storager, server scp.ServerInfo, config *scp.Config) error {
 	for _, instance := range server.ServerIDs {
 		logDir := server.GamePath(config.StacPathFmt, instance)
@@ -156,78 +150,161 @@ func (a AntiCheat) BySteamID(ctx context.Context, steamID steamid.SteamID) ([]lo
 	return a.repo.DetectionsBySteamID(ctx, steamID)
 }
 
-func (a AntiCheat) Handle(ctx context.Context, entries []logparse.StacEntry) error { //nolint:cyclop
-	var ( //nolint:prealloc
-		results       = map[steamid.SteamID]map[logparse.Detection]int32{}
-		hasBeenBanned []steamid.SteamID
+// Handle evaluates the enabled rules against the detection history of each player with new entries. For each
+// player, the harshest firing rule has its action performed while dry run rules are only recorded.
+func (a AntiCheat) Handle(ctx context.Context, entries []logparse.StacEntry) error {
+	if !a.Enabled {
+		return nil
+	}
+
+	rules, errRules := a.repo.Rules(ctx)
+	if errRules != nil {
+		return errRules
+	}
+
+	var (
+		enabled   []Rule
+		maxWindow time.Duration
+		unbounded bool
 	)
+
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+
+		enabled = append(enabled, rule)
+		maxWindow = max(maxWindow, rule.Window)
+		unbounded = unbounded || rule.Window == 0
+	}
+
+	if len(enabled) == 0 {
+		return nil
+	}
+
+	// The oldest new detection for each player, windows ending before it have already been evaluated.
+	earliest := map[steamid.SteamID]time.Time{}
 	for _, entry := range entries {
 		if !entry.SteamID.Valid() {
 			continue
 		}
-		if _, ok := results[entry.SteamID]; !ok {
-			if err := a.persons.EnsurePerson(ctx, entry.SteamID); err != nil {
-				return err
-			}
-			results[entry.SteamID] = map[logparse.Detection]int32{}
+
+		if current, found := earliest[entry.SteamID]; !found || entry.CreatedOn.Before(current) {
+			earliest[entry.SteamID] = entry.CreatedOn
+		}
+	}
+
+	for steamID, since := range earliest {
+		if err := a.persons.EnsurePerson(ctx, steamID); err != nil {
+			return err
+		}
+
+		var from time.Time
+		if !unbounded {
+			from = since.Add(-maxWindow)
 		}
 
-		if _, ok := results[entry.SteamID][entry.Detection]; !ok {
-			results[entry.SteamID][entry.Detection] = 0
+		history, errHistory := a.repo.DetectionsSince(ctx, steamID, from)
+		if errHistory != nil {
+			return errHistory
 		}
 
-		results[entry.SteamID][entry.Detection]++
-
-		isBan := false
-
-		switch entry.Detection {
-		case logparse.SilentAim:
-			isBan = a.MaxPsilent > 0 && results[entry.SteamID][entry.Detection] >= a.MaxPsilent
-		case logparse.AimSnap:
-			isBan = a.MaxAimSnap > 0 && results[entry.SteamID][entry.Detection] >= a.MaxAimSnap
-		case logparse.BHop:
-			isBan = a.MaxBhop > 0 && results[entry.SteamID][entry.Detection] >= a.MaxBhop
-		case logparse.CmdNumSpike:
-			isBan = a.MaxCmdNum > 0 && results[entry.SteamID][entry.Detection] >= a.MaxCmdNum
-		case logparse.EyeAngles:
-			isBan = a.MaxFakeAng > 0 && results[entry.SteamID][entry.Detection] >= a.MaxFakeAng
-		case logparse.InvalidUserCmd:
-			isBan = a.MaxInvalidUserCmd > 0 && results[entry.SteamID][entry.Detection] >= a.MaxInvalidUserCmd
-		case logparse.OOBCVar:
-			isBan = a.MaxOOBVar > 0 && results[entry.SteamID][entry.Detection] >= a.MaxOOBVar
-		case logparse.CheatCVar:
-			isBan = a.MaxCheatCvar > 0 && results[entry.SteamID][entry.Detection] >= a.MaxCheatCvar
-		case logparse.TooManyConnectiona:
-			isBan = a.MaxTooManyConnections > 0 && results[entry.SteamID][entry.Detection] >= a.MaxTooManyConnections
-		default:
-			slog.Warn("Got unknown stac detection", slog.String("summary", entry.Summary))
+		lastFired, errLastFired := a.repo.LastTriggers(ctx, steamID)
+		if errLastFired != nil {
+			return errLastFired
 		}
 
-		if !isBan || slices.Contains(hasBeenBanned, entry.SteamID) {
+		a.applyTriggers(ctx, EvaluateRules(enabled, history, since.Truncate(time.Second), lastFired), history)
+	}
+
+	return nil
+}
+
+func (a AntiCheat) applyTriggers(ctx context.Context, triggers []Trigger, history []logparse.StacEntry) {
+	actioned := false
+
+	for _, trigger := range triggers {
+		if !trigger.DryRun && actioned {
 			continue
 		}
 
-		var dur time.Duration
-		if a.Duration > 0 {
-			dur = time.Duration(a.Duration) * time.Second
+		saved, errSave := a.repo.SaveTrigger(ctx, trigger)
+		if errSave != nil {
+			if !errors.Is(errSave, database.ErrDuplicate) {
+				slog.Error("Failed to save anticheat trigger", slog.String("error", errSave.Error()),
+					slog.String("rule", trigger.RuleName), slog.Int64("steam_id", trigger.SteamID.Int64()))
+			}
+
+			continue
 		}
 
-		if err := a.handler(ctx, entry, dur, results[entry.SteamID][entry.Detection]); err != nil {
-			slog.Error("Failed to run antichat handler", slog.String("detection", string(entry.Detection)),
-				slog.Int64("steam_id", entry.SteamID.Int64()), slog.String("error", err.Error()))
+		if saved.DryRun {
+			slog.Info("Anticheat rule fired (dry run)", slog.String("rule", saved.RuleName),
+				slog.String("action", string(saved.Action)), slog.Int64("steam_id", saved.SteamID.Int64()))
 
 			continue
 		}
-		hasBeenBanned = append(hasBeenBanned, entry.SteamID)
+
+		actioned = true
+
+		idx := slices.IndexFunc(history, func(entry logparse.StacEntry) bool {
+			return entry.CreatedOn.Equal(saved.WindowEnd)
+		})
+		if idx < 0 {
+			continue
+		}
+
+		banID, errHandle := a.handler(ctx, saved, history[idx])
+		if errHandle != nil {
+			slog.Error("Failed to run anticheat handler", slog.String("rule", saved.RuleName),
+				slog.Int64("steam_id", saved.SteamID.Int64()), slog.String("error", errHandle.Error()))
+
+			continue
+		}
+
+		if banID > 0 {
+			if err := a.repo.SetTriggerBan(ctx, saved.TriggerID, banID); err != nil {
+				slog.Error("Failed to update anticheat trigger ban", slog.String("error", err.Error()))
+			}
+		}
 	}
+}
 
-	return nil
+// Rules returns all configured rules.
+func (a AntiCheat) Rules(ctx context.Context) ([]Rule, error) {
+	return a.repo.Rules(ctx)
+}
+
+// SaveRule creates a new rule, or updates the existing one when RuleID is set.
+func (a AntiCheat) SaveRule(ctx context.Context, rule Rule) (Rule, error) {
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+
+	now := time.Now()
+	if rule.RuleID == 0 {
+		rule.CreatedOn = now
+	}
+
+	rule.UpdatedOn = now
+
+	return a.repo.SaveRule(ctx, rule)
+}
+
+func (a AntiCheat) DeleteRule(ctx context.Context, ruleID int32) error {
+	return a.repo.DeleteRule(ctx, ruleID)
+}
+
+// Triggers returns the recorded rule triggers, most recent first.
+func (a AntiCheat) Triggers(ctx context.Context, query TriggerQuery) ([]Trigger, error) {
+	return a.repo.Triggers(ctx, query)
 }
 
 func (a AntiCheat) DetectionsByType(ctx context.Context, detectionType logparse.Detection) ([]logparse.StacEntry, error) {
 	return a.repo.DetectionsByType(ctx, detectionType)
 }
 
+// Import parses and stores the log entries, returning only the entries that were not previously stored.
 func (a AntiCheat) Import(ctx context.Context, fileName string, reader io.ReadCloser, serverID int32) ([]logparse.StacEntry, error) {
 	entries, errEntries := a.parser.Parse(fileName, reader)
 	if errEntries != nil {
@@ -249,11 +326,26 @@ func (a AntiCheat) Import(ctx context.Context, fileName string, reader io.ReadCl
 		valid = append(valid, entries[index])
 	}
 
-	if err := a.repo.SaveEntries(ctx, valid); err != nil {
-		return nil, err
+	return a.repo.SaveEntries(ctx, valid)
+}
+
+// Ingest imports log entries pushed directly from a game server and immediately evaluates the rules against
+// any that were not already known. The reader must only contain complete entries.
+func (a AntiCheat) Ingest(ctx context.Context, fileName string, reader io.Reader, serverID int32) (int, error) {
+	entries, errImport := a.Import(ctx, fileName, io.NopCloser(reader), serverID)
+	if errImport != nil {
+		return 0, errImport
+	}
+
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	if errHandle := a.Handle(ctx, entries); errHandle != nil {
+		slog.Error("Failed to handle stac logs", slog.String("error", errHandle.Error()))
 	}
 
-	return valid, nil
+	return len(entries), nil
 }
 
 func (a AntiCheat) SyncDemoIDs(ctx context.Context, limit uint64) error {
@@ -126,19 +126,25 @@ func ACPlayerLogs(_ person.Info, entries []Entry) []discordgo.MessageComponent {
 	return []discordgo.MessageComponent{discord.BodyText(content)}
 }
 
-func NewAnticheatTrigger(note string, action Action, entry logparse.StacEntry, count int32) *discordgo.MessageSend {
+func NewAnticheatTrigger(note string, trigger Trigger, entry logparse.StacEntry) *discordgo.MessageSend {
 	content, errContent := discord.RenderTemplate("ac_trigger", struct {
-		Detection string
-		Count     int32
-		Action    string
-		Note      string
-		Entry     logparse.StacEntry
+		Rule       string
+		Detection  string
+		Detections int
+		Score      float64
+		Action     string
+		Duration   string
+		Note       string
+		Entry      logparse.StacEntry
 	}{
-		Detection: string(entry.Detection),
-		Count:     count,
-		Action:    string(action),
-		Note:      note,
-		Entry:     entry,
+		Rule:       trigger.RuleName,
+		Detection:  string(entry.Detection),
+		Detections: len(trigger.AnticheatIDs),
+		Score:      trigger.Score,
+		Action:     string(trigger.Action),
+		Duration:   durationString(trigger),
+		Note:       note,
+		Entry:      entry,
 	})
 	if errContent != nil {
 		slog.Error("Failed to render template", slog.String("error", errContent.Error()))
@@ -146,3 +152,14 @@ func NewAnticheatTrigger(note string, action Action, entry logparse.StacEntry, c
 
 	return discord.NewMessage(discord.BodyColouredText(discord.ColourSuccess, content))
 }
+
+func durationString(trigger Trigger) string {
+	switch {
+	case trigger.Action != ActionBan && trigger.Action != ActionGag:
+		return ""
+	case trigger.Duration == 0:
+		return "Permanent"
+	default:
+		return trigger.Duration.String()
+	}
+}
@@ -2,10 +2,12 @@ package anticheat
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/leighmacdonald/gbans/internal/database"
 	"github.com/leighmacdonald/gbans/pkg/logparse"
 	"github.com/leighmacdonald/steamid/v4/steamid"
@@ -140,21 +142,37 @@ func (a Repository) DetectionsByType(ctx context.Context, detectionType logparse
 	return entries, nil
 }
 
-func (a Repository) SaveEntries(ctx context.Context, entries []logparse.StacEntry) error {
+// SaveEntries stores the entries, returning only those which did not already exist with their newly
+// assigned anticheat_id. Existing entries are matched on steam_id and created_on so the same detection
+// imported from multiple sources is only stored, and returned, once.
+func (a Repository) SaveEntries(ctx context.Context, entries []logparse.StacEntry) ([]logparse.StacEntry, error) {
+	const query = `
+		INSERT INTO anticheat (steam_id, name, detection, summary, demo_id, server_id, raw_log, created_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (steam_id, created_on) DO UPDATE
+		SET name = $2, detection = $3, summary = $4, demo_id = $5, server_id = $6, raw_log = $7
+		RETURNING anticheat_id, (xmax = 0) AS inserted`
+
+	var inserted []logparse.StacEntry //nolint:prealloc
+
 	for _, entry := range entries {
-		const query = `
-			INSERT INTO anticheat (steam_id, name, detection, summary, demo_id, server_id, raw_log, created_on)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-			ON CONFLICT (steam_id, created_on) DO UPDATE
-			SET name = $2, detection = $3, summary = $4, demo_id = $5, server_id = $6, raw_log = $7`
-
-		if err := a.Exec(ctx, query, entry.SteamID.Int64(), entry.Name, entry.Detection, entry.Summary,
-			entry.DemoID, entry.ServerID, entry.RawLog, entry.CreatedOn.Truncate(time.Second)); err != nil {
-			return database.Err(err)
+		var isNew bool
+
+		entry.CreatedOn = entry.CreatedOn.Truncate(time.Second)
+
+		if err := a.QueryRow(ctx, query, entry.SteamID.Int64(), entry.Name, entry.Detection, entry.Summary,
+			entry.DemoID, entry.ServerID, entry.RawLog, entry.CreatedOn).Scan(&entry.AnticheatID, &isNew); err != nil {
+			return nil, database.Err(err)
 		}
+
+		if !isNew {
+			continue
+		}
+
+		inserted = append(inserted, entry)
 	}
 
-	return nil
+	return inserted, nil
 }
 
 type demoIDMap struct {
@@ -256,3 +274,280 @@ func (a Repository) SyncDemoIDs(ctx context.Context, limit uint64) error {
 
 	return a.updateTitleMapping(ctx, titleMap)
 }
+
+// DetectionsSince returns the players detections created on or after since, ordered oldest first.
+func (a Repository) DetectionsSince(ctx context.Context, steamID steamid.SteamID, since time.Time) ([]logparse.StacEntry, error) {
+	rows, errRows := a.QueryBuilder(ctx, a.Builder().
+		Select("a.anticheat_id", "a.steam_id", "a.name", "a.detection", "a.summary", "a.demo_id", "a.demo_name",
+			"a.demo_tick", "a.server_id", "a.raw_log", "s.short_name", "a.created_on").
+		From("anticheat a").
+		LeftJoin("server s USING(server_id)").
+		Where(sq.And{sq.Eq{"a.steam_id": steamID.Int64()}, sq.GtOrEq{"a.created_on": since}}).
+		OrderBy("a.created_on"))
+	if errRows != nil {
+		return nil, errRows
+	}
+
+	defer rows.Close()
+
+	var entries []logparse.StacEntry
+
+	for rows.Next() {
+		var entry logparse.StacEntry
+		if err := rows.Scan(&entry.AnticheatID, &entry.SteamID, &entry.Name, &entry.Detection, &entry.Summary,
+			&entry.DemoID, &entry.DemoName, &entry.DemoTick, &entry.ServerID, &entry.RawLog, &entry.ServerName,
+			&entry.CreatedOn); err != nil {
+			return nil, database.Err(err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (a Repository) Rules(ctx context.Context) ([]Rule, error) {
+	rows, errRows := a.QueryBuilder(ctx, a.Builder().
+		Select("rule_id", "name", "enabled", "dry_run", "threshold", "window_seconds", "action",
+			"duration_seconds", "created_on", "updated_on").
+		From("anticheat_rule").
+		OrderBy("rule_id"))
+	if errRows != nil {
+		return nil, errRows
+	}
+
+	defer rows.Close()
+
+	var (
+		rules   []Rule
+		indexes = map[int32]int{}
+	)
+
+	for rows.Next() {
+		var (
+			rule            Rule
+			windowSeconds   int32
+			durationSeconds int32
+		)
+
+		if err := rows.Scan(&rule.RuleID, &rule.Name, &rule.Enabled, &rule.DryRun, &rule.Threshold, &windowSeconds,
+			&rule.Action, &durationSeconds, &rule.CreatedOn, &rule.UpdatedOn); err != nil {
+			return nil, database.Err(err)
+		}
+
+		rule.Window = time.Duration(windowSeconds) * time.Second
+		rule.Duration = time.Duration(durationSeconds) * time.Second
+		indexes[rule.RuleID] = len(rules)
+		rules = append(rules, rule)
+	}
+
+	conditionRows, errConditions := a.QueryBuilder(ctx, a.Builder().
+		Select("rule_id", "detection", "weight", "min_count").
+		From("anticheat_rule_condition").
+		OrderBy("rule_id", "detection"))
+	if errConditions != nil {
+		return nil, errConditions
+	}
+
+	defer conditionRows.Close()
+
+	for conditionRows.Next() {
+		var (
+			ruleID    int32
+			condition Condition
+		)
+
+		if err := conditionRows.Scan(&ruleID, &condition.Detection, &condition.Weight, &condition.MinCount); err != nil {
+			return nil, database.Err(err)
+		}
+
+		if idx, found := indexes[ruleID]; found {
+			rules[idx].Conditions = append(rules[idx].Conditions, condition)
+		}
+	}
+
+	return rules, nil
+}
+
+func (a Repository) SaveRule(ctx context.Context, rule Rule) (Rule, error) {
+	errTx := a.WrapTx(ctx, func(transaction pgx.Tx) error {
+		var (
+			windowSeconds   = int32(rule.Window / time.Second)   //nolint:gosec
+			durationSeconds = int32(rule.Duration / time.Second) //nolint:gosec
+		)
+
+		if rule.RuleID == 0 {
+			if err := transaction.QueryRow(ctx, `
+				INSERT INTO anticheat_rule (name, enabled, dry_run, threshold, window_seconds, action, duration_seconds, created_on, updated_on)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				RETURNING rule_id`,
+				rule.Name, rule.Enabled, rule.DryRun, rule.Threshold, windowSeconds, rule.Action, durationSeconds,
+				rule.CreatedOn, rule.UpdatedOn).Scan(&rule.RuleID); err != nil {
+				return database.Err(err)
+			}
+		} else {
+			if err := transaction.QueryRow(ctx, `
+				UPDATE anticheat_rule
+				SET name = $2, enabled = $3, dry_run = $4, threshold = $5, window_seconds = $6, action = $7,
+				    duration_seconds = $8, updated_on = $9
+				WHERE rule_id = $1
+				RETURNING created_on`,
+				rule.RuleID, rule.Name, rule.Enabled, rule.DryRun, rule.Threshold, windowSeconds, rule.Action,
+				durationSeconds, rule.UpdatedOn).Scan(&rule.CreatedOn); err != nil {
+				return database.Err(err)
+			}
+
+			if _, err := transaction.Exec(ctx, `DELETE FROM anticheat_rule_condition WHERE rule_id = $1`, rule.RuleID); err != nil {
+				return database.Err(err)
+			}
+		}
+
+		batch := pgx.Batch{}
+		for _, condition := range rule.Conditions {
+			batch.Queue(`INSERT INTO anticheat_rule_condition (rule_id, detection, weight, min_count) VALUES ($1, $2, $3, $4)`,
+				rule.RuleID, condition.Detection, condition.Weight, condition.MinCount)
+		}
+
+		return database.Err(transaction.SendBatch(ctx, &batch).Close())
+	})
+	if errTx != nil {
+		return Rule{}, errTx
+	}
+
+	return rule, nil
+}
+
+func (a Repository) DeleteRule(ctx context.Context, ruleID int32) error {
+	var deleted int32
+
+	return database.Err(a.QueryRow(ctx, `DELETE FROM anticheat_rule WHERE rule_id = $1 RETURNING rule_id`, ruleID).Scan(&deleted))
+}
+
+// SaveTrigger records a new trigger. database.ErrDuplicate is returned when the rule has already fired for
+// the same window.
+func (a Repository) SaveTrigger(ctx context.Context, trigger Trigger) (Trigger, error) {
+	const query = `
+		INSERT INTO anticheat_rule_trigger (rule_id, rule_name, steam_id, action, duration_seconds, dry_run, score,
+		                                    anticheat_ids, window_end, created_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (rule_id, steam_id, window_end) DO NOTHING
+		RETURNING trigger_id`
+
+	if trigger.AnticheatIDs == nil {
+		trigger.AnticheatIDs = []int64{}
+	}
+
+	if err := a.QueryRow(ctx, query, trigger.RuleID, trigger.RuleName, trigger.SteamID.Int64(), trigger.Action,
+		int32(trigger.Duration/time.Second), trigger.DryRun, trigger.Score, trigger.AnticheatIDs, //nolint:gosec
+		trigger.WindowEnd, trigger.CreatedOn).Scan(&trigger.TriggerID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return trigger, database.ErrDuplicate
+		}
+
+		return trigger, database.Err(err)
+	}
+
+	return trigger, nil
+}
+
+// LastTriggers returns the window end of the most recent trigger of each rule that was actioned against the
+// player, keyed by rule id. Dry run triggers are ignored.
+func (a Repository) LastTriggers(ctx context.Context, steamID steamid.SteamID) (map[int32]time.Time, error) {
+	rows, errRows := a.QueryBuilder(ctx, a.Builder().
+		Select("rule_id", "max(window_end)").
+		From("anticheat_rule_trigger").
+		Where(sq.And{
+			sq.Eq{"steam_id": steamID.Int64(), "dry_run": false},
+			sq.NotEq{"rule_id": nil},
+		}).
+		GroupBy("rule_id"))
+	if errRows != nil {
+		return nil, errRows
+	}
+
+	defer rows.Close()
+
+	lastFired := map[int32]time.Time{}
+
+	for rows.Next() {
+		var (
+			ruleID    int32
+			windowEnd time.Time
+		)
+
+		if err := rows.Scan(&ruleID, &windowEnd); err != nil {
+			return nil, database.Err(err)
+		}
+
+		lastFired[ruleID] = windowEnd
+	}
+
+	return lastFired, nil
+}
+
+func (a Repository) SetTriggerBan(ctx context.Context, triggerID int64, banID int32) error {
+	return a.ExecUpdateBuilder(ctx, a.Builder().
+		Update("anticheat_rule_trigger").
+		Set("ban_id", banID).
+		Where(sq.Eq{"trigger_id": triggerID}))
+}
+
+func (a Repository) Triggers(ctx context.Context, query TriggerQuery) ([]Trigger, error) {
+	builder := a.Builder().
+		Select("t.trigger_id", "t.rule_id", "t.rule_name", "t.steam_id", "t.action", "t.duration_seconds", "t.dry_run",
+			"t.score", "t.anticheat_ids", "t.ban_id", "t.window_end", "t.created_on", "coalesce(p.personaname, '')").
+		From("anticheat_rule_trigger t").
+		LeftJoin("person p USING(steam_id)").
+		OrderBy("t.created_on DESC")
+
+	var filters sq.And
+	if query.SteamID.Valid() {
+		filters = append(filters, sq.Eq{"t.steam_id": query.SteamID.Int64()})
+	}
+
+	if query.RuleID > 0 {
+		filters = append(filters, sq.Eq{"t.rule_id": query.RuleID})
+	}
+
+	if len(filters) > 0 {
+		builder = builder.Where(filters)
+	}
+
+	if query.Limit > 0 {
+		builder = builder.Limit(query.Limit)
+	}
+
+	rows, errRows := a.QueryBuilder(ctx, builder)
+	if errRows != nil {
+		return nil, errRows
+	}
+
+	defer rows.Close()
+
+	var triggers []Trigger
+
+	for rows.Next() {
+		var (
+			trigger         Trigger
+			ruleID          *int32
+			steamID         int64
+			durationSeconds int32
+		)
+
+		if err := rows.Scan(&trigger.TriggerID, &ruleID, &trigger.RuleName, &steamID, &trigger.Action, &durationSeconds,
+			&trigger.DryRun, &trigger.Score, &trigger.AnticheatIDs, &trigger.BanID, &trigger.WindowEnd, &trigger.CreatedOn,
+			&trigger.Personaname); err != nil {
+			return nil, database.Err(err)
+		}
+
+		if ruleID != nil {
+			trigger.RuleID = *ruleID
+		}
+
+		trigger.SteamID = steamid.New(steamID)
+		trigger.Duration = time.Duration(durationSeconds) * time.Second
+		triggers = append(triggers, trigger)
+	}
+
+	return triggers, nil
+}
@@ -2,14 +2,19 @@ package anticheat
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"connectrpc.com/connect"
 	v1 "github.com/leighmacdonald/gbans/internal/anticheat/v1"
 	"github.com/leighmacdonald/gbans/internal/anticheat/v1/anticheatv1connect"
 	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/database"
 	"github.com/leighmacdonald/gbans/internal/rpc"
 	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -17,6 +22,10 @@ func NewService(anticheat AntiCheat, authMiddleware *rpc.Middleware, interceptor
 	pattern, handler := anticheatv1connect.NewAnticheatServiceHandler(Service{anticheat: anticheat}, interceptor...)
 
 	authMiddleware.UserRoute(anticheatv1connect.AnticheatServiceQueryProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(anticheatv1connect.AnticheatServiceRulesProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(anticheatv1connect.AnticheatServiceRuleSaveProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(anticheatv1connect.AnticheatServiceRuleDeleteProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(anticheatv1connect.AnticheatServiceTriggersProcedure, rpc.WithMinPermissions(permission.Moderator))
 
 	return rpc.Service{
 		Pattern: pattern,
@@ -74,6 +83,170 @@ func (s Service) Query(ctx context.Context, request *v1.QueryRequest) (*v1.Query
 	return &results, nil
 }
 
+func (s Service) Rules(ctx context.Context, _ *emptypb.Empty) (*v1.RulesResponse, error) {
+	rules, errRules := s.anticheat.Rules(ctx)
+	if errRules != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.RulesResponse{Rules: make([]*v1.Rule, len(rules))}
+	for idx, rule := range rules {
+		resp.Rules[idx] = toRule(rule)
+	}
+
+	return &resp, nil
+}
+
+func (s Service) RuleSave(ctx context.Context, request *v1.RuleSaveRequest) (*v1.RuleSaveResponse, error) {
+	rule, errSave := s.anticheat.SaveRule(ctx, fromRule(request.GetRule()))
+	if errSave != nil {
+		switch {
+		case errors.Is(errSave, ErrInvalidRule), errors.Is(errSave, ErrInvalidAction), errors.Is(errSave, ErrInvalidCondition):
+			return nil, connect.NewError(connect.CodeInvalidArgument, errSave)
+		case errors.Is(errSave, database.ErrDuplicate):
+			return nil, connect.NewError(connect.CodeAlreadyExists, rpc.ErrExists)
+		case errors.Is(errSave, database.ErrNoResult):
+			return nil, connect.NewError(connect.CodeNotFound, rpc.ErrNotFound)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &v1.RuleSaveResponse{Rule: toRule(rule)}, nil
+}
+
+func (s Service) RuleDelete(ctx context.Context, request *v1.RuleDeleteRequest) (*emptypb.Empty, error) {
+	if errDelete := s.anticheat.DeleteRule(ctx, request.GetRuleId()); errDelete != nil {
+		if errors.Is(errDelete, database.ErrNoResult) {
+			return nil, connect.NewError(connect.CodeNotFound, rpc.ErrNotFound)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s Service) Triggers(ctx context.Context, request *v1.TriggersRequest) (*v1.TriggersResponse, error) {
+	query := TriggerQuery{RuleID: request.GetRuleId(), Limit: request.GetLimit()}
+	if request.SteamId != nil {
+		query.SteamID = steamid.New(request.GetSteamId())
+		if !query.SteamID.Valid() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, steamid.ErrInvalidSID)
+		}
+	}
+
+	if query.Limit == 0 {
+		query.Limit = 100
+	}
+
+	triggers, errTriggers := s.anticheat.Triggers(ctx, query)
+	if errTriggers != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.TriggersResponse{Triggers: make([]*v1.Trigger, len(triggers))}
+	for idx, trigger := range triggers {
+		resp.Triggers[idx] = &v1.Trigger{
+			TriggerId:    &trigger.TriggerID,
+			RuleId:       &trigger.RuleID,
+			RuleName:     &trigger.RuleName,
+			SteamId:      new(trigger.SteamID.Int64()),
+			PersonaName:  &trigger.Personaname,
+			Action:       new(actionToRPC(trigger.Action)),
+			Duration:     durationpb.New(trigger.Duration),
+			DryRun:       &trigger.DryRun,
+			Score:        &trigger.Score,
+			AnticheatIds: trigger.AnticheatIDs,
+			BanId:        trigger.BanID,
+			WindowEnd:    timestamppb.New(trigger.WindowEnd),
+			CreatedOn:    timestamppb.New(trigger.CreatedOn),
+		}
+	}
+
+	return &resp, nil
+}
+
+func toRule(rule Rule) *v1.Rule {
+	conditions := make([]*v1.RuleCondition, len(rule.Conditions))
+	for idx, condition := range rule.Conditions {
+		conditions[idx] = &v1.RuleCondition{
+			Detection: detectionToRPC(condition.Detection),
+			Weight:    &condition.Weight,
+			MinCount:  &condition.MinCount,
+		}
+	}
+
+	return &v1.Rule{
+		RuleId:     &rule.RuleID,
+		Name:       &rule.Name,
+		Enabled:    &rule.Enabled,
+		DryRun:     &rule.DryRun,
+		Conditions: conditions,
+		Threshold:  &rule.Threshold,
+		Window:     durationpb.New(rule.Window),
+		Action:     new(actionToRPC(rule.Action)),
+		Duration:   durationpb.New(rule.Duration),
+		CreatedOn:  timestamppb.New(rule.CreatedOn),
+		UpdatedOn:  timestamppb.New(rule.UpdatedOn),
+	}
+}
+
+func fromRule(rule *v1.Rule) Rule {
+	conditions := make([]Condition, len(rule.GetConditions()))
+	for idx, condition := range rule.GetConditions() {
+		conditions[idx] = Condition{
+			Detection: toDetection(condition.GetDetection()),
+			Weight:    condition.GetWeight(),
+			MinCount:  condition.GetMinCount(),
+		}
+	}
+
+	return Rule{
+		RuleID:     rule.GetRuleId(),
+		Name:       rule.GetName(),
+		Enabled:    rule.GetEnabled(),
+		DryRun:     rule.GetDryRun(),
+		Conditions: conditions,
+		Threshold:  rule.GetThreshold(),
+		Window:     rule.GetWindow().AsDuration(),
+		Action:     toAction(rule.GetAction()),
+		Duration:   rule.GetDuration().AsDuration(),
+	}
+}
+
+func actionToRPC(action Action) v1.Action {
+	switch action {
+	case ActionNotify:
+		return v1.Action_ACTION_NOTIFY
+	case ActionGag:
+		return v1.Action_ACTION_GAG
+	case ActionKick:
+		return v1.Action_ACTION_KICK
+	case ActionBan:
+		return v1.Action_ACTION_BAN
+	default:
+		return v1.Action_ACTION_UNSPECIFIED
+	}
+}
+
+func toAction(action v1.Action) Action {
+	switch action {
+	case v1.Action_ACTION_NOTIFY:
+		return ActionNotify
+	case v1.Action_ACTION_GAG:
+		return ActionGag
+	case v1.Action_ACTION_KICK:
+		return ActionKick
+	case v1.Action_ACTION_BAN:
+		return ActionBan
+	case v1.Action_ACTION_UNSPECIFIED:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
 func detectionToRPC(detection logparse.Detection) *v1.Detection {
 	switch detection {
 	case logparse.SilentAim:
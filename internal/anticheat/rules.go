@@ -0,0 +1,252 @@
+package anticheat
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+var (
+	ErrInvalidRule      = errors.New("invalid anticheat rule")
+	ErrInvalidAction    = errors.New("invalid anticheat action")
+	ErrInvalidCondition = errors.New("invalid anticheat rule condition")
+)
+
+// severity orders the actions so that the harshest response wins when multiple rules fire at once.
+func (a Action) severity() int {
+	switch a {
+	case ActionNotify:
+		return 1
+	case ActionGag:
+		return 2
+	case ActionKick:
+		return 3
+	case ActionBan:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// Condition contributes matching detections towards the score of a Rule.
+type Condition struct {
+	// Detection is the detection type to match. logparse.Any matches every detection.
+	Detection logparse.Detection
+	// Weight is added to the rule score for each matching detection.
+	Weight float64
+	// MinCount is the minimum number of matching detections within the window required for the rule to
+	// fire, regardless of the overall score. Zero disables the requirement.
+	MinCount int32
+}
+
+// Rule describes when an automated response should be taken against a player. A rule fires once the
+// weighted sum of the players detections that fall within Window reaches Threshold, e.g. 3 aim snaps
+// within 10 minutes is expressed as a single aim_snap condition with a weight of 1, a threshold of 3 and
+// a 10 minute window.
+type Rule struct {
+	RuleID  int32
+	Name    string
+	Enabled bool
+	// DryRun rules record a Trigger when they fire but do not perform their action.
+	DryRun     bool
+	Conditions []Condition
+	Threshold  float64
+	// Window is the sliding time window over which detections are counted. Zero counts every known
+	// detection for the player.
+	Window time.Duration
+	Action Action
+	// Duration of the gag or ban. Zero is permanent.
+	Duration  time.Duration
+	CreatedOn time.Time
+	UpdatedOn time.Time
+}
+
+func (r Rule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" || r.Threshold <= 0 || r.Window < 0 || r.Duration < 0 {
+		return ErrInvalidRule
+	}
+
+	if r.Action.severity() == 0 {
+		return ErrInvalidAction
+	}
+
+	if len(r.Conditions) == 0 {
+		return ErrInvalidCondition
+	}
+
+	seen := map[logparse.Detection]bool{}
+	for _, condition := range r.Conditions {
+		if condition.Detection == "" || condition.Detection == logparse.Unknown || condition.MinCount < 0 || seen[condition.Detection] {
+			return ErrInvalidCondition
+		}
+
+		seen[condition.Detection] = true
+	}
+
+	return nil
+}
+
+// Trigger records a rule firing against a player along with the detections that caused it.
+type Trigger struct {
+	TriggerID int64
+	RuleID    int32
+	RuleName  string
+	SteamID   steamid.SteamID
+	Action    Action
+	Duration  time.Duration
+	DryRun    bool
+	Score     float64
+	// AnticheatIDs are the detections within the window at the time the rule fired.
+	AnticheatIDs []int64
+	// BanID is set when the action resulted in a ban or gag being created.
+	BanID *int32
+	// WindowEnd is the time of the detection that caused the rule to fire.
+	WindowEnd time.Time
+	CreatedOn time.Time
+
+	// Personaname is populated when querying triggers.
+	Personaname string
+}
+
+type TriggerQuery struct {
+	SteamID steamid.SteamID
+	RuleID  int32
+	Limit   uint64
+}
+
+// Evaluate slides the rules window over the history of a single player and returns a Trigger for the
+// first window in which the rule fires. History must be sorted by CreatedOn. Only windows ending at or
+// after since are considered so that detections which have already been evaluated do not fire again.
+func (r Rule) Evaluate(history []logparse.StacEntry, since time.Time) (Trigger, bool) {
+	if !r.Enabled || len(history) == 0 {
+		return Trigger{}, false
+	}
+
+	var (
+		counts = map[logparse.Detection]int32{}
+		start  = 0
+	)
+
+	for end, entry := range history {
+		counts[entry.Detection]++
+
+		if r.Window > 0 {
+			for start < end && !history[start].CreatedOn.After(entry.CreatedOn.Add(-r.Window)) {
+				counts[history[start].Detection]--
+				start++
+			}
+		}
+
+		if entry.CreatedOn.Before(since) {
+			continue
+		}
+
+		score, matched := r.score(counts)
+		if !matched {
+			continue
+		}
+
+		trigger := Trigger{
+			RuleID:    r.RuleID,
+			RuleName:  r.Name,
+			SteamID:   entry.SteamID,
+			Action:    r.Action,
+			Duration:  r.Duration,
+			DryRun:    r.DryRun,
+			Score:     score,
+			WindowEnd: entry.CreatedOn,
+			CreatedOn: time.Now(),
+		}
+
+		for _, windowEntry := range history[start : end+1] {
+			if r.matches(windowEntry.Detection) && windowEntry.AnticheatID > 0 {
+				trigger.AnticheatIDs = append(trigger.AnticheatIDs, windowEntry.AnticheatID)
+			}
+		}
+
+		return trigger, true
+	}
+
+	return Trigger{}, false
+}
+
+// cooldown stops a rule that was actioned from firing again for every following detection. Windows ending
+// within Window of the last actioned trigger are skipped, and a rule without a window only acts once. Dry
+// run rules are always evaluated.
+func (r Rule) cooldown(since time.Time, lastFired map[int32]time.Time) (time.Time, bool) {
+	last, found := lastFired[r.RuleID]
+	if r.DryRun || !found {
+		return since, true
+	}
+
+	if r.Window == 0 {
+		return since, false
+	}
+
+	if next := last.Add(r.Window); next.After(since) {
+		return next, true
+	}
+
+	return since, true
+}
+
+func (r Rule) matches(detection logparse.Detection) bool {
+	return slices.ContainsFunc(r.Conditions, func(condition Condition) bool {
+		return condition.Detection == logparse.Any || condition.Detection == detection
+	})
+}
+
+func (r Rule) score(counts map[logparse.Detection]int32) (float64, bool) {
+	var (
+		score float64
+		total int32
+	)
+
+	for _, condition := range r.Conditions {
+		var count int32
+		if condition.Detection == logparse.Any {
+			for _, value := range counts {
+				count += value
+			}
+		} else {
+			count = counts[condition.Detection]
+		}
+
+		if count < condition.MinCount {
+			return 0, false
+		}
+
+		score += float64(count) * condition.Weight
+		total += count
+	}
+
+	return score, total > 0 && score >= r.Threshold
+}
+
+// EvaluateRules evaluates every rule against a players history, returning the triggers that fired ordered
+// by the severity of their action, harshest first. LastFired holds the window end of the most recent trigger
+// that was actioned for each rule, keyed by rule id.
+func EvaluateRules(rules []Rule, history []logparse.StacEntry, since time.Time, lastFired map[int32]time.Time) []Trigger {
+	var triggers []Trigger
+
+	for _, rule := range rules {
+		ruleSince, active := rule.cooldown(since, lastFired)
+		if !active {
+			continue
+		}
+
+		if trigger, fired := rule.Evaluate(history, ruleSince); fired {
+			triggers = append(triggers, trigger)
+		}
+	}
+
+	slices.SortStableFunc(triggers, func(a, b Trigger) int {
+		return b.Action.severity() - a.Action.severity()
+	})
+
+	return triggers
+}
@@ -0,0 +1,157 @@
+package anticheat_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/anticheat"
+	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleEvaluate(t *testing.T) {
+	var (
+		start   = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		history []logparse.StacEntry
+	)
+
+	for idx, offset := range []time.Duration{0, 20 * time.Minute, 25 * time.Minute, 28 * time.Minute, 29 * time.Minute} {
+		history = append(history, logparse.StacEntry{
+			AnticheatID: int64(idx + 1),
+			SteamID:     tests.UserSID,
+			Detection:   logparse.AimSnap,
+			CreatedOn:   start.Add(offset),
+		})
+	}
+
+	history = append(history, logparse.StacEntry{
+		AnticheatID: 6,
+		SteamID:     tests.UserSID,
+		Detection:   logparse.SilentAim,
+		CreatedOn:   start.Add(30 * time.Minute),
+	})
+
+	snaps := anticheat.Rule{
+		RuleID:     1,
+		Name:       "3 snaps in 10 minutes",
+		Enabled:    true,
+		Conditions: []anticheat.Condition{{Detection: logparse.AimSnap, Weight: 1}},
+		Threshold:  3,
+		Window:     10 * time.Minute,
+		Action:     anticheat.ActionKick,
+	}
+
+	trigger, fired := snaps.Evaluate(history, start)
+	require.True(t, fired)
+	require.Equal(t, start.Add(28*time.Minute), trigger.WindowEnd)
+	require.Equal(t, []int64{2, 3, 4}, trigger.AnticheatIDs)
+	require.InDelta(t, 3.0, trigger.Score, 0.001)
+
+	// Windows ending before since have already been evaluated.
+	_, fired = snaps.Evaluate(history, start.Add(31*time.Minute))
+	require.False(t, fired)
+
+	combined := anticheat.Rule{
+		RuleID:  2,
+		Name:    "weighted",
+		Enabled: true,
+		DryRun:  true,
+		Conditions: []anticheat.Condition{
+			{Detection: logparse.AimSnap, Weight: 1},
+			{Detection: logparse.SilentAim, Weight: 5, MinCount: 1},
+		},
+		Threshold: 8,
+		Action:    anticheat.ActionBan,
+	}
+
+	trigger, fired = combined.Evaluate(history, start)
+	require.True(t, fired)
+	require.True(t, trigger.DryRun)
+	require.Equal(t, start.Add(30*time.Minute), trigger.WindowEnd)
+	require.InDelta(t, 10.0, trigger.Score, 0.001)
+
+	triggers := anticheat.EvaluateRules([]anticheat.Rule{snaps, combined}, history, start, nil)
+	require.Len(t, triggers, 2)
+	require.Equal(t, anticheat.ActionBan, triggers[0].Action)
+
+	snaps.Enabled = false
+	_, fired = snaps.Evaluate(history, start)
+	require.False(t, fired)
+}
+
+func TestEvaluateRulesCooldown(t *testing.T) {
+	var (
+		start   = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		history []logparse.StacEntry
+	)
+
+	// An aim snap every minute for half an hour.
+	for idx := range 30 {
+		history = append(history, logparse.StacEntry{
+			AnticheatID: int64(idx + 1),
+			SteamID:     tests.UserSID,
+			Detection:   logparse.AimSnap,
+			CreatedOn:   start.Add(time.Duration(idx) * time.Minute),
+		})
+	}
+
+	snaps := anticheat.Rule{
+		RuleID:     1,
+		Name:       "3 snaps in 10 minutes",
+		Enabled:    true,
+		Conditions: []anticheat.Condition{{Detection: logparse.AimSnap, Weight: 1}},
+		Threshold:  3,
+		Window:     10 * time.Minute,
+		Action:     anticheat.ActionBan,
+	}
+
+	triggers := anticheat.EvaluateRules([]anticheat.Rule{snaps}, history, start, nil)
+	require.Len(t, triggers, 1)
+	require.Equal(t, start.Add(2*time.Minute), triggers[0].WindowEnd)
+
+	// Every following detection is evaluated on its own as it arrives, but the rule stays quiet until its
+	// window has passed since it was actioned.
+	lastFired := map[int32]time.Time{snaps.RuleID: triggers[0].WindowEnd}
+	for idx := 3; idx < 12; idx++ {
+		since := history[idx].CreatedOn
+		require.Empty(t, anticheat.EvaluateRules([]anticheat.Rule{snaps}, history[:idx+1], since, lastFired), since)
+	}
+
+	triggers = anticheat.EvaluateRules([]anticheat.Rule{snaps}, history[:13], history[12].CreatedOn, lastFired)
+	require.Len(t, triggers, 1)
+	require.Equal(t, start.Add(12*time.Minute), triggers[0].WindowEnd)
+
+	// Dry run rules are recorded for every window.
+	dryRun := snaps
+	dryRun.DryRun = true
+	require.Len(t, anticheat.EvaluateRules([]anticheat.Rule{dryRun}, history[:6], history[5].CreatedOn, lastFired), 1)
+
+	// Without a window every detection is counted, so the rule only acts once.
+	unbounded := snaps
+	unbounded.Window = 0
+	require.Empty(t, anticheat.EvaluateRules([]anticheat.Rule{unbounded}, history, history[29].CreatedOn, lastFired))
+	require.Len(t, anticheat.EvaluateRules([]anticheat.Rule{unbounded}, history, history[29].CreatedOn, nil), 1)
+}
+
+func TestRuleValidate(t *testing.T) {
+	rule := anticheat.Rule{
+		Name:       "valid",
+		Conditions: []anticheat.Condition{{Detection: logparse.BHop, Weight: 1}},
+		Threshold:  1,
+		Action:     anticheat.ActionNotify,
+	}
+	require.NoError(t, rule.Validate())
+
+	invalidAction := rule
+	invalidAction.Action = "explode"
+	require.ErrorIs(t, invalidAction.Validate(), anticheat.ErrInvalidAction)
+
+	noConditions := rule
+	noConditions.Conditions = nil
+	require.ErrorIs(t, noConditions.Validate(), anticheat.ErrInvalidCondition)
+
+	noThreshold := rule
+	noThreshold.Threshold = 0
+	require.ErrorIs(t, noThreshold.Validate(), anticheat.ErrInvalidRule)
+}
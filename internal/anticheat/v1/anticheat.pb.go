@@ -11,6 +11,8 @@ import (
 	v1 "github.com/leighmacdonald/gbans/internal/database/query/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -97,6 +99,61 @@ func (Detection) EnumDescriptor() ([]byte, []int) {
 	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{0}
 }
 
+type Action int32
+
+const (
+	Action_ACTION_UNSPECIFIED Action = 0
+	Action_ACTION_NOTIFY      Action = 1
+	Action_ACTION_GAG         Action = 2
+	Action_ACTION_KICK        Action = 3
+	Action_ACTION_BAN         Action = 4
+)
+
+// Enum value maps for Action.
+var (
+	Action_name = map[int32]string{
+		0: "ACTION_UNSPECIFIED",
+		1: "ACTION_NOTIFY",
+		2: "ACTION_GAG",
+		3: "ACTION_KICK",
+		4: "ACTION_BAN",
+	}
+	Action_value = map[string]int32{
+		"ACTION_UNSPECIFIED": 0,
+		"ACTION_NOTIFY":      1,
+		"ACTION_GAG":         2,
+		"ACTION_KICK":        3,
+		"ACTION_BAN":         4,
+	}
+)
+
+func (x Action) Enum() *Action {
+	p := new(Action)
+	*p = x
+	return p
+}
+
+func (x Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_anticheat_v1_anticheat_proto_enumTypes[1].Descriptor()
+}
+
+func (Action) Type() protoreflect.EnumType {
+	return &file_anticheat_v1_anticheat_proto_enumTypes[1]
+}
+
+func (x Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Action.Descriptor instead.
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{1}
+}
+
 type QueryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filter        *v1.Filter             `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
@@ -373,11 +430,622 @@ func (x *Entry) GetTriggered() int32 {
 	return 0
 }
 
+type RuleCondition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DETECTION_UNSPECIFIED matches any detection type.
+	Detection *Detection `protobuf:"varint,1,opt,name=detection,enum=anticheat.v1.Detection" json:"detection,omitempty"`
+	// Weight is added to the rule score for each matching detection.
+	Weight *float64 `protobuf:"fixed64,2,opt,name=weight" json:"weight,omitempty"`
+	// MinCount is the minimum number of matching detections required within the window.
+	MinCount      *int32 `protobuf:"varint,3,opt,name=min_count,json=minCount" json:"min_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleCondition) Reset() {
+	*x = RuleCondition{}
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleCondition) ProtoMessage() {}
+
+func (x *RuleCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleCondition.ProtoReflect.Descriptor instead.
+func (*RuleCondition) Descriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RuleCondition) GetDetection() Detection {
+	if x != nil && x.Detection != nil {
+		return *x.Detection
+	}
+	return Detection_DETECTION_UNSPECIFIED
+}
+
+func (x *RuleCondition) GetWeight() float64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *RuleCondition) GetMinCount() int32 {
+	if x != nil && x.MinCount != nil {
+		return *x.MinCount
+	}
+	return 0
+}
+
+type Rule struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RuleId  *int32                 `protobuf:"varint,1,opt,name=rule_id,json=ruleId" json:"rule_id,omitempty"`
+	Name    *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Enabled *bool                  `protobuf:"varint,3,opt,name=enabled" json:"enabled,omitempty"`
+	// DryRun rules record triggers without performing their action.
+	DryRun     *bool            `protobuf:"varint,4,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+	Conditions []*RuleCondition `protobuf:"bytes,5,rep,name=conditions" json:"conditions,omitempty"`
+	Threshold  *float64         `protobuf:"fixed64,6,opt,name=threshold" json:"threshold,omitempty"`
+	// Window is the sliding window over which detections are counted. Unset or zero counts all detections.
+	Window *durationpb.Duration `protobuf:"bytes,7,opt,name=window" json:"window,omitempty"`
+	Action *Action              `protobuf:"varint,8,opt,name=action,enum=anticheat.v1.Action" json:"action,omitempty"`
+	// Duration of gags and bans. Unset or zero is permanent.
+	Duration      *durationpb.Duration   `protobuf:"bytes,9,opt,name=duration" json:"duration,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	UpdatedOn     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_on,json=updatedOn" json:"updated_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Rule) GetRuleId() int32 {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return 0
+}
+
+func (x *Rule) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *Rule) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *Rule) GetConditions() []*RuleCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *Rule) GetThreshold() float64 {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return 0
+}
+
+func (x *Rule) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *Rule) GetAction() Action {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return Action_ACTION_UNSPECIFIED
+}
+
+func (x *Rule) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Rule) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *Rule) GetUpdatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedOn
+	}
+	return nil
+}
+
+type RulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*Rule                `protobuf:"bytes,1,rep,name=rules" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RulesResponse) Reset() {
+	*x = RulesResponse{}
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RulesResponse) ProtoMessage() {}
+
+func (x *RulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RulesResponse.ProtoReflect.Descriptor instead.
+func (*RulesResponse) Descriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RulesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type RuleSaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *Rule                  `protobuf:"bytes,1,opt,name=rule" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleSaveRequest) Reset() {
+	*x = RuleSaveRequest{}
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSaveRequest) ProtoMessage() {}
+
+func (x *RuleSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSaveRequest.ProtoReflect.Descriptor instead.
+func (*RuleSaveRequest) Descriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RuleSaveRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type RuleSaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *Rule                  `protobuf:"bytes,1,opt,name=rule" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleSaveResponse) Reset() {
+	*x = RuleSaveResponse{}
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleSaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSaveResponse) ProtoMessage() {}
+
+func (x *RuleSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSaveResponse.ProtoReflect.Descriptor instead.
+func (*RuleSaveResponse) Descriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RuleSaveResponse) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type RuleDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *int32                 `protobuf:"varint,1,opt,name=rule_id,json=ruleId" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleDeleteRequest) Reset() {
+	*x = RuleDeleteRequest{}
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleDeleteRequest) ProtoMessage() {}
+
+func (x *RuleDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleDeleteRequest.ProtoReflect.Descriptor instead.
+func (*RuleDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RuleDeleteRequest) GetRuleId() int32 {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return 0
+}
+
+type TriggersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SteamId       *int64                 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	RuleId        *int32                 `protobuf:"varint,2,opt,name=rule_id,json=ruleId" json:"rule_id,omitempty"`
+	Limit         *uint64                `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggersRequest) Reset() {
+	*x = TriggersRequest{}
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggersRequest) ProtoMessage() {}
+
+func (x *TriggersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggersRequest.ProtoReflect.Descriptor instead.
+func (*TriggersRequest) Descriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TriggersRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+func (x *TriggersRequest) GetRuleId() int32 {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return 0
+}
+
+func (x *TriggersRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type TriggersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Triggers      []*Trigger             `protobuf:"bytes,1,rep,name=triggers" json:"triggers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggersResponse) Reset() {
+	*x = TriggersResponse{}
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggersResponse) ProtoMessage() {}
+
+func (x *TriggersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggersResponse.ProtoReflect.Descriptor instead.
+func (*TriggersResponse) Descriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TriggersResponse) GetTriggers() []*Trigger {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+type Trigger struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TriggerId   *int64                 `protobuf:"varint,1,opt,name=trigger_id,json=triggerId" json:"trigger_id,omitempty"`
+	RuleId      *int32                 `protobuf:"varint,2,opt,name=rule_id,json=ruleId" json:"rule_id,omitempty"`
+	RuleName    *string                `protobuf:"bytes,3,opt,name=rule_name,json=ruleName" json:"rule_name,omitempty"`
+	SteamId     *int64                 `protobuf:"varint,4,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	PersonaName *string                `protobuf:"bytes,5,opt,name=persona_name,json=personaName" json:"persona_name,omitempty"`
+	Action      *Action                `protobuf:"varint,6,opt,name=action,enum=anticheat.v1.Action" json:"action,omitempty"`
+	Duration    *durationpb.Duration   `protobuf:"bytes,7,opt,name=duration" json:"duration,omitempty"`
+	DryRun      *bool                  `protobuf:"varint,8,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
+	Score       *float64               `protobuf:"fixed64,9,opt,name=score" json:"score,omitempty"`
+	// AnticheatIds are the detections within the window when the rule fired.
+	AnticheatIds  []int64                `protobuf:"varint,10,rep,packed,name=anticheat_ids,json=anticheatIds" json:"anticheat_ids,omitempty"`
+	BanId         *int32                 `protobuf:"varint,11,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=window_end,json=windowEnd" json:"window_end,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trigger) Reset() {
+	*x = Trigger{}
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trigger) ProtoMessage() {}
+
+func (x *Trigger) ProtoReflect() protoreflect.Message {
+	mi := &file_anticheat_v1_anticheat_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trigger.ProtoReflect.Descriptor instead.
+func (*Trigger) Descriptor() ([]byte, []int) {
+	return file_anticheat_v1_anticheat_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Trigger) GetTriggerId() int64 {
+	if x != nil && x.TriggerId != nil {
+		return *x.TriggerId
+	}
+	return 0
+}
+
+func (x *Trigger) GetRuleId() int32 {
+	if x != nil && x.RuleId != nil {
+		return *x.RuleId
+	}
+	return 0
+}
+
+func (x *Trigger) GetRuleName() string {
+	if x != nil && x.RuleName != nil {
+		return *x.RuleName
+	}
+	return ""
+}
+
+func (x *Trigger) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+func (x *Trigger) GetPersonaName() string {
+	if x != nil && x.PersonaName != nil {
+		return *x.PersonaName
+	}
+	return ""
+}
+
+func (x *Trigger) GetAction() Action {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return Action_ACTION_UNSPECIFIED
+}
+
+func (x *Trigger) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Trigger) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+func (x *Trigger) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *Trigger) GetAnticheatIds() []int64 {
+	if x != nil {
+		return x.AnticheatIds
+	}
+	return nil
+}
+
+func (x *Trigger) GetBanId() int32 {
+	if x != nil && x.BanId != nil {
+		return *x.BanId
+	}
+	return 0
+}
+
+func (x *Trigger) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *Trigger) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
 var File_anticheat_v1_anticheat_proto protoreflect.FileDescriptor
 
 const file_anticheat_v1_anticheat_proto_rawDesc = "" +
 	"\n" +
-	"\x1canticheat/v1/anticheat.proto\x12\fanticheat.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1edatabase/query/v1/filter.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe3\x01\n" +
+	"\x1canticheat/v1/anticheat.proto\x12\fanticheat.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1edatabase/query/v1/filter.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe3\x01\n" +
 	"\fQueryRequest\x121\n" +
 	"\x06filter\x18\x01 \x01(\v2\x19.database.query.v1.FilterR\x06filter\x12 \n" +
 	"\x04name\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x00r\x04\x10\x02\x18 R\x04name\x12#\n" +
@@ -408,7 +1076,62 @@ const file_anticheat_v1_anticheat_proto_rawDesc = "" +
 	"\xbaH\a\xc8\x01\x01r\x02\x18 R\vpersonaName\x12)\n" +
 	"\vavatar_hash\x18\x0e \x01(\tB\b\xbaH\x05r\x03\x98\x01(R\n" +
 	"avatarHash\x12$\n" +
-	"\ttriggered\x18\x0f \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\ttriggered*\xaf\x02\n" +
+	"\ttriggered\x18\x0f \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\ttriggered\"\x96\x01\n" +
+	"\rRuleCondition\x12?\n" +
+	"\tdetection\x18\x01 \x01(\x0e2\x17.anticheat.v1.DetectionB\b\xbaH\x05\x82\x01\x02\x10\x01R\tdetection\x12\x1e\n" +
+	"\x06weight\x18\x02 \x01(\x01B\x06\xbaH\x03\xc8\x01\x01R\x06weight\x12$\n" +
+	"\tmin_count\x18\x03 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\bminCount\"\x94\x04\n" +
+	"\x04Rule\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x05R\x06ruleId\x12 \n" +
+	"\x04name\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x18@R\x04name\x12 \n" +
+	"\aenabled\x18\x03 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\aenabled\x12\x1f\n" +
+	"\adry_run\x18\x04 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\x06dryRun\x12E\n" +
+	"\n" +
+	"conditions\x18\x05 \x03(\v2\x1b.anticheat.v1.RuleConditionB\b\xbaH\x05\x92\x01\x02\b\x01R\n" +
+	"conditions\x12,\n" +
+	"\tthreshold\x18\x06 \x01(\x01B\x0e\xbaH\v\x12\t!\x00\x00\x00\x00\x00\x00\x00\x00R\tthreshold\x121\n" +
+	"\x06window\x18\a \x01(\v2\x19.google.protobuf.DurationR\x06window\x129\n" +
+	"\x06action\x18\b \x01(\x0e2\x14.anticheat.v1.ActionB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\x06action\x125\n" +
+	"\bduration\x18\t \x01(\v2\x19.google.protobuf.DurationR\bduration\x129\n" +
+	"\n" +
+	"created_on\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x129\n" +
+	"\n" +
+	"updated_on\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedOn\"A\n" +
+	"\rRulesResponse\x120\n" +
+	"\x05rules\x18\x01 \x03(\v2\x12.anticheat.v1.RuleB\x06\xbaH\x03\xc8\x01\x01R\x05rules\"A\n" +
+	"\x0fRuleSaveRequest\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x12.anticheat.v1.RuleB\x06\xbaH\x03\xc8\x01\x01R\x04rule\"B\n" +
+	"\x10RuleSaveResponse\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x12.anticheat.v1.RuleB\x06\xbaH\x03\xc8\x01\x01R\x04rule\"8\n" +
+	"\x11RuleDeleteRequest\x12#\n" +
+	"\arule_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x06ruleId\"k\n" +
+	"\x0fTriggersRequest\x12\x1d\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\x020\x01R\asteamId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x05R\x06ruleId\x12 \n" +
+	"\x05limit\x18\x03 \x01(\x04B\n" +
+	"\xbaH\x052\x03\x18\xe8\a0\x01R\x05limit\"M\n" +
+	"\x10TriggersResponse\x129\n" +
+	"\btriggers\x18\x01 \x03(\v2\x15.anticheat.v1.TriggerB\x06\xbaH\x03\xc8\x01\x01R\btriggers\"\xaa\x04\n" +
+	"\aTrigger\x12'\n" +
+	"\n" +
+	"trigger_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\ttriggerId\x12\x17\n" +
+	"\arule_id\x18\x02 \x01(\x05R\x06ruleId\x12#\n" +
+	"\trule_name\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bruleName\x12#\n" +
+	"\bsteam_id\x18\x04 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\asteamId\x12!\n" +
+	"\fpersona_name\x18\x05 \x01(\tR\vpersonaName\x124\n" +
+	"\x06action\x18\x06 \x01(\x0e2\x14.anticheat.v1.ActionB\x06\xbaH\x03\xc8\x01\x01R\x06action\x125\n" +
+	"\bduration\x18\a \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x1f\n" +
+	"\adry_run\x18\b \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\x06dryRun\x12\x1c\n" +
+	"\x05score\x18\t \x01(\x01B\x06\xbaH\x03\xc8\x01\x01R\x05score\x12'\n" +
+	"\ranticheat_ids\x18\n" +
+	" \x03(\x03B\x020\x01R\fanticheatIds\x12\x15\n" +
+	"\x06ban_id\x18\v \x01(\x05R\x05banId\x12A\n" +
+	"\n" +
+	"window_end\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\twindowEnd\x12A\n" +
+	"\n" +
+	"created_on\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn*\xaf\x02\n" +
 	"\tDetection\x12\x19\n" +
 	"\x15DETECTION_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14DETECTION_SILENT_AIM\x10\x01\x12\x16\n" +
@@ -421,9 +1144,22 @@ const file_anticheat_v1_anticheat_proto_rawDesc = "" +
 	"\x1aDETECTION_INVALID_USER_CMD\x10\b\x12\x16\n" +
 	"\x12DETECTION_OOB_CVAR\x10\t\x12\x18\n" +
 	"\x14DETECTION_CHEAT_CVAR\x10\n" +
-	"2V\n" +
+	"*d\n" +
+	"\x06Action\x12\x16\n" +
+	"\x12ACTION_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rACTION_NOTIFY\x10\x01\x12\x0e\n" +
+	"\n" +
+	"ACTION_GAG\x10\x02\x12\x0f\n" +
+	"\vACTION_KICK\x10\x03\x12\x0e\n" +
+	"\n" +
+	"ACTION_BAN\x10\x042\xf9\x02\n" +
 	"\x10AnticheatService\x12B\n" +
-	"\x05Query\x12\x1a.anticheat.v1.QueryRequest\x1a\x1b.anticheat.v1.QueryResponse\"\x00B\xb6\x01\n" +
+	"\x05Query\x12\x1a.anticheat.v1.QueryRequest\x1a\x1b.anticheat.v1.QueryResponse\"\x00\x12>\n" +
+	"\x05Rules\x12\x16.google.protobuf.Empty\x1a\x1b.anticheat.v1.RulesResponse\"\x00\x12K\n" +
+	"\bRuleSave\x12\x1d.anticheat.v1.RuleSaveRequest\x1a\x1e.anticheat.v1.RuleSaveResponse\"\x00\x12G\n" +
+	"\n" +
+	"RuleDelete\x12\x1f.anticheat.v1.RuleDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12K\n" +
+	"\bTriggers\x12\x1d.anticheat.v1.TriggersRequest\x1a\x1e.anticheat.v1.TriggersResponse\"\x00B\xb6\x01\n" +
 	"\x10com.anticheat.v1B\x0eAnticheatProtoP\x01ZAgithub.com/leighmacdonald/gbans/internal/anticheat/v1;anticheatv1\xa2\x02\x03AXX\xaa\x02\fAnticheat.V1\xca\x02\fAnticheat\\V1\xe2\x02\x18Anticheat\\V1\\GPBMetadata\xea\x02\rAnticheat::V1b\beditionsp\xe8\a"
 
 var (
@@ -438,29 +1174,64 @@ func file_anticheat_v1_anticheat_proto_rawDescGZIP() []byte {
 	return file_anticheat_v1_anticheat_proto_rawDescData
 }
 
-var file_anticheat_v1_anticheat_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_anticheat_v1_anticheat_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_anticheat_v1_anticheat_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_anticheat_v1_anticheat_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_anticheat_v1_anticheat_proto_goTypes = []any{
 	(Detection)(0),                // 0: anticheat.v1.Detection
-	(*QueryRequest)(nil),          // 1: anticheat.v1.QueryRequest
-	(*QueryResponse)(nil),         // 2: anticheat.v1.QueryResponse
-	(*Entry)(nil),                 // 3: anticheat.v1.Entry
-	(*v1.Filter)(nil),             // 4: database.query.v1.Filter
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(Action)(0),                   // 1: anticheat.v1.Action
+	(*QueryRequest)(nil),          // 2: anticheat.v1.QueryRequest
+	(*QueryResponse)(nil),         // 3: anticheat.v1.QueryResponse
+	(*Entry)(nil),                 // 4: anticheat.v1.Entry
+	(*RuleCondition)(nil),         // 5: anticheat.v1.RuleCondition
+	(*Rule)(nil),                  // 6: anticheat.v1.Rule
+	(*RulesResponse)(nil),         // 7: anticheat.v1.RulesResponse
+	(*RuleSaveRequest)(nil),       // 8: anticheat.v1.RuleSaveRequest
+	(*RuleSaveResponse)(nil),      // 9: anticheat.v1.RuleSaveResponse
+	(*RuleDeleteRequest)(nil),     // 10: anticheat.v1.RuleDeleteRequest
+	(*TriggersRequest)(nil),       // 11: anticheat.v1.TriggersRequest
+	(*TriggersResponse)(nil),      // 12: anticheat.v1.TriggersResponse
+	(*Trigger)(nil),               // 13: anticheat.v1.Trigger
+	(*v1.Filter)(nil),             // 14: database.query.v1.Filter
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 16: google.protobuf.Duration
+	(*emptypb.Empty)(nil),         // 17: google.protobuf.Empty
 }
 var file_anticheat_v1_anticheat_proto_depIdxs = []int32{
-	4, // 0: anticheat.v1.QueryRequest.filter:type_name -> database.query.v1.Filter
-	0, // 1: anticheat.v1.QueryRequest.detection:type_name -> anticheat.v1.Detection
-	3, // 2: anticheat.v1.QueryResponse.entries:type_name -> anticheat.v1.Entry
-	0, // 3: anticheat.v1.Entry.detection:type_name -> anticheat.v1.Detection
-	5, // 4: anticheat.v1.Entry.created_on:type_name -> google.protobuf.Timestamp
-	1, // 5: anticheat.v1.AnticheatService.Query:input_type -> anticheat.v1.QueryRequest
-	2, // 6: anticheat.v1.AnticheatService.Query:output_type -> anticheat.v1.QueryResponse
-	6, // [6:7] is the sub-list for method output_type
-	5, // [5:6] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	14, // 0: anticheat.v1.QueryRequest.filter:type_name -> database.query.v1.Filter
+	0,  // 1: anticheat.v1.QueryRequest.detection:type_name -> anticheat.v1.Detection
+	4,  // 2: anticheat.v1.QueryResponse.entries:type_name -> anticheat.v1.Entry
+	0,  // 3: anticheat.v1.Entry.detection:type_name -> anticheat.v1.Detection
+	15, // 4: anticheat.v1.Entry.created_on:type_name -> google.protobuf.Timestamp
+	0,  // 5: anticheat.v1.RuleCondition.detection:type_name -> anticheat.v1.Detection
+	5,  // 6: anticheat.v1.Rule.conditions:type_name -> anticheat.v1.RuleCondition
+	16, // 7: anticheat.v1.Rule.window:type_name -> google.protobuf.Duration
+	1,  // 8: anticheat.v1.Rule.action:type_name -> anticheat.v1.Action
+	16, // 9: anticheat.v1.Rule.duration:type_name -> google.protobuf.Duration
+	15, // 10: anticheat.v1.Rule.created_on:type_name -> google.protobuf.Timestamp
+	15, // 11: anticheat.v1.Rule.updated_on:type_name -> google.protobuf.Timestamp
+	6,  // 12: anticheat.v1.RulesResponse.rules:type_name -> anticheat.v1.Rule
+	6,  // 13: anticheat.v1.RuleSaveRequest.rule:type_name -> anticheat.v1.Rule
+	6,  // 14: anticheat.v1.RuleSaveResponse.rule:type_name -> anticheat.v1.Rule
+	13, // 15: anticheat.v1.TriggersResponse.triggers:type_name -> anticheat.v1.Trigger
+	1,  // 16: anticheat.v1.Trigger.action:type_name -> anticheat.v1.Action
+	16, // 17: anticheat.v1.Trigger.duration:type_name -> google.protobuf.Duration
+	15, // 18: anticheat.v1.Trigger.window_end:type_name -> google.protobuf.Timestamp
+	15, // 19: anticheat.v1.Trigger.created_on:type_name -> google.protobuf.Timestamp
+	2,  // 20: anticheat.v1.AnticheatService.Query:input_type -> anticheat.v1.QueryRequest
+	17, // 21: anticheat.v1.AnticheatService.Rules:input_type -> google.protobuf.Empty
+	8,  // 22: anticheat.v1.AnticheatService.RuleSave:input_type -> anticheat.v1.RuleSaveRequest
+	10, // 23: anticheat.v1.AnticheatService.RuleDelete:input_type -> anticheat.v1.RuleDeleteRequest
+	11, // 24: anticheat.v1.AnticheatService.Triggers:input_type -> anticheat.v1.TriggersRequest
+	3,  // 25: anticheat.v1.AnticheatService.Query:output_type -> anticheat.v1.QueryResponse
+	7,  // 26: anticheat.v1.AnticheatService.Rules:output_type -> anticheat.v1.RulesResponse
+	9,  // 27: anticheat.v1.AnticheatService.RuleSave:output_type -> anticheat.v1.RuleSaveResponse
+	17, // 28: anticheat.v1.AnticheatService.RuleDelete:output_type -> google.protobuf.Empty
+	12, // 29: anticheat.v1.AnticheatService.Triggers:output_type -> anticheat.v1.TriggersResponse
+	25, // [25:30] is the sub-list for method output_type
+	20, // [20:25] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_anticheat_v1_anticheat_proto_init() }
@@ -473,8 +1244,8 @@ func file_anticheat_v1_anticheat_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_anticheat_v1_anticheat_proto_rawDesc), len(file_anticheat_v1_anticheat_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
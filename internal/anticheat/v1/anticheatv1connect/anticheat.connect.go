@@ -9,6 +9,7 @@ import (
 	context "context"
 	errors "errors"
 	v1 "github.com/leighmacdonald/gbans/internal/anticheat/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -35,11 +36,26 @@ const (
 const (
 	// AnticheatServiceQueryProcedure is the fully-qualified name of the AnticheatService's Query RPC.
 	AnticheatServiceQueryProcedure = "/anticheat.v1.AnticheatService/Query"
+	// AnticheatServiceRulesProcedure is the fully-qualified name of the AnticheatService's Rules RPC.
+	AnticheatServiceRulesProcedure = "/anticheat.v1.AnticheatService/Rules"
+	// AnticheatServiceRuleSaveProcedure is the fully-qualified name of the AnticheatService's RuleSave
+	// RPC.
+	AnticheatServiceRuleSaveProcedure = "/anticheat.v1.AnticheatService/RuleSave"
+	// AnticheatServiceRuleDeleteProcedure is the fully-qualified name of the AnticheatService's
+	// RuleDelete RPC.
+	AnticheatServiceRuleDeleteProcedure = "/anticheat.v1.AnticheatService/RuleDelete"
+	// AnticheatServiceTriggersProcedure is the fully-qualified name of the AnticheatService's Triggers
+	// RPC.
+	AnticheatServiceTriggersProcedure = "/anticheat.v1.AnticheatService/Triggers"
 )
 
 // AnticheatServiceClient is a client for the anticheat.v1.AnticheatService service.
 type AnticheatServiceClient interface {
 	Query(context.Context, *v1.QueryRequest) (*v1.QueryResponse, error)
+	Rules(context.Context, *emptypb.Empty) (*v1.RulesResponse, error)
+	RuleSave(context.Context, *v1.RuleSaveRequest) (*v1.RuleSaveResponse, error)
+	RuleDelete(context.Context, *v1.RuleDeleteRequest) (*emptypb.Empty, error)
+	Triggers(context.Context, *v1.TriggersRequest) (*v1.TriggersResponse, error)
 }
 
 // NewAnticheatServiceClient constructs a client for the anticheat.v1.AnticheatService service. By
@@ -59,12 +75,40 @@ func NewAnticheatServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(anticheatServiceMethods.ByName("Query")),
 			connect.WithClientOptions(opts...),
 		),
+		rules: connect.NewClient[emptypb.Empty, v1.RulesResponse](
+			httpClient,
+			baseURL+AnticheatServiceRulesProcedure,
+			connect.WithSchema(anticheatServiceMethods.ByName("Rules")),
+			connect.WithClientOptions(opts...),
+		),
+		ruleSave: connect.NewClient[v1.RuleSaveRequest, v1.RuleSaveResponse](
+			httpClient,
+			baseURL+AnticheatServiceRuleSaveProcedure,
+			connect.WithSchema(anticheatServiceMethods.ByName("RuleSave")),
+			connect.WithClientOptions(opts...),
+		),
+		ruleDelete: connect.NewClient[v1.RuleDeleteRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AnticheatServiceRuleDeleteProcedure,
+			connect.WithSchema(anticheatServiceMethods.ByName("RuleDelete")),
+			connect.WithClientOptions(opts...),
+		),
+		triggers: connect.NewClient[v1.TriggersRequest, v1.TriggersResponse](
+			httpClient,
+			baseURL+AnticheatServiceTriggersProcedure,
+			connect.WithSchema(anticheatServiceMethods.ByName("Triggers")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // anticheatServiceClient implements AnticheatServiceClient.
 type anticheatServiceClient struct {
-	query *connect.Client[v1.QueryRequest, v1.QueryResponse]
+	query      *connect.Client[v1.QueryRequest, v1.QueryResponse]
+	rules      *connect.Client[emptypb.Empty, v1.RulesResponse]
+	ruleSave   *connect.Client[v1.RuleSaveRequest, v1.RuleSaveResponse]
+	ruleDelete *connect.Client[v1.RuleDeleteRequest, emptypb.Empty]
+	triggers   *connect.Client[v1.TriggersRequest, v1.TriggersResponse]
 }
 
 // Query calls anticheat.v1.AnticheatService.Query.
@@ -76,9 +120,49 @@ func (c *anticheatServiceClient) Query(ctx context.Context, req *v1.QueryRequest
 	return nil, err
 }
 
+// Rules calls anticheat.v1.AnticheatService.Rules.
+func (c *anticheatServiceClient) Rules(ctx context.Context, req *emptypb.Empty) (*v1.RulesResponse, error) {
+	response, err := c.rules.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RuleSave calls anticheat.v1.AnticheatService.RuleSave.
+func (c *anticheatServiceClient) RuleSave(ctx context.Context, req *v1.RuleSaveRequest) (*v1.RuleSaveResponse, error) {
+	response, err := c.ruleSave.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RuleDelete calls anticheat.v1.AnticheatService.RuleDelete.
+func (c *anticheatServiceClient) RuleDelete(ctx context.Context, req *v1.RuleDeleteRequest) (*emptypb.Empty, error) {
+	response, err := c.ruleDelete.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Triggers calls anticheat.v1.AnticheatService.Triggers.
+func (c *anticheatServiceClient) Triggers(ctx context.Context, req *v1.TriggersRequest) (*v1.TriggersResponse, error) {
+	response, err := c.triggers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // AnticheatServiceHandler is an implementation of the anticheat.v1.AnticheatService service.
 type AnticheatServiceHandler interface {
 	Query(context.Context, *v1.QueryRequest) (*v1.QueryResponse, error)
+	Rules(context.Context, *emptypb.Empty) (*v1.RulesResponse, error)
+	RuleSave(context.Context, *v1.RuleSaveRequest) (*v1.RuleSaveResponse, error)
+	RuleDelete(context.Context, *v1.RuleDeleteRequest) (*emptypb.Empty, error)
+	Triggers(context.Context, *v1.TriggersRequest) (*v1.TriggersResponse, error)
 }
 
 // NewAnticheatServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -94,10 +178,42 @@ func NewAnticheatServiceHandler(svc AnticheatServiceHandler, opts ...connect.Han
 		connect.WithSchema(anticheatServiceMethods.ByName("Query")),
 		connect.WithHandlerOptions(opts...),
 	)
+	anticheatServiceRulesHandler := connect.NewUnaryHandlerSimple(
+		AnticheatServiceRulesProcedure,
+		svc.Rules,
+		connect.WithSchema(anticheatServiceMethods.ByName("Rules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	anticheatServiceRuleSaveHandler := connect.NewUnaryHandlerSimple(
+		AnticheatServiceRuleSaveProcedure,
+		svc.RuleSave,
+		connect.WithSchema(anticheatServiceMethods.ByName("RuleSave")),
+		connect.WithHandlerOptions(opts...),
+	)
+	anticheatServiceRuleDeleteHandler := connect.NewUnaryHandlerSimple(
+		AnticheatServiceRuleDeleteProcedure,
+		svc.RuleDelete,
+		connect.WithSchema(anticheatServiceMethods.ByName("RuleDelete")),
+		connect.WithHandlerOptions(opts...),
+	)
+	anticheatServiceTriggersHandler := connect.NewUnaryHandlerSimple(
+		AnticheatServiceTriggersProcedure,
+		svc.Triggers,
+		connect.WithSchema(anticheatServiceMethods.ByName("Triggers")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/anticheat.v1.AnticheatService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AnticheatServiceQueryProcedure:
 			anticheatServiceQueryHandler.ServeHTTP(w, r)
+		case AnticheatServiceRulesProcedure:
+			anticheatServiceRulesHandler.ServeHTTP(w, r)
+		case AnticheatServiceRuleSaveProcedure:
+			anticheatServiceRuleSaveHandler.ServeHTTP(w, r)
+		case AnticheatServiceRuleDeleteProcedure:
+			anticheatServiceRuleDeleteHandler.ServeHTTP(w, r)
+		case AnticheatServiceTriggersProcedure:
+			anticheatServiceTriggersHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -110,3 +226,19 @@ type UnimplementedAnticheatServiceHandler struct{}
 func (UnimplementedAnticheatServiceHandler) Query(context.Context, *v1.QueryRequest) (*v1.QueryResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("anticheat.v1.AnticheatService.Query is not implemented"))
 }
+
+func (UnimplementedAnticheatServiceHandler) Rules(context.Context, *emptypb.Empty) (*v1.RulesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("anticheat.v1.AnticheatService.Rules is not implemented"))
+}
+
+func (UnimplementedAnticheatServiceHandler) RuleSave(context.Context, *v1.RuleSaveRequest) (*v1.RuleSaveResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("anticheat.v1.AnticheatService.RuleSave is not implemented"))
+}
+
+func (UnimplementedAnticheatServiceHandler) RuleDelete(context.Context, *v1.RuleDeleteRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("anticheat.v1.AnticheatService.RuleDelete is not implemented"))
+}
+
+func (UnimplementedAnticheatServiceHandler) Triggers(context.Context, *v1.TriggersRequest) (*v1.TriggersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("anticheat.v1.AnticheatService.Triggers is not implemented"))
+}
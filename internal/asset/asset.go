@@ -47,6 +47,7 @@ type Bucket string
 const (
 	BucketDemo  Bucket = "demos"
 	BucketMedia Bucket = "media"
+	BucketLogs  Bucket = "logs"
 )
 
 type UserUploadedFile struct {
@@ -160,7 +161,7 @@ func NewAssets(repo Repository) Assets {
 }
 
 func (s Assets) Create(ctx context.Context, author steamid.SteamID, bucket Bucket, fileName string, content io.ReadSeeker, private bool) (Asset, error) {
-	if bucket != "demos" && bucket != "media" {
+	if bucket != BucketDemo && bucket != BucketMedia && bucket != BucketLogs {
 		return Asset{}, ErrBucketType
 	}
 
@@ -168,8 +169,8 @@ func (s Assets) Create(ctx context.Context, author steamid.SteamID, bucket Bucke
 		return Asset{}, ErrAssetName
 	}
 
-	if bucket != "demos" && !author.Valid() {
-		// Non demo assets must have a real author
+	if bucket == BucketMedia && !author.Valid() {
+		// User uploaded assets must have a real author
 		return Asset{}, steamid.ErrInvalidSID
 	}
 
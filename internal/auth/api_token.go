@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/database"
+	personDomain "github.com/leighmacdonald/gbans/internal/domain/person"
+	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/gbans/pkg/stringutil"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+var (
+	ErrAPITokenExpired   = errors.New("api token expired")
+	ErrAPITokenPrivilege = errors.New("api token privilege exceeds owner privilege")
+	ErrAPITokenCreate    = errors.New("failed to generate api token")
+	ErrAPITokenLimit     = errors.New("too many api tokens")
+)
+
+const (
+	apiTokenSecretLen = 40
+	// apiTokenPrefixLen is the length of the secret shown to identify a token.
+	apiTokenPrefixLen = len(rpc.APITokenPrefix) + 6
+	// apiTokenMaxPerUser limits how many tokens a single user can hold.
+	apiTokenMaxPerUser = 25
+	// apiTokenUsedInterval limits how often the last used time is written for busy tokens.
+	apiTokenUsedInterval = time.Minute
+)
+
+// APIToken is a named, revocable personal token used by scripts and bots in place of a browser session.
+type APIToken struct {
+	TokenID   int64
+	SteamID   steamid.SteamID
+	Name      string
+	Prefix    string
+	Privilege permission.Privilege
+	// Procedures limits the token to these procedures, or services when ending with a slash.
+	Procedures []string
+	ExpiresOn  time.Time
+	LastUsedOn *time.Time
+	CreatedOn  time.Time
+}
+
+// apiTokenOwner is the token along with the current state of the person owning it.
+type apiTokenOwner struct {
+	APIToken
+
+	PermissionLevel permission.Privilege
+	PersonaName     string
+	AvatarHash      personDomain.Avatar
+}
+
+func hashAPIToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateAPIToken mints a new token for the owner, returning the token along with the secret. Only the hash
+// of the secret is stored, so it cannot be shown again.
+func (u *Authentication) CreateAPIToken(ctx context.Context, owner rpc.UserInfo, token APIToken) (APIToken, string, error) {
+	if token.Privilege > owner.Privilege {
+		return APIToken{}, "", ErrAPITokenPrivilege
+	}
+
+	existing, errExisting := u.auth.APITokens(ctx, owner.SteamID)
+	if errExisting != nil {
+		return APIToken{}, "", errExisting
+	}
+
+	if len(existing) >= apiTokenMaxPerUser {
+		return APIToken{}, "", ErrAPITokenLimit
+	}
+
+	random := stringutil.SecureRandomString(apiTokenSecretLen)
+	if random == "" {
+		return APIToken{}, "", ErrAPITokenCreate
+	}
+
+	secret := rpc.APITokenPrefix + random
+
+	slices.Sort(token.Procedures)
+
+	token.SteamID = owner.SteamID
+	token.Name = strings.TrimSpace(token.Name)
+	token.Procedures = slices.Compact(token.Procedures)
+	token.Prefix = secret[:apiTokenPrefixLen]
+	token.LastUsedOn = nil
+	token.CreatedOn = time.Now()
+
+	if errSave := u.auth.SaveAPIToken(ctx, &token, hashAPIToken(secret)); errSave != nil {
+		return APIToken{}, "", errSave
+	}
+
+	slog.Info("Created api token", slog.Int64("token_id", token.TokenID),
+		slog.String("steam_id", owner.SteamID.String()), slog.String("privilege", token.Privilege.String()))
+
+	return token, secret, nil
+}
+
+func (u *Authentication) APITokens(ctx context.Context, steamID steamid.SteamID) ([]APIToken, error) {
+	return u.auth.APITokens(ctx, steamID)
+}
+
+// RevokeAPIToken deletes a token. Only admins may revoke tokens owned by someone else.
+func (u *Authentication) RevokeAPIToken(ctx context.Context, user rpc.UserInfo, tokenID int64) error {
+	token, errToken := u.auth.APIToken(ctx, tokenID)
+	if errToken != nil {
+		return errToken
+	}
+
+	if token.SteamID != user.SteamID && !user.HasPermission(permission.Admin) {
+		return permission.ErrDenied
+	}
+
+	if errDelete := u.auth.DeleteAPIToken(ctx, tokenID); errDelete != nil {
+		return errDelete
+	}
+
+	slog.Info("Revoked api token", slog.Int64("token_id", tokenID), slog.String("steam_id", user.SteamID.String()))
+
+	return nil
+}
+
+// ValidateAPIToken implements rpc.APITokenValidator. The token acts with the lower of its own privilege and the
+// current privilege of its owner, so demoting or banning the owner applies to their tokens immediately.
+func (u *Authentication) ValidateAPIToken(ctx context.Context, secret string) (rpc.UserInfo, []string, error) {
+	token, errToken := u.auth.APITokenByHash(ctx, hashAPIToken(secret))
+	if errToken != nil {
+		return rpc.UserInfo{}, nil, errToken
+	}
+
+	now := time.Now()
+	if now.After(token.ExpiresOn) {
+		return rpc.UserInfo{}, nil, ErrAPITokenExpired
+	}
+
+	if token.LastUsedOn == nil || now.Sub(*token.LastUsedOn) > apiTokenUsedInterval {
+		if errUsed := u.auth.TouchAPIToken(ctx, token.TokenID, now); errUsed != nil && !errors.Is(errUsed, database.ErrNoResult) {
+			slog.Error("Failed to update api token last used", slog.String("error", errUsed.Error()))
+		}
+	}
+
+	return rpc.UserInfo{
+		SteamID:    token.SteamID,
+		AvatarHash: token.AvatarHash,
+		Name:       token.PersonaName,
+		Privilege:  min(token.Privilege, token.PermissionLevel),
+		APITokenID: token.TokenID,
+	}, token.Procedures, nil
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+var apiTokenColumns = []string{ //nolint:gochecknoglobals
+	"t.token_id", "t.steam_id", "t.name", "t.token_prefix", "t.privilege", "t.procedures", "t.expires_on",
+	"t.last_used_on", "t.created_on",
+}
+
+func (r Repository) SaveAPIToken(ctx context.Context, token *APIToken, hash string) error {
+	const query = `
+		INSERT INTO person_api_token (steam_id, name, token_hash, token_prefix, privilege, procedures, expires_on, created_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING token_id`
+
+	return database.Err(r.QueryRow(ctx, query, token.SteamID.Int64(), token.Name, hash, token.Prefix, token.Privilege,
+		token.Procedures, token.ExpiresOn, token.CreatedOn).Scan(&token.TokenID))
+}
+
+func (r Repository) APITokens(ctx context.Context, steamID steamid.SteamID) ([]APIToken, error) {
+	rows, errRows := r.QueryBuilder(ctx, r.Builder().
+		Select(apiTokenColumns...).
+		From("person_api_token t").
+		Where(sq.Eq{"t.steam_id": steamID.Int64()}).
+		OrderBy("t.created_on DESC"))
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	tokens := []APIToken{}
+
+	for rows.Next() {
+		var token APIToken
+		if errScan := rows.Scan(&token.TokenID, &token.SteamID, &token.Name, &token.Prefix, &token.Privilege,
+			&token.Procedures, &token.ExpiresOn, &token.LastUsedOn, &token.CreatedOn); errScan != nil {
+			return nil, database.Err(errScan)
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+func (r Repository) APIToken(ctx context.Context, tokenID int64) (APIToken, error) {
+	var token APIToken
+
+	row, errRow := r.QueryRowBuilder(ctx, r.Builder().
+		Select(apiTokenColumns...).
+		From("person_api_token t").
+		Where(sq.Eq{"t.token_id": tokenID}))
+	if errRow != nil {
+		return token, database.Err(errRow)
+	}
+
+	return token, database.Err(row.Scan(&token.TokenID, &token.SteamID, &token.Name, &token.Prefix, &token.Privilege,
+		&token.Procedures, &token.ExpiresOn, &token.LastUsedOn, &token.CreatedOn))
+}
+
+// APITokenByHash loads the token matching the hashed secret along with the current state of its owner.
+func (r Repository) APITokenByHash(ctx context.Context, hash string) (apiTokenOwner, error) {
+	var token apiTokenOwner
+
+	row, errRow := r.QueryRowBuilder(ctx, r.Builder().
+		Select(append(apiTokenColumns, "p.permission_level", "p.personaname", "p.avatarhash")...).
+		From("person_api_token t").
+		InnerJoin("person p ON p.steam_id = t.steam_id").
+		Where(sq.Eq{"t.token_hash": hash}))
+	if errRow != nil {
+		return token, database.Err(errRow)
+	}
+
+	return token, database.Err(row.Scan(&token.TokenID, &token.SteamID, &token.Name, &token.Prefix, &token.Privilege,
+		&token.Procedures, &token.ExpiresOn, &token.LastUsedOn, &token.CreatedOn, &token.PermissionLevel,
+		&token.PersonaName, &token.AvatarHash))
+}
+
+func (r Repository) TouchAPIToken(ctx context.Context, tokenID int64, usedOn time.Time) error {
+	return database.Err(r.ExecUpdateBuilder(ctx, r.Builder().
+		Update("person_api_token").
+		Set("last_used_on", usedOn).
+		Where(sq.Eq{"token_id": tokenID})))
+}
+
+func (r Repository) DeleteAPIToken(ctx context.Context, tokenID int64) error {
+	return database.Err(r.ExecDeleteBuilder(ctx, r.Builder().
+		Delete("person_api_token").
+		Where(sq.Eq{"token_id": tokenID})))
+}
+
+// DeleteAPITokensBySteamID revokes every api token of the player.
+func (r Repository) DeleteAPITokensBySteamID(ctx context.Context, steamID steamid.SteamID) error {
+	return database.Err(r.ExecDeleteBuilder(ctx, r.Builder().
+		Delete("person_api_token").
+		Where(sq.Eq{"steam_id": steamID.Int64()})))
+}
@@ -35,8 +35,11 @@ type PersonAuth struct {
 	PersonAuthID int64
 	SteamID      steamid.SteamID
 	IPAddr       net.IP
-	AccessToken  string
-	CreatedOn    time.Time
+	// AccessToken holds the fingerprint of the session.
+	AccessToken string
+	UserAgent   string
+	CreatedOn   time.Time
+	LastSeenOn  time.Time
 }
 
 type tokenExchangeEntry struct {
@@ -126,16 +129,18 @@ type Authentication struct {
 	siteName  string
 	cookieKey string
 	exchange  *TokenExchange
+	geo       GeoLocator
 }
 
 func NewAuthentication(repository Repository, siteName string, cookieKey string, persons *person.Persons,
-	bans ban.Bans, servers *servers.Servers, sentryDSN string,
+	bans ban.Bans, servers *servers.Servers, geo GeoLocator, sentryDSN string,
 ) *Authentication {
 	return &Authentication{
 		auth:      repository,
 		persons:   persons,
 		bans:      bans,
 		servers:   servers,
+		geo:       geo,
 		sentryDSN: sentryDSN,
 		siteName:  siteName,
 		cookieKey: cookieKey,
@@ -198,12 +203,16 @@ func RegisterExchangeHandler(mux *http.ServeMux, auth *Authentication, externalU
 	})
 }
 
-func NewPersonAuth(steamID steamid.SteamID, ipAddr net.IP, refreshToken string) PersonAuth {
+func NewPersonAuth(steamID steamid.SteamID, ipAddr net.IP, refreshToken string, userAgent string) PersonAuth {
+	now := time.Now()
+
 	return PersonAuth{
 		SteamID:     steamID,
 		IPAddr:      ipAddr,
 		AccessToken: refreshToken,
-		CreatedOn:   time.Now(),
+		UserAgent:   userAgent,
+		CreatedOn:   now,
+		LastSeenOn:  now,
 	}
 }
 
@@ -114,10 +114,17 @@ func (h *authHandler) onSteamOIDCCallback() http.HandlerFunc {
 			return
 		}
 
-		if ip := parseIP(req.RemoteAddr); ip != nil {
-			if errSave := h.SavePersonAuth(req.Context(), NewPersonAuth(fetchedPerson.SteamID, ip, fingerprint)); errSave != nil {
-				slog.Error("Failed to save auth record for revocation", slog.String("error", errSave.Error()))
-			}
+		ip := parseIP(req.RemoteAddr)
+		if ip == nil {
+			// Sessions are required for the token to be accepted, so one is saved even without a usable address.
+			ip = net.IPv4zero
+		}
+
+		if errSave := h.SavePersonAuth(req.Context(), NewPersonAuth(fetchedPerson.SteamID, ip, fingerprint, req.UserAgent())); errSave != nil {
+			http.Redirect(res, req, referralURL, http.StatusFound) //nolint:gosec
+			slog.Error("Failed to save auth record for revocation", slog.String("error", errSave.Error()))
+
+			return
 		}
 
 		parsedURL, errParse := url.Parse("/login/success")
@@ -231,7 +238,8 @@ func (h *authHandler) onAPILogout() http.HandlerFunc {
 		}
 
 		if sid.Valid() {
-			if errDelete := h.DeletePersonAuthBySteamID(req.Context(), sid); errDelete != nil {
+			// Only the current session is ended, logins on other devices remain valid.
+			if errDelete := h.DeletePersonAuthByFingerprint(req.Context(), sid, fingerprint.Value); errDelete != nil {
 				slog.Error("Failed to delete person auth on logout", slog.String("error", errDelete.Error()))
 			}
 		}
@@ -20,8 +20,8 @@ func NewRepository(database database.Database) Repository {
 func (r Repository) SavePersonAuth(ctx context.Context, auth *PersonAuth) error {
 	query, args, errQuery := r.Builder().
 		Insert("person_auth").
-		Columns("steam_id", "ip_addr", "refresh_token", "created_on").
-		Values(auth.SteamID.Int64(), auth.IPAddr.String(), auth.AccessToken, auth.CreatedOn).
+		Columns("steam_id", "ip_addr", "refresh_token", "user_agent", "created_on", "last_seen_on").
+		Values(auth.SteamID.Int64(), auth.IPAddr.String(), auth.AccessToken, auth.UserAgent, auth.CreatedOn, auth.LastSeenOn).
 		Suffix("RETURNING \"person_auth_id\"").
 		ToSql()
 
@@ -44,6 +44,78 @@ func (r Repository) DeletePersonAuthBySteamID(ctx context.Context, steamID steam
 		Where(sq.Eq{"steam_id": steamID.Int64()})))
 }
 
+func (r Repository) DeletePersonAuthByFingerprint(ctx context.Context, steamID steamid.SteamID, fingerprint string) error {
+	return database.Err(r.ExecDeleteBuilder(ctx, r.Builder().
+		Delete("person_auth").
+		Where(sq.Eq{"steam_id": steamID.Int64(), "refresh_token": fingerprint})))
+}
+
+// PersonAuths returns the sessions of the player created within maxAge, newest first.
+func (r Repository) PersonAuths(ctx context.Context, steamID steamid.SteamID, maxAge time.Duration) ([]PersonAuth, error) {
+	rows, errRows := r.QueryBuilder(ctx, r.Builder().
+		Select("person_auth_id", "steam_id", "ip_addr", "user_agent", "created_on", "coalesce(last_seen_on, created_on)").
+		From("person_auth").
+		Where(sq.Eq{"steam_id": steamID.Int64()}).
+		Where(sq.Gt{"created_on": time.Now().Add(-maxAge)}).
+		OrderBy("coalesce(last_seen_on, created_on) DESC"))
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	auths := []PersonAuth{}
+
+	for rows.Next() {
+		var auth PersonAuth
+		if errScan := rows.Scan(&auth.PersonAuthID, &auth.SteamID, &auth.IPAddr, &auth.UserAgent, &auth.CreatedOn,
+			&auth.LastSeenOn); errScan != nil {
+			return nil, database.Err(errScan)
+		}
+
+		auths = append(auths, auth)
+	}
+
+	return auths, nil
+}
+
+func (r Repository) GetPersonAuth(ctx context.Context, authID int64) (PersonAuth, error) {
+	var auth PersonAuth
+
+	row, errRow := r.QueryRowBuilder(ctx, r.Builder().
+		Select("person_auth_id", "steam_id", "ip_addr", "user_agent", "created_on", "coalesce(last_seen_on, created_on)").
+		From("person_auth").
+		Where(sq.Eq{"person_auth_id": authID}))
+	if errRow != nil {
+		return auth, database.Err(errRow)
+	}
+
+	return auth, database.Err(row.Scan(&auth.PersonAuthID, &auth.SteamID, &auth.IPAddr, &auth.UserAgent, &auth.CreatedOn,
+		&auth.LastSeenOn))
+}
+
+// GetPersonAuthByFingerprint loads the session of the player matching the fingerprint.
+func (r Repository) GetPersonAuthByFingerprint(ctx context.Context, steamID steamid.SteamID, fingerprint string) (PersonAuth, error) {
+	var auth PersonAuth
+
+	row, errRow := r.QueryRowBuilder(ctx, r.Builder().
+		Select("person_auth_id", "steam_id", "created_on", "coalesce(last_seen_on, created_on)").
+		From("person_auth").
+		Where(sq.Eq{"steam_id": steamID.Int64(), "refresh_token": fingerprint}))
+	if errRow != nil {
+		return auth, database.Err(errRow)
+	}
+
+	return auth, database.Err(row.Scan(&auth.PersonAuthID, &auth.SteamID, &auth.CreatedOn, &auth.LastSeenOn))
+}
+
+func (r Repository) TouchPersonAuth(ctx context.Context, authID int64, seenOn time.Time) error {
+	return database.Err(r.ExecUpdateBuilder(ctx, r.Builder().
+		Update("person_auth").
+		Set("last_seen_on", seenOn).
+		Where(sq.Eq{"person_auth_id": authID})))
+}
+
 func (r Repository) PrunePersonAuth(ctx context.Context) error {
 	return database.Err(r.ExecDeleteBuilder(ctx, r.Builder().
 		Delete("person_auth").
@@ -2,21 +2,36 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
 	"connectrpc.com/connect"
 	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	v1 "github.com/leighmacdonald/gbans/internal/auth/v1"
 	"github.com/leighmacdonald/gbans/internal/auth/v1/authv1connect"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/httphelper"
+	personv1 "github.com/leighmacdonald/gbans/internal/person/v1"
 	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-type Service struct{}
+type Service struct {
+	auth *Authentication
+}
 
-func NewService(authMiddleware *rpc.Middleware, option ...connect.HandlerOption) rpc.Service {
-	pattern, handler := authv1connect.NewAuthServiceHandler(Service{}, option...)
+func NewService(auth *Authentication, authMiddleware *rpc.Middleware, option ...connect.HandlerOption) rpc.Service {
+	pattern, handler := authv1connect.NewAuthServiceHandler(Service{auth: auth}, option...)
 
 	authMiddleware.UserRoute(authv1connect.AuthServiceLogoutProcedure, rpc.WithMinPermissions(permission.User))
+	authMiddleware.UserRoute(authv1connect.AuthServiceAPITokensProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(authv1connect.AuthServiceAPITokenCreateProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(authv1connect.AuthServiceAPITokenRevokeProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(authv1connect.AuthServiceSessionsProcedure, rpc.WithMinPermissions(permission.User))
+	authMiddleware.UserRoute(authv1connect.AuthServiceSessionRevokeProcedure, rpc.WithMinPermissions(permission.User))
+	authMiddleware.UserRoute(authv1connect.AuthServiceForceLogoutProcedure, rpc.WithMinPermissions(permission.Admin))
 
 	return rpc.Service{Pattern: pattern, Handler: handler}
 }
@@ -27,3 +42,169 @@ func (s Service) Logout(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty,
 
 	return &emptypb.Empty{}, nil
 }
+
+func (s Service) APITokens(ctx context.Context, req *v1.APITokensRequest) (*v1.APITokensResponse, error) {
+	user := rpc.UserInfoFromCtx(ctx)
+	steamID := user.SteamID
+
+	if req.GetSteamId() != 0 {
+		steamID = steamid.New(req.GetSteamId())
+		if !steamID.Valid() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+		}
+
+		if steamID != user.SteamID && !user.HasPermission(permission.Admin) {
+			return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
+		}
+	}
+
+	tokens, errTokens := s.auth.APITokens(ctx, steamID)
+	if errTokens != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.APITokensResponse{Tokens: make([]*v1.APIToken, len(tokens))}
+	for idx, token := range tokens {
+		resp.Tokens[idx] = toAPIToken(token)
+	}
+
+	return &resp, nil
+}
+
+func (s Service) APITokenCreate(ctx context.Context, req *v1.APITokenCreateRequest) (*v1.APITokenCreateResponse, error) {
+	user := rpc.UserInfoFromCtx(ctx)
+	if user.APITokenID != 0 {
+		// Tokens cannot mint further tokens, a leaked token must not be able to outlive its revocation.
+		return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
+	}
+
+	token, secret, errCreate := s.auth.CreateAPIToken(ctx, *user, APIToken{
+		Name:       req.GetName(),
+		Privilege:  permission.Privilege(req.GetPrivilege()), //nolint:gosec
+		Procedures: req.GetProcedures(),
+		ExpiresOn:  req.GetExpiresOn().AsTime(),
+	})
+	if errCreate != nil {
+		switch {
+		case errors.Is(errCreate, ErrAPITokenPrivilege):
+			return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
+		case errors.Is(errCreate, ErrAPITokenLimit):
+			return nil, connect.NewError(connect.CodeResourceExhausted, ErrAPITokenLimit)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &v1.APITokenCreateResponse{Token: toAPIToken(token), Secret: &secret}, nil
+}
+
+func (s Service) APITokenRevoke(ctx context.Context, req *v1.APITokenRevokeRequest) (*emptypb.Empty, error) {
+	user := rpc.UserInfoFromCtx(ctx)
+
+	if errRevoke := s.auth.RevokeAPIToken(ctx, *user, req.GetTokenId()); errRevoke != nil {
+		switch {
+		case errors.Is(errRevoke, database.ErrNoResult):
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		case errors.Is(errRevoke, permission.ErrDenied):
+			return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s Service) Sessions(ctx context.Context, req *v1.SessionsRequest) (*v1.SessionsResponse, error) {
+	user := rpc.UserInfoFromCtx(ctx)
+	steamID := user.SteamID
+
+	if req.GetSteamId() != 0 {
+		steamID = steamid.New(req.GetSteamId())
+		if !steamID.Valid() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+		}
+
+		if steamID != user.SteamID && !user.HasPermission(permission.Admin) {
+			return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
+		}
+	}
+
+	sessions, errSessions := s.auth.Sessions(ctx, steamID)
+	if errSessions != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.SessionsResponse{Sessions: make([]*v1.Session, len(sessions))}
+	for idx, session := range sessions {
+		resp.Sessions[idx] = toSession(session, user.SessionID)
+	}
+
+	return &resp, nil
+}
+
+func (s Service) SessionRevoke(ctx context.Context, req *v1.SessionRevokeRequest) (*emptypb.Empty, error) {
+	user := rpc.UserInfoFromCtx(ctx)
+
+	if errRevoke := s.auth.RevokeSession(ctx, *user, req.GetSessionId()); errRevoke != nil {
+		switch {
+		case errors.Is(errRevoke, database.ErrNoResult):
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		case errors.Is(errRevoke, permission.ErrDenied):
+			return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s Service) ForceLogout(ctx context.Context, req *v1.ForceLogoutRequest) (*emptypb.Empty, error) {
+	user := rpc.UserInfoFromCtx(ctx)
+
+	steamID := steamid.New(req.GetSteamId())
+	if !steamID.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+	}
+
+	if errLogout := s.auth.ForceLogout(ctx, *user, steamID); errLogout != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toSession(session Session, currentID int64) *v1.Session {
+	return &v1.Session{
+		SessionId:   &session.PersonAuthID,
+		IpAddr:      new(session.IPAddr.String()),
+		UserAgent:   &session.UserAgent,
+		CountryCode: &session.Location.CountryCode,
+		CountryName: &session.Location.CountryName,
+		CityName:    &session.Location.CityName,
+		AsName:      &session.ASName,
+		CreatedOn:   timestamppb.New(session.CreatedOn),
+		LastSeenOn:  timestamppb.New(session.LastSeenOn),
+		Current:     new(session.PersonAuthID == currentID),
+	}
+}
+
+func toAPIToken(token APIToken) *v1.APIToken {
+	out := &v1.APIToken{
+		TokenId:    &token.TokenID,
+		SteamId:    new(token.SteamID.Int64()),
+		Name:       &token.Name,
+		Prefix:     &token.Prefix,
+		Privilege:  new(personv1.Privilege(token.Privilege)),
+		Procedures: token.Procedures,
+		ExpiresOn:  timestamppb.New(token.ExpiresOn),
+		CreatedOn:  timestamppb.New(token.CreatedOn),
+	}
+
+	if token.LastUsedOn != nil {
+		out.LastUsedOn = timestamppb.New(*token.LastUsedOn)
+	}
+
+	return out
+}
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/netip"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/network"
+	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// sessionSeenInterval limits how often the last seen time of an active session is written.
+const sessionSeenInterval = time.Minute
+
+// GeoLocator resolves the location of session addresses.
+type GeoLocator interface {
+	QueryNetwork(ctx context.Context, address netip.Addr) (network.Details, error)
+}
+
+// Session is a browser login along with the location of the address it was created from.
+type Session struct {
+	PersonAuth
+
+	Location network.Location
+	ASName   string
+}
+
+// Sessions returns the active browser logins of the player.
+func (u *Authentication) Sessions(ctx context.Context, steamID steamid.SteamID) ([]Session, error) {
+	auths, errAuths := u.auth.PersonAuths(ctx, steamID, TokenDuration)
+	if errAuths != nil {
+		return nil, errAuths
+	}
+
+	sessions := make([]Session, len(auths))
+	for idx, auth := range auths {
+		sessions[idx] = Session{PersonAuth: auth}
+
+		addr, ok := netip.AddrFromSlice(auth.IPAddr)
+		if !ok || u.geo == nil || addr.Unmap().IsUnspecified() {
+			continue
+		}
+
+		details, errDetails := u.geo.QueryNetwork(ctx, addr.Unmap())
+		if errDetails != nil {
+			slog.Warn("Failed to locate session address", slog.String("error", errDetails.Error()))
+
+			continue
+		}
+
+		sessions[idx].Location = details.Location
+		sessions[idx].ASName = details.Asn.ASName
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession ends a single browser login. Only admins may revoke sessions belonging to someone else.
+func (u *Authentication) RevokeSession(ctx context.Context, user rpc.UserInfo, sessionID int64) error {
+	auth, errAuth := u.auth.GetPersonAuth(ctx, sessionID)
+	if errAuth != nil {
+		return errAuth
+	}
+
+	if auth.SteamID != user.SteamID && !user.HasPermission(permission.Admin) {
+		return permission.ErrDenied
+	}
+
+	if errDelete := u.auth.DeletePersonAuth(ctx, sessionID); errDelete != nil {
+		return errDelete
+	}
+
+	slog.Info("Revoked session", slog.Int64("session_id", sessionID),
+		slog.String("steam_id", auth.SteamID.String()), slog.String("revoked_by", user.SteamID.String()))
+
+	return nil
+}
+
+// ForceLogout ends every browser login and revokes every api token of the player.
+func (u *Authentication) ForceLogout(ctx context.Context, admin rpc.UserInfo, steamID steamid.SteamID) error {
+	if errSessions := u.auth.DeletePersonAuthBySteamID(ctx, steamID); errSessions != nil {
+		return errSessions
+	}
+
+	if errTokens := u.auth.DeleteAPITokensBySteamID(ctx, steamID); errTokens != nil {
+		return errTokens
+	}
+
+	slog.Warn("Forced logout", slog.String("steam_id", steamID.String()), slog.String("admin", admin.SteamID.String()))
+
+	return nil
+}
+
+// ValidateSession implements rpc.SessionValidator. Logins without a matching session, because they have been
+// revoked or have logged out, are rejected even though their token is still valid.
+func (u *Authentication) ValidateSession(ctx context.Context, steamID steamid.SteamID, fingerprint string) (int64, error) {
+	auth, errAuth := u.auth.GetPersonAuthByFingerprint(ctx, steamID, fingerprint)
+	if errAuth != nil {
+		return 0, errAuth
+	}
+
+	now := time.Now()
+	if now.Sub(auth.LastSeenOn) > sessionSeenInterval {
+		if errSeen := u.auth.TouchPersonAuth(ctx, auth.PersonAuthID, now); errSeen != nil && !errors.Is(errSeen, database.ErrNoResult) {
+			slog.Error("Failed to update session last seen", slog.String("error", errSeen.Error()))
+		}
+	}
+
+	return auth.PersonAuthID, nil
+}
+
+func (u *Authentication) DeletePersonAuthByFingerprint(ctx context.Context, steamID steamid.SteamID, fingerprint string) error {
+	return u.auth.DeletePersonAuthByFingerprint(ctx, steamID, fingerprint)
+}
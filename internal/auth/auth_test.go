@@ -0,0 +1,147 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/leighmacdonald/gbans/internal/auth"
+	"github.com/leighmacdonald/gbans/internal/ban"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/notification"
+	"github.com/leighmacdonald/gbans/internal/person"
+	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/leighmacdonald/gbans/pkg/stringutil"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+var fixture *tests.Fixture //nolint:gochecknoglobals
+
+func TestMain(m *testing.M) {
+	fixture = tests.NewFixture()
+	defer fixture.Close()
+
+	m.Run()
+}
+
+var errSaveFailed = errors.New("save failed")
+
+// failedRow is returned for every single row query of failingDatabase.
+type failedRow struct{}
+
+func (failedRow) Scan(_ ...any) error {
+	return errSaveFailed
+}
+
+// failingDatabase fails inserts that return the new row id, such as saving a session.
+type failingDatabase struct {
+	database.Database
+}
+
+func (failingDatabase) QueryRow(_ context.Context, _ string, _ ...any) pgx.Row {
+	return failedRow{}
+}
+
+func newTestAuthentication(db database.Database) *auth.Authentication {
+	conf := fixture.Config.Config()
+	persons := person.NewPersons(person.NewRepository(fixture.Database, false), steamid.New(conf.Owner), fixture.TFApi)
+
+	return auth.NewAuthentication(auth.NewRepository(db), conf.General.SiteName, conf.HTTPCookieKey, persons,
+		ban.Bans{}, nil, nil, "")
+}
+
+func TestValidateSession(t *testing.T) {
+	userAuth := newTestAuthentication(fixture.Database)
+
+	sid := steamid.New(76561198084134100)
+	_, errPerson := fixture.Persons.GetOrCreatePersonBySteamID(t.Context(), sid)
+	require.NoError(t, errPerson)
+
+	fingerprint := stringutil.SecureRandomString(40)
+	require.NoError(t, userAuth.SavePersonAuth(t.Context(), auth.NewPersonAuth(sid, net.ParseIP("10.0.0.1"), fingerprint, "test")))
+
+	sessionID, errValidate := userAuth.ValidateSession(t.Context(), sid, fingerprint)
+	require.NoError(t, errValidate)
+	require.Positive(t, sessionID)
+
+	_, errOther := userAuth.ValidateSession(t.Context(), steamid.New(76561198084134101), fingerprint)
+	require.Error(t, errOther, "sessions must belong to the player of the token")
+
+	_, errUnknown := userAuth.ValidateSession(t.Context(), sid, stringutil.SecureRandomString(40))
+	require.Error(t, errUnknown)
+
+	require.NoError(t, userAuth.RevokeSession(t.Context(), rpc.UserInfo{SteamID: sid}, sessionID))
+
+	_, errRevoked := userAuth.ValidateSession(t.Context(), sid, fingerprint)
+	require.Error(t, errRevoked, "revoked sessions must be rejected")
+}
+
+func TestLogoutEndsCurrentSession(t *testing.T) {
+	userAuth := newTestAuthentication(fixture.Database)
+	middleware := rpc.NewMiddleware("gbans", fixture.Config.Config().HTTPCookieKey, nil, userAuth, nil)
+	mux := http.NewServeMux()
+	auth.NewAuthHandler(mux, userAuth, fixture.Config, fixture.TFApi, notification.NullNotifier{}, middleware)
+
+	sid := steamid.New(76561198084134102)
+	user, errPerson := fixture.Persons.GetOrCreatePersonBySteamID(t.Context(), sid)
+	require.NoError(t, errPerson)
+
+	token, fingerprint, errToken := middleware.MakeUserToken(user)
+	require.NoError(t, errToken)
+
+	otherFingerprint := stringutil.SecureRandomString(40)
+	for _, fp := range []string{fingerprint, otherFingerprint} {
+		require.NoError(t, userAuth.SavePersonAuth(t.Context(), auth.NewPersonAuth(sid, net.ParseIP("10.0.0.1"), fp, "test")))
+	}
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/api/auth/logout", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.AddCookie(&http.Cookie{Name: auth.FingerprintCookieName, Value: fingerprint})
+
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	_, errLoggedOut := userAuth.ValidateSession(t.Context(), sid, fingerprint)
+	require.Error(t, errLoggedOut, "the session of the fingerprint must be ended")
+
+	_, errOther := userAuth.ValidateSession(t.Context(), sid, otherFingerprint)
+	require.NoError(t, errOther, "sessions on other devices must remain valid")
+}
+
+func TestOIDCCallbackSessionSaveFailed(t *testing.T) {
+	conf := fixture.Config.Config()
+	conf.Debug.SkipOpenIDValidation = true
+	require.NoError(t, fixture.Config.Write(t.Context(), conf))
+
+	userAuth := newTestAuthentication(failingDatabase{Database: fixture.Database})
+	userAuth.StartExchange(t.Context())
+
+	middleware := rpc.NewMiddleware("gbans", conf.HTTPCookieKey, nil, userAuth, nil)
+	mux := http.NewServeMux()
+	auth.NewAuthHandler(mux, userAuth, fixture.Config, fixture.TFApi, notification.NullNotifier{}, middleware)
+
+	sid := steamid.New(76561198084134103)
+	query := url.Values{}
+	query.Set("openid.identity", "https://steamcommunity.com/openid/id/"+sid.String())
+	query.Set("return_url", "/profile")
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/auth/callback?"+query.Encode(), nil)
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, req)
+
+	require.Equal(t, http.StatusFound, recorder.Code)
+	require.Equal(t, "/profile", recorder.Header().Get("Location"), "the login must not complete without a session")
+	require.Empty(t, recorder.Result().Cookies(), "no token may be issued without a session") //nolint:bodyclose
+
+	sessions, errSessions := newTestAuthentication(fixture.Database).Sessions(t.Context(), sid)
+	require.NoError(t, errSessions)
+	require.Empty(t, sessions)
+}
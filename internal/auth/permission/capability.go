@@ -0,0 +1,101 @@
+package permission
+
+import "slices"
+
+// Capability is a single area of access that can be granted through roles, independently of the Privilege ladder.
+type Capability string
+
+const (
+	// CapWordFilters allows viewing, creating, editing and testing word filters.
+	CapWordFilters Capability = "chat.word_filters"
+	// CapChatWarnings allows viewing, pardoning and clearing chat warnings.
+	CapChatWarnings Capability = "chat.warnings"
+	// CapChatEscalation allows changing the escalation steps applied to chat warnings, eg: gags and mutes.
+	CapChatEscalation Capability = "chat.escalation"
+	// CapAppeals allows listing appeals and reading any appeal thread.
+	CapAppeals Capability = "appeals.view"
+	// CapAppealsReply allows replying to any appeal, including closed ones.
+	CapAppealsReply Capability = "appeals.reply"
+	// CapBansView allows listing bans, their history and the escalation ladder.
+	CapBansView Capability = "bans.view"
+	// CapBans allows creating, editing and lifting bans which stop players joining servers.
+	CapBans Capability = "bans.ban"
+	// CapGags allows creating, editing and lifting mutes and gags.
+	CapGags Capability = "bans.gag"
+	// CapBanEscalation allows changing the escalation ladder used to suggest ban durations.
+	CapBanEscalation Capability = "bans.escalation"
+)
+
+// capabilityLevels is the privilege granting each capability when no roles have been configured.
+var capabilityLevels = map[Capability]Privilege{ //nolint:gochecknoglobals
+	CapWordFilters:    Moderator,
+	CapChatWarnings:   Moderator,
+	CapChatEscalation: Admin,
+	CapAppeals:        Moderator,
+	CapAppealsReply:   Moderator,
+	CapBansView:       Moderator,
+	CapBans:           Moderator,
+	CapGags:           Moderator,
+	CapBanEscalation:  Admin,
+}
+
+// Capabilities returns every known capability.
+func Capabilities() []Capability {
+	capabilities := make([]Capability, 0, len(capabilityLevels))
+	for capability := range capabilityLevels {
+		capabilities = append(capabilities, capability)
+	}
+
+	slices.Sort(capabilities)
+
+	return capabilities
+}
+
+func (c Capability) Valid() bool {
+	_, found := capabilityLevels[c]
+
+	return found
+}
+
+// DefaultPrivilege is the privilege that grants the capability by default.
+func (c Capability) DefaultPrivilege() Privilege {
+	level, found := capabilityLevels[c]
+	if !found {
+		return Admin
+	}
+
+	return level
+}
+
+// DefaultCapabilities returns the capabilities granted to the privilege by default.
+func DefaultCapabilities(privilege Privilege) []Capability {
+	var capabilities []Capability
+
+	for _, capability := range Capabilities() {
+		if privilege >= capability.DefaultPrivilege() {
+			capabilities = append(capabilities, capability)
+		}
+	}
+
+	return capabilities
+}
+
+// Capable is implemented by users whose capabilities have been resolved from their roles.
+type Capable interface {
+	Can(capability Capability) bool
+}
+
+// Privileged is implemented by any user with a privilege level.
+type Privileged interface {
+	HasPermission(privilege Privilege) bool
+}
+
+// Granted checks the capability of the user. Users without resolved capabilities fall back to the privilege
+// granting the capability by default.
+func Granted(user Privileged, capability Capability) bool {
+	if capable, ok := user.(Capable); ok {
+		return capable.Can(capability)
+	}
+
+	return user.HasPermission(capability.DefaultPrivilege())
+}
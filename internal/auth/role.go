@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+var (
+	ErrRoleInvalid    = errors.New("invalid role")
+	ErrRoleCapability = errors.New("unknown capability")
+	ErrRoleDefault    = errors.New("default roles cannot be assigned or deleted")
+)
+
+// Role is a named set of capabilities. Default roles have a Privilege and are held by everyone at or above it,
+// other roles are assigned to individual people.
+type Role struct {
+	RoleID       int32
+	Name         string
+	Description  string
+	Capabilities []permission.Capability
+	Privilege    *permission.Privilege
+	CreatedOn    time.Time
+	UpdatedOn    time.Time
+}
+
+func (r Role) IsDefault() bool {
+	return r.Privilege != nil
+}
+
+func (r Role) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return ErrRoleInvalid
+	}
+
+	for _, capability := range r.Capabilities {
+		if !capability.Valid() {
+			return fmt.Errorf("%w: %s", ErrRoleCapability, capability)
+		}
+	}
+
+	return nil
+}
+
+// Roles holds the configured roles and their assignments in memory, as they are checked on every request.
+type Roles struct {
+	repository Repository
+
+	mu       sync.RWMutex
+	roles    []Role
+	assigned map[steamid.SteamID][]int32
+}
+
+func NewRoles(repository Repository) *Roles {
+	return &Roles{repository: repository, assigned: map[steamid.SteamID][]int32{}}
+}
+
+// Load reads the roles and their assignments from the database, replacing the cached state.
+func (r *Roles) Load(ctx context.Context) error {
+	roles, errRoles := r.repository.Roles(ctx)
+	if errRoles != nil {
+		return errRoles
+	}
+
+	assignments, errAssigned := r.repository.PersonRoles(ctx)
+	if errAssigned != nil {
+		return errAssigned
+	}
+
+	r.mu.Lock()
+	r.roles = roles
+	r.assigned = assignments
+	r.mu.Unlock()
+
+	return nil
+}
+
+// Capabilities implements rpc.CapabilityResolver. When no default roles are loaded, the capabilities of the
+// privilege are used so access is never lost because of a missing table or failed load.
+func (r *Roles) Capabilities(steamID steamid.SteamID, privilege permission.Privilege) []permission.Capability {
+	if privilege <= permission.Banned {
+		// Assigned roles are suspended while banned.
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var (
+		capabilities []permission.Capability
+		hasDefaults  bool
+	)
+
+	assigned := r.assigned[steamID]
+
+	for _, role := range r.roles {
+		if role.IsDefault() {
+			hasDefaults = true
+		}
+
+		if (role.IsDefault() && privilege >= *role.Privilege) || slices.Contains(assigned, role.RoleID) {
+			capabilities = append(capabilities, role.Capabilities...)
+		}
+	}
+
+	if !hasDefaults {
+		capabilities = append(capabilities, permission.DefaultCapabilities(privilege)...)
+	}
+
+	slices.Sort(capabilities)
+
+	return slices.Compact(capabilities)
+}
+
+func (r *Roles) Roles() []Role {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.roles)
+}
+
+// PersonRoles returns the roles assigned to the person, not including default roles.
+func (r *Roles) PersonRoles(steamID steamid.SteamID) []Role {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var roles []Role
+
+	for _, role := range r.roles {
+		if slices.Contains(r.assigned[steamID], role.RoleID) {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+// Save creates a new role when RoleID is 0, otherwise updates the existing role. The privilege of default
+// roles cannot be changed, only their capabilities.
+func (r *Roles) Save(ctx context.Context, role Role) (Role, error) {
+	role.Name = strings.TrimSpace(role.Name)
+
+	slices.Sort(role.Capabilities)
+	role.Capabilities = slices.Compact(role.Capabilities)
+
+	if errValid := role.Validate(); errValid != nil {
+		return role, errValid
+	}
+
+	role.Privilege = nil
+	role.UpdatedOn = time.Now()
+
+	if role.RoleID == 0 {
+		role.CreatedOn = role.UpdatedOn
+		if errSave := r.repository.SaveRole(ctx, &role); errSave != nil {
+			return role, errSave
+		}
+	} else if errSave := r.repository.UpdateRole(ctx, &role); errSave != nil {
+		return role, errSave
+	}
+
+	slog.Info("Saved role", slog.Int("role_id", int(role.RoleID)), slog.String("name", role.Name))
+
+	return role, r.Load(ctx)
+}
+
+func (r *Roles) Delete(ctx context.Context, roleID int32) error {
+	role, errRole := r.role(roleID)
+	if errRole != nil {
+		return errRole
+	}
+
+	if role.IsDefault() {
+		return ErrRoleDefault
+	}
+
+	if errDelete := r.repository.DeleteRole(ctx, roleID); errDelete != nil {
+		return errDelete
+	}
+
+	slog.Info("Deleted role", slog.Int("role_id", int(roleID)), slog.String("name", role.Name))
+
+	return r.Load(ctx)
+}
+
+// SetPersonRoles replaces the roles assigned to the person.
+func (r *Roles) SetPersonRoles(ctx context.Context, steamID steamid.SteamID, roleIDs []int32) error {
+	slices.Sort(roleIDs)
+	roleIDs = slices.Compact(roleIDs)
+
+	for _, roleID := range roleIDs {
+		role, errRole := r.role(roleID)
+		if errRole != nil {
+			return errRole
+		}
+
+		if role.IsDefault() {
+			return ErrRoleDefault
+		}
+	}
+
+	if errSet := r.repository.SetPersonRoles(ctx, steamID, roleIDs); errSet != nil {
+		return errSet
+	}
+
+	return r.Load(ctx)
+}
+
+func (r *Roles) role(roleID int32) (Role, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, role := range r.roles {
+		if role.RoleID == roleID {
+			return role, nil
+		}
+	}
+
+	return Role{}, ErrRoleInvalid
+}
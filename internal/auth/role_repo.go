@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+func fromCapabilities(capabilities []permission.Capability) []string {
+	values := make([]string, len(capabilities))
+	for idx, capability := range capabilities {
+		values[idx] = string(capability)
+	}
+
+	return values
+}
+
+func (r Repository) Roles(ctx context.Context) ([]Role, error) {
+	rows, errRows := r.QueryBuilder(ctx, r.Builder().
+		Select("role_id", "name", "description", "capabilities", "privilege", "created_on", "updated_on").
+		From("role").
+		OrderBy("privilege NULLS LAST", "name"))
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	roles := []Role{}
+
+	for rows.Next() {
+		var (
+			role         Role
+			capabilities []string
+		)
+
+		if errScan := rows.Scan(&role.RoleID, &role.Name, &role.Description, &capabilities, &role.Privilege,
+			&role.CreatedOn, &role.UpdatedOn); errScan != nil {
+			return nil, database.Err(errScan)
+		}
+
+		for _, capability := range capabilities {
+			role.Capabilities = append(role.Capabilities, permission.Capability(capability))
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+// PersonRoles returns the role ids assigned to each person.
+func (r Repository) PersonRoles(ctx context.Context) (map[steamid.SteamID][]int32, error) {
+	rows, errRows := r.QueryBuilder(ctx, r.Builder().
+		Select("steam_id", "role_id").
+		From("person_role"))
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	assigned := map[steamid.SteamID][]int32{}
+
+	for rows.Next() {
+		var (
+			steamID steamid.SteamID
+			roleID  int32
+		)
+
+		if errScan := rows.Scan(&steamID, &roleID); errScan != nil {
+			return nil, database.Err(errScan)
+		}
+
+		assigned[steamID] = append(assigned[steamID], roleID)
+	}
+
+	return assigned, nil
+}
+
+func (r Repository) SaveRole(ctx context.Context, role *Role) error {
+	const query = `
+		INSERT INTO role (name, description, capabilities, created_on, updated_on)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING role_id`
+
+	return database.Err(r.QueryRow(ctx, query, role.Name, role.Description, fromCapabilities(role.Capabilities),
+		role.CreatedOn, role.UpdatedOn).Scan(&role.RoleID))
+}
+
+// UpdateRole updates the role, leaving the privilege of default roles untouched.
+func (r Repository) UpdateRole(ctx context.Context, role *Role) error {
+	const query = `
+		UPDATE role SET name = $2, description = $3, capabilities = $4, updated_on = $5
+		WHERE role_id = $1
+		RETURNING privilege, created_on`
+
+	return database.Err(r.QueryRow(ctx, query, role.RoleID, role.Name, role.Description,
+		fromCapabilities(role.Capabilities), role.UpdatedOn).Scan(&role.Privilege, &role.CreatedOn))
+}
+
+func (r Repository) DeleteRole(ctx context.Context, roleID int32) error {
+	return database.Err(r.ExecDeleteBuilder(ctx, r.Builder().
+		Delete("role").
+		Where(sq.Eq{"role_id": roleID})))
+}
+
+// SetPersonRoles replaces the roles assigned to the person.
+func (r Repository) SetPersonRoles(ctx context.Context, steamID steamid.SteamID, roleIDs []int32) error {
+	return database.Err(r.WrapTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM person_role WHERE steam_id = $1`, steamID.Int64()); err != nil {
+			return err
+		}
+
+		for _, roleID := range roleIDs {
+			if _, err := tx.Exec(ctx, `INSERT INTO person_role (steam_id, role_id, created_on) VALUES ($1, $2, $3)`,
+				steamID.Int64(), roleID, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+}
@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	v1 "github.com/leighmacdonald/gbans/internal/auth/v1"
+	"github.com/leighmacdonald/gbans/internal/auth/v1/authv1connect"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/httphelper"
+	personv1 "github.com/leighmacdonald/gbans/internal/person/v1"
+	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RoleService struct {
+	roles *Roles
+}
+
+func NewRoleService(roles *Roles, authMiddleware *rpc.Middleware, option ...connect.HandlerOption) rpc.Service {
+	pattern, handler := authv1connect.NewRoleServiceHandler(RoleService{roles: roles}, option...)
+
+	// Managing roles stays on the privilege ladder so roles cannot be used to grant themselves further access.
+	authMiddleware.UserRoute(authv1connect.RoleServiceCapabilitiesProcedure, rpc.WithMinPermissions(permission.User))
+	authMiddleware.UserRoute(authv1connect.RoleServiceRolesProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(authv1connect.RoleServiceRoleSaveProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(authv1connect.RoleServiceRoleDeleteProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(authv1connect.RoleServicePersonRolesProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(authv1connect.RoleServicePersonRolesSetProcedure, rpc.WithMinPermissions(permission.Admin))
+
+	return rpc.Service{Pattern: pattern, Handler: handler}
+}
+
+func (s RoleService) Capabilities(ctx context.Context, _ *emptypb.Empty) (*v1.CapabilitiesResponse, error) {
+	user := rpc.UserInfoFromCtx(ctx)
+	capabilities := permission.Capabilities()
+
+	resp := v1.CapabilitiesResponse{
+		Capabilities: make([]*v1.Capability, len(capabilities)),
+		Granted:      make([]string, len(user.Capabilities)),
+	}
+
+	for idx, capability := range capabilities {
+		resp.Capabilities[idx] = &v1.Capability{
+			Name:             new(string(capability)),
+			DefaultPrivilege: new(personv1.Privilege(capability.DefaultPrivilege())),
+		}
+	}
+
+	for idx, capability := range user.Capabilities {
+		resp.Granted[idx] = string(capability)
+	}
+
+	return &resp, nil
+}
+
+func (s RoleService) Roles(_ context.Context, _ *emptypb.Empty) (*v1.RolesResponse, error) {
+	return &v1.RolesResponse{Roles: toRoles(s.roles.Roles())}, nil
+}
+
+func (s RoleService) RoleSave(ctx context.Context, req *v1.RoleSaveRequest) (*v1.RoleSaveResponse, error) {
+	role := req.GetRole()
+	capabilities := make([]permission.Capability, len(role.GetCapabilities()))
+
+	for idx, capability := range role.GetCapabilities() {
+		capabilities[idx] = permission.Capability(capability)
+	}
+
+	saved, errSave := s.roles.Save(ctx, Role{
+		RoleID:       role.GetRoleId(),
+		Name:         role.GetName(),
+		Description:  role.GetDescription(),
+		Capabilities: capabilities,
+	})
+	if errSave != nil {
+		switch {
+		case errors.Is(errSave, ErrRoleInvalid), errors.Is(errSave, ErrRoleCapability):
+			return nil, connect.NewError(connect.CodeInvalidArgument, errSave)
+		case errors.Is(errSave, database.ErrDuplicate):
+			return nil, connect.NewError(connect.CodeAlreadyExists, rpc.ErrExists)
+		case errors.Is(errSave, database.ErrNoResult):
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &v1.RoleSaveResponse{Role: toRole(saved)}, nil
+}
+
+func (s RoleService) RoleDelete(ctx context.Context, req *v1.RoleDeleteRequest) (*emptypb.Empty, error) {
+	if errDelete := s.roles.Delete(ctx, req.GetRoleId()); errDelete != nil {
+		switch {
+		case errors.Is(errDelete, ErrRoleInvalid):
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		case errors.Is(errDelete, ErrRoleDefault):
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrRoleDefault)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s RoleService) PersonRoles(_ context.Context, req *v1.PersonRolesRequest) (*v1.PersonRolesResponse, error) {
+	steamID := steamid.New(req.GetSteamId())
+	if !steamID.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+	}
+
+	return &v1.PersonRolesResponse{Roles: toRoles(s.roles.PersonRoles(steamID))}, nil
+}
+
+func (s RoleService) PersonRolesSet(ctx context.Context, req *v1.PersonRolesSetRequest) (*emptypb.Empty, error) {
+	steamID := steamid.New(req.GetSteamId())
+	if !steamID.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+	}
+
+	if errSet := s.roles.SetPersonRoles(ctx, steamID, req.GetRoleIds()); errSet != nil {
+		switch {
+		case errors.Is(errSet, ErrRoleInvalid), errors.Is(errSet, ErrRoleDefault):
+			return nil, connect.NewError(connect.CodeInvalidArgument, errSet)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toRoles(roles []Role) []*v1.Role {
+	out := make([]*v1.Role, len(roles))
+	for idx, role := range roles {
+		out[idx] = toRole(role)
+	}
+
+	return out
+}
+
+func toRole(role Role) *v1.Role {
+	out := &v1.Role{
+		RoleId:       &role.RoleID,
+		Name:         &role.Name,
+		Description:  &role.Description,
+		Capabilities: make([]string, len(role.Capabilities)),
+		CreatedOn:    timestamppb.New(role.CreatedOn),
+		UpdatedOn:    timestamppb.New(role.UpdatedOn),
+	}
+
+	for idx, capability := range role.Capabilities {
+		out.Capabilities[idx] = string(capability)
+	}
+
+	if role.Privilege != nil {
+		out.Privilege = new(personv1.Privilege(*role.Privilege))
+	}
+
+	return out
+}
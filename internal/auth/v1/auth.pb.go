@@ -7,10 +7,14 @@
 package authv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/leighmacdonald/gbans/internal/person/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
+	sync "sync"
 	unsafe "unsafe"
 )
 
@@ -21,26 +25,798 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type APIToken struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TokenId *int64                 `protobuf:"varint,1,opt,name=token_id,json=tokenId" json:"token_id,omitempty"`
+	SteamId *int64                 `protobuf:"varint,2,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	Name    *string                `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// Prefix is the start of the secret, used to identify the token.
+	Prefix *string `protobuf:"bytes,4,opt,name=prefix" json:"prefix,omitempty"`
+	// Privilege is the highest privilege the token acts with, it never exceeds the privilege of the owner.
+	Privilege *v1.Privilege `protobuf:"varint,5,opt,name=privilege,enum=person.v1.Privilege" json:"privilege,omitempty"`
+	// Procedures limits the token to these procedures, eg: /ban.v1.BanService/Query, or whole services when
+	// ending with a slash, eg: /ban.v1.BanService/. Empty allows every procedure permitted by the privilege.
+	Procedures    []string               `protobuf:"bytes,6,rep,name=procedures" json:"procedures,omitempty"`
+	ExpiresOn     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_on,json=expiresOn" json:"expires_on,omitempty"`
+	LastUsedOn    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_on,json=lastUsedOn" json:"last_used_on,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
+	mi := &file_auth_v1_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIToken) ProtoMessage() {}
+
+func (x *APIToken) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *APIToken) GetTokenId() int64 {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return 0
+}
+
+func (x *APIToken) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+func (x *APIToken) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *APIToken) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *APIToken) GetPrivilege() v1.Privilege {
+	if x != nil && x.Privilege != nil {
+		return *x.Privilege
+	}
+	return v1.Privilege(0)
+}
+
+func (x *APIToken) GetProcedures() []string {
+	if x != nil {
+		return x.Procedures
+	}
+	return nil
+}
+
+func (x *APIToken) GetExpiresOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return nil
+}
+
+func (x *APIToken) GetLastUsedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedOn
+	}
+	return nil
+}
+
+func (x *APIToken) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+type APITokensRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Admins may list the tokens of another user.
+	SteamId       *int64 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APITokensRequest) Reset() {
+	*x = APITokensRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APITokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokensRequest) ProtoMessage() {}
+
+func (x *APITokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokensRequest.ProtoReflect.Descriptor instead.
+func (*APITokensRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *APITokensRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+type APITokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*APIToken            `protobuf:"bytes,1,rep,name=tokens" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APITokensResponse) Reset() {
+	*x = APITokensResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APITokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokensResponse) ProtoMessage() {}
+
+func (x *APITokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokensResponse.ProtoReflect.Descriptor instead.
+func (*APITokensResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *APITokensResponse) GetTokens() []*APIToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type APITokenCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Privilege     *v1.Privilege          `protobuf:"varint,2,opt,name=privilege,enum=person.v1.Privilege" json:"privilege,omitempty"`
+	Procedures    []string               `protobuf:"bytes,3,rep,name=procedures" json:"procedures,omitempty"`
+	ExpiresOn     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_on,json=expiresOn" json:"expires_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APITokenCreateRequest) Reset() {
+	*x = APITokenCreateRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APITokenCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenCreateRequest) ProtoMessage() {}
+
+func (x *APITokenCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenCreateRequest.ProtoReflect.Descriptor instead.
+func (*APITokenCreateRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *APITokenCreateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *APITokenCreateRequest) GetPrivilege() v1.Privilege {
+	if x != nil && x.Privilege != nil {
+		return *x.Privilege
+	}
+	return v1.Privilege(0)
+}
+
+func (x *APITokenCreateRequest) GetProcedures() []string {
+	if x != nil {
+		return x.Procedures
+	}
+	return nil
+}
+
+func (x *APITokenCreateRequest) GetExpiresOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return nil
+}
+
+type APITokenCreateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token *APIToken              `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	// Secret is the bearer token to authenticate with.
+	Secret        *string `protobuf:"bytes,2,opt,name=secret" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APITokenCreateResponse) Reset() {
+	*x = APITokenCreateResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APITokenCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenCreateResponse) ProtoMessage() {}
+
+func (x *APITokenCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenCreateResponse.ProtoReflect.Descriptor instead.
+func (*APITokenCreateResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *APITokenCreateResponse) GetToken() *APIToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *APITokenCreateResponse) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+type APITokenRevokeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenId       *int64                 `protobuf:"varint,1,opt,name=token_id,json=tokenId" json:"token_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APITokenRevokeRequest) Reset() {
+	*x = APITokenRevokeRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APITokenRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APITokenRevokeRequest) ProtoMessage() {}
+
+func (x *APITokenRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APITokenRevokeRequest.ProtoReflect.Descriptor instead.
+func (*APITokenRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *APITokenRevokeRequest) GetTokenId() int64 {
+	if x != nil && x.TokenId != nil {
+		return *x.TokenId
+	}
+	return 0
+}
+
+type Session struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SessionId   *int64                 `protobuf:"varint,1,opt,name=session_id,json=sessionId" json:"session_id,omitempty"`
+	IpAddr      *string                `protobuf:"bytes,2,opt,name=ip_addr,json=ipAddr" json:"ip_addr,omitempty"`
+	UserAgent   *string                `protobuf:"bytes,3,opt,name=user_agent,json=userAgent" json:"user_agent,omitempty"`
+	CountryCode *string                `protobuf:"bytes,4,opt,name=country_code,json=countryCode" json:"country_code,omitempty"`
+	CountryName *string                `protobuf:"bytes,5,opt,name=country_name,json=countryName" json:"country_name,omitempty"`
+	CityName    *string                `protobuf:"bytes,6,opt,name=city_name,json=cityName" json:"city_name,omitempty"`
+	AsName      *string                `protobuf:"bytes,7,opt,name=as_name,json=asName" json:"as_name,omitempty"`
+	CreatedOn   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	LastSeenOn  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_seen_on,json=lastSeenOn" json:"last_seen_on,omitempty"`
+	// Current is set for the session making the request.
+	Current       *bool `protobuf:"varint,10,opt,name=current" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Session) GetSessionId() int64 {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return 0
+}
+
+func (x *Session) GetIpAddr() string {
+	if x != nil && x.IpAddr != nil {
+		return *x.IpAddr
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil && x.UserAgent != nil {
+		return *x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetCountryCode() string {
+	if x != nil && x.CountryCode != nil {
+		return *x.CountryCode
+	}
+	return ""
+}
+
+func (x *Session) GetCountryName() string {
+	if x != nil && x.CountryName != nil {
+		return *x.CountryName
+	}
+	return ""
+}
+
+func (x *Session) GetCityName() string {
+	if x != nil && x.CityName != nil {
+		return *x.CityName
+	}
+	return ""
+}
+
+func (x *Session) GetAsName() string {
+	if x != nil && x.AsName != nil {
+		return *x.AsName
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *Session) GetLastSeenOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenOn
+	}
+	return nil
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil && x.Current != nil {
+		return *x.Current
+	}
+	return false
+}
+
+type SessionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Admins may list the sessions of another user.
+	SteamId       *int64 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionsRequest) Reset() {
+	*x = SessionsRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsRequest) ProtoMessage() {}
+
+func (x *SessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsRequest.ProtoReflect.Descriptor instead.
+func (*SessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SessionsRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+type SessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionsResponse) Reset() {
+	*x = SessionsResponse{}
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionsResponse) ProtoMessage() {}
+
+func (x *SessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionsResponse.ProtoReflect.Descriptor instead.
+func (*SessionsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type SessionRevokeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *int64                 `protobuf:"varint,1,opt,name=session_id,json=sessionId" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionRevokeRequest) Reset() {
+	*x = SessionRevokeRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRevokeRequest) ProtoMessage() {}
+
+func (x *SessionRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRevokeRequest.ProtoReflect.Descriptor instead.
+func (*SessionRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SessionRevokeRequest) GetSessionId() int64 {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return 0
+}
+
+type ForceLogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SteamId       *int64                 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutRequest) Reset() {
+	*x = ForceLogoutRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutRequest) ProtoMessage() {}
+
+func (x *ForceLogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutRequest.ProtoReflect.Descriptor instead.
+func (*ForceLogoutRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ForceLogoutRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
 var File_auth_v1_auth_proto protoreflect.FileDescriptor
 
 const file_auth_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x12auth/v1/auth.proto\x12\aauth.v1\x1a\x1bgoogle/protobuf/empty.proto2I\n" +
+	"\x12auth/v1/auth.proto\x12\aauth.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19person/v1/privilege.proto\"\xb0\x03\n" +
+	"\bAPIToken\x12#\n" +
+	"\btoken_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\atokenId\x12#\n" +
+	"\bsteam_id\x18\x02 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\asteamId\x12\x1a\n" +
+	"\x04name\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12\x1e\n" +
+	"\x06prefix\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06prefix\x12:\n" +
+	"\tprivilege\x18\x05 \x01(\x0e2\x14.person.v1.PrivilegeB\x06\xbaH\x03\xc8\x01\x01R\tprivilege\x12\x1e\n" +
+	"\n" +
+	"procedures\x18\x06 \x03(\tR\n" +
+	"procedures\x12A\n" +
+	"\n" +
+	"expires_on\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\texpiresOn\x12<\n" +
+	"\flast_used_on\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedOn\x12A\n" +
+	"\n" +
+	"created_on\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn\"1\n" +
+	"\x10APITokensRequest\x12\x1d\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\x020\x01R\asteamId\">\n" +
+	"\x11APITokensResponse\x12)\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x11.auth.v1.APITokenR\x06tokens\"\xf6\x01\n" +
+	"\x15APITokenCreateRequest\x12 \n" +
+	"\x04name\x18\x01 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x18@R\x04name\x12?\n" +
+	"\tprivilege\x18\x02 \x01(\x0e2\x14.person.v1.PrivilegeB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\tprivilege\x122\n" +
+	"\n" +
+	"procedures\x18\x03 \x03(\tB\x12\xbaH\x0f\x92\x01\f\x10d\"\br\x06\x18\x80\x02:\x01/R\n" +
+	"procedures\x12F\n" +
+	"\n" +
+	"expires_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\v\xbaH\b\xc8\x01\x01\xb2\x01\x02@\x01R\texpiresOn\"i\n" +
+	"\x16APITokenCreateResponse\x12/\n" +
+	"\x05token\x18\x01 \x01(\v2\x11.auth.v1.APITokenB\x06\xbaH\x03\xc8\x01\x01R\x05token\x12\x1e\n" +
+	"\x06secret\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06secret\"@\n" +
+	"\x15APITokenRevokeRequest\x12'\n" +
+	"\btoken_id\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\atokenId\"\x91\x03\n" +
+	"\aSession\x12'\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\tsessionId\x12\x1f\n" +
+	"\aip_addr\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06ipAddr\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12!\n" +
+	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\x12!\n" +
+	"\fcountry_name\x18\x05 \x01(\tR\vcountryName\x12\x1b\n" +
+	"\tcity_name\x18\x06 \x01(\tR\bcityName\x12\x17\n" +
+	"\aas_name\x18\a \x01(\tR\x06asName\x12A\n" +
+	"\n" +
+	"created_on\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn\x12D\n" +
+	"\flast_seen_on\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\n" +
+	"lastSeenOn\x12\x18\n" +
+	"\acurrent\x18\n" +
+	" \x01(\bR\acurrent\"0\n" +
+	"\x0fSessionsRequest\x12\x1d\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\x020\x01R\asteamId\"@\n" +
+	"\x10SessionsResponse\x12,\n" +
+	"\bsessions\x18\x01 \x03(\v2\x10.auth.v1.SessionR\bsessions\"C\n" +
+	"\x14SessionRevokeRequest\x12+\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\tsessionId\"=\n" +
+	"\x12ForceLogoutRequest\x12'\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\asteamId2\x83\x04\n" +
 	"\vAuthService\x12:\n" +
-	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00B\x8e\x01\n" +
+	"\x06Logout\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12D\n" +
+	"\tAPITokens\x12\x19.auth.v1.APITokensRequest\x1a\x1a.auth.v1.APITokensResponse\"\x00\x12S\n" +
+	"\x0eAPITokenCreate\x12\x1e.auth.v1.APITokenCreateRequest\x1a\x1f.auth.v1.APITokenCreateResponse\"\x00\x12J\n" +
+	"\x0eAPITokenRevoke\x12\x1e.auth.v1.APITokenRevokeRequest\x1a\x16.google.protobuf.Empty\"\x00\x12A\n" +
+	"\bSessions\x12\x18.auth.v1.SessionsRequest\x1a\x19.auth.v1.SessionsResponse\"\x00\x12H\n" +
+	"\rSessionRevoke\x12\x1d.auth.v1.SessionRevokeRequest\x1a\x16.google.protobuf.Empty\"\x00\x12D\n" +
+	"\vForceLogout\x12\x1b.auth.v1.ForceLogoutRequest\x1a\x16.google.protobuf.Empty\"\x00B\x8e\x01\n" +
 	"\vcom.auth.v1B\tAuthProtoP\x01Z7github.com/leighmacdonald/gbans/internal/auth/v1;authv1\xa2\x02\x03AXX\xaa\x02\aAuth.V1\xca\x02\aAuth\\V1\xe2\x02\x13Auth\\V1\\GPBMetadata\xea\x02\bAuth::V1b\beditionsp\xe8\a"
 
+var (
+	file_auth_v1_auth_proto_rawDescOnce sync.Once
+	file_auth_v1_auth_proto_rawDescData []byte
+)
+
+func file_auth_v1_auth_proto_rawDescGZIP() []byte {
+	file_auth_v1_auth_proto_rawDescOnce.Do(func() {
+		file_auth_v1_auth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_auth_proto_rawDesc), len(file_auth_v1_auth_proto_rawDesc)))
+	})
+	return file_auth_v1_auth_proto_rawDescData
+}
+
+var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_auth_v1_auth_proto_goTypes = []any{
-	(*emptypb.Empty)(nil), // 0: google.protobuf.Empty
+	(*APIToken)(nil),               // 0: auth.v1.APIToken
+	(*APITokensRequest)(nil),       // 1: auth.v1.APITokensRequest
+	(*APITokensResponse)(nil),      // 2: auth.v1.APITokensResponse
+	(*APITokenCreateRequest)(nil),  // 3: auth.v1.APITokenCreateRequest
+	(*APITokenCreateResponse)(nil), // 4: auth.v1.APITokenCreateResponse
+	(*APITokenRevokeRequest)(nil),  // 5: auth.v1.APITokenRevokeRequest
+	(*Session)(nil),                // 6: auth.v1.Session
+	(*SessionsRequest)(nil),        // 7: auth.v1.SessionsRequest
+	(*SessionsResponse)(nil),       // 8: auth.v1.SessionsResponse
+	(*SessionRevokeRequest)(nil),   // 9: auth.v1.SessionRevokeRequest
+	(*ForceLogoutRequest)(nil),     // 10: auth.v1.ForceLogoutRequest
+	(v1.Privilege)(0),              // 11: person.v1.Privilege
+	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 13: google.protobuf.Empty
 }
 var file_auth_v1_auth_proto_depIdxs = []int32{
-	0, // 0: auth.v1.AuthService.Logout:input_type -> google.protobuf.Empty
-	0, // 1: auth.v1.AuthService.Logout:output_type -> google.protobuf.Empty
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	11, // 0: auth.v1.APIToken.privilege:type_name -> person.v1.Privilege
+	12, // 1: auth.v1.APIToken.expires_on:type_name -> google.protobuf.Timestamp
+	12, // 2: auth.v1.APIToken.last_used_on:type_name -> google.protobuf.Timestamp
+	12, // 3: auth.v1.APIToken.created_on:type_name -> google.protobuf.Timestamp
+	0,  // 4: auth.v1.APITokensResponse.tokens:type_name -> auth.v1.APIToken
+	11, // 5: auth.v1.APITokenCreateRequest.privilege:type_name -> person.v1.Privilege
+	12, // 6: auth.v1.APITokenCreateRequest.expires_on:type_name -> google.protobuf.Timestamp
+	0,  // 7: auth.v1.APITokenCreateResponse.token:type_name -> auth.v1.APIToken
+	12, // 8: auth.v1.Session.created_on:type_name -> google.protobuf.Timestamp
+	12, // 9: auth.v1.Session.last_seen_on:type_name -> google.protobuf.Timestamp
+	6,  // 10: auth.v1.SessionsResponse.sessions:type_name -> auth.v1.Session
+	13, // 11: auth.v1.AuthService.Logout:input_type -> google.protobuf.Empty
+	1,  // 12: auth.v1.AuthService.APITokens:input_type -> auth.v1.APITokensRequest
+	3,  // 13: auth.v1.AuthService.APITokenCreate:input_type -> auth.v1.APITokenCreateRequest
+	5,  // 14: auth.v1.AuthService.APITokenRevoke:input_type -> auth.v1.APITokenRevokeRequest
+	7,  // 15: auth.v1.AuthService.Sessions:input_type -> auth.v1.SessionsRequest
+	9,  // 16: auth.v1.AuthService.SessionRevoke:input_type -> auth.v1.SessionRevokeRequest
+	10, // 17: auth.v1.AuthService.ForceLogout:input_type -> auth.v1.ForceLogoutRequest
+	13, // 18: auth.v1.AuthService.Logout:output_type -> google.protobuf.Empty
+	2,  // 19: auth.v1.AuthService.APITokens:output_type -> auth.v1.APITokensResponse
+	4,  // 20: auth.v1.AuthService.APITokenCreate:output_type -> auth.v1.APITokenCreateResponse
+	13, // 21: auth.v1.AuthService.APITokenRevoke:output_type -> google.protobuf.Empty
+	8,  // 22: auth.v1.AuthService.Sessions:output_type -> auth.v1.SessionsResponse
+	13, // 23: auth.v1.AuthService.SessionRevoke:output_type -> google.protobuf.Empty
+	13, // 24: auth.v1.AuthService.ForceLogout:output_type -> google.protobuf.Empty
+	18, // [18:25] is the sub-list for method output_type
+	11, // [11:18] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_auth_v1_auth_proto_init() }
@@ -54,12 +830,13 @@ func file_auth_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_auth_proto_rawDesc), len(file_auth_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   0,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_auth_v1_auth_proto_goTypes,
 		DependencyIndexes: file_auth_v1_auth_proto_depIdxs,
+		MessageInfos:      file_auth_v1_auth_proto_msgTypes,
 	}.Build()
 	File_auth_v1_auth_proto = out.File
 	file_auth_v1_auth_proto_goTypes = nil
@@ -36,11 +36,36 @@ const (
 const (
 	// AuthServiceLogoutProcedure is the fully-qualified name of the AuthService's Logout RPC.
 	AuthServiceLogoutProcedure = "/auth.v1.AuthService/Logout"
+	// AuthServiceAPITokensProcedure is the fully-qualified name of the AuthService's APITokens RPC.
+	AuthServiceAPITokensProcedure = "/auth.v1.AuthService/APITokens"
+	// AuthServiceAPITokenCreateProcedure is the fully-qualified name of the AuthService's
+	// APITokenCreate RPC.
+	AuthServiceAPITokenCreateProcedure = "/auth.v1.AuthService/APITokenCreate"
+	// AuthServiceAPITokenRevokeProcedure is the fully-qualified name of the AuthService's
+	// APITokenRevoke RPC.
+	AuthServiceAPITokenRevokeProcedure = "/auth.v1.AuthService/APITokenRevoke"
+	// AuthServiceSessionsProcedure is the fully-qualified name of the AuthService's Sessions RPC.
+	AuthServiceSessionsProcedure = "/auth.v1.AuthService/Sessions"
+	// AuthServiceSessionRevokeProcedure is the fully-qualified name of the AuthService's SessionRevoke
+	// RPC.
+	AuthServiceSessionRevokeProcedure = "/auth.v1.AuthService/SessionRevoke"
+	// AuthServiceForceLogoutProcedure is the fully-qualified name of the AuthService's ForceLogout RPC.
+	AuthServiceForceLogoutProcedure = "/auth.v1.AuthService/ForceLogout"
 )
 
 // AuthServiceClient is a client for the auth.v1.AuthService service.
 type AuthServiceClient interface {
 	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// APITokens lists the personal api tokens of the current user, or of any user for admins.
+	APITokens(context.Context, *v1.APITokensRequest) (*v1.APITokensResponse, error)
+	// APITokenCreate mints a new token. The secret is only ever returned in this response.
+	APITokenCreate(context.Context, *v1.APITokenCreateRequest) (*v1.APITokenCreateResponse, error)
+	APITokenRevoke(context.Context, *v1.APITokenRevokeRequest) (*emptypb.Empty, error)
+	// Sessions lists the active browser logins of the current user, or of any user for admins.
+	Sessions(context.Context, *v1.SessionsRequest) (*v1.SessionsResponse, error)
+	SessionRevoke(context.Context, *v1.SessionRevokeRequest) (*emptypb.Empty, error)
+	// ForceLogout ends every session and revokes every api token of a user.
+	ForceLogout(context.Context, *v1.ForceLogoutRequest) (*emptypb.Empty, error)
 }
 
 // NewAuthServiceClient constructs a client for the auth.v1.AuthService service. By default, it uses
@@ -60,12 +85,54 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("Logout")),
 			connect.WithClientOptions(opts...),
 		),
+		aPITokens: connect.NewClient[v1.APITokensRequest, v1.APITokensResponse](
+			httpClient,
+			baseURL+AuthServiceAPITokensProcedure,
+			connect.WithSchema(authServiceMethods.ByName("APITokens")),
+			connect.WithClientOptions(opts...),
+		),
+		aPITokenCreate: connect.NewClient[v1.APITokenCreateRequest, v1.APITokenCreateResponse](
+			httpClient,
+			baseURL+AuthServiceAPITokenCreateProcedure,
+			connect.WithSchema(authServiceMethods.ByName("APITokenCreate")),
+			connect.WithClientOptions(opts...),
+		),
+		aPITokenRevoke: connect.NewClient[v1.APITokenRevokeRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AuthServiceAPITokenRevokeProcedure,
+			connect.WithSchema(authServiceMethods.ByName("APITokenRevoke")),
+			connect.WithClientOptions(opts...),
+		),
+		sessions: connect.NewClient[v1.SessionsRequest, v1.SessionsResponse](
+			httpClient,
+			baseURL+AuthServiceSessionsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("Sessions")),
+			connect.WithClientOptions(opts...),
+		),
+		sessionRevoke: connect.NewClient[v1.SessionRevokeRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AuthServiceSessionRevokeProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SessionRevoke")),
+			connect.WithClientOptions(opts...),
+		),
+		forceLogout: connect.NewClient[v1.ForceLogoutRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AuthServiceForceLogoutProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ForceLogout")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	logout *connect.Client[emptypb.Empty, emptypb.Empty]
+	logout         *connect.Client[emptypb.Empty, emptypb.Empty]
+	aPITokens      *connect.Client[v1.APITokensRequest, v1.APITokensResponse]
+	aPITokenCreate *connect.Client[v1.APITokenCreateRequest, v1.APITokenCreateResponse]
+	aPITokenRevoke *connect.Client[v1.APITokenRevokeRequest, emptypb.Empty]
+	sessions       *connect.Client[v1.SessionsRequest, v1.SessionsResponse]
+	sessionRevoke  *connect.Client[v1.SessionRevokeRequest, emptypb.Empty]
+	forceLogout    *connect.Client[v1.ForceLogoutRequest, emptypb.Empty]
 }
 
 // Logout calls auth.v1.AuthService.Logout.
@@ -77,9 +144,73 @@ func (c *authServiceClient) Logout(ctx context.Context, req *emptypb.Empty) (*em
 	return nil, err
 }
 
+// APITokens calls auth.v1.AuthService.APITokens.
+func (c *authServiceClient) APITokens(ctx context.Context, req *v1.APITokensRequest) (*v1.APITokensResponse, error) {
+	response, err := c.aPITokens.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// APITokenCreate calls auth.v1.AuthService.APITokenCreate.
+func (c *authServiceClient) APITokenCreate(ctx context.Context, req *v1.APITokenCreateRequest) (*v1.APITokenCreateResponse, error) {
+	response, err := c.aPITokenCreate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// APITokenRevoke calls auth.v1.AuthService.APITokenRevoke.
+func (c *authServiceClient) APITokenRevoke(ctx context.Context, req *v1.APITokenRevokeRequest) (*emptypb.Empty, error) {
+	response, err := c.aPITokenRevoke.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Sessions calls auth.v1.AuthService.Sessions.
+func (c *authServiceClient) Sessions(ctx context.Context, req *v1.SessionsRequest) (*v1.SessionsResponse, error) {
+	response, err := c.sessions.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SessionRevoke calls auth.v1.AuthService.SessionRevoke.
+func (c *authServiceClient) SessionRevoke(ctx context.Context, req *v1.SessionRevokeRequest) (*emptypb.Empty, error) {
+	response, err := c.sessionRevoke.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ForceLogout calls auth.v1.AuthService.ForceLogout.
+func (c *authServiceClient) ForceLogout(ctx context.Context, req *v1.ForceLogoutRequest) (*emptypb.Empty, error) {
+	response, err := c.forceLogout.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // AuthServiceHandler is an implementation of the auth.v1.AuthService service.
 type AuthServiceHandler interface {
 	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// APITokens lists the personal api tokens of the current user, or of any user for admins.
+	APITokens(context.Context, *v1.APITokensRequest) (*v1.APITokensResponse, error)
+	// APITokenCreate mints a new token. The secret is only ever returned in this response.
+	APITokenCreate(context.Context, *v1.APITokenCreateRequest) (*v1.APITokenCreateResponse, error)
+	APITokenRevoke(context.Context, *v1.APITokenRevokeRequest) (*emptypb.Empty, error)
+	// Sessions lists the active browser logins of the current user, or of any user for admins.
+	Sessions(context.Context, *v1.SessionsRequest) (*v1.SessionsResponse, error)
+	SessionRevoke(context.Context, *v1.SessionRevokeRequest) (*emptypb.Empty, error)
+	// ForceLogout ends every session and revokes every api token of a user.
+	ForceLogout(context.Context, *v1.ForceLogoutRequest) (*emptypb.Empty, error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -95,10 +226,58 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("Logout")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceAPITokensHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceAPITokensProcedure,
+		svc.APITokens,
+		connect.WithSchema(authServiceMethods.ByName("APITokens")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceAPITokenCreateHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceAPITokenCreateProcedure,
+		svc.APITokenCreate,
+		connect.WithSchema(authServiceMethods.ByName("APITokenCreate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceAPITokenRevokeHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceAPITokenRevokeProcedure,
+		svc.APITokenRevoke,
+		connect.WithSchema(authServiceMethods.ByName("APITokenRevoke")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSessionsHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceSessionsProcedure,
+		svc.Sessions,
+		connect.WithSchema(authServiceMethods.ByName("Sessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSessionRevokeHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceSessionRevokeProcedure,
+		svc.SessionRevoke,
+		connect.WithSchema(authServiceMethods.ByName("SessionRevoke")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceForceLogoutHandler := connect.NewUnaryHandlerSimple(
+		AuthServiceForceLogoutProcedure,
+		svc.ForceLogout,
+		connect.WithSchema(authServiceMethods.ByName("ForceLogout")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/auth.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceLogoutProcedure:
 			authServiceLogoutHandler.ServeHTTP(w, r)
+		case AuthServiceAPITokensProcedure:
+			authServiceAPITokensHandler.ServeHTTP(w, r)
+		case AuthServiceAPITokenCreateProcedure:
+			authServiceAPITokenCreateHandler.ServeHTTP(w, r)
+		case AuthServiceAPITokenRevokeProcedure:
+			authServiceAPITokenRevokeHandler.ServeHTTP(w, r)
+		case AuthServiceSessionsProcedure:
+			authServiceSessionsHandler.ServeHTTP(w, r)
+		case AuthServiceSessionRevokeProcedure:
+			authServiceSessionRevokeHandler.ServeHTTP(w, r)
+		case AuthServiceForceLogoutProcedure:
+			authServiceForceLogoutHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -111,3 +290,27 @@ type UnimplementedAuthServiceHandler struct{}
 func (UnimplementedAuthServiceHandler) Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Logout is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) APITokens(context.Context, *v1.APITokensRequest) (*v1.APITokensResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.APITokens is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) APITokenCreate(context.Context, *v1.APITokenCreateRequest) (*v1.APITokenCreateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.APITokenCreate is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) APITokenRevoke(context.Context, *v1.APITokenRevokeRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.APITokenRevoke is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) Sessions(context.Context, *v1.SessionsRequest) (*v1.SessionsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.Sessions is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) SessionRevoke(context.Context, *v1.SessionRevokeRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.SessionRevoke is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ForceLogout(context.Context, *v1.ForceLogoutRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.AuthService.ForceLogout is not implemented"))
+}
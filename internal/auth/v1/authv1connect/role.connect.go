@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: auth/v1/role.proto
+
+package authv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/leighmacdonald/gbans/internal/auth/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RoleServiceName is the fully-qualified name of the RoleService service.
+	RoleServiceName = "auth.v1.RoleService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RoleServiceCapabilitiesProcedure is the fully-qualified name of the RoleService's Capabilities
+	// RPC.
+	RoleServiceCapabilitiesProcedure = "/auth.v1.RoleService/Capabilities"
+	// RoleServiceRolesProcedure is the fully-qualified name of the RoleService's Roles RPC.
+	RoleServiceRolesProcedure = "/auth.v1.RoleService/Roles"
+	// RoleServiceRoleSaveProcedure is the fully-qualified name of the RoleService's RoleSave RPC.
+	RoleServiceRoleSaveProcedure = "/auth.v1.RoleService/RoleSave"
+	// RoleServiceRoleDeleteProcedure is the fully-qualified name of the RoleService's RoleDelete RPC.
+	RoleServiceRoleDeleteProcedure = "/auth.v1.RoleService/RoleDelete"
+	// RoleServicePersonRolesProcedure is the fully-qualified name of the RoleService's PersonRoles RPC.
+	RoleServicePersonRolesProcedure = "/auth.v1.RoleService/PersonRoles"
+	// RoleServicePersonRolesSetProcedure is the fully-qualified name of the RoleService's
+	// PersonRolesSet RPC.
+	RoleServicePersonRolesSetProcedure = "/auth.v1.RoleService/PersonRolesSet"
+)
+
+// RoleServiceClient is a client for the auth.v1.RoleService service.
+type RoleServiceClient interface {
+	// Capabilities lists every known capability along with those granted to the current user.
+	Capabilities(context.Context, *emptypb.Empty) (*v1.CapabilitiesResponse, error)
+	Roles(context.Context, *emptypb.Empty) (*v1.RolesResponse, error)
+	// RoleSave creates a role when role_id is 0, otherwise updates it.
+	RoleSave(context.Context, *v1.RoleSaveRequest) (*v1.RoleSaveResponse, error)
+	RoleDelete(context.Context, *v1.RoleDeleteRequest) (*emptypb.Empty, error)
+	PersonRoles(context.Context, *v1.PersonRolesRequest) (*v1.PersonRolesResponse, error)
+	// PersonRolesSet replaces the roles assigned to a person.
+	PersonRolesSet(context.Context, *v1.PersonRolesSetRequest) (*emptypb.Empty, error)
+}
+
+// NewRoleServiceClient constructs a client for the auth.v1.RoleService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRoleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RoleServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	roleServiceMethods := v1.File_auth_v1_role_proto.Services().ByName("RoleService").Methods()
+	return &roleServiceClient{
+		capabilities: connect.NewClient[emptypb.Empty, v1.CapabilitiesResponse](
+			httpClient,
+			baseURL+RoleServiceCapabilitiesProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("Capabilities")),
+			connect.WithClientOptions(opts...),
+		),
+		roles: connect.NewClient[emptypb.Empty, v1.RolesResponse](
+			httpClient,
+			baseURL+RoleServiceRolesProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("Roles")),
+			connect.WithClientOptions(opts...),
+		),
+		roleSave: connect.NewClient[v1.RoleSaveRequest, v1.RoleSaveResponse](
+			httpClient,
+			baseURL+RoleServiceRoleSaveProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("RoleSave")),
+			connect.WithClientOptions(opts...),
+		),
+		roleDelete: connect.NewClient[v1.RoleDeleteRequest, emptypb.Empty](
+			httpClient,
+			baseURL+RoleServiceRoleDeleteProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("RoleDelete")),
+			connect.WithClientOptions(opts...),
+		),
+		personRoles: connect.NewClient[v1.PersonRolesRequest, v1.PersonRolesResponse](
+			httpClient,
+			baseURL+RoleServicePersonRolesProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("PersonRoles")),
+			connect.WithClientOptions(opts...),
+		),
+		personRolesSet: connect.NewClient[v1.PersonRolesSetRequest, emptypb.Empty](
+			httpClient,
+			baseURL+RoleServicePersonRolesSetProcedure,
+			connect.WithSchema(roleServiceMethods.ByName("PersonRolesSet")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// roleServiceClient implements RoleServiceClient.
+type roleServiceClient struct {
+	capabilities   *connect.Client[emptypb.Empty, v1.CapabilitiesResponse]
+	roles          *connect.Client[emptypb.Empty, v1.RolesResponse]
+	roleSave       *connect.Client[v1.RoleSaveRequest, v1.RoleSaveResponse]
+	roleDelete     *connect.Client[v1.RoleDeleteRequest, emptypb.Empty]
+	personRoles    *connect.Client[v1.PersonRolesRequest, v1.PersonRolesResponse]
+	personRolesSet *connect.Client[v1.PersonRolesSetRequest, emptypb.Empty]
+}
+
+// Capabilities calls auth.v1.RoleService.Capabilities.
+func (c *roleServiceClient) Capabilities(ctx context.Context, req *emptypb.Empty) (*v1.CapabilitiesResponse, error) {
+	response, err := c.capabilities.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Roles calls auth.v1.RoleService.Roles.
+func (c *roleServiceClient) Roles(ctx context.Context, req *emptypb.Empty) (*v1.RolesResponse, error) {
+	response, err := c.roles.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RoleSave calls auth.v1.RoleService.RoleSave.
+func (c *roleServiceClient) RoleSave(ctx context.Context, req *v1.RoleSaveRequest) (*v1.RoleSaveResponse, error) {
+	response, err := c.roleSave.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RoleDelete calls auth.v1.RoleService.RoleDelete.
+func (c *roleServiceClient) RoleDelete(ctx context.Context, req *v1.RoleDeleteRequest) (*emptypb.Empty, error) {
+	response, err := c.roleDelete.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PersonRoles calls auth.v1.RoleService.PersonRoles.
+func (c *roleServiceClient) PersonRoles(ctx context.Context, req *v1.PersonRolesRequest) (*v1.PersonRolesResponse, error) {
+	response, err := c.personRoles.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PersonRolesSet calls auth.v1.RoleService.PersonRolesSet.
+func (c *roleServiceClient) PersonRolesSet(ctx context.Context, req *v1.PersonRolesSetRequest) (*emptypb.Empty, error) {
+	response, err := c.personRolesSet.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RoleServiceHandler is an implementation of the auth.v1.RoleService service.
+type RoleServiceHandler interface {
+	// Capabilities lists every known capability along with those granted to the current user.
+	Capabilities(context.Context, *emptypb.Empty) (*v1.CapabilitiesResponse, error)
+	Roles(context.Context, *emptypb.Empty) (*v1.RolesResponse, error)
+	// RoleSave creates a role when role_id is 0, otherwise updates it.
+	RoleSave(context.Context, *v1.RoleSaveRequest) (*v1.RoleSaveResponse, error)
+	RoleDelete(context.Context, *v1.RoleDeleteRequest) (*emptypb.Empty, error)
+	PersonRoles(context.Context, *v1.PersonRolesRequest) (*v1.PersonRolesResponse, error)
+	// PersonRolesSet replaces the roles assigned to a person.
+	PersonRolesSet(context.Context, *v1.PersonRolesSetRequest) (*emptypb.Empty, error)
+}
+
+// NewRoleServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRoleServiceHandler(svc RoleServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	roleServiceMethods := v1.File_auth_v1_role_proto.Services().ByName("RoleService").Methods()
+	roleServiceCapabilitiesHandler := connect.NewUnaryHandlerSimple(
+		RoleServiceCapabilitiesProcedure,
+		svc.Capabilities,
+		connect.WithSchema(roleServiceMethods.ByName("Capabilities")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServiceRolesHandler := connect.NewUnaryHandlerSimple(
+		RoleServiceRolesProcedure,
+		svc.Roles,
+		connect.WithSchema(roleServiceMethods.ByName("Roles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServiceRoleSaveHandler := connect.NewUnaryHandlerSimple(
+		RoleServiceRoleSaveProcedure,
+		svc.RoleSave,
+		connect.WithSchema(roleServiceMethods.ByName("RoleSave")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServiceRoleDeleteHandler := connect.NewUnaryHandlerSimple(
+		RoleServiceRoleDeleteProcedure,
+		svc.RoleDelete,
+		connect.WithSchema(roleServiceMethods.ByName("RoleDelete")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServicePersonRolesHandler := connect.NewUnaryHandlerSimple(
+		RoleServicePersonRolesProcedure,
+		svc.PersonRoles,
+		connect.WithSchema(roleServiceMethods.ByName("PersonRoles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	roleServicePersonRolesSetHandler := connect.NewUnaryHandlerSimple(
+		RoleServicePersonRolesSetProcedure,
+		svc.PersonRolesSet,
+		connect.WithSchema(roleServiceMethods.ByName("PersonRolesSet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/auth.v1.RoleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RoleServiceCapabilitiesProcedure:
+			roleServiceCapabilitiesHandler.ServeHTTP(w, r)
+		case RoleServiceRolesProcedure:
+			roleServiceRolesHandler.ServeHTTP(w, r)
+		case RoleServiceRoleSaveProcedure:
+			roleServiceRoleSaveHandler.ServeHTTP(w, r)
+		case RoleServiceRoleDeleteProcedure:
+			roleServiceRoleDeleteHandler.ServeHTTP(w, r)
+		case RoleServicePersonRolesProcedure:
+			roleServicePersonRolesHandler.ServeHTTP(w, r)
+		case RoleServicePersonRolesSetProcedure:
+			roleServicePersonRolesSetHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRoleServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRoleServiceHandler struct{}
+
+func (UnimplementedRoleServiceHandler) Capabilities(context.Context, *emptypb.Empty) (*v1.CapabilitiesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.RoleService.Capabilities is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) Roles(context.Context, *emptypb.Empty) (*v1.RolesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.RoleService.Roles is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) RoleSave(context.Context, *v1.RoleSaveRequest) (*v1.RoleSaveResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.RoleService.RoleSave is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) RoleDelete(context.Context, *v1.RoleDeleteRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.RoleService.RoleDelete is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) PersonRoles(context.Context, *v1.PersonRolesRequest) (*v1.PersonRolesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.RoleService.PersonRoles is not implemented"))
+}
+
+func (UnimplementedRoleServiceHandler) PersonRolesSet(context.Context, *v1.PersonRolesSetRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("auth.v1.RoleService.PersonRolesSet is not implemented"))
+}
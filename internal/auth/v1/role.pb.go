@@ -0,0 +1,669 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: auth/v1/role.proto
+
+package authv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/leighmacdonald/gbans/internal/person/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Capability struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  *string                `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// DefaultPrivilege grants the capability when no roles have been configured.
+	DefaultPrivilege *v1.Privilege `protobuf:"varint,2,opt,name=default_privilege,json=defaultPrivilege,enum=person.v1.Privilege" json:"default_privilege,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Capability) Reset() {
+	*x = Capability{}
+	mi := &file_auth_v1_role_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Capability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capability) ProtoMessage() {}
+
+func (x *Capability) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capability.ProtoReflect.Descriptor instead.
+func (*Capability) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Capability) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Capability) GetDefaultPrivilege() v1.Privilege {
+	if x != nil && x.DefaultPrivilege != nil {
+		return *x.DefaultPrivilege
+	}
+	return v1.Privilege(0)
+}
+
+type CapabilitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Capabilities  []*Capability          `protobuf:"bytes,1,rep,name=capabilities" json:"capabilities,omitempty"`
+	Granted       []string               `protobuf:"bytes,2,rep,name=granted" json:"granted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapabilitiesResponse) Reset() {
+	*x = CapabilitiesResponse{}
+	mi := &file_auth_v1_role_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilitiesResponse) ProtoMessage() {}
+
+func (x *CapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*CapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CapabilitiesResponse) GetCapabilities() []*Capability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *CapabilitiesResponse) GetGranted() []string {
+	if x != nil {
+		return x.Granted
+	}
+	return nil
+}
+
+type Role struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	RoleId       *int32                 `protobuf:"varint,1,opt,name=role_id,json=roleId" json:"role_id,omitempty"`
+	Name         *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Description  *string                `protobuf:"bytes,3,opt,name=description" json:"description,omitempty"`
+	Capabilities []string               `protobuf:"bytes,4,rep,name=capabilities" json:"capabilities,omitempty"`
+	// Privilege is set for default roles, which are held by everyone at or above it and cannot be assigned.
+	Privilege     *v1.Privilege          `protobuf:"varint,5,opt,name=privilege,enum=person.v1.Privilege" json:"privilege,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	UpdatedOn     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_on,json=updatedOn" json:"updated_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	mi := &file_auth_v1_role_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Role) GetRoleId() int32 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return 0
+}
+
+func (x *Role) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *Role) GetPrivilege() v1.Privilege {
+	if x != nil && x.Privilege != nil {
+		return *x.Privilege
+	}
+	return v1.Privilege(0)
+}
+
+func (x *Role) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *Role) GetUpdatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedOn
+	}
+	return nil
+}
+
+type RolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RolesResponse) Reset() {
+	*x = RolesResponse{}
+	mi := &file_auth_v1_role_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolesResponse) ProtoMessage() {}
+
+func (x *RolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolesResponse.ProtoReflect.Descriptor instead.
+func (*RolesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type RoleSaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleSaveRequest) Reset() {
+	*x = RoleSaveRequest{}
+	mi := &file_auth_v1_role_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleSaveRequest) ProtoMessage() {}
+
+func (x *RoleSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleSaveRequest.ProtoReflect.Descriptor instead.
+func (*RoleSaveRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RoleSaveRequest) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type RoleSaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *Role                  `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleSaveResponse) Reset() {
+	*x = RoleSaveResponse{}
+	mi := &file_auth_v1_role_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleSaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleSaveResponse) ProtoMessage() {}
+
+func (x *RoleSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleSaveResponse.ProtoReflect.Descriptor instead.
+func (*RoleSaveResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RoleSaveResponse) GetRole() *Role {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+type RoleDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RoleId        *int32                 `protobuf:"varint,1,opt,name=role_id,json=roleId" json:"role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleDeleteRequest) Reset() {
+	*x = RoleDeleteRequest{}
+	mi := &file_auth_v1_role_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDeleteRequest) ProtoMessage() {}
+
+func (x *RoleDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDeleteRequest.ProtoReflect.Descriptor instead.
+func (*RoleDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RoleDeleteRequest) GetRoleId() int32 {
+	if x != nil && x.RoleId != nil {
+		return *x.RoleId
+	}
+	return 0
+}
+
+type PersonRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SteamId       *int64                 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonRolesRequest) Reset() {
+	*x = PersonRolesRequest{}
+	mi := &file_auth_v1_role_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonRolesRequest) ProtoMessage() {}
+
+func (x *PersonRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonRolesRequest.ProtoReflect.Descriptor instead.
+func (*PersonRolesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PersonRolesRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+type PersonRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*Role                `protobuf:"bytes,1,rep,name=roles" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonRolesResponse) Reset() {
+	*x = PersonRolesResponse{}
+	mi := &file_auth_v1_role_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonRolesResponse) ProtoMessage() {}
+
+func (x *PersonRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonRolesResponse.ProtoReflect.Descriptor instead.
+func (*PersonRolesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PersonRolesResponse) GetRoles() []*Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type PersonRolesSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SteamId       *int64                 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	RoleIds       []int32                `protobuf:"varint,2,rep,packed,name=role_ids,json=roleIds" json:"role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonRolesSetRequest) Reset() {
+	*x = PersonRolesSetRequest{}
+	mi := &file_auth_v1_role_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonRolesSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonRolesSetRequest) ProtoMessage() {}
+
+func (x *PersonRolesSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_role_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonRolesSetRequest.ProtoReflect.Descriptor instead.
+func (*PersonRolesSetRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_role_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PersonRolesSetRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+func (x *PersonRolesSetRequest) GetRoleIds() []int32 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+var File_auth_v1_role_proto protoreflect.FileDescriptor
+
+const file_auth_v1_role_proto_rawDesc = "" +
+	"\n" +
+	"\x12auth/v1/role.proto\x12\aauth.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19person/v1/privilege.proto\"s\n" +
+	"\n" +
+	"Capability\x12\x1a\n" +
+	"\x04name\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04name\x12I\n" +
+	"\x11default_privilege\x18\x02 \x01(\x0e2\x14.person.v1.PrivilegeB\x06\xbaH\x03\xc8\x01\x01R\x10defaultPrivilege\"i\n" +
+	"\x14CapabilitiesResponse\x127\n" +
+	"\fcapabilities\x18\x01 \x03(\v2\x13.auth.v1.CapabilityR\fcapabilities\x12\x18\n" +
+	"\agranted\x18\x02 \x03(\tR\agranted\"\xc5\x02\n" +
+	"\x04Role\x12\x17\n" +
+	"\arole_id\x18\x01 \x01(\x05R\x06roleId\x12 \n" +
+	"\x04name\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x18@R\x04name\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\vdescription\x12,\n" +
+	"\fcapabilities\x18\x04 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10dR\fcapabilities\x122\n" +
+	"\tprivilege\x18\x05 \x01(\x0e2\x14.person.v1.PrivilegeR\tprivilege\x129\n" +
+	"\n" +
+	"created_on\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x129\n" +
+	"\n" +
+	"updated_on\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedOn\"4\n" +
+	"\rRolesResponse\x12#\n" +
+	"\x05roles\x18\x01 \x03(\v2\r.auth.v1.RoleR\x05roles\"<\n" +
+	"\x0fRoleSaveRequest\x12)\n" +
+	"\x04role\x18\x01 \x01(\v2\r.auth.v1.RoleB\x06\xbaH\x03\xc8\x01\x01R\x04role\"=\n" +
+	"\x10RoleSaveResponse\x12)\n" +
+	"\x04role\x18\x01 \x01(\v2\r.auth.v1.RoleB\x06\xbaH\x03\xc8\x01\x01R\x04role\"8\n" +
+	"\x11RoleDeleteRequest\x12#\n" +
+	"\arole_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x06roleId\"=\n" +
+	"\x12PersonRolesRequest\x12'\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\asteamId\":\n" +
+	"\x13PersonRolesResponse\x12#\n" +
+	"\x05roles\x18\x01 \x03(\v2\r.auth.v1.RoleR\x05roles\"e\n" +
+	"\x15PersonRolesSetRequest\x12'\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\asteamId\x12#\n" +
+	"\brole_ids\x18\x02 \x03(\x05B\b\xbaH\x05\x92\x01\x02\x10dR\aroleIds2\xb0\x03\n" +
+	"\vRoleService\x12G\n" +
+	"\fCapabilities\x12\x16.google.protobuf.Empty\x1a\x1d.auth.v1.CapabilitiesResponse\"\x00\x129\n" +
+	"\x05Roles\x12\x16.google.protobuf.Empty\x1a\x16.auth.v1.RolesResponse\"\x00\x12A\n" +
+	"\bRoleSave\x12\x18.auth.v1.RoleSaveRequest\x1a\x19.auth.v1.RoleSaveResponse\"\x00\x12B\n" +
+	"\n" +
+	"RoleDelete\x12\x1a.auth.v1.RoleDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12J\n" +
+	"\vPersonRoles\x12\x1b.auth.v1.PersonRolesRequest\x1a\x1c.auth.v1.PersonRolesResponse\"\x00\x12J\n" +
+	"\x0ePersonRolesSet\x12\x1e.auth.v1.PersonRolesSetRequest\x1a\x16.google.protobuf.Empty\"\x00B\x8e\x01\n" +
+	"\vcom.auth.v1B\tRoleProtoP\x01Z7github.com/leighmacdonald/gbans/internal/auth/v1;authv1\xa2\x02\x03AXX\xaa\x02\aAuth.V1\xca\x02\aAuth\\V1\xe2\x02\x13Auth\\V1\\GPBMetadata\xea\x02\bAuth::V1b\beditionsp\xe8\a"
+
+var (
+	file_auth_v1_role_proto_rawDescOnce sync.Once
+	file_auth_v1_role_proto_rawDescData []byte
+)
+
+func file_auth_v1_role_proto_rawDescGZIP() []byte {
+	file_auth_v1_role_proto_rawDescOnce.Do(func() {
+		file_auth_v1_role_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_role_proto_rawDesc), len(file_auth_v1_role_proto_rawDesc)))
+	})
+	return file_auth_v1_role_proto_rawDescData
+}
+
+var file_auth_v1_role_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_auth_v1_role_proto_goTypes = []any{
+	(*Capability)(nil),            // 0: auth.v1.Capability
+	(*CapabilitiesResponse)(nil),  // 1: auth.v1.CapabilitiesResponse
+	(*Role)(nil),                  // 2: auth.v1.Role
+	(*RolesResponse)(nil),         // 3: auth.v1.RolesResponse
+	(*RoleSaveRequest)(nil),       // 4: auth.v1.RoleSaveRequest
+	(*RoleSaveResponse)(nil),      // 5: auth.v1.RoleSaveResponse
+	(*RoleDeleteRequest)(nil),     // 6: auth.v1.RoleDeleteRequest
+	(*PersonRolesRequest)(nil),    // 7: auth.v1.PersonRolesRequest
+	(*PersonRolesResponse)(nil),   // 8: auth.v1.PersonRolesResponse
+	(*PersonRolesSetRequest)(nil), // 9: auth.v1.PersonRolesSetRequest
+	(v1.Privilege)(0),             // 10: person.v1.Privilege
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 12: google.protobuf.Empty
+}
+var file_auth_v1_role_proto_depIdxs = []int32{
+	10, // 0: auth.v1.Capability.default_privilege:type_name -> person.v1.Privilege
+	0,  // 1: auth.v1.CapabilitiesResponse.capabilities:type_name -> auth.v1.Capability
+	10, // 2: auth.v1.Role.privilege:type_name -> person.v1.Privilege
+	11, // 3: auth.v1.Role.created_on:type_name -> google.protobuf.Timestamp
+	11, // 4: auth.v1.Role.updated_on:type_name -> google.protobuf.Timestamp
+	2,  // 5: auth.v1.RolesResponse.roles:type_name -> auth.v1.Role
+	2,  // 6: auth.v1.RoleSaveRequest.role:type_name -> auth.v1.Role
+	2,  // 7: auth.v1.RoleSaveResponse.role:type_name -> auth.v1.Role
+	2,  // 8: auth.v1.PersonRolesResponse.roles:type_name -> auth.v1.Role
+	12, // 9: auth.v1.RoleService.Capabilities:input_type -> google.protobuf.Empty
+	12, // 10: auth.v1.RoleService.Roles:input_type -> google.protobuf.Empty
+	4,  // 11: auth.v1.RoleService.RoleSave:input_type -> auth.v1.RoleSaveRequest
+	6,  // 12: auth.v1.RoleService.RoleDelete:input_type -> auth.v1.RoleDeleteRequest
+	7,  // 13: auth.v1.RoleService.PersonRoles:input_type -> auth.v1.PersonRolesRequest
+	9,  // 14: auth.v1.RoleService.PersonRolesSet:input_type -> auth.v1.PersonRolesSetRequest
+	1,  // 15: auth.v1.RoleService.Capabilities:output_type -> auth.v1.CapabilitiesResponse
+	3,  // 16: auth.v1.RoleService.Roles:output_type -> auth.v1.RolesResponse
+	5,  // 17: auth.v1.RoleService.RoleSave:output_type -> auth.v1.RoleSaveResponse
+	12, // 18: auth.v1.RoleService.RoleDelete:output_type -> google.protobuf.Empty
+	8,  // 19: auth.v1.RoleService.PersonRoles:output_type -> auth.v1.PersonRolesResponse
+	12, // 20: auth.v1.RoleService.PersonRolesSet:output_type -> google.protobuf.Empty
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_role_proto_init() }
+func file_auth_v1_role_proto_init() {
+	if File_auth_v1_role_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_role_proto_rawDesc), len(file_auth_v1_role_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_role_proto_goTypes,
+		DependencyIndexes: file_auth_v1_role_proto_depIdxs,
+		MessageInfos:      file_auth_v1_role_proto_msgTypes,
+	}.Build()
+	File_auth_v1_role_proto = out.File
+	file_auth_v1_role_proto_goTypes = nil
+	file_auth_v1_role_proto_depIdxs = nil
+}
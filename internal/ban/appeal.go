@@ -49,6 +49,8 @@ type AppealOverview struct {
 	SourceAvatarhash  string
 	TargetPersonaname string
 	TargetAvatarhash  string
+	// AssigneeID is the moderator responsible for the appeal, if any.
+	AssigneeID steamid.SteamID
 }
 
 type AppealState int
@@ -154,7 +156,8 @@ func (u *Appeals) CreateBanMessage(ctx context.Context, curUser person.BaseUser,
 		return AppealMessage{}, httphelper.ErrInvalidParameter
 	}
 
-	if !httphelper.HasPrivilege(curUser, steamid.Collection{ban.TargetID}, permission.Moderator) {
+	// The target can always reply to their own ban, anyone else needs to be able to reply to appeals.
+	if !ban.TargetID.Equal(curUser.GetSteamID()) && !permission.Granted(curUser, permission.CapAppealsReply) {
 		return AppealMessage{}, permission.ErrDenied
 	}
 
@@ -171,7 +174,7 @@ func (u *Appeals) CreateBanMessage(ctx context.Context, curUser person.BaseUser,
 		return AppealMessage{}, errReport
 	}
 
-	if bannedPerson.AppealState != Open && !curUser.HasPermission(permission.Moderator) {
+	if bannedPerson.AppealState != Open && !permission.Granted(curUser, permission.CapAppealsReply) {
 		return AppealMessage{}, permission.ErrDenied
 	}
 
@@ -194,7 +197,7 @@ func (u *Appeals) CreateBanMessage(ctx context.Context, curUser person.BaseUser,
 
 	bannedPerson.UpdatedOn = time.Now()
 
-	if errUpdate := u.bans.Save(ctx, &bannedPerson); errUpdate != nil {
+	if errUpdate := u.bans.Save(ctx, curUser.GetSteamID(), &bannedPerson); errUpdate != nil {
 		return AppealMessage{}, errUpdate
 	}
 
@@ -228,7 +231,7 @@ func (u *Appeals) Messages(ctx context.Context, userProfile person.BaseUser, ban
 		return nil, errGetBan
 	}
 
-	if !userProfile.HasPermission(permission.Moderator) && !banPerson.TargetID.Equal(userProfile.GetSteamID()) {
+	if !permission.Granted(userProfile, permission.CapAppeals) && !banPerson.TargetID.Equal(userProfile.GetSteamID()) {
 		return nil, permission.ErrDenied
 	}
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/leighmacdonald/gbans/internal/config/link"
+	"github.com/leighmacdonald/gbans/internal/datetime"
 	"github.com/leighmacdonald/gbans/internal/discord"
 )
 
@@ -32,3 +33,16 @@ func newAppealMessageDelete(msg AppealMessage) *discordgo.MessageSend {
 		discord.Buttons(discord.Link("🔎 View", link.Path(msg))),
 	)
 }
+
+func newAppealReminderMessage(appeal OverdueAppeal) *discordgo.MessageSend {
+	body := fmt.Sprintf("Waiting on a staff reply since %s", datetime.FmtTimeShort(appeal.WaitingSince))
+	if appeal.AssigneeID.Valid() {
+		body += fmt.Sprintf("\nAssigned to: %s", appeal.AssigneeID.String())
+	}
+
+	return discord.NewMessage(
+		discord.Heading("Appeal Awaiting Reply"),
+		discord.BodyColouredText(discord.ColourWarn, body),
+		discord.Buttons(discord.Link("🔎 View", link.Path(appeal))),
+	)
+}
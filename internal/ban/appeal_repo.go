@@ -33,7 +33,7 @@ func (r AppealRepository) ByActivity(ctx context.Context, opts AppealQueryFilter
 			"source.steam_id as source_steam_id", "source.personaname as source_personaname",
 			"source.avatarhash as source_avatar",
 			"target.steam_id as target_steam_id", "target.personaname as target_personaname",
-			"target.avatarhash as target_avatar").
+			"target.avatarhash as target_avatar", "coalesce(w.assignee_id, 0)").
 		From("ban b").
 		Where(constraints).
 		InnerJoin(`
@@ -43,7 +43,8 @@ func (r AppealRepository) ByActivity(ctx context.Context, opts AppealQueryFilter
 				WHERE b.ban_id = a.ban_id
 			) m ON TRUE`).
 		LeftJoin("person source on source.steam_id = b.source_id").
-		LeftJoin("person target on target.steam_id = b.target_id")
+		LeftJoin("person target on target.steam_id = b.target_id").
+		LeftJoin("ban_appeal_workflow w on w.ban_id = b.ban_id")
 
 	rows, errQuery := r.QueryBuilder(ctx, builder)
 	if errQuery != nil {
@@ -61,6 +62,7 @@ func (r AppealRepository) ByActivity(ctx context.Context, opts AppealQueryFilter
 			SourceSteamID int64
 			targetID      int64
 			TargetSteamID int64
+			assigneeID    int64
 		)
 
 		if errScan := rows.Scan(
@@ -69,13 +71,14 @@ func (r AppealRepository) ByActivity(ctx context.Context, opts AppealQueryFilter
 			&overview.Origin, &overview.CreatedOn, &overview.UpdatedOn, &overview.Deleted,
 			&overview.ReportID, &overview.UnbanReasonText, &overview.IsEnabled, &overview.AppealState,
 			&SourceSteamID, &overview.SourcePersonaname, &overview.SourceAvatarhash,
-			&TargetSteamID, &overview.TargetPersonaname, &overview.TargetAvatarhash,
+			&TargetSteamID, &overview.TargetPersonaname, &overview.TargetAvatarhash, &assigneeID,
 		); errScan != nil {
 			return nil, errors.Join(errScan, database.ErrScanResult)
 		}
 
 		overview.SourceID = steamid.New(SourceSteamID)
 		overview.TargetID = steamid.New(TargetSteamID)
+		overview.AssigneeID = steamid.New(assigneeID)
 
 		overviews = append(overviews, overview)
 	}
@@ -244,3 +247,181 @@ func (r AppealRepository) DropMessage(ctx context.Context, message *AppealMessag
 
 	return nil
 }
+
+// AssignAppeal sets the assignee of the appeal. An invalid assigneeID unassigns it. Reminders restart
+// for the new assignee.
+func (r AppealRepository) AssignAppeal(ctx context.Context, banID int32, assigneeID steamid.SteamID) error {
+	var assignee *int64
+	if assigneeID.Valid() {
+		assignee = new(assigneeID.Int64())
+	}
+
+	return database.Err(r.Exec(ctx, `
+		INSERT INTO ban_appeal_workflow (ban_id, assignee_id, assigned_on, reminded_on)
+		VALUES ($1, $2, $3, NULL)
+		ON CONFLICT (ban_id) DO UPDATE
+		SET assignee_id = excluded.assignee_id, assigned_on = excluded.assigned_on, reminded_on = NULL`,
+		banID, assignee, time.Now()))
+}
+
+func (r AppealRepository) SetReminded(ctx context.Context, banID int32, remindedOn time.Time) error {
+	return database.Err(r.Exec(ctx, `
+		INSERT INTO ban_appeal_workflow (ban_id, reminded_on)
+		VALUES ($1, $2)
+		ON CONFLICT (ban_id) DO UPDATE SET reminded_on = excluded.reminded_on`,
+		banID, remindedOn))
+}
+
+// Overdue returns the open appeals where the targets oldest message since the last staff reply was sent
+// before the deadline, excluding those already reminded about since then.
+func (r AppealRepository) Overdue(ctx context.Context, deadline time.Time) ([]OverdueAppeal, error) {
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT b.ban_id, b.target_id, coalesce(w.assignee_id, 0), m.waiting_since
+		FROM ban b
+		LEFT JOIN ban_appeal_workflow w ON w.ban_id = b.ban_id
+		INNER JOIN LATERAL (
+			SELECT min(a.created_on) AS waiting_since
+			FROM ban_appeal a
+			WHERE a.ban_id = b.ban_id
+			  AND a.deleted = FALSE
+			  AND a.author_id = b.target_id
+			  AND a.created_on > coalesce((
+				SELECT max(s.created_on)
+				FROM ban_appeal s
+				WHERE s.ban_id = b.ban_id AND s.deleted = FALSE AND s.author_id <> b.target_id
+			  ), '-infinity')
+		) m ON m.waiting_since IS NOT NULL
+		WHERE b.deleted = FALSE
+		  AND b.appeal_state = $1
+		  AND m.waiting_since < $2
+		  AND (w.reminded_on IS NULL OR w.reminded_on < $2)
+		ORDER BY m.waiting_since`, Open, deadline)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	overdue := []OverdueAppeal{}
+	for rows.Next() {
+		var (
+			appeal     OverdueAppeal
+			targetID   int64
+			assigneeID int64
+		)
+
+		if err := rows.Scan(&appeal.BanID, &targetID, &assigneeID, &appeal.WaitingSince); err != nil {
+			return nil, errors.Join(err, database.ErrScanResult)
+		}
+
+		appeal.TargetID = steamid.New(targetID)
+		appeal.AssigneeID = steamid.New(assigneeID)
+
+		overdue = append(overdue, appeal)
+	}
+
+	return overdue, nil
+}
+
+func (r AppealRepository) Dashboard(ctx context.Context, moderatorID steamid.SteamID) (AppealDashboard, error) {
+	dashboard := AppealDashboard{States: map[AppealState]int32{}}
+
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT b.appeal_state, count(*)
+		FROM ban b
+		INNER JOIN ban_appeal_workflow w ON w.ban_id = b.ban_id
+		WHERE w.assignee_id = $1 AND b.deleted = FALSE
+		GROUP BY b.appeal_state`, moderatorID.Int64())
+	if errRows != nil {
+		return dashboard, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			state AppealState
+			count int32
+		)
+
+		if err := rows.Scan(&state, &count); err != nil {
+			return dashboard, errors.Join(err, database.ErrScanResult)
+		}
+
+		dashboard.States[state] = count
+	}
+
+	// Every ban starts out open, only those with messages are actual appeals.
+	if errUnassigned := r.QueryRow(ctx, `
+		SELECT count(*)
+		FROM ban b
+		LEFT JOIN ban_appeal_workflow w ON w.ban_id = b.ban_id
+		WHERE b.deleted = FALSE
+		  AND b.appeal_state = $1
+		  AND w.assignee_id IS NULL
+		  AND EXISTS (SELECT 1 FROM ban_appeal a WHERE a.ban_id = b.ban_id AND a.deleted = FALSE)`,
+		Open).Scan(&dashboard.Unassigned); errUnassigned != nil {
+		return dashboard, database.Err(errUnassigned)
+	}
+
+	return dashboard, nil
+}
+
+func (r AppealRepository) Templates(ctx context.Context) ([]AppealTemplate, error) {
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT appeal_template_id, name, body_md, created_on, updated_on
+		FROM appeal_template
+		ORDER BY name`)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	templates := []AppealTemplate{}
+	for rows.Next() {
+		var tmpl AppealTemplate
+		if err := rows.Scan(&tmpl.AppealTemplateID, &tmpl.Name, &tmpl.BodyMD, &tmpl.CreatedOn, &tmpl.UpdatedOn); err != nil {
+			return nil, errors.Join(err, database.ErrScanResult)
+		}
+
+		templates = append(templates, tmpl)
+	}
+
+	return templates, nil
+}
+
+func (r AppealRepository) Template(ctx context.Context, appealTemplateID int32) (AppealTemplate, error) {
+	var tmpl AppealTemplate
+
+	return tmpl, database.Err(r.QueryRow(ctx, `
+		SELECT appeal_template_id, name, body_md, created_on, updated_on
+		FROM appeal_template
+		WHERE appeal_template_id = $1`, appealTemplateID).
+		Scan(&tmpl.AppealTemplateID, &tmpl.Name, &tmpl.BodyMD, &tmpl.CreatedOn, &tmpl.UpdatedOn))
+}
+
+// SaveTemplate inserts the template when it has no ID, otherwise updates it.
+func (r AppealRepository) SaveTemplate(ctx context.Context, tmpl *AppealTemplate) error {
+	tmpl.UpdatedOn = time.Now()
+
+	if tmpl.AppealTemplateID > 0 {
+		return database.Err(r.QueryRow(ctx, `
+			UPDATE appeal_template SET name = $2, body_md = $3, updated_on = $4
+			WHERE appeal_template_id = $1
+			RETURNING created_on`,
+			tmpl.AppealTemplateID, tmpl.Name, tmpl.BodyMD, tmpl.UpdatedOn).Scan(&tmpl.CreatedOn))
+	}
+
+	tmpl.CreatedOn = tmpl.UpdatedOn
+
+	return database.Err(r.QueryRow(ctx, `
+		INSERT INTO appeal_template (name, body_md, created_on, updated_on)
+		VALUES ($1, $2, $3, $4)
+		RETURNING appeal_template_id`,
+		tmpl.Name, tmpl.BodyMD, tmpl.CreatedOn, tmpl.UpdatedOn).Scan(&tmpl.AppealTemplateID))
+}
+
+func (r AppealRepository) DeleteTemplate(ctx context.Context, appealTemplateID int32) error {
+	return database.Err(r.Exec(ctx, `DELETE FROM appeal_template WHERE appeal_template_id = $1`, appealTemplateID))
+}
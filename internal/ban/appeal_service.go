@@ -3,6 +3,7 @@ package ban
 import (
 	"context"
 	"errors"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/leighmacdonald/gbans/internal/auth/permission"
@@ -12,6 +13,7 @@ import (
 	"github.com/leighmacdonald/gbans/internal/httphelper"
 	personv1 "github.com/leighmacdonald/gbans/internal/person/v1"
 	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -25,18 +27,143 @@ type AppealService struct {
 func NewAppealService(appeals Appeals, authMiddleware *rpc.Middleware, options ...connect.HandlerOption) rpc.Service {
 	pattern, handler := banv1connect.NewAppealServiceHandler(AppealService{appeals: appeals}, options...)
 
-	authMiddleware.UserRoute(banv1connect.AppealServiceAppealsProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(banv1connect.AppealServiceAppealsProcedure, rpc.WithCapability(permission.CapAppeals))
 	authMiddleware.UserRoute(banv1connect.AppealServiceSetAppealStateProcedure, rpc.WithMinPermissions(permission.Moderator))
 	authMiddleware.UserRoute(banv1connect.AppealServiceMessagesProcedure, rpc.WithMinPermissions(permission.User))
 	authMiddleware.UserRoute(banv1connect.AppealServiceReplyProcedure, rpc.WithMinPermissions(permission.User))
 	authMiddleware.UserRoute(banv1connect.AppealServiceEditAppealMessageProcedure, rpc.WithMinPermissions(permission.User))
 	authMiddleware.UserRoute(banv1connect.AppealServiceDeleteAppealMessageProcedure, rpc.WithMinPermissions(permission.User))
+	authMiddleware.UserRoute(banv1connect.AppealServiceAppealAssignProcedure, rpc.WithCapability(permission.CapAppeals))
+	authMiddleware.UserRoute(banv1connect.AppealServiceAppealDashboardProcedure, rpc.WithCapability(permission.CapAppeals))
+	authMiddleware.UserRoute(banv1connect.AppealServiceAppealTemplatesProcedure, rpc.WithCapability(permission.CapAppeals))
+	authMiddleware.UserRoute(banv1connect.AppealServiceAppealTemplateRenderProcedure, rpc.WithCapability(permission.CapAppeals))
+	authMiddleware.UserRoute(banv1connect.AppealServiceAppealTemplateSaveProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(banv1connect.AppealServiceAppealTemplateDeleteProcedure, rpc.WithMinPermissions(permission.Moderator))
 
 	return rpc.Service{Pattern: pattern, Handler: handler}
 }
 
-func (s AppealService) SetAppealState(context.Context, *v1.SetAppealStateRequest) (*v1.SetAppealStateResponse, error) {
-	return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+func (s AppealService) SetAppealState(ctx context.Context, req *v1.SetAppealStateRequest) (*v1.SetAppealStateResponse, error) {
+	var validUntil time.Time
+	if req.GetValidUntil().IsValid() {
+		validUntil = req.GetValidUntil().AsTime()
+	}
+
+	ban, errState := s.appeals.SetAppealState(ctx, rpc.UserInfoFromCtx(ctx), req.GetBanId(), AppealState(req.GetAppealState()), validUntil)
+	if errState != nil {
+		switch {
+		case errors.Is(errState, ErrAppealState), errors.Is(errState, ErrAppealReduced):
+			return nil, connect.NewError(connect.CodeInvalidArgument, errState)
+		case errors.Is(errState, ErrBanDoesNotExist):
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &v1.SetAppealStateResponse{Ban: toBan(ban)}, nil
+}
+
+func (s AppealService) AppealAssign(ctx context.Context, req *v1.AppealAssignRequest) (*emptypb.Empty, error) {
+	if errAssign := s.appeals.Assign(ctx, rpc.UserInfoFromCtx(ctx), req.GetBanId(), steamid.New(req.GetAssigneeId())); errAssign != nil {
+		switch {
+		case errors.Is(errAssign, ErrAppealAssignee):
+			return nil, connect.NewError(connect.CodeInvalidArgument, errAssign)
+		case errors.Is(errAssign, ErrBanDoesNotExist):
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s AppealService) AppealDashboard(ctx context.Context, req *v1.AppealDashboardRequest) (*v1.AppealDashboardResponse, error) {
+	moderatorID := rpc.UserInfoFromCtx(ctx).GetSteamID()
+	if req.GetSteamId() != 0 {
+		moderatorID = steamid.New(req.GetSteamId())
+		if !moderatorID.Valid() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+		}
+	}
+
+	dashboard, errDashboard := s.appeals.Dashboard(ctx, moderatorID)
+	if errDashboard != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.AppealDashboardResponse{Unassigned: &dashboard.Unassigned}
+	for state := Open; state <= NoAppeal; state++ {
+		resp.States = append(resp.States, &v1.AppealStateCount{
+			AppealState: new(v1.AppealState(state)), //nolint:gosec
+			Count:       new(dashboard.States[state]),
+		})
+	}
+
+	return &resp, nil
+}
+
+func (s AppealService) AppealTemplates(ctx context.Context, _ *emptypb.Empty) (*v1.AppealTemplatesResponse, error) {
+	templates, errTemplates := s.appeals.Templates(ctx)
+	if errTemplates != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.AppealTemplatesResponse{Templates: make([]*v1.AppealTemplate, len(templates))}
+	for idx, tmpl := range templates {
+		resp.Templates[idx] = toAppealTemplate(tmpl)
+	}
+
+	return &resp, nil
+}
+
+func (s AppealService) AppealTemplateSave(ctx context.Context, req *v1.AppealTemplateSaveRequest) (*v1.AppealTemplateSaveResponse, error) {
+	tmpl := req.GetTemplate()
+
+	saved, errSave := s.appeals.SaveTemplate(ctx, AppealTemplate{
+		AppealTemplateID: tmpl.GetAppealTemplateId(),
+		Name:             tmpl.GetName(),
+		BodyMD:           tmpl.GetBodyMd(),
+	})
+	if errSave != nil {
+		switch {
+		case errors.Is(errSave, ErrAppealTemplate):
+			return nil, connect.NewError(connect.CodeInvalidArgument, errSave)
+		case errors.Is(errSave, database.ErrDuplicate):
+			return nil, connect.NewError(connect.CodeAlreadyExists, rpc.ErrExists)
+		case errors.Is(errSave, database.ErrNoResult):
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &v1.AppealTemplateSaveResponse{Template: toAppealTemplate(saved)}, nil
+}
+
+func (s AppealService) AppealTemplateDelete(ctx context.Context, req *v1.AppealTemplateDeleteRequest) (*emptypb.Empty, error) {
+	if errDelete := s.appeals.DeleteTemplate(ctx, req.GetAppealTemplateId()); errDelete != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s AppealService) AppealTemplateRender(ctx context.Context, req *v1.AppealTemplateRenderRequest) (*v1.AppealTemplateRenderResponse, error) {
+	body, errRender := s.appeals.RenderTemplate(ctx, rpc.UserInfoFromCtx(ctx), req.GetBanId(), req.GetAppealTemplateId())
+	if errRender != nil {
+		switch {
+		case errors.Is(errRender, ErrAppealTemplate):
+			return nil, connect.NewError(connect.CodeInvalidArgument, errRender)
+		case errors.Is(errRender, database.ErrNoResult), errors.Is(errRender, ErrBanDoesNotExist):
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &v1.AppealTemplateRenderResponse{BodyMd: &body}, nil
 }
 
 func (s AppealService) Appeals(ctx context.Context, req *v1.AppealsRequest) (*v1.AppealsResponse, error) {
@@ -138,3 +265,13 @@ func toAppealMessage(message AppealMessage) *v1.AppealMessage {
 		Privilege:    new(personv1.Privilege(message.PermissionLevel)),
 	}
 }
+
+func toAppealTemplate(tmpl AppealTemplate) *v1.AppealTemplate {
+	return &v1.AppealTemplate{
+		AppealTemplateId: &tmpl.AppealTemplateID,
+		Name:             &tmpl.Name,
+		BodyMd:           &tmpl.BodyMD,
+		CreatedOn:        timestamppb.New(tmpl.CreatedOn),
+		UpdatedOn:        timestamppb.New(tmpl.UpdatedOn),
+	}
+}
@@ -0,0 +1,271 @@
+package ban
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/config/link"
+	"github.com/leighmacdonald/gbans/internal/datetime"
+	"github.com/leighmacdonald/gbans/internal/domain/person"
+	"github.com/leighmacdonald/gbans/internal/notification"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+var (
+	ErrAppealState    = errors.New("invalid appeal state")
+	ErrAppealReduced  = errors.New("reduced expiry must be in the future and before the current expiry")
+	ErrAppealAssignee = errors.New("appeal assignee must be a moderator")
+	ErrAppealTemplate = errors.New("invalid appeal template")
+)
+
+// AppealTemplate is a canned response used when replying to appeals. The body is a text/template
+// rendered with AppealTemplateData.
+type AppealTemplate struct {
+	AppealTemplateID int32
+	Name             string
+	BodyMD           string
+	CreatedOn        time.Time
+	UpdatedOn        time.Time
+}
+
+// AppealTemplateData holds the variables available to appeal templates, e.g. {{ .Reason }}.
+type AppealTemplateData struct {
+	BanID      int32
+	Name       string
+	SteamID    string
+	BanType    string
+	Reason     string
+	ReasonText string
+	// ValidUntil is the formatted expiry of the ban, or Permanent.
+	ValidUntil string
+	// ExpiresIn is the remaining duration of the ban, or Permanent.
+	ExpiresIn string
+	Moderator string
+}
+
+func NewAppealTemplateData(ban Ban, moderator string) AppealTemplateData {
+	data := AppealTemplateData{
+		BanID:      ban.BanID,
+		Name:       ban.TargetPersonaname,
+		SteamID:    ban.TargetID.String(),
+		BanType:    ban.BanType.String(),
+		Reason:     ban.Reason.String(),
+		ReasonText: ban.ReasonText,
+		ValidUntil: Permanent,
+		ExpiresIn:  Permanent,
+		Moderator:  moderator,
+	}
+
+	if ban.ValidUntil.Year()-time.Now().Year() < 5 {
+		data.ValidUntil = datetime.FmtTimeShort(ban.ValidUntil)
+		data.ExpiresIn = datetime.FmtDuration(ban.ValidUntil)
+	}
+
+	return data
+}
+
+// RenderAppealTemplate executes the template body with the data. Unknown variables are treated as an error.
+func RenderAppealTemplate(body string, data AppealTemplateData) (string, error) {
+	tmpl, errParse := template.New("appeal").Option("missingkey=error").Parse(body)
+	if errParse != nil {
+		return "", errors.Join(errParse, ErrAppealTemplate)
+	}
+
+	var out bytes.Buffer
+	if errExec := tmpl.Execute(&out, data); errExec != nil {
+		return "", errors.Join(errExec, ErrAppealTemplate)
+	}
+
+	return out.String(), nil
+}
+
+// ApplyAppealState returns the ban with the new appeal outcome applied. A Reduced outcome shortens the ban
+// to validUntil, which must be in the future and before the current expiry. validUntil is ignored for
+// other states.
+func ApplyAppealState(ban Ban, state AppealState, validUntil time.Time, now time.Time) (Ban, error) {
+	if state < Open || state > NoAppeal {
+		return ban, ErrAppealState
+	}
+
+	if state == Reduced {
+		if !validUntil.After(now) || !validUntil.Before(ban.ValidUntil) {
+			return ban, ErrAppealReduced
+		}
+
+		ban.ValidUntil = validUntil
+	}
+
+	ban.AppealState = state
+
+	return ban, nil
+}
+
+// AppealDashboard summarises the appeal workload of a moderator.
+type AppealDashboard struct {
+	// States counts the appeals assigned to the moderator by their state.
+	States map[AppealState]int32
+	// Unassigned is the number of open appeals which nobody has been assigned to.
+	Unassigned int32
+}
+
+// OverdueAppeal is an open appeal where the target has been waiting on a staff reply.
+type OverdueAppeal struct {
+	BanID        int32
+	TargetID     steamid.SteamID
+	AssigneeID   steamid.SteamID
+	WaitingSince time.Time
+}
+
+func (o OverdueAppeal) Path() string {
+	return fmt.Sprintf("/ban/%d", o.BanID)
+}
+
+// SetAppealState updates the outcome of the appeal. The ban is updated along with its history in a
+// single transaction so a Reduced outcome can never be recorded without the new expiry.
+func (u *Appeals) SetAppealState(ctx context.Context, curUser person.BaseUser, banID int32, state AppealState, validUntil time.Time) (Ban, error) {
+	existing, errBan := u.bans.QueryOne(ctx, QueryOpts{BanID: banID, EvadeOk: true})
+	if errBan != nil {
+		return Ban{}, errBan
+	}
+
+	updated, errState := ApplyAppealState(existing, state, validUntil, time.Now())
+	if errState != nil {
+		return existing, errState
+	}
+
+	if errSave := u.bans.SaveAppealState(ctx, curUser.GetSteamID(), existing, &updated); errSave != nil {
+		return existing, errSave
+	}
+
+	slog.Info("Appeal state updated", slog.Int("ban_id", int(banID)),
+		slog.String("state", updated.AppealState.String()))
+
+	return updated, nil
+}
+
+// Assign sets the moderator responsible for the appeal. An invalid assigneeID unassigns it.
+func (u *Appeals) Assign(ctx context.Context, curUser person.BaseUser, banID int32, assigneeID steamid.SteamID) error {
+	ban, errBan := u.bans.QueryOne(ctx, QueryOpts{BanID: banID, Deleted: true, EvadeOk: true})
+	if errBan != nil {
+		return errBan
+	}
+
+	if !assigneeID.Valid() {
+		return u.AssignAppeal(ctx, ban.BanID, assigneeID)
+	}
+
+	assignee, errAssignee := u.persons.GetOrCreatePersonBySteamID(ctx, assigneeID)
+	if errAssignee != nil {
+		return errAssignee
+	}
+
+	if !assignee.HasPermission(permission.Moderator) {
+		return ErrAppealAssignee
+	}
+
+	if errAssign := u.AssignAppeal(ctx, ban.BanID, assigneeID); errAssign != nil {
+		return errAssign
+	}
+
+	if !assigneeID.Equal(curUser.GetSteamID()) {
+		go u.notif.Send(notification.NewSiteUser(
+			[]steamid.SteamID{assigneeID},
+			notification.Info,
+			fmt.Sprintf("%s assigned you a ban appeal", curUser.GetName()),
+			link.Path(ban)))
+	}
+
+	return nil
+}
+
+// Dashboard returns the appeal counts of the moderator.
+func (u *Appeals) Dashboard(ctx context.Context, moderatorID steamid.SteamID) (AppealDashboard, error) {
+	return u.AppealRepository.Dashboard(ctx, moderatorID)
+}
+
+func (u *Appeals) Templates(ctx context.Context) ([]AppealTemplate, error) {
+	return u.AppealRepository.Templates(ctx)
+}
+
+// SaveTemplate validates the template by rendering it against sample data before saving it.
+func (u *Appeals) SaveTemplate(ctx context.Context, tmpl AppealTemplate) (AppealTemplate, error) {
+	tmpl.Name = strings.TrimSpace(tmpl.Name)
+	if tmpl.Name == "" || strings.TrimSpace(tmpl.BodyMD) == "" {
+		return tmpl, ErrAppealTemplate
+	}
+
+	if _, errRender := RenderAppealTemplate(tmpl.BodyMD, NewAppealTemplateData(Ban{}, "")); errRender != nil {
+		return tmpl, errRender
+	}
+
+	if errSave := u.AppealRepository.SaveTemplate(ctx, &tmpl); errSave != nil {
+		return tmpl, errSave
+	}
+
+	return tmpl, nil
+}
+
+func (u *Appeals) DeleteTemplate(ctx context.Context, appealTemplateID int32) error {
+	return u.AppealRepository.DeleteTemplate(ctx, appealTemplateID)
+}
+
+// RenderTemplate fills in the template with the details of the ban and the current user as the moderator.
+func (u *Appeals) RenderTemplate(ctx context.Context, curUser person.BaseUser, banID int32, appealTemplateID int32) (string, error) {
+	tmpl, errTmpl := u.Template(ctx, appealTemplateID)
+	if errTmpl != nil {
+		return "", errTmpl
+	}
+
+	ban, errBan := u.bans.QueryOne(ctx, QueryOpts{BanID: banID, Deleted: true, EvadeOk: true})
+	if errBan != nil {
+		return "", errBan
+	}
+
+	return RenderAppealTemplate(tmpl.BodyMD, NewAppealTemplateData(ban, curUser.GetName()))
+}
+
+// Remind notifies moderators of open appeals which have been waiting on a staff reply for longer than
+// after. The assignee is notified when there is one, otherwise all moderators are. Appeals are reminded
+// about again each time the period elapses without a reply. A zero duration disables reminders.
+func (u *Appeals) Remind(ctx context.Context, after time.Duration) error {
+	if after <= 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	overdue, errOverdue := u.Overdue(ctx, now.Add(-after))
+	if errOverdue != nil {
+		return errOverdue
+	}
+
+	for _, appeal := range overdue {
+		msg := fmt.Sprintf("Ban appeal has been waiting on a reply since %s", datetime.FmtTimeShort(appeal.WaitingSince))
+
+		if appeal.AssigneeID.Valid() {
+			u.notif.Send(notification.NewSiteUser([]steamid.SteamID{appeal.AssigneeID}, notification.Warn, msg, link.Path(appeal)))
+		} else {
+			u.notif.Send(notification.NewSiteGroup(
+				[]permission.Privilege{permission.Moderator, permission.Admin}, notification.Warn, msg, link.Path(appeal)))
+		}
+
+		u.notif.Send(notification.NewDiscord(u.logChannelID, newAppealReminderMessage(appeal)))
+
+		if errReminded := u.SetReminded(ctx, appeal.BanID, now); errReminded != nil {
+			return errReminded
+		}
+	}
+
+	if len(overdue) > 0 {
+		slog.Info("Sent appeal reminders", slog.Int("count", len(overdue)))
+	}
+
+	return nil
+}
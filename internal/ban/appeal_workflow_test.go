@@ -0,0 +1,59 @@
+package ban_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban"
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyAppealState(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	existing := ban.Ban{BanID: 1, ValidUntil: now.Add(30 * 24 * time.Hour)}
+
+	denied, errDenied := ban.ApplyAppealState(existing, ban.Denied, now.Add(time.Hour), now)
+	require.NoError(t, errDenied)
+	require.Equal(t, ban.Denied, denied.AppealState)
+	require.Equal(t, existing.ValidUntil, denied.ValidUntil)
+
+	reduced, errReduced := ban.ApplyAppealState(existing, ban.Reduced, now.Add(24*time.Hour), now)
+	require.NoError(t, errReduced)
+	require.Equal(t, ban.Reduced, reduced.AppealState)
+	require.Equal(t, now.Add(24*time.Hour), reduced.ValidUntil)
+
+	_, errPast := ban.ApplyAppealState(existing, ban.Reduced, now.Add(-time.Hour), now)
+	require.ErrorIs(t, errPast, ban.ErrAppealReduced)
+
+	_, errLonger := ban.ApplyAppealState(existing, ban.Reduced, existing.ValidUntil.Add(time.Hour), now)
+	require.ErrorIs(t, errLonger, ban.ErrAppealReduced)
+
+	_, errState := ban.ApplyAppealState(existing, ban.AnyState, time.Time{}, now)
+	require.ErrorIs(t, errState, ban.ErrAppealState)
+}
+
+func TestRenderAppealTemplate(t *testing.T) {
+	t.Parallel()
+
+	data := ban.NewAppealTemplateData(ban.Ban{
+		BanID:             10,
+		TargetPersonaname: "player",
+		BanType:           bantype.Banned,
+		Reason:            reason.Cheating,
+		ValidUntil:        time.Now().AddDate(10, 0, 0),
+	}, "mod")
+
+	body, errRender := ban.RenderAppealTemplate("Hi {{ .Name }}, your ban for {{ .Reason }} expires: {{ .ValidUntil }}. - {{ .Moderator }}", data)
+	require.NoError(t, errRender)
+	require.Equal(t, "Hi player, your ban for "+reason.Cheating.String()+" expires: "+ban.Permanent+". - mod", body)
+
+	_, errUnknown := ban.RenderAppealTemplate("{{ .Unknown }}", data)
+	require.ErrorIs(t, errUnknown, ban.ErrAppealTemplate)
+
+	_, errParse := ban.RenderAppealTemplate("{{ .Name ", data)
+	require.ErrorIs(t, errParse, ban.ErrAppealTemplate)
+}
@@ -9,6 +9,7 @@ import (
 	"net"
 	"net/http"
 	"net/netip"
+	"strings"
 	"sync"
 	"time"
 
@@ -46,6 +47,14 @@ type Config struct {
 	AuthorizedKeys string
 }
 
+type AppealConfig struct {
+	sync.RWMutex
+
+	// ReminderDays is how long an open appeal may wait for a staff reply before a reminder is sent,
+	// 0 disables reminders.
+	ReminderDays int32
+}
+
 // Origin defines the origin of the ban or action.
 type Origin int
 
@@ -294,10 +303,11 @@ func (s Bans) QueryOne(ctx context.Context, opts QueryOpts) (Ban, error) {
 	return results[0], nil
 }
 
-func (s Bans) Save(ctx context.Context, ban *Ban) error {
-	oldState := Open
+// Save updates the ban, recording any changes made by the author in the bans history.
+func (s Bans) Save(ctx context.Context, author steamid.SteamID, ban *Ban) error {
+	var existing Ban
 	if ban.BanID > 0 {
-		existing, errExisting := s.QueryOne(ctx, QueryOpts{
+		found, errExisting := s.QueryOne(ctx, QueryOpts{
 			BanID:      ban.BanID,
 			EvadeOk:    true,
 			Deleted:    true,
@@ -309,33 +319,89 @@ func (s Bans) Save(ctx context.Context, ban *Ban) error {
 			return errExisting
 		}
 
-		oldState = existing.AppealState
+		existing = found
 	}
 
+	oldState := existing.AppealState
+
 	if err := s.repo.Save(ctx, ban); err != nil {
 		return err
 	}
 
-	if oldState != ban.AppealState {
-		s.notif.Send(notification.NewSiteGroup(
-			[]permission.Privilege{permission.Moderator, permission.Admin},
-			notification.Info,
-			fmt.Sprintf("Ban appeal state changed: %s -> %s", oldState, ban.AppealState),
-			link.Path(ban)))
+	if existing.BanID > 0 {
+		entry, errHistory := s.recordHistory(ctx, author, existing, *ban)
+		if errHistory != nil {
+			slog.Error("Failed to save ban history", slog.String("error", errHistory.Error()))
+		} else if len(entry.Changes) > 0 {
+			s.notif.Send(notification.NewDiscord(s.logChannelID, banEditedMessage(*ban, entry)))
+		}
+	}
 
-		s.notif.Send(notification.NewSiteUser(
-			[]steamid.SteamID{ban.TargetID},
-			notification.Info,
-			fmt.Sprintf("Your mute/ban appeal status has changed: %s -> %s", oldState, ban.AppealState),
-			link.Path(ban)))
+	s.notifyAppealState(oldState, *ban)
+
+	return nil
+}
+
+// SaveAppealState updates the appeal state and expiry of the ban. The change is recorded in the bans
+// history within the same transaction.
+func (s Bans) SaveAppealState(ctx context.Context, author steamid.SteamID, before Ban, after *Ban) error {
+	after.UpdatedOn = time.Now()
+
+	entry := HistoryEntry{
+		BanID:     after.BanID,
+		AuthorID:  author,
+		Changes:   Diff(before, *after),
+		CreatedOn: after.UpdatedOn,
 	}
 
+	if err := s.repo.SaveAppealState(ctx, after, &entry); err != nil {
+		return err
+	}
+
+	if len(entry.Changes) > 0 {
+		s.notif.Send(notification.NewDiscord(s.logChannelID, banEditedMessage(*after, entry)))
+	}
+
+	s.notifyAppealState(before.AppealState, *after)
+
 	return nil
 }
 
+func (s Bans) notifyAppealState(oldState AppealState, ban Ban) {
+	if oldState == ban.AppealState {
+		return
+	}
+
+	s.notif.Send(notification.NewSiteGroup(
+		[]permission.Privilege{permission.Moderator, permission.Admin},
+		notification.Info,
+		fmt.Sprintf("Ban appeal state changed: %s -> %s", oldState, ban.AppealState),
+		link.Path(ban)))
+
+	s.notif.Send(notification.NewSiteUser(
+		[]steamid.SteamID{ban.TargetID},
+		notification.Info,
+		fmt.Sprintf("Your mute/ban appeal status has changed: %s -> %s", oldState, ban.AppealState),
+		link.Path(ban)))
+}
+
 // Create will ban the steam id from all servers. Players are immediately kicked from servers
-// once executed. If duration is 0, the value of Config.DefaultExpiration() will be used.
+// once executed. When no ValidUntil is given, the ban type and duration are taken from the
+// escalation ladder of the reason when one applies to the targets prior bans.
 func (s Bans) Create(ctx context.Context, opts Opts) (Ban, error) {
+	if opts.ValidUntil.IsZero() {
+		suggestion, errSuggest := s.Suggest(ctx, opts.TargetID, opts.Reason)
+		if errSuggest != nil {
+			return Ban{}, errSuggest
+		}
+
+		if suggestion.Matched {
+			opts.BanType = suggestion.Step.BanType
+			opts.ValidUntil = suggestion.ValidUntil
+			opts.Note = strings.TrimSpace(fmt.Sprintf("%s\n\nEscalation: offense #%d", opts.Note, suggestion.Offense))
+		}
+	}
+
 	if errValidate := opts.Validate(); errValidate != nil {
 		return Ban{}, errValidate
 	}
@@ -457,6 +523,7 @@ func (s Bans) Unban(ctx context.Context, targetSID steamid.SteamID, reason strin
 		return false, errors.Join(errGetBan, ErrGetBan)
 	}
 
+	before := playerBan
 	playerBan.Deleted = true
 	playerBan.UnbanReasonText = reason
 
@@ -464,6 +531,10 @@ func (s Bans) Unban(ctx context.Context, targetSID steamid.SteamID, reason strin
 		return false, errors.Join(errSave, ErrSaveBan)
 	}
 
+	if _, errHistory := s.recordHistory(ctx, author.GetSteamID(), before, playerBan); errHistory != nil {
+		slog.Error("Failed to save ban history", slog.String("error", errHistory.Error()))
+	}
+
 	player, err := s.persons.GetOrCreatePersonBySteamID(ctx, targetSID)
 	if err != nil {
 		return false, errors.Join(err, ErrFetchPerson)
@@ -516,15 +587,71 @@ func (s Bans) CheckEvadeStatus(ctx context.Context, steamID steamid.SteamID, add
 		return false, errMatch
 	}
 
+	// Evading the ban of an address makes the ban permanent.
 	existing.Note += " Previous expiry: " + existing.ValidUntil.Format(time.DateTime)
 	existing.ValidUntil = time.Now().AddDate(10, 0, 0)
 
-	if errSave := s.Save(ctx, &existing); errSave != nil {
+	if errSave := s.Save(ctx, s.owner, &existing); errSave != nil {
 		slog.Error("Could not update previous ban.", slog.String("error", errSave.Error()))
 
 		return false, errSave
 	}
 
+	return s.evadeBan(ctx, steamID, existing, "Connecting from same IP as banned player.")
+}
+
+// CheckLinkedEvadeStatus checks if any of the linked accounts, such as likely alts, is currently banned. Only direct
+// links are trusted enough to ban the player for evasion of the first ban found. A ban of an indirect link, found
+// through another alt, is flagged to moderators for review instead. Like CheckEvadeStatus, the caller is expected
+// to fail-open on errors.
+func (s Bans) CheckLinkedEvadeStatus(ctx context.Context, steamID steamid.SteamID, direct []steamid.SteamID, indirect []steamid.SteamID) (bool, error) {
+	existing, found, errDirect := s.linkedBan(ctx, direct)
+	if errDirect != nil {
+		return false, errDirect
+	}
+
+	if found {
+		return s.evadeBan(ctx, steamID, existing, "Linked to banned player by shared connection history.")
+	}
+
+	existing, found, errIndirect := s.linkedBan(ctx, indirect)
+	if errIndirect != nil || !found {
+		return false, errIndirect
+	}
+
+	s.notif.Send(notification.NewSiteGroup(
+		[]permission.Privilege{permission.Moderator, permission.Admin},
+		notification.Warn,
+		fmt.Sprintf("Possible ban evasion by %s, indirectly linked to banned player %s", steamID.String(), existing.TargetID.String()),
+		link.Path(existing)))
+
+	return false, nil
+}
+
+// linkedBan returns the first active ban of the linked accounts.
+func (s Bans) linkedBan(ctx context.Context, linked []steamid.SteamID) (Ban, bool, error) {
+	for _, linkedID := range linked {
+		existing, errMatch := s.QueryOne(ctx, QueryOpts{TargetID: linkedID, LatestOnly: true})
+		if errMatch != nil {
+			if errors.Is(errMatch, ErrBanDoesNotExist) || errors.Is(errMatch, database.ErrNoResult) {
+				continue
+			}
+
+			return Ban{}, false, errMatch
+		}
+
+		if existing.BanType != bantype.Banned || existing.EvadeOk || existing.ValidUntil.Before(time.Now()) {
+			continue
+		}
+
+		return existing, true, nil
+	}
+
+	return Ban{}, false, nil
+}
+
+// evadeBan permanently bans the player evading the existing ban.
+func (s Bans) evadeBan(ctx context.Context, steamID steamid.SteamID, existing Ban, note string) (bool, error) {
 	req := Opts{
 		SourceID:   s.owner,
 		TargetID:   steamID,
@@ -532,7 +659,7 @@ func (s Bans) CheckEvadeStatus(ctx context.Context, steamID steamid.SteamID, add
 		ValidUntil: time.Now().AddDate(10, 0, 0),
 		BanType:    bantype.Banned,
 		Reason:     reason.Evading,
-		Note:       fmt.Sprintf("Connecting from same IP as banned player.\n\nEvasion of: [#%d](%s)", existing.BanID, link.Path(existing)),
+		Note:       fmt.Sprintf("%s\n\nEvasion of: [#%d](%s)", note, existing.BanID, link.Path(existing)),
 	}
 
 	_, errSave := s.Create(ctx, req)
@@ -0,0 +1,66 @@
+package ban
+
+import (
+	"context"
+	"time"
+)
+
+// AuditAction identifies a single step that was performed against a ban outside the normal edit flow.
+type AuditAction string
+
+const (
+	AuditExpired       AuditAction = "expired"
+	AuditNotifyDiscord AuditAction = "notify_discord"
+	AuditNotifySite    AuditAction = "notify_site"
+	AuditLiftComm      AuditAction = "lift_comm"
+)
+
+// AuditStatus is the outcome of an AuditAction.
+type AuditStatus string
+
+const (
+	AuditSuccess AuditStatus = "success"
+	AuditFailed  AuditStatus = "failed"
+	// AuditQueued is used for notifications, which are handed off to the notifier without waiting for delivery.
+	AuditQueued AuditStatus = "queued"
+)
+
+// AuditEntry records the outcome of an AuditAction for a ban.
+type AuditEntry struct {
+	AuditID   int64
+	BanID     int32
+	Action    AuditAction
+	Status    AuditStatus
+	Message   string
+	CreatedOn time.Time
+}
+
+func NewAuditEntry(banID int32, action AuditAction, err error, message string) AuditEntry {
+	entry := AuditEntry{
+		BanID:     banID,
+		Action:    action,
+		Status:    AuditSuccess,
+		Message:   message,
+		CreatedOn: time.Now(),
+	}
+
+	if err != nil {
+		entry.Status = AuditFailed
+		entry.Message = err.Error()
+	}
+
+	return entry
+}
+
+// NewQueuedAuditEntry records an action whose outcome is not known yet.
+func NewQueuedAuditEntry(banID int32, action AuditAction, message string) AuditEntry {
+	entry := NewAuditEntry(banID, action, nil, message)
+	entry.Status = AuditQueued
+
+	return entry
+}
+
+// Audit returns the audit log for a ban, oldest first.
+func (s Bans) Audit(ctx context.Context, banID int32) ([]AuditEntry, error) {
+	return s.repo.Audit(ctx, banID)
+}
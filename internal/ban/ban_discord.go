@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/netip"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -102,8 +103,13 @@ func createBanOpts() []discordgo.SelectMenuOption {
 	}
 }
 
+// escalationDuration is the duration option used to apply the escalation ladder of the selected reason. Selecting
+// any other duration overrides the ladder.
+const escalationDuration = "escalation"
+
 func createDurationOpts() []discordgo.SelectMenuOption {
 	return []discordgo.SelectMenuOption{
+		{Label: "Escalation Policy", Value: escalationDuration, Description: "Suggested duration based on prior bans for the reason", Default: true},
 		{Label: "15 Mins", Value: "PT15M"},
 		{Label: "6 Hours", Value: "PT6H"},
 		{Label: "12 Hours", Value: "PT12H"},
@@ -122,11 +128,11 @@ func createDurationOpts() []discordgo.SelectMenuOption {
 }
 
 type banModalOpts struct {
-	TargetID steamid.SteamID    `id:"1"`
-	CIDR     *netip.Prefix      `id:"2"`
-	Reason   reason.Reason      `id:"3"`
-	Duration *duration.Duration `id:"4"`
-	Note     string             `id:"5"`
+	TargetID steamid.SteamID `id:"1"`
+	CIDR     *netip.Prefix   `id:"2"`
+	Reason   reason.Reason   `id:"3"`
+	Duration string          `id:"4"`
+	Note     string          `id:"5"`
 }
 
 func (h discordHandler) createBanModal(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) error {
@@ -170,6 +176,12 @@ func (h discordHandler) showBan(_ context.Context, session *discordgo.Session, i
 	)
 }
 
+type banSuccessView struct {
+	Mute       bool
+	Link       string
+	Suggestion *Suggestion
+}
+
 func (h discordHandler) onBanResponse(ctx context.Context, session *discordgo.Session, interaction *discordgo.InteractionCreate) error {
 	if err := discord.AckInteraction(session, interaction); err != nil {
 		return err
@@ -197,10 +209,30 @@ func (h discordHandler) onBanResponse(ctx context.Context, session *discordgo.Se
 		ReasonText: "",
 		TargetID:   values.TargetID,
 		Reason:     values.Reason,
-		ValidUntil: time.Now().Add(values.Duration.ToTimeDuration()),
 		Note:       values.Note,
 	}
 
+	view := banSuccessView{}
+	if values.Duration == escalationDuration || values.Duration == "" {
+		suggestion, errSuggest := h.Suggest(ctx, values.TargetID, values.Reason)
+		if errSuggest != nil {
+			return errSuggest
+		}
+
+		if !suggestion.Matched {
+			return fmt.Errorf("%w: no escalation policy for reason %s, select a duration", ErrInvalidBanDuration, values.Reason)
+		}
+
+		view.Suggestion = &suggestion
+	} else {
+		banDuration, errDuration := duration.Parse(values.Duration)
+		if errDuration != nil {
+			return errors.Join(errDuration, ErrInvalidBanDuration)
+		}
+
+		banOpts.ValidUntil = time.Now().Add(banDuration.ToTimeDuration())
+	}
+
 	if values.CIDR != nil {
 		prefix := values.CIDR.String()
 		banOpts.CIDR = &prefix
@@ -216,7 +248,10 @@ func (h discordHandler) onBanResponse(ctx context.Context, session *discordgo.Se
 		return discord.ErrCommandFailed
 	}
 
-	content, errContent := discord.RenderTemplate("ban_success", templateBody)
+	view.Mute = createdBan.BanType == bantype.NoComm
+	view.Link = link.Path(createdBan)
+
+	content, errContent := discord.RenderTemplate("ban_success", view)
 	if errContent != nil {
 		return errContent
 	}
@@ -435,6 +470,59 @@ func createBanResponse(ban Ban, author person.Info, player person.Info) *discord
 			discord.Link("🌐 Steam", "https://steamcommunity.com/profiles/"+ban.TargetID.String())))
 }
 
+type banExpiresView struct {
+	Ban     Ban
+	Player  person.Info
+	SteamID string
+}
+
+// BanExpiresMessage is sent to the log channel once a ban or mute has run its course.
+func BanExpiresMessage(ban Ban, player person.Info) *discordgo.MessageSend {
+	return discord.NewMessage(
+		discord.BodyTextWithThumbnail(discord.ColourSuccess,
+			discord.PlayerThumbnail(player),
+			"ban_expired",
+			banExpiresView{
+				Ban:     ban,
+				Player:  player,
+				SteamID: player.GetSteamIDString(),
+			}),
+		discord.Buttons(
+			discord.Link("🔎 View", link.Path(ban)),
+			discord.Link("🌐 Steam", "https://steamcommunity.com/profiles/"+ban.TargetID.String())))
+}
+
+type banEditedView struct {
+	Ban     Ban
+	History HistoryEntry
+}
+
+// banEditedMessage shows a compact before/after of the fields changed by an edit.
+func banEditedMessage(ban Ban, entry HistoryEntry) *discordgo.MessageSend {
+	// Notes can be arbitrarily long, only show enough to identify the change.
+	const maxLen = 100
+
+	entry.Changes = slices.Clone(entry.Changes)
+	for idx, change := range entry.Changes {
+		if runes := []rune(change.Old); len(runes) > maxLen {
+			entry.Changes[idx].Old = string(runes[:maxLen]) + "…"
+		}
+
+		if runes := []rune(change.New); len(runes) > maxLen {
+			entry.Changes[idx].New = string(runes[:maxLen]) + "…"
+		}
+	}
+
+	content, errContent := discord.RenderTemplate("ban_edited", banEditedView{Ban: ban, History: entry})
+	if errContent != nil {
+		slog.Error("Failed to render ban edited message", slog.String("error", errContent.Error()))
+	}
+
+	return discord.NewMessage(
+		discord.BodyColouredText(discord.ColourWarn, content),
+		discord.Buttons(discord.Link("🔎 View", link.Path(ban))))
+}
+
 type deleteReportMessageView struct {
 	Existing ReportMessage
 	Person   person.BaseUser
@@ -3,29 +3,28 @@ package ban
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"time"
 
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/config/link"
 	"github.com/leighmacdonald/gbans/internal/database"
-	"github.com/leighmacdonald/gbans/internal/domain/person"
 	"github.com/leighmacdonald/gbans/internal/notification"
+	"github.com/leighmacdonald/gbans/internal/servers"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 )
 
-func NewExpirationMonitor(steam Bans, person person.Provider, notifications notification.Notifier) *ExpirationMonitor {
-	return &ExpirationMonitor{
-		steam:         steam,
-		person:        person,
-		notifications: notifications,
-	}
+func NewExpirationMonitor(bans Bans) *ExpirationMonitor {
+	return &ExpirationMonitor{bans: bans}
 }
 
 type ExpirationMonitor struct {
-	steam         Bans
-	person        person.Provider
-	notifications notification.Notifier
+	bans Bans
 }
 
 func (monitor *ExpirationMonitor) Update(ctx context.Context) {
-	expiredBans, errExpiredBans := monitor.steam.Expired(ctx)
+	expiredBans, errExpiredBans := monitor.bans.Expired(ctx)
 	if errExpiredBans != nil && !errors.Is(errExpiredBans, database.ErrNoResult) {
 		slog.Error("Failed to get expired expiredBans", slog.String("error", errExpiredBans.Error()))
 
@@ -33,35 +32,76 @@ func (monitor *ExpirationMonitor) Update(ctx context.Context) {
 	}
 
 	for _, expiredBan := range expiredBans {
-		ban := expiredBan
-		if errDrop := monitor.steam.Delete(ctx, &ban, false); errDrop != nil {
-			slog.Error("Failed to drop expired expiredBan", slog.String("error", errDrop.Error()))
-
-			continue
+		if errExpire := monitor.bans.Expire(ctx, expiredBan); errExpire != nil {
+			slog.Error("Failed to expire ban", slog.String("error", errExpire.Error()),
+				slog.Int("ban_id", int(expiredBan.BanID)))
 		}
+	}
+}
 
-		player, errPerson := monitor.person.GetOrCreatePersonBySteamID(ctx, ban.TargetID)
-		if errPerson != nil {
-			slog.Error("Failed to get expired Person", slog.String("error", errPerson.Error()))
+// Expire soft-deletes a ban that has reached its expiry, notifies the player and log channel, and lifts any
+// active mute/gag on the server the player is currently connected to. Each step is recorded in the bans audit log.
+// The player is loaded first so that a lookup failure leaves the ban in place to be retried on the next update.
+func (s Bans) Expire(ctx context.Context, ban Ban) error {
+	player, errPerson := s.persons.GetOrCreatePersonBySteamID(ctx, ban.TargetID)
+	if errPerson != nil {
+		return errors.Join(errPerson, ErrFetchPerson)
+	}
 
-			continue
-		}
+	before := ban
+	if errDrop := s.repo.Delete(ctx, &ban, false); errDrop != nil {
+		return errDrop
+	}
 
-		name := player.Name
-		if name == "" {
-			name = player.SteamID.String()
-		}
+	if _, errHistory := s.recordHistory(ctx, steamid.SteamID{}, before, ban); errHistory != nil {
+		slog.Error("Failed to save ban history", slog.String("error", errHistory.Error()))
+	}
 
-		// monitor.notifications.Send(notification.NewDiscord("", discord.BanExpiresMessage(ban, person, monitor.config.ExtURL(ban))))
+	s.audit(ctx, NewAuditEntry(ban.BanID, AuditExpired, nil, "Valid until "+ban.ValidUntil.Format(time.DateTime)))
 
-		// monitor.notifications.Enqueue(ctx, notification.NewSiteUserNotification(
-		// 	[]steamid.SteamID{person.SteamID},
-		// 	notification.SeverityInfo,
-		// 	"Your mute/ban period has expired",
-		// 	link.Path(ban)))
+	s.notif.Send(notification.NewDiscord(s.logChannelID, BanExpiresMessage(ban, player)))
+	s.audit(ctx, NewQueuedAuditEntry(ban.BanID, AuditNotifyDiscord, s.logChannelID))
+
+	s.notif.Send(notification.NewSiteUser(
+		[]steamid.SteamID{ban.TargetID},
+		notification.Info,
+		"Your mute/ban period has expired",
+		link.Path(ban)))
+	s.audit(ctx, NewQueuedAuditEntry(ban.BanID, AuditNotifySite, ""))
+
+	if ban.BanType == bantype.NoComm {
+		s.audit(ctx, s.liftComm(ctx, ban))
+	}
+
+	slog.Info("Ban expired",
+		slog.String("reason", ban.Reason.String()),
+		slog.String("sid64", ban.TargetID.String()), slog.String("name", player.GetName()))
+
+	return nil
+}
+
+// liftComm removes the mute/gag from the player if they are currently connected to a server. Otherwise, the
+// expired ban is simply not applied the next time they connect.
+func (s Bans) liftComm(ctx context.Context, ban Ban) AuditEntry {
+	if s.servers == nil {
+		return NewAuditEntry(ban.BanID, AuditLiftComm, nil, "Server state unavailable")
+	}
+
+	result, found := s.servers.FindPlayer(servers.FindOpts{SteamID: ban.TargetID})
+	if !found {
+		return NewAuditEntry(ban.BanID, AuditLiftComm, nil, "Player not connected")
+	}
+
+	if errLift := result.Server.Unsilence(ctx, ban.TargetID); errLift != nil {
+		return NewAuditEntry(ban.BanID, AuditLiftComm, errLift, "")
+	}
+
+	return NewAuditEntry(ban.BanID, AuditLiftComm, nil, fmt.Sprintf("Lifted on %s", result.Server.ShortName))
+}
 
-		slog.Info("Ban expired",
-			slog.String("reason", ban.Reason.String()),
-			slog.String("sid64", ban.TargetID.String()), slog.String("name", name))
+func (s Bans) audit(ctx context.Context, entry AuditEntry) {
+	if errAudit := s.repo.SaveAudit(ctx, &entry); errAudit != nil {
+		slog.Error("Failed to save ban audit entry", slog.String("error", errAudit.Error()),
+			slog.Int("ban_id", int(entry.BanID)), slog.String("action", string(entry.Action)))
 	}
 }
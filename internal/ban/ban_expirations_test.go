@@ -0,0 +1,140 @@
+package ban_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/anticheat"
+	"github.com/leighmacdonald/gbans/internal/asset"
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/ban"
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/gbans/internal/chat"
+	"github.com/leighmacdonald/gbans/internal/demo"
+	personDomain "github.com/leighmacdonald/gbans/internal/domain/person"
+	"github.com/leighmacdonald/gbans/internal/maps"
+	"github.com/leighmacdonald/gbans/internal/notification"
+	"github.com/leighmacdonald/gbans/internal/person"
+	"github.com/leighmacdonald/gbans/internal/servers"
+	"github.com/leighmacdonald/gbans/internal/stats"
+	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingNotifier keeps every payload it is sent.
+type recordingNotifier struct {
+	mu       sync.Mutex
+	payloads []notification.Payload
+}
+
+func (n *recordingNotifier) Send(payload notification.Payload) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.payloads = append(n.payloads, payload)
+}
+
+func (n *recordingNotifier) count() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return len(n.payloads)
+}
+
+// unavailablePersons fails every player lookup.
+type unavailablePersons struct {
+	personDomain.Provider
+}
+
+func (unavailablePersons) GetOrCreatePersonBySteamID(_ context.Context, _ steamid.SteamID) (personDomain.Core, error) {
+	return personDomain.Core{}, errors.New("steam api unavailable")
+}
+
+func newTestBans(t *testing.T, persons personDomain.Provider, notif notification.Notifier) ban.Bans {
+	t.Helper()
+
+	var (
+		assets  = asset.NewAssets(asset.NewLocalRepository(fixture.Database, t.TempDir()))
+		filters = chat.NewWordFilters(chat.NewWordFilterRepository(fixture.Database), notification.NewDiscard(), fixture.Config.Config().Filters)
+		chats   = chat.New(chat.NewRepository(fixture.Database), fixture.Config.Config().Filters, filters, fixture.Persons, notification.NewDiscard(), nil, "")
+		stat    = stats.New(stats.NewRepository(fixture.Database), maps.New(maps.NewRepository(fixture.Database)))
+		demos   = demo.NewDemos(asset.BucketDemo, demo.NewRepository(fixture.Database),
+			assets, stat, chats, fixture.Persons, fixture.Config.Config().Demo, steamid.New(fixture.Config.Config().Owner))
+		reports = ban.NewReports(ban.NewReportRepository(fixture.Database), ban.NewRepository(fixture.Database),
+			person.NewPersons(person.NewRepository(fixture.Database, true), steamid.New(tests.OwnerSID), fixture.TFApi),
+			demos, chats, anticheat.New(anticheat.NewRepository(fixture.Database), fixture.Config.Config().Anticheat,
+				notification.NewDiscard(), nil, fixture.Persons),
+			fixture.TFApi, notification.NewDiscard(), "")
+		serversCase, _ = servers.New(servers.NewRepository(fixture.Database), nil, nil, steamid.SteamID{}, "")
+	)
+
+	return ban.New(ban.NewRepository(fixture.Database), persons,
+		fixture.Config.Config().Discord.BanLogChannelID, fixture.Config.Config().Discord.KickLogChannelID,
+		steamid.New(fixture.Config.Config().Owner), reports, notif, serversCase, tests.EmptyIPProvider{})
+}
+
+func TestExpire(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = t.Context()
+		notif  = &recordingNotifier{}
+		bans   = newTestBans(t, fixture.Persons, notif)
+		source = fixture.CreateTestPerson(ctx, steamid.RandSID64(), permission.Admin)
+		target = fixture.CreateTestPerson(ctx, steamid.RandSID64(), permission.User)
+	)
+
+	muted, errCreate := bans.Create(ctx, ban.Opts{
+		SourceID: source.SteamID, TargetID: target.SteamID, ValidUntil: time.Now().Add(time.Hour),
+		BanType: bantype.NoComm, Reason: reason.Language, Origin: ban.InGame,
+	})
+	require.NoError(t, errCreate)
+
+	sent := notif.count()
+
+	// The player is loaded before anything is changed, so a failed lookup leaves the ban to be retried.
+	unavailable := newTestBans(t, unavailablePersons{Provider: fixture.Persons}, notif)
+	require.ErrorIs(t, unavailable.Expire(ctx, muted), ban.ErrFetchPerson)
+
+	current, errCurrent := bans.QueryOne(ctx, ban.QueryOpts{BanID: muted.BanID})
+	require.NoError(t, errCurrent)
+	require.False(t, current.Deleted)
+	require.Equal(t, sent, notif.count())
+
+	audit, errAudit := bans.Audit(ctx, muted.BanID)
+	require.NoError(t, errAudit)
+	require.Empty(t, audit)
+
+	require.NoError(t, bans.Expire(ctx, muted))
+
+	expired, errExpired := bans.QueryOne(ctx, ban.QueryOpts{BanID: muted.BanID, Deleted: true})
+	require.NoError(t, errExpired)
+	require.True(t, expired.Deleted)
+
+	// The discord log message and site notification.
+	require.Equal(t, sent+2, notif.count())
+
+	audit, errAudit = bans.Audit(ctx, muted.BanID)
+	require.NoError(t, errAudit)
+	require.Len(t, audit, 4)
+
+	for idx, expected := range []struct {
+		action ban.AuditAction
+		status ban.AuditStatus
+	}{
+		{ban.AuditExpired, ban.AuditSuccess},
+		{ban.AuditNotifyDiscord, ban.AuditQueued},
+		{ban.AuditNotifySite, ban.AuditQueued},
+		{ban.AuditLiftComm, ban.AuditSuccess},
+	} {
+		require.Equal(t, expected.action, audit[idx].Action)
+		require.Equal(t, expected.status, audit[idx].Status)
+	}
+
+	require.Equal(t, "Player not connected", audit[3].Message)
+}
@@ -0,0 +1,83 @@
+package ban
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// Change is a single field that differs between two states of a ban.
+type Change struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// HistoryEntry is an append-only record of the changes made to a ban in a single edit.
+type HistoryEntry struct {
+	HistoryID int64
+	BanID     int32
+	// AuthorID is the user who made the change. It is not valid for changes made by the system, e.g. expiry.
+	AuthorID  steamid.SteamID
+	Changes   []Change
+	CreatedOn time.Time
+
+	// AuthorPersonaname is populated when querying history.
+	AuthorPersonaname string
+}
+
+// Diff returns the tracked fields that differ between the before and after states of a ban.
+func Diff(before Ban, after Ban) []Change {
+	var changes []Change
+
+	add := func(field string, oldValue string, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, Change{Field: field, Old: oldValue, New: newValue})
+		}
+	}
+
+	add("ban_type", before.BanType.String(), after.BanType.String())
+	add("valid_until", formatValidUntil(before.ValidUntil), formatValidUntil(after.ValidUntil))
+	add("reason", before.Reason.String(), after.Reason.String())
+	add("reason_text", before.ReasonText, after.ReasonText)
+	add("note", before.Note, after.Note)
+	add("cidr", cidrStr(before.CIDR), cidrStr(after.CIDR))
+	add("evade_ok", strconv.FormatBool(before.EvadeOk), strconv.FormatBool(after.EvadeOk))
+	add("appeal_state", before.AppealState.String(), after.AppealState.String())
+	add("deleted", strconv.FormatBool(before.Deleted), strconv.FormatBool(after.Deleted))
+	add("unban_reason_text", before.UnbanReasonText, after.UnbanReasonText)
+
+	return changes
+}
+
+func formatValidUntil(validUntil time.Time) string {
+	if validUntil.Year()-time.Now().Year() >= 5 {
+		return Permanent
+	}
+
+	return validUntil.Format(time.DateTime)
+}
+
+// History returns the changes made to a ban, oldest first.
+func (s Bans) History(ctx context.Context, banID int32) ([]HistoryEntry, error) {
+	return s.repo.History(ctx, banID)
+}
+
+// recordHistory appends the difference between the two states to the bans history. Nothing is recorded
+// when no tracked fields have changed.
+func (s Bans) recordHistory(ctx context.Context, author steamid.SteamID, before Ban, after Ban) (HistoryEntry, error) {
+	entry := HistoryEntry{
+		BanID:     after.BanID,
+		AuthorID:  author,
+		Changes:   Diff(before, after),
+		CreatedOn: time.Now(),
+	}
+
+	if len(entry.Changes) == 0 {
+		return entry, nil
+	}
+
+	return entry, s.repo.SaveHistory(ctx, &entry)
+}
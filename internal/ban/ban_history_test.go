@@ -0,0 +1,45 @@
+package ban_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban"
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	before := ban.Ban{
+		BanID:      1,
+		BanType:    bantype.Banned,
+		Reason:     reason.Cheating,
+		Note:       "notes",
+		ValidUntil: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	require.Empty(t, ban.Diff(before, before))
+
+	after := before
+	after.ValidUntil = time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	after.Reason = reason.Spam
+	after.EvadeOk = true
+	after.CIDR = new("192.0.2.0/24")
+
+	require.Equal(t, []ban.Change{
+		{Field: "valid_until", Old: "2025-01-01 00:00:00", New: "2025-01-02 00:00:00"},
+		{Field: "reason", Old: reason.Cheating.String(), New: reason.Spam.String()},
+		{Field: "cidr", Old: "", New: "192.0.2.0/24"},
+		{Field: "evade_ok", Old: "false", New: "true"},
+	}, ban.Diff(before, after))
+
+	unbanned := before
+	unbanned.Deleted = true
+	unbanned.UnbanReasonText = "appeal accepted"
+
+	require.Equal(t, []ban.Change{
+		{Field: "deleted", Old: "false", New: "true"},
+		{Field: "unban_reason_text", Old: "", New: "appeal accepted"},
+	}, ban.Diff(before, unbanned))
+}
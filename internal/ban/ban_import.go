@@ -0,0 +1,34 @@
+package ban
+
+import (
+	"context"
+	"fmt"
+)
+
+// Import stores a ban from an external source as-is, bypassing escalation, notifications and kicks. Returns
+// false if a ban for the target with the same type and creation time already exists, as it has already been
+// imported. An active ban is stored as deleted when the target already has an active ban of the same or higher
+// type. When dryRun is set, the checks are performed without storing the ban.
+func (s Bans) Import(ctx context.Context, ban *Ban, dryRun bool) (bool, error) {
+	existing, errExisting := s.repo.Query(ctx, QueryOpts{TargetID: ban.TargetID, Deleted: true, EvadeOk: true})
+	if errExisting != nil {
+		return false, errExisting
+	}
+
+	for _, current := range existing {
+		if current.BanType == ban.BanType && current.CreatedOn.Equal(ban.CreatedOn) {
+			return false, nil
+		}
+
+		if !ban.Deleted && !current.Deleted && !current.Expired() && current.BanType >= ban.BanType {
+			ban.Deleted = true
+			ban.UnbanReasonText = fmt.Sprintf("Superseded by existing ban #%d", current.BanID)
+		}
+	}
+
+	if dryRun {
+		return true, nil
+	}
+
+	return true, s.repo.Import(ctx, ban)
+}
@@ -7,6 +7,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
 	"github.com/leighmacdonald/gbans/internal/database"
 	"github.com/leighmacdonald/gbans/internal/database/query"
 	"github.com/leighmacdonald/steamid/v4/steamid"
@@ -301,3 +302,184 @@ func (r Repository) GetOlderThan(ctx context.Context, filter query.Filter, since
 
 	return bans, nil
 }
+
+func (r Repository) SaveAudit(ctx context.Context, entry *AuditEntry) error {
+	return database.Err(r.QueryRow(ctx, `
+		INSERT INTO ban_audit (ban_id, action, status, message, created_on)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING audit_id`,
+		entry.BanID, entry.Action, entry.Status, entry.Message, entry.CreatedOn).Scan(&entry.AuditID))
+}
+
+func (r Repository) Audit(ctx context.Context, banID int32) ([]AuditEntry, error) {
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT audit_id, ban_id, action, status, message, created_on
+		FROM ban_audit
+		WHERE ban_id = $1
+		ORDER BY audit_id`, banID)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	entries := []AuditEntry{}
+	for rows.Next() {
+		var entry AuditEntry
+		if err := rows.Scan(&entry.AuditID, &entry.BanID, &entry.Action, &entry.Status, &entry.Message, &entry.CreatedOn); err != nil {
+			return nil, errors.Join(err, database.ErrScanResult)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (r Repository) SaveHistory(ctx context.Context, entry *HistoryEntry) error {
+	var authorID *int64
+	if entry.AuthorID.Valid() {
+		authorID = new(entry.AuthorID.Int64())
+	}
+
+	return database.Err(r.QueryRow(ctx, `
+		INSERT INTO ban_history (ban_id, author_id, changes, created_on)
+		VALUES ($1, $2, $3::jsonb, $4)
+		RETURNING history_id`,
+		entry.BanID, authorID, entry.Changes, entry.CreatedOn).Scan(&entry.HistoryID))
+}
+
+// SaveAppealState updates the appeal state and expiry of the ban, inserting the history entry in the same
+// transaction when it has any changes.
+func (r Repository) SaveAppealState(ctx context.Context, ban *Ban, entry *HistoryEntry) error {
+	return database.Err(r.WrapTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `UPDATE ban SET appeal_state = $2, valid_until = $3, updated_on = $4 WHERE ban_id = $1`,
+			ban.BanID, ban.AppealState, ban.ValidUntil, ban.UpdatedOn); err != nil {
+			return err
+		}
+
+		if len(entry.Changes) == 0 {
+			return nil
+		}
+
+		var authorID *int64
+		if entry.AuthorID.Valid() {
+			authorID = new(entry.AuthorID.Int64())
+		}
+
+		return tx.QueryRow(ctx, `
+			INSERT INTO ban_history (ban_id, author_id, changes, created_on)
+			VALUES ($1, $2, $3::jsonb, $4)
+			RETURNING history_id`,
+			entry.BanID, authorID, entry.Changes, entry.CreatedOn).Scan(&entry.HistoryID)
+	}))
+}
+
+func (r Repository) History(ctx context.Context, banID int32) ([]HistoryEntry, error) {
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT h.history_id, h.ban_id, h.author_id, coalesce(p.personaname, ''), h.changes, h.created_on
+		FROM ban_history h
+		LEFT JOIN person p ON p.steam_id = h.author_id
+		WHERE h.ban_id = $1
+		ORDER BY h.history_id`, banID)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	entries := []HistoryEntry{}
+	for rows.Next() {
+		var (
+			entry    HistoryEntry
+			authorID *int64
+		)
+
+		if err := rows.Scan(&entry.HistoryID, &entry.BanID, &authorID, &entry.AuthorPersonaname, &entry.Changes, &entry.CreatedOn); err != nil {
+			return nil, errors.Join(err, database.ErrScanResult)
+		}
+
+		if authorID != nil {
+			entry.AuthorID = steamid.New(*authorID)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// CountBans returns the number of bans the target has ever received for the reason, including
+// expired and deleted bans.
+func (r Repository) CountBans(ctx context.Context, targetID steamid.SteamID, banReason reason.Reason) (int32, error) {
+	var count int32
+
+	if err := r.QueryRow(ctx, `SELECT count(*) FROM ban WHERE target_id = $1 AND reason = $2`,
+		targetID.Int64(), banReason).Scan(&count); err != nil {
+		return 0, database.Err(err)
+	}
+
+	return count, nil
+}
+
+func (r Repository) Escalations(ctx context.Context) ([]EscalationStep, error) {
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT reason, offense, ban_type, duration_seconds
+		FROM ban_escalation
+		ORDER BY reason, offense`)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	steps := []EscalationStep{}
+	for rows.Next() {
+		var (
+			step     EscalationStep
+			duration int64
+		)
+
+		if err := rows.Scan(&step.Reason, &step.Offense, &step.BanType, &duration); err != nil {
+			return nil, errors.Join(err, database.ErrScanResult)
+		}
+
+		step.Duration = time.Duration(duration) * time.Second
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+func (r Repository) SaveEscalation(ctx context.Context, banReason reason.Reason, ladder []EscalationStep) error {
+	return database.Err(r.WrapTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM ban_escalation WHERE reason = $1`, banReason); err != nil {
+			return err
+		}
+
+		batch := &pgx.Batch{}
+		for _, step := range ladder {
+			batch.Queue(`INSERT INTO ban_escalation (reason, offense, ban_type, duration_seconds) VALUES ($1, $2, $3, $4)`,
+				banReason, step.Offense, step.BanType, int64(step.Duration.Seconds()))
+		}
+
+		return tx.SendBatch(ctx, batch).Close()
+	}))
+}
+
+// Import inserts a ban from an external source, keeping its original timestamps and deleted state.
+func (r Repository) Import(ctx context.Context, ban *Ban) error {
+	const sqlQuery = `
+		INSERT INTO ban (target_id, source_id, ban_type, reason, reason_text, note, valid_until, created_on, updated_on,
+		                 origin, appeal_state, evade_ok, cidr, deleted, unban_reason_text)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING ban_id`
+
+	if ban.CIDR != nil && *ban.CIDR == "" {
+		ban.CIDR = nil
+	}
+
+	return database.Err(r.QueryRow(ctx, sqlQuery, ban.TargetID.Int64(), ban.SourceID.Int64(), ban.BanType, ban.Reason,
+		ban.ReasonText, ban.Note, ban.ValidUntil, ban.CreatedOn, ban.UpdatedOn, ban.Origin, ban.AppealState,
+		ban.EvadeOk, ban.CIDR, ban.Deleted, ban.UnbanReasonText).Scan(&ban.BanID))
+}
@@ -36,12 +36,17 @@ func NewBanService(bans Bans, authMiddleware *rpc.Middleware, option ...connect.
 
 	pattern, handler := banv1connect.NewBanServiceHandler(Service{bans: bans, client: client}, option...)
 
-	authMiddleware.UserRoute(banv1connect.BanServiceQueryProcedure, rpc.WithMinPermissions(permission.Moderator))
-	authMiddleware.UserRoute(banv1connect.BanServiceDeleteProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(banv1connect.BanServiceQueryProcedure, rpc.WithCapability(permission.CapBansView))
+	authMiddleware.UserRoute(banv1connect.BanServiceDeleteProcedure, rpc.WithAnyCapability(permission.CapBans, permission.CapGags))
 	authMiddleware.UserRoute(banv1connect.BanServiceGetProcedure, rpc.WithMinPermissions(permission.User))
-	authMiddleware.UserRoute(banv1connect.BanServiceQuerySourceBansProcedure, rpc.WithMinPermissions(permission.Moderator))
-	authMiddleware.UserRoute(banv1connect.BanServiceUpdateProcedure, rpc.WithMinPermissions(permission.Moderator))
-	authMiddleware.UserRoute(banv1connect.BanServiceCreateProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(banv1connect.BanServiceQuerySourceBansProcedure, rpc.WithCapability(permission.CapBansView))
+	authMiddleware.UserRoute(banv1connect.BanServiceUpdateProcedure, rpc.WithAnyCapability(permission.CapBans, permission.CapGags))
+	authMiddleware.UserRoute(banv1connect.BanServiceCreateProcedure, rpc.WithAnyCapability(permission.CapBans, permission.CapGags))
+	authMiddleware.UserRoute(banv1connect.BanServiceHistoryProcedure, rpc.WithCapability(permission.CapBansView))
+	authMiddleware.UserRoute(banv1connect.BanServiceAuditProcedure, rpc.WithCapability(permission.CapBansView))
+	authMiddleware.UserRoute(banv1connect.BanServiceSuggestProcedure, rpc.WithCapability(permission.CapBansView))
+	authMiddleware.UserRoute(banv1connect.BanServiceEscalationsProcedure, rpc.WithCapability(permission.CapBansView))
+	authMiddleware.UserRoute(banv1connect.BanServiceEscalationSaveProcedure, rpc.WithCapability(permission.CapBanEscalation))
 
 	return rpc.Service{Pattern: pattern, Handler: handler}
 }
@@ -93,6 +98,10 @@ func (s Service) Delete(ctx context.Context, req *v1.DeleteRequest) (*emptypb.Em
 	}
 
 	user := rpc.UserInfoFromCtx(ctx)
+	if !user.Can(banCapability(bannedPerson.BanType)) {
+		return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
+	}
+
 	changed, errSave := s.bans.Unban(ctx, bannedPerson.TargetID, req.GetReason(), user)
 	if errSave != nil {
 		return nil, connect.NewError(connect.CodeInternal, errSave)
@@ -116,7 +125,7 @@ func (s Service) GetBanByReportID(ctx context.Context, req *v1.GetBanByReportIDR
 		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
 	}
 
-	if !user.HasPermission(permission.Moderator) && !bannedPerson.TargetID.Equal(user.GetSteamID()) {
+	if !user.Can(permission.CapBansView) && !bannedPerson.TargetID.Equal(user.GetSteamID()) {
 		return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
 	}
 
@@ -135,7 +144,7 @@ func (s Service) Get(ctx context.Context, req *v1.GetRequest) (*v1.GetResponse,
 		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
 	}
 
-	if !user.HasPermission(permission.Moderator) && !bannedPerson.TargetID.Equal(user.GetSteamID()) {
+	if !user.Can(permission.CapBansView) && !bannedPerson.TargetID.Equal(user.GetSteamID()) {
 		return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
 	}
 
@@ -174,17 +183,34 @@ func (s Service) QuerySourceBans(ctx context.Context, req *v1.QuerySourceBansReq
 func (s Service) Create(ctx context.Context, req *v1.CreateRequest) (*v1.CreateResponse, error) {
 	user := rpc.UserInfoFromCtx(ctx)
 	opts := Opts{
-		SourceID:   user.SteamID,
-		TargetID:   steamid.New(req.GetTargetId()),
-		Origin:     Origin(req.GetOrigin()),
-		Reason:     reason.Reason(req.GetReason()),
-		BanType:    bantype.Type(req.GetBanType()),
-		ValidUntil: req.GetValidUntil().AsTime(),
-		ReportID:   req.ReportId,
-		EvadeOk:    req.GetEvadeOk(),
-		Note:       req.GetNote(),
-		DemoID:     req.DemoId,
-		DemoTick:   req.DemoTick,
+		SourceID: user.SteamID,
+		TargetID: steamid.New(req.GetTargetId()),
+		Origin:   Origin(req.GetOrigin()),
+		Reason:   reason.Reason(req.GetReason()),
+		BanType:  bantype.Type(req.GetBanType()),
+		ReportID: req.ReportId,
+		EvadeOk:  req.GetEvadeOk(),
+		Note:     req.GetNote(),
+		DemoID:   req.DemoId,
+		DemoTick: req.DemoTick,
+	}
+
+	if req.ValidUntil != nil {
+		opts.ValidUntil = req.GetValidUntil().AsTime()
+	} else {
+		// The escalation ladder picks the ban type, so the user must be allowed to create that type instead.
+		suggestion, errSuggest := s.bans.Suggest(ctx, opts.TargetID, opts.Reason)
+		if errSuggest != nil {
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+
+		if suggestion.Matched {
+			opts.BanType = suggestion.Step.BanType
+		}
+	}
+
+	if !user.Can(banCapability(opts.BanType)) {
+		return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
 	}
 
 	if reason.Reason(req.GetReason()) == reason.Custom {
@@ -209,6 +235,10 @@ func (s Service) Create(ctx context.Context, req *v1.CreateRequest) (*v1.CreateR
 
 		slog.Error("Failed to create ban", slog.String("error", errBan.Error()))
 
+		if errors.Is(errBan, ErrInvalidBanOpts) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errBan)
+		}
+
 		return nil, connect.NewError(connect.CodeInternal, ErrSaveBan)
 	}
 
@@ -221,6 +251,12 @@ func (s Service) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.UpdateR
 		return nil, connect.NewError(connect.CodeNotFound, banErr)
 	}
 
+	// Both the current and new type are checked so a gag cannot be turned into a ban and vice versa.
+	user := rpc.UserInfoFromCtx(ctx)
+	if !user.Can(banCapability(bannedPerson.BanType)) || !user.Can(banCapability(bantype.Type(req.GetBanType()))) {
+		return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
+	}
+
 	if reason.Reason(req.GetReason()) == reason.Custom {
 		if req.GetReasonText() == "" {
 			return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
@@ -245,13 +281,145 @@ func (s Service) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.UpdateR
 		bannedPerson.CIDR = &cidr
 	}
 
-	if errSave := s.bans.Save(ctx, &bannedPerson); errSave != nil {
+	if errSave := s.bans.Save(ctx, user.GetSteamID(), &bannedPerson); errSave != nil {
 		return nil, connect.NewError(connect.CodeInternal, ErrSaveBan)
 	}
 
 	return &v1.UpdateResponse{Ban: toBan(bannedPerson)}, nil
 }
 
+func (s Service) History(ctx context.Context, req *v1.HistoryRequest) (*v1.HistoryResponse, error) {
+	history, errHistory := s.bans.History(ctx, req.GetBanId())
+	if errHistory != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := &v1.HistoryResponse{History: make([]*v1.BanHistory, len(history))}
+	for idx, entry := range history {
+		changes := make([]*v1.BanChange, len(entry.Changes))
+		for changeIdx, change := range entry.Changes {
+			changes[changeIdx] = &v1.BanChange{Field: &change.Field, OldValue: &change.Old, NewValue: &change.New}
+		}
+
+		resp.History[idx] = &v1.BanHistory{
+			HistoryId:         &entry.HistoryID,
+			BanId:             &entry.BanID,
+			AuthorPersonaName: &entry.AuthorPersonaname,
+			Changes:           changes,
+			CreatedOn:         timestamppb.New(entry.CreatedOn),
+		}
+
+		if entry.AuthorID.Valid() {
+			resp.History[idx].AuthorId = new(entry.AuthorID.Int64())
+		}
+	}
+
+	return resp, nil
+}
+
+func (s Service) Audit(ctx context.Context, req *v1.AuditRequest) (*v1.AuditResponse, error) {
+	audit, errAudit := s.bans.Audit(ctx, req.GetBanId())
+	if errAudit != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := &v1.AuditResponse{Audit: make([]*v1.BanAudit, len(audit))}
+	for idx, entry := range audit {
+		resp.Audit[idx] = &v1.BanAudit{
+			AuditId:   &entry.AuditID,
+			BanId:     &entry.BanID,
+			Action:    new(string(entry.Action)),
+			Status:    new(toAuditStatus(entry.Status)),
+			Message:   &entry.Message,
+			CreatedOn: timestamppb.New(entry.CreatedOn),
+		}
+	}
+
+	return resp, nil
+}
+
+func toAuditStatus(status AuditStatus) v1.AuditStatus {
+	switch status {
+	case AuditSuccess:
+		return v1.AuditStatus_AUDIT_STATUS_SUCCESS
+	case AuditFailed:
+		return v1.AuditStatus_AUDIT_STATUS_FAILED
+	case AuditQueued:
+		return v1.AuditStatus_AUDIT_STATUS_QUEUED
+	default:
+		return v1.AuditStatus_AUDIT_STATUS_UNSPECIFIED
+	}
+}
+
+func (s Service) Suggest(ctx context.Context, req *v1.SuggestRequest) (*v1.SuggestResponse, error) {
+	suggestion, errSuggest := s.bans.Suggest(ctx, steamid.New(req.GetTargetId()), reason.Reason(req.GetReason()))
+	if errSuggest != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := &v1.SuggestResponse{
+		PriorBans: &suggestion.PriorBans,
+		Offense:   &suggestion.Offense,
+		Matched:   &suggestion.Matched,
+	}
+
+	if suggestion.Matched {
+		resp.Step = toEscalationStep(suggestion.Step)
+		resp.ValidUntil = timestamppb.New(suggestion.ValidUntil)
+	}
+
+	return resp, nil
+}
+
+func (s Service) Escalations(ctx context.Context, _ *emptypb.Empty) (*v1.EscalationsResponse, error) {
+	steps, errSteps := s.bans.Escalations(ctx)
+	if errSteps != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := &v1.EscalationsResponse{Steps: make([]*v1.EscalationStep, len(steps))}
+	for idx, step := range steps {
+		resp.Steps[idx] = toEscalationStep(step)
+	}
+
+	return resp, nil
+}
+
+func (s Service) EscalationSave(ctx context.Context, req *v1.EscalationSaveRequest) (*v1.EscalationSaveResponse, error) {
+	ladder := make([]EscalationStep, len(req.GetSteps()))
+	for idx, step := range req.GetSteps() {
+		ladder[idx] = EscalationStep{
+			Offense:  step.GetOffense(),
+			BanType:  bantype.Type(step.GetBanType()),
+			Duration: step.GetDuration().AsDuration(),
+		}
+	}
+
+	if errSave := s.bans.SaveEscalation(ctx, reason.Reason(req.GetReason()), ladder); errSave != nil {
+		if errors.Is(errSave, ErrInvalidEscalation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := &v1.EscalationSaveResponse{Steps: make([]*v1.EscalationStep, len(ladder))}
+	for idx, step := range ladder {
+		resp.Steps[idx] = toEscalationStep(step)
+	}
+
+	return resp, nil
+}
+
+func toEscalationStep(step EscalationStep) *v1.EscalationStep {
+	return &v1.EscalationStep{
+		Reason:   new(v1.BanReason(step.Reason)), //nolint:gosec
+		Offense:  &step.Offense,
+		BanType:  new(v1.BanType(step.BanType)), //nolint:gosec
+		Duration: durationpb.New(step.Duration),
+	}
+}
+
 func toBan(ban Ban) *v1.Ban {
 	return &v1.Ban{
 		TargetId:          new(ban.TargetID.Int64()),
@@ -280,3 +448,13 @@ func toBan(ban Ban) *v1.Ban {
 		TargetAvatarHash:  &ban.TargetAvatarhash,
 	}
 }
+
+// banCapability is the capability required to manage bans of the type. Mutes and gags are separate from bans so
+// they can be delegated on their own.
+func banCapability(banType bantype.Type) permission.Capability {
+	if banType == bantype.NoComm {
+		return permission.CapGags
+	}
+
+	return permission.CapBans
+}
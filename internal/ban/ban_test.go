@@ -1,9 +1,12 @@
 package ban_test
 
 import (
+	"bytes"
+	"slices"
 	"testing"
 	"time"
 
+	"github.com/leighmacdonald/gbans/internal/anticheat"
 	"github.com/leighmacdonald/gbans/internal/asset"
 	"github.com/leighmacdonald/gbans/internal/auth/permission"
 	"github.com/leighmacdonald/gbans/internal/ban"
@@ -42,10 +45,12 @@ func TestBan(t *testing.T) {
 		stats   = stats.New(stats.NewRepository(fixture.Database), maps.New(maps.NewRepository(fixture.Database)))
 		demos   = demo.NewDemos(asset.BucketDemo, demo.NewRepository(fixture.Database),
 			assets, stats, chat, fixture.Persons, fixture.Config.Config().Demo, steamid.New(fixture.Config.Config().Owner))
-		reports = ban.NewReports(ban.NewReportRepository(fixture.Database),
+		reports = ban.NewReports(ban.NewReportRepository(fixture.Database), ban.NewRepository(fixture.Database),
 			person.NewPersons(person.NewRepository(fixture.Database, true), steamid.New(tests.OwnerSID), fixture.TFApi),
-			demos, fixture.TFApi, notification.NewDiscard(), "")
-		serversCase, _ = servers.New(servers.NewRepository(fixture.Database), nil, "")
+			demos, chat, anticheat.New(anticheat.NewRepository(fixture.Database), fixture.Config.Config().Anticheat,
+				notification.NewDiscard(), nil, fixture.Persons),
+			fixture.TFApi, notification.NewDiscard(), "")
+		serversCase, _ = servers.New(servers.NewRepository(fixture.Database), nil, nil, steamid.SteamID{}, "")
 		bans           = ban.New(ban.NewRepository(fixture.Database), fixture.Persons,
 			fixture.Config.Config().Discord.BanLogChannelID, fixture.Config.Config().Discord.KickLogChannelID,
 			steamid.New(fixture.Config.Config().Owner), reports, notification.NewDiscard(), serversCase, tests.EmptyIPProvider{})
@@ -74,10 +79,12 @@ func TestDuplicate(t *testing.T) {
 		stats   = stats.New(stats.NewRepository(fixture.Database), maps.New(maps.NewRepository(fixture.Database)))
 		demos   = demo.NewDemos(asset.BucketDemo, demo.NewRepository(fixture.Database),
 			assets, stats, chat, fixture.Persons, fixture.Config.Config().Demo, steamid.New(fixture.Config.Config().Owner))
-		reports = ban.NewReports(ban.NewReportRepository(fixture.Database),
+		reports = ban.NewReports(ban.NewReportRepository(fixture.Database), ban.NewRepository(fixture.Database),
 			person.NewPersons(person.NewRepository(fixture.Database, true), steamid.New(tests.OwnerSID), fixture.TFApi),
-			demos, fixture.TFApi, notification.NewDiscard(), "")
-		serversCase, _ = servers.New(servers.NewRepository(fixture.Database), nil, "")
+			demos, chat, anticheat.New(anticheat.NewRepository(fixture.Database), fixture.Config.Config().Anticheat,
+				notification.NewDiscard(), nil, fixture.Persons),
+			fixture.TFApi, notification.NewDiscard(), "")
+		serversCase, _ = servers.New(servers.NewRepository(fixture.Database), nil, nil, steamid.SteamID{}, "")
 		bans           = ban.New(ban.NewRepository(fixture.Database), fixture.Persons,
 			fixture.Config.Config().Discord.BanLogChannelID, fixture.Config.Config().Discord.KickLogChannelID,
 			steamid.New(fixture.Config.Config().Owner), reports, notification.NewDiscard(), serversCase, tests.EmptyIPProvider{})
@@ -115,10 +122,12 @@ func TestUnban(t *testing.T) {
 		stats   = stats.New(stats.NewRepository(fixture.Database), maps.New(maps.NewRepository(fixture.Database)))
 		demos   = demo.NewDemos(asset.BucketDemo, demo.NewRepository(fixture.Database),
 			assets, stats, chat, fixture.Persons, fixture.Config.Config().Demo, steamid.New(fixture.Config.Config().Owner))
-		reports = ban.NewReports(ban.NewReportRepository(fixture.Database),
+		reports = ban.NewReports(ban.NewReportRepository(fixture.Database), ban.NewRepository(fixture.Database),
 			person.NewPersons(person.NewRepository(fixture.Database, true), steamid.New(tests.OwnerSID), fixture.TFApi),
-			demos, fixture.TFApi, notification.NewDiscard(), "")
-		serversCase, _ = servers.New(servers.NewRepository(fixture.Database), nil, "")
+			demos, chat, anticheat.New(anticheat.NewRepository(fixture.Database), fixture.Config.Config().Anticheat,
+				notification.NewDiscard(), nil, fixture.Persons),
+			fixture.TFApi, notification.NewDiscard(), "")
+		serversCase, _ = servers.New(servers.NewRepository(fixture.Database), nil, nil, steamid.SteamID{}, "")
 		bans           = ban.New(ban.NewRepository(fixture.Database), fixture.Persons,
 			fixture.Config.Config().Discord.BanLogChannelID, fixture.Config.Config().Discord.KickLogChannelID,
 			steamid.New(fixture.Config.Config().Owner), reports, notification.NewDiscard(), serversCase, tests.EmptyIPProvider{})
@@ -136,3 +145,103 @@ func TestUnban(t *testing.T) {
 	require.NoError(t, errUnban)
 	require.True(t, didUnban)
 }
+
+func TestCheckLinkedEvadeStatus(t *testing.T) {
+	var (
+		ctx      = t.Context()
+		notif    = &recordingNotifier{}
+		bans     = newTestBans(t, fixture.Persons, notif)
+		source   = fixture.CreateTestPerson(ctx, steamid.RandSID64(), permission.Admin)
+		banned   = fixture.CreateTestPerson(ctx, steamid.RandSID64(), permission.User)
+		direct   = fixture.CreateTestPerson(ctx, steamid.RandSID64(), permission.User)
+		indirect = fixture.CreateTestPerson(ctx, steamid.RandSID64(), permission.User)
+		expires  = time.Now().Add(time.Hour).Truncate(time.Second)
+	)
+
+	original, errCreate := bans.Create(ctx, ban.Opts{
+		SourceID: source.SteamID, TargetID: banned.SteamID, ValidUntil: expires,
+		BanType: bantype.Banned, Reason: reason.Cheating, Origin: ban.Web, Note: "original ban",
+	})
+	require.NoError(t, errCreate)
+
+	// A ban of an account linked through another alt is only flagged.
+	sent := notif.count()
+	evading, errIndirect := bans.CheckLinkedEvadeStatus(ctx, indirect.SteamID, nil, []steamid.SteamID{banned.SteamID})
+	require.NoError(t, errIndirect)
+	require.False(t, evading)
+	require.Equal(t, sent+1, notif.count())
+
+	_, errNotBanned := bans.QueryOne(ctx, ban.QueryOpts{TargetID: indirect.SteamID})
+	require.Error(t, errNotBanned)
+
+	evading, errDirect := bans.CheckLinkedEvadeStatus(ctx, direct.SteamID, []steamid.SteamID{banned.SteamID}, nil)
+	require.NoError(t, errDirect)
+	require.True(t, evading)
+
+	evadeBan, errEvadeBan := bans.QueryOne(ctx, ban.QueryOpts{TargetID: direct.SteamID})
+	require.NoError(t, errEvadeBan)
+	require.Equal(t, reason.Evading, evadeBan.Reason)
+
+	// The original ban is left as it was.
+	unchanged, errOriginal := bans.QueryOne(ctx, ban.QueryOpts{BanID: original.BanID})
+	require.NoError(t, errOriginal)
+	require.WithinDuration(t, expires, unchanged.ValidUntil, time.Second)
+}
+
+func TestReportArchivesEvidenceDemo(t *testing.T) {
+	t.Parallel()
+	var (
+		assets  = asset.NewAssets(asset.NewLocalRepository(fixture.Database, t.TempDir()))
+		filters = chat.NewWordFilters(chat.NewWordFilterRepository(fixture.Database), notification.NewDiscard(), fixture.Config.Config().Filters)
+		chat    = chat.New(chat.NewRepository(fixture.Database), fixture.Config.Config().Filters, filters, fixture.Persons, notification.NewDiscard(), nil, "")
+		stats   = stats.New(stats.NewRepository(fixture.Database), maps.New(maps.NewRepository(fixture.Database)))
+		demos   = demo.NewDemos(asset.BucketDemo, demo.NewRepository(fixture.Database),
+			assets, stats, chat, fixture.Persons, fixture.Config.Config().Demo, steamid.New(fixture.Config.Config().Owner))
+		reports = ban.NewReports(ban.NewReportRepository(fixture.Database), ban.NewRepository(fixture.Database),
+			person.NewPersons(person.NewRepository(fixture.Database, true), steamid.New(tests.OwnerSID), fixture.TFApi),
+			demos, chat, anticheat.New(anticheat.NewRepository(fixture.Database), fixture.Config.Config().Anticheat,
+				notification.NewDiscard(), nil, fixture.Persons),
+			fixture.TFApi, notification.NewDiscard(), "")
+		serversCase, _ = servers.New(servers.NewRepository(fixture.Database), nil, nil, steamid.SteamID{}, "")
+		source         = steamid.RandSID64()
+		target         = steamid.RandSID64()
+	)
+
+	server, errServer := serversCase.Save(t.Context(), servers.NewServer(stringutil.SecureRandomString(10), stringutil.SecureRandomString(10)+".com", 27015))
+	require.NoError(t, errServer)
+
+	demoAsset, errAsset := assets.Create(t.Context(), source, asset.BucketDemo, stringutil.SecureRandomString(10)+".dem",
+		bytes.NewReader([]byte(stringutil.SecureRandomString(32))), false)
+	require.NoError(t, errAsset)
+
+	demoFile := demo.File{
+		ServerID:  server.ServerID,
+		Title:     demoAsset.Name,
+		CreatedOn: time.Now(),
+		MapName:   "pl_upward",
+		Stats:     map[string]map[string]any{},
+		AssetID:   demoAsset.AssetID,
+	}
+	require.NoError(t, demo.NewRepository(fixture.Database).SaveDemo(t.Context(), &demoFile))
+
+	author, errAuthor := fixture.Persons.GetOrCreatePersonBySteamID(t.Context(), source)
+	require.NoError(t, errAuthor)
+
+	report, errReport := reports.Save(t.Context(), author, ban.RequestReportCreate{
+		TargetID:    target,
+		Description: "Aimbot in the recorded match",
+		Reason:      reason.Cheating,
+		DemoID:      demoFile.DemoID,
+	})
+	require.NoError(t, errReport)
+
+	evidence, errEvidence := reports.Evidence(t.Context(), report.ReportID)
+	require.NoError(t, errEvidence)
+	require.True(t, slices.ContainsFunc(evidence, func(item ban.ReportEvidence) bool {
+		return item.Kind == ban.EvidenceDemo && item.RefID == int64(demoFile.DemoID)
+	}))
+
+	archived, errDemo := demos.GetDemoByID(t.Context(), demoFile.DemoID)
+	require.NoError(t, errDemo)
+	require.True(t, archived.Archive, "evidence demos must be kept by the demo cleanup")
+}
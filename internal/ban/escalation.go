@@ -0,0 +1,110 @@
+package ban
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+var ErrInvalidEscalation = errors.New("invalid escalation step")
+
+// EscalationStep defines the penalty for a given offense number of a reason. A reasons ladder is made
+// up of its steps ordered by Offense, e.g. first cheating offense = 1 month ban, second = permanent.
+// The highest step is reused for any offense beyond it.
+type EscalationStep struct {
+	Reason reason.Reason
+	// Offense is the 1-based offense number this step applies to.
+	Offense int32
+	BanType bantype.Type
+	// Duration of the ban. Zero is permanent.
+	Duration time.Duration
+}
+
+// Suggestion is the penalty the escalation ladder suggests for the next offense of a player.
+type Suggestion struct {
+	// PriorBans is the number of previous bans with the same reason, including expired and deleted bans.
+	PriorBans int32
+	// Offense is the offense number of the new ban, PriorBans + 1.
+	Offense int32
+	// Matched is false when there is no step configured that applies to the offense.
+	Matched    bool
+	Step       EscalationStep
+	ValidUntil time.Time
+}
+
+// Suggest selects the step applying to the next offense from the ladder of a single reason.
+func Suggest(ladder []EscalationStep, priorBans int32, now time.Time) Suggestion {
+	suggestion := Suggestion{PriorBans: priorBans, Offense: priorBans + 1}
+
+	for _, step := range ladder {
+		if step.Offense <= suggestion.Offense && step.Offense > suggestion.Step.Offense {
+			suggestion.Step = step
+			suggestion.Matched = true
+		}
+	}
+
+	if suggestion.Matched {
+		suggestion.ValidUntil = now.AddDate(10, 0, 0)
+		if suggestion.Step.Duration > 0 {
+			suggestion.ValidUntil = now.Add(suggestion.Step.Duration)
+		}
+	}
+
+	return suggestion
+}
+
+func validateLadder(ladder []EscalationStep) error {
+	seen := map[int32]bool{}
+	for _, step := range ladder {
+		if step.Offense <= 0 || seen[step.Offense] || step.Duration < 0 ||
+			(step.BanType != bantype.NoComm && step.BanType != bantype.Banned) {
+			return ErrInvalidEscalation
+		}
+
+		seen[step.Offense] = true
+	}
+
+	return nil
+}
+
+// Escalations returns the configured steps of every reason ordered by reason and offense.
+func (s Bans) Escalations(ctx context.Context) ([]EscalationStep, error) {
+	return s.repo.Escalations(ctx)
+}
+
+// SaveEscalation replaces the ladder of the reason. An empty ladder disables escalation for the reason.
+func (s Bans) SaveEscalation(ctx context.Context, banReason reason.Reason, ladder []EscalationStep) error {
+	for idx := range ladder {
+		ladder[idx].Reason = banReason
+	}
+
+	if err := validateLadder(ladder); err != nil {
+		return err
+	}
+
+	return s.repo.SaveEscalation(ctx, banReason, ladder)
+}
+
+// Suggest computes the escalated penalty for the next ban of the target for the reason.
+func (s Bans) Suggest(ctx context.Context, targetID steamid.SteamID, banReason reason.Reason) (Suggestion, error) {
+	priorBans, errCount := s.repo.CountBans(ctx, targetID, banReason)
+	if errCount != nil {
+		return Suggestion{}, errCount
+	}
+
+	steps, errSteps := s.repo.Escalations(ctx)
+	if errSteps != nil {
+		return Suggestion{}, errSteps
+	}
+
+	ladder := slices.DeleteFunc(steps, func(step EscalationStep) bool {
+		return step.Reason != banReason
+	})
+
+	return Suggest(ladder, priorBans, time.Now()), nil
+}
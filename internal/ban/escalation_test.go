@@ -0,0 +1,45 @@
+package ban_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban"
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggest(t *testing.T) {
+	var (
+		now    = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		month  = 30 * 24 * time.Hour
+		ladder = []ban.EscalationStep{
+			{Reason: reason.Language, Offense: 3, BanType: bantype.Banned, Duration: month},
+			{Reason: reason.Language, Offense: 1, BanType: bantype.NoComm, Duration: 24 * time.Hour},
+			{Reason: reason.Language, Offense: 4, BanType: bantype.Banned},
+		}
+	)
+
+	first := ban.Suggest(ladder, 0, now)
+	require.True(t, first.Matched)
+	require.Equal(t, int32(1), first.Offense)
+	require.Equal(t, bantype.NoComm, first.Step.BanType)
+	require.Equal(t, now.Add(24*time.Hour), first.ValidUntil)
+
+	// Offenses between steps use the previous step.
+	second := ban.Suggest(ladder, 1, now)
+	require.Equal(t, int32(1), second.Step.Offense)
+
+	third := ban.Suggest(ladder, 2, now)
+	require.Equal(t, bantype.Banned, third.Step.BanType)
+	require.Equal(t, now.Add(month), third.ValidUntil)
+
+	// The last step repeats and a zero duration is permanent.
+	tenth := ban.Suggest(ladder, 9, now)
+	require.Equal(t, int32(4), tenth.Step.Offense)
+	require.Equal(t, now.AddDate(10, 0, 0), tenth.ValidUntil)
+
+	require.False(t, ban.Suggest(nil, 3, now).Matched)
+	require.False(t, ban.Suggest(ladder[:1], 0, now).Matched)
+}
@@ -0,0 +1,720 @@
+package ban
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/gbans/internal/config/link"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/thirdparty"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+const (
+	tf2bdSchemaURL = "https://raw.githubusercontent.com/PazerOP/tf2_bot_detector/master/schemas/v3/playerlist.schema.json"
+	// exportCacheTTL is how long a rendered feed is reused before being regenerated.
+	exportCacheTTL = time.Minute
+)
+
+var (
+	ErrExportFormat  = errors.New("unsupported export format")
+	ErrInvalidFeed   = errors.New("invalid export feed")
+	ErrFeedNotFound  = errors.New("export feed not found")
+	ErrExportAPIKey  = errors.New("invalid export api key")
+	ErrSigningKey    = errors.New("failed to load export signing key")
+	reFeedName       = regexp.MustCompile(`^[a-z0-9_-]+$`)
+	errExportConsume = errors.New("failed to record export consumer usage")
+)
+
+// ExportFormat is the file format a feed is rendered as.
+type ExportFormat string
+
+const (
+	FormatCSV  ExportFormat = "csv"
+	FormatJSON ExportFormat = "json"
+	// FormatBannedUser is a srcds banned_user.cfg containing banid commands.
+	FormatBannedUser ExportFormat = "banned_user.cfg"
+	// FormatBannedIP is a srcds banned_ip.cfg containing addip commands. Only single address bans are included.
+	FormatBannedIP ExportFormat = "banned_ip.cfg"
+	// FormatTF2BD is a tf2 bot detector player list.
+	FormatTF2BD ExportFormat = "tf2bd"
+	// FormatSourceBans is an xml document whose rows mirror the SourceBans bans and comms tables.
+	FormatSourceBans ExportFormat = "sourcebans"
+)
+
+// ExportFormats lists every supported format.
+var ExportFormats = []ExportFormat{FormatCSV, FormatJSON, FormatBannedUser, FormatBannedIP, FormatTF2BD, FormatSourceBans} //nolint:gochecknoglobals
+
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatJSON, FormatTF2BD:
+		return "application/json"
+	case FormatSourceBans:
+		return "application/xml; charset=utf-8"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// Filename is the name a feed is downloaded as.
+func (f ExportFormat) Filename(feedName string) string {
+	switch f {
+	case FormatBannedUser, FormatBannedIP:
+		return string(f)
+	case FormatTF2BD:
+		return feedName + ".tf2bd.json"
+	case FormatSourceBans:
+		return feedName + ".sourcebans.xml"
+	default:
+		return feedName + "." + string(f)
+	}
+}
+
+// Feed is a named, operator defined subset of the active bans which partner communities can consume.
+type Feed struct {
+	FeedID      int32
+	Name        string
+	Title       string
+	Description string
+	// Reasons limits the feed to these reasons. Empty includes all reasons.
+	Reasons []reason.Reason
+	// BanTypes limits the feed to these ban types. Empty includes all types.
+	BanTypes []bantype.Type
+	// MinDuration excludes bans shorter than this. Permanent bans are always included.
+	MinDuration time.Duration
+	// MaxAge excludes bans created longer ago than this. Zero includes bans of any age.
+	MaxAge time.Duration
+	// Public feeds can be fetched without an api key.
+	Public    bool
+	Enabled   bool
+	CreatedOn time.Time
+	UpdatedOn time.Time
+}
+
+func (f Feed) Validate() error {
+	if !reFeedName.MatchString(f.Name) {
+		return fmt.Errorf("%w: name must only contain a-z, 0-9, _ and -", ErrInvalidFeed)
+	}
+
+	if strings.TrimSpace(f.Title) == "" {
+		return fmt.Errorf("%w: title cannot be empty", ErrInvalidFeed)
+	}
+
+	if f.MinDuration < 0 || f.MaxAge < 0 {
+		return fmt.Errorf("%w: durations cannot be negative", ErrInvalidFeed)
+	}
+
+	for _, banReason := range f.Reasons {
+		if banReason < reason.Custom || banReason > reason.Username {
+			return fmt.Errorf("%w: unknown reason %d", ErrInvalidFeed, banReason)
+		}
+	}
+
+	for _, banType := range f.BanTypes {
+		if banType != bantype.NoComm && banType != bantype.Banned {
+			return fmt.Errorf("%w: unknown ban type %d", ErrInvalidFeed, banType)
+		}
+	}
+
+	return nil
+}
+
+// Consumer is a partner permitted to fetch a private feed using its api key. Only a hash of the key is stored,
+// the key itself is shown once when the consumer is created.
+type Consumer struct {
+	ConsumerID int32
+	FeedID     int32
+	Name       string
+	Enabled    bool
+	CreatedOn  time.Time
+	// LastUsedOn is zero when the key has never been used.
+	LastUsedOn time.Time
+}
+
+// ExportEntry is a single active ban included in a feed.
+type ExportEntry struct {
+	BanID       int32
+	SteamID     steamid.SteamID
+	Personaname string
+	BanType     bantype.Type
+	Reason      reason.Reason
+	ReasonText  string
+	CIDR        string
+	CreatedOn   time.Time
+	ValidUntil  time.Time
+	// LastSeen is the most recent connection to any server, zero if they have never connected.
+	LastSeen time.Time
+}
+
+func (e ExportEntry) permanent() bool {
+	return e.ValidUntil.Year()-time.Now().Year() >= 5
+}
+
+// minutesRemaining is the srcds ban length, 0 being permanent.
+func (e ExportEntry) minutesRemaining() int64 {
+	if e.permanent() {
+		return 0
+	}
+
+	return max(1, int64(math.Ceil(time.Until(e.ValidUntil).Minutes())))
+}
+
+// address returns the banned ip when the ban covers exactly one address.
+func (e ExportEntry) address() (string, bool) {
+	if e.CIDR == "" {
+		return "", false
+	}
+
+	if ip := net.ParseIP(e.CIDR); ip != nil && ip.To4() != nil {
+		return ip.String(), true
+	}
+
+	ip, network, errParse := net.ParseCIDR(e.CIDR)
+	if errParse != nil || ip.To4() == nil {
+		return "", false
+	}
+
+	if ones, bits := network.Mask.Size(); ones != bits {
+		return "", false
+	}
+
+	return ip.String(), true
+}
+
+// tf2bdAttributes maps ban reasons to the closest bot detector player attribute.
+func tf2bdAttributes(banReason reason.Reason) []string {
+	switch banReason {
+	case reason.Cheating, reason.BotHost:
+		return []string{"cheater"}
+	case reason.Exploiting:
+		return []string{"exploiter"}
+	case reason.Racism:
+		return []string{"racist"}
+	default:
+		return []string{"suspicious"}
+	}
+}
+
+type exportJSONBan struct {
+	BanID       int32      `json:"ban_id"`
+	SteamID     string     `json:"steam_id"`
+	SteamID2    string     `json:"steam_id2"`
+	SteamID3    string     `json:"steam_id3"`
+	Personaname string     `json:"personaname"`
+	BanType     string     `json:"ban_type"`
+	Reason      string     `json:"reason"`
+	ReasonText  string     `json:"reason_text,omitempty"`
+	CIDR        string     `json:"cidr,omitempty"`
+	CreatedOn   time.Time  `json:"created_on"`
+	ValidUntil  *time.Time `json:"valid_until"`
+	Permanent   bool       `json:"permanent"`
+	LastSeen    *time.Time `json:"last_seen"`
+}
+
+// sourceBansType values of the SourceBans bans and comms tables.
+const (
+	sourceBansSteam   = 0
+	sourceBansIP      = 1
+	sourceBansSilence = 3
+)
+
+// exportSourceBansRow uses the column names of the SourceBans bans and comms tables. Times are unix
+// timestamps and lengths are in seconds, with a length of 0 and ends equal to created being permanent.
+type exportSourceBansRow struct {
+	BanID   int32  `xml:"bid"`
+	AuthID  string `xml:"authid,omitempty"`
+	IP      string `xml:"ip,omitempty"`
+	Name    string `xml:"name"`
+	Created int64  `xml:"created"`
+	Ends    int64  `xml:"ends"`
+	Length  int64  `xml:"length"`
+	Reason  string `xml:"reason"`
+	Type    int32  `xml:"type"`
+}
+
+type exportSourceBans struct {
+	XMLName     xml.Name              `xml:"sourcebans"`
+	Name        string                `xml:"name,attr"`
+	Title       string                `xml:"title,attr"`
+	Description string                `xml:"description,attr,omitempty"`
+	Author      string                `xml:"author,attr"`
+	UpdateURL   string                `xml:"update_url,attr"`
+	Bans        []exportSourceBansRow `xml:"bans>ban"`
+	Comms       []exportSourceBansRow `xml:"comms>comm"`
+}
+
+// sourceBansRow converts the entry to a SourceBans row. Network bans without a steam id or a single
+// address cannot be represented and are skipped.
+func (e ExportEntry) sourceBansRow() (exportSourceBansRow, bool) {
+	row := exportSourceBansRow{
+		BanID:   e.BanID,
+		Name:    e.Personaname,
+		Created: e.CreatedOn.Unix(),
+		Ends:    e.CreatedOn.Unix(),
+		Reason:  e.Reason.String(),
+	}
+
+	if e.Reason == reason.Custom && e.ReasonText != "" {
+		row.Reason = e.ReasonText
+	}
+
+	if !e.permanent() {
+		row.Ends = e.ValidUntil.Unix()
+		row.Length = max(0, row.Ends-row.Created)
+	}
+
+	if e.SteamID.Valid() {
+		row.AuthID = string(e.SteamID.Steam(false))
+	}
+
+	if e.BanType == bantype.NoComm {
+		row.Type = sourceBansSilence
+
+		return row, row.AuthID != ""
+	}
+
+	address, hasAddress := e.address()
+	if hasAddress {
+		row.IP = address
+	}
+
+	switch {
+	case row.AuthID != "":
+		row.Type = sourceBansSteam
+	case hasAddress:
+		row.Type = sourceBansIP
+	default:
+		return row, false
+	}
+
+	return row, true
+}
+
+type exportJSON struct {
+	Name        string          `json:"name"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Authors     []string        `json:"authors"`
+	UpdateURL   string          `json:"update_url"`
+	Bans        []exportJSONBan `json:"bans"`
+}
+
+// Render writes the entries of a feed in the requested format. The output only depends on the entries so
+// that unchanged feeds produce identical bodies and ETags.
+func Render(feed Feed, format ExportFormat, entries []ExportEntry, siteName string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	switch format {
+	case FormatCSV:
+		writer := csv.NewWriter(&buf)
+		_ = writer.Write([]string{
+			"ban_id", "steam_id", "steam_id2", "steam_id3", "personaname", "ban_type", "reason", "reason_text",
+			"cidr", "created_on", "valid_until", "permanent", "last_seen",
+		})
+
+		for _, entry := range entries {
+			validUntil := ""
+			if !entry.permanent() {
+				validUntil = entry.ValidUntil.UTC().Format(time.RFC3339)
+			}
+
+			lastSeen := ""
+			if !entry.LastSeen.IsZero() {
+				lastSeen = entry.LastSeen.UTC().Format(time.RFC3339)
+			}
+
+			_ = writer.Write([]string{
+				strconv.Itoa(int(entry.BanID)), entry.SteamID.String(), string(entry.SteamID.Steam(false)),
+				string(entry.SteamID.Steam3()), entry.Personaname, entry.BanType.String(), entry.Reason.String(),
+				entry.ReasonText, entry.CIDR, entry.CreatedOn.UTC().Format(time.RFC3339), validUntil,
+				strconv.FormatBool(entry.permanent()), lastSeen,
+			})
+		}
+
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return nil, err
+		}
+	case FormatJSON:
+		doc := exportJSON{
+			Name:        feed.Name,
+			Title:       feed.Title,
+			Description: feed.Description,
+			Authors:     []string{siteName},
+			UpdateURL:   link.Raw(feedPath(feed.Name, format)),
+			Bans:        make([]exportJSONBan, len(entries)),
+		}
+
+		for idx, entry := range entries {
+			value := exportJSONBan{
+				BanID:       entry.BanID,
+				SteamID:     entry.SteamID.String(),
+				SteamID2:    string(entry.SteamID.Steam(false)),
+				SteamID3:    string(entry.SteamID.Steam3()),
+				Personaname: entry.Personaname,
+				BanType:     entry.BanType.String(),
+				Reason:      entry.Reason.String(),
+				ReasonText:  entry.ReasonText,
+				CIDR:        entry.CIDR,
+				CreatedOn:   entry.CreatedOn.UTC(),
+				Permanent:   entry.permanent(),
+			}
+
+			if !value.Permanent {
+				value.ValidUntil = new(entry.ValidUntil.UTC())
+			}
+
+			if !entry.LastSeen.IsZero() {
+				value.LastSeen = new(entry.LastSeen.UTC())
+			}
+
+			doc.Bans[idx] = value
+		}
+
+		if err := json.NewEncoder(&buf).Encode(doc); err != nil {
+			return nil, err
+		}
+	case FormatBannedUser:
+		for _, entry := range entries {
+			if entry.BanType != bantype.Banned || !entry.SteamID.Valid() {
+				continue
+			}
+
+			_, _ = fmt.Fprintf(&buf, "banid %d %s\n", entry.minutesRemaining(), entry.SteamID.Steam(false))
+		}
+	case FormatBannedIP:
+		for _, entry := range entries {
+			address, ok := entry.address()
+			if entry.BanType != bantype.Banned || !ok {
+				continue
+			}
+
+			_, _ = fmt.Fprintf(&buf, "addip %d %s\n", entry.minutesRemaining(), address)
+		}
+	case FormatTF2BD:
+		doc := thirdparty.TF2BDSchema{
+			Schema: tf2bdSchemaURL,
+			FileInfo: thirdparty.FileInfo{
+				Authors:     []string{siteName},
+				Description: feed.Description,
+				Title:       feed.Title,
+				UpdateURL:   link.Raw(feedPath(feed.Name, format)),
+			},
+			Players: []thirdparty.Players{},
+		}
+
+		for _, entry := range entries {
+			seen := entry.LastSeen
+			if seen.IsZero() {
+				seen = entry.CreatedOn
+			}
+
+			doc.Players = append(doc.Players, thirdparty.Players{
+				Attributes: tf2bdAttributes(entry.Reason),
+				Steamid:    string(entry.SteamID.Steam3()),
+				LastSeen: thirdparty.LastSeen{
+					PlayerName: entry.Personaname,
+					Time:       int(seen.Unix()),
+				},
+			})
+		}
+
+		if err := json.NewEncoder(&buf).Encode(doc); err != nil {
+			return nil, err
+		}
+	case FormatSourceBans:
+		doc := exportSourceBans{
+			Name:        feed.Name,
+			Title:       feed.Title,
+			Description: feed.Description,
+			Author:      siteName,
+			UpdateURL:   link.Raw(feedPath(feed.Name, format)),
+		}
+
+		for _, entry := range entries {
+			row, ok := entry.sourceBansRow()
+			if !ok {
+				continue
+			}
+
+			if entry.BanType == bantype.NoComm {
+				doc.Comms = append(doc.Comms, row)
+			} else {
+				doc.Bans = append(doc.Bans, row)
+			}
+		}
+
+		buf.WriteString(xml.Header)
+
+		encoder := xml.NewEncoder(&buf)
+		encoder.Indent("", "  ")
+
+		if err := encoder.Encode(doc); err != nil {
+			return nil, err
+		}
+
+		buf.WriteString("\n")
+	default:
+		return nil, ErrExportFormat
+	}
+
+	return buf.Bytes(), nil
+}
+
+func feedPath(name string, format ExportFormat) string {
+	return fmt.Sprintf("/export/feeds/%s/%s", name, format)
+}
+
+// RenderedFeed is a signed feed ready to be served.
+type RenderedFeed struct {
+	Body        []byte
+	ContentType string
+	Filename    string
+	// ETag is a strong entity tag derived from the body.
+	ETag string
+	// Signature is the base64 encoded ed25519 signature of the body.
+	Signature string
+}
+
+type exportCacheKey struct {
+	name   string
+	format ExportFormat
+}
+
+type cachedFeed struct {
+	feed      RenderedFeed
+	expiresOn time.Time
+}
+
+// Exports renders, signs and caches the configured ban feeds.
+type Exports struct {
+	repo     ExportRepository
+	siteName string
+
+	mu         sync.Mutex
+	signingKey ed25519.PrivateKey
+	cache      map[exportCacheKey]cachedFeed
+}
+
+func NewExports(repo ExportRepository, siteName string) *Exports {
+	return &Exports{repo: repo, siteName: siteName, cache: map[exportCacheKey]cachedFeed{}}
+}
+
+func (e *Exports) Feeds(ctx context.Context) ([]Feed, error) {
+	return e.repo.Feeds(ctx)
+}
+
+func (e *Exports) SaveFeed(ctx context.Context, feed *Feed) error {
+	if err := feed.Validate(); err != nil {
+		return err
+	}
+
+	feed.UpdatedOn = time.Now()
+	if feed.FeedID == 0 {
+		feed.CreatedOn = feed.UpdatedOn
+	}
+
+	if err := e.repo.SaveFeed(ctx, feed); err != nil {
+		return err
+	}
+
+	e.purge()
+
+	return nil
+}
+
+func (e *Exports) DeleteFeed(ctx context.Context, feedID int32) error {
+	if err := e.repo.DeleteFeed(ctx, feedID); err != nil {
+		return err
+	}
+
+	e.purge()
+
+	return nil
+}
+
+func (e *Exports) Consumers(ctx context.Context, feedID int32) ([]Consumer, error) {
+	return e.repo.Consumers(ctx, feedID)
+}
+
+// CreateConsumer adds a new consumer to the feed and returns its api key. The key cannot be retrieved later.
+func (e *Exports) CreateConsumer(ctx context.Context, feedID int32, name string) (Consumer, string, error) {
+	if strings.TrimSpace(name) == "" {
+		return Consumer{}, "", fmt.Errorf("%w: consumer name cannot be empty", ErrInvalidFeed)
+	}
+
+	keyBytes := make([]byte, 32)
+	_, _ = rand.Read(keyBytes)
+	apiKey := hex.EncodeToString(keyBytes)
+
+	consumer := Consumer{FeedID: feedID, Name: name, Enabled: true, CreatedOn: time.Now()}
+	if err := e.repo.SaveConsumer(ctx, &consumer, hashAPIKey(apiKey)); err != nil {
+		return Consumer{}, "", err
+	}
+
+	return consumer, apiKey, nil
+}
+
+func (e *Exports) DeleteConsumer(ctx context.Context, consumerID int32) error {
+	return e.repo.DeleteConsumer(ctx, consumerID)
+}
+
+// Entries returns the active bans matching the feeds filters.
+func (e *Exports) Entries(ctx context.Context, feed Feed) ([]ExportEntry, error) {
+	return e.repo.Entries(ctx, feed)
+}
+
+// Render returns the signed feed in the requested format. Private feeds require the api key of one of
+// their enabled consumers.
+func (e *Exports) Render(ctx context.Context, name string, format ExportFormat, apiKey string) (RenderedFeed, error) {
+	if !slices.Contains(ExportFormats, format) {
+		return RenderedFeed{}, ErrExportFormat
+	}
+
+	feed, errFeed := e.repo.FeedByName(ctx, name)
+	if errFeed != nil {
+		if errors.Is(errFeed, database.ErrNoResult) {
+			return RenderedFeed{}, ErrFeedNotFound
+		}
+
+		return RenderedFeed{}, errFeed
+	}
+
+	if !feed.Enabled {
+		return RenderedFeed{}, ErrFeedNotFound
+	}
+
+	if !feed.Public {
+		if apiKey == "" {
+			return RenderedFeed{}, ErrExportAPIKey
+		}
+
+		if errConsumer := e.repo.UseConsumer(ctx, feed.FeedID, hashAPIKey(apiKey)); errConsumer != nil {
+			if errors.Is(errConsumer, database.ErrNoResult) {
+				return RenderedFeed{}, ErrExportAPIKey
+			}
+
+			return RenderedFeed{}, errors.Join(errConsumer, errExportConsume)
+		}
+	}
+
+	cacheKey := exportCacheKey{name: name, format: format}
+
+	e.mu.Lock()
+	cached, found := e.cache[cacheKey]
+	e.mu.Unlock()
+
+	if found && time.Now().Before(cached.expiresOn) {
+		return cached.feed, nil
+	}
+
+	entries, errEntries := e.repo.Entries(ctx, feed)
+	if errEntries != nil {
+		return RenderedFeed{}, errEntries
+	}
+
+	body, errRender := Render(feed, format, entries, e.siteName)
+	if errRender != nil {
+		return RenderedFeed{}, errRender
+	}
+
+	key, errKey := e.key(ctx)
+	if errKey != nil {
+		return RenderedFeed{}, errKey
+	}
+
+	digest := sha256.Sum256(body)
+	rendered := RenderedFeed{
+		Body:        body,
+		ContentType: format.ContentType(),
+		Filename:    format.Filename(feed.Name),
+		ETag:        `"` + hex.EncodeToString(digest[:16]) + `"`,
+		Signature:   base64.StdEncoding.EncodeToString(ed25519.Sign(key, body)),
+	}
+
+	e.mu.Lock()
+	e.cache[cacheKey] = cachedFeed{feed: rendered, expiresOn: time.Now().Add(exportCacheTTL)}
+	e.mu.Unlock()
+
+	return rendered, nil
+}
+
+// PublicKey returns the PEM encoded public key partners use to verify feed signatures.
+func (e *Exports) PublicKey(ctx context.Context) (string, error) {
+	key, errKey := e.key(ctx)
+	if errKey != nil {
+		return "", errKey
+	}
+
+	der, errMarshal := x509.MarshalPKIXPublicKey(key.Public())
+	if errMarshal != nil {
+		return "", errors.Join(errMarshal, ErrSigningKey)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// key loads the signing key, generating and persisting it on first use.
+func (e *Exports) key(ctx context.Context) (ed25519.PrivateKey, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.signingKey != nil {
+		return e.signingKey, nil
+	}
+
+	_, candidate, errGenerate := ed25519.GenerateKey(rand.Reader)
+	if errGenerate != nil {
+		return nil, errors.Join(errGenerate, ErrSigningKey)
+	}
+
+	seed, errSeed := e.repo.EnsureSigningKey(ctx, candidate.Seed())
+	if errSeed != nil {
+		return nil, errors.Join(errSeed, ErrSigningKey)
+	}
+
+	if len(seed) != ed25519.SeedSize {
+		return nil, ErrSigningKey
+	}
+
+	e.signingKey = ed25519.NewKeyFromSeed(seed)
+
+	return e.signingKey, nil
+}
+
+func (e *Exports) purge() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	clear(e.cache)
+}
+
+func hashAPIKey(apiKey string) string {
+	digest := sha256.Sum256([]byte(apiKey))
+
+	return hex.EncodeToString(digest[:])
+}
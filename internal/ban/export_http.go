@@ -0,0 +1,91 @@
+package ban
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/leighmacdonald/gbans/internal/httphelper"
+)
+
+type exportHandler struct {
+	exports *Exports
+}
+
+// NewExportHandler registers the plain HTTP endpoints used to download feeds. These are served outside the
+// rpc api so that srcds, bot detector and other tools can fetch them directly.
+func NewExportHandler(mux *http.ServeMux, exports *Exports) {
+	handler := exportHandler{exports: exports}
+	mux.HandleFunc("GET /export/feeds/{name}/{format}", handler.getFeed())
+	mux.HandleFunc("GET /export/public_key", handler.getPublicKey())
+}
+
+// apiKey reads the consumer key from the X-API-Key header, falling back to the key query parameter for
+// clients that cannot set headers.
+func apiKey(req *http.Request) string {
+	if key := req.Header.Get("X-API-Key"); key != "" {
+		return key
+	}
+
+	return req.URL.Query().Get("key")
+}
+
+func (h exportHandler) getFeed() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		feed, errRender := h.exports.Render(req.Context(), req.PathValue("name"), ExportFormat(req.PathValue("format")), apiKey(req))
+		if errRender != nil {
+			switch {
+			case errors.Is(errRender, ErrFeedNotFound), errors.Is(errRender, ErrExportFormat):
+				httphelper.SetError(res, req, httphelper.NewAPIError(http.StatusNotFound, httphelper.ErrNotFound))
+			case errors.Is(errRender, ErrExportAPIKey):
+				httphelper.SetError(res, req, httphelper.NewAPIError(http.StatusForbidden, ErrExportAPIKey))
+			default:
+				slog.Error("Failed to render export feed", slog.String("error", errRender.Error()))
+				httphelper.SetError(res, req, httphelper.NewAPIError(http.StatusInternalServerError, httphelper.ErrInternal))
+			}
+
+			return
+		}
+
+		res.Header().Set("ETag", feed.ETag)
+		res.Header().Set("Cache-Control", "no-cache")
+		res.Header().Set("X-Signature", feed.Signature)
+		res.Header().Set("X-Signature-Algorithm", "ed25519")
+
+		if match := req.Header.Get("If-None-Match"); match != "" && etagMatches(match, feed.ETag) {
+			res.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		res.Header().Set("Content-Disposition", fmt.Sprintf(`inline; filename="%s"`, feed.Filename))
+		httphelper.RespondData(res, http.StatusOK, feed.ContentType, feed.Body)
+	}
+}
+
+func (h exportHandler) getPublicKey() http.HandlerFunc {
+	return func(res http.ResponseWriter, req *http.Request) {
+		key, errKey := h.exports.PublicKey(req.Context())
+		if errKey != nil {
+			slog.Error("Failed to load export public key", slog.String("error", errKey.Error()))
+			httphelper.SetError(res, req, httphelper.NewAPIError(http.StatusInternalServerError, httphelper.ErrInternal))
+
+			return
+		}
+
+		httphelper.RespondData(res, http.StatusOK, "application/x-pem-file", []byte(key))
+	}
+}
+
+func etagMatches(header string, etag string) bool {
+	for candidate := range strings.SplitSeq(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
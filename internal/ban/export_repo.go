@@ -0,0 +1,257 @@
+package ban
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+type ExportRepository struct {
+	database.Database
+}
+
+func NewExportRepository(database database.Database) ExportRepository {
+	return ExportRepository{Database: database}
+}
+
+const feedColumns = `feed_id, name, title, description, reasons, ban_types, min_duration_seconds, max_age_seconds,
+	public, enabled, created_on, updated_on`
+
+type feedScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanFeed(row feedScanner) (Feed, error) {
+	var (
+		feed        Feed
+		reasons     []int32
+		banTypes    []int32
+		minDuration int64
+		maxAge      int64
+	)
+
+	if err := row.Scan(&feed.FeedID, &feed.Name, &feed.Title, &feed.Description, &reasons, &banTypes,
+		&minDuration, &maxAge, &feed.Public, &feed.Enabled, &feed.CreatedOn, &feed.UpdatedOn); err != nil {
+		return feed, database.Err(err)
+	}
+
+	feed.Reasons = make([]reason.Reason, len(reasons))
+	for idx, value := range reasons {
+		feed.Reasons[idx] = reason.Reason(value)
+	}
+
+	feed.BanTypes = make([]bantype.Type, len(banTypes))
+	for idx, value := range banTypes {
+		feed.BanTypes[idx] = bantype.Type(value)
+	}
+
+	feed.MinDuration = time.Duration(minDuration) * time.Second
+	feed.MaxAge = time.Duration(maxAge) * time.Second
+
+	return feed, nil
+}
+
+func (r ExportRepository) Feeds(ctx context.Context) ([]Feed, error) {
+	rows, errRows := r.Query(ctx, `SELECT `+feedColumns+` FROM export_feed ORDER BY name`)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	feeds := []Feed{}
+	for rows.Next() {
+		feed, errScan := scanFeed(rows)
+		if errScan != nil {
+			return nil, errors.Join(errScan, database.ErrScanResult)
+		}
+
+		feeds = append(feeds, feed)
+	}
+
+	return feeds, rows.Err()
+}
+
+func (r ExportRepository) FeedByName(ctx context.Context, name string) (Feed, error) {
+	return scanFeed(r.QueryRow(ctx, `SELECT `+feedColumns+` FROM export_feed WHERE name = $1`, name))
+}
+
+func (r ExportRepository) SaveFeed(ctx context.Context, feed *Feed) error {
+	reasons := make([]int32, len(feed.Reasons))
+	for idx, value := range feed.Reasons {
+		reasons[idx] = int32(value) //nolint:gosec
+	}
+
+	banTypes := make([]int32, len(feed.BanTypes))
+	for idx, value := range feed.BanTypes {
+		banTypes[idx] = int32(value) //nolint:gosec
+	}
+
+	minDuration := int64(feed.MinDuration.Seconds())
+	maxAge := int64(feed.MaxAge.Seconds())
+
+	if feed.FeedID > 0 {
+		return database.Err(r.QueryRow(ctx, `
+			UPDATE export_feed
+			SET name = $2, title = $3, description = $4, reasons = $5, ban_types = $6, min_duration_seconds = $7,
+			    max_age_seconds = $8, public = $9, enabled = $10, updated_on = $11
+			WHERE feed_id = $1
+			RETURNING created_on`,
+			feed.FeedID, feed.Name, feed.Title, feed.Description, reasons, banTypes, minDuration, maxAge,
+			feed.Public, feed.Enabled, feed.UpdatedOn).Scan(&feed.CreatedOn))
+	}
+
+	return database.Err(r.QueryRow(ctx, `
+		INSERT INTO export_feed (name, title, description, reasons, ban_types, min_duration_seconds, max_age_seconds,
+		                         public, enabled, created_on, updated_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING feed_id`,
+		feed.Name, feed.Title, feed.Description, reasons, banTypes, minDuration, maxAge,
+		feed.Public, feed.Enabled, feed.CreatedOn, feed.UpdatedOn).Scan(&feed.FeedID))
+}
+
+func (r ExportRepository) DeleteFeed(ctx context.Context, feedID int32) error {
+	return database.Err(r.Exec(ctx, `DELETE FROM export_feed WHERE feed_id = $1`, feedID))
+}
+
+func (r ExportRepository) Consumers(ctx context.Context, feedID int32) ([]Consumer, error) {
+	rows, errRows := r.Query(ctx, `
+		SELECT consumer_id, feed_id, name, enabled, created_on, last_used_on
+		FROM export_consumer
+		WHERE feed_id = $1
+		ORDER BY consumer_id`, feedID)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	consumers := []Consumer{}
+	for rows.Next() {
+		var (
+			consumer   Consumer
+			lastUsedOn *time.Time
+		)
+
+		if err := rows.Scan(&consumer.ConsumerID, &consumer.FeedID, &consumer.Name, &consumer.Enabled,
+			&consumer.CreatedOn, &lastUsedOn); err != nil {
+			return nil, errors.Join(err, database.ErrScanResult)
+		}
+
+		if lastUsedOn != nil {
+			consumer.LastUsedOn = *lastUsedOn
+		}
+
+		consumers = append(consumers, consumer)
+	}
+
+	return consumers, rows.Err()
+}
+
+func (r ExportRepository) SaveConsumer(ctx context.Context, consumer *Consumer, keyHash string) error {
+	return database.Err(r.QueryRow(ctx, `
+		INSERT INTO export_consumer (feed_id, name, key_hash, enabled, created_on)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING consumer_id`,
+		consumer.FeedID, consumer.Name, keyHash, consumer.Enabled, consumer.CreatedOn).Scan(&consumer.ConsumerID))
+}
+
+func (r ExportRepository) DeleteConsumer(ctx context.Context, consumerID int32) error {
+	return database.Err(r.Exec(ctx, `DELETE FROM export_consumer WHERE consumer_id = $1`, consumerID))
+}
+
+// UseConsumer records the usage of an enabled consumers key for the feed. database.ErrNoResult is returned
+// when no such consumer exists.
+func (r ExportRepository) UseConsumer(ctx context.Context, feedID int32, keyHash string) error {
+	var consumerID int32
+
+	return database.Err(r.QueryRow(ctx, `
+		UPDATE export_consumer
+		SET last_used_on = now()
+		WHERE feed_id = $1 AND key_hash = $2 AND enabled = true
+		RETURNING consumer_id`, feedID, keyHash).Scan(&consumerID))
+}
+
+// EnsureSigningKey stores the candidate seed unless a key already exists and returns the stored seed.
+func (r ExportRepository) EnsureSigningKey(ctx context.Context, candidate []byte) ([]byte, error) {
+	if err := r.Exec(ctx, `
+		INSERT INTO export_signing_key (key_id, private_key, created_on)
+		VALUES (1, $1, now())
+		ON CONFLICT (key_id) DO NOTHING`, candidate); err != nil {
+		return nil, database.Err(err)
+	}
+
+	var seed []byte
+	if err := r.QueryRow(ctx, `SELECT private_key FROM export_signing_key WHERE key_id = 1`).Scan(&seed); err != nil {
+		return nil, database.Err(err)
+	}
+
+	return seed, nil
+}
+
+// Entries returns the active, unexpired player bans matching the filters of the feed.
+func (r ExportRepository) Entries(ctx context.Context, feed Feed) ([]ExportEntry, error) {
+	reasons := make([]int32, len(feed.Reasons))
+	for idx, value := range feed.Reasons {
+		reasons[idx] = int32(value) //nolint:gosec
+	}
+
+	banTypes := make([]int32, len(feed.BanTypes))
+	for idx, value := range feed.BanTypes {
+		banTypes[idx] = int32(value) //nolint:gosec
+	}
+
+	rows, errRows := r.Query(ctx, `
+		SELECT b.ban_id, b.target_id, coalesce(p.personaname, ''), b.ban_type, b.reason, b.reason_text,
+		       coalesce(b.cidr::text, ''), b.created_on, b.valid_until, seen.last_seen
+		FROM ban b
+		LEFT JOIN person p ON p.steam_id = b.target_id
+		LEFT JOIN LATERAL (
+			SELECT max(c.created_on) AS last_seen
+			FROM person_connections c
+			WHERE c.steam_id = b.target_id
+		) seen ON TRUE
+		WHERE b.deleted = false
+		  AND b.is_enabled = true
+		  AND b.valid_until > now()
+		  AND b.target_id < $1
+		  AND (cardinality($2::int[]) = 0 OR b.reason = ANY ($2::int[]))
+		  AND (cardinality($3::int[]) = 0 OR b.ban_type = ANY ($3::int[]))
+		  AND ($4::bigint = 0 OR b.valid_until - b.created_on >= make_interval(secs => $4::bigint))
+		  AND ($5::bigint = 0 OR b.created_on >= now() - make_interval(secs => $5::bigint))
+		ORDER BY b.ban_id`,
+		steamid.BaseGID, reasons, banTypes, int64(feed.MinDuration.Seconds()), int64(feed.MaxAge.Seconds()))
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	entries := []ExportEntry{}
+	for rows.Next() {
+		var (
+			entry    ExportEntry
+			targetID int64
+			lastSeen *time.Time
+		)
+
+		if err := rows.Scan(&entry.BanID, &targetID, &entry.Personaname, &entry.BanType, &entry.Reason,
+			&entry.ReasonText, &entry.CIDR, &entry.CreatedOn, &entry.ValidUntil, &lastSeen); err != nil {
+			return nil, errors.Join(err, database.ErrScanResult)
+		}
+
+		entry.SteamID = steamid.New(targetID)
+		if lastSeen != nil {
+			entry.LastSeen = *lastSeen
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
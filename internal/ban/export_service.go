@@ -7,72 +7,87 @@ import (
 	"slices"
 
 	"connectrpc.com/connect"
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
 	"github.com/leighmacdonald/gbans/internal/ban/reason"
 	v1 "github.com/leighmacdonald/gbans/internal/ban/v1"
 	"github.com/leighmacdonald/gbans/internal/ban/v1/banv1connect"
 	"github.com/leighmacdonald/gbans/internal/database"
 	"github.com/leighmacdonald/gbans/internal/rpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ExportService struct {
 	// banv1connect.UnimplementedExportServiceHandler
 
-	bans           Bans
+	exports        *Exports
 	authorizedKeys []string
 	siteName       string
 }
 
-func NewExportService(bans Bans, authorizedKeys []string, siteName string, option ...connect.HandlerOption) rpc.Service {
+func NewExportService(exports *Exports, authorizedKeys []string, siteName string,
+	authMiddleware *rpc.Middleware, option ...connect.HandlerOption,
+) rpc.Service {
 	pattern, handler := banv1connect.NewExportServiceHandler(ExportService{
-		bans:           bans,
-		authorizedKeys: authorizedKeys,
-		siteName:       siteName,
+		exports: exports,
+		authorizedKeys: slices.DeleteFunc(authorizedKeys, func(key string) bool {
+			return key == ""
+		}),
+		siteName: siteName,
 	}, option...)
 
+	authMiddleware.UserRoute(banv1connect.ExportServiceFeedsProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(banv1connect.ExportServiceFeedSaveProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(banv1connect.ExportServiceFeedDeleteProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(banv1connect.ExportServiceConsumersProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(banv1connect.ExportServiceConsumerCreateProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(banv1connect.ExportServiceConsumerDeleteProcedure, rpc.WithMinPermissions(permission.Admin))
+
 	return rpc.Service{Pattern: pattern, Handler: handler}
 }
 
-func (s ExportService) GetTF2BD(ctx context.Context, req *v1.GetTF2BDRequest) (*v1.GetTF2BDResponse, error) {
-	if len(s.authorizedKeys) > 0 {
-		key := req.GetKey()
-		if key == "" || !slices.Contains(s.authorizedKeys, key) {
-			return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
-		}
-	}
+// authorized checks the key of the legacy tf2bd and valve lists against the configured keys. Without any
+// configured keys these lists stay public, as they always have been. Feeds, including the SourceBans xml format,
+// are instead restricted per feed using consumer keys.
+func (s ExportService) authorized(key string) bool {
+	return len(s.authorizedKeys) == 0 || key != "" && slices.Contains(s.authorizedKeys, key)
+}
 
-	bans, errBans := s.bans.Query(ctx, QueryOpts{})
-	if errBans != nil {
+func (s ExportService) GetTF2BD(ctx context.Context, req *v1.GetTF2BDRequest) (*v1.GetTF2BDResponse, error) {
+	if !s.authorized(req.GetKey()) {
 		return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
 	}
 
-	var filtered []Ban
-
-	for _, curBan := range bans {
-		if curBan.Reason != reason.Cheating || curBan.Deleted || !curBan.IsEnabled {
-			continue
-		}
-
-		filtered = append(filtered, curBan)
+	entries, errEntries := s.exports.Entries(ctx, Feed{Reasons: []reason.Reason{reason.Cheating}})
+	if errEntries != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
 	}
 
 	resp := v1.GetTF2BDResponse{
-		Schema: new("https://raw.githubusercontent.com/PazerOP/tf2_bot_detector/master/schemas/v3/playerlist.schema.json"),
+		Schema: new(tf2bdSchemaURL),
 		FileInfo: &v1.FileInfo{
 			Authors:     []string{s.siteName},
-			Description: new("Players permanently banned for cheating"),
+			Description: new("Players banned for cheating"),
 			Title:       new(s.siteName + " Cheater List"),
 			UpdateUrl:   new("/export/bans/tf2bd"),
 		},
 		Players: []*v1.Player{},
 	}
 
-	for _, ban := range filtered {
+	for _, entry := range entries {
+		seen := entry.LastSeen
+		if seen.IsZero() {
+			seen = entry.CreatedOn
+		}
+
 		resp.Players = append(resp.Players, &v1.Player{
-			Attributes: []string{"cheater"},
-			SteamId:    new(string(ban.TargetID.Steam3())),
+			Attributes: tf2bdAttributes(entry.Reason),
+			SteamId:    new(string(entry.SteamID.Steam3())),
 			LastSeen: &v1.LastSeen{
-				PlayerName: new(ban.TargetID.String()),
-				Time:       new(int32(ban.UpdatedOn.Unix())), //nolint:gosec
+				PlayerName: new(entry.Personaname),
+				Time:       new(int32(seen.Unix())), //nolint:gosec
 			},
 		})
 	}
@@ -81,28 +96,168 @@ func (s ExportService) GetTF2BD(ctx context.Context, req *v1.GetTF2BDRequest) (*
 }
 
 func (s ExportService) GetValveSteamID(ctx context.Context, req *v1.GetValveSteamIDRequest) (*v1.GetValveSteamIDResponse, error) {
-	if len(s.authorizedKeys) > 0 {
-		key := req.GetKey()
-		if key == "key" || !slices.Contains(s.authorizedKeys, key) {
-			return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
-		}
+	if !s.authorized(req.GetKey()) {
+		return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
 	}
 
-	// TODO limit to perm?
-	bans, errBans := s.bans.Query(ctx, QueryOpts{})
-	if errBans != nil && !errors.Is(errBans, database.ErrNoResult) {
+	entries, errEntries := s.exports.Entries(ctx, Feed{BanTypes: []bantype.Type{bantype.Banned}})
+	if errEntries != nil {
 		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
 	}
 
 	resp := v1.GetValveSteamIDResponse{}
 
-	for _, ban := range bans {
-		if ban.Deleted || !ban.IsEnabled {
-			continue
+	for _, entry := range entries {
+		resp.BanLines = append(resp.BanLines, fmt.Sprintf("banid %d %s", entry.minutesRemaining(), entry.SteamID.Steam(false)))
+	}
+
+	return &resp, nil
+}
+
+func (s ExportService) Feeds(ctx context.Context, _ *emptypb.Empty) (*v1.FeedsResponse, error) {
+	feeds, errFeeds := s.exports.Feeds(ctx)
+	if errFeeds != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := &v1.FeedsResponse{Feeds: make([]*v1.Feed, len(feeds))}
+	for idx, feed := range feeds {
+		resp.Feeds[idx] = toFeed(feed)
+	}
+
+	for _, format := range ExportFormats {
+		resp.Formats = append(resp.Formats, string(format))
+	}
+
+	return resp, nil
+}
+
+func (s ExportService) FeedSave(ctx context.Context, req *v1.FeedSaveRequest) (*v1.FeedSaveResponse, error) {
+	reqFeed := req.GetFeed()
+	feed := Feed{
+		FeedID:      reqFeed.GetFeedId(),
+		Name:        reqFeed.GetName(),
+		Title:       reqFeed.GetTitle(),
+		Description: reqFeed.GetDescription(),
+		MinDuration: reqFeed.GetMinDuration().AsDuration(),
+		MaxAge:      reqFeed.GetMaxAge().AsDuration(),
+		Public:      reqFeed.GetPublic(),
+		Enabled:     reqFeed.GetEnabled(),
+	}
+
+	for _, banReason := range reqFeed.GetReasons() {
+		feed.Reasons = append(feed.Reasons, reason.Reason(banReason))
+	}
+
+	for _, banType := range reqFeed.GetBanTypes() {
+		feed.BanTypes = append(feed.BanTypes, bantype.Type(banType))
+	}
+
+	if errSave := s.exports.SaveFeed(ctx, &feed); errSave != nil {
+		switch {
+		case errors.Is(errSave, ErrInvalidFeed):
+			return nil, connect.NewError(connect.CodeInvalidArgument, errSave)
+		case errors.Is(errSave, database.ErrDuplicate):
+			return nil, connect.NewError(connect.CodeAlreadyExists, rpc.ErrExists)
+		case errors.Is(errSave, database.ErrNoResult):
+			return nil, connect.NewError(connect.CodeNotFound, rpc.ErrNotFound)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
 		}
+	}
 
-		resp.BanLines = append(resp.BanLines, fmt.Sprintf("banid 0 %s", ban.TargetID.Steam(false)))
+	return &v1.FeedSaveResponse{Feed: toFeed(feed)}, nil
+}
+
+func (s ExportService) FeedDelete(ctx context.Context, req *v1.FeedDeleteRequest) (*emptypb.Empty, error) {
+	if errDelete := s.exports.DeleteFeed(ctx, req.GetFeedId()); errDelete != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
 	}
 
-	return &resp, nil
+	return &emptypb.Empty{}, nil
+}
+
+func (s ExportService) Consumers(ctx context.Context, req *v1.ConsumersRequest) (*v1.ConsumersResponse, error) {
+	consumers, errConsumers := s.exports.Consumers(ctx, req.GetFeedId())
+	if errConsumers != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := &v1.ConsumersResponse{Consumers: make([]*v1.Consumer, len(consumers))}
+	for idx, consumer := range consumers {
+		resp.Consumers[idx] = toConsumer(consumer)
+	}
+
+	return resp, nil
+}
+
+func (s ExportService) ConsumerCreate(ctx context.Context, req *v1.ConsumerCreateRequest) (*v1.ConsumerCreateResponse, error) {
+	consumer, apiKey, errCreate := s.exports.CreateConsumer(ctx, req.GetFeedId(), req.GetName())
+	if errCreate != nil {
+		if errors.Is(errCreate, ErrInvalidFeed) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errCreate)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &v1.ConsumerCreateResponse{Consumer: toConsumer(consumer), ApiKey: &apiKey}, nil
+}
+
+func (s ExportService) ConsumerDelete(ctx context.Context, req *v1.ConsumerDeleteRequest) (*emptypb.Empty, error) {
+	if errDelete := s.exports.DeleteConsumer(ctx, req.GetConsumerId()); errDelete != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s ExportService) PublicKey(ctx context.Context, _ *emptypb.Empty) (*v1.PublicKeyResponse, error) {
+	key, errKey := s.exports.PublicKey(ctx)
+	if errKey != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &v1.PublicKeyResponse{PublicKey: &key}, nil
+}
+
+func toFeed(feed Feed) *v1.Feed {
+	out := &v1.Feed{
+		FeedId:      &feed.FeedID,
+		Name:        &feed.Name,
+		Title:       &feed.Title,
+		Description: &feed.Description,
+		MinDuration: durationpb.New(feed.MinDuration),
+		MaxAge:      durationpb.New(feed.MaxAge),
+		Public:      &feed.Public,
+		Enabled:     &feed.Enabled,
+		CreatedOn:   timestamppb.New(feed.CreatedOn),
+		UpdatedOn:   timestamppb.New(feed.UpdatedOn),
+	}
+
+	for _, banReason := range feed.Reasons {
+		out.Reasons = append(out.Reasons, v1.BanReason(banReason)) //nolint:gosec
+	}
+
+	for _, banType := range feed.BanTypes {
+		out.BanTypes = append(out.BanTypes, v1.BanType(banType)) //nolint:gosec
+	}
+
+	return out
+}
+
+func toConsumer(consumer Consumer) *v1.Consumer {
+	out := &v1.Consumer{
+		ConsumerId: &consumer.ConsumerID,
+		FeedId:     &consumer.FeedID,
+		Name:       &consumer.Name,
+		Enabled:    &consumer.Enabled,
+		CreatedOn:  timestamppb.New(consumer.CreatedOn),
+	}
+
+	if !consumer.LastUsedOn.IsZero() {
+		out.LastUsedOn = timestamppb.New(consumer.LastUsedOn)
+	}
+
+	return out
 }
@@ -0,0 +1,126 @@
+package ban_test
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban"
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/leighmacdonald/gbans/internal/thirdparty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	var (
+		now     = time.Now()
+		feed    = ban.Feed{Name: "cheaters", Title: "Cheaters", Description: "Cheating bans"}
+		entries = []ban.ExportEntry{
+			{
+				BanID: 1, SteamID: tests.UserSID, Personaname: "user", BanType: bantype.Banned, Reason: reason.Cheating,
+				CreatedOn: now.Add(-time.Hour), ValidUntil: now.AddDate(10, 0, 0), LastSeen: now.Add(-time.Minute),
+			},
+			{
+				BanID: 2, SteamID: tests.ModSID, Personaname: "mod", BanType: bantype.Banned, Reason: reason.Racism,
+				CIDR: "192.0.2.1/32", CreatedOn: now.Add(-time.Hour), ValidUntil: now.Add(time.Hour),
+			},
+			{
+				BanID: 3, SteamID: tests.OwnerSID, Personaname: "owner", BanType: bantype.NoComm, Reason: reason.Spam,
+				CIDR: "192.0.2.0/24", CreatedOn: now, ValidUntil: now.Add(time.Hour),
+			},
+		}
+	)
+
+	bannedUser, errUser := ban.Render(feed, ban.FormatBannedUser, entries, "gbans")
+	require.NoError(t, errUser)
+	require.Equal(t, "banid 0 "+string(tests.UserSID.Steam(false))+"\nbanid 60 "+string(tests.ModSID.Steam(false))+"\n",
+		string(bannedUser))
+
+	bannedIP, errIP := ban.Render(feed, ban.FormatBannedIP, entries, "gbans")
+	require.NoError(t, errIP)
+	require.Equal(t, "addip 60 192.0.2.1\n", string(bannedIP))
+
+	csvBody, errCSV := ban.Render(feed, ban.FormatCSV, entries, "gbans")
+	require.NoError(t, errCSV)
+	records, errRead := csv.NewReader(strings.NewReader(string(csvBody))).ReadAll()
+	require.NoError(t, errRead)
+	require.Len(t, records, len(entries)+1)
+	require.Equal(t, tests.UserSID.String(), records[1][1])
+	require.Equal(t, "true", records[1][11])
+	require.Empty(t, records[2][12])
+
+	tf2bd, errTF2BD := ban.Render(feed, ban.FormatTF2BD, entries, "gbans")
+	require.NoError(t, errTF2BD)
+
+	var list thirdparty.TF2BDSchema
+	require.NoError(t, json.Unmarshal(tf2bd, &list))
+	require.Len(t, list.Players, len(entries))
+	require.Equal(t, []string{"cheater"}, list.Players[0].Attributes)
+	require.Equal(t, "user", list.Players[0].LastSeen.PlayerName)
+	require.Equal(t, int(entries[0].LastSeen.Unix()), list.Players[0].LastSeen.Time)
+	require.Equal(t, []string{"racist"}, list.Players[1].Attributes)
+	require.Equal(t, int(entries[1].CreatedOn.Unix()), list.Players[1].LastSeen.Time)
+
+	// Identical entries must render identically so the ETag is stable.
+	first, errFirst := ban.Render(feed, ban.FormatJSON, entries, "gbans")
+	require.NoError(t, errFirst)
+	again, errAgain := ban.Render(feed, ban.FormatJSON, entries, "gbans")
+	require.NoError(t, errAgain)
+	require.Equal(t, first, again)
+
+	sourceBans, errSourceBans := ban.Render(feed, ban.FormatSourceBans, entries, "gbans")
+	require.NoError(t, errSourceBans)
+
+	type sourceBansRow struct {
+		BanID   int32  `xml:"bid"`
+		AuthID  string `xml:"authid"`
+		IP      string `xml:"ip"`
+		Created int64  `xml:"created"`
+		Ends    int64  `xml:"ends"`
+		Length  int64  `xml:"length"`
+		Reason  string `xml:"reason"`
+		Type    int32  `xml:"type"`
+	}
+
+	var doc struct {
+		Name  string          `xml:"name,attr"`
+		Bans  []sourceBansRow `xml:"bans>ban"`
+		Comms []sourceBansRow `xml:"comms>comm"`
+	}
+	require.NoError(t, xml.Unmarshal(sourceBans, &doc))
+	require.Equal(t, feed.Name, doc.Name)
+	require.Len(t, doc.Bans, 2)
+	require.Equal(t, string(tests.UserSID.Steam(false)), doc.Bans[0].AuthID)
+	require.Equal(t, int64(0), doc.Bans[0].Length)
+	require.Equal(t, doc.Bans[0].Created, doc.Bans[0].Ends)
+	require.Equal(t, "192.0.2.1", doc.Bans[1].IP)
+	require.Equal(t, int64(time.Hour.Seconds()*2), doc.Bans[1].Length)
+	require.Equal(t, reason.Racism.String(), doc.Bans[1].Reason)
+	require.Equal(t, []sourceBansRow{{
+		BanID: 3, AuthID: string(tests.OwnerSID.Steam(false)), Created: now.Unix(), Ends: now.Add(time.Hour).Unix(),
+		Length: 3600, Reason: reason.Spam.String(), Type: 3,
+	}}, doc.Comms)
+
+	_, errFormat := ban.Render(feed, ban.ExportFormat("yaml"), entries, "gbans")
+	require.ErrorIs(t, errFormat, ban.ErrExportFormat)
+}
+
+func TestFeedValidate(t *testing.T) {
+	valid := ban.Feed{Name: "cheaters-2", Title: "Cheaters", Reasons: []reason.Reason{reason.Cheating}, BanTypes: []bantype.Type{bantype.Banned}}
+	require.NoError(t, valid.Validate())
+
+	for _, feed := range []ban.Feed{
+		{Name: "Cheaters", Title: "Cheaters"},
+		{Name: "cheaters", Title: " "},
+		{Name: "cheaters", Title: "Cheaters", MinDuration: -time.Second},
+		{Name: "cheaters", Title: "Cheaters", Reasons: []reason.Reason{0}},
+		{Name: "cheaters", Title: "Cheaters", BanTypes: []bantype.Type{bantype.Network}},
+	} {
+		require.ErrorIs(t, feed.Validate(), ban.ErrInvalidFeed)
+	}
+}
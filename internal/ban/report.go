@@ -158,20 +158,26 @@ type ReportMeta struct {
 
 type Reports struct {
 	repository ReportRepository
+	bans       Repository
 	persons    *person.Persons
 	demos      demo.Demos
+	chat       ChatEvidence
+	detections DetectionEvidence
 	tfAPI      thirdparty.APIProvider
 	notif      notification.Notifier
 	logChannel string
 }
 
-func NewReports(repo ReportRepository, persons *person.Persons, demos demo.Demos, tfAPI thirdparty.APIProvider,
-	notif notification.Notifier, logChannel string,
+func NewReports(repo ReportRepository, bans Repository, persons *person.Persons, demos demo.Demos, chat ChatEvidence,
+	detections DetectionEvidence, tfAPI thirdparty.APIProvider, notif notification.Notifier, logChannel string,
 ) Reports {
 	return Reports{
 		repository: repo,
+		bans:       bans,
 		persons:    persons,
 		demos:      demos,
+		chat:       chat,
+		detections: detections,
 		tfAPI:      tfAPI,
 		notif:      notif,
 		logChannel: logChannel,
@@ -432,13 +438,11 @@ func (r Reports) Save(ctx context.Context, currentUser personDomain.BaseUser, re
 	if existing.ReportID > 0 {
 		return ReportWithAuthor{}, ErrReportExists
 	}
-	var demo *demo.File
+
 	if req.DemoID > 0 {
-		foundDemo, errDemo := r.demos.GetDemoByID(ctx, req.DemoID)
-		if errDemo != nil {
+		if _, errDemo := r.demos.GetDemoByID(ctx, req.DemoID); errDemo != nil {
 			return ReportWithAuthor{}, errDemo
 		}
-		demo = foundDemo
 	}
 
 	// TODO encapsulate all operations in single tx
@@ -459,11 +463,7 @@ func (r Reports) Save(ctx context.Context, currentUser personDomain.BaseUser, re
 
 	slog.Info("New report created", slog.Int64("report_id", int64(report.ReportID)))
 
-	if demo.DemoID > 0 && !demo.Archive {
-		if errMark := r.demos.MarkArchived(ctx, demo); errMark != nil {
-			slog.Error("Failed to mark demo as archived", slog.String("error", errMark.Error()))
-		}
-	}
+	r.attachEvidence(ctx, report)
 
 	newReport, errReport := r.Report(ctx, currentUser, report.ReportID)
 	if errReport != nil {
@@ -0,0 +1,505 @@
+package ban
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/gbans/internal/chat"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/database/query"
+	"github.com/leighmacdonald/gbans/internal/demo"
+	personDomain "github.com/leighmacdonald/gbans/internal/domain/person"
+	"github.com/leighmacdonald/gbans/internal/httphelper"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+const (
+	// evidenceChatPadding is the number of messages either side of a reported message that are attached.
+	evidenceChatPadding = 10
+	// evidenceChatWindow is how far back the targets chat is attached when no message was reported.
+	evidenceChatWindow = 30 * time.Minute
+	evidenceChatLimit  = 25
+	// evidenceDetectionAge is how far back anticheat detections are attached.
+	evidenceDetectionAge   = 30 * 24 * time.Hour
+	evidenceDetectionLimit = 25
+	// evidenceDemoSyncAge is how long after creation a report is checked for a newly uploaded demo.
+	evidenceDemoSyncAge = 24 * time.Hour
+)
+
+// Weights used to score the severity of a report cluster. Each kind of evidence is counted at most
+// severityEvidenceCap times so a single noisy source cannot dominate the score.
+const (
+	severityDetection   = 2.0
+	severityPriorBan    = 1.5
+	severityActiveBan   = 1.0
+	severityFlaggedChat = 1.0
+	severityEvidenceCap = 5
+)
+
+type EvidenceKind int
+
+const (
+	EvidenceChat EvidenceKind = iota + 1
+	EvidenceDetection
+	EvidenceBan
+	EvidenceDemo
+)
+
+func (k EvidenceKind) String() string {
+	switch k {
+	case EvidenceChat:
+		return "Chat"
+	case EvidenceDetection:
+		return "Anticheat"
+	case EvidenceBan:
+		return "Ban"
+	case EvidenceDemo:
+		return "Demo"
+	default:
+		return "Unknown"
+	}
+}
+
+// ReportEvidence is a record attached to a report automatically when it was created.
+type ReportEvidence struct {
+	ReportEvidenceID int64
+	ReportID         int32
+	Kind             EvidenceKind
+	// RefID is the id of the source record, eg: the person_message_id of chat evidence.
+	RefID    int64
+	SteamID  steamid.SteamID
+	ServerID int32
+	// Summary is a snapshot of the source record at the time it was attached.
+	Summary string
+	// Flagged is set for chat messages matched by a word filter and for bans that are still active.
+	Flagged   bool
+	CreatedOn time.Time
+}
+
+// ReportCluster groups the open reports against a single target for triage.
+type ReportCluster struct {
+	TargetID steamid.SteamID
+	Subject  personDomain.Core
+	Reports  []ReportWithAuthor
+	// Evidence is the combined evidence of all reports, without duplicates.
+	Evidence     []ReportEvidence
+	Severity     float64
+	LastReported time.Time
+}
+
+// ChatEvidence provides the chat history attached to new reports.
+type ChatEvidence interface {
+	GetPersonMessage(ctx context.Context, messageID int64) (*chat.QueryChatHistoryResult, error)
+	GetPersonMessageContext(ctx context.Context, messageID int64, paddedMessageCount int32) ([]chat.QueryChatHistoryResult, error)
+	QueryChatHistory(ctx context.Context, permissions permission.Privilege, req chat.HistoryQueryFilter) ([]*chat.QueryChatHistoryResult, error)
+}
+
+// DetectionEvidence provides the anticheat detections attached to new reports.
+type DetectionEvidence interface {
+	BySteamID(ctx context.Context, steamID steamid.SteamID) ([]logparse.StacEntry, error)
+}
+
+// Evidence returns the evidence attached to the report.
+func (r Reports) Evidence(ctx context.Context, reportID int32) ([]ReportEvidence, error) {
+	return r.repository.GetReportEvidence(ctx, []int32{reportID})
+}
+
+// Triage returns the open reports clustered by their target, most severe first.
+func (r Reports) Triage(ctx context.Context) ([]ReportCluster, error) {
+	reports, errReports := r.Reports(ctx)
+	if errReports != nil {
+		return nil, errReports
+	}
+
+	var (
+		open      []ReportWithAuthor
+		reportIDs []int32
+	)
+
+	for _, report := range reports {
+		if report.ReportStatus == Opened || report.ReportStatus == NeedMoreInfo {
+			open = append(open, report)
+			reportIDs = append(reportIDs, report.ReportID)
+		}
+	}
+
+	evidence, errEvidence := r.repository.GetReportEvidence(ctx, reportIDs)
+	if errEvidence != nil {
+		return nil, errEvidence
+	}
+
+	return ClusterReports(open, evidence), nil
+}
+
+// ClusterReports groups reports by their target and scores each group, most severe first.
+func ClusterReports(reports []ReportWithAuthor, evidence []ReportEvidence) []ReportCluster {
+	var (
+		clusters []ReportCluster
+		index    = map[steamid.SteamID]int{}
+		owner    = map[int32]steamid.SteamID{}
+	)
+
+	for _, report := range reports {
+		idx, found := index[report.TargetID]
+		if !found {
+			idx = len(clusters)
+			index[report.TargetID] = idx
+			clusters = append(clusters, ReportCluster{TargetID: report.TargetID, Subject: report.Subject})
+		}
+
+		clusters[idx].Reports = append(clusters[idx].Reports, report)
+		if report.CreatedOn.After(clusters[idx].LastReported) {
+			clusters[idx].LastReported = report.CreatedOn
+		}
+
+		owner[report.ReportID] = report.TargetID
+	}
+
+	for _, item := range evidence {
+		targetID, found := owner[item.ReportID]
+		if !found {
+			continue
+		}
+
+		cluster := &clusters[index[targetID]]
+		if !slices.ContainsFunc(cluster.Evidence, func(existing ReportEvidence) bool {
+			return existing.Kind == item.Kind && existing.RefID == item.RefID
+		}) {
+			cluster.Evidence = append(cluster.Evidence, item)
+		}
+	}
+
+	for idx := range clusters {
+		clusters[idx].Severity = Severity(clusters[idx].TargetID, clusters[idx].Reports, clusters[idx].Evidence)
+	}
+
+	slices.SortStableFunc(clusters, func(a, b ReportCluster) int {
+		if order := cmp.Compare(b.Severity, a.Severity); order != 0 {
+			return order
+		}
+
+		return b.LastReported.Compare(a.LastReported)
+	})
+
+	return clusters
+}
+
+// Severity scores the reports against a target. Each distinct reporter adds the weight of the most severe
+// reason they reported, and the evidence against the target adds to that.
+func Severity(targetID steamid.SteamID, reports []ReportWithAuthor, evidence []ReportEvidence) float64 {
+	var (
+		score     float64
+		reporters = map[steamid.SteamID]float64{}
+		counts    = map[EvidenceKind]int{}
+		active    int
+	)
+
+	for _, report := range reports {
+		reporters[report.SourceID] = max(reporters[report.SourceID], reasonSeverity(report.Reason))
+	}
+
+	for _, weight := range reporters {
+		score += weight
+	}
+
+	for _, item := range evidence {
+		switch item.Kind {
+		case EvidenceChat:
+			if item.Flagged && item.SteamID == targetID {
+				counts[item.Kind]++
+			}
+		case EvidenceBan:
+			counts[item.Kind]++
+			if item.Flagged {
+				active++
+			}
+		case EvidenceDetection:
+			counts[item.Kind]++
+		case EvidenceDemo:
+		}
+	}
+
+	score += float64(min(counts[EvidenceDetection], severityEvidenceCap)) * severityDetection
+	score += float64(min(counts[EvidenceBan], severityEvidenceCap)) * severityPriorBan
+	score += float64(min(active, severityEvidenceCap)) * severityActiveBan
+	score += float64(min(counts[EvidenceChat], severityEvidenceCap)) * severityFlaggedChat
+
+	return score
+}
+
+func reasonSeverity(banReason reason.Reason) float64 {
+	switch banReason {
+	case reason.Cheating, reason.Exploiting, reason.Evading:
+		return 3
+	case reason.Racism, reason.Harassment, reason.BotHost:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// attachEvidence gathers the evidence against the target of a new report. Sources that fail are logged and
+// skipped so a report is never lost because of missing evidence.
+func (r Reports) attachEvidence(ctx context.Context, report Report) {
+	var evidence []ReportEvidence
+
+	messages, errChat := r.chatEvidence(ctx, report)
+	if errChat != nil && !errors.Is(errChat, database.ErrNoResult) {
+		slog.Warn("Failed to load chat evidence", slog.String("error", errChat.Error()))
+	}
+
+	evidence = append(evidence, messages...)
+
+	detections, errDetections := r.detectionEvidence(ctx, report)
+	if errDetections != nil && !errors.Is(errDetections, database.ErrNoResult) {
+		slog.Warn("Failed to load anticheat evidence", slog.String("error", errDetections.Error()))
+	}
+
+	evidence = append(evidence, detections...)
+
+	bans, errBans := r.banEvidence(ctx, report)
+	if errBans != nil && !errors.Is(errBans, database.ErrNoResult) {
+		slog.Warn("Failed to load ban evidence", slog.String("error", errBans.Error()))
+	}
+
+	evidence = append(evidence, bans...)
+
+	demoEvidence, errDemo := r.demoEvidence(ctx, report)
+	switch {
+	case errDemo == nil:
+		evidence = append(evidence, demoEvidence)
+	case !errors.Is(errDemo, database.ErrNoResult):
+		slog.Warn("Failed to load demo evidence", slog.String("error", errDemo.Error()))
+	}
+
+	if errSave := r.repository.SaveReportEvidence(ctx, evidence); errSave != nil {
+		slog.Error("Failed to save report evidence", slog.String("error", errSave.Error()),
+			slog.Int("report_id", int(report.ReportID)))
+
+		return
+	}
+
+	slog.Debug("Attached report evidence", slog.Int("report_id", int(report.ReportID)),
+		slog.Int("count", len(evidence)))
+}
+
+func (r Reports) chatEvidence(ctx context.Context, report Report) ([]ReportEvidence, error) {
+	var messages []chat.QueryChatHistoryResult
+
+	if report.PersonMessageID > 0 {
+		found, errContext := r.chat.GetPersonMessageContext(ctx, report.PersonMessageID, evidenceChatPadding)
+		if errContext != nil {
+			return nil, errContext
+		}
+
+		messages = found
+	} else {
+		start := report.CreatedOn.Add(-evidenceChatWindow)
+		found, errHistory := r.chat.QueryChatHistory(ctx, permission.Moderator, chat.HistoryQueryFilter{
+			Filter:        query.Filter{Limit: evidenceChatLimit},
+			SourceIDField: httphelper.SourceIDField{SourceID: report.TargetID.String()},
+			DateStart:     &start,
+			DateEnd:       &report.CreatedOn,
+			DontCalcTotal: true,
+		})
+		if errHistory != nil {
+			return nil, errHistory
+		}
+
+		for _, message := range found {
+			messages = append(messages, *message)
+		}
+	}
+
+	evidence := make([]ReportEvidence, len(messages))
+	for idx, message := range messages {
+		evidence[idx] = ReportEvidence{
+			ReportID:  report.ReportID,
+			Kind:      EvidenceChat,
+			RefID:     message.PersonMessageID,
+			SteamID:   message.SteamID,
+			ServerID:  message.ServerID,
+			Summary:   fmt.Sprintf("%s: %s", message.PersonaName, message.Body),
+			Flagged:   message.AutoFilterFlagged > 0,
+			CreatedOn: message.CreatedOn,
+		}
+	}
+
+	return evidence, nil
+}
+
+func (r Reports) detectionEvidence(ctx context.Context, report Report) ([]ReportEvidence, error) {
+	entries, errEntries := r.detections.BySteamID(ctx, report.TargetID)
+	if errEntries != nil {
+		return nil, errEntries
+	}
+
+	slices.SortFunc(entries, func(a, b logparse.StacEntry) int {
+		return b.CreatedOn.Compare(a.CreatedOn)
+	})
+
+	var (
+		evidence []ReportEvidence
+		since    = report.CreatedOn.Add(-evidenceDetectionAge)
+	)
+
+	for _, entry := range entries {
+		if entry.CreatedOn.Before(since) || len(evidence) >= evidenceDetectionLimit {
+			break
+		}
+
+		evidence = append(evidence, ReportEvidence{
+			ReportID:  report.ReportID,
+			Kind:      EvidenceDetection,
+			RefID:     entry.AnticheatID,
+			SteamID:   entry.SteamID,
+			ServerID:  entry.ServerID,
+			Summary:   fmt.Sprintf("%s: %s", entry.Detection, entry.Summary),
+			CreatedOn: entry.CreatedOn,
+		})
+	}
+
+	return evidence, nil
+}
+
+func (r Reports) banEvidence(ctx context.Context, report Report) ([]ReportEvidence, error) {
+	bans, errBans := r.bans.Query(ctx, QueryOpts{TargetID: report.TargetID, Deleted: true})
+	if errBans != nil {
+		return nil, errBans
+	}
+
+	evidence := make([]ReportEvidence, len(bans))
+	for idx, ban := range bans {
+		evidence[idx] = ReportEvidence{
+			ReportID:  report.ReportID,
+			Kind:      EvidenceBan,
+			RefID:     int64(ban.BanID),
+			SteamID:   ban.TargetID,
+			Summary:   fmt.Sprintf("%s: %s", ban.BanType, ban.Reason),
+			Flagged:   !ban.Deleted && ban.ValidUntil.After(report.CreatedOn),
+			CreatedOn: ban.CreatedOn,
+		}
+	}
+
+	return evidence, nil
+}
+
+// demoEvidence finds the demo recording the target at the time of the report. The demo attached to the report
+// or reported message is preferred, otherwise a demo containing the target is searched for by time. The demo is
+// archived so it is not removed by the demo cleanup while the report is open.
+func (r Reports) demoEvidence(ctx context.Context, report Report) (ReportEvidence, error) {
+	var (
+		demoID = report.DemoID
+		at     = report.CreatedOn
+	)
+
+	if demoID <= 0 && report.PersonMessageID > 0 {
+		message, errMessage := r.chat.GetPersonMessage(ctx, report.PersonMessageID)
+		if errMessage != nil {
+			return ReportEvidence{}, errMessage
+		}
+
+		// The reported message is a better indication of when the target was seen than the report itself.
+		at = message.CreatedOn
+		if message.DemoID != nil {
+			demoID = *message.DemoID
+		}
+	}
+
+	var (
+		demoFile *demo.File
+		errDemo  error
+	)
+
+	if demoID > 0 {
+		demoFile, errDemo = r.demos.GetDemoByID(ctx, demoID)
+	} else {
+		demoFile, errDemo = r.demos.GetDemoCovering(ctx, report.TargetID, at)
+	}
+
+	if errDemo != nil {
+		return ReportEvidence{}, errDemo
+	}
+
+	if !demoFile.Archive {
+		if errMark := r.demos.MarkArchived(ctx, demoFile); errMark != nil {
+			slog.Error("Failed to mark demo as archived", slog.String("error", errMark.Error()))
+		}
+	}
+
+	return ReportEvidence{
+		ReportID:  report.ReportID,
+		Kind:      EvidenceDemo,
+		RefID:     int64(demoFile.DemoID),
+		SteamID:   report.TargetID,
+		ServerID:  demoFile.ServerID,
+		Summary:   fmt.Sprintf("%s on %s", demoFile.MapName, demoFile.ServerNameShort),
+		CreatedOn: demoFile.CreatedOn,
+	}, nil
+}
+
+// SyncEvidenceDemos attaches demos to recent open reports that had none when they were created. Demos are only
+// uploaded once they finish recording, so they are usually not available when the report is made.
+func (r Reports) SyncEvidenceDemos(ctx context.Context) error {
+	reports, errReports := r.repository.GetReports(ctx, steamid.SteamID{})
+	if errReports != nil {
+		if errors.Is(errReports, database.ErrNoResult) {
+			return nil
+		}
+
+		return errReports
+	}
+
+	var (
+		recent    []Report
+		reportIDs []int32
+		since     = time.Now().Add(-evidenceDemoSyncAge)
+	)
+
+	for _, report := range reports {
+		if report.CreatedOn.After(since) && (report.ReportStatus == Opened || report.ReportStatus == NeedMoreInfo) {
+			recent = append(recent, report)
+			reportIDs = append(reportIDs, report.ReportID)
+		}
+	}
+
+	if len(recent) == 0 {
+		return nil
+	}
+
+	evidence, errEvidence := r.repository.GetReportEvidence(ctx, reportIDs)
+	if errEvidence != nil {
+		return errEvidence
+	}
+
+	var found []ReportEvidence
+
+	for _, report := range recent {
+		if slices.ContainsFunc(evidence, func(item ReportEvidence) bool {
+			return item.ReportID == report.ReportID && item.Kind == EvidenceDemo
+		}) {
+			continue
+		}
+
+		demoEvidence, errDemo := r.demoEvidence(ctx, report)
+		if errDemo != nil {
+			if !errors.Is(errDemo, database.ErrNoResult) {
+				slog.Warn("Failed to load demo evidence", slog.String("error", errDemo.Error()))
+			}
+
+			continue
+		}
+
+		found = append(found, demoEvidence)
+	}
+
+	return r.repository.SaveReportEvidence(ctx, found)
+}
@@ -0,0 +1,78 @@
+package ban_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterReports(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now       = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		cheater   = steamid.New(76561197960287930)
+		spammer   = steamid.New(76561197960287931)
+		reporter  = steamid.New(76561197960287932)
+		other     = steamid.New(76561197960287933)
+		newReport = func(reportID int32, source steamid.SteamID, target steamid.SteamID, banReason reason.Reason, age time.Duration) ban.ReportWithAuthor {
+			return ban.ReportWithAuthor{Report: ban.Report{
+				ReportID: reportID, SourceID: source, TargetID: target, Reason: banReason, CreatedOn: now.Add(-age),
+			}}
+		}
+	)
+
+	reports := []ban.ReportWithAuthor{
+		newReport(1, reporter, spammer, reason.Spam, time.Minute),
+		newReport(2, reporter, cheater, reason.Cheating, time.Hour),
+		newReport(3, other, cheater, reason.Cheating, 2*time.Hour),
+	}
+
+	evidence := []ban.ReportEvidence{
+		{ReportID: 2, Kind: ban.EvidenceDetection, RefID: 10, SteamID: cheater},
+		// The same detection attached to both reports is only counted once.
+		{ReportID: 3, Kind: ban.EvidenceDetection, RefID: 10, SteamID: cheater},
+		{ReportID: 3, Kind: ban.EvidenceBan, RefID: 5, SteamID: cheater, Flagged: true},
+		{ReportID: 1, Kind: ban.EvidenceChat, RefID: 100, SteamID: spammer, Flagged: true},
+		// Flagged messages from other players in the chat context do not count against the target.
+		{ReportID: 1, Kind: ban.EvidenceChat, RefID: 101, SteamID: reporter, Flagged: true},
+		{ReportID: 1, Kind: ban.EvidenceDemo, RefID: 7, SteamID: spammer},
+		// Evidence of reports outside the set is ignored.
+		{ReportID: 99, Kind: ban.EvidenceDetection, RefID: 11, SteamID: cheater},
+	}
+
+	clusters := ban.ClusterReports(reports, evidence)
+	require.Len(t, clusters, 2)
+
+	require.Equal(t, cheater, clusters[0].TargetID)
+	require.Len(t, clusters[0].Reports, 2)
+	require.Len(t, clusters[0].Evidence, 2)
+	require.Equal(t, now.Add(-time.Hour), clusters[0].LastReported)
+	// Two cheating reporters, one detection and one active prior ban.
+	require.InDelta(t, 3+3+2+1.5+1, clusters[0].Severity, 0.001)
+
+	require.Equal(t, spammer, clusters[1].TargetID)
+	require.Len(t, clusters[1].Evidence, 3)
+	require.InDelta(t, 1+1, clusters[1].Severity, 0.001)
+}
+
+func TestSeverityReporters(t *testing.T) {
+	t.Parallel()
+
+	var (
+		target   = steamid.New(76561197960287930)
+		reporter = steamid.New(76561197960287931)
+	)
+
+	// Repeat reports from a single reporter count once, using the most severe reason.
+	reports := []ban.ReportWithAuthor{
+		{Report: ban.Report{SourceID: reporter, TargetID: target, Reason: reason.Spam}},
+		{Report: ban.Report{SourceID: reporter, TargetID: target, Reason: reason.Harassment}},
+	}
+
+	require.InDelta(t, 2, ban.Severity(target, reports, nil), 0.001)
+}
@@ -395,3 +395,59 @@ func (r ReportRepository) GetReportMessageByID(ctx context.Context, reportMessag
 
 	return message, nil
 }
+
+// SaveReportEvidence stores the evidence, ignoring any already attached to the report.
+func (r ReportRepository) SaveReportEvidence(ctx context.Context, evidence []ReportEvidence) error {
+	if len(evidence) == 0 {
+		return nil
+	}
+
+	builder := r.Builder().
+		Insert("report_evidence").
+		Columns("report_id", "kind", "ref_id", "steam_id", "server_id", "summary", "flagged", "created_on").
+		Suffix("ON CONFLICT (report_id, kind, ref_id) DO NOTHING")
+
+	for _, item := range evidence {
+		var serverID *int32
+		if item.ServerID > 0 {
+			serverID = &item.ServerID
+		}
+
+		builder = builder.Values(item.ReportID, item.Kind, item.RefID, item.SteamID.Int64(), serverID,
+			item.Summary, item.Flagged, item.CreatedOn)
+	}
+
+	return database.Err(r.ExecInsertBuilder(ctx, builder))
+}
+
+// GetReportEvidence returns the evidence attached to the reports, newest first.
+func (r ReportRepository) GetReportEvidence(ctx context.Context, reportIDs []int32) ([]ReportEvidence, error) {
+	evidence := []ReportEvidence{}
+	if len(reportIDs) == 0 {
+		return evidence, nil
+	}
+
+	rows, errQuery := r.QueryBuilder(ctx, r.Builder().
+		Select("report_evidence_id", "report_id", "kind", "ref_id", "steam_id", "coalesce(server_id, 0)",
+			"summary", "flagged", "created_on").
+		From("report_evidence").
+		Where(sq.Eq{"report_id": reportIDs}).
+		OrderBy("created_on DESC"))
+	if errQuery != nil {
+		return nil, database.Err(errQuery)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var item ReportEvidence
+		if errScan := rows.Scan(&item.ReportEvidenceID, &item.ReportID, &item.Kind, &item.RefID, &item.SteamID,
+			&item.ServerID, &item.Summary, &item.Flagged, &item.CreatedOn); errScan != nil {
+			return nil, database.Err(errScan)
+		}
+
+		evidence = append(evidence, item)
+	}
+
+	return evidence, nil
+}
@@ -37,6 +37,8 @@ func NewReportService(reports Reports, authMiddleware *rpc.Middleware, option ..
 	authMiddleware.UserRoute(banv1connect.ReportServiceReportMessageEditProcedure, rpc.WithMinPermissions(permission.User))
 	authMiddleware.UserRoute(banv1connect.ReportServiceReportMessageDeleteProcedure, rpc.WithMinPermissions(permission.User))
 	authMiddleware.UserRoute(banv1connect.ReportServiceReportsProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(banv1connect.ReportServiceReportEvidenceProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(banv1connect.ReportServiceReportTriageProcedure, rpc.WithMinPermissions(permission.Moderator))
 
 	return rpc.Service{Pattern: pattern, Handler: handler}
 }
@@ -174,6 +176,59 @@ func (s ReportService) ReportMessageDelete(ctx context.Context, req *v1.ReportMe
 	return &emptypb.Empty{}, nil
 }
 
+func (s ReportService) ReportEvidence(ctx context.Context, req *v1.ReportEvidenceRequest) (*v1.ReportEvidenceResponse, error) {
+	evidence, errEvidence := s.reports.Evidence(ctx, req.GetReportId())
+	if errEvidence != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &v1.ReportEvidenceResponse{Evidence: toReportEvidence(evidence)}, nil
+}
+
+func (s ReportService) ReportTriage(ctx context.Context, _ *emptypb.Empty) (*v1.ReportTriageResponse, error) {
+	clusters, errClusters := s.reports.Triage(ctx)
+	if errClusters != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.ReportTriageResponse{Clusters: make([]*v1.ReportCluster, len(clusters))}
+	for idx, cluster := range clusters {
+		reports := make([]*v1.ReportWithAuthor, len(cluster.Reports))
+		for reportIdx, report := range cluster.Reports {
+			reports[reportIdx] = toReportWithAuthor(report)
+		}
+
+		resp.Clusters[idx] = &v1.ReportCluster{
+			Subject:      toPersonCore(cluster.Subject),
+			Reports:      reports,
+			Evidence:     toReportEvidence(cluster.Evidence),
+			Severity:     &cluster.Severity,
+			LastReported: timestamppb.New(cluster.LastReported),
+		}
+	}
+
+	return &resp, nil
+}
+
+func toReportEvidence(evidence []ReportEvidence) []*v1.ReportEvidence {
+	out := make([]*v1.ReportEvidence, len(evidence))
+	for idx, item := range evidence {
+		out[idx] = &v1.ReportEvidence{
+			ReportEvidenceId: &item.ReportEvidenceID,
+			ReportId:         &item.ReportID,
+			Kind:             new(v1.EvidenceKind(item.Kind)), //nolint:gosec
+			RefId:            &item.RefID,
+			SteamId:          new(item.SteamID.Int64()),
+			ServerId:         &item.ServerID,
+			Summary:          &item.Summary,
+			Flagged:          &item.Flagged,
+			CreatedOn:        timestamppb.New(item.CreatedOn),
+		}
+	}
+
+	return out
+}
+
 func toReportMessage(msg ReportMessage) *v1.ReportMessage {
 	return &v1.ReportMessage{
 		ReportId:        &msg.ReportID,
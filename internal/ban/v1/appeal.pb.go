@@ -26,9 +26,11 @@ const (
 )
 
 type SetAppealStateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BanId         *int32                 `protobuf:"varint,1,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
-	AppealState   *AppealState           `protobuf:"varint,2,opt,name=appeal_state,json=appealState,enum=ban.v1.AppealState" json:"appeal_state,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BanId       *int32                 `protobuf:"varint,1,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
+	AppealState *AppealState           `protobuf:"varint,2,opt,name=appeal_state,json=appealState,enum=ban.v1.AppealState" json:"appeal_state,omitempty"`
+	// ValidUntil is the new, earlier, expiry of the ban. It is required for Reduced outcomes.
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_until,json=validUntil" json:"valid_until,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -77,6 +79,13 @@ func (x *SetAppealStateRequest) GetAppealState() AppealState {
 	return AppealState_APPEAL_STATE_OPEN_UNSPECIFIED
 }
 
+func (x *SetAppealStateRequest) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
 type SetAppealStateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Ban           *Ban                   `protobuf:"bytes,1,opt,name=ban" json:"ban,omitempty"`
@@ -304,6 +313,7 @@ type AppealOverview struct {
 	SourceAvatarHash  *string                `protobuf:"bytes,3,opt,name=source_avatar_hash,json=sourceAvatarHash" json:"source_avatar_hash,omitempty"`
 	TargetPersonaName *string                `protobuf:"bytes,4,opt,name=target_persona_name,json=targetPersonaName" json:"target_persona_name,omitempty"`
 	TargetAvatarHash  *string                `protobuf:"bytes,5,opt,name=target_avatar_hash,json=targetAvatarHash" json:"target_avatar_hash,omitempty"`
+	AssigneeId        *int64                 `protobuf:"varint,6,opt,name=assignee_id,json=assigneeId" json:"assignee_id,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -373,6 +383,13 @@ func (x *AppealOverview) GetTargetAvatarHash() string {
 	return ""
 }
 
+func (x *AppealOverview) GetAssigneeId() int64 {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return 0
+}
+
 type AppealMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	BanId         *int32                 `protobuf:"varint,1,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
@@ -725,14 +742,565 @@ func (x *DeleteAppealMessageRequest) GetBanMessageId() int64 {
 	return 0
 }
 
+type AppealAssignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BanId         *int32                 `protobuf:"varint,1,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
+	AssigneeId    *int64                 `protobuf:"varint,2,opt,name=assignee_id,json=assigneeId" json:"assignee_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealAssignRequest) Reset() {
+	*x = AppealAssignRequest{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealAssignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealAssignRequest) ProtoMessage() {}
+
+func (x *AppealAssignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealAssignRequest.ProtoReflect.Descriptor instead.
+func (*AppealAssignRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AppealAssignRequest) GetBanId() int32 {
+	if x != nil && x.BanId != nil {
+		return *x.BanId
+	}
+	return 0
+}
+
+func (x *AppealAssignRequest) GetAssigneeId() int64 {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return 0
+}
+
+type AppealDashboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SteamId       *int64                 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealDashboardRequest) Reset() {
+	*x = AppealDashboardRequest{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealDashboardRequest) ProtoMessage() {}
+
+func (x *AppealDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealDashboardRequest.ProtoReflect.Descriptor instead.
+func (*AppealDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AppealDashboardRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+type AppealStateCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppealState   *AppealState           `protobuf:"varint,1,opt,name=appeal_state,json=appealState,enum=ban.v1.AppealState" json:"appeal_state,omitempty"`
+	Count         *int32                 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealStateCount) Reset() {
+	*x = AppealStateCount{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealStateCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealStateCount) ProtoMessage() {}
+
+func (x *AppealStateCount) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealStateCount.ProtoReflect.Descriptor instead.
+func (*AppealStateCount) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AppealStateCount) GetAppealState() AppealState {
+	if x != nil && x.AppealState != nil {
+		return *x.AppealState
+	}
+	return AppealState_APPEAL_STATE_OPEN_UNSPECIFIED
+}
+
+func (x *AppealStateCount) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type AppealDashboardResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	States []*AppealStateCount    `protobuf:"bytes,1,rep,name=states" json:"states,omitempty"`
+	// Unassigned is the number of open appeals which nobody has been assigned to.
+	Unassigned    *int32 `protobuf:"varint,2,opt,name=unassigned" json:"unassigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealDashboardResponse) Reset() {
+	*x = AppealDashboardResponse{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealDashboardResponse) ProtoMessage() {}
+
+func (x *AppealDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealDashboardResponse.ProtoReflect.Descriptor instead.
+func (*AppealDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AppealDashboardResponse) GetStates() []*AppealStateCount {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *AppealDashboardResponse) GetUnassigned() int32 {
+	if x != nil && x.Unassigned != nil {
+		return *x.Unassigned
+	}
+	return 0
+}
+
+type AppealTemplate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AppealTemplateId *int32                 `protobuf:"varint,1,opt,name=appeal_template_id,json=appealTemplateId" json:"appeal_template_id,omitempty"`
+	Name             *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	BodyMd           *string                `protobuf:"bytes,3,opt,name=body_md,json=bodyMd" json:"body_md,omitempty"`
+	CreatedOn        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	UpdatedOn        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_on,json=updatedOn" json:"updated_on,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AppealTemplate) Reset() {
+	*x = AppealTemplate{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealTemplate) ProtoMessage() {}
+
+func (x *AppealTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealTemplate.ProtoReflect.Descriptor instead.
+func (*AppealTemplate) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AppealTemplate) GetAppealTemplateId() int32 {
+	if x != nil && x.AppealTemplateId != nil {
+		return *x.AppealTemplateId
+	}
+	return 0
+}
+
+func (x *AppealTemplate) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *AppealTemplate) GetBodyMd() string {
+	if x != nil && x.BodyMd != nil {
+		return *x.BodyMd
+	}
+	return ""
+}
+
+func (x *AppealTemplate) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *AppealTemplate) GetUpdatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedOn
+	}
+	return nil
+}
+
+type AppealTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*AppealTemplate      `protobuf:"bytes,1,rep,name=templates" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealTemplatesResponse) Reset() {
+	*x = AppealTemplatesResponse{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealTemplatesResponse) ProtoMessage() {}
+
+func (x *AppealTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*AppealTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AppealTemplatesResponse) GetTemplates() []*AppealTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type AppealTemplateSaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *AppealTemplate        `protobuf:"bytes,1,opt,name=template" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealTemplateSaveRequest) Reset() {
+	*x = AppealTemplateSaveRequest{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealTemplateSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealTemplateSaveRequest) ProtoMessage() {}
+
+func (x *AppealTemplateSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealTemplateSaveRequest.ProtoReflect.Descriptor instead.
+func (*AppealTemplateSaveRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AppealTemplateSaveRequest) GetTemplate() *AppealTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type AppealTemplateSaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *AppealTemplate        `protobuf:"bytes,1,opt,name=template" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealTemplateSaveResponse) Reset() {
+	*x = AppealTemplateSaveResponse{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealTemplateSaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealTemplateSaveResponse) ProtoMessage() {}
+
+func (x *AppealTemplateSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealTemplateSaveResponse.ProtoReflect.Descriptor instead.
+func (*AppealTemplateSaveResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AppealTemplateSaveResponse) GetTemplate() *AppealTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type AppealTemplateDeleteRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AppealTemplateId *int32                 `protobuf:"varint,1,opt,name=appeal_template_id,json=appealTemplateId" json:"appeal_template_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AppealTemplateDeleteRequest) Reset() {
+	*x = AppealTemplateDeleteRequest{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealTemplateDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealTemplateDeleteRequest) ProtoMessage() {}
+
+func (x *AppealTemplateDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealTemplateDeleteRequest.ProtoReflect.Descriptor instead.
+func (*AppealTemplateDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AppealTemplateDeleteRequest) GetAppealTemplateId() int32 {
+	if x != nil && x.AppealTemplateId != nil {
+		return *x.AppealTemplateId
+	}
+	return 0
+}
+
+type AppealTemplateRenderRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BanId            *int32                 `protobuf:"varint,1,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
+	AppealTemplateId *int32                 `protobuf:"varint,2,opt,name=appeal_template_id,json=appealTemplateId" json:"appeal_template_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AppealTemplateRenderRequest) Reset() {
+	*x = AppealTemplateRenderRequest{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealTemplateRenderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealTemplateRenderRequest) ProtoMessage() {}
+
+func (x *AppealTemplateRenderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealTemplateRenderRequest.ProtoReflect.Descriptor instead.
+func (*AppealTemplateRenderRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AppealTemplateRenderRequest) GetBanId() int32 {
+	if x != nil && x.BanId != nil {
+		return *x.BanId
+	}
+	return 0
+}
+
+func (x *AppealTemplateRenderRequest) GetAppealTemplateId() int32 {
+	if x != nil && x.AppealTemplateId != nil {
+		return *x.AppealTemplateId
+	}
+	return 0
+}
+
+type AppealTemplateRenderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BodyMd        *string                `protobuf:"bytes,1,opt,name=body_md,json=bodyMd" json:"body_md,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppealTemplateRenderResponse) Reset() {
+	*x = AppealTemplateRenderResponse{}
+	mi := &file_ban_v1_appeal_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppealTemplateRenderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppealTemplateRenderResponse) ProtoMessage() {}
+
+func (x *AppealTemplateRenderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_appeal_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppealTemplateRenderResponse.ProtoReflect.Descriptor instead.
+func (*AppealTemplateRenderResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_appeal_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AppealTemplateRenderResponse) GetBodyMd() string {
+	if x != nil && x.BodyMd != nil {
+		return *x.BodyMd
+	}
+	return ""
+}
+
 var File_ban_v1_appeal_proto protoreflect.FileDescriptor
 
 const file_ban_v1_appeal_proto_rawDesc = "" +
 	"\n" +
-	"\x13ban/v1/appeal.proto\x12\x06ban.v1\x1a\x10ban/v1/ban.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19person/v1/privilege.proto\"{\n" +
+	"\x13ban/v1/appeal.proto\x12\x06ban.v1\x1a\x10ban/v1/ban.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19person/v1/privilege.proto\"\xb8\x01\n" +
 	"\x15SetAppealStateRequest\x12\x1d\n" +
 	"\x06ban_id\x18\x01 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\x05banId\x12C\n" +
-	"\fappeal_state\x18\x02 \x01(\x0e2\x13.ban.v1.AppealStateB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\vappealState\"7\n" +
+	"\fappeal_state\x18\x02 \x01(\x0e2\x13.ban.v1.AppealStateB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\vappealState\x12;\n" +
+	"\vvalid_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\"7\n" +
 	"\x16SetAppealStateResponse\x12\x1d\n" +
 	"\x03ban\x18\x01 \x01(\v2\v.ban.v1.BanR\x03ban\"*\n" +
 	"\x0eAppealsRequest\x12\x18\n" +
@@ -742,7 +1310,7 @@ const file_ban_v1_appeal_proto_rawDesc = "" +
 	"\x0fMessagesRequest\x12\x1d\n" +
 	"\x06ban_id\x18\x01 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\x05banId\"M\n" +
 	"\x10MessagesResponse\x129\n" +
-	"\bmessages\x18\x01 \x03(\v2\x15.ban.v1.AppealMessageB\x06\xbaH\x03\xc8\x01\x01R\bmessages\"\xa5\x02\n" +
+	"\bmessages\x18\x01 \x03(\v2\x15.ban.v1.AppealMessageB\x06\xbaH\x03\xc8\x01\x01R\bmessages\"\xca\x02\n" +
 	"\x0eAppealOverview\x12%\n" +
 	"\x03ban\x18\x01 \x01(\v2\v.ban.v1.BanB\x06\xbaH\x03\xc8\x01\x01R\x03ban\x12:\n" +
 	"\x13source_persona_name\x18\x02 \x01(\tB\n" +
@@ -750,7 +1318,9 @@ const file_ban_v1_appeal_proto_rawDesc = "" +
 	"\x12source_avatar_hash\x18\x03 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01(R\x10sourceAvatarHash\x12:\n" +
 	"\x13target_persona_name\x18\x04 \x01(\tB\n" +
 	"\xbaH\a\xc8\x01\x01r\x02\x18 R\x11targetPersonaName\x129\n" +
-	"\x12target_avatar_hash\x18\x05 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01(R\x10targetAvatarHash\"\x90\x04\n" +
+	"\x12target_avatar_hash\x18\x05 \x01(\tB\v\xbaH\b\xc8\x01\x01r\x03\x98\x01(R\x10targetAvatarHash\x12#\n" +
+	"\vassignee_id\x18\x06 \x01(\x03B\x020\x01R\n" +
+	"assigneeId\"\x90\x04\n" +
 	"\rAppealMessage\x12!\n" +
 	"\x06ban_id\x18\x01 \x01(\x05B\n" +
 	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x05banId\x122\n" +
@@ -781,14 +1351,59 @@ const file_ban_v1_appeal_proto_rawDesc = "" +
 	"\x19EditAppealMessageResponse\x127\n" +
 	"\amessage\x18\x01 \x01(\v2\x15.ban.v1.AppealMessageB\x06\xbaH\x03\xc8\x01\x01R\amessage\"P\n" +
 	"\x1aDeleteAppealMessageRequest\x122\n" +
-	"\x0eban_message_id\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\fbanMessageId2\xca\x03\n" +
+	"\x0eban_message_id\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\fbanMessageId\"d\n" +
+	"\x13AppealAssignRequest\x12!\n" +
+	"\x06ban_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x05banId\x12*\n" +
+	"\vassignee_id\x18\x02 \x01(\x03B\t\xbaH\x04\"\x02(\x000\x01R\n" +
+	"assigneeId\">\n" +
+	"\x16AppealDashboardRequest\x12$\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\t\xbaH\x04\"\x02(\x000\x01R\asteamId\"p\n" +
+	"\x10AppealStateCount\x12>\n" +
+	"\fappeal_state\x18\x01 \x01(\x0e2\x13.ban.v1.AppealStateB\x06\xbaH\x03\xc8\x01\x01R\vappealState\x12\x1c\n" +
+	"\x05count\x18\x02 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\x05count\"k\n" +
+	"\x17AppealDashboardResponse\x120\n" +
+	"\x06states\x18\x01 \x03(\v2\x18.ban.v1.AppealStateCountR\x06states\x12\x1e\n" +
+	"\n" +
+	"unassigned\x18\x02 \x01(\x05R\n" +
+	"unassigned\"\xff\x01\n" +
+	"\x0eAppealTemplate\x12,\n" +
+	"\x12appeal_template_id\x18\x01 \x01(\x05R\x10appealTemplateId\x12 \n" +
+	"\x04name\x18\x02 \x01(\tB\f\xbaH\t\xc8\x01\x01r\x04\x10\x01\x18@R\x04name\x12'\n" +
+	"\abody_md\x18\x03 \x01(\tB\x0e\xbaH\v\xc8\x01\x01r\x06\x10\x01\x18І\x03R\x06bodyMd\x129\n" +
+	"\n" +
+	"created_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x129\n" +
+	"\n" +
+	"updated_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedOn\"O\n" +
+	"\x17AppealTemplatesResponse\x124\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x16.ban.v1.AppealTemplateR\ttemplates\"W\n" +
+	"\x19AppealTemplateSaveRequest\x12:\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.ban.v1.AppealTemplateB\x06\xbaH\x03\xc8\x01\x01R\btemplate\"X\n" +
+	"\x1aAppealTemplateSaveResponse\x12:\n" +
+	"\btemplate\x18\x01 \x01(\v2\x16.ban.v1.AppealTemplateB\x06\xbaH\x03\xc8\x01\x01R\btemplate\"W\n" +
+	"\x1bAppealTemplateDeleteRequest\x128\n" +
+	"\x12appeal_template_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x10appealTemplateId\"z\n" +
+	"\x1bAppealTemplateRenderRequest\x12!\n" +
+	"\x06ban_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x05banId\x128\n" +
+	"\x12appeal_template_id\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x10appealTemplateId\"?\n" +
+	"\x1cAppealTemplateRenderResponse\x12\x1f\n" +
+	"\abody_md\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06bodyMd2\xd0\a\n" +
 	"\rAppealService\x12<\n" +
 	"\aAppeals\x12\x16.ban.v1.AppealsRequest\x1a\x17.ban.v1.AppealsResponse\"\x00\x12?\n" +
 	"\bMessages\x12\x17.ban.v1.MessagesRequest\x1a\x18.ban.v1.MessagesResponse\"\x00\x126\n" +
 	"\x05Reply\x12\x14.ban.v1.ReplyRequest\x1a\x15.ban.v1.ReplyResponse\"\x00\x12Z\n" +
 	"\x11EditAppealMessage\x12 .ban.v1.EditAppealMessageRequest\x1a!.ban.v1.EditAppealMessageResponse\"\x00\x12S\n" +
 	"\x13DeleteAppealMessage\x12\".ban.v1.DeleteAppealMessageRequest\x1a\x16.google.protobuf.Empty\"\x00\x12Q\n" +
-	"\x0eSetAppealState\x12\x1d.ban.v1.SetAppealStateRequest\x1a\x1e.ban.v1.SetAppealStateResponse\"\x00B\x89\x01\n" +
+	"\x0eSetAppealState\x12\x1d.ban.v1.SetAppealStateRequest\x1a\x1e.ban.v1.SetAppealStateResponse\"\x00\x12E\n" +
+	"\fAppealAssign\x12\x1b.ban.v1.AppealAssignRequest\x1a\x16.google.protobuf.Empty\"\x00\x12T\n" +
+	"\x0fAppealDashboard\x12\x1e.ban.v1.AppealDashboardRequest\x1a\x1f.ban.v1.AppealDashboardResponse\"\x00\x12L\n" +
+	"\x0fAppealTemplates\x12\x16.google.protobuf.Empty\x1a\x1f.ban.v1.AppealTemplatesResponse\"\x00\x12]\n" +
+	"\x12AppealTemplateSave\x12!.ban.v1.AppealTemplateSaveRequest\x1a\".ban.v1.AppealTemplateSaveResponse\"\x00\x12U\n" +
+	"\x14AppealTemplateDelete\x12#.ban.v1.AppealTemplateDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12c\n" +
+	"\x14AppealTemplateRender\x12#.ban.v1.AppealTemplateRenderRequest\x1a$.ban.v1.AppealTemplateRenderResponse\"\x00B\x89\x01\n" +
 	"\n" +
 	"com.ban.v1B\vAppealProtoP\x01Z5github.com/leighmacdonald/gbans/internal/ban/v1;banv1\xa2\x02\x03BXX\xaa\x02\x06Ban.V1\xca\x02\x06Ban\\V1\xe2\x02\x12Ban\\V1\\GPBMetadata\xea\x02\aBan::V1b\beditionsp\xe8\a"
 
@@ -804,55 +1419,86 @@ func file_ban_v1_appeal_proto_rawDescGZIP() []byte {
 	return file_ban_v1_appeal_proto_rawDescData
 }
 
-var file_ban_v1_appeal_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_ban_v1_appeal_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_ban_v1_appeal_proto_goTypes = []any{
-	(*SetAppealStateRequest)(nil),      // 0: ban.v1.SetAppealStateRequest
-	(*SetAppealStateResponse)(nil),     // 1: ban.v1.SetAppealStateResponse
-	(*AppealsRequest)(nil),             // 2: ban.v1.AppealsRequest
-	(*AppealsResponse)(nil),            // 3: ban.v1.AppealsResponse
-	(*MessagesRequest)(nil),            // 4: ban.v1.MessagesRequest
-	(*MessagesResponse)(nil),           // 5: ban.v1.MessagesResponse
-	(*AppealOverview)(nil),             // 6: ban.v1.AppealOverview
-	(*AppealMessage)(nil),              // 7: ban.v1.AppealMessage
-	(*ReplyRequest)(nil),               // 8: ban.v1.ReplyRequest
-	(*ReplyResponse)(nil),              // 9: ban.v1.ReplyResponse
-	(*EditAppealMessageRequest)(nil),   // 10: ban.v1.EditAppealMessageRequest
-	(*EditAppealMessageResponse)(nil),  // 11: ban.v1.EditAppealMessageResponse
-	(*DeleteAppealMessageRequest)(nil), // 12: ban.v1.DeleteAppealMessageRequest
-	(AppealState)(0),                   // 13: ban.v1.AppealState
-	(*Ban)(nil),                        // 14: ban.v1.Ban
-	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
-	(v1.Privilege)(0),                  // 16: person.v1.Privilege
-	(*emptypb.Empty)(nil),              // 17: google.protobuf.Empty
+	(*SetAppealStateRequest)(nil),        // 0: ban.v1.SetAppealStateRequest
+	(*SetAppealStateResponse)(nil),       // 1: ban.v1.SetAppealStateResponse
+	(*AppealsRequest)(nil),               // 2: ban.v1.AppealsRequest
+	(*AppealsResponse)(nil),              // 3: ban.v1.AppealsResponse
+	(*MessagesRequest)(nil),              // 4: ban.v1.MessagesRequest
+	(*MessagesResponse)(nil),             // 5: ban.v1.MessagesResponse
+	(*AppealOverview)(nil),               // 6: ban.v1.AppealOverview
+	(*AppealMessage)(nil),                // 7: ban.v1.AppealMessage
+	(*ReplyRequest)(nil),                 // 8: ban.v1.ReplyRequest
+	(*ReplyResponse)(nil),                // 9: ban.v1.ReplyResponse
+	(*EditAppealMessageRequest)(nil),     // 10: ban.v1.EditAppealMessageRequest
+	(*EditAppealMessageResponse)(nil),    // 11: ban.v1.EditAppealMessageResponse
+	(*DeleteAppealMessageRequest)(nil),   // 12: ban.v1.DeleteAppealMessageRequest
+	(*AppealAssignRequest)(nil),          // 13: ban.v1.AppealAssignRequest
+	(*AppealDashboardRequest)(nil),       // 14: ban.v1.AppealDashboardRequest
+	(*AppealStateCount)(nil),             // 15: ban.v1.AppealStateCount
+	(*AppealDashboardResponse)(nil),      // 16: ban.v1.AppealDashboardResponse
+	(*AppealTemplate)(nil),               // 17: ban.v1.AppealTemplate
+	(*AppealTemplatesResponse)(nil),      // 18: ban.v1.AppealTemplatesResponse
+	(*AppealTemplateSaveRequest)(nil),    // 19: ban.v1.AppealTemplateSaveRequest
+	(*AppealTemplateSaveResponse)(nil),   // 20: ban.v1.AppealTemplateSaveResponse
+	(*AppealTemplateDeleteRequest)(nil),  // 21: ban.v1.AppealTemplateDeleteRequest
+	(*AppealTemplateRenderRequest)(nil),  // 22: ban.v1.AppealTemplateRenderRequest
+	(*AppealTemplateRenderResponse)(nil), // 23: ban.v1.AppealTemplateRenderResponse
+	(AppealState)(0),                     // 24: ban.v1.AppealState
+	(*timestamppb.Timestamp)(nil),        // 25: google.protobuf.Timestamp
+	(*Ban)(nil),                          // 26: ban.v1.Ban
+	(v1.Privilege)(0),                    // 27: person.v1.Privilege
+	(*emptypb.Empty)(nil),                // 28: google.protobuf.Empty
 }
 var file_ban_v1_appeal_proto_depIdxs = []int32{
-	13, // 0: ban.v1.SetAppealStateRequest.appeal_state:type_name -> ban.v1.AppealState
-	14, // 1: ban.v1.SetAppealStateResponse.ban:type_name -> ban.v1.Ban
-	6,  // 2: ban.v1.AppealsResponse.appeals:type_name -> ban.v1.AppealOverview
-	7,  // 3: ban.v1.MessagesResponse.messages:type_name -> ban.v1.AppealMessage
-	14, // 4: ban.v1.AppealOverview.ban:type_name -> ban.v1.Ban
-	15, // 5: ban.v1.AppealMessage.created_on:type_name -> google.protobuf.Timestamp
-	15, // 6: ban.v1.AppealMessage.updated_on:type_name -> google.protobuf.Timestamp
-	16, // 7: ban.v1.AppealMessage.privilege:type_name -> person.v1.Privilege
-	7,  // 8: ban.v1.ReplyResponse.message:type_name -> ban.v1.AppealMessage
-	7,  // 9: ban.v1.EditAppealMessageResponse.message:type_name -> ban.v1.AppealMessage
-	2,  // 10: ban.v1.AppealService.Appeals:input_type -> ban.v1.AppealsRequest
-	4,  // 11: ban.v1.AppealService.Messages:input_type -> ban.v1.MessagesRequest
-	8,  // 12: ban.v1.AppealService.Reply:input_type -> ban.v1.ReplyRequest
-	10, // 13: ban.v1.AppealService.EditAppealMessage:input_type -> ban.v1.EditAppealMessageRequest
-	12, // 14: ban.v1.AppealService.DeleteAppealMessage:input_type -> ban.v1.DeleteAppealMessageRequest
-	0,  // 15: ban.v1.AppealService.SetAppealState:input_type -> ban.v1.SetAppealStateRequest
-	3,  // 16: ban.v1.AppealService.Appeals:output_type -> ban.v1.AppealsResponse
-	5,  // 17: ban.v1.AppealService.Messages:output_type -> ban.v1.MessagesResponse
-	9,  // 18: ban.v1.AppealService.Reply:output_type -> ban.v1.ReplyResponse
-	11, // 19: ban.v1.AppealService.EditAppealMessage:output_type -> ban.v1.EditAppealMessageResponse
-	17, // 20: ban.v1.AppealService.DeleteAppealMessage:output_type -> google.protobuf.Empty
-	1,  // 21: ban.v1.AppealService.SetAppealState:output_type -> ban.v1.SetAppealStateResponse
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	24, // 0: ban.v1.SetAppealStateRequest.appeal_state:type_name -> ban.v1.AppealState
+	25, // 1: ban.v1.SetAppealStateRequest.valid_until:type_name -> google.protobuf.Timestamp
+	26, // 2: ban.v1.SetAppealStateResponse.ban:type_name -> ban.v1.Ban
+	6,  // 3: ban.v1.AppealsResponse.appeals:type_name -> ban.v1.AppealOverview
+	7,  // 4: ban.v1.MessagesResponse.messages:type_name -> ban.v1.AppealMessage
+	26, // 5: ban.v1.AppealOverview.ban:type_name -> ban.v1.Ban
+	25, // 6: ban.v1.AppealMessage.created_on:type_name -> google.protobuf.Timestamp
+	25, // 7: ban.v1.AppealMessage.updated_on:type_name -> google.protobuf.Timestamp
+	27, // 8: ban.v1.AppealMessage.privilege:type_name -> person.v1.Privilege
+	7,  // 9: ban.v1.ReplyResponse.message:type_name -> ban.v1.AppealMessage
+	7,  // 10: ban.v1.EditAppealMessageResponse.message:type_name -> ban.v1.AppealMessage
+	24, // 11: ban.v1.AppealStateCount.appeal_state:type_name -> ban.v1.AppealState
+	15, // 12: ban.v1.AppealDashboardResponse.states:type_name -> ban.v1.AppealStateCount
+	25, // 13: ban.v1.AppealTemplate.created_on:type_name -> google.protobuf.Timestamp
+	25, // 14: ban.v1.AppealTemplate.updated_on:type_name -> google.protobuf.Timestamp
+	17, // 15: ban.v1.AppealTemplatesResponse.templates:type_name -> ban.v1.AppealTemplate
+	17, // 16: ban.v1.AppealTemplateSaveRequest.template:type_name -> ban.v1.AppealTemplate
+	17, // 17: ban.v1.AppealTemplateSaveResponse.template:type_name -> ban.v1.AppealTemplate
+	2,  // 18: ban.v1.AppealService.Appeals:input_type -> ban.v1.AppealsRequest
+	4,  // 19: ban.v1.AppealService.Messages:input_type -> ban.v1.MessagesRequest
+	8,  // 20: ban.v1.AppealService.Reply:input_type -> ban.v1.ReplyRequest
+	10, // 21: ban.v1.AppealService.EditAppealMessage:input_type -> ban.v1.EditAppealMessageRequest
+	12, // 22: ban.v1.AppealService.DeleteAppealMessage:input_type -> ban.v1.DeleteAppealMessageRequest
+	0,  // 23: ban.v1.AppealService.SetAppealState:input_type -> ban.v1.SetAppealStateRequest
+	13, // 24: ban.v1.AppealService.AppealAssign:input_type -> ban.v1.AppealAssignRequest
+	14, // 25: ban.v1.AppealService.AppealDashboard:input_type -> ban.v1.AppealDashboardRequest
+	28, // 26: ban.v1.AppealService.AppealTemplates:input_type -> google.protobuf.Empty
+	19, // 27: ban.v1.AppealService.AppealTemplateSave:input_type -> ban.v1.AppealTemplateSaveRequest
+	21, // 28: ban.v1.AppealService.AppealTemplateDelete:input_type -> ban.v1.AppealTemplateDeleteRequest
+	22, // 29: ban.v1.AppealService.AppealTemplateRender:input_type -> ban.v1.AppealTemplateRenderRequest
+	3,  // 30: ban.v1.AppealService.Appeals:output_type -> ban.v1.AppealsResponse
+	5,  // 31: ban.v1.AppealService.Messages:output_type -> ban.v1.MessagesResponse
+	9,  // 32: ban.v1.AppealService.Reply:output_type -> ban.v1.ReplyResponse
+	11, // 33: ban.v1.AppealService.EditAppealMessage:output_type -> ban.v1.EditAppealMessageResponse
+	28, // 34: ban.v1.AppealService.DeleteAppealMessage:output_type -> google.protobuf.Empty
+	1,  // 35: ban.v1.AppealService.SetAppealState:output_type -> ban.v1.SetAppealStateResponse
+	28, // 36: ban.v1.AppealService.AppealAssign:output_type -> google.protobuf.Empty
+	16, // 37: ban.v1.AppealService.AppealDashboard:output_type -> ban.v1.AppealDashboardResponse
+	18, // 38: ban.v1.AppealService.AppealTemplates:output_type -> ban.v1.AppealTemplatesResponse
+	20, // 39: ban.v1.AppealService.AppealTemplateSave:output_type -> ban.v1.AppealTemplateSaveResponse
+	28, // 40: ban.v1.AppealService.AppealTemplateDelete:output_type -> google.protobuf.Empty
+	23, // 41: ban.v1.AppealService.AppealTemplateRender:output_type -> ban.v1.AppealTemplateRenderResponse
+	30, // [30:42] is the sub-list for method output_type
+	18, // [18:30] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_ban_v1_appeal_proto_init() }
@@ -867,7 +1513,7 @@ func file_ban_v1_appeal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ban_v1_appeal_proto_rawDesc), len(file_ban_v1_appeal_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
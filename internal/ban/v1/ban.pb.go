@@ -217,6 +217,59 @@ func (BanReason) EnumDescriptor() ([]byte, []int) {
 	return file_ban_v1_ban_proto_rawDescGZIP(), []int{2}
 }
 
+type AuditStatus int32
+
+const (
+	AuditStatus_AUDIT_STATUS_UNSPECIFIED AuditStatus = 0
+	AuditStatus_AUDIT_STATUS_SUCCESS     AuditStatus = 1
+	AuditStatus_AUDIT_STATUS_FAILED      AuditStatus = 2
+	// The step was handed off, eg. a notification, and its delivery is not tracked.
+	AuditStatus_AUDIT_STATUS_QUEUED AuditStatus = 3
+)
+
+// Enum value maps for AuditStatus.
+var (
+	AuditStatus_name = map[int32]string{
+		0: "AUDIT_STATUS_UNSPECIFIED",
+		1: "AUDIT_STATUS_SUCCESS",
+		2: "AUDIT_STATUS_FAILED",
+		3: "AUDIT_STATUS_QUEUED",
+	}
+	AuditStatus_value = map[string]int32{
+		"AUDIT_STATUS_UNSPECIFIED": 0,
+		"AUDIT_STATUS_SUCCESS":     1,
+		"AUDIT_STATUS_FAILED":      2,
+		"AUDIT_STATUS_QUEUED":      3,
+	}
+)
+
+func (x AuditStatus) Enum() *AuditStatus {
+	p := new(AuditStatus)
+	*p = x
+	return p
+}
+
+func (x AuditStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ban_v1_ban_proto_enumTypes[3].Descriptor()
+}
+
+func (AuditStatus) Type() protoreflect.EnumType {
+	return &file_ban_v1_ban_proto_enumTypes[3]
+}
+
+func (x AuditStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditStatus.Descriptor instead.
+func (AuditStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{3}
+}
+
 type Origin int32
 
 const (
@@ -256,11 +309,11 @@ func (x Origin) String() string {
 }
 
 func (Origin) Descriptor() protoreflect.EnumDescriptor {
-	return file_ban_v1_ban_proto_enumTypes[3].Descriptor()
+	return file_ban_v1_ban_proto_enumTypes[4].Descriptor()
 }
 
 func (Origin) Type() protoreflect.EnumType {
-	return &file_ban_v1_ban_proto_enumTypes[3]
+	return &file_ban_v1_ban_proto_enumTypes[4]
 }
 
 func (x Origin) Number() protoreflect.EnumNumber {
@@ -269,7 +322,7 @@ func (x Origin) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Origin.Descriptor instead.
 func (Origin) EnumDescriptor() ([]byte, []int) {
-	return file_ban_v1_ban_proto_rawDescGZIP(), []int{3}
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{4}
 }
 
 type GetBanByReportIDRequest struct {
@@ -366,7 +419,9 @@ type CreateRequest struct {
 	// This is only really used when making calls from sourcemod where we dont have the
 	// same auth mechanism, otherwise the user is automatically set from the
 	// authentication context so that it cannot be forged.
-	SourceId      *int64                 `protobuf:"varint,2,opt,name=source_id,json=sourceId" json:"source_id,omitempty"`
+	SourceId *int64 `protobuf:"varint,2,opt,name=source_id,json=sourceId" json:"source_id,omitempty"`
+	// When valid_until is left unset, the escalation ladder of the reason decides both the duration and
+	// ban_type. The request fails when no escalation step applies.
 	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_until,json=validUntil" json:"valid_until,omitempty"`
 	BanType       *BanType               `protobuf:"varint,4,opt,name=ban_type,json=banType,enum=ban.v1.BanType" json:"ban_type,omitempty"`
 	Reason        *BanReason             `protobuf:"varint,5,opt,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
@@ -1043,34 +1098,32 @@ func (x *DeleteRequest) GetReason() string {
 	return ""
 }
 
-type QueryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SourceId      *int64                 `protobuf:"varint,1,opt,name=source_id,json=sourceId" json:"source_id,omitempty"`
-	TargetId      *int64                 `protobuf:"varint,2,opt,name=target_id,json=targetId" json:"target_id,omitempty"`
-	GroupsOnly    *bool                  `protobuf:"varint,3,opt,name=groups_only,json=groupsOnly" json:"groups_only,omitempty"`
-	Deleted       *bool                  `protobuf:"varint,4,opt,name=deleted" json:"deleted,omitempty"`
-	Cidr          *string                `protobuf:"bytes,5,opt,name=cidr" json:"cidr,omitempty"`
-	CidrOnly      *bool                  `protobuf:"varint,6,opt,name=cidr_only,json=cidrOnly" json:"cidr_only,omitempty"`
-	Reason        []BanReason            `protobuf:"varint,7,rep,packed,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
-	AppealState   *AppealState           `protobuf:"varint,8,opt,name=appeal_state,json=appealState,enum=ban.v1.AppealState" json:"appeal_state,omitempty"`
+type EscalationStep struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Reason *BanReason             `protobuf:"varint,1,opt,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
+	// offense is the 1-based offense number the step applies to.
+	Offense *int32   `protobuf:"varint,2,opt,name=offense" json:"offense,omitempty"`
+	BanType *BanType `protobuf:"varint,3,opt,name=ban_type,json=banType,enum=ban.v1.BanType" json:"ban_type,omitempty"`
+	// duration of the ban, unset or zero is permanent.
+	Duration      *durationpb.Duration `protobuf:"bytes,4,opt,name=duration" json:"duration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryRequest) Reset() {
-	*x = QueryRequest{}
+func (x *EscalationStep) Reset() {
+	*x = EscalationStep{}
 	mi := &file_ban_v1_ban_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryRequest) String() string {
+func (x *EscalationStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRequest) ProtoMessage() {}
+func (*EscalationStep) ProtoMessage() {}
 
-func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+func (x *EscalationStep) ProtoReflect() protoreflect.Message {
 	mi := &file_ban_v1_ban_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1082,88 +1135,60 @@ func (x *QueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
-func (*QueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EscalationStep.ProtoReflect.Descriptor instead.
+func (*EscalationStep) Descriptor() ([]byte, []int) {
 	return file_ban_v1_ban_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryRequest) GetSourceId() int64 {
-	if x != nil && x.SourceId != nil {
-		return *x.SourceId
+func (x *EscalationStep) GetReason() BanReason {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
-	return 0
+	return BanReason_BAN_REASON_UNSPECIFIED
 }
 
-func (x *QueryRequest) GetTargetId() int64 {
-	if x != nil && x.TargetId != nil {
-		return *x.TargetId
+func (x *EscalationStep) GetOffense() int32 {
+	if x != nil && x.Offense != nil {
+		return *x.Offense
 	}
 	return 0
 }
 
-func (x *QueryRequest) GetGroupsOnly() bool {
-	if x != nil && x.GroupsOnly != nil {
-		return *x.GroupsOnly
-	}
-	return false
-}
-
-func (x *QueryRequest) GetDeleted() bool {
-	if x != nil && x.Deleted != nil {
-		return *x.Deleted
-	}
-	return false
-}
-
-func (x *QueryRequest) GetCidr() string {
-	if x != nil && x.Cidr != nil {
-		return *x.Cidr
-	}
-	return ""
-}
-
-func (x *QueryRequest) GetCidrOnly() bool {
-	if x != nil && x.CidrOnly != nil {
-		return *x.CidrOnly
+func (x *EscalationStep) GetBanType() BanType {
+	if x != nil && x.BanType != nil {
+		return *x.BanType
 	}
-	return false
+	return BanType_BAN_TYPE_OK_UNSPECIFIED
 }
 
-func (x *QueryRequest) GetReason() []BanReason {
+func (x *EscalationStep) GetDuration() *durationpb.Duration {
 	if x != nil {
-		return x.Reason
+		return x.Duration
 	}
 	return nil
 }
 
-func (x *QueryRequest) GetAppealState() AppealState {
-	if x != nil && x.AppealState != nil {
-		return *x.AppealState
-	}
-	return AppealState_APPEAL_STATE_OPEN_UNSPECIFIED
-}
-
-type QueryResponse struct {
+type EscalationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bans          []*Ban                 `protobuf:"bytes,1,rep,name=bans" json:"bans,omitempty"`
+	Steps         []*EscalationStep      `protobuf:"bytes,1,rep,name=steps" json:"steps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryResponse) Reset() {
-	*x = QueryResponse{}
+func (x *EscalationsResponse) Reset() {
+	*x = EscalationsResponse{}
 	mi := &file_ban_v1_ban_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryResponse) String() string {
+func (x *EscalationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryResponse) ProtoMessage() {}
+func (*EscalationsResponse) ProtoMessage() {}
 
-func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+func (x *EscalationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_ban_v1_ban_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1175,63 +1200,40 @@ func (x *QueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
-func (*QueryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EscalationsResponse.ProtoReflect.Descriptor instead.
+func (*EscalationsResponse) Descriptor() ([]byte, []int) {
 	return file_ban_v1_ban_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryResponse) GetBans() []*Ban {
+func (x *EscalationsResponse) GetSteps() []*EscalationStep {
 	if x != nil {
-		return x.Bans
+		return x.Steps
 	}
 	return nil
 }
 
-type Ban struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	TargetId          *int64                 `protobuf:"varint,1,opt,name=target_id,json=targetId" json:"target_id,omitempty"`
-	TargetPersonaName *string                `protobuf:"bytes,2,opt,name=target_persona_name,json=targetPersonaName" json:"target_persona_name,omitempty"`
-	TargetAvatarHash  *string                `protobuf:"bytes,3,opt,name=target_avatar_hash,json=targetAvatarHash" json:"target_avatar_hash,omitempty"`
-	SourceId          *int64                 `protobuf:"varint,4,opt,name=source_id,json=sourceId" json:"source_id,omitempty"`
-	SourcePersonaName *string                `protobuf:"bytes,5,opt,name=source_persona_name,json=sourcePersonaName" json:"source_persona_name,omitempty"`
-	SourceAvatarHash  *string                `protobuf:"bytes,6,opt,name=source_avatar_hash,json=sourceAvatarHash" json:"source_avatar_hash,omitempty"`
-	BanId             *int32                 `protobuf:"varint,7,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
-	ReportId          *int32                 `protobuf:"varint,8,opt,name=report_id,json=reportId" json:"report_id,omitempty"`
-	LastIp            *string                `protobuf:"bytes,9,opt,name=last_ip,json=lastIp" json:"last_ip,omitempty"`
-	EvadeOk           *bool                  `protobuf:"varint,10,opt,name=evade_ok,json=evadeOk" json:"evade_ok,omitempty"`
-	BanType           *BanType               `protobuf:"varint,11,opt,name=ban_type,json=banType,enum=ban.v1.BanType" json:"ban_type,omitempty"`
-	Reason            *BanReason             `protobuf:"varint,12,opt,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
-	ReasonText        *string                `protobuf:"bytes,13,opt,name=reason_text,json=reasonText" json:"reason_text,omitempty"`
-	UnbanReasonText   *string                `protobuf:"bytes,14,opt,name=unban_reason_text,json=unbanReasonText" json:"unban_reason_text,omitempty"`
-	Note              *string                `protobuf:"bytes,15,opt,name=note" json:"note,omitempty"`
-	Origin            *Origin                `protobuf:"varint,16,opt,name=origin,enum=ban.v1.Origin" json:"origin,omitempty"`
-	Cidr              *string                `protobuf:"bytes,17,opt,name=cidr" json:"cidr,omitempty"`
-	AppealState       *AppealState           `protobuf:"varint,18,opt,name=appeal_state,json=appealState,enum=ban.v1.AppealState" json:"appeal_state,omitempty"`
-	Name              *string                `protobuf:"bytes,19,opt,name=name" json:"name,omitempty"`
-	Deleted           *bool                  `protobuf:"varint,20,opt,name=deleted" json:"deleted,omitempty"`
-	IsEnabled         *bool                  `protobuf:"varint,21,opt,name=is_enabled,json=isEnabled" json:"is_enabled,omitempty"`
-	CreatedOn         *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
-	UpdatedOn         *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=updated_on,json=updatedOn" json:"updated_on,omitempty"`
-	ValidUntil        *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=valid_until,json=validUntil" json:"valid_until,omitempty"`
-	DemoId            *int32                 `protobuf:"varint,25,opt,name=demo_id,json=demoId" json:"demo_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type EscalationSaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        *BanReason             `protobuf:"varint,1,opt,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
+	Steps         []*EscalationStep      `protobuf:"bytes,2,rep,name=steps" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Ban) Reset() {
-	*x = Ban{}
+func (x *EscalationSaveRequest) Reset() {
+	*x = EscalationSaveRequest{}
 	mi := &file_ban_v1_ban_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ban) String() string {
+func (x *EscalationSaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ban) ProtoMessage() {}
+func (*EscalationSaveRequest) ProtoMessage() {}
 
-func (x *Ban) ProtoReflect() protoreflect.Message {
+func (x *EscalationSaveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_ban_v1_ban_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1243,110 +1245,908 @@ func (x *Ban) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
-func (*Ban) Descriptor() ([]byte, []int) {
+// Deprecated: Use EscalationSaveRequest.ProtoReflect.Descriptor instead.
+func (*EscalationSaveRequest) Descriptor() ([]byte, []int) {
 	return file_ban_v1_ban_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Ban) GetTargetId() int64 {
-	if x != nil && x.TargetId != nil {
-		return *x.TargetId
+func (x *EscalationSaveRequest) GetReason() BanReason {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
-	return 0
+	return BanReason_BAN_REASON_UNSPECIFIED
 }
 
-func (x *Ban) GetTargetPersonaName() string {
-	if x != nil && x.TargetPersonaName != nil {
-		return *x.TargetPersonaName
+func (x *EscalationSaveRequest) GetSteps() []*EscalationStep {
+	if x != nil {
+		return x.Steps
 	}
-	return ""
+	return nil
 }
 
-func (x *Ban) GetTargetAvatarHash() string {
-	if x != nil && x.TargetAvatarHash != nil {
-		return *x.TargetAvatarHash
-	}
-	return ""
+type EscalationSaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Steps         []*EscalationStep      `protobuf:"bytes,1,rep,name=steps" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Ban) GetSourceId() int64 {
-	if x != nil && x.SourceId != nil {
-		return *x.SourceId
-	}
-	return 0
+func (x *EscalationSaveResponse) Reset() {
+	*x = EscalationSaveResponse{}
+	mi := &file_ban_v1_ban_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ban) GetSourcePersonaName() string {
-	if x != nil && x.SourcePersonaName != nil {
-		return *x.SourcePersonaName
-	}
-	return ""
+func (x *EscalationSaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Ban) GetSourceAvatarHash() string {
-	if x != nil && x.SourceAvatarHash != nil {
-		return *x.SourceAvatarHash
+func (*EscalationSaveResponse) ProtoMessage() {}
+
+func (x *EscalationSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Ban) GetBanId() int32 {
-	if x != nil && x.BanId != nil {
-		return *x.BanId
-	}
-	return 0
+// Deprecated: Use EscalationSaveResponse.ProtoReflect.Descriptor instead.
+func (*EscalationSaveResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Ban) GetReportId() int32 {
-	if x != nil && x.ReportId != nil {
-		return *x.ReportId
+func (x *EscalationSaveResponse) GetSteps() []*EscalationStep {
+	if x != nil {
+		return x.Steps
 	}
-	return 0
+	return nil
 }
 
-func (x *Ban) GetLastIp() string {
-	if x != nil && x.LastIp != nil {
-		return *x.LastIp
-	}
-	return ""
+type SuggestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      *int64                 `protobuf:"varint,1,opt,name=target_id,json=targetId" json:"target_id,omitempty"`
+	Reason        *BanReason             `protobuf:"varint,2,opt,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Ban) GetEvadeOk() bool {
-	if x != nil && x.EvadeOk != nil {
-		return *x.EvadeOk
+func (x *SuggestRequest) Reset() {
+	*x = SuggestRequest{}
+	mi := &file_ban_v1_ban_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuggestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestRequest) ProtoMessage() {}
+
+func (x *SuggestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Ban) GetBanType() BanType {
-	if x != nil && x.BanType != nil {
-		return *x.BanType
+// Deprecated: Use SuggestRequest.ProtoReflect.Descriptor instead.
+func (*SuggestRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SuggestRequest) GetTargetId() int64 {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
 	}
-	return BanType_BAN_TYPE_OK_UNSPECIFIED
+	return 0
 }
 
-func (x *Ban) GetReason() BanReason {
+func (x *SuggestRequest) GetReason() BanReason {
 	if x != nil && x.Reason != nil {
 		return *x.Reason
 	}
 	return BanReason_BAN_REASON_UNSPECIFIED
 }
 
-func (x *Ban) GetReasonText() string {
-	if x != nil && x.ReasonText != nil {
-		return *x.ReasonText
-	}
-	return ""
+type SuggestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// prior_bans includes expired and deleted bans for the same reason.
+	PriorBans *int32 `protobuf:"varint,1,opt,name=prior_bans,json=priorBans" json:"prior_bans,omitempty"`
+	Offense   *int32 `protobuf:"varint,2,opt,name=offense" json:"offense,omitempty"`
+	// matched is false when no escalation step applies, in which case the moderator must pick the duration.
+	Matched       *bool                  `protobuf:"varint,3,opt,name=matched" json:"matched,omitempty"`
+	Step          *EscalationStep        `protobuf:"bytes,4,opt,name=step" json:"step,omitempty"`
+	ValidUntil    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=valid_until,json=validUntil" json:"valid_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Ban) GetUnbanReasonText() string {
-	if x != nil && x.UnbanReasonText != nil {
-		return *x.UnbanReasonText
-	}
-	return ""
+func (x *SuggestResponse) Reset() {
+	*x = SuggestResponse{}
+	mi := &file_ban_v1_ban_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ban) GetNote() string {
+func (x *SuggestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestResponse) ProtoMessage() {}
+
+func (x *SuggestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestResponse.ProtoReflect.Descriptor instead.
+func (*SuggestResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SuggestResponse) GetPriorBans() int32 {
+	if x != nil && x.PriorBans != nil {
+		return *x.PriorBans
+	}
+	return 0
+}
+
+func (x *SuggestResponse) GetOffense() int32 {
+	if x != nil && x.Offense != nil {
+		return *x.Offense
+	}
+	return 0
+}
+
+func (x *SuggestResponse) GetMatched() bool {
+	if x != nil && x.Matched != nil {
+		return *x.Matched
+	}
+	return false
+}
+
+func (x *SuggestResponse) GetStep() *EscalationStep {
+	if x != nil {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *SuggestResponse) GetValidUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return nil
+}
+
+type HistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BanId         *int32                 `protobuf:"varint,1,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryRequest) Reset() {
+	*x = HistoryRequest{}
+	mi := &file_ban_v1_ban_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryRequest) ProtoMessage() {}
+
+func (x *HistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryRequest.ProtoReflect.Descriptor instead.
+func (*HistoryRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *HistoryRequest) GetBanId() int32 {
+	if x != nil && x.BanId != nil {
+		return *x.BanId
+	}
+	return 0
+}
+
+type HistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*BanHistory          `protobuf:"bytes,1,rep,name=history" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HistoryResponse) Reset() {
+	*x = HistoryResponse{}
+	mi := &file_ban_v1_ban_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HistoryResponse) ProtoMessage() {}
+
+func (x *HistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HistoryResponse.ProtoReflect.Descriptor instead.
+func (*HistoryResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HistoryResponse) GetHistory() []*BanHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type BanChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *string                `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
+	OldValue      *string                `protobuf:"bytes,2,opt,name=old_value,json=oldValue" json:"old_value,omitempty"`
+	NewValue      *string                `protobuf:"bytes,3,opt,name=new_value,json=newValue" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanChange) Reset() {
+	*x = BanChange{}
+	mi := &file_ban_v1_ban_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanChange) ProtoMessage() {}
+
+func (x *BanChange) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanChange.ProtoReflect.Descriptor instead.
+func (*BanChange) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BanChange) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
+func (x *BanChange) GetOldValue() string {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return ""
+}
+
+func (x *BanChange) GetNewValue() string {
+	if x != nil && x.NewValue != nil {
+		return *x.NewValue
+	}
+	return ""
+}
+
+type BanHistory struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	HistoryId *int64                 `protobuf:"varint,1,opt,name=history_id,json=historyId" json:"history_id,omitempty"`
+	BanId     *int32                 `protobuf:"varint,2,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
+	// author_id is unset for changes made by the system.
+	AuthorId          *int64                 `protobuf:"varint,3,opt,name=author_id,json=authorId" json:"author_id,omitempty"`
+	AuthorPersonaName *string                `protobuf:"bytes,4,opt,name=author_persona_name,json=authorPersonaName" json:"author_persona_name,omitempty"`
+	Changes           []*BanChange           `protobuf:"bytes,5,rep,name=changes" json:"changes,omitempty"`
+	CreatedOn         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BanHistory) Reset() {
+	*x = BanHistory{}
+	mi := &file_ban_v1_ban_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanHistory) ProtoMessage() {}
+
+func (x *BanHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanHistory.ProtoReflect.Descriptor instead.
+func (*BanHistory) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BanHistory) GetHistoryId() int64 {
+	if x != nil && x.HistoryId != nil {
+		return *x.HistoryId
+	}
+	return 0
+}
+
+func (x *BanHistory) GetBanId() int32 {
+	if x != nil && x.BanId != nil {
+		return *x.BanId
+	}
+	return 0
+}
+
+func (x *BanHistory) GetAuthorId() int64 {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return 0
+}
+
+func (x *BanHistory) GetAuthorPersonaName() string {
+	if x != nil && x.AuthorPersonaName != nil {
+		return *x.AuthorPersonaName
+	}
+	return ""
+}
+
+func (x *BanHistory) GetChanges() []*BanChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *BanHistory) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+type AuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BanId         *int32                 `protobuf:"varint,1,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRequest) Reset() {
+	*x = AuditRequest{}
+	mi := &file_ban_v1_ban_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRequest) ProtoMessage() {}
+
+func (x *AuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRequest.ProtoReflect.Descriptor instead.
+func (*AuditRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AuditRequest) GetBanId() int32 {
+	if x != nil && x.BanId != nil {
+		return *x.BanId
+	}
+	return 0
+}
+
+type AuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Audit         []*BanAudit            `protobuf:"bytes,1,rep,name=audit" json:"audit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditResponse) Reset() {
+	*x = AuditResponse{}
+	mi := &file_ban_v1_ban_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditResponse) ProtoMessage() {}
+
+func (x *AuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditResponse.ProtoReflect.Descriptor instead.
+func (*AuditResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AuditResponse) GetAudit() []*BanAudit {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+type BanAudit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuditId       *int64                 `protobuf:"varint,1,opt,name=audit_id,json=auditId" json:"audit_id,omitempty"`
+	BanId         *int32                 `protobuf:"varint,2,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
+	Action        *string                `protobuf:"bytes,3,opt,name=action" json:"action,omitempty"`
+	Status        *AuditStatus           `protobuf:"varint,4,opt,name=status,enum=ban.v1.AuditStatus" json:"status,omitempty"`
+	Message       *string                `protobuf:"bytes,5,opt,name=message" json:"message,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanAudit) Reset() {
+	*x = BanAudit{}
+	mi := &file_ban_v1_ban_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanAudit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanAudit) ProtoMessage() {}
+
+func (x *BanAudit) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanAudit.ProtoReflect.Descriptor instead.
+func (*BanAudit) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BanAudit) GetAuditId() int64 {
+	if x != nil && x.AuditId != nil {
+		return *x.AuditId
+	}
+	return 0
+}
+
+func (x *BanAudit) GetBanId() int32 {
+	if x != nil && x.BanId != nil {
+		return *x.BanId
+	}
+	return 0
+}
+
+func (x *BanAudit) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *BanAudit) GetStatus() AuditStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return AuditStatus_AUDIT_STATUS_UNSPECIFIED
+}
+
+func (x *BanAudit) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *BanAudit) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+type QueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      *int64                 `protobuf:"varint,1,opt,name=source_id,json=sourceId" json:"source_id,omitempty"`
+	TargetId      *int64                 `protobuf:"varint,2,opt,name=target_id,json=targetId" json:"target_id,omitempty"`
+	GroupsOnly    *bool                  `protobuf:"varint,3,opt,name=groups_only,json=groupsOnly" json:"groups_only,omitempty"`
+	Deleted       *bool                  `protobuf:"varint,4,opt,name=deleted" json:"deleted,omitempty"`
+	Cidr          *string                `protobuf:"bytes,5,opt,name=cidr" json:"cidr,omitempty"`
+	CidrOnly      *bool                  `protobuf:"varint,6,opt,name=cidr_only,json=cidrOnly" json:"cidr_only,omitempty"`
+	Reason        []BanReason            `protobuf:"varint,7,rep,packed,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
+	AppealState   *AppealState           `protobuf:"varint,8,opt,name=appeal_state,json=appealState,enum=ban.v1.AppealState" json:"appeal_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryRequest) Reset() {
+	*x = QueryRequest{}
+	mi := &file_ban_v1_ban_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequest) ProtoMessage() {}
+
+func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QueryRequest) GetSourceId() int64 {
+	if x != nil && x.SourceId != nil {
+		return *x.SourceId
+	}
+	return 0
+}
+
+func (x *QueryRequest) GetTargetId() int64 {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return 0
+}
+
+func (x *QueryRequest) GetGroupsOnly() bool {
+	if x != nil && x.GroupsOnly != nil {
+		return *x.GroupsOnly
+	}
+	return false
+}
+
+func (x *QueryRequest) GetDeleted() bool {
+	if x != nil && x.Deleted != nil {
+		return *x.Deleted
+	}
+	return false
+}
+
+func (x *QueryRequest) GetCidr() string {
+	if x != nil && x.Cidr != nil {
+		return *x.Cidr
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetCidrOnly() bool {
+	if x != nil && x.CidrOnly != nil {
+		return *x.CidrOnly
+	}
+	return false
+}
+
+func (x *QueryRequest) GetReason() []BanReason {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
+}
+
+func (x *QueryRequest) GetAppealState() AppealState {
+	if x != nil && x.AppealState != nil {
+		return *x.AppealState
+	}
+	return AppealState_APPEAL_STATE_OPEN_UNSPECIFIED
+}
+
+type QueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bans          []*Ban                 `protobuf:"bytes,1,rep,name=bans" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
+	mi := &file_ban_v1_ban_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResponse) ProtoMessage() {}
+
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryResponse) GetBans() []*Ban {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+type Ban struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TargetId          *int64                 `protobuf:"varint,1,opt,name=target_id,json=targetId" json:"target_id,omitempty"`
+	TargetPersonaName *string                `protobuf:"bytes,2,opt,name=target_persona_name,json=targetPersonaName" json:"target_persona_name,omitempty"`
+	TargetAvatarHash  *string                `protobuf:"bytes,3,opt,name=target_avatar_hash,json=targetAvatarHash" json:"target_avatar_hash,omitempty"`
+	SourceId          *int64                 `protobuf:"varint,4,opt,name=source_id,json=sourceId" json:"source_id,omitempty"`
+	SourcePersonaName *string                `protobuf:"bytes,5,opt,name=source_persona_name,json=sourcePersonaName" json:"source_persona_name,omitempty"`
+	SourceAvatarHash  *string                `protobuf:"bytes,6,opt,name=source_avatar_hash,json=sourceAvatarHash" json:"source_avatar_hash,omitempty"`
+	BanId             *int32                 `protobuf:"varint,7,opt,name=ban_id,json=banId" json:"ban_id,omitempty"`
+	ReportId          *int32                 `protobuf:"varint,8,opt,name=report_id,json=reportId" json:"report_id,omitempty"`
+	LastIp            *string                `protobuf:"bytes,9,opt,name=last_ip,json=lastIp" json:"last_ip,omitempty"`
+	EvadeOk           *bool                  `protobuf:"varint,10,opt,name=evade_ok,json=evadeOk" json:"evade_ok,omitempty"`
+	BanType           *BanType               `protobuf:"varint,11,opt,name=ban_type,json=banType,enum=ban.v1.BanType" json:"ban_type,omitempty"`
+	Reason            *BanReason             `protobuf:"varint,12,opt,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
+	ReasonText        *string                `protobuf:"bytes,13,opt,name=reason_text,json=reasonText" json:"reason_text,omitempty"`
+	UnbanReasonText   *string                `protobuf:"bytes,14,opt,name=unban_reason_text,json=unbanReasonText" json:"unban_reason_text,omitempty"`
+	Note              *string                `protobuf:"bytes,15,opt,name=note" json:"note,omitempty"`
+	Origin            *Origin                `protobuf:"varint,16,opt,name=origin,enum=ban.v1.Origin" json:"origin,omitempty"`
+	Cidr              *string                `protobuf:"bytes,17,opt,name=cidr" json:"cidr,omitempty"`
+	AppealState       *AppealState           `protobuf:"varint,18,opt,name=appeal_state,json=appealState,enum=ban.v1.AppealState" json:"appeal_state,omitempty"`
+	Name              *string                `protobuf:"bytes,19,opt,name=name" json:"name,omitempty"`
+	Deleted           *bool                  `protobuf:"varint,20,opt,name=deleted" json:"deleted,omitempty"`
+	IsEnabled         *bool                  `protobuf:"varint,21,opt,name=is_enabled,json=isEnabled" json:"is_enabled,omitempty"`
+	CreatedOn         *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	UpdatedOn         *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=updated_on,json=updatedOn" json:"updated_on,omitempty"`
+	ValidUntil        *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=valid_until,json=validUntil" json:"valid_until,omitempty"`
+	DemoId            *int32                 `protobuf:"varint,25,opt,name=demo_id,json=demoId" json:"demo_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Ban) Reset() {
+	*x = Ban{}
+	mi := &file_ban_v1_ban_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ban) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ban) ProtoMessage() {}
+
+func (x *Ban) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_ban_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ban.ProtoReflect.Descriptor instead.
+func (*Ban) Descriptor() ([]byte, []int) {
+	return file_ban_v1_ban_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Ban) GetTargetId() int64 {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return 0
+}
+
+func (x *Ban) GetTargetPersonaName() string {
+	if x != nil && x.TargetPersonaName != nil {
+		return *x.TargetPersonaName
+	}
+	return ""
+}
+
+func (x *Ban) GetTargetAvatarHash() string {
+	if x != nil && x.TargetAvatarHash != nil {
+		return *x.TargetAvatarHash
+	}
+	return ""
+}
+
+func (x *Ban) GetSourceId() int64 {
+	if x != nil && x.SourceId != nil {
+		return *x.SourceId
+	}
+	return 0
+}
+
+func (x *Ban) GetSourcePersonaName() string {
+	if x != nil && x.SourcePersonaName != nil {
+		return *x.SourcePersonaName
+	}
+	return ""
+}
+
+func (x *Ban) GetSourceAvatarHash() string {
+	if x != nil && x.SourceAvatarHash != nil {
+		return *x.SourceAvatarHash
+	}
+	return ""
+}
+
+func (x *Ban) GetBanId() int32 {
+	if x != nil && x.BanId != nil {
+		return *x.BanId
+	}
+	return 0
+}
+
+func (x *Ban) GetReportId() int32 {
+	if x != nil && x.ReportId != nil {
+		return *x.ReportId
+	}
+	return 0
+}
+
+func (x *Ban) GetLastIp() string {
+	if x != nil && x.LastIp != nil {
+		return *x.LastIp
+	}
+	return ""
+}
+
+func (x *Ban) GetEvadeOk() bool {
+	if x != nil && x.EvadeOk != nil {
+		return *x.EvadeOk
+	}
+	return false
+}
+
+func (x *Ban) GetBanType() BanType {
+	if x != nil && x.BanType != nil {
+		return *x.BanType
+	}
+	return BanType_BAN_TYPE_OK_UNSPECIFIED
+}
+
+func (x *Ban) GetReason() BanReason {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return BanReason_BAN_REASON_UNSPECIFIED
+}
+
+func (x *Ban) GetReasonText() string {
+	if x != nil && x.ReasonText != nil {
+		return *x.ReasonText
+	}
+	return ""
+}
+
+func (x *Ban) GetUnbanReasonText() string {
+	if x != nil && x.UnbanReasonText != nil {
+		return *x.UnbanReasonText
+	}
+	return ""
+}
+
+func (x *Ban) GetNote() string {
 	if x != nil && x.Note != nil {
 		return *x.Note
 	}
@@ -1431,13 +2231,13 @@ const file_ban_v1_ban_proto_rawDesc = "" +
 	"\x17GetBanByReportIDRequest\x12#\n" +
 	"\treport_id\x18\x01 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\breportId\"A\n" +
 	"\x18GetBanByReportIDResponse\x12%\n" +
-	"\x03ban\x18\x01 \x01(\v2\v.ban.v1.BanB\x06\xbaH\x03\xc8\x01\x01R\x03ban\"\xe6\x04\n" +
+	"\x03ban\x18\x01 \x01(\v2\v.ban.v1.BanB\x06\xbaH\x03\xc8\x01\x01R\x03ban\"\xe3\x04\n" +
 	"\rCreateRequest\x121\n" +
 	"\ttarget_id\x18\x01 \x01(\x03B\x14\xbaH\x0f\xc8\x01\x01\"\n" +
 	"(\x81\x80\x80\x80\x90\x80\x80\x88\x010\x01R\btargetId\x12.\n" +
 	"\tsource_id\x18\x02 \x01(\x03B\x11\xbaH\f\"\n" +
-	"(\x81\x80\x80\x80\x90\x80\x80\x88\x010\x01R\bsourceId\x12H\n" +
-	"\vvalid_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\v\xbaH\b\xc8\x01\x01\xb2\x01\x02@\x01R\n" +
+	"(\x81\x80\x80\x80\x90\x80\x80\x88\x010\x01R\bsourceId\x12E\n" +
+	"\vvalid_until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\xb2\x01\x02@\x01R\n" +
 	"validUntil\x127\n" +
 	"\bban_type\x18\x04 \x01(\x0e2\x0f.ban.v1.BanTypeB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\abanType\x126\n" +
 	"\x06reason\x18\x05 \x01(\x0e2\x11.ban.v1.BanReasonB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\x06reason\x12\x1f\n" +
@@ -1497,7 +2297,65 @@ const file_ban_v1_ban_proto_rawDesc = "" +
 	"\x06ban_id\x18\x01 \x01(\x05B\n" +
 	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x05banId\x12\"\n" +
 	"\x06reason\x18\x02 \x01(\tB\n" +
-	"\xbaH\a\xc8\x01\x01r\x02\x10\x04R\x06reason\"\xd1\x02\n" +
+	"\xbaH\a\xc8\x01\x01r\x02\x10\x04R\x06reason\"\xe7\x01\n" +
+	"\x0eEscalationStep\x123\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x11.ban.v1.BanReasonB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06reason\x12$\n" +
+	"\aoffense\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\aoffense\x129\n" +
+	"\bban_type\x18\x03 \x01(\x0e2\x0f.ban.v1.BanTypeB\r\xbaH\n" +
+	"\xc8\x01\x01\x82\x01\x04\x18\x01\x18\x02R\abanType\x12?\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationB\b\xbaH\x05\xaa\x01\x022\x00R\bduration\"C\n" +
+	"\x13EscalationsResponse\x12,\n" +
+	"\x05steps\x18\x01 \x03(\v2\x16.ban.v1.EscalationStepR\x05steps\"}\n" +
+	"\x15EscalationSaveRequest\x126\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x11.ban.v1.BanReasonB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\x06reason\x12,\n" +
+	"\x05steps\x18\x02 \x03(\v2\x16.ban.v1.EscalationStepR\x05steps\"F\n" +
+	"\x16EscalationSaveResponse\x12,\n" +
+	"\x05steps\x18\x01 \x03(\v2\x16.ban.v1.EscalationStepR\x05steps\"{\n" +
+	"\x0eSuggestRequest\x121\n" +
+	"\ttarget_id\x18\x01 \x01(\x03B\x14\xbaH\x0f\xc8\x01\x01\"\n" +
+	"(\x81\x80\x80\x80\x90\x80\x80\x88\x010\x01R\btargetId\x126\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x11.ban.v1.BanReasonB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\x06reason\"\xcd\x01\n" +
+	"\x0fSuggestResponse\x12\x1d\n" +
+	"\n" +
+	"prior_bans\x18\x01 \x01(\x05R\tpriorBans\x12\x18\n" +
+	"\aoffense\x18\x02 \x01(\x05R\aoffense\x12\x18\n" +
+	"\amatched\x18\x03 \x01(\bR\amatched\x12*\n" +
+	"\x04step\x18\x04 \x01(\v2\x16.ban.v1.EscalationStepR\x04step\x12;\n" +
+	"\vvalid_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"validUntil\"3\n" +
+	"\x0eHistoryRequest\x12!\n" +
+	"\x06ban_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x05banId\"?\n" +
+	"\x0fHistoryResponse\x12,\n" +
+	"\ahistory\x18\x01 \x03(\v2\x12.ban.v1.BanHistoryR\ahistory\"c\n" +
+	"\tBanChange\x12\x1c\n" +
+	"\x05field\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\x95\x02\n" +
+	"\n" +
+	"BanHistory\x12'\n" +
+	"\n" +
+	"history_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\thistoryId\x12\x1d\n" +
+	"\x06ban_id\x18\x02 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\x05banId\x12\x1f\n" +
+	"\tauthor_id\x18\x03 \x01(\x03B\x020\x01R\bauthorId\x12.\n" +
+	"\x13author_persona_name\x18\x04 \x01(\tR\x11authorPersonaName\x12+\n" +
+	"\achanges\x18\x05 \x03(\v2\x11.ban.v1.BanChangeR\achanges\x12A\n" +
+	"\n" +
+	"created_on\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn\"1\n" +
+	"\fAuditRequest\x12!\n" +
+	"\x06ban_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x05banId\"7\n" +
+	"\rAuditResponse\x12&\n" +
+	"\x05audit\x18\x01 \x03(\v2\x10.ban.v1.BanAuditR\x05audit\"\x80\x02\n" +
+	"\bBanAudit\x12#\n" +
+	"\baudit_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\aauditId\x12\x1d\n" +
+	"\x06ban_id\x18\x02 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\x05banId\x12\x1e\n" +
+	"\x06action\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06action\x123\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x13.ban.v1.AuditStatusB\x06\xbaH\x03\xc8\x01\x01R\x06status\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12A\n" +
+	"\n" +
+	"created_on\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn\"\xd1\x02\n" +
 	"\fQueryRequest\x12.\n" +
 	"\tsource_id\x18\x01 \x01(\x03B\x11\xbaH\f\"\n" +
 	"(\x81\x80\x80\x80\x90\x80\x80\x88\x010\x01R\bsourceId\x12.\n" +
@@ -1576,7 +2434,12 @@ const file_ban_v1_ban_proto_rawDesc = "" +
 	"\x1cBAN_REASON_ITEM_DESCRIPTIONS\x10\v\x12\x17\n" +
 	"\x13BAN_REASON_BOT_HOST\x10\f\x12\x16\n" +
 	"\x12BAN_REASON_EVADING\x10\r\x12\x17\n" +
-	"\x13BAN_REASON_USERNAME\x10\x0e*p\n" +
+	"\x13BAN_REASON_USERNAME\x10\x0e*w\n" +
+	"\vAuditStatus\x12\x1c\n" +
+	"\x18AUDIT_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14AUDIT_STATUS_SUCCESS\x10\x01\x12\x17\n" +
+	"\x13AUDIT_STATUS_FAILED\x10\x02\x12\x17\n" +
+	"\x13AUDIT_STATUS_QUEUED\x10\x03*p\n" +
 	"\x06Origin\x12\x1d\n" +
 	"\x19ORIGIN_SYSTEM_UNSPECIFIED\x10\x00\x12\x0e\n" +
 	"\n" +
@@ -1584,7 +2447,7 @@ const file_ban_v1_ban_proto_rawDesc = "" +
 	"\n" +
 	"ORIGIN_WEB\x10\x02\x12\x12\n" +
 	"\x0eORIGIN_IN_GAME\x10\x03\x12\x13\n" +
-	"\x0fORIGIN_REPORTED\x10\x042\xd6\x03\n" +
+	"\x0fORIGIN_REPORTED\x10\x042\xa3\x06\n" +
 	"\n" +
 	"BanService\x126\n" +
 	"\x05Query\x12\x14.ban.v1.QueryRequest\x1a\x15.ban.v1.QueryResponse\"\x00\x129\n" +
@@ -1593,7 +2456,12 @@ const file_ban_v1_ban_proto_rawDesc = "" +
 	"\x10GetBanByReportID\x12\x1f.ban.v1.GetBanByReportIDRequest\x1a .ban.v1.GetBanByReportIDResponse\"\x00\x12T\n" +
 	"\x0fQuerySourceBans\x12\x1e.ban.v1.QuerySourceBansRequest\x1a\x1f.ban.v1.QuerySourceBansResponse\"\x00\x129\n" +
 	"\x06Update\x12\x15.ban.v1.UpdateRequest\x1a\x16.ban.v1.UpdateResponse\"\x00\x129\n" +
-	"\x06Create\x12\x15.ban.v1.CreateRequest\x1a\x16.ban.v1.CreateResponse\"\x00B\x86\x01\n" +
+	"\x06Create\x12\x15.ban.v1.CreateRequest\x1a\x16.ban.v1.CreateResponse\"\x00\x12<\n" +
+	"\aHistory\x12\x16.ban.v1.HistoryRequest\x1a\x17.ban.v1.HistoryResponse\"\x00\x126\n" +
+	"\x05Audit\x12\x14.ban.v1.AuditRequest\x1a\x15.ban.v1.AuditResponse\"\x00\x12<\n" +
+	"\aSuggest\x12\x16.ban.v1.SuggestRequest\x1a\x17.ban.v1.SuggestResponse\"\x00\x12D\n" +
+	"\vEscalations\x12\x16.google.protobuf.Empty\x1a\x1b.ban.v1.EscalationsResponse\"\x00\x12Q\n" +
+	"\x0eEscalationSave\x12\x1d.ban.v1.EscalationSaveRequest\x1a\x1e.ban.v1.EscalationSaveResponse\"\x00B\x86\x01\n" +
 	"\n" +
 	"com.ban.v1B\bBanProtoP\x01Z5github.com/leighmacdonald/gbans/internal/ban/v1;banv1\xa2\x02\x03BXX\xaa\x02\x06Ban.V1\xca\x02\x06Ban\\V1\xe2\x02\x12Ban\\V1\\GPBMetadata\xea\x02\aBan::V1b\beditionsp\xe8\a"
 
@@ -1609,77 +2477,117 @@ func file_ban_v1_ban_proto_rawDescGZIP() []byte {
 	return file_ban_v1_ban_proto_rawDescData
 }
 
-var file_ban_v1_ban_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_ban_v1_ban_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_ban_v1_ban_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_ban_v1_ban_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_ban_v1_ban_proto_goTypes = []any{
 	(BanType)(0),                     // 0: ban.v1.BanType
 	(AppealState)(0),                 // 1: ban.v1.AppealState
 	(BanReason)(0),                   // 2: ban.v1.BanReason
-	(Origin)(0),                      // 3: ban.v1.Origin
-	(*GetBanByReportIDRequest)(nil),  // 4: ban.v1.GetBanByReportIDRequest
-	(*GetBanByReportIDResponse)(nil), // 5: ban.v1.GetBanByReportIDResponse
-	(*CreateRequest)(nil),            // 6: ban.v1.CreateRequest
-	(*CreateResponse)(nil),           // 7: ban.v1.CreateResponse
-	(*UpdateRequest)(nil),            // 8: ban.v1.UpdateRequest
-	(*UpdateResponse)(nil),           // 9: ban.v1.UpdateResponse
-	(*QuerySourceBansRequest)(nil),   // 10: ban.v1.QuerySourceBansRequest
-	(*SourceBanRecord)(nil),          // 11: ban.v1.SourceBanRecord
-	(*QuerySourceBansResponse)(nil),  // 12: ban.v1.QuerySourceBansResponse
-	(*GetRequest)(nil),               // 13: ban.v1.GetRequest
-	(*GetResponse)(nil),              // 14: ban.v1.GetResponse
-	(*DeleteRequest)(nil),            // 15: ban.v1.DeleteRequest
-	(*QueryRequest)(nil),             // 16: ban.v1.QueryRequest
-	(*QueryResponse)(nil),            // 17: ban.v1.QueryResponse
-	(*Ban)(nil),                      // 18: ban.v1.Ban
-	(*timestamppb.Timestamp)(nil),    // 19: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),      // 20: google.protobuf.Duration
-	(*emptypb.Empty)(nil),            // 21: google.protobuf.Empty
+	(AuditStatus)(0),                 // 3: ban.v1.AuditStatus
+	(Origin)(0),                      // 4: ban.v1.Origin
+	(*GetBanByReportIDRequest)(nil),  // 5: ban.v1.GetBanByReportIDRequest
+	(*GetBanByReportIDResponse)(nil), // 6: ban.v1.GetBanByReportIDResponse
+	(*CreateRequest)(nil),            // 7: ban.v1.CreateRequest
+	(*CreateResponse)(nil),           // 8: ban.v1.CreateResponse
+	(*UpdateRequest)(nil),            // 9: ban.v1.UpdateRequest
+	(*UpdateResponse)(nil),           // 10: ban.v1.UpdateResponse
+	(*QuerySourceBansRequest)(nil),   // 11: ban.v1.QuerySourceBansRequest
+	(*SourceBanRecord)(nil),          // 12: ban.v1.SourceBanRecord
+	(*QuerySourceBansResponse)(nil),  // 13: ban.v1.QuerySourceBansResponse
+	(*GetRequest)(nil),               // 14: ban.v1.GetRequest
+	(*GetResponse)(nil),              // 15: ban.v1.GetResponse
+	(*DeleteRequest)(nil),            // 16: ban.v1.DeleteRequest
+	(*EscalationStep)(nil),           // 17: ban.v1.EscalationStep
+	(*EscalationsResponse)(nil),      // 18: ban.v1.EscalationsResponse
+	(*EscalationSaveRequest)(nil),    // 19: ban.v1.EscalationSaveRequest
+	(*EscalationSaveResponse)(nil),   // 20: ban.v1.EscalationSaveResponse
+	(*SuggestRequest)(nil),           // 21: ban.v1.SuggestRequest
+	(*SuggestResponse)(nil),          // 22: ban.v1.SuggestResponse
+	(*HistoryRequest)(nil),           // 23: ban.v1.HistoryRequest
+	(*HistoryResponse)(nil),          // 24: ban.v1.HistoryResponse
+	(*BanChange)(nil),                // 25: ban.v1.BanChange
+	(*BanHistory)(nil),               // 26: ban.v1.BanHistory
+	(*AuditRequest)(nil),             // 27: ban.v1.AuditRequest
+	(*AuditResponse)(nil),            // 28: ban.v1.AuditResponse
+	(*BanAudit)(nil),                 // 29: ban.v1.BanAudit
+	(*QueryRequest)(nil),             // 30: ban.v1.QueryRequest
+	(*QueryResponse)(nil),            // 31: ban.v1.QueryResponse
+	(*Ban)(nil),                      // 32: ban.v1.Ban
+	(*timestamppb.Timestamp)(nil),    // 33: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),      // 34: google.protobuf.Duration
+	(*emptypb.Empty)(nil),            // 35: google.protobuf.Empty
 }
 var file_ban_v1_ban_proto_depIdxs = []int32{
-	18, // 0: ban.v1.GetBanByReportIDResponse.ban:type_name -> ban.v1.Ban
-	19, // 1: ban.v1.CreateRequest.valid_until:type_name -> google.protobuf.Timestamp
+	32, // 0: ban.v1.GetBanByReportIDResponse.ban:type_name -> ban.v1.Ban
+	33, // 1: ban.v1.CreateRequest.valid_until:type_name -> google.protobuf.Timestamp
 	0,  // 2: ban.v1.CreateRequest.ban_type:type_name -> ban.v1.BanType
 	2,  // 3: ban.v1.CreateRequest.reason:type_name -> ban.v1.BanReason
-	3,  // 4: ban.v1.CreateRequest.origin:type_name -> ban.v1.Origin
-	18, // 5: ban.v1.CreateResponse.ban:type_name -> ban.v1.Ban
+	4,  // 4: ban.v1.CreateRequest.origin:type_name -> ban.v1.Origin
+	32, // 5: ban.v1.CreateResponse.ban:type_name -> ban.v1.Ban
 	0,  // 6: ban.v1.UpdateRequest.ban_type:type_name -> ban.v1.BanType
 	2,  // 7: ban.v1.UpdateRequest.reason:type_name -> ban.v1.BanReason
-	19, // 8: ban.v1.UpdateRequest.valid_until:type_name -> google.protobuf.Timestamp
+	33, // 8: ban.v1.UpdateRequest.valid_until:type_name -> google.protobuf.Timestamp
 	1,  // 9: ban.v1.UpdateRequest.appeal_state:type_name -> ban.v1.AppealState
-	18, // 10: ban.v1.UpdateResponse.ban:type_name -> ban.v1.Ban
-	20, // 11: ban.v1.SourceBanRecord.duration:type_name -> google.protobuf.Duration
-	19, // 12: ban.v1.SourceBanRecord.created_on:type_name -> google.protobuf.Timestamp
-	11, // 13: ban.v1.QuerySourceBansResponse.bans:type_name -> ban.v1.SourceBanRecord
-	18, // 14: ban.v1.GetResponse.ban:type_name -> ban.v1.Ban
-	2,  // 15: ban.v1.QueryRequest.reason:type_name -> ban.v1.BanReason
-	1,  // 16: ban.v1.QueryRequest.appeal_state:type_name -> ban.v1.AppealState
-	18, // 17: ban.v1.QueryResponse.bans:type_name -> ban.v1.Ban
-	0,  // 18: ban.v1.Ban.ban_type:type_name -> ban.v1.BanType
-	2,  // 19: ban.v1.Ban.reason:type_name -> ban.v1.BanReason
-	3,  // 20: ban.v1.Ban.origin:type_name -> ban.v1.Origin
-	1,  // 21: ban.v1.Ban.appeal_state:type_name -> ban.v1.AppealState
-	19, // 22: ban.v1.Ban.created_on:type_name -> google.protobuf.Timestamp
-	19, // 23: ban.v1.Ban.updated_on:type_name -> google.protobuf.Timestamp
-	19, // 24: ban.v1.Ban.valid_until:type_name -> google.protobuf.Timestamp
-	16, // 25: ban.v1.BanService.Query:input_type -> ban.v1.QueryRequest
-	15, // 26: ban.v1.BanService.Delete:input_type -> ban.v1.DeleteRequest
-	13, // 27: ban.v1.BanService.Get:input_type -> ban.v1.GetRequest
-	4,  // 28: ban.v1.BanService.GetBanByReportID:input_type -> ban.v1.GetBanByReportIDRequest
-	10, // 29: ban.v1.BanService.QuerySourceBans:input_type -> ban.v1.QuerySourceBansRequest
-	8,  // 30: ban.v1.BanService.Update:input_type -> ban.v1.UpdateRequest
-	6,  // 31: ban.v1.BanService.Create:input_type -> ban.v1.CreateRequest
-	17, // 32: ban.v1.BanService.Query:output_type -> ban.v1.QueryResponse
-	21, // 33: ban.v1.BanService.Delete:output_type -> google.protobuf.Empty
-	14, // 34: ban.v1.BanService.Get:output_type -> ban.v1.GetResponse
-	5,  // 35: ban.v1.BanService.GetBanByReportID:output_type -> ban.v1.GetBanByReportIDResponse
-	12, // 36: ban.v1.BanService.QuerySourceBans:output_type -> ban.v1.QuerySourceBansResponse
-	9,  // 37: ban.v1.BanService.Update:output_type -> ban.v1.UpdateResponse
-	7,  // 38: ban.v1.BanService.Create:output_type -> ban.v1.CreateResponse
-	32, // [32:39] is the sub-list for method output_type
-	25, // [25:32] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	32, // 10: ban.v1.UpdateResponse.ban:type_name -> ban.v1.Ban
+	34, // 11: ban.v1.SourceBanRecord.duration:type_name -> google.protobuf.Duration
+	33, // 12: ban.v1.SourceBanRecord.created_on:type_name -> google.protobuf.Timestamp
+	12, // 13: ban.v1.QuerySourceBansResponse.bans:type_name -> ban.v1.SourceBanRecord
+	32, // 14: ban.v1.GetResponse.ban:type_name -> ban.v1.Ban
+	2,  // 15: ban.v1.EscalationStep.reason:type_name -> ban.v1.BanReason
+	0,  // 16: ban.v1.EscalationStep.ban_type:type_name -> ban.v1.BanType
+	34, // 17: ban.v1.EscalationStep.duration:type_name -> google.protobuf.Duration
+	17, // 18: ban.v1.EscalationsResponse.steps:type_name -> ban.v1.EscalationStep
+	2,  // 19: ban.v1.EscalationSaveRequest.reason:type_name -> ban.v1.BanReason
+	17, // 20: ban.v1.EscalationSaveRequest.steps:type_name -> ban.v1.EscalationStep
+	17, // 21: ban.v1.EscalationSaveResponse.steps:type_name -> ban.v1.EscalationStep
+	2,  // 22: ban.v1.SuggestRequest.reason:type_name -> ban.v1.BanReason
+	17, // 23: ban.v1.SuggestResponse.step:type_name -> ban.v1.EscalationStep
+	33, // 24: ban.v1.SuggestResponse.valid_until:type_name -> google.protobuf.Timestamp
+	26, // 25: ban.v1.HistoryResponse.history:type_name -> ban.v1.BanHistory
+	25, // 26: ban.v1.BanHistory.changes:type_name -> ban.v1.BanChange
+	33, // 27: ban.v1.BanHistory.created_on:type_name -> google.protobuf.Timestamp
+	29, // 28: ban.v1.AuditResponse.audit:type_name -> ban.v1.BanAudit
+	3,  // 29: ban.v1.BanAudit.status:type_name -> ban.v1.AuditStatus
+	33, // 30: ban.v1.BanAudit.created_on:type_name -> google.protobuf.Timestamp
+	2,  // 31: ban.v1.QueryRequest.reason:type_name -> ban.v1.BanReason
+	1,  // 32: ban.v1.QueryRequest.appeal_state:type_name -> ban.v1.AppealState
+	32, // 33: ban.v1.QueryResponse.bans:type_name -> ban.v1.Ban
+	0,  // 34: ban.v1.Ban.ban_type:type_name -> ban.v1.BanType
+	2,  // 35: ban.v1.Ban.reason:type_name -> ban.v1.BanReason
+	4,  // 36: ban.v1.Ban.origin:type_name -> ban.v1.Origin
+	1,  // 37: ban.v1.Ban.appeal_state:type_name -> ban.v1.AppealState
+	33, // 38: ban.v1.Ban.created_on:type_name -> google.protobuf.Timestamp
+	33, // 39: ban.v1.Ban.updated_on:type_name -> google.protobuf.Timestamp
+	33, // 40: ban.v1.Ban.valid_until:type_name -> google.protobuf.Timestamp
+	30, // 41: ban.v1.BanService.Query:input_type -> ban.v1.QueryRequest
+	16, // 42: ban.v1.BanService.Delete:input_type -> ban.v1.DeleteRequest
+	14, // 43: ban.v1.BanService.Get:input_type -> ban.v1.GetRequest
+	5,  // 44: ban.v1.BanService.GetBanByReportID:input_type -> ban.v1.GetBanByReportIDRequest
+	11, // 45: ban.v1.BanService.QuerySourceBans:input_type -> ban.v1.QuerySourceBansRequest
+	9,  // 46: ban.v1.BanService.Update:input_type -> ban.v1.UpdateRequest
+	7,  // 47: ban.v1.BanService.Create:input_type -> ban.v1.CreateRequest
+	23, // 48: ban.v1.BanService.History:input_type -> ban.v1.HistoryRequest
+	27, // 49: ban.v1.BanService.Audit:input_type -> ban.v1.AuditRequest
+	21, // 50: ban.v1.BanService.Suggest:input_type -> ban.v1.SuggestRequest
+	35, // 51: ban.v1.BanService.Escalations:input_type -> google.protobuf.Empty
+	19, // 52: ban.v1.BanService.EscalationSave:input_type -> ban.v1.EscalationSaveRequest
+	31, // 53: ban.v1.BanService.Query:output_type -> ban.v1.QueryResponse
+	35, // 54: ban.v1.BanService.Delete:output_type -> google.protobuf.Empty
+	15, // 55: ban.v1.BanService.Get:output_type -> ban.v1.GetResponse
+	6,  // 56: ban.v1.BanService.GetBanByReportID:output_type -> ban.v1.GetBanByReportIDResponse
+	13, // 57: ban.v1.BanService.QuerySourceBans:output_type -> ban.v1.QuerySourceBansResponse
+	10, // 58: ban.v1.BanService.Update:output_type -> ban.v1.UpdateResponse
+	8,  // 59: ban.v1.BanService.Create:output_type -> ban.v1.CreateResponse
+	24, // 60: ban.v1.BanService.History:output_type -> ban.v1.HistoryResponse
+	28, // 61: ban.v1.BanService.Audit:output_type -> ban.v1.AuditResponse
+	22, // 62: ban.v1.BanService.Suggest:output_type -> ban.v1.SuggestResponse
+	18, // 63: ban.v1.BanService.Escalations:output_type -> ban.v1.EscalationsResponse
+	20, // 64: ban.v1.BanService.EscalationSave:output_type -> ban.v1.EscalationSaveResponse
+	53, // [53:65] is the sub-list for method output_type
+	41, // [41:53] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_ban_v1_ban_proto_init() }
@@ -1692,8 +2600,8 @@ func file_ban_v1_ban_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ban_v1_ban_proto_rawDesc), len(file_ban_v1_ban_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   15,
+			NumEnums:      5,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
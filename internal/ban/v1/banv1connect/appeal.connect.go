@@ -49,6 +49,24 @@ const (
 	// AppealServiceSetAppealStateProcedure is the fully-qualified name of the AppealService's
 	// SetAppealState RPC.
 	AppealServiceSetAppealStateProcedure = "/ban.v1.AppealService/SetAppealState"
+	// AppealServiceAppealAssignProcedure is the fully-qualified name of the AppealService's
+	// AppealAssign RPC.
+	AppealServiceAppealAssignProcedure = "/ban.v1.AppealService/AppealAssign"
+	// AppealServiceAppealDashboardProcedure is the fully-qualified name of the AppealService's
+	// AppealDashboard RPC.
+	AppealServiceAppealDashboardProcedure = "/ban.v1.AppealService/AppealDashboard"
+	// AppealServiceAppealTemplatesProcedure is the fully-qualified name of the AppealService's
+	// AppealTemplates RPC.
+	AppealServiceAppealTemplatesProcedure = "/ban.v1.AppealService/AppealTemplates"
+	// AppealServiceAppealTemplateSaveProcedure is the fully-qualified name of the AppealService's
+	// AppealTemplateSave RPC.
+	AppealServiceAppealTemplateSaveProcedure = "/ban.v1.AppealService/AppealTemplateSave"
+	// AppealServiceAppealTemplateDeleteProcedure is the fully-qualified name of the AppealService's
+	// AppealTemplateDelete RPC.
+	AppealServiceAppealTemplateDeleteProcedure = "/ban.v1.AppealService/AppealTemplateDelete"
+	// AppealServiceAppealTemplateRenderProcedure is the fully-qualified name of the AppealService's
+	// AppealTemplateRender RPC.
+	AppealServiceAppealTemplateRenderProcedure = "/ban.v1.AppealService/AppealTemplateRender"
 )
 
 // AppealServiceClient is a client for the ban.v1.AppealService service.
@@ -58,7 +76,18 @@ type AppealServiceClient interface {
 	Reply(context.Context, *v1.ReplyRequest) (*v1.ReplyResponse, error)
 	EditAppealMessage(context.Context, *v1.EditAppealMessageRequest) (*v1.EditAppealMessageResponse, error)
 	DeleteAppealMessage(context.Context, *v1.DeleteAppealMessageRequest) (*emptypb.Empty, error)
+	// SetAppealState updates the outcome of an appeal. Reduced outcomes also shorten the ban to valid_until.
 	SetAppealState(context.Context, *v1.SetAppealStateRequest) (*v1.SetAppealStateResponse, error)
+	// AppealAssign assigns an appeal to a moderator, an assignee_id of 0 unassigns it.
+	AppealAssign(context.Context, *v1.AppealAssignRequest) (*emptypb.Empty, error)
+	// AppealDashboard counts the appeals assigned to a moderator, defaulting to the current user.
+	AppealDashboard(context.Context, *v1.AppealDashboardRequest) (*v1.AppealDashboardResponse, error)
+	AppealTemplates(context.Context, *emptypb.Empty) (*v1.AppealTemplatesResponse, error)
+	// AppealTemplateSave creates a template when appeal_template_id is 0, otherwise updates it.
+	AppealTemplateSave(context.Context, *v1.AppealTemplateSaveRequest) (*v1.AppealTemplateSaveResponse, error)
+	AppealTemplateDelete(context.Context, *v1.AppealTemplateDeleteRequest) (*emptypb.Empty, error)
+	// AppealTemplateRender fills in a template with the details of a ban, ready to be used as a reply.
+	AppealTemplateRender(context.Context, *v1.AppealTemplateRenderRequest) (*v1.AppealTemplateRenderResponse, error)
 }
 
 // NewAppealServiceClient constructs a client for the ban.v1.AppealService service. By default, it
@@ -108,17 +137,59 @@ func NewAppealServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(appealServiceMethods.ByName("SetAppealState")),
 			connect.WithClientOptions(opts...),
 		),
+		appealAssign: connect.NewClient[v1.AppealAssignRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AppealServiceAppealAssignProcedure,
+			connect.WithSchema(appealServiceMethods.ByName("AppealAssign")),
+			connect.WithClientOptions(opts...),
+		),
+		appealDashboard: connect.NewClient[v1.AppealDashboardRequest, v1.AppealDashboardResponse](
+			httpClient,
+			baseURL+AppealServiceAppealDashboardProcedure,
+			connect.WithSchema(appealServiceMethods.ByName("AppealDashboard")),
+			connect.WithClientOptions(opts...),
+		),
+		appealTemplates: connect.NewClient[emptypb.Empty, v1.AppealTemplatesResponse](
+			httpClient,
+			baseURL+AppealServiceAppealTemplatesProcedure,
+			connect.WithSchema(appealServiceMethods.ByName("AppealTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		appealTemplateSave: connect.NewClient[v1.AppealTemplateSaveRequest, v1.AppealTemplateSaveResponse](
+			httpClient,
+			baseURL+AppealServiceAppealTemplateSaveProcedure,
+			connect.WithSchema(appealServiceMethods.ByName("AppealTemplateSave")),
+			connect.WithClientOptions(opts...),
+		),
+		appealTemplateDelete: connect.NewClient[v1.AppealTemplateDeleteRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AppealServiceAppealTemplateDeleteProcedure,
+			connect.WithSchema(appealServiceMethods.ByName("AppealTemplateDelete")),
+			connect.WithClientOptions(opts...),
+		),
+		appealTemplateRender: connect.NewClient[v1.AppealTemplateRenderRequest, v1.AppealTemplateRenderResponse](
+			httpClient,
+			baseURL+AppealServiceAppealTemplateRenderProcedure,
+			connect.WithSchema(appealServiceMethods.ByName("AppealTemplateRender")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // appealServiceClient implements AppealServiceClient.
 type appealServiceClient struct {
-	appeals             *connect.Client[v1.AppealsRequest, v1.AppealsResponse]
-	messages            *connect.Client[v1.MessagesRequest, v1.MessagesResponse]
-	reply               *connect.Client[v1.ReplyRequest, v1.ReplyResponse]
-	editAppealMessage   *connect.Client[v1.EditAppealMessageRequest, v1.EditAppealMessageResponse]
-	deleteAppealMessage *connect.Client[v1.DeleteAppealMessageRequest, emptypb.Empty]
-	setAppealState      *connect.Client[v1.SetAppealStateRequest, v1.SetAppealStateResponse]
+	appeals              *connect.Client[v1.AppealsRequest, v1.AppealsResponse]
+	messages             *connect.Client[v1.MessagesRequest, v1.MessagesResponse]
+	reply                *connect.Client[v1.ReplyRequest, v1.ReplyResponse]
+	editAppealMessage    *connect.Client[v1.EditAppealMessageRequest, v1.EditAppealMessageResponse]
+	deleteAppealMessage  *connect.Client[v1.DeleteAppealMessageRequest, emptypb.Empty]
+	setAppealState       *connect.Client[v1.SetAppealStateRequest, v1.SetAppealStateResponse]
+	appealAssign         *connect.Client[v1.AppealAssignRequest, emptypb.Empty]
+	appealDashboard      *connect.Client[v1.AppealDashboardRequest, v1.AppealDashboardResponse]
+	appealTemplates      *connect.Client[emptypb.Empty, v1.AppealTemplatesResponse]
+	appealTemplateSave   *connect.Client[v1.AppealTemplateSaveRequest, v1.AppealTemplateSaveResponse]
+	appealTemplateDelete *connect.Client[v1.AppealTemplateDeleteRequest, emptypb.Empty]
+	appealTemplateRender *connect.Client[v1.AppealTemplateRenderRequest, v1.AppealTemplateRenderResponse]
 }
 
 // Appeals calls ban.v1.AppealService.Appeals.
@@ -175,6 +246,60 @@ func (c *appealServiceClient) SetAppealState(ctx context.Context, req *v1.SetApp
 	return nil, err
 }
 
+// AppealAssign calls ban.v1.AppealService.AppealAssign.
+func (c *appealServiceClient) AppealAssign(ctx context.Context, req *v1.AppealAssignRequest) (*emptypb.Empty, error) {
+	response, err := c.appealAssign.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AppealDashboard calls ban.v1.AppealService.AppealDashboard.
+func (c *appealServiceClient) AppealDashboard(ctx context.Context, req *v1.AppealDashboardRequest) (*v1.AppealDashboardResponse, error) {
+	response, err := c.appealDashboard.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AppealTemplates calls ban.v1.AppealService.AppealTemplates.
+func (c *appealServiceClient) AppealTemplates(ctx context.Context, req *emptypb.Empty) (*v1.AppealTemplatesResponse, error) {
+	response, err := c.appealTemplates.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AppealTemplateSave calls ban.v1.AppealService.AppealTemplateSave.
+func (c *appealServiceClient) AppealTemplateSave(ctx context.Context, req *v1.AppealTemplateSaveRequest) (*v1.AppealTemplateSaveResponse, error) {
+	response, err := c.appealTemplateSave.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AppealTemplateDelete calls ban.v1.AppealService.AppealTemplateDelete.
+func (c *appealServiceClient) AppealTemplateDelete(ctx context.Context, req *v1.AppealTemplateDeleteRequest) (*emptypb.Empty, error) {
+	response, err := c.appealTemplateDelete.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// AppealTemplateRender calls ban.v1.AppealService.AppealTemplateRender.
+func (c *appealServiceClient) AppealTemplateRender(ctx context.Context, req *v1.AppealTemplateRenderRequest) (*v1.AppealTemplateRenderResponse, error) {
+	response, err := c.appealTemplateRender.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // AppealServiceHandler is an implementation of the ban.v1.AppealService service.
 type AppealServiceHandler interface {
 	Appeals(context.Context, *v1.AppealsRequest) (*v1.AppealsResponse, error)
@@ -182,7 +307,18 @@ type AppealServiceHandler interface {
 	Reply(context.Context, *v1.ReplyRequest) (*v1.ReplyResponse, error)
 	EditAppealMessage(context.Context, *v1.EditAppealMessageRequest) (*v1.EditAppealMessageResponse, error)
 	DeleteAppealMessage(context.Context, *v1.DeleteAppealMessageRequest) (*emptypb.Empty, error)
+	// SetAppealState updates the outcome of an appeal. Reduced outcomes also shorten the ban to valid_until.
 	SetAppealState(context.Context, *v1.SetAppealStateRequest) (*v1.SetAppealStateResponse, error)
+	// AppealAssign assigns an appeal to a moderator, an assignee_id of 0 unassigns it.
+	AppealAssign(context.Context, *v1.AppealAssignRequest) (*emptypb.Empty, error)
+	// AppealDashboard counts the appeals assigned to a moderator, defaulting to the current user.
+	AppealDashboard(context.Context, *v1.AppealDashboardRequest) (*v1.AppealDashboardResponse, error)
+	AppealTemplates(context.Context, *emptypb.Empty) (*v1.AppealTemplatesResponse, error)
+	// AppealTemplateSave creates a template when appeal_template_id is 0, otherwise updates it.
+	AppealTemplateSave(context.Context, *v1.AppealTemplateSaveRequest) (*v1.AppealTemplateSaveResponse, error)
+	AppealTemplateDelete(context.Context, *v1.AppealTemplateDeleteRequest) (*emptypb.Empty, error)
+	// AppealTemplateRender fills in a template with the details of a ban, ready to be used as a reply.
+	AppealTemplateRender(context.Context, *v1.AppealTemplateRenderRequest) (*v1.AppealTemplateRenderResponse, error)
 }
 
 // NewAppealServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -228,6 +364,42 @@ func NewAppealServiceHandler(svc AppealServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(appealServiceMethods.ByName("SetAppealState")),
 		connect.WithHandlerOptions(opts...),
 	)
+	appealServiceAppealAssignHandler := connect.NewUnaryHandlerSimple(
+		AppealServiceAppealAssignProcedure,
+		svc.AppealAssign,
+		connect.WithSchema(appealServiceMethods.ByName("AppealAssign")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appealServiceAppealDashboardHandler := connect.NewUnaryHandlerSimple(
+		AppealServiceAppealDashboardProcedure,
+		svc.AppealDashboard,
+		connect.WithSchema(appealServiceMethods.ByName("AppealDashboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appealServiceAppealTemplatesHandler := connect.NewUnaryHandlerSimple(
+		AppealServiceAppealTemplatesProcedure,
+		svc.AppealTemplates,
+		connect.WithSchema(appealServiceMethods.ByName("AppealTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appealServiceAppealTemplateSaveHandler := connect.NewUnaryHandlerSimple(
+		AppealServiceAppealTemplateSaveProcedure,
+		svc.AppealTemplateSave,
+		connect.WithSchema(appealServiceMethods.ByName("AppealTemplateSave")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appealServiceAppealTemplateDeleteHandler := connect.NewUnaryHandlerSimple(
+		AppealServiceAppealTemplateDeleteProcedure,
+		svc.AppealTemplateDelete,
+		connect.WithSchema(appealServiceMethods.ByName("AppealTemplateDelete")),
+		connect.WithHandlerOptions(opts...),
+	)
+	appealServiceAppealTemplateRenderHandler := connect.NewUnaryHandlerSimple(
+		AppealServiceAppealTemplateRenderProcedure,
+		svc.AppealTemplateRender,
+		connect.WithSchema(appealServiceMethods.ByName("AppealTemplateRender")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ban.v1.AppealService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AppealServiceAppealsProcedure:
@@ -242,6 +414,18 @@ func NewAppealServiceHandler(svc AppealServiceHandler, opts ...connect.HandlerOp
 			appealServiceDeleteAppealMessageHandler.ServeHTTP(w, r)
 		case AppealServiceSetAppealStateProcedure:
 			appealServiceSetAppealStateHandler.ServeHTTP(w, r)
+		case AppealServiceAppealAssignProcedure:
+			appealServiceAppealAssignHandler.ServeHTTP(w, r)
+		case AppealServiceAppealDashboardProcedure:
+			appealServiceAppealDashboardHandler.ServeHTTP(w, r)
+		case AppealServiceAppealTemplatesProcedure:
+			appealServiceAppealTemplatesHandler.ServeHTTP(w, r)
+		case AppealServiceAppealTemplateSaveProcedure:
+			appealServiceAppealTemplateSaveHandler.ServeHTTP(w, r)
+		case AppealServiceAppealTemplateDeleteProcedure:
+			appealServiceAppealTemplateDeleteHandler.ServeHTTP(w, r)
+		case AppealServiceAppealTemplateRenderProcedure:
+			appealServiceAppealTemplateRenderHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -274,3 +458,27 @@ func (UnimplementedAppealServiceHandler) DeleteAppealMessage(context.Context, *v
 func (UnimplementedAppealServiceHandler) SetAppealState(context.Context, *v1.SetAppealStateRequest) (*v1.SetAppealStateResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.AppealService.SetAppealState is not implemented"))
 }
+
+func (UnimplementedAppealServiceHandler) AppealAssign(context.Context, *v1.AppealAssignRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.AppealService.AppealAssign is not implemented"))
+}
+
+func (UnimplementedAppealServiceHandler) AppealDashboard(context.Context, *v1.AppealDashboardRequest) (*v1.AppealDashboardResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.AppealService.AppealDashboard is not implemented"))
+}
+
+func (UnimplementedAppealServiceHandler) AppealTemplates(context.Context, *emptypb.Empty) (*v1.AppealTemplatesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.AppealService.AppealTemplates is not implemented"))
+}
+
+func (UnimplementedAppealServiceHandler) AppealTemplateSave(context.Context, *v1.AppealTemplateSaveRequest) (*v1.AppealTemplateSaveResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.AppealService.AppealTemplateSave is not implemented"))
+}
+
+func (UnimplementedAppealServiceHandler) AppealTemplateDelete(context.Context, *v1.AppealTemplateDeleteRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.AppealService.AppealTemplateDelete is not implemented"))
+}
+
+func (UnimplementedAppealServiceHandler) AppealTemplateRender(context.Context, *v1.AppealTemplateRenderRequest) (*v1.AppealTemplateRenderResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.AppealService.AppealTemplateRender is not implemented"))
+}
@@ -50,6 +50,17 @@ const (
 	BanServiceUpdateProcedure = "/ban.v1.BanService/Update"
 	// BanServiceCreateProcedure is the fully-qualified name of the BanService's Create RPC.
 	BanServiceCreateProcedure = "/ban.v1.BanService/Create"
+	// BanServiceHistoryProcedure is the fully-qualified name of the BanService's History RPC.
+	BanServiceHistoryProcedure = "/ban.v1.BanService/History"
+	// BanServiceAuditProcedure is the fully-qualified name of the BanService's Audit RPC.
+	BanServiceAuditProcedure = "/ban.v1.BanService/Audit"
+	// BanServiceSuggestProcedure is the fully-qualified name of the BanService's Suggest RPC.
+	BanServiceSuggestProcedure = "/ban.v1.BanService/Suggest"
+	// BanServiceEscalationsProcedure is the fully-qualified name of the BanService's Escalations RPC.
+	BanServiceEscalationsProcedure = "/ban.v1.BanService/Escalations"
+	// BanServiceEscalationSaveProcedure is the fully-qualified name of the BanService's EscalationSave
+	// RPC.
+	BanServiceEscalationSaveProcedure = "/ban.v1.BanService/EscalationSave"
 )
 
 // BanServiceClient is a client for the ban.v1.BanService service.
@@ -62,6 +73,15 @@ type BanServiceClient interface {
 	QuerySourceBans(context.Context, *v1.QuerySourceBansRequest) (*v1.QuerySourceBansResponse, error)
 	Update(context.Context, *v1.UpdateRequest) (*v1.UpdateResponse, error)
 	Create(context.Context, *v1.CreateRequest) (*v1.CreateResponse, error)
+	// History returns the ordered list of changes made to a ban.
+	History(context.Context, *v1.HistoryRequest) (*v1.HistoryResponse, error)
+	// Audit returns the automated steps, such as expiry notifications, performed against a ban.
+	Audit(context.Context, *v1.AuditRequest) (*v1.AuditResponse, error)
+	// Suggest returns the penalty the escalation ladder suggests for the next ban of a player.
+	Suggest(context.Context, *v1.SuggestRequest) (*v1.SuggestResponse, error)
+	Escalations(context.Context, *emptypb.Empty) (*v1.EscalationsResponse, error)
+	// EscalationSave replaces the ladder of a reason. An empty ladder disables escalation for the reason.
+	EscalationSave(context.Context, *v1.EscalationSaveRequest) (*v1.EscalationSaveResponse, error)
 }
 
 // NewBanServiceClient constructs a client for the ban.v1.BanService service. By default, it uses
@@ -117,6 +137,36 @@ func NewBanServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(banServiceMethods.ByName("Create")),
 			connect.WithClientOptions(opts...),
 		),
+		history: connect.NewClient[v1.HistoryRequest, v1.HistoryResponse](
+			httpClient,
+			baseURL+BanServiceHistoryProcedure,
+			connect.WithSchema(banServiceMethods.ByName("History")),
+			connect.WithClientOptions(opts...),
+		),
+		audit: connect.NewClient[v1.AuditRequest, v1.AuditResponse](
+			httpClient,
+			baseURL+BanServiceAuditProcedure,
+			connect.WithSchema(banServiceMethods.ByName("Audit")),
+			connect.WithClientOptions(opts...),
+		),
+		suggest: connect.NewClient[v1.SuggestRequest, v1.SuggestResponse](
+			httpClient,
+			baseURL+BanServiceSuggestProcedure,
+			connect.WithSchema(banServiceMethods.ByName("Suggest")),
+			connect.WithClientOptions(opts...),
+		),
+		escalations: connect.NewClient[emptypb.Empty, v1.EscalationsResponse](
+			httpClient,
+			baseURL+BanServiceEscalationsProcedure,
+			connect.WithSchema(banServiceMethods.ByName("Escalations")),
+			connect.WithClientOptions(opts...),
+		),
+		escalationSave: connect.NewClient[v1.EscalationSaveRequest, v1.EscalationSaveResponse](
+			httpClient,
+			baseURL+BanServiceEscalationSaveProcedure,
+			connect.WithSchema(banServiceMethods.ByName("EscalationSave")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -129,6 +179,11 @@ type banServiceClient struct {
 	querySourceBans  *connect.Client[v1.QuerySourceBansRequest, v1.QuerySourceBansResponse]
 	update           *connect.Client[v1.UpdateRequest, v1.UpdateResponse]
 	create           *connect.Client[v1.CreateRequest, v1.CreateResponse]
+	history          *connect.Client[v1.HistoryRequest, v1.HistoryResponse]
+	audit            *connect.Client[v1.AuditRequest, v1.AuditResponse]
+	suggest          *connect.Client[v1.SuggestRequest, v1.SuggestResponse]
+	escalations      *connect.Client[emptypb.Empty, v1.EscalationsResponse]
+	escalationSave   *connect.Client[v1.EscalationSaveRequest, v1.EscalationSaveResponse]
 }
 
 // Query calls ban.v1.BanService.Query.
@@ -194,6 +249,51 @@ func (c *banServiceClient) Create(ctx context.Context, req *v1.CreateRequest) (*
 	return nil, err
 }
 
+// History calls ban.v1.BanService.History.
+func (c *banServiceClient) History(ctx context.Context, req *v1.HistoryRequest) (*v1.HistoryResponse, error) {
+	response, err := c.history.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Audit calls ban.v1.BanService.Audit.
+func (c *banServiceClient) Audit(ctx context.Context, req *v1.AuditRequest) (*v1.AuditResponse, error) {
+	response, err := c.audit.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Suggest calls ban.v1.BanService.Suggest.
+func (c *banServiceClient) Suggest(ctx context.Context, req *v1.SuggestRequest) (*v1.SuggestResponse, error) {
+	response, err := c.suggest.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Escalations calls ban.v1.BanService.Escalations.
+func (c *banServiceClient) Escalations(ctx context.Context, req *emptypb.Empty) (*v1.EscalationsResponse, error) {
+	response, err := c.escalations.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EscalationSave calls ban.v1.BanService.EscalationSave.
+func (c *banServiceClient) EscalationSave(ctx context.Context, req *v1.EscalationSaveRequest) (*v1.EscalationSaveResponse, error) {
+	response, err := c.escalationSave.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // BanServiceHandler is an implementation of the ban.v1.BanService service.
 type BanServiceHandler interface {
 	Query(context.Context, *v1.QueryRequest) (*v1.QueryResponse, error)
@@ -204,6 +304,15 @@ type BanServiceHandler interface {
 	QuerySourceBans(context.Context, *v1.QuerySourceBansRequest) (*v1.QuerySourceBansResponse, error)
 	Update(context.Context, *v1.UpdateRequest) (*v1.UpdateResponse, error)
 	Create(context.Context, *v1.CreateRequest) (*v1.CreateResponse, error)
+	// History returns the ordered list of changes made to a ban.
+	History(context.Context, *v1.HistoryRequest) (*v1.HistoryResponse, error)
+	// Audit returns the automated steps, such as expiry notifications, performed against a ban.
+	Audit(context.Context, *v1.AuditRequest) (*v1.AuditResponse, error)
+	// Suggest returns the penalty the escalation ladder suggests for the next ban of a player.
+	Suggest(context.Context, *v1.SuggestRequest) (*v1.SuggestResponse, error)
+	Escalations(context.Context, *emptypb.Empty) (*v1.EscalationsResponse, error)
+	// EscalationSave replaces the ladder of a reason. An empty ladder disables escalation for the reason.
+	EscalationSave(context.Context, *v1.EscalationSaveRequest) (*v1.EscalationSaveResponse, error)
 }
 
 // NewBanServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -255,6 +364,36 @@ func NewBanServiceHandler(svc BanServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(banServiceMethods.ByName("Create")),
 		connect.WithHandlerOptions(opts...),
 	)
+	banServiceHistoryHandler := connect.NewUnaryHandlerSimple(
+		BanServiceHistoryProcedure,
+		svc.History,
+		connect.WithSchema(banServiceMethods.ByName("History")),
+		connect.WithHandlerOptions(opts...),
+	)
+	banServiceAuditHandler := connect.NewUnaryHandlerSimple(
+		BanServiceAuditProcedure,
+		svc.Audit,
+		connect.WithSchema(banServiceMethods.ByName("Audit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	banServiceSuggestHandler := connect.NewUnaryHandlerSimple(
+		BanServiceSuggestProcedure,
+		svc.Suggest,
+		connect.WithSchema(banServiceMethods.ByName("Suggest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	banServiceEscalationsHandler := connect.NewUnaryHandlerSimple(
+		BanServiceEscalationsProcedure,
+		svc.Escalations,
+		connect.WithSchema(banServiceMethods.ByName("Escalations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	banServiceEscalationSaveHandler := connect.NewUnaryHandlerSimple(
+		BanServiceEscalationSaveProcedure,
+		svc.EscalationSave,
+		connect.WithSchema(banServiceMethods.ByName("EscalationSave")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ban.v1.BanService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BanServiceQueryProcedure:
@@ -271,6 +410,16 @@ func NewBanServiceHandler(svc BanServiceHandler, opts ...connect.HandlerOption)
 			banServiceUpdateHandler.ServeHTTP(w, r)
 		case BanServiceCreateProcedure:
 			banServiceCreateHandler.ServeHTTP(w, r)
+		case BanServiceHistoryProcedure:
+			banServiceHistoryHandler.ServeHTTP(w, r)
+		case BanServiceAuditProcedure:
+			banServiceAuditHandler.ServeHTTP(w, r)
+		case BanServiceSuggestProcedure:
+			banServiceSuggestHandler.ServeHTTP(w, r)
+		case BanServiceEscalationsProcedure:
+			banServiceEscalationsHandler.ServeHTTP(w, r)
+		case BanServiceEscalationSaveProcedure:
+			banServiceEscalationSaveHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -307,3 +456,23 @@ func (UnimplementedBanServiceHandler) Update(context.Context, *v1.UpdateRequest)
 func (UnimplementedBanServiceHandler) Create(context.Context, *v1.CreateRequest) (*v1.CreateResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.BanService.Create is not implemented"))
 }
+
+func (UnimplementedBanServiceHandler) History(context.Context, *v1.HistoryRequest) (*v1.HistoryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.BanService.History is not implemented"))
+}
+
+func (UnimplementedBanServiceHandler) Audit(context.Context, *v1.AuditRequest) (*v1.AuditResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.BanService.Audit is not implemented"))
+}
+
+func (UnimplementedBanServiceHandler) Suggest(context.Context, *v1.SuggestRequest) (*v1.SuggestResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.BanService.Suggest is not implemented"))
+}
+
+func (UnimplementedBanServiceHandler) Escalations(context.Context, *emptypb.Empty) (*v1.EscalationsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.BanService.Escalations is not implemented"))
+}
+
+func (UnimplementedBanServiceHandler) EscalationSave(context.Context, *v1.EscalationSaveRequest) (*v1.EscalationSaveResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.BanService.EscalationSave is not implemented"))
+}
@@ -9,6 +9,7 @@ import (
 	context "context"
 	errors "errors"
 	v1 "github.com/leighmacdonald/gbans/internal/ban/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -38,12 +39,40 @@ const (
 	// ExportServiceGetValveSteamIDProcedure is the fully-qualified name of the ExportService's
 	// GetValveSteamID RPC.
 	ExportServiceGetValveSteamIDProcedure = "/ban.v1.ExportService/GetValveSteamID"
+	// ExportServiceFeedsProcedure is the fully-qualified name of the ExportService's Feeds RPC.
+	ExportServiceFeedsProcedure = "/ban.v1.ExportService/Feeds"
+	// ExportServiceFeedSaveProcedure is the fully-qualified name of the ExportService's FeedSave RPC.
+	ExportServiceFeedSaveProcedure = "/ban.v1.ExportService/FeedSave"
+	// ExportServiceFeedDeleteProcedure is the fully-qualified name of the ExportService's FeedDelete
+	// RPC.
+	ExportServiceFeedDeleteProcedure = "/ban.v1.ExportService/FeedDelete"
+	// ExportServiceConsumersProcedure is the fully-qualified name of the ExportService's Consumers RPC.
+	ExportServiceConsumersProcedure = "/ban.v1.ExportService/Consumers"
+	// ExportServiceConsumerCreateProcedure is the fully-qualified name of the ExportService's
+	// ConsumerCreate RPC.
+	ExportServiceConsumerCreateProcedure = "/ban.v1.ExportService/ConsumerCreate"
+	// ExportServiceConsumerDeleteProcedure is the fully-qualified name of the ExportService's
+	// ConsumerDelete RPC.
+	ExportServiceConsumerDeleteProcedure = "/ban.v1.ExportService/ConsumerDelete"
+	// ExportServicePublicKeyProcedure is the fully-qualified name of the ExportService's PublicKey RPC.
+	ExportServicePublicKeyProcedure = "/ban.v1.ExportService/PublicKey"
 )
 
 // ExportServiceClient is a client for the ban.v1.ExportService service.
 type ExportServiceClient interface {
 	GetTF2BD(context.Context, *v1.GetTF2BDRequest) (*v1.GetTF2BDResponse, error)
 	GetValveSteamID(context.Context, *v1.GetValveSteamIDRequest) (*v1.GetValveSteamIDResponse, error)
+	// Feeds lists the configured export feeds.
+	Feeds(context.Context, *emptypb.Empty) (*v1.FeedsResponse, error)
+	// FeedSave creates a new feed, or updates the existing one when a feed_id is set.
+	FeedSave(context.Context, *v1.FeedSaveRequest) (*v1.FeedSaveResponse, error)
+	FeedDelete(context.Context, *v1.FeedDeleteRequest) (*emptypb.Empty, error)
+	Consumers(context.Context, *v1.ConsumersRequest) (*v1.ConsumersResponse, error)
+	// ConsumerCreate issues a new api key for a feed. The key is only returned once.
+	ConsumerCreate(context.Context, *v1.ConsumerCreateRequest) (*v1.ConsumerCreateResponse, error)
+	ConsumerDelete(context.Context, *v1.ConsumerDeleteRequest) (*emptypb.Empty, error)
+	// PublicKey returns the PEM encoded ed25519 key used to sign the X-Signature header of feeds.
+	PublicKey(context.Context, *emptypb.Empty) (*v1.PublicKeyResponse, error)
 }
 
 // NewExportServiceClient constructs a client for the ban.v1.ExportService service. By default, it
@@ -69,6 +98,48 @@ func NewExportServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(exportServiceMethods.ByName("GetValveSteamID")),
 			connect.WithClientOptions(opts...),
 		),
+		feeds: connect.NewClient[emptypb.Empty, v1.FeedsResponse](
+			httpClient,
+			baseURL+ExportServiceFeedsProcedure,
+			connect.WithSchema(exportServiceMethods.ByName("Feeds")),
+			connect.WithClientOptions(opts...),
+		),
+		feedSave: connect.NewClient[v1.FeedSaveRequest, v1.FeedSaveResponse](
+			httpClient,
+			baseURL+ExportServiceFeedSaveProcedure,
+			connect.WithSchema(exportServiceMethods.ByName("FeedSave")),
+			connect.WithClientOptions(opts...),
+		),
+		feedDelete: connect.NewClient[v1.FeedDeleteRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ExportServiceFeedDeleteProcedure,
+			connect.WithSchema(exportServiceMethods.ByName("FeedDelete")),
+			connect.WithClientOptions(opts...),
+		),
+		consumers: connect.NewClient[v1.ConsumersRequest, v1.ConsumersResponse](
+			httpClient,
+			baseURL+ExportServiceConsumersProcedure,
+			connect.WithSchema(exportServiceMethods.ByName("Consumers")),
+			connect.WithClientOptions(opts...),
+		),
+		consumerCreate: connect.NewClient[v1.ConsumerCreateRequest, v1.ConsumerCreateResponse](
+			httpClient,
+			baseURL+ExportServiceConsumerCreateProcedure,
+			connect.WithSchema(exportServiceMethods.ByName("ConsumerCreate")),
+			connect.WithClientOptions(opts...),
+		),
+		consumerDelete: connect.NewClient[v1.ConsumerDeleteRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ExportServiceConsumerDeleteProcedure,
+			connect.WithSchema(exportServiceMethods.ByName("ConsumerDelete")),
+			connect.WithClientOptions(opts...),
+		),
+		publicKey: connect.NewClient[emptypb.Empty, v1.PublicKeyResponse](
+			httpClient,
+			baseURL+ExportServicePublicKeyProcedure,
+			connect.WithSchema(exportServiceMethods.ByName("PublicKey")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -76,6 +147,13 @@ func NewExportServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 type exportServiceClient struct {
 	getTF2BD        *connect.Client[v1.GetTF2BDRequest, v1.GetTF2BDResponse]
 	getValveSteamID *connect.Client[v1.GetValveSteamIDRequest, v1.GetValveSteamIDResponse]
+	feeds           *connect.Client[emptypb.Empty, v1.FeedsResponse]
+	feedSave        *connect.Client[v1.FeedSaveRequest, v1.FeedSaveResponse]
+	feedDelete      *connect.Client[v1.FeedDeleteRequest, emptypb.Empty]
+	consumers       *connect.Client[v1.ConsumersRequest, v1.ConsumersResponse]
+	consumerCreate  *connect.Client[v1.ConsumerCreateRequest, v1.ConsumerCreateResponse]
+	consumerDelete  *connect.Client[v1.ConsumerDeleteRequest, emptypb.Empty]
+	publicKey       *connect.Client[emptypb.Empty, v1.PublicKeyResponse]
 }
 
 // GetTF2BD calls ban.v1.ExportService.GetTF2BD.
@@ -96,10 +174,84 @@ func (c *exportServiceClient) GetValveSteamID(ctx context.Context, req *v1.GetVa
 	return nil, err
 }
 
+// Feeds calls ban.v1.ExportService.Feeds.
+func (c *exportServiceClient) Feeds(ctx context.Context, req *emptypb.Empty) (*v1.FeedsResponse, error) {
+	response, err := c.feeds.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// FeedSave calls ban.v1.ExportService.FeedSave.
+func (c *exportServiceClient) FeedSave(ctx context.Context, req *v1.FeedSaveRequest) (*v1.FeedSaveResponse, error) {
+	response, err := c.feedSave.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// FeedDelete calls ban.v1.ExportService.FeedDelete.
+func (c *exportServiceClient) FeedDelete(ctx context.Context, req *v1.FeedDeleteRequest) (*emptypb.Empty, error) {
+	response, err := c.feedDelete.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Consumers calls ban.v1.ExportService.Consumers.
+func (c *exportServiceClient) Consumers(ctx context.Context, req *v1.ConsumersRequest) (*v1.ConsumersResponse, error) {
+	response, err := c.consumers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ConsumerCreate calls ban.v1.ExportService.ConsumerCreate.
+func (c *exportServiceClient) ConsumerCreate(ctx context.Context, req *v1.ConsumerCreateRequest) (*v1.ConsumerCreateResponse, error) {
+	response, err := c.consumerCreate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ConsumerDelete calls ban.v1.ExportService.ConsumerDelete.
+func (c *exportServiceClient) ConsumerDelete(ctx context.Context, req *v1.ConsumerDeleteRequest) (*emptypb.Empty, error) {
+	response, err := c.consumerDelete.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PublicKey calls ban.v1.ExportService.PublicKey.
+func (c *exportServiceClient) PublicKey(ctx context.Context, req *emptypb.Empty) (*v1.PublicKeyResponse, error) {
+	response, err := c.publicKey.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ExportServiceHandler is an implementation of the ban.v1.ExportService service.
 type ExportServiceHandler interface {
 	GetTF2BD(context.Context, *v1.GetTF2BDRequest) (*v1.GetTF2BDResponse, error)
 	GetValveSteamID(context.Context, *v1.GetValveSteamIDRequest) (*v1.GetValveSteamIDResponse, error)
+	// Feeds lists the configured export feeds.
+	Feeds(context.Context, *emptypb.Empty) (*v1.FeedsResponse, error)
+	// FeedSave creates a new feed, or updates the existing one when a feed_id is set.
+	FeedSave(context.Context, *v1.FeedSaveRequest) (*v1.FeedSaveResponse, error)
+	FeedDelete(context.Context, *v1.FeedDeleteRequest) (*emptypb.Empty, error)
+	Consumers(context.Context, *v1.ConsumersRequest) (*v1.ConsumersResponse, error)
+	// ConsumerCreate issues a new api key for a feed. The key is only returned once.
+	ConsumerCreate(context.Context, *v1.ConsumerCreateRequest) (*v1.ConsumerCreateResponse, error)
+	ConsumerDelete(context.Context, *v1.ConsumerDeleteRequest) (*emptypb.Empty, error)
+	// PublicKey returns the PEM encoded ed25519 key used to sign the X-Signature header of feeds.
+	PublicKey(context.Context, *emptypb.Empty) (*v1.PublicKeyResponse, error)
 }
 
 // NewExportServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -121,12 +273,68 @@ func NewExportServiceHandler(svc ExportServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(exportServiceMethods.ByName("GetValveSteamID")),
 		connect.WithHandlerOptions(opts...),
 	)
+	exportServiceFeedsHandler := connect.NewUnaryHandlerSimple(
+		ExportServiceFeedsProcedure,
+		svc.Feeds,
+		connect.WithSchema(exportServiceMethods.ByName("Feeds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exportServiceFeedSaveHandler := connect.NewUnaryHandlerSimple(
+		ExportServiceFeedSaveProcedure,
+		svc.FeedSave,
+		connect.WithSchema(exportServiceMethods.ByName("FeedSave")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exportServiceFeedDeleteHandler := connect.NewUnaryHandlerSimple(
+		ExportServiceFeedDeleteProcedure,
+		svc.FeedDelete,
+		connect.WithSchema(exportServiceMethods.ByName("FeedDelete")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exportServiceConsumersHandler := connect.NewUnaryHandlerSimple(
+		ExportServiceConsumersProcedure,
+		svc.Consumers,
+		connect.WithSchema(exportServiceMethods.ByName("Consumers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exportServiceConsumerCreateHandler := connect.NewUnaryHandlerSimple(
+		ExportServiceConsumerCreateProcedure,
+		svc.ConsumerCreate,
+		connect.WithSchema(exportServiceMethods.ByName("ConsumerCreate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exportServiceConsumerDeleteHandler := connect.NewUnaryHandlerSimple(
+		ExportServiceConsumerDeleteProcedure,
+		svc.ConsumerDelete,
+		connect.WithSchema(exportServiceMethods.ByName("ConsumerDelete")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exportServicePublicKeyHandler := connect.NewUnaryHandlerSimple(
+		ExportServicePublicKeyProcedure,
+		svc.PublicKey,
+		connect.WithSchema(exportServiceMethods.ByName("PublicKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ban.v1.ExportService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ExportServiceGetTF2BDProcedure:
 			exportServiceGetTF2BDHandler.ServeHTTP(w, r)
 		case ExportServiceGetValveSteamIDProcedure:
 			exportServiceGetValveSteamIDHandler.ServeHTTP(w, r)
+		case ExportServiceFeedsProcedure:
+			exportServiceFeedsHandler.ServeHTTP(w, r)
+		case ExportServiceFeedSaveProcedure:
+			exportServiceFeedSaveHandler.ServeHTTP(w, r)
+		case ExportServiceFeedDeleteProcedure:
+			exportServiceFeedDeleteHandler.ServeHTTP(w, r)
+		case ExportServiceConsumersProcedure:
+			exportServiceConsumersHandler.ServeHTTP(w, r)
+		case ExportServiceConsumerCreateProcedure:
+			exportServiceConsumerCreateHandler.ServeHTTP(w, r)
+		case ExportServiceConsumerDeleteProcedure:
+			exportServiceConsumerDeleteHandler.ServeHTTP(w, r)
+		case ExportServicePublicKeyProcedure:
+			exportServicePublicKeyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -143,3 +351,31 @@ func (UnimplementedExportServiceHandler) GetTF2BD(context.Context, *v1.GetTF2BDR
 func (UnimplementedExportServiceHandler) GetValveSteamID(context.Context, *v1.GetValveSteamIDRequest) (*v1.GetValveSteamIDResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ExportService.GetValveSteamID is not implemented"))
 }
+
+func (UnimplementedExportServiceHandler) Feeds(context.Context, *emptypb.Empty) (*v1.FeedsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ExportService.Feeds is not implemented"))
+}
+
+func (UnimplementedExportServiceHandler) FeedSave(context.Context, *v1.FeedSaveRequest) (*v1.FeedSaveResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ExportService.FeedSave is not implemented"))
+}
+
+func (UnimplementedExportServiceHandler) FeedDelete(context.Context, *v1.FeedDeleteRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ExportService.FeedDelete is not implemented"))
+}
+
+func (UnimplementedExportServiceHandler) Consumers(context.Context, *v1.ConsumersRequest) (*v1.ConsumersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ExportService.Consumers is not implemented"))
+}
+
+func (UnimplementedExportServiceHandler) ConsumerCreate(context.Context, *v1.ConsumerCreateRequest) (*v1.ConsumerCreateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ExportService.ConsumerCreate is not implemented"))
+}
+
+func (UnimplementedExportServiceHandler) ConsumerDelete(context.Context, *v1.ConsumerDeleteRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ExportService.ConsumerDelete is not implemented"))
+}
+
+func (UnimplementedExportServiceHandler) PublicKey(context.Context, *emptypb.Empty) (*v1.PublicKeyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ExportService.PublicKey is not implemented"))
+}
@@ -59,6 +59,12 @@ const (
 	ReportServiceReportMessageDeleteProcedure = "/ban.v1.ReportService/ReportMessageDelete"
 	// ReportServiceReportsProcedure is the fully-qualified name of the ReportService's Reports RPC.
 	ReportServiceReportsProcedure = "/ban.v1.ReportService/Reports"
+	// ReportServiceReportEvidenceProcedure is the fully-qualified name of the ReportService's
+	// ReportEvidence RPC.
+	ReportServiceReportEvidenceProcedure = "/ban.v1.ReportService/ReportEvidence"
+	// ReportServiceReportTriageProcedure is the fully-qualified name of the ReportService's
+	// ReportTriage RPC.
+	ReportServiceReportTriageProcedure = "/ban.v1.ReportService/ReportTriage"
 )
 
 // ReportServiceClient is a client for the ban.v1.ReportService service.
@@ -72,6 +78,10 @@ type ReportServiceClient interface {
 	ReportMessageEdit(context.Context, *v1.ReportMessageEditRequest) (*v1.ReportMessageEditResponse, error)
 	ReportMessageDelete(context.Context, *v1.ReportMessageDeleteRequest) (*emptypb.Empty, error)
 	Reports(context.Context, *emptypb.Empty) (*v1.ReportsResponse, error)
+	// ReportEvidence returns the evidence attached to a report when it was created.
+	ReportEvidence(context.Context, *v1.ReportEvidenceRequest) (*v1.ReportEvidenceResponse, error)
+	// ReportTriage returns the open reports clustered by their target, most severe first.
+	ReportTriage(context.Context, *emptypb.Empty) (*v1.ReportTriageResponse, error)
 }
 
 // NewReportServiceClient constructs a client for the ban.v1.ReportService service. By default, it
@@ -139,6 +149,18 @@ func NewReportServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(reportServiceMethods.ByName("Reports")),
 			connect.WithClientOptions(opts...),
 		),
+		reportEvidence: connect.NewClient[v1.ReportEvidenceRequest, v1.ReportEvidenceResponse](
+			httpClient,
+			baseURL+ReportServiceReportEvidenceProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("ReportEvidence")),
+			connect.WithClientOptions(opts...),
+		),
+		reportTriage: connect.NewClient[emptypb.Empty, v1.ReportTriageResponse](
+			httpClient,
+			baseURL+ReportServiceReportTriageProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("ReportTriage")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -153,6 +175,8 @@ type reportServiceClient struct {
 	reportMessageEdit   *connect.Client[v1.ReportMessageEditRequest, v1.ReportMessageEditResponse]
 	reportMessageDelete *connect.Client[v1.ReportMessageDeleteRequest, emptypb.Empty]
 	reports             *connect.Client[emptypb.Empty, v1.ReportsResponse]
+	reportEvidence      *connect.Client[v1.ReportEvidenceRequest, v1.ReportEvidenceResponse]
+	reportTriage        *connect.Client[emptypb.Empty, v1.ReportTriageResponse]
 }
 
 // ReportCreate calls ban.v1.ReportService.ReportCreate.
@@ -236,6 +260,24 @@ func (c *reportServiceClient) Reports(ctx context.Context, req *emptypb.Empty) (
 	return nil, err
 }
 
+// ReportEvidence calls ban.v1.ReportService.ReportEvidence.
+func (c *reportServiceClient) ReportEvidence(ctx context.Context, req *v1.ReportEvidenceRequest) (*v1.ReportEvidenceResponse, error) {
+	response, err := c.reportEvidence.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ReportTriage calls ban.v1.ReportService.ReportTriage.
+func (c *reportServiceClient) ReportTriage(ctx context.Context, req *emptypb.Empty) (*v1.ReportTriageResponse, error) {
+	response, err := c.reportTriage.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ReportServiceHandler is an implementation of the ban.v1.ReportService service.
 type ReportServiceHandler interface {
 	ReportCreate(context.Context, *v1.ReportCreateRequest) (*v1.ReportCreateResponse, error)
@@ -247,6 +289,10 @@ type ReportServiceHandler interface {
 	ReportMessageEdit(context.Context, *v1.ReportMessageEditRequest) (*v1.ReportMessageEditResponse, error)
 	ReportMessageDelete(context.Context, *v1.ReportMessageDeleteRequest) (*emptypb.Empty, error)
 	Reports(context.Context, *emptypb.Empty) (*v1.ReportsResponse, error)
+	// ReportEvidence returns the evidence attached to a report when it was created.
+	ReportEvidence(context.Context, *v1.ReportEvidenceRequest) (*v1.ReportEvidenceResponse, error)
+	// ReportTriage returns the open reports clustered by their target, most severe first.
+	ReportTriage(context.Context, *emptypb.Empty) (*v1.ReportTriageResponse, error)
 }
 
 // NewReportServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -310,6 +356,18 @@ func NewReportServiceHandler(svc ReportServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(reportServiceMethods.ByName("Reports")),
 		connect.WithHandlerOptions(opts...),
 	)
+	reportServiceReportEvidenceHandler := connect.NewUnaryHandlerSimple(
+		ReportServiceReportEvidenceProcedure,
+		svc.ReportEvidence,
+		connect.WithSchema(reportServiceMethods.ByName("ReportEvidence")),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceReportTriageHandler := connect.NewUnaryHandlerSimple(
+		ReportServiceReportTriageProcedure,
+		svc.ReportTriage,
+		connect.WithSchema(reportServiceMethods.ByName("ReportTriage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ban.v1.ReportService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ReportServiceReportCreateProcedure:
@@ -330,6 +388,10 @@ func NewReportServiceHandler(svc ReportServiceHandler, opts ...connect.HandlerOp
 			reportServiceReportMessageDeleteHandler.ServeHTTP(w, r)
 		case ReportServiceReportsProcedure:
 			reportServiceReportsHandler.ServeHTTP(w, r)
+		case ReportServiceReportEvidenceProcedure:
+			reportServiceReportEvidenceHandler.ServeHTTP(w, r)
+		case ReportServiceReportTriageProcedure:
+			reportServiceReportTriageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -374,3 +436,11 @@ func (UnimplementedReportServiceHandler) ReportMessageDelete(context.Context, *v
 func (UnimplementedReportServiceHandler) Reports(context.Context, *emptypb.Empty) (*v1.ReportsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ReportService.Reports is not implemented"))
 }
+
+func (UnimplementedReportServiceHandler) ReportEvidence(context.Context, *v1.ReportEvidenceRequest) (*v1.ReportEvidenceResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ReportService.ReportEvidence is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) ReportTriage(context.Context, *emptypb.Empty) (*v1.ReportTriageResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ban.v1.ReportService.ReportTriage is not implemented"))
+}
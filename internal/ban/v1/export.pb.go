@@ -7,8 +7,12 @@
 package banv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -401,11 +405,700 @@ func (x *FileInfo) GetUpdateUrl() string {
 	return ""
 }
 
+type Feed struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	FeedId *int32                 `protobuf:"varint,1,opt,name=feed_id,json=feedId" json:"feed_id,omitempty"`
+	// name is used in the feed url, /export/feeds/{name}/{format}.
+	Name        *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Title       *string `protobuf:"bytes,3,opt,name=title" json:"title,omitempty"`
+	Description *string `protobuf:"bytes,4,opt,name=description" json:"description,omitempty"`
+	// reasons limits the feed to these reasons, empty includes all.
+	Reasons []BanReason `protobuf:"varint,5,rep,packed,name=reasons,enum=ban.v1.BanReason" json:"reasons,omitempty"`
+	// ban_types limits the feed to these types, empty includes all.
+	BanTypes []BanType `protobuf:"varint,6,rep,packed,name=ban_types,json=banTypes,enum=ban.v1.BanType" json:"ban_types,omitempty"`
+	// min_duration excludes bans shorter than the duration. Permanent bans are always included.
+	MinDuration *durationpb.Duration `protobuf:"bytes,7,opt,name=min_duration,json=minDuration" json:"min_duration,omitempty"`
+	// max_age excludes bans created before now - max_age. Unset includes bans of any age.
+	MaxAge *durationpb.Duration `protobuf:"bytes,8,opt,name=max_age,json=maxAge" json:"max_age,omitempty"`
+	// public feeds do not require an api key.
+	Public        *bool                  `protobuf:"varint,9,opt,name=public" json:"public,omitempty"`
+	Enabled       *bool                  `protobuf:"varint,10,opt,name=enabled" json:"enabled,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	UpdatedOn     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_on,json=updatedOn" json:"updated_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feed) Reset() {
+	*x = Feed{}
+	mi := &file_ban_v1_export_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed) ProtoMessage() {}
+
+func (x *Feed) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
+func (*Feed) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Feed) GetFeedId() int32 {
+	if x != nil && x.FeedId != nil {
+		return *x.FeedId
+	}
+	return 0
+}
+
+func (x *Feed) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Feed) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Feed) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Feed) GetReasons() []BanReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *Feed) GetBanTypes() []BanType {
+	if x != nil {
+		return x.BanTypes
+	}
+	return nil
+}
+
+func (x *Feed) GetMinDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MinDuration
+	}
+	return nil
+}
+
+func (x *Feed) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *Feed) GetPublic() bool {
+	if x != nil && x.Public != nil {
+		return *x.Public
+	}
+	return false
+}
+
+func (x *Feed) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *Feed) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *Feed) GetUpdatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedOn
+	}
+	return nil
+}
+
+type FeedsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Feeds []*Feed                `protobuf:"bytes,1,rep,name=feeds" json:"feeds,omitempty"`
+	// formats lists the format names supported by every feed.
+	Formats       []string `protobuf:"bytes,2,rep,name=formats" json:"formats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedsResponse) Reset() {
+	*x = FeedsResponse{}
+	mi := &file_ban_v1_export_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedsResponse) ProtoMessage() {}
+
+func (x *FeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedsResponse.ProtoReflect.Descriptor instead.
+func (*FeedsResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FeedsResponse) GetFeeds() []*Feed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+func (x *FeedsResponse) GetFormats() []string {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+type FeedSaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feed          *Feed                  `protobuf:"bytes,1,opt,name=feed" json:"feed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedSaveRequest) Reset() {
+	*x = FeedSaveRequest{}
+	mi := &file_ban_v1_export_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedSaveRequest) ProtoMessage() {}
+
+func (x *FeedSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedSaveRequest.ProtoReflect.Descriptor instead.
+func (*FeedSaveRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FeedSaveRequest) GetFeed() *Feed {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
+type FeedSaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feed          *Feed                  `protobuf:"bytes,1,opt,name=feed" json:"feed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedSaveResponse) Reset() {
+	*x = FeedSaveResponse{}
+	mi := &file_ban_v1_export_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedSaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedSaveResponse) ProtoMessage() {}
+
+func (x *FeedSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedSaveResponse.ProtoReflect.Descriptor instead.
+func (*FeedSaveResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FeedSaveResponse) GetFeed() *Feed {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
+type FeedDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedId        *int32                 `protobuf:"varint,1,opt,name=feed_id,json=feedId" json:"feed_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedDeleteRequest) Reset() {
+	*x = FeedDeleteRequest{}
+	mi := &file_ban_v1_export_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedDeleteRequest) ProtoMessage() {}
+
+func (x *FeedDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedDeleteRequest.ProtoReflect.Descriptor instead.
+func (*FeedDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FeedDeleteRequest) GetFeedId() int32 {
+	if x != nil && x.FeedId != nil {
+		return *x.FeedId
+	}
+	return 0
+}
+
+type Consumer struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerId *int32                 `protobuf:"varint,1,opt,name=consumer_id,json=consumerId" json:"consumer_id,omitempty"`
+	FeedId     *int32                 `protobuf:"varint,2,opt,name=feed_id,json=feedId" json:"feed_id,omitempty"`
+	Name       *string                `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Enabled    *bool                  `protobuf:"varint,4,opt,name=enabled" json:"enabled,omitempty"`
+	CreatedOn  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	// last_used_on is unset when the key has never been used.
+	LastUsedOn    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_on,json=lastUsedOn" json:"last_used_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Consumer) Reset() {
+	*x = Consumer{}
+	mi := &file_ban_v1_export_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Consumer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Consumer) ProtoMessage() {}
+
+func (x *Consumer) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Consumer.ProtoReflect.Descriptor instead.
+func (*Consumer) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Consumer) GetConsumerId() int32 {
+	if x != nil && x.ConsumerId != nil {
+		return *x.ConsumerId
+	}
+	return 0
+}
+
+func (x *Consumer) GetFeedId() int32 {
+	if x != nil && x.FeedId != nil {
+		return *x.FeedId
+	}
+	return 0
+}
+
+func (x *Consumer) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Consumer) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *Consumer) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *Consumer) GetLastUsedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedOn
+	}
+	return nil
+}
+
+type ConsumersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedId        *int32                 `protobuf:"varint,1,opt,name=feed_id,json=feedId" json:"feed_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumersRequest) Reset() {
+	*x = ConsumersRequest{}
+	mi := &file_ban_v1_export_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumersRequest) ProtoMessage() {}
+
+func (x *ConsumersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumersRequest.ProtoReflect.Descriptor instead.
+func (*ConsumersRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConsumersRequest) GetFeedId() int32 {
+	if x != nil && x.FeedId != nil {
+		return *x.FeedId
+	}
+	return 0
+}
+
+type ConsumersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consumers     []*Consumer            `protobuf:"bytes,1,rep,name=consumers" json:"consumers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumersResponse) Reset() {
+	*x = ConsumersResponse{}
+	mi := &file_ban_v1_export_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumersResponse) ProtoMessage() {}
+
+func (x *ConsumersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumersResponse.ProtoReflect.Descriptor instead.
+func (*ConsumersResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConsumersResponse) GetConsumers() []*Consumer {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+type ConsumerCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedId        *int32                 `protobuf:"varint,1,opt,name=feed_id,json=feedId" json:"feed_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerCreateRequest) Reset() {
+	*x = ConsumerCreateRequest{}
+	mi := &file_ban_v1_export_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerCreateRequest) ProtoMessage() {}
+
+func (x *ConsumerCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerCreateRequest.ProtoReflect.Descriptor instead.
+func (*ConsumerCreateRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ConsumerCreateRequest) GetFeedId() int32 {
+	if x != nil && x.FeedId != nil {
+		return *x.FeedId
+	}
+	return 0
+}
+
+func (x *ConsumerCreateRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type ConsumerCreateResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Consumer *Consumer              `protobuf:"bytes,1,opt,name=consumer" json:"consumer,omitempty"`
+	// api_key is sent by the consumer as the X-API-Key header or key query parameter.
+	ApiKey        *string `protobuf:"bytes,2,opt,name=api_key,json=apiKey" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerCreateResponse) Reset() {
+	*x = ConsumerCreateResponse{}
+	mi := &file_ban_v1_export_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerCreateResponse) ProtoMessage() {}
+
+func (x *ConsumerCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerCreateResponse.ProtoReflect.Descriptor instead.
+func (*ConsumerCreateResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConsumerCreateResponse) GetConsumer() *Consumer {
+	if x != nil {
+		return x.Consumer
+	}
+	return nil
+}
+
+func (x *ConsumerCreateResponse) GetApiKey() string {
+	if x != nil && x.ApiKey != nil {
+		return *x.ApiKey
+	}
+	return ""
+}
+
+type ConsumerDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConsumerId    *int32                 `protobuf:"varint,1,opt,name=consumer_id,json=consumerId" json:"consumer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumerDeleteRequest) Reset() {
+	*x = ConsumerDeleteRequest{}
+	mi := &file_ban_v1_export_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumerDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerDeleteRequest) ProtoMessage() {}
+
+func (x *ConsumerDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ConsumerDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ConsumerDeleteRequest) GetConsumerId() int32 {
+	if x != nil && x.ConsumerId != nil {
+		return *x.ConsumerId
+	}
+	return 0
+}
+
+type PublicKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicKey     *string                `protobuf:"bytes,1,opt,name=public_key,json=publicKey" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicKeyResponse) Reset() {
+	*x = PublicKeyResponse{}
+	mi := &file_ban_v1_export_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicKeyResponse) ProtoMessage() {}
+
+func (x *PublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_export_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*PublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_export_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PublicKeyResponse) GetPublicKey() string {
+	if x != nil && x.PublicKey != nil {
+		return *x.PublicKey
+	}
+	return ""
+}
+
 var File_ban_v1_export_proto protoreflect.FileDescriptor
 
 const file_ban_v1_export_proto_rawDesc = "" +
 	"\n" +
-	"\x13ban/v1/export.proto\x12\x06ban.v1\"6\n" +
+	"\x13ban/v1/export.proto\x12\x06ban.v1\x1a\x10ban/v1/ban.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"6\n" +
 	"\x17GetValveSteamIDResponse\x12\x1b\n" +
 	"\tban_lines\x18\x01 \x03(\tR\bbanLines\"*\n" +
 	"\x16GetValveSteamIDRequest\x12\x10\n" +
@@ -432,10 +1125,70 @@ const file_ban_v1_export_proto_rawDesc = "" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1e\n" +
 	"\n" +
 	"update_url\x18\x04 \x01(\tR\n" +
-	"update_url2\xa6\x01\n" +
+	"update_url\"\xb5\x04\n" +
+	"\x04Feed\x12\x17\n" +
+	"\afeed_id\x18\x01 \x01(\x05R\x06feedId\x12+\n" +
+	"\x04name\x18\x02 \x01(\tB\x17\xbaH\x14\xc8\x01\x01r\x0f2\r^[a-z0-9_-]+$R\x04name\x12\x1c\n" +
+	"\x05title\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12:\n" +
+	"\areasons\x18\x05 \x03(\x0e2\x11.ban.v1.BanReasonB\r\xbaH\n" +
+	"\x92\x01\a\"\x05\x82\x01\x02\x10\x01R\areasons\x12=\n" +
+	"\tban_types\x18\x06 \x03(\x0e2\x0f.ban.v1.BanTypeB\x0f\xbaH\f\x92\x01\t\"\a\x82\x01\x04\x18\x01\x18\x02R\bbanTypes\x12F\n" +
+	"\fmin_duration\x18\a \x01(\v2\x19.google.protobuf.DurationB\b\xbaH\x05\xaa\x01\x022\x00R\vminDuration\x12<\n" +
+	"\amax_age\x18\b \x01(\v2\x19.google.protobuf.DurationB\b\xbaH\x05\xaa\x01\x022\x00R\x06maxAge\x12\x16\n" +
+	"\x06public\x18\t \x01(\bR\x06public\x12\x18\n" +
+	"\aenabled\x18\n" +
+	" \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_on\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x129\n" +
+	"\n" +
+	"updated_on\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedOn\"M\n" +
+	"\rFeedsResponse\x12\"\n" +
+	"\x05feeds\x18\x01 \x03(\v2\f.ban.v1.FeedR\x05feeds\x12\x18\n" +
+	"\aformats\x18\x02 \x03(\tR\aformats\";\n" +
+	"\x0fFeedSaveRequest\x12(\n" +
+	"\x04feed\x18\x01 \x01(\v2\f.ban.v1.FeedB\x06\xbaH\x03\xc8\x01\x01R\x04feed\"4\n" +
+	"\x10FeedSaveResponse\x12 \n" +
+	"\x04feed\x18\x01 \x01(\v2\f.ban.v1.FeedR\x04feed\"5\n" +
+	"\x11FeedDeleteRequest\x12 \n" +
+	"\afeed_id\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x06feedId\"\xeb\x01\n" +
+	"\bConsumer\x12\x1f\n" +
+	"\vconsumer_id\x18\x01 \x01(\x05R\n" +
+	"consumerId\x12\x17\n" +
+	"\afeed_id\x18\x02 \x01(\x05R\x06feedId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x12<\n" +
+	"\flast_used_on\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedOn\"4\n" +
+	"\x10ConsumersRequest\x12 \n" +
+	"\afeed_id\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x06feedId\"C\n" +
+	"\x11ConsumersResponse\x12.\n" +
+	"\tconsumers\x18\x01 \x03(\v2\x10.ban.v1.ConsumerR\tconsumers\"V\n" +
+	"\x15ConsumerCreateRequest\x12 \n" +
+	"\afeed_id\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x06feedId\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\"_\n" +
+	"\x16ConsumerCreateResponse\x12,\n" +
+	"\bconsumer\x18\x01 \x01(\v2\x10.ban.v1.ConsumerR\bconsumer\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"A\n" +
+	"\x15ConsumerDeleteRequest\x12(\n" +
+	"\vconsumer_id\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\n" +
+	"consumerId\"2\n" +
+	"\x11PublicKeyResponse\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey2\x88\x05\n" +
 	"\rExportService\x12?\n" +
 	"\bGetTF2BD\x12\x17.ban.v1.GetTF2BDRequest\x1a\x18.ban.v1.GetTF2BDResponse\"\x00\x12T\n" +
-	"\x0fGetValveSteamID\x12\x1e.ban.v1.GetValveSteamIDRequest\x1a\x1f.ban.v1.GetValveSteamIDResponse\"\x00B\x89\x01\n" +
+	"\x0fGetValveSteamID\x12\x1e.ban.v1.GetValveSteamIDRequest\x1a\x1f.ban.v1.GetValveSteamIDResponse\"\x00\x128\n" +
+	"\x05Feeds\x12\x16.google.protobuf.Empty\x1a\x15.ban.v1.FeedsResponse\"\x00\x12?\n" +
+	"\bFeedSave\x12\x17.ban.v1.FeedSaveRequest\x1a\x18.ban.v1.FeedSaveResponse\"\x00\x12A\n" +
+	"\n" +
+	"FeedDelete\x12\x19.ban.v1.FeedDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12B\n" +
+	"\tConsumers\x12\x18.ban.v1.ConsumersRequest\x1a\x19.ban.v1.ConsumersResponse\"\x00\x12Q\n" +
+	"\x0eConsumerCreate\x12\x1d.ban.v1.ConsumerCreateRequest\x1a\x1e.ban.v1.ConsumerCreateResponse\"\x00\x12I\n" +
+	"\x0eConsumerDelete\x12\x1d.ban.v1.ConsumerDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12@\n" +
+	"\tPublicKey\x12\x16.google.protobuf.Empty\x1a\x19.ban.v1.PublicKeyResponse\"\x00B\x89\x01\n" +
 	"\n" +
 	"com.ban.v1B\vExportProtoP\x01Z5github.com/leighmacdonald/gbans/internal/ban/v1;banv1\xa2\x02\x03BXX\xaa\x02\x06Ban.V1\xca\x02\x06Ban\\V1\xe2\x02\x12Ban\\V1\\GPBMetadata\xea\x02\aBan::V1b\beditionsp\xe8\a"
 
@@ -451,7 +1204,7 @@ func file_ban_v1_export_proto_rawDescGZIP() []byte {
 	return file_ban_v1_export_proto_rawDescData
 }
 
-var file_ban_v1_export_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_ban_v1_export_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_ban_v1_export_proto_goTypes = []any{
 	(*GetValveSteamIDResponse)(nil), // 0: ban.v1.GetValveSteamIDResponse
 	(*GetValveSteamIDRequest)(nil),  // 1: ban.v1.GetValveSteamIDRequest
@@ -460,20 +1213,64 @@ var file_ban_v1_export_proto_goTypes = []any{
 	(*Player)(nil),                  // 4: ban.v1.Player
 	(*LastSeen)(nil),                // 5: ban.v1.LastSeen
 	(*FileInfo)(nil),                // 6: ban.v1.FileInfo
+	(*Feed)(nil),                    // 7: ban.v1.Feed
+	(*FeedsResponse)(nil),           // 8: ban.v1.FeedsResponse
+	(*FeedSaveRequest)(nil),         // 9: ban.v1.FeedSaveRequest
+	(*FeedSaveResponse)(nil),        // 10: ban.v1.FeedSaveResponse
+	(*FeedDeleteRequest)(nil),       // 11: ban.v1.FeedDeleteRequest
+	(*Consumer)(nil),                // 12: ban.v1.Consumer
+	(*ConsumersRequest)(nil),        // 13: ban.v1.ConsumersRequest
+	(*ConsumersResponse)(nil),       // 14: ban.v1.ConsumersResponse
+	(*ConsumerCreateRequest)(nil),   // 15: ban.v1.ConsumerCreateRequest
+	(*ConsumerCreateResponse)(nil),  // 16: ban.v1.ConsumerCreateResponse
+	(*ConsumerDeleteRequest)(nil),   // 17: ban.v1.ConsumerDeleteRequest
+	(*PublicKeyResponse)(nil),       // 18: ban.v1.PublicKeyResponse
+	(BanReason)(0),                  // 19: ban.v1.BanReason
+	(BanType)(0),                    // 20: ban.v1.BanType
+	(*durationpb.Duration)(nil),     // 21: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),   // 22: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 23: google.protobuf.Empty
 }
 var file_ban_v1_export_proto_depIdxs = []int32{
-	6, // 0: ban.v1.GetTF2BDResponse.file_info:type_name -> ban.v1.FileInfo
-	4, // 1: ban.v1.GetTF2BDResponse.players:type_name -> ban.v1.Player
-	5, // 2: ban.v1.Player.last_seen:type_name -> ban.v1.LastSeen
-	2, // 3: ban.v1.ExportService.GetTF2BD:input_type -> ban.v1.GetTF2BDRequest
-	1, // 4: ban.v1.ExportService.GetValveSteamID:input_type -> ban.v1.GetValveSteamIDRequest
-	3, // 5: ban.v1.ExportService.GetTF2BD:output_type -> ban.v1.GetTF2BDResponse
-	0, // 6: ban.v1.ExportService.GetValveSteamID:output_type -> ban.v1.GetValveSteamIDResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	6,  // 0: ban.v1.GetTF2BDResponse.file_info:type_name -> ban.v1.FileInfo
+	4,  // 1: ban.v1.GetTF2BDResponse.players:type_name -> ban.v1.Player
+	5,  // 2: ban.v1.Player.last_seen:type_name -> ban.v1.LastSeen
+	19, // 3: ban.v1.Feed.reasons:type_name -> ban.v1.BanReason
+	20, // 4: ban.v1.Feed.ban_types:type_name -> ban.v1.BanType
+	21, // 5: ban.v1.Feed.min_duration:type_name -> google.protobuf.Duration
+	21, // 6: ban.v1.Feed.max_age:type_name -> google.protobuf.Duration
+	22, // 7: ban.v1.Feed.created_on:type_name -> google.protobuf.Timestamp
+	22, // 8: ban.v1.Feed.updated_on:type_name -> google.protobuf.Timestamp
+	7,  // 9: ban.v1.FeedsResponse.feeds:type_name -> ban.v1.Feed
+	7,  // 10: ban.v1.FeedSaveRequest.feed:type_name -> ban.v1.Feed
+	7,  // 11: ban.v1.FeedSaveResponse.feed:type_name -> ban.v1.Feed
+	22, // 12: ban.v1.Consumer.created_on:type_name -> google.protobuf.Timestamp
+	22, // 13: ban.v1.Consumer.last_used_on:type_name -> google.protobuf.Timestamp
+	12, // 14: ban.v1.ConsumersResponse.consumers:type_name -> ban.v1.Consumer
+	12, // 15: ban.v1.ConsumerCreateResponse.consumer:type_name -> ban.v1.Consumer
+	2,  // 16: ban.v1.ExportService.GetTF2BD:input_type -> ban.v1.GetTF2BDRequest
+	1,  // 17: ban.v1.ExportService.GetValveSteamID:input_type -> ban.v1.GetValveSteamIDRequest
+	23, // 18: ban.v1.ExportService.Feeds:input_type -> google.protobuf.Empty
+	9,  // 19: ban.v1.ExportService.FeedSave:input_type -> ban.v1.FeedSaveRequest
+	11, // 20: ban.v1.ExportService.FeedDelete:input_type -> ban.v1.FeedDeleteRequest
+	13, // 21: ban.v1.ExportService.Consumers:input_type -> ban.v1.ConsumersRequest
+	15, // 22: ban.v1.ExportService.ConsumerCreate:input_type -> ban.v1.ConsumerCreateRequest
+	17, // 23: ban.v1.ExportService.ConsumerDelete:input_type -> ban.v1.ConsumerDeleteRequest
+	23, // 24: ban.v1.ExportService.PublicKey:input_type -> google.protobuf.Empty
+	3,  // 25: ban.v1.ExportService.GetTF2BD:output_type -> ban.v1.GetTF2BDResponse
+	0,  // 26: ban.v1.ExportService.GetValveSteamID:output_type -> ban.v1.GetValveSteamIDResponse
+	8,  // 27: ban.v1.ExportService.Feeds:output_type -> ban.v1.FeedsResponse
+	10, // 28: ban.v1.ExportService.FeedSave:output_type -> ban.v1.FeedSaveResponse
+	23, // 29: ban.v1.ExportService.FeedDelete:output_type -> google.protobuf.Empty
+	14, // 30: ban.v1.ExportService.Consumers:output_type -> ban.v1.ConsumersResponse
+	16, // 31: ban.v1.ExportService.ConsumerCreate:output_type -> ban.v1.ConsumerCreateResponse
+	23, // 32: ban.v1.ExportService.ConsumerDelete:output_type -> google.protobuf.Empty
+	18, // 33: ban.v1.ExportService.PublicKey:output_type -> ban.v1.PublicKeyResponse
+	25, // [25:34] is the sub-list for method output_type
+	16, // [16:25] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_ban_v1_export_proto_init() }
@@ -481,13 +1278,14 @@ func file_ban_v1_export_proto_init() {
 	if File_ban_v1_export_proto != nil {
 		return
 	}
+	file_ban_v1_ban_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ban_v1_export_proto_rawDesc), len(file_ban_v1_export_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EvidenceKind int32
+
+const (
+	EvidenceKind_EVIDENCE_KIND_UNSPECIFIED EvidenceKind = 0
+	EvidenceKind_EVIDENCE_KIND_CHAT        EvidenceKind = 1
+	EvidenceKind_EVIDENCE_KIND_DETECTION   EvidenceKind = 2
+	EvidenceKind_EVIDENCE_KIND_BAN         EvidenceKind = 3
+	EvidenceKind_EVIDENCE_KIND_DEMO        EvidenceKind = 4
+)
+
+// Enum value maps for EvidenceKind.
+var (
+	EvidenceKind_name = map[int32]string{
+		0: "EVIDENCE_KIND_UNSPECIFIED",
+		1: "EVIDENCE_KIND_CHAT",
+		2: "EVIDENCE_KIND_DETECTION",
+		3: "EVIDENCE_KIND_BAN",
+		4: "EVIDENCE_KIND_DEMO",
+	}
+	EvidenceKind_value = map[string]int32{
+		"EVIDENCE_KIND_UNSPECIFIED": 0,
+		"EVIDENCE_KIND_CHAT":        1,
+		"EVIDENCE_KIND_DETECTION":   2,
+		"EVIDENCE_KIND_BAN":         3,
+		"EVIDENCE_KIND_DEMO":        4,
+	}
+)
+
+func (x EvidenceKind) Enum() *EvidenceKind {
+	p := new(EvidenceKind)
+	*p = x
+	return p
+}
+
+func (x EvidenceKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EvidenceKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_ban_v1_report_proto_enumTypes[0].Descriptor()
+}
+
+func (EvidenceKind) Type() protoreflect.EnumType {
+	return &file_ban_v1_report_proto_enumTypes[0]
+}
+
+func (x EvidenceKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EvidenceKind.Descriptor instead.
+func (EvidenceKind) EnumDescriptor() ([]byte, []int) {
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{0}
+}
+
 type ReportStatus int32
 
 const (
@@ -61,11 +116,11 @@ func (x ReportStatus) String() string {
 }
 
 func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_ban_v1_report_proto_enumTypes[0].Descriptor()
+	return file_ban_v1_report_proto_enumTypes[1].Descriptor()
 }
 
 func (ReportStatus) Type() protoreflect.EnumType {
-	return &file_ban_v1_report_proto_enumTypes[0]
+	return &file_ban_v1_report_proto_enumTypes[1]
 }
 
 func (x ReportStatus) Number() protoreflect.EnumNumber {
@@ -74,9 +129,329 @@ func (x ReportStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReportStatus.Descriptor instead.
 func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{1}
+}
+
+type ReportEvidence struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReportEvidenceId *int64                 `protobuf:"varint,1,opt,name=report_evidence_id,json=reportEvidenceId" json:"report_evidence_id,omitempty"`
+	ReportId         *int32                 `protobuf:"varint,2,opt,name=report_id,json=reportId" json:"report_id,omitempty"`
+	Kind             *EvidenceKind          `protobuf:"varint,3,opt,name=kind,enum=ban.v1.EvidenceKind" json:"kind,omitempty"`
+	// RefId is the id of the source record, eg: the person_message_id of chat evidence.
+	RefId    *int64 `protobuf:"varint,4,opt,name=ref_id,json=refId" json:"ref_id,omitempty"`
+	SteamId  *int64 `protobuf:"varint,5,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	ServerId *int32 `protobuf:"varint,6,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	// Summary is a snapshot of the source record at the time it was attached.
+	Summary *string `protobuf:"bytes,7,opt,name=summary" json:"summary,omitempty"`
+	// Flagged is set for chat messages matched by a word filter and for bans that are still active.
+	Flagged       *bool                  `protobuf:"varint,8,opt,name=flagged" json:"flagged,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportEvidence) Reset() {
+	*x = ReportEvidence{}
+	mi := &file_ban_v1_report_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportEvidence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportEvidence) ProtoMessage() {}
+
+func (x *ReportEvidence) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_report_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportEvidence.ProtoReflect.Descriptor instead.
+func (*ReportEvidence) Descriptor() ([]byte, []int) {
 	return file_ban_v1_report_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *ReportEvidence) GetReportEvidenceId() int64 {
+	if x != nil && x.ReportEvidenceId != nil {
+		return *x.ReportEvidenceId
+	}
+	return 0
+}
+
+func (x *ReportEvidence) GetReportId() int32 {
+	if x != nil && x.ReportId != nil {
+		return *x.ReportId
+	}
+	return 0
+}
+
+func (x *ReportEvidence) GetKind() EvidenceKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return EvidenceKind_EVIDENCE_KIND_UNSPECIFIED
+}
+
+func (x *ReportEvidence) GetRefId() int64 {
+	if x != nil && x.RefId != nil {
+		return *x.RefId
+	}
+	return 0
+}
+
+func (x *ReportEvidence) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+func (x *ReportEvidence) GetServerId() int32 {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return 0
+}
+
+func (x *ReportEvidence) GetSummary() string {
+	if x != nil && x.Summary != nil {
+		return *x.Summary
+	}
+	return ""
+}
+
+func (x *ReportEvidence) GetFlagged() bool {
+	if x != nil && x.Flagged != nil {
+		return *x.Flagged
+	}
+	return false
+}
+
+func (x *ReportEvidence) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+type ReportEvidenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      *int32                 `protobuf:"varint,1,opt,name=report_id,json=reportId" json:"report_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportEvidenceRequest) Reset() {
+	*x = ReportEvidenceRequest{}
+	mi := &file_ban_v1_report_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportEvidenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportEvidenceRequest) ProtoMessage() {}
+
+func (x *ReportEvidenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_report_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportEvidenceRequest.ProtoReflect.Descriptor instead.
+func (*ReportEvidenceRequest) Descriptor() ([]byte, []int) {
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReportEvidenceRequest) GetReportId() int32 {
+	if x != nil && x.ReportId != nil {
+		return *x.ReportId
+	}
+	return 0
+}
+
+type ReportEvidenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Evidence      []*ReportEvidence      `protobuf:"bytes,1,rep,name=evidence" json:"evidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportEvidenceResponse) Reset() {
+	*x = ReportEvidenceResponse{}
+	mi := &file_ban_v1_report_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportEvidenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportEvidenceResponse) ProtoMessage() {}
+
+func (x *ReportEvidenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_report_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportEvidenceResponse.ProtoReflect.Descriptor instead.
+func (*ReportEvidenceResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReportEvidenceResponse) GetEvidence() []*ReportEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+type ReportCluster struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Subject *v1.PersonCore         `protobuf:"bytes,1,opt,name=subject" json:"subject,omitempty"`
+	Reports []*ReportWithAuthor    `protobuf:"bytes,2,rep,name=reports" json:"reports,omitempty"`
+	// Evidence is the combined evidence of all reports in the cluster, without duplicates.
+	Evidence      []*ReportEvidence      `protobuf:"bytes,3,rep,name=evidence" json:"evidence,omitempty"`
+	Severity      *float64               `protobuf:"fixed64,4,opt,name=severity" json:"severity,omitempty"`
+	LastReported  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_reported,json=lastReported" json:"last_reported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCluster) Reset() {
+	*x = ReportCluster{}
+	mi := &file_ban_v1_report_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCluster) ProtoMessage() {}
+
+func (x *ReportCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_report_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCluster.ProtoReflect.Descriptor instead.
+func (*ReportCluster) Descriptor() ([]byte, []int) {
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReportCluster) GetSubject() *v1.PersonCore {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *ReportCluster) GetReports() []*ReportWithAuthor {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *ReportCluster) GetEvidence() []*ReportEvidence {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *ReportCluster) GetSeverity() float64 {
+	if x != nil && x.Severity != nil {
+		return *x.Severity
+	}
+	return 0
+}
+
+func (x *ReportCluster) GetLastReported() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReported
+	}
+	return nil
+}
+
+type ReportTriageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*ReportCluster       `protobuf:"bytes,1,rep,name=clusters" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportTriageResponse) Reset() {
+	*x = ReportTriageResponse{}
+	mi := &file_ban_v1_report_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportTriageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportTriageResponse) ProtoMessage() {}
+
+func (x *ReportTriageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ban_v1_report_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportTriageResponse.ProtoReflect.Descriptor instead.
+func (*ReportTriageResponse) Descriptor() ([]byte, []int) {
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReportTriageResponse) GetClusters() []*ReportCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
 type ReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reports       []*ReportWithAuthor    `protobuf:"bytes,1,rep,name=reports" json:"reports,omitempty"`
@@ -86,7 +461,7 @@ type ReportsResponse struct {
 
 func (x *ReportsResponse) Reset() {
 	*x = ReportsResponse{}
-	mi := &file_ban_v1_report_proto_msgTypes[0]
+	mi := &file_ban_v1_report_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -98,7 +473,7 @@ func (x *ReportsResponse) String() string {
 func (*ReportsResponse) ProtoMessage() {}
 
 func (x *ReportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[0]
+	mi := &file_ban_v1_report_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -111,7 +486,7 @@ func (x *ReportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportsResponse.ProtoReflect.Descriptor instead.
 func (*ReportsResponse) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{0}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReportsResponse) GetReports() []*ReportWithAuthor {
@@ -130,7 +505,7 @@ type ReportMessageDeleteRequest struct {
 
 func (x *ReportMessageDeleteRequest) Reset() {
 	*x = ReportMessageDeleteRequest{}
-	mi := &file_ban_v1_report_proto_msgTypes[1]
+	mi := &file_ban_v1_report_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -142,7 +517,7 @@ func (x *ReportMessageDeleteRequest) String() string {
 func (*ReportMessageDeleteRequest) ProtoMessage() {}
 
 func (x *ReportMessageDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[1]
+	mi := &file_ban_v1_report_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +530,7 @@ func (x *ReportMessageDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportMessageDeleteRequest.ProtoReflect.Descriptor instead.
 func (*ReportMessageDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{1}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ReportMessageDeleteRequest) GetReportMessageId() int32 {
@@ -175,7 +550,7 @@ type ReportMessageEditRequest struct {
 
 func (x *ReportMessageEditRequest) Reset() {
 	*x = ReportMessageEditRequest{}
-	mi := &file_ban_v1_report_proto_msgTypes[2]
+	mi := &file_ban_v1_report_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -187,7 +562,7 @@ func (x *ReportMessageEditRequest) String() string {
 func (*ReportMessageEditRequest) ProtoMessage() {}
 
 func (x *ReportMessageEditRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[2]
+	mi := &file_ban_v1_report_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +575,7 @@ func (x *ReportMessageEditRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportMessageEditRequest.ProtoReflect.Descriptor instead.
 func (*ReportMessageEditRequest) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{2}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ReportMessageEditRequest) GetReportMessageId() int32 {
@@ -226,7 +601,7 @@ type ReportMessageEditResponse struct {
 
 func (x *ReportMessageEditResponse) Reset() {
 	*x = ReportMessageEditResponse{}
-	mi := &file_ban_v1_report_proto_msgTypes[3]
+	mi := &file_ban_v1_report_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -238,7 +613,7 @@ func (x *ReportMessageEditResponse) String() string {
 func (*ReportMessageEditResponse) ProtoMessage() {}
 
 func (x *ReportMessageEditResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[3]
+	mi := &file_ban_v1_report_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,7 +626,7 @@ func (x *ReportMessageEditResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportMessageEditResponse.ProtoReflect.Descriptor instead.
 func (*ReportMessageEditResponse) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{3}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ReportMessageEditResponse) GetMessage() *ReportMessage {
@@ -270,7 +645,7 @@ type ReportMessagesRequest struct {
 
 func (x *ReportMessagesRequest) Reset() {
 	*x = ReportMessagesRequest{}
-	mi := &file_ban_v1_report_proto_msgTypes[4]
+	mi := &file_ban_v1_report_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -282,7 +657,7 @@ func (x *ReportMessagesRequest) String() string {
 func (*ReportMessagesRequest) ProtoMessage() {}
 
 func (x *ReportMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[4]
+	mi := &file_ban_v1_report_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,7 +670,7 @@ func (x *ReportMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportMessagesRequest.ProtoReflect.Descriptor instead.
 func (*ReportMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{4}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ReportMessagesRequest) GetReportId() int32 {
@@ -314,7 +689,7 @@ type UserReportsRequest struct {
 
 func (x *UserReportsRequest) Reset() {
 	*x = UserReportsRequest{}
-	mi := &file_ban_v1_report_proto_msgTypes[5]
+	mi := &file_ban_v1_report_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -326,7 +701,7 @@ func (x *UserReportsRequest) String() string {
 func (*UserReportsRequest) ProtoMessage() {}
 
 func (x *UserReportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[5]
+	mi := &file_ban_v1_report_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +714,7 @@ func (x *UserReportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserReportsRequest.ProtoReflect.Descriptor instead.
 func (*UserReportsRequest) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{5}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UserReportsRequest) GetSteamId() int64 {
@@ -358,7 +733,7 @@ type ReportMessagesResponse struct {
 
 func (x *ReportMessagesResponse) Reset() {
 	*x = ReportMessagesResponse{}
-	mi := &file_ban_v1_report_proto_msgTypes[6]
+	mi := &file_ban_v1_report_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -370,7 +745,7 @@ func (x *ReportMessagesResponse) String() string {
 func (*ReportMessagesResponse) ProtoMessage() {}
 
 func (x *ReportMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[6]
+	mi := &file_ban_v1_report_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +758,7 @@ func (x *ReportMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportMessagesResponse.ProtoReflect.Descriptor instead.
 func (*ReportMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{6}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReportMessagesResponse) GetMessages() []*ReportMessage {
@@ -402,7 +777,7 @@ type UserReportsResponse struct {
 
 func (x *UserReportsResponse) Reset() {
 	*x = UserReportsResponse{}
-	mi := &file_ban_v1_report_proto_msgTypes[7]
+	mi := &file_ban_v1_report_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -414,7 +789,7 @@ func (x *UserReportsResponse) String() string {
 func (*UserReportsResponse) ProtoMessage() {}
 
 func (x *UserReportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[7]
+	mi := &file_ban_v1_report_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +802,7 @@ func (x *UserReportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserReportsResponse.ProtoReflect.Descriptor instead.
 func (*UserReportsResponse) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{7}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UserReportsResponse) GetReports() []*ReportWithAuthor {
@@ -446,7 +821,7 @@ type ReportRequest struct {
 
 func (x *ReportRequest) Reset() {
 	*x = ReportRequest{}
-	mi := &file_ban_v1_report_proto_msgTypes[8]
+	mi := &file_ban_v1_report_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +833,7 @@ func (x *ReportRequest) String() string {
 func (*ReportRequest) ProtoMessage() {}
 
 func (x *ReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[8]
+	mi := &file_ban_v1_report_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +846,7 @@ func (x *ReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportRequest.ProtoReflect.Descriptor instead.
 func (*ReportRequest) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{8}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ReportRequest) GetReportId() int32 {
@@ -490,7 +865,7 @@ type ReportResponse struct {
 
 func (x *ReportResponse) Reset() {
 	*x = ReportResponse{}
-	mi := &file_ban_v1_report_proto_msgTypes[9]
+	mi := &file_ban_v1_report_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +877,7 @@ func (x *ReportResponse) String() string {
 func (*ReportResponse) ProtoMessage() {}
 
 func (x *ReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[9]
+	mi := &file_ban_v1_report_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +890,7 @@ func (x *ReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportResponse.ProtoReflect.Descriptor instead.
 func (*ReportResponse) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{9}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ReportResponse) GetReport() *ReportWithAuthor {
@@ -543,7 +918,7 @@ type ReportMessage struct {
 
 func (x *ReportMessage) Reset() {
 	*x = ReportMessage{}
-	mi := &file_ban_v1_report_proto_msgTypes[10]
+	mi := &file_ban_v1_report_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +930,7 @@ func (x *ReportMessage) String() string {
 func (*ReportMessage) ProtoMessage() {}
 
 func (x *ReportMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[10]
+	mi := &file_ban_v1_report_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +943,7 @@ func (x *ReportMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportMessage.ProtoReflect.Descriptor instead.
 func (*ReportMessage) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{10}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ReportMessage) GetReportId() int32 {
@@ -662,7 +1037,7 @@ type Report struct {
 
 func (x *Report) Reset() {
 	*x = Report{}
-	mi := &file_ban_v1_report_proto_msgTypes[11]
+	mi := &file_ban_v1_report_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -674,7 +1049,7 @@ func (x *Report) String() string {
 func (*Report) ProtoMessage() {}
 
 func (x *Report) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[11]
+	mi := &file_ban_v1_report_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,7 +1062,7 @@ func (x *Report) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Report.ProtoReflect.Descriptor instead.
 func (*Report) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{11}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Report) GetReportId() int32 {
@@ -792,7 +1167,7 @@ type ReportWithAuthor struct {
 
 func (x *ReportWithAuthor) Reset() {
 	*x = ReportWithAuthor{}
-	mi := &file_ban_v1_report_proto_msgTypes[12]
+	mi := &file_ban_v1_report_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -804,7 +1179,7 @@ func (x *ReportWithAuthor) String() string {
 func (*ReportWithAuthor) ProtoMessage() {}
 
 func (x *ReportWithAuthor) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[12]
+	mi := &file_ban_v1_report_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +1192,7 @@ func (x *ReportWithAuthor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportWithAuthor.ProtoReflect.Descriptor instead.
 func (*ReportWithAuthor) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{12}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ReportWithAuthor) GetReport() *Report {
@@ -851,7 +1226,7 @@ type ReportStatusEditRequest struct {
 
 func (x *ReportStatusEditRequest) Reset() {
 	*x = ReportStatusEditRequest{}
-	mi := &file_ban_v1_report_proto_msgTypes[13]
+	mi := &file_ban_v1_report_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -863,7 +1238,7 @@ func (x *ReportStatusEditRequest) String() string {
 func (*ReportStatusEditRequest) ProtoMessage() {}
 
 func (x *ReportStatusEditRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[13]
+	mi := &file_ban_v1_report_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +1251,7 @@ func (x *ReportStatusEditRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportStatusEditRequest.ProtoReflect.Descriptor instead.
 func (*ReportStatusEditRequest) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{13}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ReportStatusEditRequest) GetReportId() int32 {
@@ -909,7 +1284,7 @@ type ReportCreateRequest struct {
 
 func (x *ReportCreateRequest) Reset() {
 	*x = ReportCreateRequest{}
-	mi := &file_ban_v1_report_proto_msgTypes[14]
+	mi := &file_ban_v1_report_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1296,7 @@ func (x *ReportCreateRequest) String() string {
 func (*ReportCreateRequest) ProtoMessage() {}
 
 func (x *ReportCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[14]
+	mi := &file_ban_v1_report_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1309,7 @@ func (x *ReportCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportCreateRequest.ProtoReflect.Descriptor instead.
 func (*ReportCreateRequest) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{14}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReportCreateRequest) GetSourceId() int64 {
@@ -1002,7 +1377,7 @@ type ReportCreateResponse struct {
 
 func (x *ReportCreateResponse) Reset() {
 	*x = ReportCreateResponse{}
-	mi := &file_ban_v1_report_proto_msgTypes[15]
+	mi := &file_ban_v1_report_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1014,7 +1389,7 @@ func (x *ReportCreateResponse) String() string {
 func (*ReportCreateResponse) ProtoMessage() {}
 
 func (x *ReportCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[15]
+	mi := &file_ban_v1_report_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1027,7 +1402,7 @@ func (x *ReportCreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportCreateResponse.ProtoReflect.Descriptor instead.
 func (*ReportCreateResponse) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{15}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ReportCreateResponse) GetReport() *ReportWithAuthor {
@@ -1047,7 +1422,7 @@ type ReportMessageCreateRequest struct {
 
 func (x *ReportMessageCreateRequest) Reset() {
 	*x = ReportMessageCreateRequest{}
-	mi := &file_ban_v1_report_proto_msgTypes[16]
+	mi := &file_ban_v1_report_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1059,7 +1434,7 @@ func (x *ReportMessageCreateRequest) String() string {
 func (*ReportMessageCreateRequest) ProtoMessage() {}
 
 func (x *ReportMessageCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[16]
+	mi := &file_ban_v1_report_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1447,7 @@ func (x *ReportMessageCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportMessageCreateRequest.ProtoReflect.Descriptor instead.
 func (*ReportMessageCreateRequest) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{16}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ReportMessageCreateRequest) GetReportId() int32 {
@@ -1098,7 +1473,7 @@ type ReportMessageCreateResponse struct {
 
 func (x *ReportMessageCreateResponse) Reset() {
 	*x = ReportMessageCreateResponse{}
-	mi := &file_ban_v1_report_proto_msgTypes[17]
+	mi := &file_ban_v1_report_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1110,7 +1485,7 @@ func (x *ReportMessageCreateResponse) String() string {
 func (*ReportMessageCreateResponse) ProtoMessage() {}
 
 func (x *ReportMessageCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ban_v1_report_proto_msgTypes[17]
+	mi := &file_ban_v1_report_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1123,7 +1498,7 @@ func (x *ReportMessageCreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportMessageCreateResponse.ProtoReflect.Descriptor instead.
 func (*ReportMessageCreateResponse) Descriptor() ([]byte, []int) {
-	return file_ban_v1_report_proto_rawDescGZIP(), []int{17}
+	return file_ban_v1_report_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ReportMessageCreateResponse) GetReportMessage() *ReportMessage {
@@ -1137,7 +1512,31 @@ var File_ban_v1_report_proto protoreflect.FileDescriptor
 
 const file_ban_v1_report_proto_rawDesc = "" +
 	"\n" +
-	"\x13ban/v1/report.proto\x12\x06ban.v1\x1a\x10ban/v1/ban.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bperson/v1/person_core.proto\x1a\x19person/v1/privilege.proto\"M\n" +
+	"\x13ban/v1/report.proto\x12\x06ban.v1\x1a\x10ban/v1/ban.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bperson/v1/person_core.proto\x1a\x19person/v1/privilege.proto\"\xf8\x02\n" +
+	"\x0eReportEvidence\x126\n" +
+	"\x12report_evidence_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\x10reportEvidenceId\x12#\n" +
+	"\treport_id\x18\x02 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\breportId\x125\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x14.ban.v1.EvidenceKindB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\x04kind\x12\x1f\n" +
+	"\x06ref_id\x18\x04 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\x05refId\x12\x1d\n" +
+	"\bsteam_id\x18\x05 \x01(\x03B\x020\x01R\asteamId\x12\x1b\n" +
+	"\tserver_id\x18\x06 \x01(\x05R\bserverId\x12\x18\n" +
+	"\asummary\x18\a \x01(\tR\asummary\x12\x18\n" +
+	"\aflagged\x18\b \x01(\bR\aflagged\x12A\n" +
+	"\n" +
+	"created_on\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn\"@\n" +
+	"\x15ReportEvidenceRequest\x12'\n" +
+	"\treport_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\breportId\"L\n" +
+	"\x16ReportEvidenceResponse\x122\n" +
+	"\bevidence\x18\x01 \x03(\v2\x16.ban.v1.ReportEvidenceR\bevidence\"\xa5\x02\n" +
+	"\rReportCluster\x127\n" +
+	"\asubject\x18\x01 \x01(\v2\x15.person.v1.PersonCoreB\x06\xbaH\x03\xc8\x01\x01R\asubject\x12:\n" +
+	"\areports\x18\x02 \x03(\v2\x18.ban.v1.ReportWithAuthorB\x06\xbaH\x03\xc8\x01\x01R\areports\x122\n" +
+	"\bevidence\x18\x03 \x03(\v2\x16.ban.v1.ReportEvidenceR\bevidence\x12\"\n" +
+	"\bseverity\x18\x04 \x01(\x01B\x06\xbaH\x03\xc8\x01\x01R\bseverity\x12G\n" +
+	"\rlast_reported\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\flastReported\"I\n" +
+	"\x14ReportTriageResponse\x121\n" +
+	"\bclusters\x18\x01 \x03(\v2\x15.ban.v1.ReportClusterR\bclusters\"M\n" +
 	"\x0fReportsResponse\x12:\n" +
 	"\areports\x18\x01 \x03(\v2\x18.ban.v1.ReportWithAuthorB\x06\xbaH\x03\xc8\x01\x01R\areports\"T\n" +
 	"\x1aReportMessageDeleteRequest\x126\n" +
@@ -1232,12 +1631,18 @@ const file_ban_v1_report_proto_rawDesc = "" +
 	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\breportId\x12'\n" +
 	"\abody_md\x18\x02 \x01(\tB\x0e\xbaH\v\xc8\x01\x01r\x06\x10\x01\x18І\x03R\x06bodyMd\"c\n" +
 	"\x1bReportMessageCreateResponse\x12D\n" +
-	"\x0ereport_message\x18\x01 \x01(\v2\x15.ban.v1.ReportMessageB\x06\xbaH\x03\xc8\x01\x01R\rreportMessage*\xa5\x01\n" +
+	"\x0ereport_message\x18\x01 \x01(\v2\x15.ban.v1.ReportMessageB\x06\xbaH\x03\xc8\x01\x01R\rreportMessage*\x91\x01\n" +
+	"\fEvidenceKind\x12\x1d\n" +
+	"\x19EVIDENCE_KIND_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EVIDENCE_KIND_CHAT\x10\x01\x12\x1b\n" +
+	"\x17EVIDENCE_KIND_DETECTION\x10\x02\x12\x15\n" +
+	"\x11EVIDENCE_KIND_BAN\x10\x03\x12\x16\n" +
+	"\x12EVIDENCE_KIND_DEMO\x10\x04*\xa5\x01\n" +
 	"\fReportStatus\x12$\n" +
 	" REPORT_STATUS_OPENED_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cREPORT_STATUS_NEED_MORE_INFO\x10\x01\x12'\n" +
 	"#REPORT_STATUS_CLOSED_WITHOUT_ACTION\x10\x02\x12$\n" +
-	" REPORT_STATUS_CLOSED_WITH_ACTION\x10\x032\xd4\x05\n" +
+	" REPORT_STATUS_CLOSED_WITH_ACTION\x10\x032\xef\x06\n" +
 	"\rReportService\x12K\n" +
 	"\fReportCreate\x12\x1b.ban.v1.ReportCreateRequest\x1a\x1c.ban.v1.ReportCreateResponse\"\x00\x129\n" +
 	"\x06Report\x12\x15.ban.v1.ReportRequest\x1a\x16.ban.v1.ReportResponse\"\x00\x12M\n" +
@@ -1247,7 +1652,9 @@ const file_ban_v1_report_proto_rawDesc = "" +
 	"\x13ReportMessageCreate\x12\".ban.v1.ReportMessageCreateRequest\x1a#.ban.v1.ReportMessageCreateResponse\"\x00\x12Z\n" +
 	"\x11ReportMessageEdit\x12 .ban.v1.ReportMessageEditRequest\x1a!.ban.v1.ReportMessageEditResponse\"\x00\x12S\n" +
 	"\x13ReportMessageDelete\x12\".ban.v1.ReportMessageDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12<\n" +
-	"\aReports\x12\x16.google.protobuf.Empty\x1a\x17.ban.v1.ReportsResponse\"\x00B\x89\x01\n" +
+	"\aReports\x12\x16.google.protobuf.Empty\x1a\x17.ban.v1.ReportsResponse\"\x00\x12Q\n" +
+	"\x0eReportEvidence\x12\x1d.ban.v1.ReportEvidenceRequest\x1a\x1e.ban.v1.ReportEvidenceResponse\"\x00\x12F\n" +
+	"\fReportTriage\x12\x16.google.protobuf.Empty\x1a\x1c.ban.v1.ReportTriageResponse\"\x00B\x89\x01\n" +
 	"\n" +
 	"com.ban.v1B\vReportProtoP\x01Z5github.com/leighmacdonald/gbans/internal/ban/v1;banv1\xa2\x02\x03BXX\xaa\x02\x06Ban.V1\xca\x02\x06Ban\\V1\xe2\x02\x12Ban\\V1\\GPBMetadata\xea\x02\aBan::V1b\beditionsp\xe8\a"
 
@@ -1263,77 +1670,95 @@ func file_ban_v1_report_proto_rawDescGZIP() []byte {
 	return file_ban_v1_report_proto_rawDescData
 }
 
-var file_ban_v1_report_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ban_v1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_ban_v1_report_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ban_v1_report_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_ban_v1_report_proto_goTypes = []any{
-	(ReportStatus)(0),                   // 0: ban.v1.ReportStatus
-	(*ReportsResponse)(nil),             // 1: ban.v1.ReportsResponse
-	(*ReportMessageDeleteRequest)(nil),  // 2: ban.v1.ReportMessageDeleteRequest
-	(*ReportMessageEditRequest)(nil),    // 3: ban.v1.ReportMessageEditRequest
-	(*ReportMessageEditResponse)(nil),   // 4: ban.v1.ReportMessageEditResponse
-	(*ReportMessagesRequest)(nil),       // 5: ban.v1.ReportMessagesRequest
-	(*UserReportsRequest)(nil),          // 6: ban.v1.UserReportsRequest
-	(*ReportMessagesResponse)(nil),      // 7: ban.v1.ReportMessagesResponse
-	(*UserReportsResponse)(nil),         // 8: ban.v1.UserReportsResponse
-	(*ReportRequest)(nil),               // 9: ban.v1.ReportRequest
-	(*ReportResponse)(nil),              // 10: ban.v1.ReportResponse
-	(*ReportMessage)(nil),               // 11: ban.v1.ReportMessage
-	(*Report)(nil),                      // 12: ban.v1.Report
-	(*ReportWithAuthor)(nil),            // 13: ban.v1.ReportWithAuthor
-	(*ReportStatusEditRequest)(nil),     // 14: ban.v1.ReportStatusEditRequest
-	(*ReportCreateRequest)(nil),         // 15: ban.v1.ReportCreateRequest
-	(*ReportCreateResponse)(nil),        // 16: ban.v1.ReportCreateResponse
-	(*ReportMessageCreateRequest)(nil),  // 17: ban.v1.ReportMessageCreateRequest
-	(*ReportMessageCreateResponse)(nil), // 18: ban.v1.ReportMessageCreateResponse
-	(*timestamppb.Timestamp)(nil),       // 19: google.protobuf.Timestamp
-	(v1.Privilege)(0),                   // 20: person.v1.Privilege
-	(BanReason)(0),                      // 21: ban.v1.BanReason
-	(*v1.PersonCore)(nil),               // 22: person.v1.PersonCore
-	(*emptypb.Empty)(nil),               // 23: google.protobuf.Empty
+	(EvidenceKind)(0),                   // 0: ban.v1.EvidenceKind
+	(ReportStatus)(0),                   // 1: ban.v1.ReportStatus
+	(*ReportEvidence)(nil),              // 2: ban.v1.ReportEvidence
+	(*ReportEvidenceRequest)(nil),       // 3: ban.v1.ReportEvidenceRequest
+	(*ReportEvidenceResponse)(nil),      // 4: ban.v1.ReportEvidenceResponse
+	(*ReportCluster)(nil),               // 5: ban.v1.ReportCluster
+	(*ReportTriageResponse)(nil),        // 6: ban.v1.ReportTriageResponse
+	(*ReportsResponse)(nil),             // 7: ban.v1.ReportsResponse
+	(*ReportMessageDeleteRequest)(nil),  // 8: ban.v1.ReportMessageDeleteRequest
+	(*ReportMessageEditRequest)(nil),    // 9: ban.v1.ReportMessageEditRequest
+	(*ReportMessageEditResponse)(nil),   // 10: ban.v1.ReportMessageEditResponse
+	(*ReportMessagesRequest)(nil),       // 11: ban.v1.ReportMessagesRequest
+	(*UserReportsRequest)(nil),          // 12: ban.v1.UserReportsRequest
+	(*ReportMessagesResponse)(nil),      // 13: ban.v1.ReportMessagesResponse
+	(*UserReportsResponse)(nil),         // 14: ban.v1.UserReportsResponse
+	(*ReportRequest)(nil),               // 15: ban.v1.ReportRequest
+	(*ReportResponse)(nil),              // 16: ban.v1.ReportResponse
+	(*ReportMessage)(nil),               // 17: ban.v1.ReportMessage
+	(*Report)(nil),                      // 18: ban.v1.Report
+	(*ReportWithAuthor)(nil),            // 19: ban.v1.ReportWithAuthor
+	(*ReportStatusEditRequest)(nil),     // 20: ban.v1.ReportStatusEditRequest
+	(*ReportCreateRequest)(nil),         // 21: ban.v1.ReportCreateRequest
+	(*ReportCreateResponse)(nil),        // 22: ban.v1.ReportCreateResponse
+	(*ReportMessageCreateRequest)(nil),  // 23: ban.v1.ReportMessageCreateRequest
+	(*ReportMessageCreateResponse)(nil), // 24: ban.v1.ReportMessageCreateResponse
+	(*timestamppb.Timestamp)(nil),       // 25: google.protobuf.Timestamp
+	(*v1.PersonCore)(nil),               // 26: person.v1.PersonCore
+	(v1.Privilege)(0),                   // 27: person.v1.Privilege
+	(BanReason)(0),                      // 28: ban.v1.BanReason
+	(*emptypb.Empty)(nil),               // 29: google.protobuf.Empty
 }
 var file_ban_v1_report_proto_depIdxs = []int32{
-	13, // 0: ban.v1.ReportsResponse.reports:type_name -> ban.v1.ReportWithAuthor
-	11, // 1: ban.v1.ReportMessageEditResponse.message:type_name -> ban.v1.ReportMessage
-	11, // 2: ban.v1.ReportMessagesResponse.messages:type_name -> ban.v1.ReportMessage
-	13, // 3: ban.v1.UserReportsResponse.reports:type_name -> ban.v1.ReportWithAuthor
-	13, // 4: ban.v1.ReportResponse.report:type_name -> ban.v1.ReportWithAuthor
-	19, // 5: ban.v1.ReportMessage.created_on:type_name -> google.protobuf.Timestamp
-	19, // 6: ban.v1.ReportMessage.updated_on:type_name -> google.protobuf.Timestamp
-	20, // 7: ban.v1.ReportMessage.permission_level:type_name -> person.v1.Privilege
-	0,  // 8: ban.v1.Report.report_status:type_name -> ban.v1.ReportStatus
-	21, // 9: ban.v1.Report.reason:type_name -> ban.v1.BanReason
-	19, // 10: ban.v1.Report.created_on:type_name -> google.protobuf.Timestamp
-	19, // 11: ban.v1.Report.updated_on:type_name -> google.protobuf.Timestamp
-	12, // 12: ban.v1.ReportWithAuthor.report:type_name -> ban.v1.Report
-	22, // 13: ban.v1.ReportWithAuthor.author:type_name -> person.v1.PersonCore
-	22, // 14: ban.v1.ReportWithAuthor.subject:type_name -> person.v1.PersonCore
-	0,  // 15: ban.v1.ReportStatusEditRequest.report_status:type_name -> ban.v1.ReportStatus
-	21, // 16: ban.v1.ReportCreateRequest.reason:type_name -> ban.v1.BanReason
-	13, // 17: ban.v1.ReportCreateResponse.report:type_name -> ban.v1.ReportWithAuthor
-	11, // 18: ban.v1.ReportMessageCreateResponse.report_message:type_name -> ban.v1.ReportMessage
-	15, // 19: ban.v1.ReportService.ReportCreate:input_type -> ban.v1.ReportCreateRequest
-	9,  // 20: ban.v1.ReportService.Report:input_type -> ban.v1.ReportRequest
-	14, // 21: ban.v1.ReportService.ReportStatusEdit:input_type -> ban.v1.ReportStatusEditRequest
-	6,  // 22: ban.v1.ReportService.UserReports:input_type -> ban.v1.UserReportsRequest
-	5,  // 23: ban.v1.ReportService.ReportMessages:input_type -> ban.v1.ReportMessagesRequest
-	17, // 24: ban.v1.ReportService.ReportMessageCreate:input_type -> ban.v1.ReportMessageCreateRequest
-	3,  // 25: ban.v1.ReportService.ReportMessageEdit:input_type -> ban.v1.ReportMessageEditRequest
-	2,  // 26: ban.v1.ReportService.ReportMessageDelete:input_type -> ban.v1.ReportMessageDeleteRequest
-	23, // 27: ban.v1.ReportService.Reports:input_type -> google.protobuf.Empty
-	16, // 28: ban.v1.ReportService.ReportCreate:output_type -> ban.v1.ReportCreateResponse
-	10, // 29: ban.v1.ReportService.Report:output_type -> ban.v1.ReportResponse
-	23, // 30: ban.v1.ReportService.ReportStatusEdit:output_type -> google.protobuf.Empty
-	8,  // 31: ban.v1.ReportService.UserReports:output_type -> ban.v1.UserReportsResponse
-	7,  // 32: ban.v1.ReportService.ReportMessages:output_type -> ban.v1.ReportMessagesResponse
-	18, // 33: ban.v1.ReportService.ReportMessageCreate:output_type -> ban.v1.ReportMessageCreateResponse
-	4,  // 34: ban.v1.ReportService.ReportMessageEdit:output_type -> ban.v1.ReportMessageEditResponse
-	23, // 35: ban.v1.ReportService.ReportMessageDelete:output_type -> google.protobuf.Empty
-	1,  // 36: ban.v1.ReportService.Reports:output_type -> ban.v1.ReportsResponse
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	0,  // 0: ban.v1.ReportEvidence.kind:type_name -> ban.v1.EvidenceKind
+	25, // 1: ban.v1.ReportEvidence.created_on:type_name -> google.protobuf.Timestamp
+	2,  // 2: ban.v1.ReportEvidenceResponse.evidence:type_name -> ban.v1.ReportEvidence
+	26, // 3: ban.v1.ReportCluster.subject:type_name -> person.v1.PersonCore
+	19, // 4: ban.v1.ReportCluster.reports:type_name -> ban.v1.ReportWithAuthor
+	2,  // 5: ban.v1.ReportCluster.evidence:type_name -> ban.v1.ReportEvidence
+	25, // 6: ban.v1.ReportCluster.last_reported:type_name -> google.protobuf.Timestamp
+	5,  // 7: ban.v1.ReportTriageResponse.clusters:type_name -> ban.v1.ReportCluster
+	19, // 8: ban.v1.ReportsResponse.reports:type_name -> ban.v1.ReportWithAuthor
+	17, // 9: ban.v1.ReportMessageEditResponse.message:type_name -> ban.v1.ReportMessage
+	17, // 10: ban.v1.ReportMessagesResponse.messages:type_name -> ban.v1.ReportMessage
+	19, // 11: ban.v1.UserReportsResponse.reports:type_name -> ban.v1.ReportWithAuthor
+	19, // 12: ban.v1.ReportResponse.report:type_name -> ban.v1.ReportWithAuthor
+	25, // 13: ban.v1.ReportMessage.created_on:type_name -> google.protobuf.Timestamp
+	25, // 14: ban.v1.ReportMessage.updated_on:type_name -> google.protobuf.Timestamp
+	27, // 15: ban.v1.ReportMessage.permission_level:type_name -> person.v1.Privilege
+	1,  // 16: ban.v1.Report.report_status:type_name -> ban.v1.ReportStatus
+	28, // 17: ban.v1.Report.reason:type_name -> ban.v1.BanReason
+	25, // 18: ban.v1.Report.created_on:type_name -> google.protobuf.Timestamp
+	25, // 19: ban.v1.Report.updated_on:type_name -> google.protobuf.Timestamp
+	18, // 20: ban.v1.ReportWithAuthor.report:type_name -> ban.v1.Report
+	26, // 21: ban.v1.ReportWithAuthor.author:type_name -> person.v1.PersonCore
+	26, // 22: ban.v1.ReportWithAuthor.subject:type_name -> person.v1.PersonCore
+	1,  // 23: ban.v1.ReportStatusEditRequest.report_status:type_name -> ban.v1.ReportStatus
+	28, // 24: ban.v1.ReportCreateRequest.reason:type_name -> ban.v1.BanReason
+	19, // 25: ban.v1.ReportCreateResponse.report:type_name -> ban.v1.ReportWithAuthor
+	17, // 26: ban.v1.ReportMessageCreateResponse.report_message:type_name -> ban.v1.ReportMessage
+	21, // 27: ban.v1.ReportService.ReportCreate:input_type -> ban.v1.ReportCreateRequest
+	15, // 28: ban.v1.ReportService.Report:input_type -> ban.v1.ReportRequest
+	20, // 29: ban.v1.ReportService.ReportStatusEdit:input_type -> ban.v1.ReportStatusEditRequest
+	12, // 30: ban.v1.ReportService.UserReports:input_type -> ban.v1.UserReportsRequest
+	11, // 31: ban.v1.ReportService.ReportMessages:input_type -> ban.v1.ReportMessagesRequest
+	23, // 32: ban.v1.ReportService.ReportMessageCreate:input_type -> ban.v1.ReportMessageCreateRequest
+	9,  // 33: ban.v1.ReportService.ReportMessageEdit:input_type -> ban.v1.ReportMessageEditRequest
+	8,  // 34: ban.v1.ReportService.ReportMessageDelete:input_type -> ban.v1.ReportMessageDeleteRequest
+	29, // 35: ban.v1.ReportService.Reports:input_type -> google.protobuf.Empty
+	3,  // 36: ban.v1.ReportService.ReportEvidence:input_type -> ban.v1.ReportEvidenceRequest
+	29, // 37: ban.v1.ReportService.ReportTriage:input_type -> google.protobuf.Empty
+	22, // 38: ban.v1.ReportService.ReportCreate:output_type -> ban.v1.ReportCreateResponse
+	16, // 39: ban.v1.ReportService.Report:output_type -> ban.v1.ReportResponse
+	29, // 40: ban.v1.ReportService.ReportStatusEdit:output_type -> google.protobuf.Empty
+	14, // 41: ban.v1.ReportService.UserReports:output_type -> ban.v1.UserReportsResponse
+	13, // 42: ban.v1.ReportService.ReportMessages:output_type -> ban.v1.ReportMessagesResponse
+	24, // 43: ban.v1.ReportService.ReportMessageCreate:output_type -> ban.v1.ReportMessageCreateResponse
+	10, // 44: ban.v1.ReportService.ReportMessageEdit:output_type -> ban.v1.ReportMessageEditResponse
+	29, // 45: ban.v1.ReportService.ReportMessageDelete:output_type -> google.protobuf.Empty
+	7,  // 46: ban.v1.ReportService.Reports:output_type -> ban.v1.ReportsResponse
+	4,  // 47: ban.v1.ReportService.ReportEvidence:output_type -> ban.v1.ReportEvidenceResponse
+	6,  // 48: ban.v1.ReportService.ReportTriage:output_type -> ban.v1.ReportTriageResponse
+	38, // [38:49] is the sub-list for method output_type
+	27, // [27:38] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_ban_v1_report_proto_init() }
@@ -1347,8 +1772,8 @@ func file_ban_v1_report_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ban_v1_report_proto_rawDesc), len(file_ban_v1_report_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   18,
+			NumEnums:      2,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
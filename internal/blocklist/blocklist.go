@@ -4,13 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
 	"net"
 	"net/http"
 	"net/netip"
 	"net/url"
-	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +30,15 @@ type CIDRBlockSource struct {
 	Enabled           bool
 	CreatedOn         time.Time
 	UpdatedOn         time.Time
+	// ETag and LastModified are the validators returned by the remote on the last successful fetch and
+	// are used to make conditional requests.
+	ETag          string
+	LastModified  string
+	LastSyncOn    *time.Time
+	LastSuccessOn *time.Time
+	// LastError holds the error text of the most recent sync attempt, empty on success.
+	LastError  string
+	EntryCount int32
 }
 
 type WhitelistIP struct {
@@ -57,14 +64,14 @@ type CacheUpdater interface {
 type Blocklists struct {
 	repository Repository
 	updater    CacheUpdater
-	cidrRx     *regexp.Regexp
+	matcher    *Matcher
 }
 
 func NewBlocklists(br Repository, updater CacheUpdater) Blocklists {
 	return Blocklists{
 		repository: br,
 		updater:    updater,
-		cidrRx:     regexp.MustCompile(`^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)(/(3[0-2]|2[0-9]|1[0-9]|[0-9]))?$`),
+		matcher:    NewMatcher(),
 	}
 }
 
@@ -101,20 +108,92 @@ func (b *Blocklists) UpdateCache(ctx context.Context) error {
 	}
 
 	for _, list := range lists {
-		if err := b.updateSource(ctx, list); err != nil {
-			slog.Error("Failed to update cidr block source", slog.String("error", err.Error()))
+		if !list.Enabled {
+			continue
+		}
+
+		if err := b.syncSource(ctx, list); err != nil {
+			slog.Error("Failed to update cidr block source", slog.String("name", list.Name), slog.String("error", err.Error()))
 		}
 	}
 
+	return b.RebuildMatcher(ctx)
+}
+
+// RebuildMatcher reloads the in-memory matcher from the currently cached block entries and whitelists.
+func (b *Blocklists) RebuildMatcher(ctx context.Context) error {
+	sources, errSources := b.repository.GetCIDRBlockSources(ctx)
+	if errSources != nil {
+		return errSources
+	}
+
+	entries, errEntries := b.repository.GetCachedEntries(ctx)
+	if errEntries != nil {
+		return errEntries
+	}
+
+	whitelists, errWhitelists := b.repository.GetCIDRBlockWhitelists(ctx)
+	if errWhitelists != nil {
+		return errWhitelists
+	}
+
+	steamWhitelists, errSteam := b.repository.GetSteamBlockWhitelists(ctx)
+	if errSteam != nil {
+		return errSteam
+	}
+
+	b.matcher.Load(sources, entries, whitelists, steamWhitelists)
+
+	slog.Debug("Rebuilt blocklist matcher", slog.Int("entries", b.matcher.Len()))
+
 	return nil
 }
 
-func (b *Blocklists) updateSource(ctx context.Context, list CIDRBlockSource) error {
+func (b *Blocklists) rebuildMatcher(ctx context.Context) {
+	if err := b.RebuildMatcher(ctx); err != nil {
+		slog.Error("Failed to rebuild blocklist matcher", slog.String("error", err.Error()))
+	}
+}
+
+// CheckBlock tests the address and steam id against all enabled block sources and whitelists.
+func (b *Blocklists) CheckBlock(addr netip.Addr, steamID steamid.SteamID) CheckResult {
+	return b.matcher.Check(addr, steamID)
+}
+
+// syncSource refreshes a single source and records the outcome of the attempt against it.
+func (b *Blocklists) syncSource(ctx context.Context, list CIDRBlockSource) error {
+	now := time.Now()
+	list.LastSyncOn = &now
+
+	errUpdate := b.updateSource(ctx, &list)
+	if errUpdate != nil {
+		list.LastError = errUpdate.Error()
+	} else {
+		list.LastError = ""
+		list.LastSuccessOn = &now
+	}
+
+	if errSave := b.repository.SaveSyncState(ctx, list); errSave != nil {
+		return errors.Join(errUpdate, errSave)
+	}
+
+	return errUpdate
+}
+
+func (b *Blocklists) updateSource(ctx context.Context, list *CIDRBlockSource) error {
 	req, errReq := http.NewRequestWithContext(ctx, http.MethodGet, list.URL, nil)
 	if errReq != nil {
 		return errors.Join(errReq, httphelper.ErrRequestCreate)
 	}
 
+	if list.ETag != "" {
+		req.Header.Set("If-None-Match", list.ETag)
+	}
+
+	if list.LastModified != "" {
+		req.Header.Set("If-Modified-Since", list.LastModified)
+	}
+
 	client := httphelper.NewClient()
 
 	resp, errResp := client.Do(req)
@@ -126,38 +205,32 @@ func (b *Blocklists) updateSource(ctx context.Context, list CIDRBlockSource) err
 		_ = resp.Body.Close()
 	}()
 
+	if resp.StatusCode == http.StatusNotModified {
+		slog.Debug("Blocklist source not modified", slog.String("name", list.Name))
+
+		return nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("%w: %d", httphelper.ErrRequestInvalidCode, resp.StatusCode)
 	}
 
-	bodyBytes, errRead := io.ReadAll(resp.Body)
-	if errRead != nil {
-		return errors.Join(errRead, httphelper.ErrResponseBody)
+	blocks, errParse := ParseList(resp.Body)
+	if errParse != nil {
+		return errors.Join(errParse, httphelper.ErrResponseBody)
 	}
 
-	var blocks []netip.Prefix //nolint:prealloc
-
-	for line := range strings.SplitSeq(string(bodyBytes), "\n") {
-		trimmed := strings.TrimSpace(line)
-		if !b.cidrRx.MatchString(trimmed) {
-			continue
-		}
-
-		prefix, errBlock := netip.ParsePrefix(trimmed)
-		if errBlock != nil {
-			continue
-		}
-
-		blocks = append(blocks, prefix)
+	added, removed, errReplace := b.repository.ReplaceCachedEntries(ctx, *list, blocks)
+	if errReplace != nil {
+		return errReplace
 	}
 
-	if err := b.repository.TruncateCachedEntries(ctx); err != nil {
-		return err
-	}
+	list.ETag = resp.Header.Get("ETag")
+	list.LastModified = resp.Header.Get("Last-Modified")
+	list.EntryCount = int32(len(blocks)) //nolint:gosec
 
-	if err := b.repository.InsertCache(ctx, list, blocks); err != nil {
-		return err
-	}
+	slog.Info("Updated blocklist source", slog.String("name", list.Name), slog.Int("entries", len(blocks)),
+		slog.Int("added", added), slog.Int("removed", removed))
 
 	return nil
 }
@@ -170,6 +243,8 @@ func (b *Blocklists) CreateSteamBlockWhitelists(ctx context.Context, steamID ste
 
 	slog.Info("Created steam block whitelist", slog.String("steam_id", steamID.String()))
 
+	b.rebuildMatcher(ctx)
+
 	return whitelist, nil
 }
 
@@ -184,6 +259,8 @@ func (b *Blocklists) DeleteSteamBlockWhitelists(ctx context.Context, steamID ste
 
 	slog.Info("Deleted steam whitelist", slog.String("steam_id", steamID.String()))
 
+	b.rebuildMatcher(ctx)
+
 	return nil
 }
 
@@ -233,6 +310,12 @@ func (b *Blocklists) UpdateCIDRBlockSource(ctx context.Context, sourceID int32,
 		return blockSource, rpc.ErrBadRequest // TODO better errro
 	}
 
+	if blockSource.URL != url {
+		// Force a full fetch of the new list.
+		blockSource.ETag = ""
+		blockSource.LastModified = ""
+	}
+
 	blockSource.Enabled = enabled
 	blockSource.Name = name
 	blockSource.URL = url
@@ -243,6 +326,8 @@ func (b *Blocklists) UpdateCIDRBlockSource(ctx context.Context, sourceID int32,
 
 	slog.Info("Updated blocklist", slog.String("name", blockSource.Name))
 
+	b.rebuildMatcher(ctx)
+
 	return blockSource, nil
 }
 
@@ -253,6 +338,8 @@ func (b *Blocklists) DeleteCIDRBlockSources(ctx context.Context, blockSourceID i
 
 	slog.Info("Deleted blocklist", slog.Int("cidr_block_source_id", int(blockSourceID)))
 
+	b.rebuildMatcher(ctx)
+
 	return nil
 }
 
@@ -286,6 +373,8 @@ func (b *Blocklists) CreateCIDRBlockWhitelist(ctx context.Context, address strin
 
 	slog.Info("Created ip whitelist", slog.String("addr", address))
 
+	b.rebuildMatcher(ctx)
+
 	return whitelist, nil
 }
 
@@ -308,6 +397,8 @@ func (b *Blocklists) UpdateCIDRBlockWhitelist(ctx context.Context, whitelistID i
 
 	slog.Info("Updated ip whitelist", slog.String("addr", address), slog.Int("whitelist_id", int(whitelistID)))
 
+	b.rebuildMatcher(ctx)
+
 	return whitelist, nil
 }
 
@@ -318,5 +409,7 @@ func (b *Blocklists) DeleteCIDRBlockWhitelist(ctx context.Context, whitelistID i
 
 	slog.Info("Blocklist deleted", slog.Int("cidr_block_source_id", int(whitelistID)))
 
+	b.rebuildMatcher(ctx)
+
 	return nil
 }
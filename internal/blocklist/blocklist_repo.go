@@ -21,26 +21,122 @@ func NewRepository(database database.Database) Repository {
 	return Repository{Database: database}
 }
 
-func (b Repository) InsertCache(ctx context.Context, list CIDRBlockSource, entries []netip.Prefix) error {
-	const query = "INSERT INTO cidr_block_entries (cidr_block_source_id, net_block, created_on) VALUES ($1, $2, $3)"
+// ReplaceCachedEntries updates the cached entries of a single source so that they exactly match the entries
+// provided. Only the differences between the current and new set are written. The returned values are the number
+// of added and removed prefixes.
+func (b Repository) ReplaceCachedEntries(ctx context.Context, list CIDRBlockSource, entries []netip.Prefix) (int, int, error) {
+	var added, removed int
+
+	errTx := b.WrapTx(ctx, func(transaction pgx.Tx) error {
+		rows, errRows := transaction.Query(ctx,
+			"SELECT net_block::cidr FROM cidr_block_entries WHERE cidr_block_source_id = $1 FOR UPDATE",
+			list.CIDRBlockSourceID)
+		if errRows != nil {
+			return database.Err(errRows)
+		}
 
-	batch := pgx.Batch{}
-	now := time.Now()
+		existing := map[netip.Prefix]struct{}{}
 
-	for _, cidrRange := range entries {
-		batch.Queue(query, list.CIDRBlockSourceID, cidrRange, now)
-	}
+		for rows.Next() {
+			var prefix netip.Prefix
+			if errScan := rows.Scan(&prefix); errScan != nil {
+				rows.Close()
+
+				return database.Err(errScan)
+			}
+
+			existing[prefix] = struct{}{}
+		}
+
+		rows.Close()
+
+		if errRows := rows.Err(); errRows != nil {
+			return database.Err(errRows)
+		}
+
+		var (
+			batch = pgx.Batch{}
+			now   = time.Now()
+		)
+
+		for _, prefix := range entries {
+			if _, found := existing[prefix]; found {
+				delete(existing, prefix)
+
+				continue
+			}
 
-	batchResults := b.SendBatch(ctx, &batch)
-	if errCloseBatch := batchResults.Close(); errCloseBatch != nil {
-		return errors.Join(errCloseBatch, database.ErrCloseBatch)
+			batch.Queue("INSERT INTO cidr_block_entries (cidr_block_source_id, net_block, created_on) VALUES ($1, $2, $3)",
+				list.CIDRBlockSourceID, prefix, now)
+			added++
+		}
+
+		// Anything remaining was not present in the new list.
+		for prefix := range existing {
+			batch.Queue("DELETE FROM cidr_block_entries WHERE cidr_block_source_id = $1 AND net_block = $2::text::ip4r",
+				list.CIDRBlockSourceID, prefix.String())
+			removed++
+		}
+
+		batch.Queue("UPDATE cidr_block_source SET entry_count = $2 WHERE cidr_block_source_id = $1",
+			list.CIDRBlockSourceID, len(entries))
+
+		if errCloseBatch := transaction.SendBatch(ctx, &batch).Close(); errCloseBatch != nil {
+			return errors.Join(errCloseBatch, database.ErrCloseBatch)
+		}
+
+		return nil
+	})
+	if errTx != nil {
+		return 0, 0, errTx
 	}
 
-	return nil
+	return added, removed, nil
 }
 
-func (b Repository) TruncateCachedEntries(ctx context.Context) error {
-	return database.Err(b.ExecDeleteBuilder(ctx, b.Builder().Delete("cidr_block_entries")))
+// SaveSyncState records the result of the last synchronization attempt for a source.
+func (b Repository) SaveSyncState(ctx context.Context, list CIDRBlockSource) error {
+	return database.Err(b.ExecUpdateBuilder(ctx, b.Builder().
+		Update("cidr_block_source").
+		SetMap(map[string]any{
+			"etag":            list.ETag,
+			"last_modified":   list.LastModified,
+			"last_sync_on":    list.LastSyncOn,
+			"last_success_on": list.LastSuccessOn,
+			"last_error":      list.LastError,
+		}).
+		Where(sq.Eq{"cidr_block_source_id": list.CIDRBlockSourceID})))
+}
+
+// GetCachedEntries returns all cached entries belonging to enabled block sources.
+func (b Repository) GetCachedEntries(ctx context.Context) ([]CachedEntry, error) {
+	entries := make([]CachedEntry, 0)
+
+	rows, errRows := b.QueryBuilder(ctx, b.Builder().
+		Select("e.cidr_block_source_id", "e.net_block::cidr").
+		From("cidr_block_entries e").
+		InnerJoin("cidr_block_source s ON s.cidr_block_source_id = e.cidr_block_source_id").
+		Where(sq.Eq{"s.enabled": true}))
+	if errRows != nil {
+		if errors.Is(errRows, database.ErrNoResult) {
+			return entries, nil
+		}
+
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry CachedEntry
+		if errScan := rows.Scan(&entry.CIDRBlockSourceID, &entry.Prefix); errScan != nil {
+			return nil, database.Err(errScan)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
 }
 
 func (b Repository) CreateSteamBlockWhitelists(ctx context.Context, steamID steamid.SteamID) (WhitelistSteam, error) {
@@ -116,7 +212,8 @@ func (b Repository) GetCIDRBlockSources(ctx context.Context) ([]CIDRBlockSource,
 	blocks := make([]CIDRBlockSource, 0)
 
 	rows, errRows := b.QueryBuilder(ctx, b.Builder().
-		Select("cidr_block_source_id", "name", "url", "enabled", "created_on", "updated_on").
+		Select("cidr_block_source_id", "name", "url", "enabled", "created_on", "updated_on",
+			"etag", "last_modified", "last_sync_on", "last_success_on", "last_error", "entry_count").
 		From("cidr_block_source"))
 	if errRows != nil {
 		if errors.Is(errRows, database.ErrNoResult) {
@@ -130,7 +227,8 @@ func (b Repository) GetCIDRBlockSources(ctx context.Context) ([]CIDRBlockSource,
 
 	for rows.Next() {
 		var block CIDRBlockSource
-		if errScan := rows.Scan(&block.CIDRBlockSourceID, &block.Name, &block.URL, &block.Enabled, &block.CreatedOn, &block.UpdatedOn); errScan != nil {
+		if errScan := rows.Scan(&block.CIDRBlockSourceID, &block.Name, &block.URL, &block.Enabled, &block.CreatedOn, &block.UpdatedOn,
+			&block.ETag, &block.LastModified, &block.LastSyncOn, &block.LastSuccessOn, &block.LastError, &block.EntryCount); errScan != nil {
 			return nil, database.Err(errScan)
 		}
 
@@ -142,14 +240,16 @@ func (b Repository) GetCIDRBlockSources(ctx context.Context) ([]CIDRBlockSource,
 
 func (b Repository) GetCIDRBlockSource(ctx context.Context, sourceID int32, block *CIDRBlockSource) error {
 	row, errRow := b.QueryRowBuilder(ctx, b.Builder().
-		Select("cidr_block_source_id", "name", "url", "enabled", "created_on", "updated_on").
+		Select("cidr_block_source_id", "name", "url", "enabled", "created_on", "updated_on",
+			"etag", "last_modified", "last_sync_on", "last_success_on", "last_error", "entry_count").
 		From("cidr_block_source").
 		Where(sq.Eq{"cidr_block_source_id": sourceID}))
 	if errRow != nil {
 		return database.Err(errRow)
 	}
 
-	if errScan := row.Scan(&block.CIDRBlockSourceID, &block.Name, &block.URL, &block.Enabled, &block.CreatedOn, &block.UpdatedOn); errScan != nil {
+	if errScan := row.Scan(&block.CIDRBlockSourceID, &block.Name, &block.URL, &block.Enabled, &block.CreatedOn, &block.UpdatedOn,
+		&block.ETag, &block.LastModified, &block.LastSyncOn, &block.LastSuccessOn, &block.LastError, &block.EntryCount); errScan != nil {
 		return database.Err(errScan)
 	}
 
@@ -165,10 +265,12 @@ func (b Repository) SaveCIDRBlockSources(ctx context.Context, block *CIDRBlockSo
 		return database.Err(b.ExecUpdateBuilder(ctx, b.Builder().
 			Update("cidr_block_source").
 			SetMap(map[string]any{
-				"name":       block.Name,
-				"url":        block.URL,
-				"enabled":    block.Enabled,
-				"updated_on": block.UpdatedOn,
+				"name":          block.Name,
+				"url":           block.URL,
+				"enabled":       block.Enabled,
+				"etag":          block.ETag,
+				"last_modified": block.LastModified,
+				"updated_on":    block.UpdatedOn,
 			}).
 			Where(sq.Eq{"cidr_block_source_id": block.CIDRBlockSourceID})))
 	}
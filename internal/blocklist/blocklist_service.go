@@ -2,6 +2,7 @@ package blocklist
 
 import (
 	"context"
+	"net/netip"
 	"strings"
 
 	"connectrpc.com/connect"
@@ -54,14 +55,26 @@ func (s Service) BlocklistSources(ctx context.Context, _ *emptypb.Empty) (*v1.Bl
 }
 
 func toBlocklistSource(source CIDRBlockSource) *v1.CIDRBlockSource {
-	return &v1.CIDRBlockSource{
+	blockSource := &v1.CIDRBlockSource{
 		CidrBlockSourceId: &source.CIDRBlockSourceID,
 		Name:              &source.Name,
 		Url:               &source.URL,
 		Enabled:           &source.Enabled,
 		CreatedOn:         timestamppb.New(source.CreatedOn),
 		UpdatedOn:         timestamppb.New(source.UpdatedOn),
+		LastError:         &source.LastError,
+		EntryCount:        &source.EntryCount,
 	}
+
+	if source.LastSyncOn != nil {
+		blockSource.LastSyncOn = timestamppb.New(*source.LastSyncOn)
+	}
+
+	if source.LastSuccessOn != nil {
+		blockSource.LastSuccessOn = timestamppb.New(*source.LastSuccessOn)
+	}
+
+	return blockSource
 }
 
 func (s Service) BlocklistSourcesCreate(ctx context.Context, req *v1.BlocklistSourcesCreateRequest) (*v1.BlocklistSourcesCreateResponse, error) {
@@ -173,8 +186,29 @@ func (s Service) WhitelistSteamCreate(ctx context.Context, req *v1.WhitelistStea
 	return &v1.WhitelistSteamCreateResponse{Whitelist: toWhitelistSteam(whitelist)}, nil
 }
 
-func (s Service) CheckBlock(_ context.Context, _ *v1.CheckBlockRequest) (*v1.CheckBlockResponse, error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, rpc.ErrInternal)
+func (s Service) CheckBlock(_ context.Context, req *v1.CheckBlockRequest) (*v1.CheckBlockResponse, error) {
+	addr, errAddr := netip.ParseAddr(req.GetAddress())
+	if errAddr != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidCIDR)
+	}
+
+	result := s.blocklists.CheckBlock(addr, steamid.New(req.GetSteamId()))
+	resp := v1.CheckBlockResponse{
+		Blocked:        &result.Blocked,
+		Source:         &result.Source.Name,
+		WhitelistSteam: &result.WhitelistSteam,
+	}
+
+	if result.Matched {
+		resp.CidrBlockSourceId = &result.Source.CIDRBlockSourceID
+		resp.Prefix = new(result.Prefix.String())
+	}
+
+	if result.WhitelistAddress.IsValid() {
+		resp.WhitelistAddress = new(result.WhitelistAddress.String())
+	}
+
+	return &resp, nil
 }
 
 func toCIDRBlockWhitelist(whitelist WhitelistIP) *v1.CIDRBlockWhitelist {
@@ -0,0 +1,304 @@
+package blocklist
+
+import (
+	"net"
+	"net/netip"
+	"sync"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// trieNode is a single node within a path compressed binary prefix trie. Nodes which exist only to
+// join two diverging branches have set == false.
+type trieNode[T any] struct {
+	prefix   netip.Prefix
+	children [2]*trieNode[T]
+	value    T
+	set      bool
+}
+
+// prefixTrie implements a path compressed radix trie keyed by network prefix which supports longest
+// prefix match lookups. IPv4 and IPv6 prefixes are stored in separate trees.
+type prefixTrie[T any] struct {
+	v4   *trieNode[T]
+	v6   *trieNode[T]
+	size int
+}
+
+func (t *prefixTrie[T]) root(addr netip.Addr) **trieNode[T] {
+	if addr.Is4() {
+		return &t.v4
+	}
+
+	return &t.v6
+}
+
+// Insert adds the prefix to the trie, replacing the value of any existing identical prefix.
+func (t *prefixTrie[T]) Insert(prefix netip.Prefix, value T) {
+	if !prefix.IsValid() {
+		return
+	}
+
+	if prefix.Addr().Is4In6() {
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), max(prefix.Bits()-96, 0))
+	}
+
+	prefix = prefix.Masked()
+	node := t.root(prefix.Addr())
+
+	for {
+		current := *node
+		if current == nil {
+			*node = &trieNode[T]{prefix: prefix, value: value, set: true}
+			t.size++
+
+			return
+		}
+
+		common := commonBits(current.prefix, prefix)
+
+		switch {
+		case common == current.prefix.Bits() && common == prefix.Bits():
+			if !current.set {
+				t.size++
+			}
+
+			current.value = value
+			current.set = true
+
+			return
+		case common == current.prefix.Bits():
+			node = &current.children[bitAt(prefix.Addr(), common)]
+
+			continue
+		}
+
+		branch := &trieNode[T]{prefix: netip.PrefixFrom(prefix.Addr(), common).Masked()}
+		branch.children[bitAt(current.prefix.Addr(), common)] = current
+
+		if common == prefix.Bits() {
+			branch.value = value
+			branch.set = true
+		} else {
+			branch.children[bitAt(prefix.Addr(), common)] = &trieNode[T]{prefix: prefix, value: value, set: true}
+		}
+
+		*node = branch
+		t.size++
+
+		return
+	}
+}
+
+// Lookup returns the most specific prefix containing the address.
+func (t *prefixTrie[T]) Lookup(addr netip.Addr) (netip.Prefix, T, bool) {
+	var (
+		best  *trieNode[T]
+		empty T
+	)
+
+	addr = addr.Unmap()
+	node := *t.root(addr)
+
+	for node != nil && node.prefix.Contains(addr) {
+		if node.set {
+			best = node
+		}
+
+		if node.prefix.Bits() == addr.BitLen() {
+			break
+		}
+
+		node = node.children[bitAt(addr, node.prefix.Bits())]
+	}
+
+	if best == nil {
+		return netip.Prefix{}, empty, false
+	}
+
+	return best.prefix, best.value, true
+}
+
+// Len returns the number of prefixes stored.
+func (t *prefixTrie[T]) Len() int {
+	return t.size
+}
+
+func bitAt(addr netip.Addr, idx int) int {
+	raw := addr.AsSlice()
+
+	return int(raw[idx/8]>>(7-idx%8)) & 1
+}
+
+func commonBits(left netip.Prefix, right netip.Prefix) int {
+	var (
+		leftRaw  = left.Addr().AsSlice()
+		rightRaw = right.Addr().AsSlice()
+		limit    = min(left.Bits(), right.Bits())
+		count    = 0
+	)
+
+	for idx := range leftRaw {
+		if count >= limit {
+			break
+		}
+
+		diff := leftRaw[idx] ^ rightRaw[idx]
+		if diff == 0 {
+			count += 8
+
+			continue
+		}
+
+		for bit := 7; bit >= 0 && diff>>bit == 0; bit-- {
+			count++
+		}
+
+		break
+	}
+
+	return min(count, limit)
+}
+
+// CachedEntry is a single network range that was fetched from a remote CIDRBlockSource.
+type CachedEntry struct {
+	CIDRBlockSourceID int32
+	Prefix            netip.Prefix
+}
+
+// CheckResult contains the outcome of checking an address against the blocklists.
+type CheckResult struct {
+	// Matched is true when the address is contained within any enabled block source.
+	Matched bool
+	// Blocked is true when the address matched and there was no applicable whitelist entry.
+	Blocked bool
+	// Source is the block source that contains the most specific matching prefix.
+	Source CIDRBlockSource
+	// Prefix is the most specific prefix that matched the address.
+	Prefix netip.Prefix
+	// WhitelistAddress is set to the matching whitelist range, if any.
+	WhitelistAddress netip.Prefix
+	// WhitelistSteam is true when the steam id was on the steam whitelist.
+	WhitelistSteam bool
+}
+
+// Matcher is an in-memory index of the cached block source entries and whitelists. It is rebuilt
+// wholesale from the database so that lookups never need to touch the database themselves.
+type Matcher struct {
+	mu        *sync.RWMutex
+	sources   map[int32]CIDRBlockSource
+	blocks    *prefixTrie[int32]
+	whitelist *prefixTrie[int32]
+	steam     map[steamid.SteamID]struct{}
+}
+
+func NewMatcher() *Matcher {
+	return &Matcher{
+		mu:        &sync.RWMutex{},
+		sources:   map[int32]CIDRBlockSource{},
+		blocks:    &prefixTrie[int32]{},
+		whitelist: &prefixTrie[int32]{},
+		steam:     map[steamid.SteamID]struct{}{},
+	}
+}
+
+// Load replaces the current index with a new one built from the provided values. Entries belonging to
+// disabled or unknown sources are skipped.
+func (m *Matcher) Load(sources []CIDRBlockSource, entries []CachedEntry, whitelists []WhitelistIP, steamWhitelists []WhitelistSteam) {
+	var (
+		sourceMap = make(map[int32]CIDRBlockSource, len(sources))
+		blocks    = &prefixTrie[int32]{}
+		whitelist = &prefixTrie[int32]{}
+		steam     = make(map[steamid.SteamID]struct{}, len(steamWhitelists))
+	)
+
+	for _, source := range sources {
+		if source.Enabled {
+			sourceMap[source.CIDRBlockSourceID] = source
+		}
+	}
+
+	for _, entry := range entries {
+		if _, found := sourceMap[entry.CIDRBlockSourceID]; !found {
+			continue
+		}
+
+		blocks.Insert(entry.Prefix, entry.CIDRBlockSourceID)
+	}
+
+	for _, entry := range whitelists {
+		if prefix, ok := toPrefix(entry.Address); ok {
+			whitelist.Insert(prefix, entry.CIDRBlockWhitelistID)
+		}
+	}
+
+	for _, entry := range steamWhitelists {
+		if sid := steamid.New(entry.SteamIDValue); sid.Valid() {
+			steam[sid] = struct{}{}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sources = sourceMap
+	m.blocks = blocks
+	m.whitelist = whitelist
+	m.steam = steam
+}
+
+// Check tests the address, and optionally steam id, against the current index.
+func (m *Matcher) Check(addr netip.Addr, steamID steamid.SteamID) CheckResult {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var result CheckResult
+
+	if steamID.Valid() {
+		_, result.WhitelistSteam = m.steam[steamID]
+	}
+
+	if prefix, _, found := m.whitelist.Lookup(addr); found {
+		result.WhitelistAddress = prefix
+	}
+
+	prefix, sourceID, found := m.blocks.Lookup(addr)
+	if !found {
+		return result
+	}
+
+	result.Matched = true
+	result.Prefix = prefix
+	result.Source = m.sources[sourceID]
+	result.Blocked = !result.WhitelistSteam && !result.WhitelistAddress.IsValid()
+
+	return result
+}
+
+// Len returns the total count of blocked prefixes currently indexed.
+func (m *Matcher) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.blocks.Len()
+}
+
+func toPrefix(network *net.IPNet) (netip.Prefix, bool) {
+	if network == nil {
+		return netip.Prefix{}, false
+	}
+
+	addr, ok := netip.AddrFromSlice(network.IP)
+	if !ok {
+		return netip.Prefix{}, false
+	}
+
+	ones, _ := network.Mask.Size()
+	addr = addr.Unmap()
+
+	if addr.Is4() && ones > 32 {
+		ones -= 96
+	}
+
+	return netip.PrefixFrom(addr, ones).Masked(), true
+}
@@ -0,0 +1,68 @@
+package blocklist_test
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/leighmacdonald/gbans/internal/blocklist"
+	"github.com/leighmacdonald/gbans/internal/httphelper"
+	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatcher(t *testing.T) {
+	_, whitelisted, errCIDR := net.ParseCIDR("10.1.2.0/24")
+	require.NoError(t, errCIDR)
+
+	matcher := blocklist.NewMatcher()
+	matcher.Load(
+		[]blocklist.CIDRBlockSource{
+			{CIDRBlockSourceID: 1, Name: "vpn", Enabled: true},
+			{CIDRBlockSourceID: 2, Name: "hosting", Enabled: true},
+			{CIDRBlockSourceID: 3, Name: "disabled", Enabled: false},
+		},
+		[]blocklist.CachedEntry{
+			{CIDRBlockSourceID: 1, Prefix: netip.MustParsePrefix("10.0.0.0/8")},
+			{CIDRBlockSourceID: 2, Prefix: netip.MustParsePrefix("10.20.0.0/16")},
+			{CIDRBlockSourceID: 2, Prefix: netip.MustParsePrefix("10.20.30.40/32")},
+			{CIDRBlockSourceID: 1, Prefix: netip.MustParsePrefix("192.168.0.0/30")},
+			{CIDRBlockSourceID: 3, Prefix: netip.MustParsePrefix("172.16.0.0/12")},
+		},
+		[]blocklist.WhitelistIP{{CIDRBlockWhitelistID: 1, Address: whitelisted}},
+		[]blocklist.WhitelistSteam{{SteamIDField: httphelper.SteamIDField{SteamIDValue: tests.ModSID.String()}}},
+	)
+
+	require.Equal(t, 4, matcher.Len())
+
+	result := matcher.Check(netip.MustParseAddr("10.9.9.9"), steamid.SteamID{})
+	require.True(t, result.Blocked)
+	require.Equal(t, "vpn", result.Source.Name)
+	require.Equal(t, netip.MustParsePrefix("10.0.0.0/8"), result.Prefix)
+
+	result = matcher.Check(netip.MustParseAddr("10.20.1.1"), steamid.SteamID{})
+	require.True(t, result.Blocked)
+	require.Equal(t, "hosting", result.Source.Name)
+	require.Equal(t, netip.MustParsePrefix("10.20.0.0/16"), result.Prefix)
+
+	result = matcher.Check(netip.MustParseAddr("10.20.30.40"), steamid.SteamID{})
+	require.True(t, result.Blocked)
+	require.Equal(t, netip.MustParsePrefix("10.20.30.40/32"), result.Prefix)
+
+	result = matcher.Check(netip.MustParseAddr("192.168.0.4"), steamid.SteamID{})
+	require.False(t, result.Matched)
+
+	result = matcher.Check(netip.MustParseAddr("172.16.1.1"), steamid.SteamID{})
+	require.False(t, result.Matched)
+
+	result = matcher.Check(netip.MustParseAddr("10.1.2.3"), steamid.SteamID{})
+	require.True(t, result.Matched)
+	require.False(t, result.Blocked)
+	require.Equal(t, netip.MustParsePrefix("10.1.2.0/24"), result.WhitelistAddress)
+
+	result = matcher.Check(netip.MustParseAddr("10.9.9.9"), tests.ModSID)
+	require.True(t, result.Matched)
+	require.True(t, result.WhitelistSteam)
+	require.False(t, result.Blocked)
+}
@@ -0,0 +1,184 @@
+package blocklist
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+	"net/netip"
+	"slices"
+	"strings"
+)
+
+var ErrInvalidRange = errors.New("invalid address range")
+
+// maxListLineLength limits the size of a single line so malformed lists cannot exhaust memory.
+const maxListLineLength = 64 * 1024
+
+// ParseList reads a network list and returns the unique set of IPv4 prefixes it contains. The following
+// line formats are understood, with anything after a #, ; or // treated as a comment:
+//
+//	192.0.2.1
+//	192.0.2.0/24
+//	192.0.2.10-192.0.2.20
+//	192.0.2.10 - 192.0.2.20
+//	192.0.2.0/24 ; SBL12345
+//
+// Gzip compressed input is detected and decompressed automatically. IPv6 entries are skipped as the
+// cache table only stores IPv4 ranges.
+func ParseList(reader io.Reader) ([]netip.Prefix, error) {
+	buffered := bufio.NewReader(reader)
+
+	magic, errPeek := buffered.Peek(2)
+	if errPeek == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gzipReader, errGzip := gzip.NewReader(buffered)
+		if errGzip != nil {
+			return nil, errGzip
+		}
+
+		defer func() {
+			_ = gzipReader.Close()
+		}()
+
+		buffered = bufio.NewReader(gzipReader)
+	}
+
+	var (
+		seen     = map[netip.Prefix]struct{}{}
+		prefixes []netip.Prefix
+		scanner  = bufio.NewScanner(buffered)
+	)
+
+	scanner.Buffer(make([]byte, 0, 4096), maxListLineLength)
+
+	for scanner.Scan() {
+		parsed, errLine := ParseListLine(scanner.Text())
+		if errLine != nil {
+			continue
+		}
+
+		for _, prefix := range parsed {
+			if _, found := seen[prefix]; found {
+				continue
+			}
+
+			seen[prefix] = struct{}{}
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(prefixes, comparePrefix)
+
+	return prefixes, nil
+}
+
+// ParseListLine parses a single line from a network list. Empty and comment only lines return no
+// prefixes and no error.
+func ParseListLine(line string) ([]netip.Prefix, error) {
+	for _, marker := range []string{"#", ";", "//"} {
+		if idx := strings.Index(line, marker); idx >= 0 {
+			line = line[:idx]
+		}
+	}
+
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil, nil
+	}
+
+	fields := strings.FieldsFunc(line, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == ','
+	})
+
+	if len(fields) >= 3 && fields[1] == "-" {
+		return parseRange(fields[0], fields[2])
+	}
+
+	value := fields[0]
+	if start, end, isRange := strings.Cut(value, "-"); isRange {
+		return parseRange(start, end)
+	}
+
+	if strings.Contains(value, "/") {
+		prefix, errPrefix := netip.ParsePrefix(value)
+		if errPrefix != nil || !prefix.Addr().Unmap().Is4() {
+			return nil, ErrInvalidCIDR
+		}
+
+		return []netip.Prefix{netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()).Masked()}, nil
+	}
+
+	addr, errAddr := netip.ParseAddr(value)
+	if errAddr != nil || !addr.Unmap().Is4() {
+		return nil, ErrInvalidCIDR
+	}
+
+	return []netip.Prefix{netip.PrefixFrom(addr.Unmap(), 32)}, nil
+}
+
+// parseRange converts an inclusive address range into the minimal set of covering prefixes.
+func parseRange(startValue string, endValue string) ([]netip.Prefix, error) {
+	startAddr, errStart := netip.ParseAddr(startValue)
+	if errStart != nil || !startAddr.Is4() {
+		return nil, ErrInvalidRange
+	}
+
+	endAddr, errEnd := netip.ParseAddr(endValue)
+	if errEnd != nil || !endAddr.Is4() || endAddr.Less(startAddr) {
+		return nil, ErrInvalidRange
+	}
+
+	var (
+		start    = uint64(addrToUint32(startAddr))
+		end      = uint64(addrToUint32(endAddr))
+		prefixes []netip.Prefix
+	)
+
+	for start <= end {
+		// The largest block aligned to start, shrunk until it fits within the remaining range.
+		size := 32
+		if start > 0 {
+			size = bits.TrailingZeros64(start)
+		}
+
+		size = min(size, 32)
+		for size > 0 && start+(uint64(1)<<size)-1 > end {
+			size--
+		}
+
+		prefixes = append(prefixes, netip.PrefixFrom(uint32ToAddr(uint32(start)), 32-size)) //nolint:gosec
+
+		start += uint64(1) << size
+	}
+
+	return prefixes, nil
+}
+
+func addrToUint32(addr netip.Addr) uint32 {
+	raw := addr.As4()
+
+	return binary.BigEndian.Uint32(raw[:])
+}
+
+func uint32ToAddr(value uint32) netip.Addr {
+	var raw [4]byte
+
+	binary.BigEndian.PutUint32(raw[:], value)
+
+	return netip.AddrFrom4(raw)
+}
+
+func comparePrefix(left netip.Prefix, right netip.Prefix) int {
+	if cmp := left.Addr().Compare(right.Addr()); cmp != 0 {
+		return cmp
+	}
+
+	return left.Bits() - right.Bits()
+}
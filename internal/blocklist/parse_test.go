@@ -0,0 +1,62 @@
+package blocklist_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/leighmacdonald/gbans/internal/blocklist"
+	"github.com/stretchr/testify/require"
+)
+
+const testList = `# Example list
+; another comment style
+192.0.2.1
+192.0.2.1
+198.51.100.0/24 ; SBL123
+203.0.113.10-203.0.113.20
+203.0.113.64 - 203.0.113.127 // spaced range
+2001:db8::/32
+not an address
+
+10.0.0.7/8 # unmasked
+`
+
+func TestParseList(t *testing.T) {
+	expected := []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.0.2.1/32"),
+		netip.MustParsePrefix("198.51.100.0/24"),
+		netip.MustParsePrefix("203.0.113.10/31"),
+		netip.MustParsePrefix("203.0.113.12/30"),
+		netip.MustParsePrefix("203.0.113.16/30"),
+		netip.MustParsePrefix("203.0.113.20/32"),
+		netip.MustParsePrefix("203.0.113.64/26"),
+	}
+
+	prefixes, errParse := blocklist.ParseList(strings.NewReader(testList))
+	require.NoError(t, errParse)
+	require.Equal(t, expected, prefixes)
+
+	var compressed bytes.Buffer
+
+	writer := gzip.NewWriter(&compressed)
+	_, errWrite := writer.Write([]byte(testList))
+	require.NoError(t, errWrite)
+	require.NoError(t, writer.Close())
+
+	gzipPrefixes, errGzip := blocklist.ParseList(&compressed)
+	require.NoError(t, errGzip)
+	require.Equal(t, expected, gzipPrefixes)
+}
+
+func TestParseListLineRange(t *testing.T) {
+	prefixes, errParse := blocklist.ParseListLine("0.0.0.0-255.255.255.255")
+	require.NoError(t, errParse)
+	require.Equal(t, []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")}, prefixes)
+
+	_, errInvalid := blocklist.ParseListLine("10.0.0.5-10.0.0.1")
+	require.ErrorIs(t, errInvalid, blocklist.ErrInvalidRange)
+}
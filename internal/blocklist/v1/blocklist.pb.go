@@ -505,8 +505,10 @@ func (x *WhitelistAddressCreateResponse) GetWhitelist() *CIDRBlockWhitelist {
 }
 
 type CheckBlockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       *string                `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address *string                `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Optional steam id used to also check the steam whitelist.
+	SteamId       *int64 `protobuf:"varint,2,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -548,12 +550,27 @@ func (x *CheckBlockRequest) GetAddress() string {
 	return ""
 }
 
+func (x *CheckBlockRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
 type CheckBlockResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Blocked       *bool                  `protobuf:"varint,1,opt,name=blocked" json:"blocked,omitempty"`
-	Source        *string                `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when the address matched a source and was not whitelisted.
+	Blocked *bool `protobuf:"varint,1,opt,name=blocked" json:"blocked,omitempty"`
+	// Name of the source containing the matched prefix.
+	Source            *string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
+	CidrBlockSourceId *int32  `protobuf:"varint,3,opt,name=cidr_block_source_id,json=cidrBlockSourceId" json:"cidr_block_source_id,omitempty"`
+	// Most specific matching prefix.
+	Prefix *string `protobuf:"bytes,4,opt,name=prefix" json:"prefix,omitempty"`
+	// Matching address whitelist entry, if any.
+	WhitelistAddress *string `protobuf:"bytes,5,opt,name=whitelist_address,json=whitelistAddress" json:"whitelist_address,omitempty"`
+	WhitelistSteam   *bool   `protobuf:"varint,6,opt,name=whitelist_steam,json=whitelistSteam" json:"whitelist_steam,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CheckBlockResponse) Reset() {
@@ -600,6 +617,34 @@ func (x *CheckBlockResponse) GetSource() string {
 	return ""
 }
 
+func (x *CheckBlockResponse) GetCidrBlockSourceId() int32 {
+	if x != nil && x.CidrBlockSourceId != nil {
+		return *x.CidrBlockSourceId
+	}
+	return 0
+}
+
+func (x *CheckBlockResponse) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *CheckBlockResponse) GetWhitelistAddress() string {
+	if x != nil && x.WhitelistAddress != nil {
+		return *x.WhitelistAddress
+	}
+	return ""
+}
+
+func (x *CheckBlockResponse) GetWhitelistSteam() bool {
+	if x != nil && x.WhitelistSteam != nil {
+		return *x.WhitelistSteam
+	}
+	return false
+}
+
 type BlocklistSourcesDeleteRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	CidrBlockSourceId *int32                 `protobuf:"varint,1,opt,name=cidr_block_source_id,json=cidrBlockSourceId" json:"cidr_block_source_id,omitempty"`
@@ -1024,8 +1069,16 @@ type CIDRBlockSource struct {
 	Enabled           *bool                  `protobuf:"varint,4,opt,name=enabled" json:"enabled,omitempty"`
 	CreatedOn         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
 	UpdatedOn         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_on,json=updatedOn" json:"updated_on,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Time of the most recent sync attempt, unset if never synced.
+	LastSyncOn *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_sync_on,json=lastSyncOn" json:"last_sync_on,omitempty"`
+	// Time of the most recent sync which completed without error.
+	LastSuccessOn *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_success_on,json=lastSuccessOn" json:"last_success_on,omitempty"`
+	// Error text from the most recent sync attempt, empty on success.
+	LastError *string `protobuf:"bytes,9,opt,name=last_error,json=lastError" json:"last_error,omitempty"`
+	// Number of ranges currently cached for this source.
+	EntryCount    *int32 `protobuf:"varint,10,opt,name=entry_count,json=entryCount" json:"entry_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CIDRBlockSource) Reset() {
@@ -1100,6 +1153,34 @@ func (x *CIDRBlockSource) GetUpdatedOn() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CIDRBlockSource) GetLastSyncOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncOn
+	}
+	return nil
+}
+
+func (x *CIDRBlockSource) GetLastSuccessOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessOn
+	}
+	return nil
+}
+
+func (x *CIDRBlockSource) GetLastError() string {
+	if x != nil && x.LastError != nil {
+		return *x.LastError
+	}
+	return ""
+}
+
+func (x *CIDRBlockSource) GetEntryCount() int32 {
+	if x != nil && x.EntryCount != nil {
+		return *x.EntryCount
+	}
+	return 0
+}
+
 type WhitelistIP struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	CidrBlockWhitelistId *int32                 `protobuf:"varint,1,opt,name=cidr_block_whitelist_id,json=cidrBlockWhitelistId" json:"cidr_block_whitelist_id,omitempty"`
@@ -1205,13 +1286,19 @@ const file_blocklist_v1_blocklist_proto_rawDesc = "" +
 	"\x1dWhitelistAddressCreateRequest\x12 \n" +
 	"\aaddress\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\aaddress\"h\n" +
 	"\x1eWhitelistAddressCreateResponse\x12F\n" +
-	"\twhitelist\x18\x01 \x01(\v2 .blocklist.v1.CIDRBlockWhitelistB\x06\xbaH\x03\xc8\x01\x01R\twhitelist\"9\n" +
+	"\twhitelist\x18\x01 \x01(\v2 .blocklist.v1.CIDRBlockWhitelistB\x06\xbaH\x03\xc8\x01\x01R\twhitelist\"g\n" +
 	"\x11CheckBlockRequest\x12$\n" +
 	"\aaddress\x18\x01 \x01(\tB\n" +
-	"\xbaH\a\xc8\x01\x01r\x02x\x01R\aaddress\"V\n" +
+	"\xbaH\a\xc8\x01\x01r\x02x\x01R\aaddress\x12,\n" +
+	"\bsteam_id\x18\x02 \x01(\x03B\x11\xbaH\f\"\n" +
+	"(\x81\x80\x80\x80\x90\x80\x80\x88\x010\x01R\asteamId\"\xf5\x01\n" +
 	"\x12CheckBlockResponse\x12 \n" +
 	"\ablocked\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\ablocked\x12\x1e\n" +
-	"\x06source\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06source\"X\n" +
+	"\x06source\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06source\x12/\n" +
+	"\x14cidr_block_source_id\x18\x03 \x01(\x05R\x11cidrBlockSourceId\x12\x16\n" +
+	"\x06prefix\x18\x04 \x01(\tR\x06prefix\x12+\n" +
+	"\x11whitelist_address\x18\x05 \x01(\tR\x10whitelistAddress\x12'\n" +
+	"\x0fwhitelist_steam\x18\x06 \x01(\bR\x0ewhitelistSteam\"X\n" +
 	"\x1dBlocklistSourcesDeleteRequest\x127\n" +
 	"\x14cidr_block_source_id\x18\x01 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\x11cidrBlockSourceId\"\xae\x01\n" +
 	"\x1bBlocklistSourcesEditRequest\x127\n" +
@@ -1237,7 +1324,7 @@ const file_blocklist_v1_blocklist_proto_rawDesc = "" +
 	"\n" +
 	"updated_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedOn\"l\n" +
 	"\x18BlocklistSourcesResponse\x12P\n" +
-	"\x10blocklist_source\x18\x01 \x03(\v2\x1d.blocklist.v1.CIDRBlockSourceB\x06\xbaH\x03\xc8\x01\x01R\x0fblocklistSource\"\xb5\x02\n" +
+	"\x10blocklist_source\x18\x01 \x03(\v2\x1d.blocklist.v1.CIDRBlockSourceB\x06\xbaH\x03\xc8\x01\x01R\x0fblocklistSource\"\xf7\x03\n" +
 	"\x0fCIDRBlockSource\x12;\n" +
 	"\x14cidr_block_source_id\x18\x01 \x01(\x05B\n" +
 	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x11cidrBlockSourceId\x12\x1e\n" +
@@ -1248,7 +1335,15 @@ const file_blocklist_v1_blocklist_proto_rawDesc = "" +
 	"\n" +
 	"created_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn\x12A\n" +
 	"\n" +
-	"updated_on\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedOn\"\xfd\x01\n" +
+	"updated_on\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedOn\x12<\n" +
+	"\flast_sync_on\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSyncOn\x12B\n" +
+	"\x0flast_success_on\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rlastSuccessOn\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12\x1f\n" +
+	"\ventry_count\x18\n" +
+	" \x01(\x05R\n" +
+	"entryCount\"\xfd\x01\n" +
 	"\vWhitelistIP\x12A\n" +
 	"\x17cidr_block_whitelist_id\x18\x01 \x01(\x05B\n" +
 	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\x14cidrBlockWhitelistId\x12%\n" +
@@ -1327,37 +1422,39 @@ var file_blocklist_v1_blocklist_proto_depIdxs = []int32{
 	20, // 11: blocklist.v1.BlocklistSourcesResponse.blocklist_source:type_name -> blocklist.v1.CIDRBlockSource
 	22, // 12: blocklist.v1.CIDRBlockSource.created_on:type_name -> google.protobuf.Timestamp
 	22, // 13: blocklist.v1.CIDRBlockSource.updated_on:type_name -> google.protobuf.Timestamp
-	22, // 14: blocklist.v1.WhitelistIP.created_on:type_name -> google.protobuf.Timestamp
-	22, // 15: blocklist.v1.WhitelistIP.updated_on:type_name -> google.protobuf.Timestamp
-	23, // 16: blocklist.v1.BlocklistService.BlocklistSources:input_type -> google.protobuf.Empty
-	15, // 17: blocklist.v1.BlocklistService.BlocklistSourcesCreate:input_type -> blocklist.v1.BlocklistSourcesCreateRequest
-	13, // 18: blocklist.v1.BlocklistService.BlocklistSourcesEdit:input_type -> blocklist.v1.BlocklistSourcesEditRequest
-	12, // 19: blocklist.v1.BlocklistService.BlocklistSourcesDelete:input_type -> blocklist.v1.BlocklistSourcesDeleteRequest
-	23, // 20: blocklist.v1.BlocklistService.WhitelistAddress:input_type -> google.protobuf.Empty
-	8,  // 21: blocklist.v1.BlocklistService.WhitelistAddressCreate:input_type -> blocklist.v1.WhitelistAddressCreateRequest
-	7,  // 22: blocklist.v1.BlocklistService.WhitelistAddressDelete:input_type -> blocklist.v1.WhitelistAddressDeleteRequest
-	5,  // 23: blocklist.v1.BlocklistService.WhitelistAddressEdit:input_type -> blocklist.v1.WhitelistAddressEditRequest
-	23, // 24: blocklist.v1.BlocklistService.WhitelistSteam:input_type -> google.protobuf.Empty
-	2,  // 25: blocklist.v1.BlocklistService.WhitelistSteamDelete:input_type -> blocklist.v1.WhitelistSteamDeleteRequest
-	0,  // 26: blocklist.v1.BlocklistService.WhitelistSteamCreate:input_type -> blocklist.v1.WhitelistSteamCreateRequest
-	10, // 27: blocklist.v1.BlocklistService.CheckBlock:input_type -> blocklist.v1.CheckBlockRequest
-	19, // 28: blocklist.v1.BlocklistService.BlocklistSources:output_type -> blocklist.v1.BlocklistSourcesResponse
-	16, // 29: blocklist.v1.BlocklistService.BlocklistSourcesCreate:output_type -> blocklist.v1.BlocklistSourcesCreateResponse
-	14, // 30: blocklist.v1.BlocklistService.BlocklistSourcesEdit:output_type -> blocklist.v1.BlocklistSourcesEditResponse
-	23, // 31: blocklist.v1.BlocklistService.BlocklistSourcesDelete:output_type -> google.protobuf.Empty
-	17, // 32: blocklist.v1.BlocklistService.WhitelistAddress:output_type -> blocklist.v1.WhitelistAddressResponse
-	9,  // 33: blocklist.v1.BlocklistService.WhitelistAddressCreate:output_type -> blocklist.v1.WhitelistAddressCreateResponse
-	23, // 34: blocklist.v1.BlocklistService.WhitelistAddressDelete:output_type -> google.protobuf.Empty
-	6,  // 35: blocklist.v1.BlocklistService.WhitelistAddressEdit:output_type -> blocklist.v1.WhitelistAddressEditResponse
-	3,  // 36: blocklist.v1.BlocklistService.WhitelistSteam:output_type -> blocklist.v1.WhitelistSteamResponse
-	23, // 37: blocklist.v1.BlocklistService.WhitelistSteamDelete:output_type -> google.protobuf.Empty
-	1,  // 38: blocklist.v1.BlocklistService.WhitelistSteamCreate:output_type -> blocklist.v1.WhitelistSteamCreateResponse
-	11, // 39: blocklist.v1.BlocklistService.CheckBlock:output_type -> blocklist.v1.CheckBlockResponse
-	28, // [28:40] is the sub-list for method output_type
-	16, // [16:28] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	22, // 14: blocklist.v1.CIDRBlockSource.last_sync_on:type_name -> google.protobuf.Timestamp
+	22, // 15: blocklist.v1.CIDRBlockSource.last_success_on:type_name -> google.protobuf.Timestamp
+	22, // 16: blocklist.v1.WhitelistIP.created_on:type_name -> google.protobuf.Timestamp
+	22, // 17: blocklist.v1.WhitelistIP.updated_on:type_name -> google.protobuf.Timestamp
+	23, // 18: blocklist.v1.BlocklistService.BlocklistSources:input_type -> google.protobuf.Empty
+	15, // 19: blocklist.v1.BlocklistService.BlocklistSourcesCreate:input_type -> blocklist.v1.BlocklistSourcesCreateRequest
+	13, // 20: blocklist.v1.BlocklistService.BlocklistSourcesEdit:input_type -> blocklist.v1.BlocklistSourcesEditRequest
+	12, // 21: blocklist.v1.BlocklistService.BlocklistSourcesDelete:input_type -> blocklist.v1.BlocklistSourcesDeleteRequest
+	23, // 22: blocklist.v1.BlocklistService.WhitelistAddress:input_type -> google.protobuf.Empty
+	8,  // 23: blocklist.v1.BlocklistService.WhitelistAddressCreate:input_type -> blocklist.v1.WhitelistAddressCreateRequest
+	7,  // 24: blocklist.v1.BlocklistService.WhitelistAddressDelete:input_type -> blocklist.v1.WhitelistAddressDeleteRequest
+	5,  // 25: blocklist.v1.BlocklistService.WhitelistAddressEdit:input_type -> blocklist.v1.WhitelistAddressEditRequest
+	23, // 26: blocklist.v1.BlocklistService.WhitelistSteam:input_type -> google.protobuf.Empty
+	2,  // 27: blocklist.v1.BlocklistService.WhitelistSteamDelete:input_type -> blocklist.v1.WhitelistSteamDeleteRequest
+	0,  // 28: blocklist.v1.BlocklistService.WhitelistSteamCreate:input_type -> blocklist.v1.WhitelistSteamCreateRequest
+	10, // 29: blocklist.v1.BlocklistService.CheckBlock:input_type -> blocklist.v1.CheckBlockRequest
+	19, // 30: blocklist.v1.BlocklistService.BlocklistSources:output_type -> blocklist.v1.BlocklistSourcesResponse
+	16, // 31: blocklist.v1.BlocklistService.BlocklistSourcesCreate:output_type -> blocklist.v1.BlocklistSourcesCreateResponse
+	14, // 32: blocklist.v1.BlocklistService.BlocklistSourcesEdit:output_type -> blocklist.v1.BlocklistSourcesEditResponse
+	23, // 33: blocklist.v1.BlocklistService.BlocklistSourcesDelete:output_type -> google.protobuf.Empty
+	17, // 34: blocklist.v1.BlocklistService.WhitelistAddress:output_type -> blocklist.v1.WhitelistAddressResponse
+	9,  // 35: blocklist.v1.BlocklistService.WhitelistAddressCreate:output_type -> blocklist.v1.WhitelistAddressCreateResponse
+	23, // 36: blocklist.v1.BlocklistService.WhitelistAddressDelete:output_type -> google.protobuf.Empty
+	6,  // 37: blocklist.v1.BlocklistService.WhitelistAddressEdit:output_type -> blocklist.v1.WhitelistAddressEditResponse
+	3,  // 38: blocklist.v1.BlocklistService.WhitelistSteam:output_type -> blocklist.v1.WhitelistSteamResponse
+	23, // 39: blocklist.v1.BlocklistService.WhitelistSteamDelete:output_type -> google.protobuf.Empty
+	1,  // 40: blocklist.v1.BlocklistService.WhitelistSteamCreate:output_type -> blocklist.v1.WhitelistSteamCreateResponse
+	11, // 41: blocklist.v1.BlocklistService.CheckBlock:output_type -> blocklist.v1.CheckBlockResponse
+	30, // [30:42] is the sub-list for method output_type
+	18, // [18:30] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_blocklist_v1_blocklist_proto_init() }
@@ -4,13 +4,14 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"slices"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/leighmacdonald/gbans/internal/auth/permission"
 	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/gbans/internal/database"
 	"github.com/leighmacdonald/gbans/internal/database/query"
 	"github.com/leighmacdonald/gbans/internal/discord"
 	"github.com/leighmacdonald/gbans/internal/domain/person"
@@ -23,7 +24,20 @@ import (
 
 var ErrInvalidActionDuration = errors.New("invalid action duration")
 
-type ExceedHandler func(ctx context.Context, exceeded bool, warning NewUserWarning) error
+// demoMessageWindow is how far apart a demo message and a live message may be to be considered the same. Demo
+// times are derived from the demo file name and tick count, so they do not line up exactly with the log.
+const demoMessageWindow = time.Minute * 2
+
+// escalationQueueSize is how many escalations may wait for the handler before new ones are dropped.
+const escalationQueueSize = 100
+
+// EscalationHandler applies the escalation step reached by a new warning.
+type EscalationHandler func(ctx context.Context, step EscalationStep, warning NewUserWarning) error
+
+type pendingEscalation struct {
+	step    EscalationStep
+	warning NewUserWarning
+}
 
 type HistoryQueryFilter struct {
 	query.Filter
@@ -65,6 +79,8 @@ type Message struct {
 	CreatedOn         time.Time
 	AutoFilterFlagged int32
 	MatchID           *uuid.UUID
+	// Live is set for messages recorded from the server logs rather than imported from a demo.
+	Live bool
 }
 
 type PersonMessages []Message
@@ -78,57 +94,49 @@ type QueryChatHistoryResult struct {
 type Chat struct {
 	*Config
 
-	repository    Repository
-	wordFilters   WordFilters
-	persons       person.Provider
-	notifications notification.Notifier
-	warningMu     *sync.RWMutex
-	dry           bool
-	maxWeight     int32
-	warnings      map[steamid.SteamID][]UserWarning
-	owner         steamid.SteamID
-	matchTimeout  time.Duration
-	checkTimeout  time.Duration
-	pingDiscord   bool
-	exceedHandler ExceedHandler
-	WarningChan   chan NewUserWarning
-	logChannelID  string
+	repository        Repository
+	wordFilters       WordFilters
+	persons           person.Provider
+	notifications     notification.Notifier
+	escalationHandler EscalationHandler
+	escalations       chan pendingEscalation
+	logChannelID      string
 }
 
 func New(repo Repository, config *Config, filters WordFilters,
-	persons person.Provider, notifications notification.Notifier, actionHandler ExceedHandler, logChannelID string,
+	persons person.Provider, notifications notification.Notifier, escalationHandler EscalationHandler, logChannelID string,
 ) *Chat {
 	return &Chat{
-		Config:        config,
-		repository:    repo,
-		wordFilters:   filters,
-		notifications: notifications,
-		persons:       persons,
-		logChannelID:  logChannelID,
-		warnings:      make(map[steamid.SteamID][]UserWarning),
-		warningMu:     &sync.RWMutex{},
-		matchTimeout:  time.Duration(config.MatchTimeout) * time.Second,
-		exceedHandler: actionHandler,
-		checkTimeout:  time.Duration(config.CheckTimeout) * time.Second,
+		Config:            config,
+		repository:        repo,
+		wordFilters:       filters,
+		notifications:     notifications,
+		persons:           persons,
+		logChannelID:      logChannelID,
+		escalationHandler: escalationHandler,
+		escalations:       make(chan pendingEscalation, escalationQueueSize),
 	}
 }
 
 func (u *Chat) Start(ctx context.Context, events *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]) {
-	cleanupTicker := time.NewTicker(u.checkTimeout)
-	eventChan := make(chan logparse.ServerEvent)
-	if errRegister := events.Consume(eventChan, logparse.Connected, logparse.Say, logparse.SayTeam); errRegister != nil {
-		slog.Warn("logWriter Tried to register duplicate reader channel", slog.String("error", errRegister.Error()))
+	consumer, errRegister := events.Subscribe("chat", broadcaster.Options{Policy: broadcaster.Block},
+		logparse.Connected, logparse.Say, logparse.SayTeam)
+	if errRegister != nil {
+		slog.Warn("Failed to register chat event consumer", slog.String("error", errRegister.Error()))
 
 		return
 	}
+	defer events.Unsubscribe(consumer)
+
+	// Escalations talk to the game servers and create bans, so they are applied separately to keep them
+	// from holding up the chat consumer, which blocks the broadcaster when it falls behind.
+	go u.applyEscalations(ctx)
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-cleanupTicker.C:
-			u.cleanupExpired()
-		case evt := <-eventChan:
+		case evt := <-consumer.C:
 			if errEvent := u.handleEvent(ctx, evt); errEvent != nil {
 				slog.Error("Failed to handle chat event", slog.String("error", errEvent.Error()))
 			}
@@ -136,6 +144,24 @@ func (u *Chat) Start(ctx context.Context, events *broadcaster.Broadcaster[logpar
 	}
 }
 
+func (u *Chat) applyEscalations(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case pending := <-u.escalations:
+			if u.escalationHandler == nil {
+				continue
+			}
+
+			if errHandle := u.escalationHandler(ctx, pending.step, pending.warning); errHandle != nil {
+				slog.Error("Failed to apply chat escalation", slog.String("action", pending.step.Action.String()),
+					slog.String("steam_id", pending.warning.UserMessage.SteamID.String()), slog.String("error", errHandle.Error()))
+			}
+		}
+	}
+}
+
 func (u *Chat) handleEvent(ctx context.Context, evt logparse.ServerEvent) error {
 	switch evt.EventType {
 	case logparse.Connected:
@@ -161,23 +187,6 @@ func (u *Chat) handleEvent(ctx context.Context, evt logparse.ServerEvent) error
 	return nil
 }
 
-func (u *Chat) cleanupExpired() {
-	u.warningMu.Lock()
-	defer u.warningMu.Unlock()
-
-	for steamID := range u.warnings {
-		for warnIdx, warning := range u.warnings[steamID] {
-			if time.Since(warning.CreatedOn) > u.matchTimeout {
-				if len(u.warnings[steamID]) > 1 {
-					u.warnings[steamID] = append(u.warnings[steamID][:warnIdx], u.warnings[steamID][warnIdx+1])
-				} else {
-					delete(u.warnings, steamID)
-				}
-			}
-		}
-	}
-}
-
 func (u *Chat) handleMessage(ctx context.Context, evt logparse.ServerEvent, person logparse.SourcePlayer, msg string, created time.Time, reason reason.Reason) error {
 	if msg == "" {
 		return nil
@@ -203,148 +212,176 @@ func (u *Chat) handleMessage(ctx context.Context, evt logparse.ServerEvent, pers
 			"Player": player,
 		}))))
 
-	// Stores from demos instead
-	// if errChat := u.AddChatHistory(ctx, &userMsg); errChat != nil {
-	// 	return errChat
-	// }
+	// Connection names are checked but are not chat, so only messages are stored. Demo imports are linked to
+	// these messages afterward instead of duplicating them.
+	if evt.EventType == logparse.Say || evt.EventType == logparse.SayTeam {
+		userMsg.Live = true
+		if errChat := u.AddChatHistory(ctx, &userMsg); errChat != nil {
+			return errChat
+		}
+	}
 
-	matchedFilter := u.wordFilters.Check(userMsg.Body)
-	if len(matchedFilter) == 0 {
+	matches := u.wordFilters.Match(userMsg.Body)
+	if len(matches) == 0 {
 		return nil
 	}
 
-	if errSaveMatch := u.wordFilters.AddMessageFilterMatch(ctx, userMsg.PersonMessageID, matchedFilter[0].FilterID); errSaveMatch != nil {
-		slog.Error("Failed to save message findMatch status", slog.String("error", errSaveMatch.Error()))
+	matchResult := matches[0]
+
+	if userMsg.PersonMessageID > 0 {
+		if errSaveMatch := u.wordFilters.AddMessageFilterMatch(ctx, userMsg.PersonMessageID, matchResult.Filter.FilterID); errSaveMatch != nil {
+			slog.Error("Failed to save message findMatch status", slog.String("error", errSaveMatch.Error()))
+		}
 	}
 
-	matchResult := matchedFilter[0]
+	userMsg.ServerName = evt.ServerName
 
-	u.WarningChan <- NewUserWarning{
+	return u.trigger(ctx, NewUserWarning{
 		UserMessage: userMsg,
 		PlayerID:    person.PID,
 		UserWarning: UserWarning{
-			WarnReason: reason,
-			Message:    userMsg.Body,
-			// todo
-			// Matched:       matchResult,
-			MatchedFilter: matchResult,
-			CreatedOn:     time.Now(),
-			Personaname:   userMsg.PersonaName,
-			Avatar:        userMsg.AvatarHash,
-			ServerName:    evt.ServerName,
-			ServerID:      userMsg.ServerID,
-			SteamID:       userMsg.SteamID.Int64(),
+			PersonMessageID: userMsg.PersonMessageID,
+			WarnReason:      reason,
+			Message:         userMsg.Body,
+			Matched:         matchResult.Matched,
+			MatchedFilter:   matchResult.Filter,
+			CreatedOn:       time.Now(),
+			Personaname:     userMsg.PersonaName,
+			Avatar:          userMsg.AvatarHash,
+			ServerName:      evt.ServerName,
+			ServerID:        userMsg.ServerID,
+			SteamID:         userMsg.SteamID.Int64(),
+			Weight:          matchResult.Filter.Weight,
 		},
+	})
+}
+
+func (u *Chat) warningTimeout() time.Duration {
+	return time.Duration(u.WarningTimeout) * time.Second
+}
+
+// warningsSince returns the oldest time a warning can be created and still count towards a players total.
+func (u *Chat) warningsSince(now time.Time) time.Time {
+	if u.WarningTimeout <= 0 {
+		return time.Time{}
 	}
 
-	return nil
+	return now.Add(-u.warningTimeout())
 }
 
-func (u *Chat) saveWarning(ctx context.Context, newWarning NewUserWarning) error {
-	newWarning.MatchedFilter.TriggerCount++
-	admin, errAdmin := u.persons.GetOrCreatePersonBySteamID(ctx, u.owner)
-	if errAdmin != nil {
-		return errAdmin
+// trigger records the warning and queues the highest escalation step reached by the decayed total weight of the
+// players active warnings, raised to the matched filters action when that is more severe. Steps are dropped when
+// the queue is full, the saved warning still counts towards the next escalation of the player.
+func (u *Chat) trigger(ctx context.Context, newWarn NewUserWarning) error {
+	if !newWarn.UserMessage.SteamID.Valid() {
+		return nil
 	}
 
-	_, errSave := u.wordFilters.Edit(ctx, admin, newWarning.MatchedFilter.FilterID, newWarning.MatchedFilter)
-	if errSave != nil {
-		return errSave
+	existing, errExisting := u.repository.Warnings(ctx, WarningsQuery{
+		SteamID: newWarn.UserMessage.SteamID,
+		Since:   u.warningsSince(newWarn.CreatedOn),
+	})
+	if errExisting != nil {
+		return errExisting
 	}
 
-	return nil
-}
+	current := ApplyDecay(append(existing, newWarn.UserWarning), newWarn.CreatedOn, u.warningTimeout())
+	newWarn.CurrentTotal = current[len(current)-1].CurrentTotal
 
-// State returns a string key so its more easily portable to frontend js w/o using BigInt.
-func (u *Chat) State() map[string][]UserWarning {
-	u.warningMu.RLock()
-	defer u.warningMu.RUnlock()
+	steps, errSteps := u.repository.EscalationSteps(ctx)
+	if errSteps != nil {
+		return errSteps
+	}
 
-	out := make(map[string][]UserWarning)
+	step, found := Escalate(steps, newWarn.CurrentTotal, newWarn.MatchedFilter)
+	if found && !u.Dry {
+		newWarn.Action = &step.Action
+	}
 
-	for steamID, v := range u.warnings {
-		out[steamID.String()] = v
+	if errSave := u.repository.SaveWarning(ctx, &newWarn.UserWarning); errSave != nil {
+		return errSave
 	}
 
-	return out
-}
+	newWarn.MatchedFilter.TriggerCount++
+	if errCount := u.wordFilters.IncrementTriggerCount(ctx, newWarn.MatchedFilter.FilterID); errCount != nil {
+		slog.Error("Failed to update filter trigger count", slog.String("error", errCount.Error()))
+	}
 
-func (u *Chat) check(now time.Time) {
-	u.warningMu.Lock()
-	defer u.warningMu.Unlock()
-
-	for steamID := range u.warnings {
-		for warnIdx, warning := range u.warnings[steamID] {
-			if now.Sub(warning.CreatedOn) > u.matchTimeout {
-				if len(u.warnings[steamID]) > 1 {
-					u.warnings[steamID] = append(u.warnings[steamID][:warnIdx], u.warnings[steamID][warnIdx+1])
-				} else {
-					delete(u.warnings, steamID)
-				}
-			}
-		}
+	if newWarn.Action == nil {
+		return nil
+	}
 
-		var newSum int32
-		for idx := range u.warnings[steamID] {
-			newSum += u.warnings[steamID][idx].MatchedFilter.Weight
-			u.warnings[steamID][idx].CurrentTotal = newSum
-		}
+	select {
+	case u.escalations <- pendingEscalation{step: step, warning: newWarn}:
+	default:
+		slog.Error("Chat escalation queue full, dropping escalation", slog.String("action", step.Action.String()),
+			slog.String("steam_id", newWarn.UserMessage.SteamID.String()))
 	}
+
+	return nil
 }
 
-func (u *Chat) trigger(ctx context.Context, newWarn NewUserWarning) {
-	if !newWarn.UserMessage.SteamID.Valid() {
-		return
-	}
+// WarningState returns the active warnings of all players keyed by their steam id. A string key is used so its
+// more easily portable to frontend js w/o using BigInt.
+func (u *Chat) WarningState(ctx context.Context) (map[string][]UserWarning, error) {
+	now := time.Now()
 
-	if u.dry {
-		return
+	warnings, errWarnings := u.repository.Warnings(ctx, WarningsQuery{Since: u.warningsSince(now)})
+	if errWarnings != nil {
+		return nil, errWarnings
 	}
 
-	u.warningMu.Lock()
+	out := make(map[string][]UserWarning)
 
-	_, found := u.warnings[newWarn.UserMessage.SteamID]
-	if !found {
-		u.warnings[newWarn.UserMessage.SteamID] = []UserWarning{}
+	for _, warning := range ApplyDecay(warnings, now, u.warningTimeout()) {
+		steamID := steamid.New(warning.SteamID)
+		key := steamID.String()
+		out[key] = append(out[key], warning)
 	}
 
-	var (
-		currentWeight = newWarn.MatchedFilter.Weight
-		count         int
-	)
+	return out, nil
+}
 
-	for _, existing := range u.warnings[newWarn.UserMessage.SteamID] {
-		currentWeight += existing.MatchedFilter.Weight
-		count++
+// Warnings returns the full warning history of a player, including expired and pardoned warnings.
+func (u *Chat) Warnings(ctx context.Context, steamID steamid.SteamID) ([]UserWarning, error) {
+	warnings, errWarnings := u.repository.Warnings(ctx, WarningsQuery{SteamID: steamID, IncludePardoned: true})
+	if errWarnings != nil {
+		return nil, errWarnings
 	}
 
-	newWarn.CurrentTotal = currentWeight + newWarn.MatchedFilter.Weight
-	newWarn.MatchedFilter.TriggerCount++
+	return ApplyDecay(warnings, time.Now(), u.warningTimeout()), nil
+}
 
-	u.warnings[newWarn.UserMessage.SteamID] = append(u.warnings[newWarn.UserMessage.SteamID], newWarn.UserWarning)
-	u.warningMu.Unlock()
+func (u *Chat) PardonWarning(ctx context.Context, warningID int64, moderator steamid.SteamID) error {
+	return u.repository.PardonWarning(ctx, warningID, moderator)
+}
 
-	if err := u.saveWarning(ctx, newWarn); err != nil {
-		slog.Error("Failed to execute warning handler", slog.String("error", err.Error()))
+func (u *Chat) ClearWarnings(ctx context.Context, steamID steamid.SteamID) error {
+	return u.repository.ClearWarnings(ctx, steamID)
+}
 
-		return
-	}
+func (u *Chat) EscalationSteps(ctx context.Context) ([]EscalationStep, error) {
+	return u.repository.EscalationSteps(ctx)
+}
 
-	if errExceed := u.exceedHandler(ctx, currentWeight > u.maxWeight, newWarn); errExceed != nil {
-		slog.Error("Failed to execute exceed handler", slog.String("error", errExceed.Error()))
+func (u *Chat) SaveEscalationSteps(ctx context.Context, steps []EscalationStep) error {
+	for idx, step := range steps {
+		if err := step.Validate(); err != nil {
+			return err
+		}
 
-		return
+		if slices.ContainsFunc(steps[:idx], func(existing EscalationStep) bool { return existing.Weight == step.Weight }) {
+			return ErrInvalidEscalation
+		}
 	}
+
+	return u.repository.SaveEscalationSteps(ctx, steps)
 }
 
 func (u *Chat) GetPersonMessageByID(ctx context.Context, personMessageID int64) (Message, error) {
 	return u.repository.GetPersonMessageByID(ctx, personMessageID)
 }
 
-func (u *Chat) WarningState() map[string][]UserWarning {
-	return u.State()
-}
-
 func (u *Chat) GetPersonMessage(ctx context.Context, messageID int64) (*QueryChatHistoryResult, error) {
 	return u.repository.GetPersonMessage(ctx, messageID)
 }
@@ -357,6 +394,17 @@ func (u *Chat) AddChatHistory(ctx context.Context, message *Message) error {
 	return u.repository.AddChatHistory(ctx, message)
 }
 
+// AddDemoChatHistory records a message parsed from a demo. When the message was already recorded from the
+// live server logs, the existing message is linked to the demo instead of creating a duplicate.
+func (u *Chat) AddDemoChatHistory(ctx context.Context, message *Message) error {
+	errLink := u.repository.LinkDemoMessage(ctx, message, demoMessageWindow)
+	if errLink == nil || !errors.Is(errLink, database.ErrNoResult) {
+		return errLink
+	}
+
+	return u.repository.AddChatHistory(ctx, message)
+}
+
 func (u *Chat) QueryChatHistory(ctx context.Context, permissions permission.Privilege, req HistoryQueryFilter) ([]*QueryChatHistoryResult, error) {
 	if req.Limit <= 0 || (req.Limit > 100 && permissions < permission.Moderator) {
 		req.Limit = 100
@@ -9,6 +9,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/gofrs/uuid/v5"
+	"github.com/jackc/pgx/v5"
 	"github.com/leighmacdonald/gbans/internal/database"
 	"github.com/leighmacdonald/gbans/internal/datetime"
 	"github.com/leighmacdonald/gbans/internal/httphelper"
@@ -35,15 +36,22 @@ func (s SlurMessage) Text() string {
 }
 
 type MessageProvider struct {
-	Db     database.Database
+	Db database.Database
+	// Desc returns the most recent messages first.
+	Desc   bool
 	offset int
 }
 
 func (m *MessageProvider) Next(ctx context.Context, count uint64) ([]slur.Message, error) {
+	order := "ASC"
+	if m.Desc {
+		order = "DESC"
+	}
+
 	rows, errRows := m.Db.Query(ctx,
 		`SELECT steam_id, person_message_id, body
 		   FROM person_messages
-		  ORDER BY person_message_id OFFSET $1 LIMIT $2`, m.offset, count)
+		  ORDER BY person_message_id `+order+` OFFSET $1 LIMIT $2`, m.offset, count)
 	if errRows != nil {
 		if errors.Is(errRows, database.ErrNoResult) {
 			return nil, nil
@@ -109,20 +117,31 @@ func (r Repository) TopChatters(ctx context.Context, count uint64) ([]TopChatter
 
 const minQueryLen = 2
 
+// DeleteByDemoID removes the messages imported from the demo. Live messages are kept, only their link to the
+// demo is removed.
 func (r Repository) DeleteByDemoID(ctx context.Context, demoID int32) error {
-	return database.Err(r.ExecDeleteBuilder(ctx, r.Builder().
-		Delete("person_messages").Where(sq.Eq{"demo_id": demoID})))
+	return database.Err(r.WrapTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM person_messages WHERE demo_id = $1 AND NOT live`, demoID); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(ctx, `
+			UPDATE person_messages SET demo_id = NULL, demo_tick = NULL, match_id = NULL
+			WHERE demo_id = $1 AND live`, demoID)
+
+		return err
+	}))
 }
 
 func (r Repository) AddChatHistory(ctx context.Context, message *Message) error {
 	const query = `INSERT INTO person_messages
-    		(steam_id, server_id, body, created_on, persona_name, demo_id, demo_tick, match_id)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    		(steam_id, server_id, body, created_on, persona_name, demo_id, demo_tick, match_id, live)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 			RETURNING person_message_id`
 
 	if errScan := r.
 		QueryRow(ctx, query, message.SteamID.Int64(), message.ServerID, message.Body,
-			message.CreatedOn, message.PersonaName, message.DemoID, message.DemoTick, message.MatchID).
+			message.CreatedOn, message.PersonaName, message.DemoID, message.DemoTick, message.MatchID, message.Live).
 		Scan(&message.PersonMessageID); errScan != nil {
 		return database.Err(errScan)
 	}
@@ -130,6 +149,34 @@ func (r Repository) AddChatHistory(ctx context.Context, message *Message) error
 	return nil
 }
 
+// LinkDemoMessage attaches the demo details of the message to the closest matching live message sent by the
+// same player on the same server within the window. Returns ErrNoResult when there is no live message.
+func (r Repository) LinkDemoMessage(ctx context.Context, message *Message, window time.Duration) error {
+	const query = `
+		UPDATE person_messages m SET demo_id = $5, demo_tick = $6, match_id = $7
+		FROM (
+			SELECT person_message_id FROM person_messages
+			WHERE server_id = $1 AND steam_id = $2 AND body = $3 AND live AND demo_id IS NULL
+			  AND created_on BETWEEN $4::timestamptz - make_interval(secs => $8) AND $4::timestamptz + make_interval(secs => $8)
+			ORDER BY abs(extract(EPOCH FROM created_on - $4::timestamptz))
+			LIMIT 1
+			FOR UPDATE
+		) live
+		WHERE m.person_message_id = live.person_message_id
+		RETURNING m.person_message_id, m.created_on`
+
+	if errScan := r.
+		QueryRow(ctx, query, message.ServerID, message.SteamID.Int64(), message.Body, message.CreatedOn,
+			message.DemoID, message.DemoTick, message.MatchID, window.Seconds()).
+		Scan(&message.PersonMessageID, &message.CreatedOn); errScan != nil {
+		return database.Err(errScan)
+	}
+
+	message.Live = true
+
+	return nil
+}
+
 func (r Repository) GetPersonMessageByID(ctx context.Context, personMessageID int64) (Message, error) {
 	var msg Message
 
@@ -0,0 +1,135 @@
+package chat_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/asset"
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/chat"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/demo"
+	"github.com/leighmacdonald/gbans/internal/notification"
+	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/leighmacdonald/gbans/pkg/stringutil"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+// fixture provides a shared set of common dependencies that can be used for integration testing.
+var fixture *tests.Fixture //nolint:gochecknoglobals
+
+func TestMain(m *testing.M) {
+	fixture = tests.NewFixture()
+	defer fixture.Close()
+
+	m.Run()
+}
+
+func TestAddDemoChatHistory(t *testing.T) {
+	var (
+		ctx     = t.Context()
+		server  = fixture.CreateTestServer(ctx)
+		player  = fixture.CreateTestPerson(ctx, steamid.RandSID64(), permission.User)
+		filters = chat.NewWordFilters(chat.NewWordFilterRepository(fixture.Database), notification.NewDiscard(), fixture.Config.Config().Filters)
+		chats   = chat.New(chat.NewRepository(fixture.Database), fixture.Config.Config().Filters, filters, fixture.Persons,
+			notification.NewDiscard(), nil, "")
+		demoID  = createTestDemo(t, server.ServerID, player.SteamID)
+		started = time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	)
+
+	live := chat.Message{
+		SteamID: player.SteamID, PersonaName: "player", ServerID: server.ServerID, Body: "gg",
+		CreatedOn: started.Add(time.Minute), Live: true,
+	}
+	require.NoError(t, chats.AddChatHistory(ctx, &live))
+
+	demoMessages := func() (chat.Message, chat.Message, chat.Message) {
+		var (
+			matched = chat.Message{
+				SteamID: player.SteamID, PersonaName: "player", ServerID: server.ServerID, Body: live.Body,
+				CreatedOn: live.CreatedOn.Add(time.Second * 20), DemoID: &demoID, DemoTick: new(int32(100)),
+			}
+			demoOnly = chat.Message{
+				SteamID: player.SteamID, PersonaName: "player", ServerID: server.ServerID, Body: "only in the demo",
+				CreatedOn: live.CreatedOn.Add(time.Second * 30), DemoID: &demoID, DemoTick: new(int32(200)),
+			}
+			// Same text as the live message but outside the window, so its another message.
+			later = chat.Message{
+				SteamID: player.SteamID, PersonaName: "player", ServerID: server.ServerID, Body: live.Body,
+				CreatedOn: live.CreatedOn.Add(time.Minute * 10), DemoID: &demoID, DemoTick: new(int32(300)),
+			}
+		)
+
+		require.NoError(t, chats.AddDemoChatHistory(ctx, &matched))
+		require.NoError(t, chats.AddDemoChatHistory(ctx, &demoOnly))
+		require.NoError(t, chats.AddDemoChatHistory(ctx, &later))
+
+		return matched, demoOnly, later
+	}
+
+	matched, demoOnly, later := demoMessages()
+
+	// The demo line is linked to the live message, keeping the live time.
+	require.Equal(t, live.PersonMessageID, matched.PersonMessageID)
+	require.True(t, matched.Live)
+	require.WithinDuration(t, live.CreatedOn, matched.CreatedOn, time.Millisecond)
+
+	linked, errLinked := chats.GetPersonMessageByID(ctx, live.PersonMessageID)
+	require.NoError(t, errLinked)
+	require.Equal(t, demoID, *linked.DemoID)
+	require.Equal(t, int32(100), *linked.DemoTick)
+
+	require.NotEqual(t, live.PersonMessageID, demoOnly.PersonMessageID)
+	require.False(t, demoOnly.Live)
+	require.NotEqual(t, live.PersonMessageID, later.PersonMessageID)
+	require.Equal(t, 3, countMessages(ctx, t, server.ServerID))
+
+	// Removing the demo drops the demo only messages and unlinks the live one.
+	require.NoError(t, chats.DeleteByDemoID(ctx, demoID))
+	require.Equal(t, 1, countMessages(ctx, t, server.ServerID))
+
+	_, errDeleted := chats.GetPersonMessageByID(ctx, demoOnly.PersonMessageID)
+	require.ErrorIs(t, errDeleted, database.ErrNoResult)
+
+	unlinked, errUnlinked := chats.GetPersonMessageByID(ctx, live.PersonMessageID)
+	require.NoError(t, errUnlinked)
+	require.Nil(t, unlinked.DemoID)
+	require.Nil(t, unlinked.DemoTick)
+
+	// A re-import links the same live message again instead of duplicating it.
+	rematched, _, _ := demoMessages()
+	require.Equal(t, live.PersonMessageID, rematched.PersonMessageID)
+	require.Equal(t, 3, countMessages(ctx, t, server.ServerID))
+}
+
+func createTestDemo(t *testing.T, serverID int32, author steamid.SteamID) int32 {
+	t.Helper()
+
+	var (
+		assets = asset.NewAssets(asset.NewLocalRepository(fixture.Database, t.TempDir()))
+		name   = "20231112-063943-" + stringutil.SecureRandomString(8) + ".dem"
+	)
+
+	demoAsset, errAsset := assets.Create(t.Context(), author, asset.BucketDemo, name, bytes.NewReader([]byte(name)), false)
+	require.NoError(t, errAsset)
+
+	demoFile := demo.File{
+		ServerID: serverID, Title: name, CreatedOn: time.Now(), MapName: "koth_harvest_final",
+		Stats: map[string]map[string]any{}, AssetID: demoAsset.AssetID,
+	}
+	require.NoError(t, demo.NewRepository(fixture.Database).SaveDemo(t.Context(), &demoFile))
+
+	return demoFile.DemoID
+}
+
+func countMessages(ctx context.Context, t *testing.T, serverID int32) int {
+	t.Helper()
+
+	var count int
+	require.NoError(t, fixture.Database.QueryRow(ctx, `SELECT count(*) FROM person_messages WHERE server_id = $1`, serverID).Scan(&count))
+
+	return count
+}
@@ -52,6 +52,24 @@ const (
 	// WordfilterServiceFilterMatchProcedure is the fully-qualified name of the WordfilterService's
 	// FilterMatch RPC.
 	WordfilterServiceFilterMatchProcedure = "/chat.v1.WordfilterService/FilterMatch"
+	// WordfilterServiceFilterTestProcedure is the fully-qualified name of the WordfilterService's
+	// FilterTest RPC.
+	WordfilterServiceFilterTestProcedure = "/chat.v1.WordfilterService/FilterTest"
+	// WordfilterServiceWarningsProcedure is the fully-qualified name of the WordfilterService's
+	// Warnings RPC.
+	WordfilterServiceWarningsProcedure = "/chat.v1.WordfilterService/Warnings"
+	// WordfilterServiceWarningPardonProcedure is the fully-qualified name of the WordfilterService's
+	// WarningPardon RPC.
+	WordfilterServiceWarningPardonProcedure = "/chat.v1.WordfilterService/WarningPardon"
+	// WordfilterServiceWarningsClearProcedure is the fully-qualified name of the WordfilterService's
+	// WarningsClear RPC.
+	WordfilterServiceWarningsClearProcedure = "/chat.v1.WordfilterService/WarningsClear"
+	// WordfilterServiceEscalationStepsProcedure is the fully-qualified name of the WordfilterService's
+	// EscalationSteps RPC.
+	WordfilterServiceEscalationStepsProcedure = "/chat.v1.WordfilterService/EscalationSteps"
+	// WordfilterServiceEscalationStepsSaveProcedure is the fully-qualified name of the
+	// WordfilterService's EscalationStepsSave RPC.
+	WordfilterServiceEscalationStepsSaveProcedure = "/chat.v1.WordfilterService/EscalationStepsSave"
 )
 
 // WordfilterServiceClient is a client for the chat.v1.WordfilterService service.
@@ -62,6 +80,17 @@ type WordfilterServiceClient interface {
 	FilterEdit(context.Context, *v1.FilterEditRequest) (*v1.FilterEditResponse, error)
 	FilterDelete(context.Context, *v1.FilterDeleteRequest) (*emptypb.Empty, error)
 	FilterMatch(context.Context, *v1.FilterMatchRequest) (*v1.FilterMatchResponse, error)
+	// Run a filter against the most recent messages to estimate how often it would trigger before enabling it.
+	FilterTest(context.Context, *v1.FilterTestRequest) (*v1.FilterTestResponse, error)
+	// Full warning history of a player, including expired and pardoned warnings.
+	Warnings(context.Context, *v1.WarningsRequest) (*v1.WarningsResponse, error)
+	// Stop a warning from counting towards the players total while keeping it in their history.
+	WarningPardon(context.Context, *v1.WarningPardonRequest) (*emptypb.Empty, error)
+	// Remove all warnings of a player.
+	WarningsClear(context.Context, *v1.WarningsClearRequest) (*emptypb.Empty, error)
+	EscalationSteps(context.Context, *emptypb.Empty) (*v1.EscalationStepsResponse, error)
+	// Replace the escalation steps.
+	EscalationStepsSave(context.Context, *v1.EscalationStepsSaveRequest) (*v1.EscalationStepsResponse, error)
 }
 
 // NewWordfilterServiceClient constructs a client for the chat.v1.WordfilterService service. By
@@ -111,17 +140,59 @@ func NewWordfilterServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(wordfilterServiceMethods.ByName("FilterMatch")),
 			connect.WithClientOptions(opts...),
 		),
+		filterTest: connect.NewClient[v1.FilterTestRequest, v1.FilterTestResponse](
+			httpClient,
+			baseURL+WordfilterServiceFilterTestProcedure,
+			connect.WithSchema(wordfilterServiceMethods.ByName("FilterTest")),
+			connect.WithClientOptions(opts...),
+		),
+		warnings: connect.NewClient[v1.WarningsRequest, v1.WarningsResponse](
+			httpClient,
+			baseURL+WordfilterServiceWarningsProcedure,
+			connect.WithSchema(wordfilterServiceMethods.ByName("Warnings")),
+			connect.WithClientOptions(opts...),
+		),
+		warningPardon: connect.NewClient[v1.WarningPardonRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WordfilterServiceWarningPardonProcedure,
+			connect.WithSchema(wordfilterServiceMethods.ByName("WarningPardon")),
+			connect.WithClientOptions(opts...),
+		),
+		warningsClear: connect.NewClient[v1.WarningsClearRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WordfilterServiceWarningsClearProcedure,
+			connect.WithSchema(wordfilterServiceMethods.ByName("WarningsClear")),
+			connect.WithClientOptions(opts...),
+		),
+		escalationSteps: connect.NewClient[emptypb.Empty, v1.EscalationStepsResponse](
+			httpClient,
+			baseURL+WordfilterServiceEscalationStepsProcedure,
+			connect.WithSchema(wordfilterServiceMethods.ByName("EscalationSteps")),
+			connect.WithClientOptions(opts...),
+		),
+		escalationStepsSave: connect.NewClient[v1.EscalationStepsSaveRequest, v1.EscalationStepsResponse](
+			httpClient,
+			baseURL+WordfilterServiceEscalationStepsSaveProcedure,
+			connect.WithSchema(wordfilterServiceMethods.ByName("EscalationStepsSave")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // wordfilterServiceClient implements WordfilterServiceClient.
 type wordfilterServiceClient struct {
-	filters      *connect.Client[emptypb.Empty, v1.FiltersResponse]
-	warningState *connect.Client[emptypb.Empty, v1.WarningStateResponse]
-	filterCreate *connect.Client[v1.FilterCreateRequest, v1.FilterCreateResponse]
-	filterEdit   *connect.Client[v1.FilterEditRequest, v1.FilterEditResponse]
-	filterDelete *connect.Client[v1.FilterDeleteRequest, emptypb.Empty]
-	filterMatch  *connect.Client[v1.FilterMatchRequest, v1.FilterMatchResponse]
+	filters             *connect.Client[emptypb.Empty, v1.FiltersResponse]
+	warningState        *connect.Client[emptypb.Empty, v1.WarningStateResponse]
+	filterCreate        *connect.Client[v1.FilterCreateRequest, v1.FilterCreateResponse]
+	filterEdit          *connect.Client[v1.FilterEditRequest, v1.FilterEditResponse]
+	filterDelete        *connect.Client[v1.FilterDeleteRequest, emptypb.Empty]
+	filterMatch         *connect.Client[v1.FilterMatchRequest, v1.FilterMatchResponse]
+	filterTest          *connect.Client[v1.FilterTestRequest, v1.FilterTestResponse]
+	warnings            *connect.Client[v1.WarningsRequest, v1.WarningsResponse]
+	warningPardon       *connect.Client[v1.WarningPardonRequest, emptypb.Empty]
+	warningsClear       *connect.Client[v1.WarningsClearRequest, emptypb.Empty]
+	escalationSteps     *connect.Client[emptypb.Empty, v1.EscalationStepsResponse]
+	escalationStepsSave *connect.Client[v1.EscalationStepsSaveRequest, v1.EscalationStepsResponse]
 }
 
 // Filters calls chat.v1.WordfilterService.Filters.
@@ -178,6 +249,60 @@ func (c *wordfilterServiceClient) FilterMatch(ctx context.Context, req *v1.Filte
 	return nil, err
 }
 
+// FilterTest calls chat.v1.WordfilterService.FilterTest.
+func (c *wordfilterServiceClient) FilterTest(ctx context.Context, req *v1.FilterTestRequest) (*v1.FilterTestResponse, error) {
+	response, err := c.filterTest.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Warnings calls chat.v1.WordfilterService.Warnings.
+func (c *wordfilterServiceClient) Warnings(ctx context.Context, req *v1.WarningsRequest) (*v1.WarningsResponse, error) {
+	response, err := c.warnings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// WarningPardon calls chat.v1.WordfilterService.WarningPardon.
+func (c *wordfilterServiceClient) WarningPardon(ctx context.Context, req *v1.WarningPardonRequest) (*emptypb.Empty, error) {
+	response, err := c.warningPardon.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// WarningsClear calls chat.v1.WordfilterService.WarningsClear.
+func (c *wordfilterServiceClient) WarningsClear(ctx context.Context, req *v1.WarningsClearRequest) (*emptypb.Empty, error) {
+	response, err := c.warningsClear.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EscalationSteps calls chat.v1.WordfilterService.EscalationSteps.
+func (c *wordfilterServiceClient) EscalationSteps(ctx context.Context, req *emptypb.Empty) (*v1.EscalationStepsResponse, error) {
+	response, err := c.escalationSteps.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// EscalationStepsSave calls chat.v1.WordfilterService.EscalationStepsSave.
+func (c *wordfilterServiceClient) EscalationStepsSave(ctx context.Context, req *v1.EscalationStepsSaveRequest) (*v1.EscalationStepsResponse, error) {
+	response, err := c.escalationStepsSave.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // WordfilterServiceHandler is an implementation of the chat.v1.WordfilterService service.
 type WordfilterServiceHandler interface {
 	Filters(context.Context, *emptypb.Empty) (*v1.FiltersResponse, error)
@@ -186,6 +311,17 @@ type WordfilterServiceHandler interface {
 	FilterEdit(context.Context, *v1.FilterEditRequest) (*v1.FilterEditResponse, error)
 	FilterDelete(context.Context, *v1.FilterDeleteRequest) (*emptypb.Empty, error)
 	FilterMatch(context.Context, *v1.FilterMatchRequest) (*v1.FilterMatchResponse, error)
+	// Run a filter against the most recent messages to estimate how often it would trigger before enabling it.
+	FilterTest(context.Context, *v1.FilterTestRequest) (*v1.FilterTestResponse, error)
+	// Full warning history of a player, including expired and pardoned warnings.
+	Warnings(context.Context, *v1.WarningsRequest) (*v1.WarningsResponse, error)
+	// Stop a warning from counting towards the players total while keeping it in their history.
+	WarningPardon(context.Context, *v1.WarningPardonRequest) (*emptypb.Empty, error)
+	// Remove all warnings of a player.
+	WarningsClear(context.Context, *v1.WarningsClearRequest) (*emptypb.Empty, error)
+	EscalationSteps(context.Context, *emptypb.Empty) (*v1.EscalationStepsResponse, error)
+	// Replace the escalation steps.
+	EscalationStepsSave(context.Context, *v1.EscalationStepsSaveRequest) (*v1.EscalationStepsResponse, error)
 }
 
 // NewWordfilterServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -231,6 +367,42 @@ func NewWordfilterServiceHandler(svc WordfilterServiceHandler, opts ...connect.H
 		connect.WithSchema(wordfilterServiceMethods.ByName("FilterMatch")),
 		connect.WithHandlerOptions(opts...),
 	)
+	wordfilterServiceFilterTestHandler := connect.NewUnaryHandlerSimple(
+		WordfilterServiceFilterTestProcedure,
+		svc.FilterTest,
+		connect.WithSchema(wordfilterServiceMethods.ByName("FilterTest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordfilterServiceWarningsHandler := connect.NewUnaryHandlerSimple(
+		WordfilterServiceWarningsProcedure,
+		svc.Warnings,
+		connect.WithSchema(wordfilterServiceMethods.ByName("Warnings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordfilterServiceWarningPardonHandler := connect.NewUnaryHandlerSimple(
+		WordfilterServiceWarningPardonProcedure,
+		svc.WarningPardon,
+		connect.WithSchema(wordfilterServiceMethods.ByName("WarningPardon")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordfilterServiceWarningsClearHandler := connect.NewUnaryHandlerSimple(
+		WordfilterServiceWarningsClearProcedure,
+		svc.WarningsClear,
+		connect.WithSchema(wordfilterServiceMethods.ByName("WarningsClear")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordfilterServiceEscalationStepsHandler := connect.NewUnaryHandlerSimple(
+		WordfilterServiceEscalationStepsProcedure,
+		svc.EscalationSteps,
+		connect.WithSchema(wordfilterServiceMethods.ByName("EscalationSteps")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordfilterServiceEscalationStepsSaveHandler := connect.NewUnaryHandlerSimple(
+		WordfilterServiceEscalationStepsSaveProcedure,
+		svc.EscalationStepsSave,
+		connect.WithSchema(wordfilterServiceMethods.ByName("EscalationStepsSave")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/chat.v1.WordfilterService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WordfilterServiceFiltersProcedure:
@@ -245,6 +417,18 @@ func NewWordfilterServiceHandler(svc WordfilterServiceHandler, opts ...connect.H
 			wordfilterServiceFilterDeleteHandler.ServeHTTP(w, r)
 		case WordfilterServiceFilterMatchProcedure:
 			wordfilterServiceFilterMatchHandler.ServeHTTP(w, r)
+		case WordfilterServiceFilterTestProcedure:
+			wordfilterServiceFilterTestHandler.ServeHTTP(w, r)
+		case WordfilterServiceWarningsProcedure:
+			wordfilterServiceWarningsHandler.ServeHTTP(w, r)
+		case WordfilterServiceWarningPardonProcedure:
+			wordfilterServiceWarningPardonHandler.ServeHTTP(w, r)
+		case WordfilterServiceWarningsClearProcedure:
+			wordfilterServiceWarningsClearHandler.ServeHTTP(w, r)
+		case WordfilterServiceEscalationStepsProcedure:
+			wordfilterServiceEscalationStepsHandler.ServeHTTP(w, r)
+		case WordfilterServiceEscalationStepsSaveProcedure:
+			wordfilterServiceEscalationStepsSaveHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -277,3 +461,27 @@ func (UnimplementedWordfilterServiceHandler) FilterDelete(context.Context, *v1.F
 func (UnimplementedWordfilterServiceHandler) FilterMatch(context.Context, *v1.FilterMatchRequest) (*v1.FilterMatchResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat.v1.WordfilterService.FilterMatch is not implemented"))
 }
+
+func (UnimplementedWordfilterServiceHandler) FilterTest(context.Context, *v1.FilterTestRequest) (*v1.FilterTestResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat.v1.WordfilterService.FilterTest is not implemented"))
+}
+
+func (UnimplementedWordfilterServiceHandler) Warnings(context.Context, *v1.WarningsRequest) (*v1.WarningsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat.v1.WordfilterService.Warnings is not implemented"))
+}
+
+func (UnimplementedWordfilterServiceHandler) WarningPardon(context.Context, *v1.WarningPardonRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat.v1.WordfilterService.WarningPardon is not implemented"))
+}
+
+func (UnimplementedWordfilterServiceHandler) WarningsClear(context.Context, *v1.WarningsClearRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat.v1.WordfilterService.WarningsClear is not implemented"))
+}
+
+func (UnimplementedWordfilterServiceHandler) EscalationSteps(context.Context, *emptypb.Empty) (*v1.EscalationStepsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat.v1.WordfilterService.EscalationSteps is not implemented"))
+}
+
+func (UnimplementedWordfilterServiceHandler) EscalationStepsSave(context.Context, *v1.EscalationStepsSaveRequest) (*v1.EscalationStepsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("chat.v1.WordfilterService.EscalationStepsSave is not implemented"))
+}
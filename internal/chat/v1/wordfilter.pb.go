@@ -25,6 +25,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Ordered from least to most severe.
+type EscalationAction int32
+
+const (
+	EscalationAction_ESCALATION_ACTION_WARN_UNSPECIFIED EscalationAction = 0
+	EscalationAction_ESCALATION_ACTION_GAG              EscalationAction = 1
+	EscalationAction_ESCALATION_ACTION_KICK             EscalationAction = 2
+	EscalationAction_ESCALATION_ACTION_MUTE             EscalationAction = 3
+	EscalationAction_ESCALATION_ACTION_BAN              EscalationAction = 4
+)
+
+// Enum value maps for EscalationAction.
+var (
+	EscalationAction_name = map[int32]string{
+		0: "ESCALATION_ACTION_WARN_UNSPECIFIED",
+		1: "ESCALATION_ACTION_GAG",
+		2: "ESCALATION_ACTION_KICK",
+		3: "ESCALATION_ACTION_MUTE",
+		4: "ESCALATION_ACTION_BAN",
+	}
+	EscalationAction_value = map[string]int32{
+		"ESCALATION_ACTION_WARN_UNSPECIFIED": 0,
+		"ESCALATION_ACTION_GAG":              1,
+		"ESCALATION_ACTION_KICK":             2,
+		"ESCALATION_ACTION_MUTE":             3,
+		"ESCALATION_ACTION_BAN":              4,
+	}
+)
+
+func (x EscalationAction) Enum() *EscalationAction {
+	p := new(EscalationAction)
+	*p = x
+	return p
+}
+
+func (x EscalationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EscalationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_chat_v1_wordfilter_proto_enumTypes[0].Descriptor()
+}
+
+func (EscalationAction) Type() protoreflect.EnumType {
+	return &file_chat_v1_wordfilter_proto_enumTypes[0]
+}
+
+func (x EscalationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EscalationAction.Descriptor instead.
+func (EscalationAction) EnumDescriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{0}
+}
+
 type FilterAction int32
 
 const (
@@ -58,20 +114,346 @@ func (x FilterAction) String() string {
 }
 
 func (FilterAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_chat_v1_wordfilter_proto_enumTypes[0].Descriptor()
+	return file_chat_v1_wordfilter_proto_enumTypes[1].Descriptor()
 }
 
 func (FilterAction) Type() protoreflect.EnumType {
-	return &file_chat_v1_wordfilter_proto_enumTypes[0]
+	return &file_chat_v1_wordfilter_proto_enumTypes[1]
+}
+
+func (x FilterAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FilterAction.Descriptor instead.
+func (FilterAction) EnumDescriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{1}
+}
+
+type WarningsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SteamId       *int64                 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarningsRequest) Reset() {
+	*x = WarningsRequest{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarningsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarningsRequest) ProtoMessage() {}
+
+func (x *WarningsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarningsRequest.ProtoReflect.Descriptor instead.
+func (*WarningsRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WarningsRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+type WarningsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warnings      []*UserWarning         `protobuf:"bytes,1,rep,name=warnings" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarningsResponse) Reset() {
+	*x = WarningsResponse{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarningsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarningsResponse) ProtoMessage() {}
+
+func (x *WarningsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarningsResponse.ProtoReflect.Descriptor instead.
+func (*WarningsResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WarningsResponse) GetWarnings() []*UserWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type WarningPardonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarningId     *int64                 `protobuf:"varint,1,opt,name=warning_id,json=warningId" json:"warning_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarningPardonRequest) Reset() {
+	*x = WarningPardonRequest{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarningPardonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarningPardonRequest) ProtoMessage() {}
+
+func (x *WarningPardonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarningPardonRequest.ProtoReflect.Descriptor instead.
+func (*WarningPardonRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WarningPardonRequest) GetWarningId() int64 {
+	if x != nil && x.WarningId != nil {
+		return *x.WarningId
+	}
+	return 0
+}
+
+type WarningsClearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SteamId       *int64                 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarningsClearRequest) Reset() {
+	*x = WarningsClearRequest{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarningsClearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarningsClearRequest) ProtoMessage() {}
+
+func (x *WarningsClearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarningsClearRequest.ProtoReflect.Descriptor instead.
+func (*WarningsClearRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WarningsClearRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+// Applied once the decayed total weight of a players warnings reaches the weight.
+type EscalationStep struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Weight *int32                 `protobuf:"varint,1,opt,name=weight" json:"weight,omitempty"`
+	Action *EscalationAction      `protobuf:"varint,2,opt,name=action,enum=chat.v1.EscalationAction" json:"action,omitempty"`
+	// Duration of mute and ban actions.
+	Duration      *string `protobuf:"bytes,3,opt,name=duration" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationStep) Reset() {
+	*x = EscalationStep{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationStep) ProtoMessage() {}
+
+func (x *EscalationStep) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationStep.ProtoReflect.Descriptor instead.
+func (*EscalationStep) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EscalationStep) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *EscalationStep) GetAction() EscalationAction {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return EscalationAction_ESCALATION_ACTION_WARN_UNSPECIFIED
+}
+
+func (x *EscalationStep) GetDuration() string {
+	if x != nil && x.Duration != nil {
+		return *x.Duration
+	}
+	return ""
+}
+
+type EscalationStepsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Steps         []*EscalationStep      `protobuf:"bytes,1,rep,name=steps" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationStepsResponse) Reset() {
+	*x = EscalationStepsResponse{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationStepsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationStepsResponse) ProtoMessage() {}
+
+func (x *EscalationStepsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationStepsResponse.ProtoReflect.Descriptor instead.
+func (*EscalationStepsResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EscalationStepsResponse) GetSteps() []*EscalationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type EscalationStepsSaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Steps         []*EscalationStep      `protobuf:"bytes,1,rep,name=steps" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationStepsSaveRequest) Reset() {
+	*x = EscalationStepsSaveRequest{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationStepsSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationStepsSaveRequest) ProtoMessage() {}
+
+func (x *EscalationStepsSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x FilterAction) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use EscalationStepsSaveRequest.ProtoReflect.Descriptor instead.
+func (*EscalationStepsSaveRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{6}
 }
 
-// Deprecated: Use FilterAction.Descriptor instead.
-func (FilterAction) EnumDescriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{0}
+func (x *EscalationStepsSaveRequest) GetSteps() []*EscalationStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
 }
 
 type FilterMatchRequest struct {
@@ -83,7 +465,7 @@ type FilterMatchRequest struct {
 
 func (x *FilterMatchRequest) Reset() {
 	*x = FilterMatchRequest{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[0]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -95,7 +477,7 @@ func (x *FilterMatchRequest) String() string {
 func (*FilterMatchRequest) ProtoMessage() {}
 
 func (x *FilterMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[0]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,7 +490,7 @@ func (x *FilterMatchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterMatchRequest.ProtoReflect.Descriptor instead.
 func (*FilterMatchRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{0}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *FilterMatchRequest) GetQuery() string {
@@ -121,13 +503,14 @@ func (x *FilterMatchRequest) GetQuery() string {
 type FilterMatchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filters       []*Filter              `protobuf:"bytes,1,rep,name=filters" json:"filters,omitempty"`
+	Matches       []*FilterSpan          `protobuf:"bytes,2,rep,name=matches" json:"matches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FilterMatchResponse) Reset() {
 	*x = FilterMatchResponse{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[1]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -139,7 +522,7 @@ func (x *FilterMatchResponse) String() string {
 func (*FilterMatchResponse) ProtoMessage() {}
 
 func (x *FilterMatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[1]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +535,7 @@ func (x *FilterMatchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterMatchResponse.ProtoReflect.Descriptor instead.
 func (*FilterMatchResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{1}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FilterMatchResponse) GetFilters() []*Filter {
@@ -162,6 +545,262 @@ func (x *FilterMatchResponse) GetFilters() []*Filter {
 	return nil
 }
 
+func (x *FilterMatchResponse) GetMatches() []*FilterSpan {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// A single match of a filter. Offsets are byte offsets of the original message.
+type FilterSpan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FilterId      *int64                 `protobuf:"varint,1,opt,name=filter_id,json=filterId" json:"filter_id,omitempty"`
+	Start         *int32                 `protobuf:"varint,2,opt,name=start" json:"start,omitempty"`
+	End           *int32                 `protobuf:"varint,3,opt,name=end" json:"end,omitempty"`
+	Matched       *string                `protobuf:"bytes,4,opt,name=matched" json:"matched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterSpan) Reset() {
+	*x = FilterSpan{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterSpan) ProtoMessage() {}
+
+func (x *FilterSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterSpan.ProtoReflect.Descriptor instead.
+func (*FilterSpan) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FilterSpan) GetFilterId() int64 {
+	if x != nil && x.FilterId != nil {
+		return *x.FilterId
+	}
+	return 0
+}
+
+func (x *FilterSpan) GetStart() int32 {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return 0
+}
+
+func (x *FilterSpan) GetEnd() int32 {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	return 0
+}
+
+func (x *FilterSpan) GetMatched() string {
+	if x != nil && x.Matched != nil {
+		return *x.Matched
+	}
+	return ""
+}
+
+type FilterTestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *Filter                `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
+	Limit         *uint64                `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterTestRequest) Reset() {
+	*x = FilterTestRequest{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterTestRequest) ProtoMessage() {}
+
+func (x *FilterTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterTestRequest.ProtoReflect.Descriptor instead.
+func (*FilterTestRequest) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FilterTestRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *FilterTestRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type FilterTestSample struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PersonMessageId *int64                 `protobuf:"varint,1,opt,name=person_message_id,json=personMessageId" json:"person_message_id,omitempty"`
+	SteamId         *int64                 `protobuf:"varint,2,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	Body            *string                `protobuf:"bytes,3,opt,name=body" json:"body,omitempty"`
+	Matches         []*FilterSpan          `protobuf:"bytes,4,rep,name=matches" json:"matches,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FilterTestSample) Reset() {
+	*x = FilterTestSample{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterTestSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterTestSample) ProtoMessage() {}
+
+func (x *FilterTestSample) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterTestSample.ProtoReflect.Descriptor instead.
+func (*FilterTestSample) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FilterTestSample) GetPersonMessageId() int64 {
+	if x != nil && x.PersonMessageId != nil {
+		return *x.PersonMessageId
+	}
+	return 0
+}
+
+func (x *FilterTestSample) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+func (x *FilterTestSample) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *FilterTestSample) GetMatches() []*FilterSpan {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type FilterTestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checked       *int64                 `protobuf:"varint,1,opt,name=checked" json:"checked,omitempty"`
+	Matched       *int64                 `protobuf:"varint,2,opt,name=matched" json:"matched,omitempty"`
+	Samples       []*FilterTestSample    `protobuf:"bytes,3,rep,name=samples" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterTestResponse) Reset() {
+	*x = FilterTestResponse{}
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterTestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterTestResponse) ProtoMessage() {}
+
+func (x *FilterTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterTestResponse.ProtoReflect.Descriptor instead.
+func (*FilterTestResponse) Descriptor() ([]byte, []int) {
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FilterTestResponse) GetChecked() int64 {
+	if x != nil && x.Checked != nil {
+		return *x.Checked
+	}
+	return 0
+}
+
+func (x *FilterTestResponse) GetMatched() int64 {
+	if x != nil && x.Matched != nil {
+		return *x.Matched
+	}
+	return 0
+}
+
+func (x *FilterTestResponse) GetSamples() []*FilterTestSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
 type FilterDeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FilterId      *int64                 `protobuf:"varint,1,opt,name=filter_id,json=filterId" json:"filter_id,omitempty"`
@@ -171,7 +810,7 @@ type FilterDeleteRequest struct {
 
 func (x *FilterDeleteRequest) Reset() {
 	*x = FilterDeleteRequest{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[2]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -183,7 +822,7 @@ func (x *FilterDeleteRequest) String() string {
 func (*FilterDeleteRequest) ProtoMessage() {}
 
 func (x *FilterDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[2]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,7 +835,7 @@ func (x *FilterDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterDeleteRequest.ProtoReflect.Descriptor instead.
 func (*FilterDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{2}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *FilterDeleteRequest) GetFilterId() int64 {
@@ -215,7 +854,7 @@ type FilterCreateRequest struct {
 
 func (x *FilterCreateRequest) Reset() {
 	*x = FilterCreateRequest{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[3]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -227,7 +866,7 @@ func (x *FilterCreateRequest) String() string {
 func (*FilterCreateRequest) ProtoMessage() {}
 
 func (x *FilterCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[3]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +879,7 @@ func (x *FilterCreateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterCreateRequest.ProtoReflect.Descriptor instead.
 func (*FilterCreateRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{3}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *FilterCreateRequest) GetFilter() *Filter {
@@ -259,7 +898,7 @@ type FilterCreateResponse struct {
 
 func (x *FilterCreateResponse) Reset() {
 	*x = FilterCreateResponse{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[4]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -271,7 +910,7 @@ func (x *FilterCreateResponse) String() string {
 func (*FilterCreateResponse) ProtoMessage() {}
 
 func (x *FilterCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[4]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -284,7 +923,7 @@ func (x *FilterCreateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterCreateResponse.ProtoReflect.Descriptor instead.
 func (*FilterCreateResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{4}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FilterCreateResponse) GetFilter() *Filter {
@@ -303,7 +942,7 @@ type FilterEditRequest struct {
 
 func (x *FilterEditRequest) Reset() {
 	*x = FilterEditRequest{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[5]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -315,7 +954,7 @@ func (x *FilterEditRequest) String() string {
 func (*FilterEditRequest) ProtoMessage() {}
 
 func (x *FilterEditRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[5]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +967,7 @@ func (x *FilterEditRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterEditRequest.ProtoReflect.Descriptor instead.
 func (*FilterEditRequest) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{5}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *FilterEditRequest) GetFilter() *Filter {
@@ -347,7 +986,7 @@ type FilterEditResponse struct {
 
 func (x *FilterEditResponse) Reset() {
 	*x = FilterEditResponse{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[6]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +998,7 @@ func (x *FilterEditResponse) String() string {
 func (*FilterEditResponse) ProtoMessage() {}
 
 func (x *FilterEditResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[6]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +1011,7 @@ func (x *FilterEditResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterEditResponse.ProtoReflect.Descriptor instead.
 func (*FilterEditResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{6}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *FilterEditResponse) GetFilter() *Filter {
@@ -401,7 +1040,7 @@ type Filter struct {
 
 func (x *Filter) Reset() {
 	*x = Filter{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[7]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +1052,7 @@ func (x *Filter) String() string {
 func (*Filter) ProtoMessage() {}
 
 func (x *Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[7]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +1065,7 @@ func (x *Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Filter.ProtoReflect.Descriptor instead.
 func (*Filter) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{7}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Filter) GetFilterId() int64 {
@@ -515,7 +1154,7 @@ type FiltersResponse struct {
 
 func (x *FiltersResponse) Reset() {
 	*x = FiltersResponse{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[8]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +1166,7 @@ func (x *FiltersResponse) String() string {
 func (*FiltersResponse) ProtoMessage() {}
 
 func (x *FiltersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[8]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +1179,7 @@ func (x *FiltersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FiltersResponse.ProtoReflect.Descriptor instead.
 func (*FiltersResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{8}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *FiltersResponse) GetFilters() []*Filter {
@@ -551,25 +1190,31 @@ func (x *FiltersResponse) GetFilters() []*Filter {
 }
 
 type UserWarning struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        *v1.BanReason          `protobuf:"varint,1,opt,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
-	Message       *string                `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
-	Matched       *string                `protobuf:"bytes,3,opt,name=matched" json:"matched,omitempty"`
-	Filter        *Filter                `protobuf:"bytes,4,opt,name=filter" json:"filter,omitempty"`
-	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
-	PersonaName   *string                `protobuf:"bytes,6,opt,name=persona_name,json=personaName" json:"persona_name,omitempty"`
-	AvatarHash    *string                `protobuf:"bytes,7,opt,name=avatar_hash,json=avatarHash" json:"avatar_hash,omitempty"`
-	ServerName    *string                `protobuf:"bytes,8,opt,name=server_name,json=serverName" json:"server_name,omitempty"`
-	ServerId      *int32                 `protobuf:"varint,9,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
-	SteamId       *int64                 `protobuf:"varint,10,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
-	CurrentTotal  *int32                 `protobuf:"varint,11,opt,name=current_total,json=currentTotal" json:"current_total,omitempty"`
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Reason          *v1.BanReason          `protobuf:"varint,1,opt,name=reason,enum=ban.v1.BanReason" json:"reason,omitempty"`
+	Message         *string                `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	Matched         *string                `protobuf:"bytes,3,opt,name=matched" json:"matched,omitempty"`
+	Filter          *Filter                `protobuf:"bytes,4,opt,name=filter" json:"filter,omitempty"`
+	CreatedOn       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	PersonaName     *string                `protobuf:"bytes,6,opt,name=persona_name,json=personaName" json:"persona_name,omitempty"`
+	AvatarHash      *string                `protobuf:"bytes,7,opt,name=avatar_hash,json=avatarHash" json:"avatar_hash,omitempty"`
+	ServerName      *string                `protobuf:"bytes,8,opt,name=server_name,json=serverName" json:"server_name,omitempty"`
+	ServerId        *int32                 `protobuf:"varint,9,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	SteamId         *int64                 `protobuf:"varint,10,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	CurrentTotal    *int32                 `protobuf:"varint,11,opt,name=current_total,json=currentTotal" json:"current_total,omitempty"`
+	WarningId       *int64                 `protobuf:"varint,12,opt,name=warning_id,json=warningId" json:"warning_id,omitempty"`
+	PersonMessageId *int64                 `protobuf:"varint,13,opt,name=person_message_id,json=personMessageId" json:"person_message_id,omitempty"`
+	Weight          *int32                 `protobuf:"varint,14,opt,name=weight" json:"weight,omitempty"`
+	// Unset when no escalation step was applied.
+	Action        *EscalationAction `protobuf:"varint,15,opt,name=action,enum=chat.v1.EscalationAction" json:"action,omitempty"`
+	Pardoned      *bool             `protobuf:"varint,16,opt,name=pardoned" json:"pardoned,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UserWarning) Reset() {
 	*x = UserWarning{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[9]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +1226,7 @@ func (x *UserWarning) String() string {
 func (*UserWarning) ProtoMessage() {}
 
 func (x *UserWarning) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[9]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +1239,7 @@ func (x *UserWarning) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserWarning.ProtoReflect.Descriptor instead.
 func (*UserWarning) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{9}
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UserWarning) GetReason() v1.BanReason {
@@ -674,9 +1319,43 @@ func (x *UserWarning) GetCurrentTotal() int32 {
 	return 0
 }
 
+func (x *UserWarning) GetWarningId() int64 {
+	if x != nil && x.WarningId != nil {
+		return *x.WarningId
+	}
+	return 0
+}
+
+func (x *UserWarning) GetPersonMessageId() int64 {
+	if x != nil && x.PersonMessageId != nil {
+		return *x.PersonMessageId
+	}
+	return 0
+}
+
+func (x *UserWarning) GetWeight() int32 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+func (x *UserWarning) GetAction() EscalationAction {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return EscalationAction_ESCALATION_ACTION_WARN_UNSPECIFIED
+}
+
+func (x *UserWarning) GetPardoned() bool {
+	if x != nil && x.Pardoned != nil {
+		return *x.Pardoned
+	}
+	return false
+}
+
 type WarningStateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MaxWeight     *int32                 `protobuf:"varint,1,opt,name=max_weight,json=maxWeight" json:"max_weight,omitempty"`
 	Current       []*UserWarning         `protobuf:"bytes,2,rep,name=current" json:"current,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -684,7 +1363,7 @@ type WarningStateResponse struct {
 
 func (x *WarningStateResponse) Reset() {
 	*x = WarningStateResponse{}
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[10]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -696,7 +1375,7 @@ func (x *WarningStateResponse) String() string {
 func (*WarningStateResponse) ProtoMessage() {}
 
 func (x *WarningStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_v1_wordfilter_proto_msgTypes[10]
+	mi := &file_chat_v1_wordfilter_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,14 +1388,7 @@ func (x *WarningStateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WarningStateResponse.ProtoReflect.Descriptor instead.
 func (*WarningStateResponse) Descriptor() ([]byte, []int) {
-	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *WarningStateResponse) GetMaxWeight() int32 {
-	if x != nil && x.MaxWeight != nil {
-		return *x.MaxWeight
-	}
-	return 0
+	return file_chat_v1_wordfilter_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *WarningStateResponse) GetCurrent() []*UserWarning {
@@ -730,11 +1402,47 @@ var File_chat_v1_wordfilter_proto protoreflect.FileDescriptor
 
 const file_chat_v1_wordfilter_proto_rawDesc = "" +
 	"\n" +
-	"\x18chat/v1/wordfilter.proto\x12\achat.v1\x1a\x10ban/v1/ban.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"2\n" +
+	"\x18chat/v1/wordfilter.proto\x12\achat.v1\x1a\x10ban/v1/ban.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"6\n" +
+	"\x0fWarningsRequest\x12#\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\asteamId\"D\n" +
+	"\x10WarningsResponse\x120\n" +
+	"\bwarnings\x18\x01 \x03(\v2\x14.chat.v1.UserWarningR\bwarnings\"?\n" +
+	"\x14WarningPardonRequest\x12'\n" +
+	"\n" +
+	"warning_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\twarningId\";\n" +
+	"\x14WarningsClearRequest\x12#\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\asteamId\"\x80\x01\n" +
+	"\x0eEscalationStep\x12\x1f\n" +
+	"\x06weight\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\x06weight\x121\n" +
+	"\x06action\x18\x02 \x01(\x0e2\x19.chat.v1.EscalationActionR\x06action\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\tR\bduration\"H\n" +
+	"\x17EscalationStepsResponse\x12-\n" +
+	"\x05steps\x18\x01 \x03(\v2\x17.chat.v1.EscalationStepR\x05steps\"K\n" +
+	"\x1aEscalationStepsSaveRequest\x12-\n" +
+	"\x05steps\x18\x01 \x03(\v2\x17.chat.v1.EscalationStepR\x05steps\"2\n" +
 	"\x12FilterMatchRequest\x12\x1c\n" +
-	"\x05query\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05query\"H\n" +
+	"\x05query\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05query\"w\n" +
 	"\x13FilterMatchResponse\x121\n" +
-	"\afilters\x18\x01 \x03(\v2\x0f.chat.v1.FilterB\x06\xbaH\x03\xc8\x01\x01R\afilters\"<\n" +
+	"\afilters\x18\x01 \x03(\v2\x0f.chat.v1.FilterB\x06\xbaH\x03\xc8\x01\x01R\afilters\x12-\n" +
+	"\amatches\x18\x02 \x03(\v2\x13.chat.v1.FilterSpanR\amatches\"u\n" +
+	"\n" +
+	"FilterSpan\x12%\n" +
+	"\tfilter_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\bfilterId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x05R\x03end\x12\x18\n" +
+	"\amatched\x18\x04 \x01(\tR\amatched\"i\n" +
+	"\x11FilterTestRequest\x12/\n" +
+	"\x06filter\x18\x01 \x01(\v2\x0f.chat.v1.FilterB\x06\xbaH\x03\xc8\x01\x01R\x06filter\x12#\n" +
+	"\x05limit\x18\x02 \x01(\x04B\r\xbaH\b2\x06\x18\xa0\x8d\x06(\x010\x01R\x05limit\"\xa4\x01\n" +
+	"\x10FilterTestSample\x12.\n" +
+	"\x11person_message_id\x18\x01 \x01(\x03B\x020\x01R\x0fpersonMessageId\x12\x1d\n" +
+	"\bsteam_id\x18\x02 \x01(\x03B\x020\x01R\asteamId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12-\n" +
+	"\amatches\x18\x04 \x03(\v2\x13.chat.v1.FilterSpanR\amatches\"\x85\x01\n" +
+	"\x12FilterTestResponse\x12\x1c\n" +
+	"\achecked\x18\x01 \x01(\x03B\x020\x01R\achecked\x12\x1c\n" +
+	"\amatched\x18\x02 \x01(\x03B\x020\x01R\amatched\x123\n" +
+	"\asamples\x18\x03 \x03(\v2\x19.chat.v1.FilterTestSampleR\asamples\"<\n" +
 	"\x13FilterDeleteRequest\x12%\n" +
 	"\tfilter_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\bfilterId\"F\n" +
 	"\x13FilterCreateRequest\x12/\n" +
@@ -762,7 +1470,7 @@ const file_chat_v1_wordfilter_proto_rawDesc = "" +
 	"\n" +
 	"updated_on\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedOn\"D\n" +
 	"\x0fFiltersResponse\x121\n" +
-	"\afilters\x18\x01 \x03(\v2\x0f.chat.v1.FilterB\x06\xbaH\x03\xc8\x01\x01R\afilters\"\xec\x03\n" +
+	"\afilters\x18\x01 \x03(\v2\x0f.chat.v1.FilterB\x06\xbaH\x03\xc8\x01\x01R\afilters\"\xa6\x05\n" +
 	"\vUserWarning\x121\n" +
 	"\x06reason\x18\x01 \x01(\x0e2\x11.ban.v1.BanReasonB\x06\xbaH\x03\xc8\x01\x01R\x06reason\x12 \n" +
 	"\amessage\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\amessage\x12 \n" +
@@ -778,15 +1486,26 @@ const file_chat_v1_wordfilter_proto_rawDesc = "" +
 	"\tserver_id\x18\t \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\bserverId\x12#\n" +
 	"\bsteam_id\x18\n" +
 	" \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\asteamId\x12+\n" +
-	"\rcurrent_total\x18\v \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\fcurrentTotal\"u\n" +
-	"\x14WarningStateResponse\x12%\n" +
+	"\rcurrent_total\x18\v \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\fcurrentTotal\x12!\n" +
 	"\n" +
-	"max_weight\x18\x01 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\tmaxWeight\x126\n" +
-	"\acurrent\x18\x02 \x03(\v2\x14.chat.v1.UserWarningB\x06\xbaH\x03\xc8\x01\x01R\acurrent*a\n" +
+	"warning_id\x18\f \x01(\x03B\x020\x01R\twarningId\x12.\n" +
+	"\x11person_message_id\x18\r \x01(\x03B\x020\x01R\x0fpersonMessageId\x12\x16\n" +
+	"\x06weight\x18\x0e \x01(\x05R\x06weight\x121\n" +
+	"\x06action\x18\x0f \x01(\x0e2\x19.chat.v1.EscalationActionR\x06action\x12\x1a\n" +
+	"\bpardoned\x18\x10 \x01(\bR\bpardoned\"`\n" +
+	"\x14WarningStateResponse\x126\n" +
+	"\acurrent\x18\x02 \x03(\v2\x14.chat.v1.UserWarningB\x06\xbaH\x03\xc8\x01\x01R\acurrentJ\x04\b\x01\x10\x02R\n" +
+	"max_weight*\xa8\x01\n" +
+	"\x10EscalationAction\x12&\n" +
+	"\"ESCALATION_ACTION_WARN_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ESCALATION_ACTION_GAG\x10\x01\x12\x1a\n" +
+	"\x16ESCALATION_ACTION_KICK\x10\x02\x12\x1a\n" +
+	"\x16ESCALATION_ACTION_MUTE\x10\x03\x12\x19\n" +
+	"\x15ESCALATION_ACTION_BAN\x10\x04*a\n" +
 	"\fFilterAction\x12\"\n" +
 	"\x1eFILTER_ACTION_KICK_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12FILTER_ACTION_MUTE\x10\x01\x12\x15\n" +
-	"\x11FILTER_ACTION_BAN\x10\x022\xc7\x03\n" +
+	"\x11FILTER_ACTION_BAN\x10\x022\x96\a\n" +
 	"\x11WordfilterService\x12=\n" +
 	"\aFilters\x12\x16.google.protobuf.Empty\x1a\x18.chat.v1.FiltersResponse\"\x00\x12G\n" +
 	"\fWarningState\x12\x16.google.protobuf.Empty\x1a\x1d.chat.v1.WarningStateResponse\"\x00\x12M\n" +
@@ -794,7 +1513,14 @@ const file_chat_v1_wordfilter_proto_rawDesc = "" +
 	"\n" +
 	"FilterEdit\x12\x1a.chat.v1.FilterEditRequest\x1a\x1b.chat.v1.FilterEditResponse\"\x00\x12F\n" +
 	"\fFilterDelete\x12\x1c.chat.v1.FilterDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00\x12J\n" +
-	"\vFilterMatch\x12\x1b.chat.v1.FilterMatchRequest\x1a\x1c.chat.v1.FilterMatchResponse\"\x00B\x94\x01\n" +
+	"\vFilterMatch\x12\x1b.chat.v1.FilterMatchRequest\x1a\x1c.chat.v1.FilterMatchResponse\"\x00\x12G\n" +
+	"\n" +
+	"FilterTest\x12\x1a.chat.v1.FilterTestRequest\x1a\x1b.chat.v1.FilterTestResponse\"\x00\x12A\n" +
+	"\bWarnings\x12\x18.chat.v1.WarningsRequest\x1a\x19.chat.v1.WarningsResponse\"\x00\x12H\n" +
+	"\rWarningPardon\x12\x1d.chat.v1.WarningPardonRequest\x1a\x16.google.protobuf.Empty\"\x00\x12H\n" +
+	"\rWarningsClear\x12\x1d.chat.v1.WarningsClearRequest\x1a\x16.google.protobuf.Empty\"\x00\x12M\n" +
+	"\x0fEscalationSteps\x12\x16.google.protobuf.Empty\x1a .chat.v1.EscalationStepsResponse\"\x00\x12^\n" +
+	"\x13EscalationStepsSave\x12#.chat.v1.EscalationStepsSaveRequest\x1a .chat.v1.EscalationStepsResponse\"\x00B\x94\x01\n" +
 	"\vcom.chat.v1B\x0fWordfilterProtoP\x01Z7github.com/leighmacdonald/gbans/internal/chat/v1;chatv1\xa2\x02\x03CXX\xaa\x02\aChat.V1\xca\x02\aChat\\V1\xe2\x02\x13Chat\\V1\\GPBMetadata\xea\x02\bChat::V1b\beditionsp\xe8\a"
 
 var (
@@ -809,56 +1535,89 @@ func file_chat_v1_wordfilter_proto_rawDescGZIP() []byte {
 	return file_chat_v1_wordfilter_proto_rawDescData
 }
 
-var file_chat_v1_wordfilter_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_chat_v1_wordfilter_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_chat_v1_wordfilter_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_chat_v1_wordfilter_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_chat_v1_wordfilter_proto_goTypes = []any{
-	(FilterAction)(0),             // 0: chat.v1.FilterAction
-	(*FilterMatchRequest)(nil),    // 1: chat.v1.FilterMatchRequest
-	(*FilterMatchResponse)(nil),   // 2: chat.v1.FilterMatchResponse
-	(*FilterDeleteRequest)(nil),   // 3: chat.v1.FilterDeleteRequest
-	(*FilterCreateRequest)(nil),   // 4: chat.v1.FilterCreateRequest
-	(*FilterCreateResponse)(nil),  // 5: chat.v1.FilterCreateResponse
-	(*FilterEditRequest)(nil),     // 6: chat.v1.FilterEditRequest
-	(*FilterEditResponse)(nil),    // 7: chat.v1.FilterEditResponse
-	(*Filter)(nil),                // 8: chat.v1.Filter
-	(*FiltersResponse)(nil),       // 9: chat.v1.FiltersResponse
-	(*UserWarning)(nil),           // 10: chat.v1.UserWarning
-	(*WarningStateResponse)(nil),  // 11: chat.v1.WarningStateResponse
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(v1.BanReason)(0),             // 13: ban.v1.BanReason
-	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+	(EscalationAction)(0),              // 0: chat.v1.EscalationAction
+	(FilterAction)(0),                  // 1: chat.v1.FilterAction
+	(*WarningsRequest)(nil),            // 2: chat.v1.WarningsRequest
+	(*WarningsResponse)(nil),           // 3: chat.v1.WarningsResponse
+	(*WarningPardonRequest)(nil),       // 4: chat.v1.WarningPardonRequest
+	(*WarningsClearRequest)(nil),       // 5: chat.v1.WarningsClearRequest
+	(*EscalationStep)(nil),             // 6: chat.v1.EscalationStep
+	(*EscalationStepsResponse)(nil),    // 7: chat.v1.EscalationStepsResponse
+	(*EscalationStepsSaveRequest)(nil), // 8: chat.v1.EscalationStepsSaveRequest
+	(*FilterMatchRequest)(nil),         // 9: chat.v1.FilterMatchRequest
+	(*FilterMatchResponse)(nil),        // 10: chat.v1.FilterMatchResponse
+	(*FilterSpan)(nil),                 // 11: chat.v1.FilterSpan
+	(*FilterTestRequest)(nil),          // 12: chat.v1.FilterTestRequest
+	(*FilterTestSample)(nil),           // 13: chat.v1.FilterTestSample
+	(*FilterTestResponse)(nil),         // 14: chat.v1.FilterTestResponse
+	(*FilterDeleteRequest)(nil),        // 15: chat.v1.FilterDeleteRequest
+	(*FilterCreateRequest)(nil),        // 16: chat.v1.FilterCreateRequest
+	(*FilterCreateResponse)(nil),       // 17: chat.v1.FilterCreateResponse
+	(*FilterEditRequest)(nil),          // 18: chat.v1.FilterEditRequest
+	(*FilterEditResponse)(nil),         // 19: chat.v1.FilterEditResponse
+	(*Filter)(nil),                     // 20: chat.v1.Filter
+	(*FiltersResponse)(nil),            // 21: chat.v1.FiltersResponse
+	(*UserWarning)(nil),                // 22: chat.v1.UserWarning
+	(*WarningStateResponse)(nil),       // 23: chat.v1.WarningStateResponse
+	(*timestamppb.Timestamp)(nil),      // 24: google.protobuf.Timestamp
+	(v1.BanReason)(0),                  // 25: ban.v1.BanReason
+	(*emptypb.Empty)(nil),              // 26: google.protobuf.Empty
 }
 var file_chat_v1_wordfilter_proto_depIdxs = []int32{
-	8,  // 0: chat.v1.FilterMatchResponse.filters:type_name -> chat.v1.Filter
-	8,  // 1: chat.v1.FilterCreateRequest.filter:type_name -> chat.v1.Filter
-	8,  // 2: chat.v1.FilterCreateResponse.filter:type_name -> chat.v1.Filter
-	8,  // 3: chat.v1.FilterEditRequest.filter:type_name -> chat.v1.Filter
-	8,  // 4: chat.v1.FilterEditResponse.filter:type_name -> chat.v1.Filter
-	0,  // 5: chat.v1.Filter.action:type_name -> chat.v1.FilterAction
-	12, // 6: chat.v1.Filter.created_on:type_name -> google.protobuf.Timestamp
-	12, // 7: chat.v1.Filter.updated_on:type_name -> google.protobuf.Timestamp
-	8,  // 8: chat.v1.FiltersResponse.filters:type_name -> chat.v1.Filter
-	13, // 9: chat.v1.UserWarning.reason:type_name -> ban.v1.BanReason
-	8,  // 10: chat.v1.UserWarning.filter:type_name -> chat.v1.Filter
-	12, // 11: chat.v1.UserWarning.created_on:type_name -> google.protobuf.Timestamp
-	10, // 12: chat.v1.WarningStateResponse.current:type_name -> chat.v1.UserWarning
-	14, // 13: chat.v1.WordfilterService.Filters:input_type -> google.protobuf.Empty
-	14, // 14: chat.v1.WordfilterService.WarningState:input_type -> google.protobuf.Empty
-	4,  // 15: chat.v1.WordfilterService.FilterCreate:input_type -> chat.v1.FilterCreateRequest
-	6,  // 16: chat.v1.WordfilterService.FilterEdit:input_type -> chat.v1.FilterEditRequest
-	3,  // 17: chat.v1.WordfilterService.FilterDelete:input_type -> chat.v1.FilterDeleteRequest
-	1,  // 18: chat.v1.WordfilterService.FilterMatch:input_type -> chat.v1.FilterMatchRequest
-	9,  // 19: chat.v1.WordfilterService.Filters:output_type -> chat.v1.FiltersResponse
-	11, // 20: chat.v1.WordfilterService.WarningState:output_type -> chat.v1.WarningStateResponse
-	5,  // 21: chat.v1.WordfilterService.FilterCreate:output_type -> chat.v1.FilterCreateResponse
-	7,  // 22: chat.v1.WordfilterService.FilterEdit:output_type -> chat.v1.FilterEditResponse
-	14, // 23: chat.v1.WordfilterService.FilterDelete:output_type -> google.protobuf.Empty
-	2,  // 24: chat.v1.WordfilterService.FilterMatch:output_type -> chat.v1.FilterMatchResponse
-	19, // [19:25] is the sub-list for method output_type
-	13, // [13:19] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	22, // 0: chat.v1.WarningsResponse.warnings:type_name -> chat.v1.UserWarning
+	0,  // 1: chat.v1.EscalationStep.action:type_name -> chat.v1.EscalationAction
+	6,  // 2: chat.v1.EscalationStepsResponse.steps:type_name -> chat.v1.EscalationStep
+	6,  // 3: chat.v1.EscalationStepsSaveRequest.steps:type_name -> chat.v1.EscalationStep
+	20, // 4: chat.v1.FilterMatchResponse.filters:type_name -> chat.v1.Filter
+	11, // 5: chat.v1.FilterMatchResponse.matches:type_name -> chat.v1.FilterSpan
+	20, // 6: chat.v1.FilterTestRequest.filter:type_name -> chat.v1.Filter
+	11, // 7: chat.v1.FilterTestSample.matches:type_name -> chat.v1.FilterSpan
+	13, // 8: chat.v1.FilterTestResponse.samples:type_name -> chat.v1.FilterTestSample
+	20, // 9: chat.v1.FilterCreateRequest.filter:type_name -> chat.v1.Filter
+	20, // 10: chat.v1.FilterCreateResponse.filter:type_name -> chat.v1.Filter
+	20, // 11: chat.v1.FilterEditRequest.filter:type_name -> chat.v1.Filter
+	20, // 12: chat.v1.FilterEditResponse.filter:type_name -> chat.v1.Filter
+	1,  // 13: chat.v1.Filter.action:type_name -> chat.v1.FilterAction
+	24, // 14: chat.v1.Filter.created_on:type_name -> google.protobuf.Timestamp
+	24, // 15: chat.v1.Filter.updated_on:type_name -> google.protobuf.Timestamp
+	20, // 16: chat.v1.FiltersResponse.filters:type_name -> chat.v1.Filter
+	25, // 17: chat.v1.UserWarning.reason:type_name -> ban.v1.BanReason
+	20, // 18: chat.v1.UserWarning.filter:type_name -> chat.v1.Filter
+	24, // 19: chat.v1.UserWarning.created_on:type_name -> google.protobuf.Timestamp
+	0,  // 20: chat.v1.UserWarning.action:type_name -> chat.v1.EscalationAction
+	22, // 21: chat.v1.WarningStateResponse.current:type_name -> chat.v1.UserWarning
+	26, // 22: chat.v1.WordfilterService.Filters:input_type -> google.protobuf.Empty
+	26, // 23: chat.v1.WordfilterService.WarningState:input_type -> google.protobuf.Empty
+	16, // 24: chat.v1.WordfilterService.FilterCreate:input_type -> chat.v1.FilterCreateRequest
+	18, // 25: chat.v1.WordfilterService.FilterEdit:input_type -> chat.v1.FilterEditRequest
+	15, // 26: chat.v1.WordfilterService.FilterDelete:input_type -> chat.v1.FilterDeleteRequest
+	9,  // 27: chat.v1.WordfilterService.FilterMatch:input_type -> chat.v1.FilterMatchRequest
+	12, // 28: chat.v1.WordfilterService.FilterTest:input_type -> chat.v1.FilterTestRequest
+	2,  // 29: chat.v1.WordfilterService.Warnings:input_type -> chat.v1.WarningsRequest
+	4,  // 30: chat.v1.WordfilterService.WarningPardon:input_type -> chat.v1.WarningPardonRequest
+	5,  // 31: chat.v1.WordfilterService.WarningsClear:input_type -> chat.v1.WarningsClearRequest
+	26, // 32: chat.v1.WordfilterService.EscalationSteps:input_type -> google.protobuf.Empty
+	8,  // 33: chat.v1.WordfilterService.EscalationStepsSave:input_type -> chat.v1.EscalationStepsSaveRequest
+	21, // 34: chat.v1.WordfilterService.Filters:output_type -> chat.v1.FiltersResponse
+	23, // 35: chat.v1.WordfilterService.WarningState:output_type -> chat.v1.WarningStateResponse
+	17, // 36: chat.v1.WordfilterService.FilterCreate:output_type -> chat.v1.FilterCreateResponse
+	19, // 37: chat.v1.WordfilterService.FilterEdit:output_type -> chat.v1.FilterEditResponse
+	26, // 38: chat.v1.WordfilterService.FilterDelete:output_type -> google.protobuf.Empty
+	10, // 39: chat.v1.WordfilterService.FilterMatch:output_type -> chat.v1.FilterMatchResponse
+	14, // 40: chat.v1.WordfilterService.FilterTest:output_type -> chat.v1.FilterTestResponse
+	3,  // 41: chat.v1.WordfilterService.Warnings:output_type -> chat.v1.WarningsResponse
+	26, // 42: chat.v1.WordfilterService.WarningPardon:output_type -> google.protobuf.Empty
+	26, // 43: chat.v1.WordfilterService.WarningsClear:output_type -> google.protobuf.Empty
+	7,  // 44: chat.v1.WordfilterService.EscalationSteps:output_type -> chat.v1.EscalationStepsResponse
+	7,  // 45: chat.v1.WordfilterService.EscalationStepsSave:output_type -> chat.v1.EscalationStepsResponse
+	34, // [34:46] is the sub-list for method output_type
+	22, // [22:34] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_chat_v1_wordfilter_proto_init() }
@@ -871,8 +1630,8 @@ func file_chat_v1_wordfilter_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chat_v1_wordfilter_proto_rawDesc), len(file_chat_v1_wordfilter_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
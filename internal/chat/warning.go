@@ -0,0 +1,149 @@
+package chat
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/datetime"
+)
+
+var ErrInvalidEscalation = errors.New("invalid escalation step")
+
+// EscalationAction is ordered from least to most severe.
+type EscalationAction int
+
+const (
+	// EscalationWarn privately messages the player in-game.
+	EscalationWarn EscalationAction = iota
+	// EscalationGag removes the players ability to chat for the remainder of their session.
+	EscalationGag
+	// EscalationKick removes the player from the server.
+	EscalationKick
+	// EscalationMute creates a communication ban.
+	EscalationMute
+	// EscalationBan creates a ban.
+	EscalationBan
+)
+
+func (a EscalationAction) String() string {
+	switch a {
+	case EscalationGag:
+		return "gag"
+	case EscalationKick:
+		return "kick"
+	case EscalationMute:
+		return "mute"
+	case EscalationBan:
+		return "ban"
+	default:
+		return "warn"
+	}
+}
+
+// EscalationStep is applied once the total weight of a players active warnings reaches Weight.
+type EscalationStep struct {
+	Weight   int32
+	Action   EscalationAction
+	Duration string
+}
+
+func (s EscalationStep) Validate() error {
+	if s.Weight <= 0 || s.Action < EscalationWarn || s.Action > EscalationBan {
+		return ErrInvalidEscalation
+	}
+
+	if s.Action == EscalationMute || s.Action == EscalationBan {
+		if _, errDur := datetime.ParseDuration(s.Duration); errDur != nil {
+			return errors.Join(errDur, ErrInvalidEscalation)
+		}
+	}
+
+	return nil
+}
+
+// EscalationFor returns the highest step reached by the total weight.
+func EscalationFor(steps []EscalationStep, total int32) (EscalationStep, bool) {
+	var (
+		found   bool
+		reached EscalationStep
+	)
+
+	for _, step := range steps {
+		if step.Weight <= total && (!found || step.Weight > reached.Weight) {
+			reached = step
+			found = true
+		}
+	}
+
+	return reached, found
+}
+
+// filterStep converts the action of a filter into the equivalent escalation step.
+func filterStep(filter Filter) (EscalationStep, bool) {
+	switch filter.Action {
+	case FilterActionKick:
+		return EscalationStep{Action: EscalationKick}, true
+	case FilterActionMute, FilterActionBan:
+		if _, errDur := datetime.ParseDuration(filter.Duration); errDur != nil {
+			return EscalationStep{}, false
+		}
+
+		action := EscalationMute
+		if filter.Action == FilterActionBan {
+			action = EscalationBan
+		}
+
+		return EscalationStep{Action: action, Duration: filter.Duration}, true
+	default:
+		return EscalationStep{}, false
+	}
+}
+
+// Escalate returns the step to apply for a warning matching the filter. The total weight selects the step,
+// once a player is past being warned the filters own action and duration are used instead when they are
+// more severe than the step.
+func Escalate(steps []EscalationStep, total int32, filter Filter) (EscalationStep, bool) {
+	step, found := EscalationFor(steps, total)
+	if !found || step.Action == EscalationWarn {
+		return step, found
+	}
+
+	if penalty, ok := filterStep(filter); ok && penalty.Action > step.Action {
+		penalty.Weight = step.Weight
+
+		return penalty, true
+	}
+
+	return step, true
+}
+
+// decayedWeight linearly reduces the weight of a warning to zero over the timeout. A timeout of zero disables decay.
+func decayedWeight(weight int32, created time.Time, now time.Time, timeout time.Duration) float64 {
+	if timeout <= 0 {
+		return float64(weight)
+	}
+
+	remaining := 1 - float64(now.Sub(created))/float64(timeout)
+	if remaining <= 0 {
+		return 0
+	}
+
+	return float64(weight) * min(remaining, 1)
+}
+
+// ApplyDecay calculates the running total of the decayed weights of each players warnings. Pardoned warnings
+// are not counted. Warnings are expected to be ordered by creation time.
+func ApplyDecay(warnings []UserWarning, now time.Time, timeout time.Duration) []UserWarning {
+	totals := map[int64]float64{}
+
+	for idx := range warnings {
+		if !warnings[idx].Pardoned {
+			totals[warnings[idx].SteamID] += decayedWeight(warnings[idx].Weight, warnings[idx].CreatedOn, now, timeout)
+		}
+
+		warnings[idx].CurrentTotal = int32(math.Round(totals[warnings[idx].SteamID]))
+	}
+
+	return warnings
+}
@@ -0,0 +1,149 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// WarningsQuery selects the warnings to load. Since is the oldest warning to include.
+type WarningsQuery struct {
+	SteamID         steamid.SteamID
+	Since           time.Time
+	IncludePardoned bool
+}
+
+func (r Repository) SaveWarning(ctx context.Context, warning *UserWarning) error {
+	const query = `
+		INSERT INTO chat_warning (steam_id, person_message_id, filter_id, server_id, warn_reason, message, matched,
+		                          weight, action, created_on, updated_on)
+		VALUES ($1, NULLIF($2, 0), NULLIF($3, 0), NULLIF($4, 0), $5, $6, $7, $8, $9, $10, $10)
+		RETURNING warning_id`
+
+	return database.Err(r.QueryRow(ctx, query, warning.SteamID, warning.PersonMessageID, warning.MatchedFilter.FilterID,
+		warning.ServerID, warning.WarnReason, warning.Message, warning.Matched, warning.Weight, warning.Action,
+		warning.CreatedOn).Scan(&warning.WarningID))
+}
+
+func (r Repository) SetWarningAction(ctx context.Context, warningID int64, action EscalationAction) error {
+	return database.Err(r.ExecUpdateBuilder(ctx, r.Builder().
+		Update("chat_warning").
+		Set("action", action).
+		Set("updated_on", time.Now()).
+		Where(sq.Eq{"warning_id": warningID})))
+}
+
+// Warnings returns the matching warnings ordered by their creation time.
+func (r Repository) Warnings(ctx context.Context, opts WarningsQuery) ([]UserWarning, error) {
+	builder := r.Builder().
+		Select("w.warning_id", "coalesce(w.person_message_id, 0)", "w.warn_reason", "w.message", "w.matched",
+			"w.created_on", "coalesce(p.personaname, '')", "coalesce(p.avatarhash, '')",
+			"coalesce(s.short_name, '')", "coalesce(w.server_id, 0)", "w.steam_id", "w.weight", "w.action",
+			"w.pardoned", "coalesce(f.filter_id, 0)", "coalesce(f.pattern, '')", "coalesce(f.is_regex, false)",
+			"coalesce(f.is_enabled, false)", "coalesce(f.action, 0)", "coalesce(f.duration, '')",
+			"coalesce(f.trigger_count, 0)", "coalesce(f.weight, 0)").
+		From("chat_warning w").
+		LeftJoin("person p ON p.steam_id = w.steam_id").
+		LeftJoin("server s ON s.server_id = w.server_id").
+		LeftJoin("filtered_word f ON f.filter_id = w.filter_id").
+		Where(sq.GtOrEq{"w.created_on": opts.Since}).
+		OrderBy("w.created_on", "w.warning_id")
+
+	if opts.SteamID.Valid() {
+		builder = builder.Where(sq.Eq{"w.steam_id": opts.SteamID.Int64()})
+	}
+
+	if !opts.IncludePardoned {
+		builder = builder.Where(sq.Eq{"w.pardoned": false})
+	}
+
+	rows, errRows := r.QueryBuilder(ctx, builder)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	warnings := []UserWarning{}
+
+	for rows.Next() {
+		var warning UserWarning
+
+		if errScan := rows.Scan(&warning.WarningID, &warning.PersonMessageID, &warning.WarnReason, &warning.Message,
+			&warning.Matched, &warning.CreatedOn, &warning.Personaname, &warning.Avatar, &warning.ServerName,
+			&warning.ServerID, &warning.SteamID, &warning.Weight, &warning.Action, &warning.Pardoned,
+			&warning.MatchedFilter.FilterID, &warning.MatchedFilter.Pattern, &warning.MatchedFilter.IsRegex,
+			&warning.MatchedFilter.IsEnabled, &warning.MatchedFilter.Action, &warning.MatchedFilter.Duration,
+			&warning.MatchedFilter.TriggerCount, &warning.MatchedFilter.Weight); errScan != nil {
+			return nil, database.Err(errScan)
+		}
+
+		warnings = append(warnings, warning)
+	}
+
+	return warnings, nil
+}
+
+// PardonWarning stops the warning from counting towards the players total while keeping it in their history.
+func (r Repository) PardonWarning(ctx context.Context, warningID int64, moderator steamid.SteamID) error {
+	const query = `
+		UPDATE chat_warning SET pardoned = true, pardoned_by = $2, updated_on = $3
+		WHERE warning_id = $1
+		RETURNING warning_id`
+
+	return database.Err(r.QueryRow(ctx, query, warningID, moderator.Int64(), time.Now()).Scan(&warningID))
+}
+
+// ClearWarnings removes every warning of the player.
+func (r Repository) ClearWarnings(ctx context.Context, steamID steamid.SteamID) error {
+	return database.Err(r.ExecDeleteBuilder(ctx, r.Builder().
+		Delete("chat_warning").
+		Where(sq.Eq{"steam_id": steamID.Int64()})))
+}
+
+func (r Repository) EscalationSteps(ctx context.Context) ([]EscalationStep, error) {
+	rows, errRows := r.QueryBuilder(ctx, r.Builder().
+		Select("weight", "action", "duration").
+		From("chat_escalation").
+		OrderBy("weight"))
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	steps := []EscalationStep{}
+
+	for rows.Next() {
+		var step EscalationStep
+		if errScan := rows.Scan(&step.Weight, &step.Action, &step.Duration); errScan != nil {
+			return nil, database.Err(errScan)
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+// SaveEscalationSteps replaces all the escalation steps.
+func (r Repository) SaveEscalationSteps(ctx context.Context, steps []EscalationStep) error {
+	return database.Err(r.WrapTx(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `DELETE FROM chat_escalation`); err != nil {
+			return err
+		}
+
+		for _, step := range steps {
+			if _, err := tx.Exec(ctx, `INSERT INTO chat_escalation (weight, action, duration, updated_on) VALUES ($1, $2, $3, $4)`,
+				step.Weight, step.Action, step.Duration, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+}
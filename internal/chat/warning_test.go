@@ -0,0 +1,128 @@
+package chat_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/chat"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscalationStepValidate(t *testing.T) {
+	require.NoError(t, chat.EscalationStep{Weight: 1, Action: chat.EscalationWarn}.Validate())
+	require.NoError(t, chat.EscalationStep{Weight: 5, Action: chat.EscalationGag}.Validate())
+	require.NoError(t, chat.EscalationStep{Weight: 10, Action: chat.EscalationMute, Duration: "1d"}.Validate())
+	require.NoError(t, chat.EscalationStep{Weight: 20, Action: chat.EscalationBan, Duration: "2w"}.Validate())
+	require.NoError(t, chat.EscalationStep{Weight: 8, Action: chat.EscalationKick}.Validate())
+
+	require.ErrorIs(t, chat.EscalationStep{Weight: 0, Action: chat.EscalationWarn}.Validate(), chat.ErrInvalidEscalation)
+	require.ErrorIs(t, chat.EscalationStep{Weight: 1, Action: chat.EscalationAction(9)}.Validate(), chat.ErrInvalidEscalation)
+	require.ErrorIs(t, chat.EscalationStep{Weight: 1, Action: chat.EscalationBan}.Validate(), chat.ErrInvalidEscalation)
+}
+
+func TestEscalationFor(t *testing.T) {
+	steps := []chat.EscalationStep{
+		{Weight: 10, Action: chat.EscalationMute, Duration: "1d"},
+		{Weight: 1, Action: chat.EscalationWarn},
+		{Weight: 5, Action: chat.EscalationGag},
+	}
+
+	for _, testCase := range []struct {
+		total  int32
+		found  bool
+		action chat.EscalationAction
+	}{
+		{0, false, chat.EscalationWarn},
+		{1, true, chat.EscalationWarn},
+		{4, true, chat.EscalationWarn},
+		{5, true, chat.EscalationGag},
+		{9, true, chat.EscalationGag},
+		{10, true, chat.EscalationMute},
+		{100, true, chat.EscalationMute},
+	} {
+		step, found := chat.EscalationFor(steps, testCase.total)
+		require.Equal(t, testCase.found, found, testCase.total)
+		require.Equal(t, testCase.action, step.Action, testCase.total)
+	}
+
+	_, found := chat.EscalationFor(nil, 100)
+	require.False(t, found)
+}
+
+func TestEscalate(t *testing.T) {
+	steps := []chat.EscalationStep{
+		{Weight: 1, Action: chat.EscalationWarn},
+		{Weight: 5, Action: chat.EscalationGag},
+		{Weight: 10, Action: chat.EscalationMute, Duration: "1d"},
+	}
+
+	for _, testCase := range []struct {
+		name     string
+		total    int32
+		filter   chat.Filter
+		found    bool
+		action   chat.EscalationAction
+		duration string
+	}{
+		{"below ladder", 0, chat.Filter{Action: chat.FilterActionBan, Duration: "1w"}, false, chat.EscalationWarn, ""},
+		{"warnings ignore filter", 1, chat.Filter{Action: chat.FilterActionBan, Duration: "1w"}, true, chat.EscalationWarn, ""},
+		{"kick filter raises gag", 5, chat.Filter{Action: chat.FilterActionKick}, true, chat.EscalationKick, ""},
+		{"ban filter raises gag", 5, chat.Filter{Action: chat.FilterActionBan, Duration: "1w"}, true, chat.EscalationBan, "1w"},
+		{"kick filter below mute", 10, chat.Filter{Action: chat.FilterActionKick}, true, chat.EscalationMute, "1d"},
+		{"same action keeps step", 10, chat.Filter{Action: chat.FilterActionMute, Duration: "1w"}, true, chat.EscalationMute, "1d"},
+		{"invalid filter duration", 10, chat.Filter{Action: chat.FilterActionBan, Duration: "forever"}, true, chat.EscalationMute, "1d"},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			step, found := chat.Escalate(steps, testCase.total, testCase.filter)
+			require.Equal(t, testCase.found, found)
+			require.Equal(t, testCase.action, step.Action)
+			require.Equal(t, testCase.duration, step.Duration)
+		})
+	}
+}
+
+func TestApplyDecay(t *testing.T) {
+	var (
+		now     = time.Now()
+		timeout = time.Hour
+	)
+
+	warnings := chat.ApplyDecay([]chat.UserWarning{
+		{SteamID: 1, Weight: 4, CreatedOn: now.Add(-timeout)},
+		{SteamID: 1, Weight: 4, CreatedOn: now.Add(-timeout / 2)},
+		{SteamID: 2, Weight: 3, CreatedOn: now.Add(-timeout / 4)},
+		{SteamID: 1, Weight: 5, CreatedOn: now, Pardoned: true},
+		{SteamID: 1, Weight: 3, CreatedOn: now},
+	}, now, timeout)
+
+	// Fully decayed, half decayed, three quarters of another player, pardoned and not decayed.
+	require.Equal(t, []int32{0, 2, 2, 2, 5}, totals(warnings))
+
+	undecayed := chat.ApplyDecay([]chat.UserWarning{
+		{SteamID: 1, Weight: 4, CreatedOn: now.Add(-timeout * 10)},
+		{SteamID: 1, Weight: 4, CreatedOn: now},
+	}, now, 0)
+	require.Equal(t, []int32{4, 8}, totals(undecayed))
+}
+
+func TestApplyDecayNewWarning(t *testing.T) {
+	now := time.Now()
+	existing := []chat.UserWarning{
+		{SteamID: 1, Weight: 2, CreatedOn: now},
+		{SteamID: 1, Weight: 3, CreatedOn: now},
+	}
+	newWarning := chat.UserWarning{SteamID: 1, Weight: 5, CreatedOn: now}
+
+	// The weight of the new warning is only counted once.
+	current := chat.ApplyDecay(append(existing, newWarning), now, time.Hour)
+	require.Equal(t, int32(10), current[len(current)-1].CurrentTotal)
+}
+
+func totals(warnings []chat.UserWarning) []int32 {
+	out := make([]int32, len(warnings))
+	for idx, warning := range warnings {
+		out[idx] = warning.CurrentTotal
+	}
+
+	return out
+}
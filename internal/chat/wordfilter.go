@@ -6,7 +6,6 @@ import (
 	"log/slog"
 	"regexp"
 	"slices"
-	"strings"
 	"sync"
 	"time"
 
@@ -88,26 +87,26 @@ func (f *Filter) Init() {
 	}
 }
 
-func (f *Filter) Match(value string) bool {
-	if f.IsRegex {
-		return f.Regex.MatchString(strings.ToLower(value))
-	}
-
-	return f.Pattern == strings.ToLower(value)
-}
-
 type UserWarning struct {
-	WarnReason    reason.Reason
-	Message       string
-	Matched       string
-	MatchedFilter Filter
-	CreatedOn     time.Time
-	Personaname   string
-	Avatar        string
-	ServerName    string
-	ServerID      int32
-	SteamID       int64
-	CurrentTotal  int32
+	WarningID       int64
+	PersonMessageID int64
+	WarnReason      reason.Reason
+	Message         string
+	Matched         string
+	MatchedFilter   Filter
+	CreatedOn       time.Time
+	Personaname     string
+	Avatar          string
+	ServerName      string
+	ServerID        int32
+	SteamID         int64
+	// Weight is the weight of the filter at the time of the warning.
+	Weight int32
+	// CurrentTotal is the decayed weight of the players active warnings up to and including this one.
+	CurrentTotal int32
+	// Action is the escalation step applied for the warning, if any.
+	Action   *EscalationAction
+	Pardoned bool
 }
 
 type NewUserWarning struct {
@@ -117,10 +116,6 @@ type NewUserWarning struct {
 	PlayerID    int
 }
 
-type Warnings interface {
-	State() map[string][]UserWarning
-}
-
 type Config struct {
 	sync.RWMutex
 
@@ -129,84 +124,163 @@ type Config struct {
 	WarningLimit   int32
 	Dry            bool
 	PingDiscord    bool
-	MaxWeight      int32
-	CheckTimeout   int32
-	MatchTimeout   int32
+}
+
+// wordFilterSet is shared between copies of WordFilters so that changes made through any of them are seen by all.
+type wordFilterSet struct {
+	filters []Filter
+	matcher *filterMatcher
 }
 
 type WordFilters struct {
 	*sync.RWMutex
 
-	repository  WordFilterRepository
-	wordFilters []Filter
-	notif       notification.Notifier
-	config      *Config
+	repository WordFilterRepository
+	set        *wordFilterSet
+	notif      notification.Notifier
+	config     *Config
 }
 
 func NewWordFilters(repository WordFilterRepository, notif notification.Notifier, config *Config) WordFilters {
-	return WordFilters{repository: repository, RWMutex: &sync.RWMutex{}, notif: notif, config: config}
+	return WordFilters{
+		repository: repository,
+		RWMutex:    &sync.RWMutex{},
+		set:        &wordFilterSet{matcher: newFilterMatcher(nil)},
+		notif:      notif,
+		config:     config,
+	}
+}
+
+// rebuild compiles the enabled filters into a new matcher. The caller must hold the write lock.
+func (w *WordFilters) rebuild() {
+	enabled := make([]Filter, 0, len(w.set.filters))
+	for _, filter := range w.set.filters {
+		if filter.IsEnabled {
+			enabled = append(enabled, filter)
+		}
+	}
+
+	w.set.matcher = newFilterMatcher(enabled)
 }
 
 func (w *WordFilters) Add(filter Filter) {
 	w.Lock()
-	w.wordFilters = append(w.wordFilters, filter)
-	w.Unlock()
+	defer w.Unlock()
+
+	w.set.filters = append(w.set.filters, filter)
+	w.rebuild()
 }
 
-// Match checks to see if the body of text contains a known filtered word
-// It will only return the first matched filter found.
-func (w *WordFilters) Match(body string) (string, Filter, bool) {
+// Match returns every match of the enabled filters within the body of text, ordered by their position.
+func (w *WordFilters) Match(body string) []FilterMatch {
 	if body == "" {
-		return "", Filter{}, false
+		return nil
 	}
 
-	words := strings.Split(strings.ToLower(body), " ")
-
 	w.RLock()
 	defer w.RUnlock()
 
-	for _, filter := range w.wordFilters {
-		for _, word := range words {
-			if filter.IsEnabled && filter.Match(word) {
-				return word, filter, true
-			}
-		}
-	}
-
-	return "", Filter{}, false
+	return w.set.matcher.match(body)
 }
 
 func (w *WordFilters) Remove(filterID int64) {
 	w.Lock()
 	defer w.Unlock()
 
-	w.wordFilters = slices.DeleteFunc(w.wordFilters, func(filter Filter) bool {
+	w.set.filters = slices.DeleteFunc(w.set.filters, func(filter Filter) bool {
 		return filter.FilterID == filterID
 	})
+	w.rebuild()
 }
 
-// Check can be used to check if a phrase will match any filters.
+// Check can be used to check if a phrase will match any enabled filters. Each filter is only returned once,
+// in the order they first matched.
 func (w *WordFilters) Check(message string) []Filter {
-	if message == "" {
-		return nil
+	var found []Filter
+
+	for _, match := range w.Match(message) {
+		if !slices.ContainsFunc(found, func(filter Filter) bool { return filter.FilterID == match.Filter.FilterID }) {
+			found = append(found, match.Filter)
+		}
 	}
 
-	words := strings.Split(strings.ToLower(message), " ")
+	return found
+}
 
-	w.RLock()
-	defer w.RUnlock()
+// FilterTestResult is the outcome of running a filter against previously sent messages.
+type FilterTestResult struct {
+	Checked int64
+	Matched int64
+	Samples []FilterTestSample
+}
 
-	var found []Filter
+type FilterTestSample struct {
+	PersonMessageID int64
+	SteamID         steamid.SteamID
+	Body            string
+	Matches         []FilterMatch
+}
+
+const (
+	filterTestBatchSize  = 1000
+	filterTestMaxSamples = 100
+)
+
+// TestFilter runs a filter that has not necessarily been saved or enabled against the most recent messages,
+// which can be used to estimate how many false positives it will produce.
+func (w *WordFilters) TestFilter(ctx context.Context, filter Filter, limit uint64) (FilterTestResult, error) {
+	var result FilterTestResult
+
+	if filter.Pattern == "" {
+		return result, ErrInvalidPattern
+	}
+
+	if filter.IsRegex {
+		compiled, errRegex := regexp.Compile(filter.Pattern)
+		if errRegex != nil {
+			return result, errors.Join(errRegex, ErrInvalidRegex)
+		}
+
+		filter.Regex = compiled
+	}
+
+	matcher := newFilterMatcher([]Filter{filter})
+	provider := MessageProvider{Db: w.repository.Database, Desc: true}
+
+	for remaining := limit; remaining > 0; {
+		messages, errMessages := provider.Next(ctx, min(remaining, filterTestBatchSize))
+		if errMessages != nil {
+			return result, errMessages
+		}
+
+		for _, message := range messages {
+			result.Checked++
+
+			matches := matcher.match(message.Text())
+			if len(matches) == 0 {
+				continue
+			}
+
+			result.Matched++
 
-	for _, filter := range w.wordFilters {
-		for _, word := range words {
-			if filter.Match(word) {
-				found = append(found, filter)
+			if len(result.Samples) < filterTestMaxSamples {
+				result.Samples = append(result.Samples, FilterTestSample{
+					PersonMessageID: message.MessageID(),
+					SteamID:         steamid.New(message.UserID()),
+					Body:            message.Text(),
+					Matches:         matches,
+				})
 			}
 		}
+
+		if uint64(len(messages)) < min(remaining, filterTestBatchSize) {
+			break
+		}
+
+		remaining -= uint64(len(messages))
 	}
 
-	return found
+	return result, nil
 }
 
 func (w *WordFilters) Import(ctx context.Context) error {
@@ -217,7 +291,9 @@ func (w *WordFilters) Import(ctx context.Context) error {
 
 	w.Lock()
 	defer w.Unlock()
-	w.wordFilters = filters
+
+	w.set.filters = filters
+	w.rebuild()
 
 	return nil
 }
@@ -328,6 +404,10 @@ func (w *WordFilters) GetFilters(ctx context.Context) ([]Filter, error) {
 	return w.repository.GetFilters(ctx)
 }
 
+func (w *WordFilters) IncrementTriggerCount(ctx context.Context, filterID int64) error {
+	return w.repository.IncrementTriggerCount(ctx, filterID)
+}
+
 func (w *WordFilters) AddMessageFilterMatch(ctx context.Context, messageID int64, filterID int64) error {
 	return w.repository.AddMessageFilterMatch(ctx, messageID, filterID)
 }
@@ -0,0 +1,437 @@
+package chat
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// confusables maps common look-alike characters from other scripts to the latin character they imitate.
+// Fullwidth and other compatibility forms are already handled by NFKD.
+var confusables = map[rune]rune{ //nolint:gochecknoglobals
+	'а': 'a', 'в': 'b', 'е': 'e', 'ё': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o', 'р': 'p', 'с': 'c',
+	'т': 't', 'у': 'y', 'х': 'x', 'ѕ': 's', 'і': 'i', 'ї': 'i', 'ј': 'j', 'ԁ': 'd', 'ԛ': 'q', 'ԝ': 'w',
+	'α': 'a', 'β': 'b', 'ε': 'e', 'η': 'n', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'τ': 't',
+	'υ': 'u', 'χ': 'x', 'ω': 'w', 'ɡ': 'g', 'ɑ': 'a', 'ı': 'i', 'ȷ': 'j', 'ß': 's', 'ø': 'o', 'ł': 'l',
+	'đ': 'd', 'ħ': 'h',
+}
+
+// leet maps digits and symbols used in place of letters. They are only replaced within words that
+// contain letters, so plain numbers and punctuation are left alone.
+var leet = map[rune]rune{ //nolint:gochecknoglobals
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't', '8': 'b', '9': 'g',
+	'@': 'a', '$': 's', '!': 'i', '|': 'l', '+': 't', '€': 'e', '£': 'l',
+}
+
+// span is a byte range within the original message.
+type span struct {
+	start int
+	end   int
+}
+
+type normalRune struct {
+	value rune
+	span
+}
+
+// normalized is the canonical form of a message that filters are matched against. Words are lowercased,
+// stripped of accents, confusables, leetspeak and invisible characters, and separated by a single space.
+// Each byte of text maps back to the range of the original message it was produced from.
+type normalized struct {
+	text   string
+	origin []span
+}
+
+// originalSpan converts a byte range of the normalized text to the range of the original message.
+func (n normalized) originalSpan(start int, end int) span {
+	return span{start: n.origin[start].start, end: n.origin[end-1].end}
+}
+
+func isWordRune(value rune) bool {
+	return unicode.IsLetter(value) || unicode.IsDigit(value)
+}
+
+func normalizeMessage(message string) normalized { //nolint:cyclop
+	// Canonical runes, each remembering the original character it came from.
+	runes := make([]normalRune, 0, len(message))
+
+	for offset, value := range message {
+		source := span{start: offset, end: offset + utf8.RuneLen(value)}
+		if value == utf8.RuneError {
+			source.end = offset + 1
+		}
+
+		for _, decomposed := range norm.NFKD.String(string(value)) {
+			switch {
+			case unicode.Is(unicode.Mn, decomposed), unicode.Is(unicode.Cf, decomposed):
+				// Accents and zero width characters.
+				continue
+			case unicode.IsSpace(decomposed):
+				decomposed = ' '
+			}
+
+			decomposed = unicode.ToLower(decomposed)
+			if replacement, found := confusables[decomposed]; found {
+				decomposed = replacement
+			}
+
+			runes = append(runes, normalRune{value: decomposed, span: source})
+		}
+	}
+
+	// Split into words. Leet symbols are part of a word when surrounded by word characters, eg: n!ce
+	var words [][]normalRune
+
+	var current []normalRune
+
+	for idx, value := range runes {
+		_, isLeet := leet[value.value]
+		inWord := isWordRune(value.value) ||
+			isLeet && idx > 0 && idx < len(runes)-1 && isWordRune(runes[idx-1].value) && isWordRune(runes[idx+1].value)
+
+		if inWord {
+			current = append(current, value)
+
+			continue
+		}
+
+		if len(current) > 0 {
+			words = append(words, current)
+			current = nil
+		}
+	}
+
+	if len(current) > 0 {
+		words = append(words, current)
+	}
+
+	for idx, word := range words {
+		words[idx] = collapseRepeats(replaceLeet(word))
+	}
+
+	// Join spaced out letters back together, eg: "n i c e" or "n.i.c.e"
+	var merged [][]normalRune
+
+	for _, word := range words {
+		if len(word) == 1 && len(merged) > 0 && len(merged[len(merged)-1]) > 0 && isSpaced(merged[len(merged)-1]) {
+			merged[len(merged)-1] = append(merged[len(merged)-1], word[0])
+
+			continue
+		}
+
+		merged = append(merged, word)
+	}
+
+	var (
+		builder strings.Builder
+		origin  []span
+	)
+
+	for idx, word := range merged {
+		if idx > 0 {
+			builder.WriteByte(' ')
+			origin = append(origin, span{start: merged[idx-1][len(merged[idx-1])-1].end, end: word[0].start})
+		}
+
+		for _, value := range word {
+			size, _ := builder.WriteRune(value.value)
+			for range size {
+				origin = append(origin, value.span)
+			}
+		}
+	}
+
+	return normalized{text: builder.String(), origin: origin}
+}
+
+// isSpaced reports whether the word is built from single letters that were separated in the original message.
+func isSpaced(word []normalRune) bool {
+	for idx := 1; idx < len(word); idx++ {
+		if word[idx].start == word[idx-1].end {
+			return false
+		}
+	}
+
+	return true
+}
+
+func replaceLeet(word []normalRune) []normalRune {
+	if !slices.ContainsFunc(word, func(value normalRune) bool { return unicode.IsLetter(value.value) }) {
+		return word
+	}
+
+	for idx, value := range word {
+		if replacement, found := leet[value.value]; found {
+			word[idx].value = replacement
+		}
+	}
+
+	return word
+}
+
+// collapseRepeats reduces runs of 3 or more of the same character to a single one, eg: niiiice -> nice.
+func collapseRepeats(word []normalRune) []normalRune {
+	collapsed := make([]normalRune, 0, len(word))
+
+	for idx := 0; idx < len(word); {
+		end := idx
+		for end < len(word) && word[end].value == word[idx].value {
+			end++
+		}
+
+		if end-idx >= 3 {
+			collapsed = append(collapsed, normalRune{value: word[idx].value, span: span{start: word[idx].start, end: word[end-1].end}})
+		} else {
+			collapsed = append(collapsed, word[idx:end]...)
+		}
+
+		idx = end
+	}
+
+	return collapsed
+}
+
+// FilterMatch is a single match of a filter within a message.
+type FilterMatch struct {
+	Filter Filter
+	// Start and End are the byte offsets of the match within the original message.
+	Start int
+	End   int
+	// Matched is the text of the original message that was matched.
+	Matched string
+}
+
+// filterMatcher is a compiled set of filters. Literal patterns are matched as whole words or phrases using an
+// Aho-Corasick automaton, regex patterns are combined into a single expression used to quickly rule out
+// messages before finding which of them matched.
+type filterMatcher struct {
+	literals *ahoCorasick
+	patterns [][]Filter
+	regexes  []Filter
+	combined *regexp.Regexp
+}
+
+func newFilterMatcher(filters []Filter) *filterMatcher {
+	matcher := &filterMatcher{}
+
+	var (
+		literalPatterns []string
+		expressions     []string
+	)
+
+	for _, filter := range filters {
+		if filter.IsRegex {
+			if filter.Regex == nil {
+				continue
+			}
+
+			matcher.regexes = append(matcher.regexes, filter)
+			expressions = append(expressions, "(?:"+filter.Regex.String()+")")
+
+			continue
+		}
+
+		pattern := normalizeMessage(filter.Pattern).text
+		if pattern == "" {
+			continue
+		}
+
+		if idx := slices.Index(literalPatterns, pattern); idx >= 0 {
+			matcher.patterns[idx] = append(matcher.patterns[idx], filter)
+
+			continue
+		}
+
+		literalPatterns = append(literalPatterns, pattern)
+		matcher.patterns = append(matcher.patterns, []Filter{filter})
+	}
+
+	matcher.literals = newAhoCorasick(literalPatterns)
+
+	if len(expressions) > 0 {
+		// Each expression compiled on its own already, so the combination is always valid.
+		matcher.combined = regexp.MustCompile(strings.Join(expressions, "|"))
+	}
+
+	return matcher
+}
+
+// match returns every filter match within the message, ordered by their position.
+func (m *filterMatcher) match(message string) []FilterMatch {
+	normal := normalizeMessage(message)
+	if normal.text == "" {
+		return nil
+	}
+
+	var matches []FilterMatch
+
+	add := func(filter Filter, start int, end int) {
+		original := normal.originalSpan(start, end)
+		for _, existing := range matches {
+			if existing.Filter.FilterID == filter.FilterID && existing.Start == original.start && existing.End == original.end {
+				return
+			}
+		}
+
+		matches = append(matches, FilterMatch{
+			Filter:  filter,
+			Start:   original.start,
+			End:     original.end,
+			Matched: message[original.start:original.end],
+		})
+	}
+
+	for _, found := range m.literals.findAll(normal.text) {
+		// Literals must cover whole words.
+		if found.start > 0 && normal.text[found.start-1] != ' ' || found.end < len(normal.text) && normal.text[found.end] != ' ' {
+			continue
+		}
+
+		for _, filter := range m.patterns[found.pattern] {
+			add(filter, found.start, found.end)
+		}
+	}
+
+	if m.combined != nil {
+		// Regexes are checked against the whole message as well as each word on its own, which is how
+		// they were historically matched, so anchored patterns such as ^word$ continue to work.
+		segments := []span{{start: 0, end: len(normal.text)}}
+		for offset := 0; offset < len(normal.text); {
+			end := strings.IndexByte(normal.text[offset:], ' ')
+			if end < 0 {
+				end = len(normal.text) - offset
+			}
+
+			if offset > 0 || offset+end < len(normal.text) {
+				segments = append(segments, span{start: offset, end: offset + end})
+			}
+
+			offset += end + 1
+		}
+
+		for _, segment := range segments {
+			text := normal.text[segment.start:segment.end]
+			if !m.combined.MatchString(text) {
+				continue
+			}
+
+			for _, filter := range m.regexes {
+				for _, loc := range filter.Regex.FindAllStringIndex(text, -1) {
+					if loc[1] > loc[0] {
+						add(filter, segment.start+loc[0], segment.start+loc[1])
+					}
+				}
+			}
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a FilterMatch, b FilterMatch) int {
+		return a.Start - b.Start
+	})
+
+	return matches
+}
+
+type ahoNode struct {
+	next    map[byte]int
+	fail    int
+	outputs []int
+}
+
+// ahoCorasick finds all occurrences of a set of patterns in a single pass over the text.
+type ahoCorasick struct {
+	nodes   []ahoNode
+	lengths []int
+}
+
+type ahoMatch struct {
+	pattern int
+	start   int
+	end     int
+}
+
+func newAhoCorasick(patterns []string) *ahoCorasick {
+	automaton := &ahoCorasick{nodes: []ahoNode{{next: map[byte]int{}}}, lengths: make([]int, len(patterns))}
+
+	for idx, pattern := range patterns {
+		automaton.lengths[idx] = len(pattern)
+		node := 0
+
+		for i := range len(pattern) {
+			child, found := automaton.nodes[node].next[pattern[i]]
+			if !found {
+				child = len(automaton.nodes)
+				automaton.nodes = append(automaton.nodes, ahoNode{next: map[byte]int{}})
+				automaton.nodes[node].next[pattern[i]] = child
+			}
+
+			node = child
+		}
+
+		automaton.nodes[node].outputs = append(automaton.nodes[node].outputs, idx)
+	}
+
+	// Breadth first construction of the failure links.
+	queue := make([]int, 0, len(automaton.nodes))
+	for _, child := range automaton.nodes[0].next {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for char, child := range automaton.nodes[node].next {
+			queue = append(queue, child)
+
+			fail := automaton.nodes[node].fail
+			for fail > 0 {
+				if _, found := automaton.nodes[fail].next[char]; found {
+					break
+				}
+
+				fail = automaton.nodes[fail].fail
+			}
+
+			if target, found := automaton.nodes[fail].next[char]; found && target != child {
+				automaton.nodes[child].fail = target
+			}
+
+			failNode := automaton.nodes[child].fail
+			automaton.nodes[child].outputs = append(automaton.nodes[child].outputs, automaton.nodes[failNode].outputs...)
+		}
+	}
+
+	return automaton
+}
+
+func (a *ahoCorasick) findAll(text string) []ahoMatch {
+	var (
+		matches []ahoMatch
+		node    int
+	)
+
+	for idx := range len(text) {
+		for node > 0 {
+			if _, found := a.nodes[node].next[text[idx]]; found {
+				break
+			}
+
+			node = a.nodes[node].fail
+		}
+
+		if next, found := a.nodes[node].next[text[idx]]; found {
+			node = next
+		}
+
+		for _, pattern := range a.nodes[node].outputs {
+			matches = append(matches, ahoMatch{pattern: pattern, start: idx + 1 - a.lengths[pattern], end: idx + 1})
+		}
+	}
+
+	return matches
+}
@@ -138,6 +138,13 @@ func (r WordFilterRepository) GetFilters(ctx context.Context) ([]Filter, error)
 	return filters, nil
 }
 
+func (r WordFilterRepository) IncrementTriggerCount(ctx context.Context, filterID int64) error {
+	return database.Err(r.ExecUpdateBuilder(ctx, r.Builder().
+		Update("filtered_word").
+		Set("trigger_count", sq.Expr("trigger_count + 1")).
+		Where(sq.Eq{"filter_id": filterID})))
+}
+
 func (r WordFilterRepository) AddMessageFilterMatch(ctx context.Context, messageID int64, filterID int64) error {
 	return database.Err(r.ExecInsertBuilder(ctx, r.Builder().
 		Insert("person_messages_filter").
@@ -2,6 +2,7 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"connectrpc.com/connect"
@@ -9,7 +10,10 @@ import (
 	banv1 "github.com/leighmacdonald/gbans/internal/ban/v1"
 	v1 "github.com/leighmacdonald/gbans/internal/chat/v1"
 	"github.com/leighmacdonald/gbans/internal/chat/v1/chatv1connect"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/httphelper"
 	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -18,19 +22,24 @@ type WordfilterService struct {
 	// chatv1connect.UnimplementedWordfilterServiceHandler
 
 	chat    *Chat
-	config  *Config
 	filters WordFilters
 }
 
-func NewWordfilterService(filters WordFilters, chat *Chat, config *Config, authMiddleware *rpc.Middleware, options ...connect.HandlerOption) rpc.Service {
-	pattern, handler := chatv1connect.NewWordfilterServiceHandler(WordfilterService{filters: filters, chat: chat, config: config}, options...)
+func NewWordfilterService(filters WordFilters, chat *Chat, authMiddleware *rpc.Middleware, options ...connect.HandlerOption) rpc.Service {
+	pattern, handler := chatv1connect.NewWordfilterServiceHandler(WordfilterService{filters: filters, chat: chat}, options...)
 
-	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFiltersProcedure, rpc.WithMinPermissions(permission.Moderator))
-	authMiddleware.UserRoute(chatv1connect.WordfilterServiceWarningStateProcedure, rpc.WithMinPermissions(permission.Moderator))
-	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFilterCreateProcedure, rpc.WithMinPermissions(permission.Moderator))
-	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFilterEditProcedure, rpc.WithMinPermissions(permission.Moderator))
-	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFilterDeleteProcedure, rpc.WithMinPermissions(permission.Moderator))
-	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFilterMatchProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFiltersProcedure, rpc.WithCapability(permission.CapWordFilters))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceWarningStateProcedure, rpc.WithCapability(permission.CapChatWarnings))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFilterCreateProcedure, rpc.WithCapability(permission.CapWordFilters))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFilterEditProcedure, rpc.WithCapability(permission.CapWordFilters))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFilterDeleteProcedure, rpc.WithCapability(permission.CapWordFilters))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFilterMatchProcedure, rpc.WithCapability(permission.CapWordFilters))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceFilterTestProcedure, rpc.WithCapability(permission.CapWordFilters))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceWarningsProcedure, rpc.WithCapability(permission.CapChatWarnings))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceWarningPardonProcedure, rpc.WithCapability(permission.CapChatWarnings))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceWarningsClearProcedure, rpc.WithCapability(permission.CapChatWarnings))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceEscalationStepsProcedure, rpc.WithCapability(permission.CapChatWarnings))
+	authMiddleware.UserRoute(chatv1connect.WordfilterServiceEscalationStepsSaveProcedure, rpc.WithCapability(permission.CapChatEscalation))
 
 	return rpc.Service{Pattern: pattern, Handler: handler}
 }
@@ -65,31 +74,137 @@ func toFilter(filter Filter) *v1.Filter {
 	}
 }
 
-func (s WordfilterService) WarningState(_ context.Context, _ *emptypb.Empty) (*v1.WarningStateResponse, error) {
-	state := s.chat.WarningState()
+func (s WordfilterService) WarningState(ctx context.Context, _ *emptypb.Empty) (*v1.WarningStateResponse, error) {
+	state, errState := s.chat.WarningState(ctx)
+	if errState != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
 
-	resp := v1.WarningStateResponse{Current: make([]*v1.UserWarning, 0), MaxWeight: &s.config.MaxWeight}
+	resp := v1.WarningStateResponse{Current: make([]*v1.UserWarning, 0)}
 	for _, warnings := range state {
 		for _, warn := range warnings {
-			resp.Current = append(resp.Current, &v1.UserWarning{
-				Reason:       new(banv1.BanReason(warn.WarnReason)), //nolint:gosec
-				Message:      &warn.Message,
-				Matched:      &warn.Matched,
-				Filter:       toFilter(warn.MatchedFilter),
-				CreatedOn:    timestamppb.New(warn.CreatedOn),
-				PersonaName:  &warn.Personaname,
-				AvatarHash:   &warn.Avatar,
-				ServerName:   &warn.ServerName,
-				ServerId:     &warn.ServerID,
-				SteamId:      &warn.SteamID,
-				CurrentTotal: &warn.CurrentTotal,
-			})
+			resp.Current = append(resp.Current, toUserWarning(warn))
 		}
 	}
 
 	return &resp, nil
 }
 
+func toUserWarning(warn UserWarning) *v1.UserWarning {
+	warning := &v1.UserWarning{
+		Reason:          new(banv1.BanReason(warn.WarnReason)), //nolint:gosec
+		Message:         &warn.Message,
+		Matched:         &warn.Matched,
+		Filter:          toFilter(warn.MatchedFilter),
+		CreatedOn:       timestamppb.New(warn.CreatedOn),
+		PersonaName:     &warn.Personaname,
+		AvatarHash:      &warn.Avatar,
+		ServerName:      &warn.ServerName,
+		ServerId:        &warn.ServerID,
+		SteamId:         &warn.SteamID,
+		CurrentTotal:    &warn.CurrentTotal,
+		WarningId:       &warn.WarningID,
+		PersonMessageId: &warn.PersonMessageID,
+		Weight:          &warn.Weight,
+		Pardoned:        &warn.Pardoned,
+	}
+
+	if warn.Action != nil {
+		warning.Action = new(v1.EscalationAction(*warn.Action)) //nolint:gosec
+	}
+
+	return warning
+}
+
+func (s WordfilterService) Warnings(ctx context.Context, req *v1.WarningsRequest) (*v1.WarningsResponse, error) {
+	steamID := steamid.New(req.GetSteamId())
+	if !steamID.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+	}
+
+	warnings, errWarnings := s.chat.Warnings(ctx, steamID)
+	if errWarnings != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.WarningsResponse{Warnings: make([]*v1.UserWarning, len(warnings))}
+	for idx, warning := range warnings {
+		resp.Warnings[idx] = toUserWarning(warning)
+	}
+
+	return &resp, nil
+}
+
+func (s WordfilterService) WarningPardon(ctx context.Context, req *v1.WarningPardonRequest) (*emptypb.Empty, error) {
+	user := rpc.UserInfoFromCtx(ctx)
+
+	if errPardon := s.chat.PardonWarning(ctx, req.GetWarningId(), user.SteamID); errPardon != nil {
+		if errors.Is(errPardon, database.ErrNoResult) {
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s WordfilterService) WarningsClear(ctx context.Context, req *v1.WarningsClearRequest) (*emptypb.Empty, error) {
+	steamID := steamid.New(req.GetSteamId())
+	if !steamID.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+	}
+
+	if errClear := s.chat.ClearWarnings(ctx, steamID); errClear != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s WordfilterService) EscalationSteps(ctx context.Context, _ *emptypb.Empty) (*v1.EscalationStepsResponse, error) {
+	steps, errSteps := s.chat.EscalationSteps(ctx)
+	if errSteps != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return toEscalationSteps(steps), nil
+}
+
+func (s WordfilterService) EscalationStepsSave(ctx context.Context, req *v1.EscalationStepsSaveRequest) (*v1.EscalationStepsResponse, error) {
+	steps := make([]EscalationStep, len(req.GetSteps()))
+	for idx, step := range req.GetSteps() {
+		steps[idx] = EscalationStep{
+			Weight:   step.GetWeight(),
+			Action:   EscalationAction(step.GetAction()),
+			Duration: step.GetDuration(),
+		}
+	}
+
+	if errSave := s.chat.SaveEscalationSteps(ctx, steps); errSave != nil {
+		if errors.Is(errSave, ErrInvalidEscalation) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errSave)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return s.EscalationSteps(ctx, &emptypb.Empty{})
+}
+
+func toEscalationSteps(steps []EscalationStep) *v1.EscalationStepsResponse {
+	resp := v1.EscalationStepsResponse{Steps: make([]*v1.EscalationStep, len(steps))}
+	for idx, step := range steps {
+		resp.Steps[idx] = &v1.EscalationStep{
+			Weight:   &step.Weight,
+			Action:   new(v1.EscalationAction(step.Action)), //nolint:gosec
+			Duration: &step.Duration,
+		}
+	}
+
+	return &resp
+}
+
 func (s WordfilterService) FilterCreate(ctx context.Context, req *v1.FilterCreateRequest) (*v1.FilterCreateResponse, error) {
 	user := rpc.UserInfoFromCtx(ctx)
 
@@ -159,12 +274,64 @@ func (s WordfilterService) FilterDelete(ctx context.Context, req *v1.FilterDelet
 }
 
 func (s WordfilterService) FilterMatch(_ context.Context, req *v1.FilterMatchRequest) (*v1.FilterMatchResponse, error) {
-	matches := s.filters.Check(req.GetQuery())
+	matches := s.filters.Match(req.GetQuery())
+	filters := s.filters.Check(req.GetQuery())
 
-	resp := v1.FilterMatchResponse{Filters: make([]*v1.Filter, len(matches))}
-	for i, m := range matches {
+	resp := v1.FilterMatchResponse{Filters: make([]*v1.Filter, len(filters)), Matches: toFilterSpans(matches)}
+	for i, m := range filters {
 		resp.Filters[i] = toFilter(m)
 	}
 
 	return &resp, nil
 }
+
+func (s WordfilterService) FilterTest(ctx context.Context, req *v1.FilterTestRequest) (*v1.FilterTestResponse, error) {
+	reqFilter := req.GetFilter()
+
+	result, errTest := s.filters.TestFilter(ctx, Filter{
+		FilterID:  reqFilter.GetFilterId(),
+		Pattern:   reqFilter.GetPattern(),
+		IsRegex:   reqFilter.GetIsRegex(),
+		IsEnabled: true,
+		Action:    FilterAction(reqFilter.GetAction()),
+		Duration:  reqFilter.GetDuration(),
+		Weight:    reqFilter.GetWeight(),
+	}, req.GetLimit())
+	if errTest != nil {
+		if errors.Is(errTest, ErrInvalidRegex) || errors.Is(errTest, ErrInvalidPattern) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errTest)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.FilterTestResponse{
+		Checked: &result.Checked,
+		Matched: &result.Matched,
+		Samples: make([]*v1.FilterTestSample, len(result.Samples)),
+	}
+	for idx, sample := range result.Samples {
+		resp.Samples[idx] = &v1.FilterTestSample{
+			PersonMessageId: &sample.PersonMessageID,
+			SteamId:         new(sample.SteamID.Int64()),
+			Body:            &sample.Body,
+			Matches:         toFilterSpans(sample.Matches),
+		}
+	}
+
+	return &resp, nil
+}
+
+func toFilterSpans(matches []FilterMatch) []*v1.FilterSpan {
+	spans := make([]*v1.FilterSpan, len(matches))
+	for idx, match := range matches {
+		spans[idx] = &v1.FilterSpan{
+			FilterId: &match.Filter.FilterID,
+			Start:    new(int32(match.Start)), //nolint:gosec
+			End:      new(int32(match.End)),   //nolint:gosec
+			Matched:  &match.Matched,
+		}
+	}
+
+	return spans
+}
@@ -1,5 +1,61 @@
 package chat_test
 
+import (
+	"testing"
+
+	"github.com/leighmacdonald/gbans/internal/chat"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordFilterMatch(t *testing.T) {
+	filters := chat.NewWordFilters(chat.WordFilterRepository{}, nil, &chat.Config{})
+
+	for idx, opts := range []struct {
+		pattern string
+		regex   bool
+	}{{"nice", false}, {"bad phrase", false}, {"^sh[i1]t$", true}, {"disabled", false}} {
+		filter, errFilter := chat.NewFilter(steamid.New(76561198084134025), opts.pattern, opts.regex, chat.FilterActionMute, "1d", 1)
+		require.NoError(t, errFilter)
+
+		filter.FilterID = int64(idx + 1)
+		filter.IsEnabled = opts.pattern != "disabled"
+		filters.Add(filter)
+	}
+
+	for _, tc := range []struct {
+		message  string
+		filterID int64
+		matched  string
+	}{
+		{"that is NICE", 1, "NICE"},
+		{"very n i c e!", 1, "n i c e"},
+		{"n.i.c.e one", 1, "n.i.c.e"},
+		{"n1c3 work", 1, "n1c3"},
+		{"niiiiice", 1, "niiiiice"},
+		{"ñíçé", 1, "ñíçé"},
+		{"nі​ce", 1, "nі​ce"}, // cyrillic і and a zero width space
+		{"ｎｉｃｅ", 1, "ｎｉｃｅ"},
+		{"such a bad   phrase", 2, "bad   phrase"},
+		{"oh sh1t", 3, "sh1t"},
+		{"shit happens", 3, "shit"},
+	} {
+		matches := filters.Match(tc.message)
+		require.Len(t, matches, 1, tc.message)
+		require.Equal(t, tc.filterID, matches[0].Filter.FilterID, tc.message)
+		require.Equal(t, tc.matched, matches[0].Matched, tc.message)
+		require.Equal(t, tc.matched, tc.message[matches[0].Start:matches[0].End], tc.message)
+	}
+
+	for _, message := range []string{"", "venice", "nicely", "bad", "disabled", "1234 55"} {
+		require.Empty(t, filters.Check(message), message)
+	}
+
+	copied := filters
+	copied.Remove(1)
+	require.Empty(t, filters.Check("nice"), "changes should be shared between copies")
+}
+
 // func TestWordFilter(t *testing.T) {
 // 	router := testRouter()
 // 	moderator := getModerator()
@@ -26,6 +26,7 @@ import (
 	"github.com/leighmacdonald/gbans/internal/config"
 	"github.com/leighmacdonald/gbans/internal/contest"
 	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/datetime"
 	"github.com/leighmacdonald/gbans/internal/demo"
 	"github.com/leighmacdonald/gbans/internal/discord"
 	discordoauth "github.com/leighmacdonald/gbans/internal/discord/oauth"
@@ -36,6 +37,7 @@ import (
 	"github.com/leighmacdonald/gbans/internal/metrics"
 	"github.com/leighmacdonald/gbans/internal/mge"
 	"github.com/leighmacdonald/gbans/internal/network"
+	"github.com/leighmacdonald/gbans/internal/network/asn"
 	"github.com/leighmacdonald/gbans/internal/network/scp"
 	"github.com/leighmacdonald/gbans/internal/news"
 	"github.com/leighmacdonald/gbans/internal/notification"
@@ -51,7 +53,6 @@ import (
 	"github.com/leighmacdonald/gbans/pkg/broadcaster"
 	"github.com/leighmacdonald/gbans/pkg/logparse"
 	"github.com/leighmacdonald/steamid/v4/steamid"
-	"github.com/sosodev/duration"
 )
 
 var (
@@ -64,6 +65,7 @@ type GBans struct {
 	anticheat      anticheat.AntiCheat
 	assets         asset.Assets
 	appeals        ban.Appeals
+	asnBlocker     asn.Blocker
 	banExpirations *ban.ExpirationMonitor
 	bans           ban.Bans
 	blocklists     blocklist.Blocklists
@@ -72,6 +74,7 @@ type GBans struct {
 	contests       contest.Contests
 	database       database.Database
 	demos          demo.Demos
+	exports        *ban.Exports
 	forums         forum.Forums
 	discordOAuth   discordoauth.DiscordOAuth
 	memberships    *ban.Memberships
@@ -86,6 +89,7 @@ type GBans struct {
 	speedruns      speedruns.Speedruns
 	sourcemod      sourcemod.Sourcemod
 	stats          stats.Stats
+	matchRecorder  *stats.Recorder
 	staticConfig   config.Static
 	tfapiClient    thirdparty.APIProvider
 	votes          votes.Votes
@@ -160,6 +164,7 @@ func (g *GBans) Init(ctx context.Context) error {
 	g.wordFilters = wordFilters
 
 	g.networks = network.NewNetworks(g.broadcaster, network.NewRepository(g.database, g.persons), conf.Network, conf.GeoLocation)
+	g.asnBlocker = asn.NewBlocker(asn.NewRepository(g.database))
 
 	assetRepo := asset.NewLocalRepository(g.database, conf.LocalStore.PathRoot)
 	if err := assetRepo.Init(ctx); err != nil {
@@ -171,36 +176,41 @@ func (g *GBans) Init(ctx context.Context) error {
 	g.assets = asset.NewAssets(assetRepo)
 
 	var errServer error
-	if g.servers, errServer = servers.New(servers.NewRepository(g.database), g.broadcaster, conf.General.SrcdsLogAddr); errServer != nil {
+	if g.servers, errServer = servers.New(servers.NewRepository(g.database), g.broadcaster, g.assets, steamid.New(conf.Owner), conf.General.SrcdsLogAddr); errServer != nil {
 		return errServer
 	}
 
 	mapsSvc := maps.New(maps.NewRepository(g.database))
 
 	g.stats = stats.New(stats.NewRepository(g.database), mapsSvc)
+	g.matchRecorder = stats.NewRecorder(g.stats, g.persons, g.broadcaster)
 
 	g.chat = chat.New(chat.NewRepository(g.database), conf.Filters, g.wordFilters, g.persons, g.notifications, g.chatHandler, conf.Discord.SafeChatLogChannelID())
 	g.demos = demo.NewDemos(asset.BucketDemo, demo.NewRepository(g.database), g.assets, g.stats, g.chat, g.persons, conf.Demo, steamid.New(conf.Owner))
-	g.reports = ban.NewReports(ban.NewReportRepository(g.database), g.persons, g.demos, g.tfapiClient, g.notifications,
-		conf.Discord.SafeAppealLogChannelID())
+	g.anticheat = anticheat.New(anticheat.NewRepository(g.database), conf.Anticheat, g.notifications, g.onAnticheatTrigger, g.persons)
+	g.reports = ban.NewReports(ban.NewReportRepository(g.database), ban.NewRepository(g.database), g.persons, g.demos,
+		g.chat, g.anticheat, g.tfapiClient, g.notifications, conf.Discord.SafeAppealLogChannelID())
 
 	g.bans = ban.New(ban.NewRepository(g.database), g.persons, conf.Discord.SafeBanLogChannelID(),
 		conf.Discord.SafeKickLogChannelID(), steamid.New(conf.Owner), g.reports, g.notifications, g.servers, g.networks)
 	g.blocklists = blocklist.NewBlocklists(blocklist.NewRepository(g.database),
 		ban.NewGroupMemberships(tfapiClient, ban.NewRepository(g.database)))
+	if errMatcher := g.blocklists.RebuildMatcher(ctx); errMatcher != nil {
+		slog.Error("Failed to load blocklist matcher", slog.String("error", errMatcher.Error()))
+	}
 	g.discordOAuth = discordoauth.NewOAuth(discordoauth.NewRepository(g.database), conf.Discord)
 	g.forums = forum.New(forum.NewRepository(g.database), g.notifications, g.persons, "")
 	g.metrics = metrics.New(g.broadcaster)
 	g.news = news.New(news.NewRepository(g.database), g.notifications, conf.Discord.SafePublicLogChannelID())
-	g.sourcemod = sourcemod.New(sourcemod.NewRepository(g.database), g.persons, g.notifications, conf.Discord.SafeSeedChannelID(), conf.Discord.LogChannelID, conf.Discord.SafeModPingRoleID(), g.servers)
+	g.sourcemod = sourcemod.New(sourcemod.NewRepository(g.database), g.persons, g.notifications, conf.Discord.SafeSeedChannelID(), conf.Discord.LogChannelID, conf.Discord.SafeModPingRoleID(), g.servers, &g.blocklists, g.asnBlocker)
 	g.wiki = wiki.New(wiki.NewRepository(g.database), g.notifications, conf.Discord.SafePublicLogChannelID(), conf.Discord.LogChannelID)
-	g.anticheat = anticheat.New(anticheat.NewRepository(g.database), conf.Anticheat, g.notifications, g.onAnticheatBan, g.persons)
 	g.votes = votes.New(votes.NewRepository(g.database), g.broadcaster, g.notifications,
 		conf.Discord.SafeVoteLogChannelID(), g.persons)
 
 	g.speedruns = speedruns.NewSpeedruns(speedruns.NewSpeedrunRepository(g.database, g.persons), mapsSvc)
 	g.memberships = ban.NewMemberships(ban.NewRepository(g.database), g.tfapiClient)
-	g.banExpirations = ban.NewExpirationMonitor(g.bans, g.persons, g.notifications)
+	g.banExpirations = ban.NewExpirationMonitor(g.bans)
+	g.exports = ban.NewExports(ban.NewExportRepository(g.database), conf.General.SiteName)
 	g.mge = mge.NewMGE(mge.NewRepository(g.database))
 	g.appeals = ban.NewAppeals(ban.NewAppealRepository(g.database), g.bans, g.persons, g.notifications, conf.Discord.SafeAppealLogChannelID())
 
@@ -279,31 +289,75 @@ func (g *GBans) createDiscordRoles(ctx context.Context) error {
 	return nil
 }
 
-func (g *GBans) chatHandler(ctx context.Context, exceeded bool, newWarning chat.NewUserWarning) error {
-	if !newWarning.MatchedFilter.IsEnabled {
-		return nil
-	}
+// chatHandler applies the escalation step reached by a players word filter warnings, see chat.Escalate.
+func (g *GBans) chatHandler(ctx context.Context, step chat.EscalationStep, newWarning chat.NewUserWarning) error {
+	conf := g.config.Config()
+	target := newWarning.UserMessage.SteamID
 
-	if !exceeded {
+	var (
+		validUntil time.Time
+		banType    bantype.Type
+	)
+
+	switch step.Action {
+	case chat.EscalationWarn:
 		const msg = "[WARN] Please refrain from using slurs/toxicity (see: rules & MOTD). " +
 			"Further offenses will result in mutes/bans"
-		if result, found := g.servers.FindPlayer(servers.FindOpts{SteamID: newWarning.UserMessage.SteamID}); found {
-			opts := servers.SayOpts{
-				Type:    servers.PSay,
-				Message: msg,
-				Targets: []steamid.SteamID{newWarning.UserMessage.SteamID},
-			}
+		if result, found := g.servers.FindPlayer(servers.FindOpts{SteamID: target}); found {
+			opts := servers.SayOpts{Type: servers.PSay, Message: msg, Targets: []steamid.SteamID{target}}
 			if errPSay := result.Server.Say(ctx, opts); errPSay != nil {
 				return errPSay
 			}
 		}
 
 		return nil
+	case chat.EscalationGag:
+		if result, found := g.servers.FindPlayer(servers.FindOpts{SteamID: target}); found {
+			if errGag := result.Server.Gag(ctx, target, newWarning.WarnReason.String()); errGag != nil {
+				return errGag
+			}
+		}
+	case chat.EscalationKick:
+		if result, found := g.servers.FindPlayer(servers.FindOpts{SteamID: target}); found {
+			if errKick := result.Server.Kick(ctx, target, newWarning.WarnReason.String()); errKick != nil {
+				return errKick
+			}
+		}
+	case chat.EscalationMute, chat.EscalationBan:
+		parsedDur, errDur := datetime.ParseDuration(step.Duration)
+		if errDur != nil {
+			return errors.Join(errDur, chat.ErrInvalidActionDuration)
+		}
+
+		validUntil = time.Now().Add(parsedDur)
+		banType = bantype.NoComm
+
+		if step.Action == chat.EscalationBan {
+			banType = bantype.Banned
+		}
+
+		if _, errBan := g.bans.Create(ctx, ban.Opts{
+			Origin:     ban.System,
+			SourceID:   steamid.New(conf.Owner),
+			TargetID:   target,
+			Reason:     newWarning.WarnReason,
+			Note:       "Automatic warning " + step.Action.String(),
+			ValidUntil: validUntil,
+			BanType:    banType,
+		}); errBan != nil {
+			return errBan
+		}
 	}
 
-	slog.Info("Warn limit exceeded",
-		slog.String("sid64", newWarning.UserMessage.SteamID.String()),
-		slog.Int("weight", int(newWarning.CurrentTotal)))
+	slog.Info("Chat warning escalated", slog.String("sid64", target.String()),
+		slog.String("action", step.Action.String()), slog.Int("weight", int(newWarning.CurrentTotal)))
+
+	if !conf.Filters.PingDiscord || validUntil.IsZero() {
+		return nil
+	}
+
+	go g.notifications.Send(notification.NewDiscord(conf.Discord.SafeWordFilterLogChannelID(),
+		chat.WarningMessage(newWarning, validUntil)))
 
 	return nil
 }
@@ -385,6 +439,10 @@ func (g *GBans) StartBackground(ctx context.Context) {
 	go g.networks.Start(ctx)
 	go g.notifications.Sender(ctx)
 
+	if conf.General.StatsEnabled {
+		go g.matchRecorder.Start(ctx)
+	}
+
 	go downloadManager(ctx, g.database, conf.SSH, g.demos, g.anticheat)
 
 	go func() {
@@ -412,6 +470,8 @@ func (g *GBans) StartBackground(ctx context.Context) {
 	defer blocklistTicker.Stop()
 	demoTicker := time.NewTicker(15 * time.Minute)
 	defer demoTicker.Stop()
+	appealTicker := time.NewTicker(time.Hour)
+	defer appealTicker.Stop()
 
 	for {
 		select {
@@ -429,8 +489,20 @@ func (g *GBans) StartBackground(ctx context.Context) {
 			}()
 		case <-blocklistTicker.C:
 			go g.blocklists.Sync(ctx)
+		case <-appealTicker.C:
+			go func() {
+				days := g.config.Config().Appeals.ReminderDays
+				if errRemind := g.appeals.Remind(ctx, time.Duration(days)*24*time.Hour); errRemind != nil {
+					slog.Error("Failed to send appeal reminders", slog.String("error", errRemind.Error()))
+				}
+			}()
 		case <-demoTicker.C:
 			go g.demos.Cleanup(ctx)
+			go func() {
+				if errSync := g.reports.SyncEvidenceDemos(ctx); errSync != nil {
+					slog.Error("Failed to sync report demos", slog.String("error", errSync.Error()))
+				}
+			}()
 			go func() {
 				if errSync := g.anticheat.SyncDemoIDs(ctx, 100); errSync != nil {
 					slog.Error("failed to sync anticheat demos")
@@ -440,7 +512,7 @@ func (g *GBans) StartBackground(ctx context.Context) {
 	}
 }
 
-func (g *GBans) createAPI(authMiddleware *rpc.Middleware) *http.ServeMux {
+func (g *GBans) createAPI(authMiddleware *rpc.Middleware, userAuth *auth.Authentication, roles *auth.Roles) *http.ServeMux {
 	interceptors := rpc.CreateInterceptors()
 	api := http.NewServeMux()
 	conf := g.config.Config()
@@ -448,13 +520,15 @@ func (g *GBans) createAPI(authMiddleware *rpc.Middleware) *http.ServeMux {
 	services := []rpc.Service{
 		anticheat.NewService(g.anticheat, authMiddleware, interceptors),
 		asset.NewService(g.assets, authMiddleware, interceptors),
-		auth.NewService(authMiddleware, interceptors),
+		auth.NewService(userAuth, authMiddleware, interceptors),
+		auth.NewRoleService(roles, authMiddleware, interceptors),
 		ban.NewAppealService(g.appeals, authMiddleware, interceptors),
 		ban.NewBanService(g.bans, authMiddleware, interceptors),
-		ban.NewExportService(g.bans, strings.Split(conf.Exports.AuthorizedKeys, ","), conf.General.SiteName),
+		ban.NewExportService(g.exports, strings.Split(conf.Exports.AuthorizedKeys, ","), conf.General.SiteName,
+			authMiddleware, interceptors),
 		ban.NewReportService(g.reports, authMiddleware, interceptors),
 		chat.NewService(g.chat, authMiddleware, interceptors),
-		chat.NewWordfilterService(g.wordFilters, g.chat, g.config.Config().Filters, authMiddleware, interceptors),
+		chat.NewWordfilterService(g.wordFilters, g.chat, authMiddleware, interceptors),
 		config.NewService(g.config, BuildVersion, authMiddleware, interceptors),
 		contest.NewService(g.contests, g.assets, authMiddleware, interceptors),
 		discord.NewService(g.bot, authMiddleware, interceptors),
@@ -463,13 +537,14 @@ func (g *GBans) createAPI(authMiddleware *rpc.Middleware) *http.ServeMux {
 		mge.NewService(g.mge, authMiddleware, interceptors),
 		blocklist.NewService(g.blocklists, authMiddleware, interceptors),
 		network.NewNetworkService(g.networks, authMiddleware, interceptors),
+		asn.NewService(g.asnBlocker, authMiddleware, interceptors),
 		news.NewService(g.news, authMiddleware, interceptors),
 		notification.NewService(g.notifications, authMiddleware, interceptors),
 		person.NewPersonService(g.persons, authMiddleware, interceptors),
 		servers.NewServersService(g.servers, authMiddleware, interceptors),
 		demo.NewService(g.demos, authMiddleware, interceptors),
 		speedruns.NewService(g.speedruns, authMiddleware, interceptors),
-		sourcemod.NewPluginService(g.sourcemod, g.persons, g.servers, g.bans,
+		sourcemod.NewPluginService(g.sourcemod, g.persons, g.servers, g.bans, g.networks, g.anticheat,
 			rpc.NewServerTokenGenerator(conf.General.SiteName, []byte(conf.HTTPCookieKey)), g.notifications, conf.Discord.LogChannelID, authMiddleware, interceptors),
 		sourcemod.NewSourcemodService(g.sourcemod, authMiddleware, interceptors),
 		stats.NewService(g.stats, g.servers, authMiddleware, interceptors),
@@ -513,18 +588,24 @@ func (g *GBans) Serve(rootCtx context.Context) error {
 		return err
 	}
 
-	userAuth := auth.NewAuthentication(auth.NewRepository(g.database), conf.General.SiteName, conf.HTTPCookieKey, g.persons, g.bans, g.servers, g.config.Config().General.SentryDSN)
+	userAuth := auth.NewAuthentication(auth.NewRepository(g.database), conf.General.SiteName, conf.HTTPCookieKey, g.persons, g.bans, g.servers, g.networks, g.config.Config().General.SentryDSN)
 	userAuth.StartExchange(ctx)
 
-	authMiddleware := rpc.NewMiddleware(conf.General.SiteName, conf.HTTPCookieKey)
+	roles := auth.NewRoles(auth.NewRepository(g.database))
+	if errRoles := roles.Load(ctx); errRoles != nil {
+		slog.Error("Could not load roles, using default capabilities", slog.String("error", errRoles.Error()))
+	}
+
+	authMiddleware := rpc.NewMiddleware(conf.General.SiteName, conf.HTTPCookieKey, userAuth, userAuth, roles)
 
 	asset.NewAssetHandler(mux, g.assets)
+	ban.NewExportHandler(mux, g.exports)
 	auth.NewAuthHandler(mux, userAuth, g.config, g.tfapiClient, g.notifications, authMiddleware)
 	discordoauth.NewDiscordOAuthHandler(mux, g.config, g.persons, g.discordOAuth)
 
 	mux.HandleFunc("GET /health", g.healthCheck)
 
-	apiHandler := g.createAPI(authMiddleware)
+	apiHandler := g.createAPI(authMiddleware, userAuth, roles)
 
 	topMux := http.NewServeMux()
 
@@ -635,98 +716,87 @@ func (g *GBans) firstTimeSetup(ctx context.Context) error {
 	return nil
 }
 
-func (g *GBans) onChatBan(ctx context.Context, warning chat.NewUserWarning) error {
-	var validUntil time.Time
-	if warning.MatchedFilter.Action == chat.FilterActionBan || warning.MatchedFilter.Action == chat.FilterActionMute {
-		parsedDur, errDur := duration.Parse(warning.MatchedFilter.Duration)
-		if errDur != nil {
-			return errors.Join(errDur, chat.ErrInvalidActionDuration)
-		}
-		validUntil = time.Now().Add(parsedDur.ToTimeDuration())
-	}
+func (g *GBans) onAnticheatTrigger(ctx context.Context, trigger anticheat.Trigger, entry logparse.StacEntry) (int32, error) {
+	conf := g.config.Config()
+	note := "```\n" + entry.Summary + "\n\nRaw log:\n" + entry.RawLog + "\n```"
 
 	var (
-		errBan error
-		newBan ban.Ban
-		req    = ban.Opts{
-			TargetID:   warning.UserMessage.SteamID,
-			Reason:     warning.WarnReason,
-			ReasonText: "",
-			Note:       "Automatic warning ban",
-			ValidUntil: validUntil,
-		}
+		banID   int32
+		banType = bantype.Unknown
 	)
-	switch warning.MatchedFilter.Action {
-	case chat.FilterActionMute:
-		req.BanType = bantype.NoComm
-		newBan, errBan = g.bans.Create(ctx, req)
-	case chat.FilterActionBan:
-		req.BanType = bantype.Banned
-		newBan, errBan = g.bans.Create(ctx, req)
-	case chat.FilterActionKick:
-		if result, found := g.servers.FindPlayer(servers.FindOpts{SteamID: warning.UserMessage.SteamID}); found {
-			errBan = result.Server.Kick(ctx, result.Player.SID, warning.WarnReason.String())
-		}
-	}
 
-	if errBan != nil {
-		return errBan
-	}
-
-	admin, err := g.persons.GetOrCreatePersonBySteamID(ctx, steamid.New(g.config.Config().Owner))
-	if err != nil {
-		return err
-	}
-
-	_, errSave := g.wordFilters.Edit(ctx, admin, warning.MatchedFilter.FilterID, warning.MatchedFilter)
-	if errSave != nil {
-		return errSave
-	}
+	switch trigger.Action {
+	case anticheat.ActionBan:
+		banType = bantype.Banned
+	case anticheat.ActionGag:
+		banType = bantype.NoComm
+	case anticheat.ActionKick:
+		if result, found := g.servers.FindPlayer(servers.FindOpts{SteamID: entry.SteamID}); found {
+			if errKick := result.Server.Kick(ctx, result.Player.SID, reason.Cheating.String()); errKick != nil {
+				return 0, errKick
+			}
+		}
+	case anticheat.ActionNotify:
+	}
+
+	if banType != bantype.Unknown {
+		// Live detections usually arrive before their demo has been uploaded, the demo is only attached
+		// when it is already known.
+		var (
+			demoID   *int32
+			demoTick *int32
+		)
+
+		if entry.DemoName != "" {
+			demoFile, errDemo := g.demos.GetDemoByName(ctx, entry.DemoName)
+			if errDemo != nil && !errors.Is(errDemo, database.ErrNoResult) {
+				slog.Warn("Failed to load anticheat demo", slog.String("demo", entry.DemoName), slog.String("error", errDemo.Error()))
+			} else if errDemo == nil && demoFile != nil && demoFile.DemoID > 0 {
+				demoID = &demoFile.DemoID
+				demoTick = &entry.DemoTick
+			}
+		}
 
-	if !g.config.Config().Filters.PingDiscord {
-		return nil
-	}
+		validUntil := time.Now().AddDate(10, 0, 0)
+		if trigger.Duration > 0 {
+			validUntil = time.Now().Add(trigger.Duration)
+		}
 
-	go g.notifications.Send(notification.NewDiscord(g.config.Config().Discord.SafeWordFilterLogChannelID(),
-		chat.WarningMessage(warning, newBan.ValidUntil)))
+		newBan, err := g.bans.Create(ctx, ban.Opts{
+			Origin:      ban.System,
+			SourceID:    steamid.New(conf.Owner),
+			TargetID:    entry.SteamID,
+			ValidUntil:  validUntil,
+			BanType:     banType,
+			Reason:      reason.Cheating,
+			ReasonText:  "",
+			Note:        "Anticheat rule: " + trigger.RuleName + "\n" + note,
+			DemoID:      demoID,
+			DemoTick:    demoTick,
+			AnticheatID: &entry.AnticheatID,
+			EvadeOk:     false,
+			Name:        entry.Name,
+		})
+		if err != nil && !errors.Is(err, database.ErrDuplicate) {
+			slog.Error("Failed to ban cheater", slog.String("rule", trigger.RuleName),
+				slog.Int64("steam_id", entry.SteamID.Int64()), slog.String("error", err.Error()))
 
-	return nil
-}
+			return 0, err
+		}
 
-func (g *GBans) onAnticheatBan(ctx context.Context, entry logparse.StacEntry, dur time.Duration, count int32) error {
-	conf := g.config.Config()
-	demoFile, errDemo := g.demos.GetDemoByName(ctx, entry.DemoName)
-	if errDemo != nil || entry.DemoID == nil || *entry.DemoID <= 0 {
-		return errDemo
-	}
-	newBan, err := g.bans.Create(ctx, ban.Opts{
-		Origin:      ban.System,
-		SourceID:    steamid.New(conf.Owner),
-		TargetID:    entry.SteamID,
-		ValidUntil:  time.Now().Add(dur),
-		BanType:     bantype.Banned,
-		Reason:      reason.Cheating,
-		ReasonText:  "",
-		Note:        "```\n" + entry.Summary + "\n\nRaw log:\n" + entry.RawLog + "\n```",
-		DemoID:      &demoFile.DemoID,
-		DemoTick:    &entry.DemoTick,
-		AnticheatID: &entry.AnticheatID,
-		EvadeOk:     false,
-		Name:        entry.Name,
-	})
-	if err != nil && !errors.Is(err, database.ErrDuplicate) {
-		slog.Error("Failed to ban cheater", slog.String("detection", string(entry.Detection)),
-			slog.Int64("steam_id", entry.SteamID.Int64()), slog.String("error", err.Error()))
+		if newBan.BanID <= 0 {
+			return 0, nil
+		}
 
-		return err
-	} else if newBan.BanID > 0 {
-		slog.Info("Banned cheater", slog.String("detection", string(entry.Detection)),
-			slog.String("steam_id", entry.SteamID.String()))
-		g.notifications.Send(notification.NewDiscord(conf.Discord.AnticheatChannelID,
-			anticheat.NewAnticheatTrigger(newBan.Note, conf.Anticheat.Action, entry, count)))
+		banID = newBan.BanID
+		note = newBan.Note
+		slog.Info("Banned cheater", slog.String("rule", trigger.RuleName), slog.String("steam_id", entry.SteamID.String()))
 	}
 
-	return nil
+	g.notifications.Send(notification.NewDiscord(conf.Discord.AnticheatChannelID,
+		anticheat.NewAnticheatTrigger(note, trigger, entry)))
+
+	return banID, nil
 }
 
 func (g *GBans) healthCheck(res http.ResponseWriter, _ *http.Request) {
@@ -12,7 +12,10 @@ import (
 
 	"github.com/leighmacdonald/gbans/internal/database"
 	"github.com/leighmacdonald/gbans/internal/demo"
+	"github.com/leighmacdonald/gbans/internal/log"
 	"github.com/leighmacdonald/gbans/internal/servers"
+	"github.com/leighmacdonald/gbans/internal/sourcebans"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 	"github.com/spf13/cobra"
 )
 
@@ -22,6 +25,7 @@ func importCmd() *cobra.Command {
 		Short: "Import existing data",
 	}
 	cmd.AddCommand(importDemoCmd())
+	cmd.AddCommand(importSourcebansCmd())
 
 	return cmd
 }
@@ -122,6 +126,69 @@ func importDemoCmd() *cobra.Command {
 	return cmd
 }
 
+func importSourcebansCmd() *cobra.Command {
+	var (
+		dsn    string
+		prefix string
+		dryRun bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sourcebans",
+		Short: "Import bans, comm blocks, admins, groups and overrides from a SourceBans++ or MaterialAdmin database",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := cmd.Context()
+
+			source, errSource := sourcebans.Open(ctx, dsn, prefix)
+			if errSource != nil {
+				return errSource
+			}
+
+			defer log.Closer(source)
+
+			app, errApp := New()
+			if errApp != nil {
+				return errApp
+			}
+
+			defer func() {
+				if errClose := app.Shutdown(ctx); errClose != nil {
+					slog.Error("Error closing", slog.String("error", errClose.Error()))
+				}
+			}()
+
+			if errSetup := app.Init(ctx); errSetup != nil {
+				return errSetup
+			}
+
+			importer := sourcebans.NewImporter(source, app.bans, app.persons, app.sourcemod, steamid.New(app.config.Config().Owner))
+
+			result, errImport := importer.Import(ctx, dryRun)
+			if errImport != nil {
+				return errImport
+			}
+
+			slog.Info("SourceBans import complete", slog.Bool("dry_run", dryRun),
+				slog.String("groups", result.Groups.String()),
+				slog.String("group_overrides", result.GroupOverrides.String()),
+				slog.String("overrides", result.Overrides.String()),
+				slog.String("admins", result.Admins.String()),
+				slog.String("bans", result.Bans.String()),
+				slog.String("comms", result.Comms.String()))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&dsn, "dsn", "", "MySQL DSN of the SourceBans database, e.g. user:pass@tcp(localhost:3306)/sourcebans")
+	cmd.Flags().StringVar(&prefix, "prefix", "sb", "Table prefix used by the SourceBans install")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Report what would be imported without writing anything")
+	_ = cmd.MarkFlagRequired("dsn")
+
+	return cmd
+}
+
 func isDir(path string) bool {
 	fileInfo, err := os.Stat(path)
 	if err != nil {
@@ -92,6 +92,7 @@ type Config struct {
 	LocalStore  *asset.Config
 	Exports     *ban.Config
 	Anticheat   *anticheat.Config
+	Appeals     *ban.AppealConfig
 }
 
 func (c Config) ExtURLRaw(path string, args ...any) string {
@@ -192,6 +193,7 @@ func NewConfiguration(ctx context.Context, static Static, repository Repo) (*Con
 			LocalStore:  &asset.Config{},
 			Exports:     &ban.Config{},
 			Anticheat:   &anticheat.Config{},
+			Appeals:     &ban.AppealConfig{},
 		},
 	}
 
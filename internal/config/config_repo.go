@@ -54,7 +54,7 @@ func (c *Repository) Read(ctx context.Context) (Config, error) {
 			   general_speedruns_enabled, general_playerqueue_enabled, general_favicon, general_sentry_dsn, general_sentry_dsn_web,
 			   general_mge_enabled,
 
-		       filters_enabled, filters_dry, filters_ping_discord, filters_max_weight, filters_warning_timeout, filters_check_timeout, filters_match_timeout,
+		       filters_enabled, filters_dry, filters_ping_discord, filters_warning_timeout,
 
 		       demo_cleanup_enabled, demo_cleanup_strategy, demo_cleanup_min_pct, demo_cleanup_mount, demo_count_limit, demo_parser_url,
 
@@ -69,6 +69,7 @@ func (c *Repository) Read(ctx context.Context) (Config, error) {
 		       logging_level, logging_file, logging_http_enabled, logging_http_otel_enabled, logging_http_level,
 
 		       ip2location_enabled, ip2location_cache_path, ip2location_token,
+		       geo_provider, geo_maxmind_city_path, geo_maxmind_asn_path,
 
 		       debug_skip_open_id_validation, debug_add_rcon_log_address,
 
@@ -79,11 +80,11 @@ func (c *Repository) Read(ctx context.Context) (Config, error) {
 
 		       exports_bd_enabled, exports_valve_enabled, exports_authorized_keys,
 
-		       anticheat_enabled, anticheat_action, anticheat_duration, anticheat_max_aim_snap, anticheat_max_psilent, anticheat_max_bhop,
-		       anticheat_max_fake_ang, anticheat_max_cmd_num, anticheat_max_too_many_connections, anticheat_max_cheat_cvar,
-		       anticheat_max_oob_var, anticheat_max_invalid_user_cmd, discord_anticheat_channel_id,
+		       anticheat_enabled, discord_anticheat_channel_id,
 
-		       network_sdr_enabled
+		       network_sdr_enabled, network_alt_score_threshold,
+
+		       appeals_reminder_days
 		 FROM config`
 
 	var (
@@ -102,6 +103,7 @@ func (c *Repository) Read(ctx context.Context) (Config, error) {
 			LocalStore:  &asset.Config{},
 			Exports:     &ban.Config{},
 			Anticheat:   &anticheat.Config{},
+			Appeals:     &ban.AppealConfig{},
 		}
 		authorizedKeys []string
 	)
@@ -112,7 +114,7 @@ func (c *Repository) Read(ctx context.Context) (Config, error) {
 			&cfg.General.StatsEnabled, &cfg.General.ServersEnabled, &cfg.General.ReportsEnabled, &cfg.General.ChatlogsEnabled, &cfg.General.DemosEnabled, &cfg.General.SpeedrunsEnabled,
 			&cfg.General.PlayerqueueEnabled, &cfg.General.Favicon, &cfg.General.SentryDSN, &cfg.General.SentryDSNWeb,
 			&cfg.General.MGEEnabled,
-			&cfg.Filters.Enabled, &cfg.Filters.Dry, &cfg.Filters.PingDiscord, &cfg.Filters.MaxWeight, &cfg.Filters.WarningTimeout, &cfg.Filters.CheckTimeout, &cfg.Filters.MatchTimeout,
+			&cfg.Filters.Enabled, &cfg.Filters.Dry, &cfg.Filters.PingDiscord, &cfg.Filters.WarningTimeout,
 			&cfg.Demo.DemoCleanupEnabled, &cfg.Demo.DemoCleanupStrategy, &cfg.Demo.DemoCleanupMinPct, &cfg.Demo.DemoCleanupMount, &cfg.Demo.DemoCountLimit, &cfg.Demo.DemoParserURL,
 			&cfg.Patreon.Enabled, &cfg.Patreon.ClientID, &cfg.Patreon.ClientSecret, &cfg.Patreon.CreatorAccessToken, &cfg.Patreon.CreatorRefreshToken, &cfg.Patreon.IntegrationsEnabled,
 			&cfg.Discord.Enabled, &cfg.Discord.AppID, &cfg.Discord.AppSecret, &cfg.Discord.LinkID, &cfg.Discord.Token, &cfg.Discord.GuildID, &cfg.Discord.LogChannelID,
@@ -122,15 +124,15 @@ func (c *Repository) Read(ctx context.Context) (Config, error) {
 			&cfg.Discord.SeedChannelID, &cfg.Discord.ChatLogChannelID,
 			&cfg.Log.Level, &cfg.Log.File, &cfg.Log.HTTPEnabled, &cfg.Log.HTTPOtelEnabled, &cfg.Log.HTTPLevel,
 			&cfg.GeoLocation.Enabled, &cfg.GeoLocation.CachePath, &cfg.GeoLocation.Token,
+			&cfg.Network.GeoSource, &cfg.Network.MaxMindCityPath, &cfg.Network.MaxMindASNPath,
 			&cfg.Debug.SkipOpenIDValidation, &cfg.Debug.AddRCONLogAddress,
 			&cfg.LocalStore.PathRoot,
 			&cfg.SSH.Enabled, &cfg.SSH.Username, &cfg.SSH.Password, &cfg.SSH.Port, &cfg.SSH.PrivateKeyPath, &cfg.SSH.UpdateInterval,
 			&cfg.SSH.Timeout, &cfg.SSH.DemoPathFmt, &cfg.SSH.StacPathFmt, &cfg.SSH.HostKeyStrategy,
 			&cfg.Exports.BDEnabled, &cfg.Exports.ValveEnabled, &authorizedKeys,
-			&cfg.Anticheat.Enabled, &cfg.Anticheat.Action, &cfg.Anticheat.Duration, &cfg.Anticheat.MaxAimSnap, &cfg.Anticheat.MaxPsilent,
-			&cfg.Anticheat.MaxBhop, &cfg.Anticheat.MaxFakeAng, &cfg.Anticheat.MaxCmdNum, &cfg.Anticheat.MaxTooManyConnections,
-			&cfg.Anticheat.MaxCheatCvar, &cfg.Anticheat.MaxOOBVar, &cfg.Anticheat.MaxInvalidUserCmd, &cfg.Discord.AnticheatChannelID,
-			&cfg.Network.SDREnabled,
+			&cfg.Anticheat.Enabled, &cfg.Discord.AnticheatChannelID,
+			&cfg.Network.SDREnabled, &cfg.Network.AltScoreThreshold,
+			&cfg.Appeals.ReminderDays,
 		)
 	if err != nil {
 		return cfg, database.Err(err)
@@ -191,10 +193,7 @@ func (c *Repository) Write(ctx context.Context, config Config) error {
 			"filters_enabled":                     config.Filters.Enabled,
 			"filters_dry":                         config.Filters.Dry,
 			"filters_ping_discord":                config.Filters.PingDiscord,
-			"filters_max_weight":                  config.Filters.MaxWeight,
 			"filters_warning_timeout":             config.Filters.WarningTimeout,
-			"filters_check_timeout":               config.Filters.CheckTimeout,
-			"filters_match_timeout":               config.Filters.MatchTimeout,
 			"demo_cleanup_enabled":                config.Demo.DemoCleanupEnabled,
 			"demo_cleanup_strategy":               config.Demo.DemoCleanupStrategy,
 			"demo_cleanup_min_pct":                config.Demo.DemoCleanupMinPct,
@@ -237,6 +236,9 @@ func (c *Repository) Write(ctx context.Context, config Config) error {
 			"ip2location_enabled":                 config.GeoLocation.Enabled,
 			"ip2location_cache_path":              config.GeoLocation.CachePath,
 			"ip2location_token":                   config.GeoLocation.Token,
+			"geo_provider":                        config.Network.GeoSource,
+			"geo_maxmind_city_path":               config.Network.MaxMindCityPath,
+			"geo_maxmind_asn_path":                config.Network.MaxMindASNPath,
 			"debug_skip_open_id_validation":       config.Debug.SkipOpenIDValidation,
 			"debug_add_rcon_log_address":          config.Debug.AddRCONLogAddress,
 			"local_store_path_root":               config.LocalStore.PathRoot,
@@ -254,17 +256,8 @@ func (c *Repository) Write(ctx context.Context, config Config) error {
 			"exports_valve_enabled":               config.Exports.ValveEnabled,
 			"exports_authorized_keys":             strings.Split(config.Exports.AuthorizedKeys, ","),
 			"anticheat_enabled":                   config.Anticheat.Enabled,
-			"anticheat_action":                    config.Anticheat.Action,
-			"anticheat_duration":                  config.Anticheat.Duration,
-			"anticheat_max_aim_snap":              config.Anticheat.MaxAimSnap,
-			"anticheat_max_psilent":               config.Anticheat.MaxPsilent,
-			"anticheat_max_bhop":                  config.Anticheat.MaxBhop,
-			"anticheat_max_fake_ang":              config.Anticheat.MaxFakeAng,
-			"anticheat_max_cmd_num":               config.Anticheat.MaxCmdNum,
-			"anticheat_max_too_many_connections":  config.Anticheat.MaxTooManyConnections,
-			"anticheat_max_cheat_cvar":            config.Anticheat.MaxCheatCvar,
-			"anticheat_max_oob_var":               config.Anticheat.MaxOOBVar,
-			"anticheat_max_invalid_user_cmd":      config.Anticheat.MaxInvalidUserCmd,
 			"network_sdr_enabled":                 config.Network.SDREnabled,
+			"network_alt_score_threshold":         config.Network.AltScoreThreshold,
+			"appeals_reminder_days":               config.Appeals.ReminderDays,
 		})))
 }
@@ -99,6 +99,7 @@ func (r *Service) Update(ctx context.Context, request *configv1.UpdateRequest) (
 	inDebug := inCfg.GetDebug()
 	inAC := inCfg.GetAnticheat()
 	inExports := inCfg.GetExports()
+	inAppeals := inCfg.GetAppeals()
 	inLocalStore := inCfg.GetLocalStore()
 	inDemo := inCfg.GetDemo()
 	inNetwork := inCfg.GetNetwork()
@@ -151,9 +152,6 @@ func (r *Service) Update(ctx context.Context, request *configv1.UpdateRequest) (
 			WarningLimit:   inFilters.GetWarningLimit(),
 			Dry:            inFilters.GetDry(),
 			PingDiscord:    inFilters.GetPingDiscord(),
-			MaxWeight:      inFilters.GetMaxWeight(),
-			CheckTimeout:   inFilters.GetCheckTimeout(),
-			MatchTimeout:   inFilters.GetMatchTimeout(),
 		},
 		Discord: &discord.Config{
 			Enabled:                 inDiscord.GetEnabled(),
@@ -215,7 +213,11 @@ func (r *Service) Update(ctx context.Context, request *configv1.UpdateRequest) (
 			StacPathFmt:     inSSH.GetStacPathFmt(),
 		},
 		Network: &network.Config{
-			SDREnabled: inNetwork.GetSdrEnabled(),
+			SDREnabled:        inNetwork.GetSdrEnabled(),
+			AltScoreThreshold: inNetwork.GetAltScoreThreshold(),
+			GeoSource:         fromGeoProvider(inGeo.GetProvider()),
+			MaxMindCityPath:   inGeo.GetMaxmindCityPath(),
+			MaxMindASNPath:    inGeo.GetMaxmindAsnPath(),
 		},
 		LocalStore: &asset.Config{
 			PathRoot: inLocalStore.GetPathRoot(),
@@ -226,18 +228,10 @@ func (r *Service) Update(ctx context.Context, request *configv1.UpdateRequest) (
 			AuthorizedKeys: strings.Join(inExports.GetAuthorizedKeys(), ","),
 		},
 		Anticheat: &anticheat.Config{
-			Enabled:               inAC.GetEnabled(),
-			Action:                fromAction(inAC.GetAction()),
-			Duration:              inAC.GetDuration(),
-			MaxAimSnap:            inAC.GetMaxAimSnaps(),
-			MaxPsilent:            inAC.GetMaxPsilent(),
-			MaxBhop:               inAC.GetMaxBhop(),
-			MaxFakeAng:            inAC.GetMaxFakeAng(),
-			MaxCmdNum:             inAC.GetMaxCmdNum(),
-			MaxTooManyConnections: inAC.GetMaxTooManyConnections(),
-			MaxCheatCvar:          inAC.GetMaxCheatCvar(),
-			MaxOOBVar:             inAC.GetMaxOobVar(),
-			MaxInvalidUserCmd:     inAC.GetMaxInvalidUserCmd(),
+			Enabled: inAC.GetEnabled(),
+		},
+		Appeals: &ban.AppealConfig{
+			ReminderDays: inAppeals.GetReminderDays(),
 		},
 	}
 	if errWrite := r.config.Write(ctx, conf); errWrite != nil {
@@ -316,9 +310,6 @@ func toConfig(conf Config) *configv1.Config {
 			WarningLimit:   new(conf.Filters.WarningLimit),
 			Dry:            &conf.Filters.Dry,
 			PingDiscord:    &conf.Filters.PingDiscord,
-			MaxWeight:      new(conf.Filters.MaxWeight),
-			CheckTimeout:   new(conf.Filters.CheckTimeout),
-			MatchTimeout:   new(conf.Filters.MatchTimeout),
 		},
 		Log: &configv1.Log{
 			Level:           new(toLevel(conf.Log.Level)),
@@ -328,8 +319,11 @@ func toConfig(conf Config) *configv1.Config {
 			HttpLevel:       new(toLevel(conf.Log.HTTPLevel)),
 		},
 		GeoLocation: &configv1.GeoLocation{
-			Enabled:   &conf.GeoLocation.Enabled,
-			CachePath: &conf.GeoLocation.CachePath,
+			Enabled:         &conf.GeoLocation.Enabled,
+			CachePath:       &conf.GeoLocation.CachePath,
+			Provider:        new(toGeoProvider(conf.Network.GeoSource)),
+			MaxmindCityPath: &conf.Network.MaxMindCityPath,
+			MaxmindAsnPath:  &conf.Network.MaxMindASNPath,
 		},
 		Ssh: &configv1.SSH{
 			Enabled:         &conf.SSH.Enabled,
@@ -342,7 +336,8 @@ func toConfig(conf Config) *configv1.Config {
 			StacPathFmt:     &conf.SSH.StacPathFmt,
 		},
 		Network: &configv1.Network{
-			SdrEnabled: &conf.Network.SDREnabled,
+			SdrEnabled:        &conf.Network.SDREnabled,
+			AltScoreThreshold: &conf.Network.AltScoreThreshold,
 		},
 		LocalStore: &configv1.LocalStore{
 			PathRoot: &conf.LocalStore.PathRoot,
@@ -353,18 +348,10 @@ func toConfig(conf Config) *configv1.Config {
 			AuthorizedKeys: strings.Split(conf.Exports.AuthorizedKeys, ","),
 		},
 		Anticheat: &configv1.Anticheat{
-			Enabled:               &conf.Anticheat.Enabled,
-			Action:                new(toAction(conf.Anticheat.Action)),
-			Duration:              new(conf.Anticheat.Duration),
-			MaxAimSnaps:           new(conf.Anticheat.MaxAimSnap),
-			MaxPsilent:            new(conf.Anticheat.MaxPsilent),
-			MaxBhop:               new(conf.Anticheat.MaxBhop),
-			MaxFakeAng:            new(conf.Anticheat.MaxFakeAng),
-			MaxCmdNum:             new(conf.Anticheat.MaxCmdNum),
-			MaxTooManyConnections: new(conf.Anticheat.MaxTooManyConnections),
-			MaxOobVar:             new(conf.Anticheat.MaxOOBVar),
-			MaxInvalidUserCmd:     new(conf.Anticheat.MaxInvalidUserCmd),
-			MaxCheatCvar:          new(conf.Anticheat.MaxCheatCvar),
+			Enabled: &conf.Anticheat.Enabled,
+		},
+		Appeals: &configv1.Appeals{
+			ReminderDays: &conf.Appeals.ReminderDays,
 		},
 	}
 }
@@ -435,6 +422,28 @@ func toDemoStrategy(strategy demo.Strategy) configv1.DemoStrategy {
 	}
 }
 
+func fromGeoProvider(provider configv1.GeoProvider) network.GeoSource {
+	switch provider {
+	case configv1.GeoProvider_GEO_PROVIDER_MAXMIND:
+		return network.GeoSourceMaxMind
+	case configv1.GeoProvider_GEO_PROVIDER_IP2LOCATION_UNSPECIFIED:
+		fallthrough
+	default:
+		return network.GeoSourceIP2Location
+	}
+}
+
+func toGeoProvider(source network.GeoSource) configv1.GeoProvider {
+	switch source {
+	case network.GeoSourceMaxMind:
+		return configv1.GeoProvider_GEO_PROVIDER_MAXMIND
+	case network.GeoSourceIP2Location:
+		fallthrough
+	default:
+		return configv1.GeoProvider_GEO_PROVIDER_IP2LOCATION_UNSPECIFIED
+	}
+}
+
 func fromLevel(level configv1.Level) log.Level {
 	switch level {
 	case configv1.Level_LEVEL_DEBUG:
@@ -464,29 +473,3 @@ func toLevel(level log.Level) configv1.Level {
 		return configv1.Level_LEVEL_ERROR_UNSPECIFIED
 	}
 }
-
-func toAction(action anticheat.Action) configv1.Action {
-	switch action {
-	case anticheat.ActionGag:
-		return configv1.Action_ACTION_GAG
-	case anticheat.ActionBan:
-		return configv1.Action_ACTION_BAN
-	case anticheat.ActionKick:
-		fallthrough
-	default:
-		return configv1.Action_ACTION_KICK_UNSPECIFIED
-	}
-}
-
-func fromAction(action configv1.Action) anticheat.Action {
-	switch action {
-	case configv1.Action_ACTION_BAN:
-		return anticheat.ActionBan
-	case configv1.Action_ACTION_GAG:
-		return anticheat.ActionGag
-	case configv1.Action_ACTION_KICK_UNSPECIFIED:
-		fallthrough
-	default:
-		return anticheat.ActionKick
-	}
-}
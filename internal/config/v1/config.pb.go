@@ -215,101 +215,98 @@ func (Level) EnumDescriptor() ([]byte, []int) {
 	return file_config_v1_config_proto_rawDescGZIP(), []int{3}
 }
 
-type HostKeyStrategy int32
+type GeoProvider int32
 
 const (
-	HostKeyStrategy_HOST_KEY_STRATEGY_AUTO_ACCEPT_UNSPECIFIED HostKeyStrategy = 0
-	HostKeyStrategy_HOST_KEY_STRATEGY_ACCEPT_FIRST            HostKeyStrategy = 1
-	HostKeyStrategy_HOST_KEY_STRATEGY_IGNORE_ALL              HostKeyStrategy = 2
+	GeoProvider_GEO_PROVIDER_IP2LOCATION_UNSPECIFIED GeoProvider = 0
+	GeoProvider_GEO_PROVIDER_MAXMIND                 GeoProvider = 1
 )
 
-// Enum value maps for HostKeyStrategy.
+// Enum value maps for GeoProvider.
 var (
-	HostKeyStrategy_name = map[int32]string{
-		0: "HOST_KEY_STRATEGY_AUTO_ACCEPT_UNSPECIFIED",
-		1: "HOST_KEY_STRATEGY_ACCEPT_FIRST",
-		2: "HOST_KEY_STRATEGY_IGNORE_ALL",
+	GeoProvider_name = map[int32]string{
+		0: "GEO_PROVIDER_IP2LOCATION_UNSPECIFIED",
+		1: "GEO_PROVIDER_MAXMIND",
 	}
-	HostKeyStrategy_value = map[string]int32{
-		"HOST_KEY_STRATEGY_AUTO_ACCEPT_UNSPECIFIED": 0,
-		"HOST_KEY_STRATEGY_ACCEPT_FIRST":            1,
-		"HOST_KEY_STRATEGY_IGNORE_ALL":              2,
+	GeoProvider_value = map[string]int32{
+		"GEO_PROVIDER_IP2LOCATION_UNSPECIFIED": 0,
+		"GEO_PROVIDER_MAXMIND":                 1,
 	}
 )
 
-func (x HostKeyStrategy) Enum() *HostKeyStrategy {
-	p := new(HostKeyStrategy)
+func (x GeoProvider) Enum() *GeoProvider {
+	p := new(GeoProvider)
 	*p = x
 	return p
 }
 
-func (x HostKeyStrategy) String() string {
+func (x GeoProvider) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (HostKeyStrategy) Descriptor() protoreflect.EnumDescriptor {
+func (GeoProvider) Descriptor() protoreflect.EnumDescriptor {
 	return file_config_v1_config_proto_enumTypes[4].Descriptor()
 }
 
-func (HostKeyStrategy) Type() protoreflect.EnumType {
+func (GeoProvider) Type() protoreflect.EnumType {
 	return &file_config_v1_config_proto_enumTypes[4]
 }
 
-func (x HostKeyStrategy) Number() protoreflect.EnumNumber {
+func (x GeoProvider) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use HostKeyStrategy.Descriptor instead.
-func (HostKeyStrategy) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use GeoProvider.Descriptor instead.
+func (GeoProvider) EnumDescriptor() ([]byte, []int) {
 	return file_config_v1_config_proto_rawDescGZIP(), []int{4}
 }
 
-type Action int32
+type HostKeyStrategy int32
 
 const (
-	Action_ACTION_KICK_UNSPECIFIED Action = 0
-	Action_ACTION_GAG              Action = 1
-	Action_ACTION_BAN              Action = 2
+	HostKeyStrategy_HOST_KEY_STRATEGY_AUTO_ACCEPT_UNSPECIFIED HostKeyStrategy = 0
+	HostKeyStrategy_HOST_KEY_STRATEGY_ACCEPT_FIRST            HostKeyStrategy = 1
+	HostKeyStrategy_HOST_KEY_STRATEGY_IGNORE_ALL              HostKeyStrategy = 2
 )
 
-// Enum value maps for Action.
+// Enum value maps for HostKeyStrategy.
 var (
-	Action_name = map[int32]string{
-		0: "ACTION_KICK_UNSPECIFIED",
-		1: "ACTION_GAG",
-		2: "ACTION_BAN",
+	HostKeyStrategy_name = map[int32]string{
+		0: "HOST_KEY_STRATEGY_AUTO_ACCEPT_UNSPECIFIED",
+		1: "HOST_KEY_STRATEGY_ACCEPT_FIRST",
+		2: "HOST_KEY_STRATEGY_IGNORE_ALL",
 	}
-	Action_value = map[string]int32{
-		"ACTION_KICK_UNSPECIFIED": 0,
-		"ACTION_GAG":              1,
-		"ACTION_BAN":              2,
+	HostKeyStrategy_value = map[string]int32{
+		"HOST_KEY_STRATEGY_AUTO_ACCEPT_UNSPECIFIED": 0,
+		"HOST_KEY_STRATEGY_ACCEPT_FIRST":            1,
+		"HOST_KEY_STRATEGY_IGNORE_ALL":              2,
 	}
 )
 
-func (x Action) Enum() *Action {
-	p := new(Action)
+func (x HostKeyStrategy) Enum() *HostKeyStrategy {
+	p := new(HostKeyStrategy)
 	*p = x
 	return p
 }
 
-func (x Action) String() string {
+func (x HostKeyStrategy) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Action) Descriptor() protoreflect.EnumDescriptor {
+func (HostKeyStrategy) Descriptor() protoreflect.EnumDescriptor {
 	return file_config_v1_config_proto_enumTypes[5].Descriptor()
 }
 
-func (Action) Type() protoreflect.EnumType {
+func (HostKeyStrategy) Type() protoreflect.EnumType {
 	return &file_config_v1_config_proto_enumTypes[5]
 }
 
-func (x Action) Number() protoreflect.EnumNumber {
+func (x HostKeyStrategy) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Action.Descriptor instead.
-func (Action) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use HostKeyStrategy.Descriptor instead.
+func (HostKeyStrategy) EnumDescriptor() ([]byte, []int) {
 	return file_config_v1_config_proto_rawDescGZIP(), []int{5}
 }
 
@@ -1064,9 +1061,6 @@ type Filters struct {
 	WarningLimit   *int32                 `protobuf:"varint,3,opt,name=warning_limit,json=warningLimit" json:"warning_limit,omitempty"`
 	Dry            *bool                  `protobuf:"varint,4,opt,name=dry" json:"dry,omitempty"`
 	PingDiscord    *bool                  `protobuf:"varint,5,opt,name=ping_discord,json=pingDiscord" json:"ping_discord,omitempty"`
-	MaxWeight      *int32                 `protobuf:"varint,6,opt,name=max_weight,json=maxWeight" json:"max_weight,omitempty"`
-	CheckTimeout   *int32                 `protobuf:"varint,7,opt,name=check_timeout,json=checkTimeout" json:"check_timeout,omitempty"`
-	MatchTimeout   *int32                 `protobuf:"varint,8,opt,name=match_timeout,json=matchTimeout" json:"match_timeout,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1136,27 +1130,6 @@ func (x *Filters) GetPingDiscord() bool {
 	return false
 }
 
-func (x *Filters) GetMaxWeight() int32 {
-	if x != nil && x.MaxWeight != nil {
-		return *x.MaxWeight
-	}
-	return 0
-}
-
-func (x *Filters) GetCheckTimeout() int32 {
-	if x != nil && x.CheckTimeout != nil {
-		return *x.CheckTimeout
-	}
-	return 0
-}
-
-func (x *Filters) GetMatchTimeout() int32 {
-	if x != nil && x.MatchTimeout != nil {
-		return *x.MatchTimeout
-	}
-	return 0
-}
-
 type Discord struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	Enabled                 *bool                  `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
@@ -1482,12 +1455,16 @@ func (x *Log) GetHttpLevel() Level {
 }
 
 type GeoLocation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enabled       *bool                  `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
-	CachePath     *string                `protobuf:"bytes,2,opt,name=cache_path,json=cachePath" json:"cache_path,omitempty"`
-	Token         *string                `protobuf:"bytes,3,opt,name=token" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Enabled   *bool                  `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	CachePath *string                `protobuf:"bytes,2,opt,name=cache_path,json=cachePath" json:"cache_path,omitempty"`
+	Token     *string                `protobuf:"bytes,3,opt,name=token" json:"token,omitempty"`
+	Provider  *GeoProvider           `protobuf:"varint,4,opt,name=provider,enum=config.v1.GeoProvider" json:"provider,omitempty"`
+	// Paths to the GeoLite2-City.mmdb and GeoLite2-ASN.mmdb files used by the maxmind provider.
+	MaxmindCityPath *string `protobuf:"bytes,5,opt,name=maxmind_city_path,json=maxmindCityPath" json:"maxmind_city_path,omitempty"`
+	MaxmindAsnPath  *string `protobuf:"bytes,6,opt,name=maxmind_asn_path,json=maxmindAsnPath" json:"maxmind_asn_path,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GeoLocation) Reset() {
@@ -1541,6 +1518,27 @@ func (x *GeoLocation) GetToken() string {
 	return ""
 }
 
+func (x *GeoLocation) GetProvider() GeoProvider {
+	if x != nil && x.Provider != nil {
+		return *x.Provider
+	}
+	return GeoProvider_GEO_PROVIDER_IP2LOCATION_UNSPECIFIED
+}
+
+func (x *GeoLocation) GetMaxmindCityPath() string {
+	if x != nil && x.MaxmindCityPath != nil {
+		return *x.MaxmindCityPath
+	}
+	return ""
+}
+
+func (x *GeoLocation) GetMaxmindAsnPath() string {
+	if x != nil && x.MaxmindAsnPath != nil {
+		return *x.MaxmindAsnPath
+	}
+	return ""
+}
+
 type Patreon struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Enabled             *bool                  `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
@@ -1742,10 +1740,12 @@ func (x *SSH) GetStacPathFmt() string {
 }
 
 type Network struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SdrEnabled    *bool                  `protobuf:"varint,1,opt,name=sdr_enabled,json=sdrEnabled" json:"sdr_enabled,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SdrEnabled *bool                  `protobuf:"varint,1,opt,name=sdr_enabled,json=sdrEnabled" json:"sdr_enabled,omitempty"`
+	// Minimum alt account score for a connecting player to inherit the ban of a linked account, 0 to disable.
+	AltScoreThreshold *float64 `protobuf:"fixed64,2,opt,name=alt_score_threshold,json=altScoreThreshold" json:"alt_score_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Network) Reset() {
@@ -1785,6 +1785,13 @@ func (x *Network) GetSdrEnabled() bool {
 	return false
 }
 
+func (x *Network) GetAltScoreThreshold() float64 {
+	if x != nil && x.AltScoreThreshold != nil {
+		return *x.AltScoreThreshold
+	}
+	return 0
+}
+
 type LocalStore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PathRoot      *string                `protobuf:"bytes,1,opt,name=path_root,json=pathRoot" json:"path_root,omitempty"`
@@ -1889,38 +1896,28 @@ func (x *Exports) GetAuthorizedKeys() []string {
 	return nil
 }
 
-type Anticheat struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Enabled               *bool                  `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
-	Action                *Action                `protobuf:"varint,2,opt,name=action,enum=config.v1.Action" json:"action,omitempty"`
-	Duration              *int32                 `protobuf:"varint,3,opt,name=duration" json:"duration,omitempty"`
-	MaxAimSnaps           *int32                 `protobuf:"varint,4,opt,name=max_aim_snaps,json=maxAimSnaps" json:"max_aim_snaps,omitempty"`
-	MaxPsilent            *int32                 `protobuf:"varint,5,opt,name=max_psilent,json=maxPsilent" json:"max_psilent,omitempty"`
-	MaxBhop               *int32                 `protobuf:"varint,6,opt,name=max_bhop,json=maxBhop" json:"max_bhop,omitempty"`
-	MaxFakeAng            *int32                 `protobuf:"varint,7,opt,name=max_fake_ang,json=maxFakeAng" json:"max_fake_ang,omitempty"`
-	MaxCmdNum             *int32                 `protobuf:"varint,8,opt,name=max_cmd_num,json=maxCmdNum" json:"max_cmd_num,omitempty"`
-	MaxTooManyConnections *int32                 `protobuf:"varint,9,opt,name=max_too_many_connections,json=maxTooManyConnections" json:"max_too_many_connections,omitempty"`
-	MaxOobVar             *int32                 `protobuf:"varint,10,opt,name=max_oob_var,json=maxOobVar" json:"max_oob_var,omitempty"`
-	MaxInvalidUserCmd     *int32                 `protobuf:"varint,11,opt,name=max_invalid_user_cmd,json=maxInvalidUserCmd" json:"max_invalid_user_cmd,omitempty"`
-	MaxCheatCvar          *int32                 `protobuf:"varint,12,opt,name=max_cheat_cvar,json=maxCheatCvar" json:"max_cheat_cvar,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type Appeals struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Days an open appeal may wait for a staff reply before moderators are reminded, 0 disables reminders.
+	ReminderDays  *int32 `protobuf:"varint,1,opt,name=reminder_days,json=reminderDays" json:"reminder_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Anticheat) Reset() {
-	*x = Anticheat{}
+func (x *Appeals) Reset() {
+	*x = Appeals{}
 	mi := &file_config_v1_config_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Anticheat) String() string {
+func (x *Appeals) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Anticheat) ProtoMessage() {}
+func (*Appeals) ProtoMessage() {}
 
-func (x *Anticheat) ProtoReflect() protoreflect.Message {
+func (x *Appeals) ProtoReflect() protoreflect.Message {
 	mi := &file_config_v1_config_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1932,93 +1929,60 @@ func (x *Anticheat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Anticheat.ProtoReflect.Descriptor instead.
-func (*Anticheat) Descriptor() ([]byte, []int) {
+// Deprecated: Use Appeals.ProtoReflect.Descriptor instead.
+func (*Appeals) Descriptor() ([]byte, []int) {
 	return file_config_v1_config_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Anticheat) GetEnabled() bool {
-	if x != nil && x.Enabled != nil {
-		return *x.Enabled
-	}
-	return false
-}
-
-func (x *Anticheat) GetAction() Action {
-	if x != nil && x.Action != nil {
-		return *x.Action
-	}
-	return Action_ACTION_KICK_UNSPECIFIED
-}
-
-func (x *Anticheat) GetDuration() int32 {
-	if x != nil && x.Duration != nil {
-		return *x.Duration
+func (x *Appeals) GetReminderDays() int32 {
+	if x != nil && x.ReminderDays != nil {
+		return *x.ReminderDays
 	}
 	return 0
 }
 
-func (x *Anticheat) GetMaxAimSnaps() int32 {
-	if x != nil && x.MaxAimSnaps != nil {
-		return *x.MaxAimSnaps
-	}
-	return 0
-}
-
-func (x *Anticheat) GetMaxPsilent() int32 {
-	if x != nil && x.MaxPsilent != nil {
-		return *x.MaxPsilent
-	}
-	return 0
-}
-
-func (x *Anticheat) GetMaxBhop() int32 {
-	if x != nil && x.MaxBhop != nil {
-		return *x.MaxBhop
-	}
-	return 0
+type Anticheat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       *bool                  `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Anticheat) GetMaxFakeAng() int32 {
-	if x != nil && x.MaxFakeAng != nil {
-		return *x.MaxFakeAng
-	}
-	return 0
+func (x *Anticheat) Reset() {
+	*x = Anticheat{}
+	mi := &file_config_v1_config_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Anticheat) GetMaxCmdNum() int32 {
-	if x != nil && x.MaxCmdNum != nil {
-		return *x.MaxCmdNum
-	}
-	return 0
+func (x *Anticheat) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Anticheat) GetMaxTooManyConnections() int32 {
-	if x != nil && x.MaxTooManyConnections != nil {
-		return *x.MaxTooManyConnections
-	}
-	return 0
-}
+func (*Anticheat) ProtoMessage() {}
 
-func (x *Anticheat) GetMaxOobVar() int32 {
-	if x != nil && x.MaxOobVar != nil {
-		return *x.MaxOobVar
+func (x *Anticheat) ProtoReflect() protoreflect.Message {
+	mi := &file_config_v1_config_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Anticheat) GetMaxInvalidUserCmd() int32 {
-	if x != nil && x.MaxInvalidUserCmd != nil {
-		return *x.MaxInvalidUserCmd
-	}
-	return 0
+// Deprecated: Use Anticheat.ProtoReflect.Descriptor instead.
+func (*Anticheat) Descriptor() ([]byte, []int) {
+	return file_config_v1_config_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Anticheat) GetMaxCheatCvar() int32 {
-	if x != nil && x.MaxCheatCvar != nil {
-		return *x.MaxCheatCvar
+func (x *Anticheat) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
 	}
-	return 0
+	return false
 }
 
 type Clientprefs struct {
@@ -2030,7 +1994,7 @@ type Clientprefs struct {
 
 func (x *Clientprefs) Reset() {
 	*x = Clientprefs{}
-	mi := &file_config_v1_config_proto_msgTypes[19]
+	mi := &file_config_v1_config_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2042,7 +2006,7 @@ func (x *Clientprefs) String() string {
 func (*Clientprefs) ProtoMessage() {}
 
 func (x *Clientprefs) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[19]
+	mi := &file_config_v1_config_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2055,7 +2019,7 @@ func (x *Clientprefs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Clientprefs.ProtoReflect.Descriptor instead.
 func (*Clientprefs) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{19}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *Clientprefs) GetCenterProjectiles() bool {
@@ -2081,13 +2045,14 @@ type Config struct {
 	LocalStore    *LocalStore  `protobuf:"bytes,12,opt,name=local_store,json=localStore" json:"local_store,omitempty"`
 	Exports       *Exports     `protobuf:"bytes,13,opt,name=exports" json:"exports,omitempty"`
 	Anticheat     *Anticheat   `protobuf:"bytes,14,opt,name=anticheat" json:"anticheat,omitempty"`
+	Appeals       *Appeals     `protobuf:"bytes,15,opt,name=appeals" json:"appeals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Config) Reset() {
 	*x = Config{}
-	mi := &file_config_v1_config_proto_msgTypes[20]
+	mi := &file_config_v1_config_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2099,7 +2064,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[20]
+	mi := &file_config_v1_config_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2112,7 +2077,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{20}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Config) GetGeneral() *General {
@@ -2206,6 +2171,13 @@ func (x *Config) GetAnticheat() *Anticheat {
 	return nil
 }
 
+func (x *Config) GetAppeals() *Appeals {
+	if x != nil {
+		return x.Appeals
+	}
+	return nil
+}
+
 type GithubRelease struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Url             *string                `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
@@ -2230,7 +2202,7 @@ type GithubRelease struct {
 
 func (x *GithubRelease) Reset() {
 	*x = GithubRelease{}
-	mi := &file_config_v1_config_proto_msgTypes[21]
+	mi := &file_config_v1_config_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2242,7 +2214,7 @@ func (x *GithubRelease) String() string {
 func (*GithubRelease) ProtoMessage() {}
 
 func (x *GithubRelease) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[21]
+	mi := &file_config_v1_config_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2255,7 +2227,7 @@ func (x *GithubRelease) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GithubRelease.ProtoReflect.Descriptor instead.
 func (*GithubRelease) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{21}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GithubRelease) GetUrl() string {
@@ -2396,7 +2368,7 @@ type GithubRelease_Author struct {
 
 func (x *GithubRelease_Author) Reset() {
 	*x = GithubRelease_Author{}
-	mi := &file_config_v1_config_proto_msgTypes[22]
+	mi := &file_config_v1_config_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2408,7 +2380,7 @@ func (x *GithubRelease_Author) String() string {
 func (*GithubRelease_Author) ProtoMessage() {}
 
 func (x *GithubRelease_Author) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[22]
+	mi := &file_config_v1_config_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2421,7 +2393,7 @@ func (x *GithubRelease_Author) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GithubRelease_Author.ProtoReflect.Descriptor instead.
 func (*GithubRelease_Author) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{21, 0}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{22, 0}
 }
 
 func (x *GithubRelease_Author) GetLogin() string {
@@ -2571,7 +2543,7 @@ type GithubRelease_Asset struct {
 
 func (x *GithubRelease_Asset) Reset() {
 	*x = GithubRelease_Asset{}
-	mi := &file_config_v1_config_proto_msgTypes[23]
+	mi := &file_config_v1_config_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2583,7 +2555,7 @@ func (x *GithubRelease_Asset) String() string {
 func (*GithubRelease_Asset) ProtoMessage() {}
 
 func (x *GithubRelease_Asset) ProtoReflect() protoreflect.Message {
-	mi := &file_config_v1_config_proto_msgTypes[23]
+	mi := &file_config_v1_config_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2596,7 +2568,7 @@ func (x *GithubRelease_Asset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GithubRelease_Asset.ProtoReflect.Descriptor instead.
 func (*GithubRelease_Asset) Descriptor() ([]byte, []int) {
-	return file_config_v1_config_proto_rawDescGZIP(), []int{21, 1}
+	return file_config_v1_config_proto_rawDescGZIP(), []int{22, 1}
 }
 
 func (x *GithubRelease_Asset) GetUrl() string {
@@ -2767,17 +2739,14 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\vcount_limit\x18\x05 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\n" +
 	"countLimit\x12%\n" +
 	"\n" +
-	"parser_url\x18\x06 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tparserUrl\"\xcf\x02\n" +
+	"parser_url\x18\x06 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tparserUrl\"\xfe\x01\n" +
 	"\aFilters\x12 \n" +
 	"\aenabled\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\aenabled\x12/\n" +
 	"\x0fwarning_timeout\x18\x02 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\x0ewarningTimeout\x12+\n" +
 	"\rwarning_limit\x18\x03 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\fwarningLimit\x12\x18\n" +
 	"\x03dry\x18\x04 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\x03dry\x12)\n" +
-	"\fping_discord\x18\x05 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\vpingDiscord\x12%\n" +
-	"\n" +
-	"max_weight\x18\x06 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\tmaxWeight\x12+\n" +
-	"\rcheck_timeout\x18\a \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\fcheckTimeout\x12+\n" +
-	"\rmatch_timeout\x18\b \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\fmatchTimeout\"\x9b\b\n" +
+	"\fping_discord\x18\x05 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\vpingDiscordJ\x04\b\x06\x10\tR\n" +
+	"max_weightR\rcheck_timeoutR\rmatch_timeout\"\x9b\b\n" +
 	"\aDiscord\x12 \n" +
 	"\aenabled\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\aenabled\x12'\n" +
 	"\vbot_enabled\x18\x02 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\n" +
@@ -2811,12 +2780,15 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\fhttp_enabled\x18\x03 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\vhttpEnabled\x122\n" +
 	"\x11http_otel_enabled\x18\x04 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\x0fhttpOtelEnabled\x12<\n" +
 	"\n" +
-	"http_level\x18\x05 \x01(\x0e2\x10.config.v1.LevelB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\thttpLevel\"t\n" +
+	"http_level\x18\x05 \x01(\x0e2\x10.config.v1.LevelB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\thttpLevel\"\x88\x02\n" +
 	"\vGeoLocation\x12 \n" +
 	"\aenabled\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\aenabled\x12%\n" +
 	"\n" +
 	"cache_path\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tcachePath\x12\x1c\n" +
-	"\x05token\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05token\"\xae\x02\n" +
+	"\x05token\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x05token\x12<\n" +
+	"\bprovider\x18\x04 \x01(\x0e2\x16.config.v1.GeoProviderB\b\xbaH\x05\x82\x01\x02\x10\x01R\bprovider\x12*\n" +
+	"\x11maxmind_city_path\x18\x05 \x01(\tR\x0fmaxmindCityPath\x12(\n" +
+	"\x10maxmind_asn_path\x18\x06 \x01(\tR\x0emaxmindAsnPath\"\xae\x02\n" +
 	"\aPatreon\x12 \n" +
 	"\aenabled\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\aenabled\x129\n" +
 	"\x14integrations_enabled\x18\x02 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\x13integrationsEnabled\x12#\n" +
@@ -2835,10 +2807,11 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\atimeout\x18\b \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\atimeout\x12*\n" +
 	"\rdemo_path_fmt\x18\t \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\vdemoPathFmt\x12*\n" +
 	"\rstac_path_fmt\x18\n" +
-	" \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\vstacPathFmt\"2\n" +
+	" \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\vstacPathFmt\"{\n" +
 	"\aNetwork\x12'\n" +
 	"\vsdr_enabled\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\n" +
-	"sdrEnabled\"1\n" +
+	"sdrEnabled\x12G\n" +
+	"\x13alt_score_threshold\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x11altScoreThreshold\"1\n" +
 	"\n" +
 	"LocalStore\x12#\n" +
 	"\tpath_root\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bpathRoot\"\x8e\x01\n" +
@@ -2846,25 +2819,13 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\n" +
 	"bd_enabled\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\tbdEnabled\x12+\n" +
 	"\rvalve_enabled\x18\x02 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\fvalveEnabled\x12/\n" +
-	"\x0fauthorized_keys\x18\x03 \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x0eauthorizedKeys\"\xa3\x04\n" +
+	"\x0fauthorized_keys\x18\x03 \x03(\tB\x06\xbaH\x03\xc8\x01\x01R\x0eauthorizedKeys\"7\n" +
+	"\aAppeals\x12,\n" +
+	"\rreminder_days\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\freminderDays\"\xd3\x01\n" +
 	"\tAnticheat\x12 \n" +
-	"\aenabled\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\aenabled\x126\n" +
-	"\x06action\x18\x02 \x01(\x0e2\x11.config.v1.ActionB\v\xbaH\b\xc8\x01\x01\x82\x01\x02\x10\x01R\x06action\x12\"\n" +
-	"\bduration\x18\x03 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\bduration\x12*\n" +
-	"\rmax_aim_snaps\x18\x04 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\vmaxAimSnaps\x12'\n" +
-	"\vmax_psilent\x18\x05 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\n" +
-	"maxPsilent\x12!\n" +
-	"\bmax_bhop\x18\x06 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\amaxBhop\x12(\n" +
-	"\fmax_fake_ang\x18\a \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\n" +
-	"maxFakeAng\x12&\n" +
-	"\vmax_cmd_num\x18\b \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\tmaxCmdNum\x12?\n" +
-	"\x18max_too_many_connections\x18\t \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\x15maxTooManyConnections\x12&\n" +
-	"\vmax_oob_var\x18\n" +
-	" \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\tmaxOobVar\x127\n" +
-	"\x14max_invalid_user_cmd\x18\v \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\x11maxInvalidUserCmd\x12,\n" +
-	"\x0emax_cheat_cvar\x18\f \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\fmaxCheatCvar\"D\n" +
+	"\aenabled\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\aenabledJ\x04\b\x02\x10\rR\x06actionR\bdurationR\rmax_aim_snapsR\vmax_psilentR\bmax_bhopR\fmax_fake_angR\vmax_cmd_numR\x18max_too_many_connectionsR\vmax_oob_varR\x14max_invalid_user_cmdR\x0emax_cheat_cvar\"D\n" +
 	"\vClientprefs\x125\n" +
-	"\x12center_projectiles\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\x11centerProjectiles\"\xd4\x04\n" +
+	"\x12center_projectiles\x18\x01 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\x11centerProjectiles\"\x82\x05\n" +
 	"\x06Config\x12,\n" +
 	"\ageneral\x18\x01 \x01(\v2\x12.config.v1.GeneralR\ageneral\x12&\n" +
 	"\x05debug\x18\x02 \x01(\v2\x10.config.v1.DebugR\x05debug\x12#\n" +
@@ -2880,7 +2841,8 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\vlocal_store\x18\f \x01(\v2\x15.config.v1.LocalStoreR\n" +
 	"localStore\x12,\n" +
 	"\aexports\x18\r \x01(\v2\x12.config.v1.ExportsR\aexports\x122\n" +
-	"\tanticheat\x18\x0e \x01(\v2\x14.config.v1.AnticheatR\tanticheat\"\x9f\f\n" +
+	"\tanticheat\x18\x0e \x01(\v2\x14.config.v1.AnticheatR\tanticheat\x12,\n" +
+	"\aappeals\x18\x0f \x01(\v2\x12.config.v1.AppealsR\aappeals\"\x9f\f\n" +
 	"\rGithubRelease\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x19\n" +
 	"\bhtml_url\x18\x02 \x01(\tR\ahtmlUrl\x12\x1b\n" +
@@ -2959,17 +2921,14 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\rLEVEL_WARNING\x10\x01\x12\x0e\n" +
 	"\n" +
 	"LEVEL_INFO\x10\x02\x12\x0f\n" +
-	"\vLEVEL_DEBUG\x10\x03*\x86\x01\n" +
+	"\vLEVEL_DEBUG\x10\x03*Q\n" +
+	"\vGeoProvider\x12(\n" +
+	"$GEO_PROVIDER_IP2LOCATION_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14GEO_PROVIDER_MAXMIND\x10\x01*\x86\x01\n" +
 	"\x0fHostKeyStrategy\x12-\n" +
 	")HOST_KEY_STRATEGY_AUTO_ACCEPT_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eHOST_KEY_STRATEGY_ACCEPT_FIRST\x10\x01\x12 \n" +
-	"\x1cHOST_KEY_STRATEGY_IGNORE_ALL\x10\x02*E\n" +
-	"\x06Action\x12\x1b\n" +
-	"\x17ACTION_KICK_UNSPECIFIED\x10\x00\x12\x0e\n" +
-	"\n" +
-	"ACTION_GAG\x10\x01\x12\x0e\n" +
-	"\n" +
-	"ACTION_BAN\x10\x022\x8c\x02\n" +
+	"\x1cHOST_KEY_STRATEGY_IGNORE_ALL\x10\x022\x8c\x02\n" +
 	"\rConfigService\x12<\n" +
 	"\x04Info\x12\x16.google.protobuf.Empty\x1a\x17.config.v1.InfoResponse\"\x03\x90\x02\x01\x127\n" +
 	"\x03Get\x12\x16.google.protobuf.Empty\x1a\x16.config.v1.GetResponse\"\x00\x12?\n" +
@@ -2991,14 +2950,14 @@ func file_config_v1_config_proto_rawDescGZIP() []byte {
 }
 
 var file_config_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_config_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_config_v1_config_proto_goTypes = []any{
 	(RunMode)(0),                  // 0: config.v1.RunMode
 	(FileServeMode)(0),            // 1: config.v1.FileServeMode
 	(DemoStrategy)(0),             // 2: config.v1.DemoStrategy
 	(Level)(0),                    // 3: config.v1.Level
-	(HostKeyStrategy)(0),          // 4: config.v1.HostKeyStrategy
-	(Action)(0),                   // 5: config.v1.Action
+	(GeoProvider)(0),              // 4: config.v1.GeoProvider
+	(HostKeyStrategy)(0),          // 5: config.v1.HostKeyStrategy
 	(*ChangelogResponse)(nil),     // 6: config.v1.ChangelogResponse
 	(*InfoResponse)(nil),          // 7: config.v1.InfoResponse
 	(*GetResponse)(nil),           // 8: config.v1.GetResponse
@@ -3017,27 +2976,28 @@ var file_config_v1_config_proto_goTypes = []any{
 	(*Network)(nil),               // 21: config.v1.Network
 	(*LocalStore)(nil),            // 22: config.v1.LocalStore
 	(*Exports)(nil),               // 23: config.v1.Exports
-	(*Anticheat)(nil),             // 24: config.v1.Anticheat
-	(*Clientprefs)(nil),           // 25: config.v1.Clientprefs
-	(*Config)(nil),                // 26: config.v1.Config
-	(*GithubRelease)(nil),         // 27: config.v1.GithubRelease
-	(*GithubRelease_Author)(nil),  // 28: config.v1.GithubRelease.Author
-	(*GithubRelease_Asset)(nil),   // 29: config.v1.GithubRelease.Asset
-	(*timestamppb.Timestamp)(nil), // 30: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 31: google.protobuf.Empty
+	(*Appeals)(nil),               // 24: config.v1.Appeals
+	(*Anticheat)(nil),             // 25: config.v1.Anticheat
+	(*Clientprefs)(nil),           // 26: config.v1.Clientprefs
+	(*Config)(nil),                // 27: config.v1.Config
+	(*GithubRelease)(nil),         // 28: config.v1.GithubRelease
+	(*GithubRelease_Author)(nil),  // 29: config.v1.GithubRelease.Author
+	(*GithubRelease_Asset)(nil),   // 30: config.v1.GithubRelease.Asset
+	(*timestamppb.Timestamp)(nil), // 31: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 32: google.protobuf.Empty
 }
 var file_config_v1_config_proto_depIdxs = []int32{
-	27, // 0: config.v1.ChangelogResponse.changelog:type_name -> config.v1.GithubRelease
-	26, // 1: config.v1.GetResponse.config:type_name -> config.v1.Config
-	26, // 2: config.v1.UpdateRequest.config:type_name -> config.v1.Config
-	26, // 3: config.v1.UpdateResponse.config:type_name -> config.v1.Config
+	28, // 0: config.v1.ChangelogResponse.changelog:type_name -> config.v1.GithubRelease
+	27, // 1: config.v1.GetResponse.config:type_name -> config.v1.Config
+	27, // 2: config.v1.UpdateRequest.config:type_name -> config.v1.Config
+	27, // 3: config.v1.UpdateResponse.config:type_name -> config.v1.Config
 	0,  // 4: config.v1.General.mode:type_name -> config.v1.RunMode
 	1,  // 5: config.v1.General.file_serve_mode:type_name -> config.v1.FileServeMode
 	2,  // 6: config.v1.Demo.strategy:type_name -> config.v1.DemoStrategy
 	3,  // 7: config.v1.Log.level:type_name -> config.v1.Level
 	3,  // 8: config.v1.Log.http_level:type_name -> config.v1.Level
-	4,  // 9: config.v1.SSH.host_key_strategy:type_name -> config.v1.HostKeyStrategy
-	5,  // 10: config.v1.Anticheat.action:type_name -> config.v1.Action
+	4,  // 9: config.v1.GeoLocation.provider:type_name -> config.v1.GeoProvider
+	5,  // 10: config.v1.SSH.host_key_strategy:type_name -> config.v1.HostKeyStrategy
 	11, // 11: config.v1.Config.general:type_name -> config.v1.General
 	12, // 12: config.v1.Config.debug:type_name -> config.v1.Debug
 	13, // 13: config.v1.Config.demo:type_name -> config.v1.Demo
@@ -3050,26 +3010,27 @@ var file_config_v1_config_proto_depIdxs = []int32{
 	21, // 20: config.v1.Config.network:type_name -> config.v1.Network
 	22, // 21: config.v1.Config.local_store:type_name -> config.v1.LocalStore
 	23, // 22: config.v1.Config.exports:type_name -> config.v1.Exports
-	24, // 23: config.v1.Config.anticheat:type_name -> config.v1.Anticheat
-	30, // 24: config.v1.GithubRelease.created_at:type_name -> google.protobuf.Timestamp
-	30, // 25: config.v1.GithubRelease.published_at:type_name -> google.protobuf.Timestamp
-	28, // 26: config.v1.GithubRelease.author:type_name -> config.v1.GithubRelease.Author
-	30, // 27: config.v1.GithubRelease.Asset.created_at:type_name -> google.protobuf.Timestamp
-	30, // 28: config.v1.GithubRelease.Asset.updated_at:type_name -> google.protobuf.Timestamp
-	28, // 29: config.v1.GithubRelease.Asset.uploader:type_name -> config.v1.GithubRelease.Author
-	31, // 30: config.v1.ConfigService.Info:input_type -> google.protobuf.Empty
-	31, // 31: config.v1.ConfigService.Get:input_type -> google.protobuf.Empty
-	9,  // 32: config.v1.ConfigService.Update:input_type -> config.v1.UpdateRequest
-	31, // 33: config.v1.ConfigService.Changelog:input_type -> google.protobuf.Empty
-	7,  // 34: config.v1.ConfigService.Info:output_type -> config.v1.InfoResponse
-	8,  // 35: config.v1.ConfigService.Get:output_type -> config.v1.GetResponse
-	10, // 36: config.v1.ConfigService.Update:output_type -> config.v1.UpdateResponse
-	6,  // 37: config.v1.ConfigService.Changelog:output_type -> config.v1.ChangelogResponse
-	34, // [34:38] is the sub-list for method output_type
-	30, // [30:34] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	25, // 23: config.v1.Config.anticheat:type_name -> config.v1.Anticheat
+	24, // 24: config.v1.Config.appeals:type_name -> config.v1.Appeals
+	31, // 25: config.v1.GithubRelease.created_at:type_name -> google.protobuf.Timestamp
+	31, // 26: config.v1.GithubRelease.published_at:type_name -> google.protobuf.Timestamp
+	29, // 27: config.v1.GithubRelease.author:type_name -> config.v1.GithubRelease.Author
+	31, // 28: config.v1.GithubRelease.Asset.created_at:type_name -> google.protobuf.Timestamp
+	31, // 29: config.v1.GithubRelease.Asset.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 30: config.v1.GithubRelease.Asset.uploader:type_name -> config.v1.GithubRelease.Author
+	32, // 31: config.v1.ConfigService.Info:input_type -> google.protobuf.Empty
+	32, // 32: config.v1.ConfigService.Get:input_type -> google.protobuf.Empty
+	9,  // 33: config.v1.ConfigService.Update:input_type -> config.v1.UpdateRequest
+	32, // 34: config.v1.ConfigService.Changelog:input_type -> google.protobuf.Empty
+	7,  // 35: config.v1.ConfigService.Info:output_type -> config.v1.InfoResponse
+	8,  // 36: config.v1.ConfigService.Get:output_type -> config.v1.GetResponse
+	10, // 37: config.v1.ConfigService.Update:output_type -> config.v1.UpdateResponse
+	6,  // 38: config.v1.ConfigService.Changelog:output_type -> config.v1.ChangelogResponse
+	35, // [35:39] is the sub-list for method output_type
+	31, // [31:35] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_config_v1_config_proto_init() }
@@ -3083,7 +3044,7 @@ func file_config_v1_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_v1_config_proto_rawDesc), len(file_config_v1_config_proto_rawDesc)),
 			NumEnums:      6,
-			NumMessages:   24,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,19 @@
+DROP INDEX IF EXISTS cidr_block_entries_source_idx;
+
+ALTER TABLE cidr_block_source
+    DROP COLUMN IF EXISTS entry_count;
+
+ALTER TABLE cidr_block_source
+    DROP COLUMN IF EXISTS last_error;
+
+ALTER TABLE cidr_block_source
+    DROP COLUMN IF EXISTS last_success_on;
+
+ALTER TABLE cidr_block_source
+    DROP COLUMN IF EXISTS last_sync_on;
+
+ALTER TABLE cidr_block_source
+    DROP COLUMN IF EXISTS last_modified;
+
+ALTER TABLE cidr_block_source
+    DROP COLUMN IF EXISTS etag;
@@ -0,0 +1,22 @@
+ALTER TABLE cidr_block_source
+    ADD COLUMN IF NOT EXISTS etag TEXT DEFAULT '' NOT NULL;
+
+ALTER TABLE cidr_block_source
+    ADD COLUMN IF NOT EXISTS last_modified TEXT DEFAULT '' NOT NULL;
+
+ALTER TABLE cidr_block_source
+    ADD COLUMN IF NOT EXISTS last_sync_on TIMESTAMP;
+
+ALTER TABLE cidr_block_source
+    ADD COLUMN IF NOT EXISTS last_success_on TIMESTAMP;
+
+ALTER TABLE cidr_block_source
+    ADD COLUMN IF NOT EXISTS last_error TEXT DEFAULT '' NOT NULL;
+
+ALTER TABLE cidr_block_source
+    ADD COLUMN IF NOT EXISTS entry_count INT DEFAULT 0 NOT NULL;
+
+UPDATE cidr_block_source s
+SET entry_count = (SELECT count(*) FROM cidr_block_entries e WHERE e.cidr_block_source_id = s.cidr_block_source_id);
+
+CREATE INDEX IF NOT EXISTS cidr_block_entries_source_idx ON cidr_block_entries (cidr_block_source_id);
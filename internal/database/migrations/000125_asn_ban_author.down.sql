@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS net_asn_as_num_idx;
+
+ALTER TABLE asn_ban
+    DROP COLUMN IF EXISTS author_id;
+
+ALTER TABLE asn_ban
+    ALTER COLUMN as_num TYPE INTEGER;
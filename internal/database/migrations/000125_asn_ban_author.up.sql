@@ -0,0 +1,7 @@
+ALTER TABLE asn_ban
+    ALTER COLUMN as_num TYPE BIGINT;
+
+ALTER TABLE asn_ban
+    ADD COLUMN IF NOT EXISTS author_id BIGINT REFERENCES person (steam_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS net_asn_as_num_idx ON net_asn (as_num);
@@ -0,0 +1,102 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS anticheat_rule
+(
+    rule_id          serial PRIMARY KEY,
+    name             text             NOT NULL UNIQUE,
+    enabled          boolean          NOT NULL DEFAULT true,
+    dry_run          boolean          NOT NULL DEFAULT false,
+    threshold        double precision NOT NULL CHECK ( threshold > 0 ),
+    window_seconds   int              NOT NULL DEFAULT 0 CHECK ( window_seconds >= 0 ),
+    action           text             NOT NULL CHECK ( action IN ('notify', 'gag', 'kick', 'ban') ),
+    duration_seconds int              NOT NULL DEFAULT 0 CHECK ( duration_seconds >= 0 ),
+    created_on       timestamptz      NOT NULL,
+    updated_on       timestamptz      NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS anticheat_rule_condition
+(
+    rule_id   int              NOT NULL REFERENCES anticheat_rule (rule_id) ON DELETE CASCADE,
+    detection text             NOT NULL,
+    weight    double precision NOT NULL DEFAULT 1,
+    min_count int              NOT NULL DEFAULT 0 CHECK ( min_count >= 0 ),
+    PRIMARY KEY (rule_id, detection)
+);
+
+CREATE TABLE IF NOT EXISTS anticheat_rule_trigger
+(
+    trigger_id       bigserial PRIMARY KEY,
+    rule_id          int              REFERENCES anticheat_rule (rule_id) ON DELETE SET NULL,
+    rule_name        text             NOT NULL,
+    steam_id         bigint           NOT NULL REFERENCES person (steam_id) ON DELETE CASCADE,
+    action           text             NOT NULL,
+    duration_seconds int              NOT NULL DEFAULT 0,
+    dry_run          boolean          NOT NULL DEFAULT false,
+    score            double precision NOT NULL,
+    anticheat_ids    bigint[]         NOT NULL DEFAULT '{}',
+    ban_id           int              REFERENCES ban (ban_id) ON DELETE SET NULL,
+    window_end       timestamptz      NOT NULL,
+    created_on       timestamptz      NOT NULL,
+    UNIQUE (rule_id, steam_id, window_end)
+);
+
+CREATE INDEX IF NOT EXISTS anticheat_rule_trigger_steam_id_idx ON anticheat_rule_trigger (steam_id);
+
+-- Convert the previous fixed per detection thresholds into equivalent rules. The old thresholds were
+-- evaluated per daily log file, so a one day window is used.
+WITH thresholds (name, detection, threshold, action, duration) AS (
+    SELECT 'Silent Aim', 'silent_aim', anticheat_max_psilent, anticheat_action::text, anticheat_duration FROM config
+    UNION ALL
+    SELECT 'Aim Snap', 'aim_snap', anticheat_max_aim_snap, anticheat_action::text, anticheat_duration FROM config
+    UNION ALL
+    SELECT 'Bhop', 'bhop', anticheat_max_bhop, anticheat_action::text, anticheat_duration FROM config
+    UNION ALL
+    SELECT 'Fake Angles', 'eye_angles', anticheat_max_fake_ang, anticheat_action::text, anticheat_duration FROM config
+    UNION ALL
+    SELECT 'Cmdnum Spike', 'cmdnum_spike', anticheat_max_cmd_num, anticheat_action::text, anticheat_duration FROM config
+    UNION ALL
+    SELECT 'Too Many Connections', 'too_many_conn', anticheat_max_too_many_connections, anticheat_action::text, anticheat_duration FROM config
+    UNION ALL
+    SELECT 'Cheat Cvar', 'cheat_cvar', anticheat_max_cheat_cvar, anticheat_action::text, anticheat_duration FROM config
+    UNION ALL
+    SELECT 'OOB Cvar', 'oob_cvar', anticheat_max_oob_var, anticheat_action::text, anticheat_duration FROM config
+    UNION ALL
+    SELECT 'Invalid User Cmd', 'invalid_user_cmd', anticheat_max_invalid_user_cmd, anticheat_action::text, anticheat_duration FROM config
+),
+     inserted AS (
+         INSERT INTO anticheat_rule (name, enabled, dry_run, threshold, window_seconds, action, duration_seconds, created_on, updated_on)
+             SELECT name, true, false, threshold, 86400, action, duration, now(), now()
+             FROM thresholds
+             WHERE threshold > 0
+             ON CONFLICT (name) DO NOTHING
+             RETURNING rule_id, name)
+INSERT
+INTO anticheat_rule_condition (rule_id, detection, weight, min_count)
+SELECT i.rule_id, t.detection, 1, 0
+FROM inserted i
+         INNER JOIN thresholds t ON t.name = i.name;
+
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_action;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_duration;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_max_aim_snap;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_max_psilent;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_max_bhop;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_max_fake_ang;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_max_cmd_num;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_max_too_many_connections;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_max_cheat_cvar;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_max_oob_var;
+ALTER TABLE config
+    DROP COLUMN IF EXISTS anticheat_max_invalid_user_cmd;
+
+COMMIT;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS ban_audit
+(
+    audit_id   BIGSERIAL PRIMARY KEY,
+    ban_id     INT         NOT NULL REFERENCES ban (ban_id) ON DELETE CASCADE,
+    action     TEXT        NOT NULL,
+    status     TEXT        NOT NULL,
+    message    TEXT        NOT NULL DEFAULT '',
+    created_on TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ban_audit_ban_id_idx ON ban_audit (ban_id);
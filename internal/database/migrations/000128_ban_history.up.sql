@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS ban_history
+(
+    history_id BIGSERIAL PRIMARY KEY,
+    ban_id     INT         NOT NULL REFERENCES ban (ban_id) ON DELETE CASCADE,
+    author_id  BIGINT REFERENCES person (steam_id) ON DELETE SET NULL,
+    changes    JSONB       NOT NULL,
+    created_on TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ban_history_ban_id_idx ON ban_history (ban_id);
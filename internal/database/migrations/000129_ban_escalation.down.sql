@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS ban_target_reason_idx;
+DROP TABLE IF EXISTS ban_escalation;
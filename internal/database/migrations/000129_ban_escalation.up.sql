@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS ban_escalation
+(
+    reason           INT    NOT NULL,
+    offense          INT    NOT NULL CHECK (offense > 0),
+    ban_type         INT    NOT NULL CHECK (ban_type IN (1, 2)),
+    duration_seconds BIGINT NOT NULL DEFAULT 0 CHECK (duration_seconds >= 0),
+    PRIMARY KEY (reason, offense)
+);
+
+CREATE INDEX IF NOT EXISTS ban_target_reason_idx ON ban (target_id, reason);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS person_connections_steam_id_created_on_idx;
+DROP TABLE IF EXISTS export_signing_key;
+DROP TABLE IF EXISTS export_consumer;
+DROP TABLE IF EXISTS export_feed;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS export_feed
+(
+    feed_id              SERIAL PRIMARY KEY,
+    name                 TEXT        NOT NULL UNIQUE CHECK (name ~ '^[a-z0-9_-]+$'),
+    title                TEXT        NOT NULL,
+    description          TEXT        NOT NULL DEFAULT '',
+    reasons              INT[]       NOT NULL DEFAULT '{}',
+    ban_types            INT[]       NOT NULL DEFAULT '{}',
+    min_duration_seconds BIGINT      NOT NULL DEFAULT 0 CHECK (min_duration_seconds >= 0),
+    max_age_seconds      BIGINT      NOT NULL DEFAULT 0 CHECK (max_age_seconds >= 0),
+    public               BOOLEAN     NOT NULL DEFAULT FALSE,
+    enabled              BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_on           TIMESTAMPTZ NOT NULL,
+    updated_on           TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS export_consumer
+(
+    consumer_id  SERIAL PRIMARY KEY,
+    feed_id      INT         NOT NULL REFERENCES export_feed (feed_id) ON DELETE CASCADE,
+    name         TEXT        NOT NULL,
+    key_hash     TEXT        NOT NULL UNIQUE,
+    enabled      BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_on   TIMESTAMPTZ NOT NULL,
+    last_used_on TIMESTAMPTZ
+);
+
+-- Single row holding the ed25519 key used to sign every feed.
+CREATE TABLE IF NOT EXISTS export_signing_key
+(
+    key_id      INT PRIMARY KEY DEFAULT 1 CHECK (key_id = 1),
+    private_key BYTEA       NOT NULL,
+    created_on  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS person_connections_steam_id_created_on_idx ON person_connections (steam_id, created_on);
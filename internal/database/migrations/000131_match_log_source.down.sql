@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS match_server_start_idx;
+
+DELETE FROM match WHERE demo_id IS NULL;
+
+ALTER TABLE match ALTER COLUMN demo_id SET NOT NULL;
@@ -0,0 +1,4 @@
+-- Matches recorded from the live log stream do not have a demo until the STV recording is uploaded.
+ALTER TABLE match ALTER COLUMN demo_id DROP NOT NULL;
+
+CREATE INDEX IF NOT EXISTS match_server_start_idx ON match (server_id, start_time);
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE config ALTER COLUMN demo_parser_url SET DEFAULT 'http://localhost:8811/';
+
+UPDATE config SET demo_parser_url = 'http://localhost:8811/' WHERE demo_parser_url = '';
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Demos are parsed in-process unless an external parser is explicitly configured.
+ALTER TABLE config ALTER COLUMN demo_parser_url SET DEFAULT '';
+
+UPDATE config SET demo_parser_url = '' WHERE demo_parser_url = 'http://localhost:8811/';
+
+COMMIT;
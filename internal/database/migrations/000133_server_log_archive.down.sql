@@ -0,0 +1,18 @@
+BEGIN;
+
+DROP TABLE IF EXISTS server_log_archive;
+
+DROP TABLE IF EXISTS server_log_policy;
+
+DROP INDEX IF EXISTS idx_server_logs_body_search;
+
+DROP INDEX IF EXISTS idx_server_logs_steam_ids;
+
+DROP INDEX IF EXISTS idx_server_logs_server_created_on;
+
+ALTER TABLE server_logs
+  DROP COLUMN IF EXISTS body_search,
+  DROP COLUMN IF EXISTS steam_ids,
+  DROP COLUMN IF EXISTS event_type;
+
+COMMIT;
@@ -0,0 +1,43 @@
+BEGIN;
+
+ALTER TABLE server_logs
+  ADD COLUMN IF NOT EXISTS event_type INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS steam_ids BIGINT[] NOT NULL DEFAULT '{}',
+  ADD COLUMN IF NOT EXISTS body_search tsvector GENERATED ALWAYS AS (to_tsvector('simple', body)) STORED;
+
+-- Existing lines are matched by the players they reference, the same as new lines. The event type can only be
+-- determined by the log parser, so existing lines are left as 0 and will not match event type filters.
+UPDATE server_logs
+SET steam_ids = ids.steam_ids
+FROM (
+  SELECT server_log_id, array_agg(DISTINCT 76561197960265728 + m[1]::BIGINT) AS steam_ids
+  FROM server_logs, regexp_matches(body, '\[U:1:(\d+)\]', 'g') AS m
+  WHERE m[1]::BIGINT > 0
+  GROUP BY server_log_id
+) ids
+WHERE server_logs.server_log_id = ids.server_log_id;
+
+CREATE INDEX IF NOT EXISTS idx_server_logs_server_created_on ON server_logs (server_id, created_on);
+
+CREATE INDEX IF NOT EXISTS idx_server_logs_steam_ids ON server_logs USING GIN (steam_ids);
+
+CREATE INDEX IF NOT EXISTS idx_server_logs_body_search ON server_logs USING GIN (body_search);
+
+CREATE TABLE IF NOT EXISTS server_log_policy (
+  server_id INT PRIMARY KEY REFERENCES server (server_id) ON DELETE CASCADE ON UPDATE CASCADE,
+  max_age_days INT NOT NULL DEFAULT 30 CHECK (max_age_days >= 0),
+  max_size_bytes BIGINT NOT NULL DEFAULT 0 CHECK (max_size_bytes >= 0),
+  archive_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  updated_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS server_log_archive (
+  server_id INT NOT NULL REFERENCES server (server_id) ON DELETE CASCADE ON UPDATE CASCADE,
+  day DATE NOT NULL,
+  asset_id UUID NOT NULL REFERENCES asset (asset_id) ON DELETE CASCADE ON UPDATE CASCADE,
+  lines INT NOT NULL,
+  created_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (server_id, day)
+);
+
+COMMIT;
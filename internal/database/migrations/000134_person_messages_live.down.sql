@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS person_messages_live_link_idx;
+
+ALTER TABLE person_messages DROP COLUMN IF EXISTS live;
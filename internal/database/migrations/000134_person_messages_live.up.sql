@@ -0,0 +1,6 @@
+-- Messages recorded from the live server logs, these are linked to a demo once it is imported rather than
+-- being duplicated by the demo chat.
+ALTER TABLE person_messages ADD COLUMN IF NOT EXISTS live BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS person_messages_live_link_idx ON person_messages (server_id, steam_id, created_on)
+  WHERE demo_id IS NULL;
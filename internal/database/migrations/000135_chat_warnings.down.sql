@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS chat_escalation;
+
+DROP TABLE IF EXISTS chat_warning;
+
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS chat_warning (
+  warning_id BIGSERIAL PRIMARY KEY,
+  steam_id BIGINT NOT NULL REFERENCES person (steam_id) ON DELETE CASCADE ON UPDATE CASCADE,
+  person_message_id BIGINT REFERENCES person_messages (person_message_id) ON DELETE SET NULL,
+  filter_id BIGINT REFERENCES filtered_word (filter_id) ON DELETE SET NULL,
+  server_id INT REFERENCES server (server_id) ON DELETE SET NULL ON UPDATE CASCADE,
+  warn_reason INT NOT NULL,
+  message TEXT NOT NULL,
+  matched TEXT NOT NULL,
+  weight INT NOT NULL CHECK (weight >= 0),
+  action INT,
+  pardoned BOOLEAN NOT NULL DEFAULT FALSE,
+  pardoned_by BIGINT REFERENCES person (steam_id) ON DELETE SET NULL ON UPDATE CASCADE,
+  created_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_warning_steam_id_created_on ON chat_warning (steam_id, created_on);
+
+-- Actions applied once the decayed total weight of a players warnings reaches the step weight. Actions are
+-- ordered by severity: 0 warn, 1 gag, 2 kick, 3 mute, 4 ban.
+CREATE TABLE IF NOT EXISTS chat_escalation (
+  weight INT PRIMARY KEY CHECK (weight > 0),
+  action INT NOT NULL,
+  duration TEXT NOT NULL DEFAULT '',
+  updated_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO chat_escalation (weight, action, duration)
+VALUES (1, 0, ''),
+       (5, 1, ''),
+       (10, 3, '1d')
+ON CONFLICT DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE config
+  DROP COLUMN IF EXISTS network_alt_score_threshold;
+
+COMMIT;
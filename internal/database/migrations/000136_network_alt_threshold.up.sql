@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Minimum alt account score for a connecting player to inherit the ban of a linked account, 0 to disable.
+ALTER TABLE config
+  ADD COLUMN IF NOT EXISTS network_alt_score_threshold DOUBLE PRECISION NOT NULL DEFAULT 0
+    CHECK (network_alt_score_threshold >= 0 AND network_alt_score_threshold <= 1);
+
+COMMIT;
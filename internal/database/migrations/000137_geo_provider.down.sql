@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE config DROP COLUMN IF EXISTS geo_maxmind_asn_path;
+ALTER TABLE config DROP COLUMN IF EXISTS geo_maxmind_city_path;
+ALTER TABLE config DROP COLUMN IF EXISTS geo_provider;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+-- Source of location and ASN lookups. MaxMind reads local GeoLite2 mmdb files instead of the imported ip2location data.
+ALTER TABLE config
+  ADD COLUMN IF NOT EXISTS geo_provider TEXT NOT NULL DEFAULT 'ip2location'
+    CHECK (geo_provider IN ('ip2location', 'maxmind'));
+ALTER TABLE config ADD COLUMN IF NOT EXISTS geo_maxmind_city_path TEXT NOT NULL DEFAULT '';
+ALTER TABLE config ADD COLUMN IF NOT EXISTS geo_maxmind_asn_path TEXT NOT NULL DEFAULT '';
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS person_api_token;
+
+COMMIT;
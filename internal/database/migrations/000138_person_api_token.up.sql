@@ -0,0 +1,19 @@
+BEGIN;
+
+-- Personal api tokens. Only the sha256 hash of the secret is stored.
+CREATE TABLE IF NOT EXISTS person_api_token (
+  token_id BIGSERIAL PRIMARY KEY,
+  steam_id BIGINT NOT NULL REFERENCES person (steam_id) ON DELETE CASCADE ON UPDATE CASCADE,
+  name TEXT NOT NULL,
+  token_hash TEXT NOT NULL UNIQUE,
+  token_prefix TEXT NOT NULL,
+  privilege INT NOT NULL,
+  procedures TEXT[] NOT NULL DEFAULT '{}',
+  expires_on TIMESTAMPTZ NOT NULL,
+  last_used_on TIMESTAMPTZ,
+  created_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_person_api_token_steam_id ON person_api_token (steam_id);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_person_auth_refresh_token;
+
+ALTER TABLE person_auth DROP COLUMN IF EXISTS last_seen_on;
+ALTER TABLE person_auth DROP COLUMN IF EXISTS user_agent;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Browser sessions are looked up by their fingerprint on each request so they can be revoked.
+ALTER TABLE person_auth ADD COLUMN IF NOT EXISTS user_agent TEXT NOT NULL DEFAULT '';
+ALTER TABLE person_auth ADD COLUMN IF NOT EXISTS last_seen_on TIMESTAMPTZ;
+
+UPDATE person_auth SET last_seen_on = created_on WHERE last_seen_on IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_person_auth_refresh_token ON person_auth (refresh_token);
+
+COMMIT;
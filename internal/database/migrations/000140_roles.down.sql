@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS person_role;
+DROP TABLE IF EXISTS role;
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+-- Named sets of capabilities. Roles with a privilege are the defaults held by everyone at or above that privilege
+-- and cannot be assigned or deleted.
+CREATE TABLE IF NOT EXISTS role (
+  role_id SERIAL PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  description TEXT NOT NULL DEFAULT '',
+  capabilities TEXT[] NOT NULL DEFAULT '{}',
+  privilege INT UNIQUE,
+  created_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS person_role (
+  steam_id BIGINT NOT NULL REFERENCES person (steam_id) ON DELETE CASCADE ON UPDATE CASCADE,
+  role_id INT NOT NULL REFERENCES role (role_id) ON DELETE CASCADE,
+  created_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (steam_id, role_id)
+);
+
+INSERT INTO role (name, description, capabilities, privilege)
+VALUES ('moderator', 'Held by all moderators', '{appeals.reply,appeals.view,bans.ban,bans.gag,bans.view,chat.warnings,chat.word_filters}', 50),
+       ('admin', 'Held by all admins', '{appeals.reply,appeals.view,bans.ban,bans.escalation,bans.gag,bans.view,chat.escalation,chat.warnings,chat.word_filters}', 100)
+ON CONFLICT DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Evidence gathered automatically when a report is created. The summary is a snapshot so the evidence stays
+-- readable after the source record has been pruned.
+CREATE TABLE IF NOT EXISTS report_evidence (
+  report_evidence_id BIGSERIAL PRIMARY KEY,
+  report_id INT NOT NULL REFERENCES report (report_id) ON DELETE CASCADE,
+  kind INT NOT NULL,
+  ref_id BIGINT NOT NULL,
+  steam_id BIGINT NOT NULL,
+  server_id INT,
+  summary TEXT NOT NULL DEFAULT '',
+  flagged BOOLEAN NOT NULL DEFAULT FALSE,
+  created_on TIMESTAMPTZ NOT NULL,
+  UNIQUE (report_id, kind, ref_id)
+);
+
+COMMIT;
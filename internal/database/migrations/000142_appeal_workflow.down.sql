@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE IF EXISTS appeal_template;
+DROP TABLE IF EXISTS ban_appeal_workflow;
+
+ALTER TABLE config DROP COLUMN IF EXISTS appeals_reminder_days;
+
+COMMIT;
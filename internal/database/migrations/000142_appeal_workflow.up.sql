@@ -0,0 +1,27 @@
+BEGIN;
+
+-- Days an open appeal may wait for a staff reply before moderators are reminded, 0 to disable.
+ALTER TABLE config
+  ADD COLUMN IF NOT EXISTS appeals_reminder_days INT NOT NULL DEFAULT 0
+    CHECK (appeals_reminder_days >= 0);
+
+-- Assignment and reminder state of ban appeals.
+CREATE TABLE IF NOT EXISTS ban_appeal_workflow (
+  ban_id INT PRIMARY KEY REFERENCES ban (ban_id) ON DELETE CASCADE,
+  assignee_id BIGINT REFERENCES person (steam_id) ON DELETE SET NULL ON UPDATE CASCADE,
+  assigned_on TIMESTAMPTZ,
+  reminded_on TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_ban_appeal_workflow_assignee ON ban_appeal_workflow (assignee_id);
+
+-- Canned responses used when replying to appeals.
+CREATE TABLE IF NOT EXISTS appeal_template (
+  appeal_template_id SERIAL PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  body_md TEXT NOT NULL,
+  created_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_on TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMIT;
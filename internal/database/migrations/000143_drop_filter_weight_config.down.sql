@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE config
+  ADD COLUMN IF NOT EXISTS filters_max_weight INT NOT NULL DEFAULT 10,
+  ADD COLUMN IF NOT EXISTS filters_check_timeout INT NOT NULL DEFAULT 5,
+  ADD COLUMN IF NOT EXISTS filters_match_timeout INT NOT NULL DEFAULT 7200;
+
+COMMIT;
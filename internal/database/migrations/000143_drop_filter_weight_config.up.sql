@@ -0,0 +1,9 @@
+BEGIN;
+
+-- Replaced by the chat_escalation steps and the decay of filters_warning_timeout.
+ALTER TABLE config
+  DROP COLUMN IF EXISTS filters_max_weight,
+  DROP COLUMN IF EXISTS filters_check_timeout,
+  DROP COLUMN IF EXISTS filters_match_timeout;
+
+COMMIT;
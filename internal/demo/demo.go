@@ -171,7 +171,7 @@ func (d Demos) createFromAsset(ctx context.Context, asset *asset.Asset, serverID
 		mapName = nameParts[0]
 	}
 
-	parsedDemo, err = demoparse.Submit(ctx, d.DemoParserURL, asset.String(), asset)
+	parsedDemo, err = d.parse(ctx, asset)
 	if err != nil {
 		return nil, err
 	}
@@ -234,6 +234,16 @@ func (d Demos) createFromAsset(ctx context.Context, asset *asset.Asset, serverID
 	return &newDemo, nil
 }
 
+// parse reads the demo with the built-in parser, or submits it to the external parser service when a
+// DemoParserURL is configured.
+func (d Demos) parse(ctx context.Context, asset *asset.Asset) (*demoparse.Demo, error) {
+	if d.DemoParserURL != "" {
+		return demoparse.Submit(ctx, d.DemoParserURL, asset.String(), asset)
+	}
+
+	return demoparse.Parse(asset.String(), asset)
+}
+
 func (d Demos) importChatMessages(ctx context.Context, serverID int32, demoID int32, parsedDemo *demoparse.Demo, startTime time.Time, matchID *uuid.UUID) error {
 	for _, msg := range parsedDemo.Chat {
 		if msg.User == "BOT" {
@@ -248,7 +258,7 @@ func (d Demos) importChatMessages(ctx context.Context, serverID int32, demoID in
 		}
 		userName := parsedDemo.UserName(sid)
 
-		if err := d.chat.AddChatHistory(ctx, &chat.Message{
+		if err := d.chat.AddDemoChatHistory(ctx, &chat.Message{
 			ServerID:    serverID,
 			DemoID:      &demoID,
 			DemoTick:    &msg.Tick,
@@ -521,10 +531,19 @@ func (d Demos) GetDemoByName(ctx context.Context, demoName string) (*File, error
 	return d.repository.GetDemoByName(ctx, demoName)
 }
 
+// GetDemoCovering returns the demo recording the player at the given time.
+func (d Demos) GetDemoCovering(ctx context.Context, steamID steamid.SteamID, at time.Time) (*File, error) {
+	return d.repository.GetDemoCovering(ctx, steamID, at, maxDemoDuration)
+}
+
 func (d Demos) GetDemos(ctx context.Context) ([]File, error) {
 	return d.repository.GetDemos(ctx)
 }
 
+// maxDemoDuration is the longest a single demo is expected to record for, a demo starting earlier than this
+// before a given time is assumed to have ended already.
+const maxDemoDuration = 2 * time.Hour
+
 // Were just going to assume the server is relatively consistent, it doesnt matter too much.
 const frameDuration = 16600 * time.Microsecond
 
@@ -3,10 +3,12 @@ package demo
 import (
 	"context"
 	"errors"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/gofrs/uuid/v5"
 	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 )
 
 var ErrServerValidate = errors.New("failed to validate server")
@@ -63,6 +65,19 @@ func (r Repository) ExpiredDemos(ctx context.Context, limit uint64) ([]Info, err
 }
 
 func (r Repository) GetDemoByColumn(ctx context.Context, key string, value any) (*File, error) {
+	return r.getDemo(ctx, sq.Eq{key: value})
+}
+
+// GetDemoCovering returns the most recent demo containing the player that started within window before at.
+func (r Repository) GetDemoCovering(ctx context.Context, steamID steamid.SteamID, at time.Time, window time.Duration) (*File, error) {
+	return r.getDemo(ctx, sq.And{
+		sq.Expr("d.stats ?? ?", steamID.String()),
+		sq.LtOrEq{"d.created_on": at},
+		sq.Gt{"d.created_on": at.Add(-window)},
+	})
+}
+
+func (r Repository) getDemo(ctx context.Context, where sq.Sqlizer) (*File, error) {
 	var demoFile File
 	row, errRow := r.QueryRowBuilder(ctx, r.Builder().
 		Select("d.demo_id", "d.server_id", "d.title", "d.created_on", "d.downloads",
@@ -70,7 +85,9 @@ func (r Repository) GetDemoByColumn(ctx context.Context, key string, value any)
 		From("demo d").
 		LeftJoin("server s ON s.server_id = d.server_id").
 		InnerJoin("asset a ON a.asset_id = d.asset_id").
-		Where(sq.And{sq.Eq{key: value}, sq.Eq{"a.deleted": false}}))
+		Where(sq.And{where, sq.Eq{"a.deleted": false}}).
+		OrderBy("d.created_on DESC").
+		Limit(1))
 	if errRow != nil {
 		return nil, database.Err(errRow)
 	}
@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"github.com/leighmacdonald/gbans/pkg/broadcaster"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// consumerCollector exports the queue state of each broadcaster consumer. The values are read from the
+// broadcaster at scrape time so consumers do not need to know about prometheus.
+type consumerCollector struct {
+	eb        *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]
+	lag       *prometheus.Desc
+	capacity  *prometheus.Desc
+	delivered *prometheus.Desc
+	dropped   *prometheus.Desc
+}
+
+func newConsumerCollector(eb *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]) *consumerCollector {
+	labels := []string{"consumer", "policy"}
+
+	return &consumerCollector{
+		eb: eb,
+		lag: prometheus.NewDesc("gbans_event_consumer_lag",
+			"Events queued but not yet processed by the consumer", labels, nil),
+		capacity: prometheus.NewDesc("gbans_event_consumer_capacity",
+			"Maximum events queued for the consumer", labels, nil),
+		delivered: prometheus.NewDesc("gbans_event_consumer_delivered_total",
+			"Total events queued for the consumer", labels, nil),
+		dropped: prometheus.NewDesc("gbans_event_consumer_dropped_total",
+			"Total events dropped due to a full consumer queue", labels, nil),
+	}
+}
+
+func (c *consumerCollector) Describe(descs chan<- *prometheus.Desc) {
+	descs <- c.lag
+	descs <- c.capacity
+	descs <- c.delivered
+	descs <- c.dropped
+}
+
+func (c *consumerCollector) Collect(metrics chan<- prometheus.Metric) {
+	for _, stats := range c.eb.Stats() {
+		policy := stats.Policy.String()
+		metrics <- prometheus.MustNewConstMetric(c.lag, prometheus.GaugeValue, float64(stats.Lag), stats.Name, policy)
+		metrics <- prometheus.MustNewConstMetric(c.capacity, prometheus.GaugeValue, float64(stats.Capacity), stats.Name, policy)
+		metrics <- prometheus.MustNewConstMetric(c.delivered, prometheus.CounterValue, float64(stats.Delivered), stats.Name, policy)
+		metrics <- prometheus.MustNewConstMetric(c.dropped, prometheus.CounterValue, float64(stats.Dropped), stats.Name, policy)
+	}
+}
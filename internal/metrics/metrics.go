@@ -32,18 +32,20 @@ type Metrics struct {
 
 func New(broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]) Metrics {
 	collector := newMetricCollector()
+	_ = prometheus.Register(newConsumerCollector(broadcaster))
 
 	return Metrics{collector: collector, eb: broadcaster}
 }
 
 // Start begins processing incoming log events and updating any associated metrics.
 func (u Metrics) Start(ctx context.Context) {
-	eventChan := make(chan logparse.ServerEvent)
-	if errRegister := u.eb.Consume(eventChan); errRegister != nil {
+	consumer, errRegister := u.eb.Subscribe("metrics", broadcaster.Options{Policy: broadcaster.DropOldest})
+	if errRegister != nil {
 		slog.Error("Failed to register event consumer", slog.String("error", errRegister.Error()))
 
 		return
 	}
+	defer u.eb.Unsubscribe(consumer)
 
 	parser := logparse.NewWeaponParser()
 
@@ -51,7 +53,7 @@ func (u Metrics) Start(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			return
-		case newEvent := <-eventChan:
+		case newEvent := <-consumer.C:
 			// if newEvent.ServerID == 0 {
 			// TODO why is this ever nil?
 			// u.collector.LogEventCounter.With(prometheus.Labels{"server_name": newEvent.ServerID}).Inc()
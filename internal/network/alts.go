@@ -0,0 +1,216 @@
+package network
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+const (
+	// altHalfLife is how long until the weight of a shared address is halved.
+	altHalfLife = time.Hour * 24 * 90
+	// altMaxAccounts is the amount of accounts an address can be shared by before it is considered a public
+	// network, eg: a school, LAN centre or CGNAT range not known to ip2location, and ignored.
+	altMaxAccounts = 10
+	// altMaxDepth is how many links away from the player accounts are searched.
+	altMaxDepth = 2
+	// altMinScore is the minimum score for an account to be considered linked.
+	altMinScore = 0.05
+	// altMaxExpand limits the amount of linked accounts searched at each depth.
+	altMaxExpand = 25
+)
+
+// cgnatPrefix is the shared address space used by carrier grade NAT, RFC 6598.
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10") //nolint:gochecknoglobals
+
+// AltAccount is an account that is likely operated by the same person as another account.
+type AltAccount struct {
+	SteamID     steamid.SteamID
+	PersonaName string
+	// Score is the likelihood between 0 and 1 of the account being an alt.
+	Score float64
+	// SharedAddresses is the amount of addresses shared directly with the account that linked it.
+	SharedAddresses int
+	LastShared      time.Time
+	// Depth is 1 for accounts sharing addresses with the player directly, 2 when linked through another alt.
+	Depth int
+	// LinkedVia is the account that linked this one when Depth > 1.
+	LinkedVia steamid.SteamID
+}
+
+// sharedAddress is an address used by both the source account and the linked account.
+type sharedAddress struct {
+	Addr        netip.Addr
+	SteamID     steamid.SteamID
+	PersonaName string
+	// LastSeen is when the linked account last used the address.
+	LastSeen time.Time
+	// SourceLastSeen is when the source account last used the address.
+	SourceLastSeen time.Time
+	// Accounts is the total amount of distinct accounts that used the address.
+	Accounts int
+}
+
+// ignoredAddress reports addresses that are shared by design and say nothing about who is using them.
+func ignoredAddress(addr netip.Addr) bool {
+	return !addr.IsValid() || addr.IsPrivate() || addr.IsLoopback() || addr.IsUnspecified() || cgnatPrefix.Contains(addr)
+}
+
+// addressWeight is the weight of a single shared address. It decays with the time since the address was last
+// used by both accounts and is divided between all the other accounts using the address.
+func addressWeight(shared sharedAddress, now time.Time) float64 {
+	if shared.Accounts > altMaxAccounts || ignoredAddress(shared.Addr) {
+		return 0
+	}
+
+	lastShared := shared.LastSeen
+	if shared.SourceLastSeen.Before(lastShared) {
+		lastShared = shared.SourceLastSeen
+	}
+
+	age := max(now.Sub(lastShared), 0)
+	decay := math.Pow(0.5, float64(age)/float64(altHalfLife))
+
+	return decay / float64(max(shared.Accounts-1, 1))
+}
+
+// scoreLinks combines the weights of all the addresses shared with each account. Each address is treated as
+// independent evidence, so an account sharing several addresses scores higher than one sharing a single address.
+func scoreLinks(addresses []sharedAddress, now time.Time) map[steamid.SteamID]AltAccount {
+	missing := map[steamid.SteamID]float64{}
+	links := map[steamid.SteamID]AltAccount{}
+
+	for _, shared := range addresses {
+		weight := addressWeight(shared, now)
+		if weight <= 0 {
+			continue
+		}
+
+		link, found := links[shared.SteamID]
+		if !found {
+			link = AltAccount{SteamID: shared.SteamID, Depth: 1}
+			missing[shared.SteamID] = 1
+		}
+
+		missing[shared.SteamID] *= 1 - weight
+		link.SharedAddresses++
+
+		lastShared := shared.LastSeen
+		if shared.SourceLastSeen.Before(lastShared) {
+			lastShared = shared.SourceLastSeen
+		}
+
+		if lastShared.After(link.LastShared) {
+			link.LastShared = lastShared
+			link.PersonaName = shared.PersonaName
+		}
+
+		links[shared.SteamID] = link
+	}
+
+	for steamID, link := range links {
+		link.Score = 1 - missing[steamID]
+		links[steamID] = link
+	}
+
+	return links
+}
+
+// AltAccounts walks the graph of accounts sharing addresses with the player. Addresses known to be proxies or
+// that are shared by many accounts are ignored. The score of accounts found through another alt is the product
+// of the scores along the path.
+func (u Networks) AltAccounts(ctx context.Context, steamID steamid.SteamID) ([]AltAccount, error) {
+	var (
+		now      = time.Now()
+		found    = map[steamid.SteamID]AltAccount{}
+		frontier = []AltAccount{{SteamID: steamID, Score: 1}}
+	)
+
+	for depth := 1; depth <= altMaxDepth && len(frontier) > 0; depth++ {
+		var next []AltAccount
+
+		for _, source := range frontier {
+			addresses, errAddresses := u.repository.SharedAddresses(ctx, source.SteamID)
+			if errAddresses != nil {
+				return nil, errAddresses
+			}
+
+			for linkedID, link := range scoreLinks(addresses, now) {
+				if linkedID == steamID {
+					continue
+				}
+
+				link.Score *= source.Score
+				link.Depth = depth
+
+				if depth > 1 {
+					link.LinkedVia = source.SteamID
+				}
+
+				if link.Score < altMinScore {
+					continue
+				}
+
+				if existing, ok := found[linkedID]; ok && existing.Score >= link.Score {
+					continue
+				}
+
+				found[linkedID] = link
+				next = append(next, link)
+			}
+		}
+
+		slices.SortFunc(next, compareScore)
+
+		frontier = next[:min(len(next), altMaxExpand)]
+	}
+
+	alts := make([]AltAccount, 0, len(found))
+	for _, alt := range found {
+		alts = append(alts, alt)
+	}
+
+	slices.SortFunc(alts, compareScore)
+
+	return alts, nil
+}
+
+// LinkedAccounts returns the alt accounts scoring at least the configured AltScoreThreshold. Nothing is returned
+// when the threshold is disabled.
+func (u Networks) LinkedAccounts(ctx context.Context, steamID steamid.SteamID) ([]AltAccount, error) {
+	u.RLock()
+	threshold := u.AltScoreThreshold
+	u.RUnlock()
+
+	if threshold <= 0 {
+		return nil, nil
+	}
+
+	alts, errAlts := u.AltAccounts(ctx, steamID)
+	if errAlts != nil {
+		return nil, errAlts
+	}
+
+	// Sorted by score, so everything after the first miss is below the threshold as well.
+	for idx, alt := range alts {
+		if alt.Score < threshold {
+			return alts[:idx], nil
+		}
+	}
+
+	return alts, nil
+}
+
+// compareScore orders by the highest score first.
+func compareScore(a AltAccount, b AltAccount) int {
+	if order := cmp.Compare(b.Score, a.Score); order != 0 {
+		return order
+	}
+
+	return cmp.Compare(a.SteamID.Int64(), b.SteamID.Int64())
+}
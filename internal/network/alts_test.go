@@ -0,0 +1,102 @@
+package network_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/network"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+const halfLife = time.Hour * 24 * 90
+
+func TestIgnoredAddress(t *testing.T) {
+	for _, testCase := range []struct {
+		addr    string
+		ignored bool
+	}{
+		{"1.1.1.1", false},
+		{"2606:4700:4700::1111", false},
+		{"10.0.0.1", true},
+		{"172.16.5.4", true},
+		{"192.168.1.1", true},
+		{"fd00::1", true},
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"0.0.0.0", true},
+		{"100.64.0.1", true},
+		{"100.127.255.254", true},
+		{"100.128.0.1", false},
+	} {
+		require.Equal(t, testCase.ignored, network.IgnoredAddress(netip.MustParseAddr(testCase.addr)), testCase.addr)
+	}
+
+	require.True(t, network.IgnoredAddress(netip.Addr{}))
+}
+
+func TestAddressWeight(t *testing.T) {
+	var (
+		now    = time.Now()
+		public = netip.MustParseAddr("1.1.1.1")
+	)
+
+	for _, testCase := range []struct {
+		name   string
+		shared network.SharedAddress
+		weight float64
+	}{
+		{"recent", network.SharedAddress{Addr: public, Accounts: 2, LastSeen: now, SourceLastSeen: now}, 1},
+		{"single account", network.SharedAddress{Addr: public, Accounts: 1, LastSeen: now, SourceLastSeen: now}, 1},
+		{"one half life", network.SharedAddress{Addr: public, Accounts: 2, LastSeen: now.Add(-halfLife), SourceLastSeen: now}, 0.5},
+		{"source last seen", network.SharedAddress{Addr: public, Accounts: 2, LastSeen: now, SourceLastSeen: now.Add(-halfLife * 2)}, 0.25},
+		{"future", network.SharedAddress{Addr: public, Accounts: 2, LastSeen: now.Add(time.Hour), SourceLastSeen: now.Add(time.Hour)}, 1},
+		{"divided", network.SharedAddress{Addr: public, Accounts: 5, LastSeen: now, SourceLastSeen: now}, 0.25},
+		{"divided and decayed", network.SharedAddress{Addr: public, Accounts: 3, LastSeen: now.Add(-halfLife), SourceLastSeen: now}, 0.25},
+		{"max accounts", network.SharedAddress{Addr: public, Accounts: 10, LastSeen: now, SourceLastSeen: now}, 1.0 / 9},
+		{"public network", network.SharedAddress{Addr: public, Accounts: 11, LastSeen: now, SourceLastSeen: now}, 0},
+		{"private", network.SharedAddress{Addr: netip.MustParseAddr("192.168.0.10"), Accounts: 2, LastSeen: now, SourceLastSeen: now}, 0},
+		{"cgnat", network.SharedAddress{Addr: netip.MustParseAddr("100.100.1.1"), Accounts: 2, LastSeen: now, SourceLastSeen: now}, 0},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.InDelta(t, testCase.weight, network.AddressWeight(testCase.shared, now), 0.0001)
+		})
+	}
+}
+
+func TestScoreLinks(t *testing.T) {
+	var (
+		now     = time.Now()
+		strong  = steamid.New(76561198084134025)
+		weak    = steamid.New(76561197960265728)
+		ignored = steamid.New(76561197970669109)
+	)
+
+	links := network.ScoreLinks([]network.SharedAddress{
+		// Two addresses of half weight combine to 1 - (0.5 * 0.5).
+		{Addr: netip.MustParseAddr("1.1.1.1"), SteamID: strong, PersonaName: "old", Accounts: 3, LastSeen: now.Add(-time.Hour), SourceLastSeen: now},
+		{Addr: netip.MustParseAddr("1.1.1.2"), SteamID: strong, PersonaName: "new", Accounts: 2, LastSeen: now.Add(-halfLife), SourceLastSeen: now},
+		{Addr: netip.MustParseAddr("8.8.8.8"), SteamID: weak, PersonaName: "weak", Accounts: 5, LastSeen: now, SourceLastSeen: now},
+		{Addr: netip.MustParseAddr("192.168.1.1"), SteamID: weak, Accounts: 2, LastSeen: now, SourceLastSeen: now},
+		{Addr: netip.MustParseAddr("100.64.1.1"), SteamID: ignored, Accounts: 2, LastSeen: now, SourceLastSeen: now},
+		{Addr: netip.MustParseAddr("9.9.9.9"), SteamID: ignored, Accounts: 20, LastSeen: now, SourceLastSeen: now},
+	}, now)
+
+	require.Len(t, links, 2)
+	require.NotContains(t, links, ignored)
+
+	strongLink := links[strong]
+	require.InDelta(t, 0.75, strongLink.Score, 0.001)
+	require.Equal(t, 2, strongLink.SharedAddresses)
+	require.Equal(t, 1, strongLink.Depth)
+	require.Equal(t, "old", strongLink.PersonaName)
+	require.Equal(t, now.Add(-time.Hour), strongLink.LastShared)
+
+	weakLink := links[weak]
+	require.InDelta(t, 0.25, weakLink.Score, 0.0001)
+	require.Equal(t, 1, weakLink.SharedAddresses)
+	require.Equal(t, "weak", weakLink.PersonaName)
+
+	require.Empty(t, network.ScoreLinks(nil, now))
+}
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"net/netip"
 	"time"
+
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 )
 
 var (
@@ -14,17 +17,19 @@ var (
 
 // Block represents a autonomous systems number based network block.
 type Block struct {
-	ASNum int
+	ASNum int64
 	// Reason is the one liner reason shown to banned users upon connect.
 	Reason string
 	// Notes is the hidden moderator/admin notes for the ban.
-	Notes     string
+	Notes string
+	// AuthorID is the moderator who created the block.
+	AuthorID  steamid.SteamID
 	CreatedOn time.Time
 	UpdatedOn time.Time
 }
 
-func NewBlock(asNum int, reason string) Block {
-	return Block{ASNum: asNum, Reason: reason, CreatedOn: time.Now(), UpdatedOn: time.Now()}
+func NewBlock(asNum int64, reason string, notes string, authorID steamid.SteamID) Block {
+	return Block{ASNum: asNum, Reason: reason, Notes: notes, AuthorID: authorID, CreatedOn: time.Now(), UpdatedOn: time.Now()}
 }
 
 func NewBlocker(repo Repository) Blocker {
@@ -35,26 +40,39 @@ type Blocker struct {
 	repo Repository
 }
 
-func (a Blocker) Check(ctx context.Context, addr netip.Addr) error {
-	if a.repo.IsBlocked(ctx, addr) {
-		return ErrBlocked
+// Check looks up the autonomous system the address belongs to and returns the matching block along with
+// ErrBlocked if that system is blocked.
+func (a Blocker) Check(ctx context.Context, addr netip.Addr) (Block, error) {
+	block, errBlock := a.repo.BlockByAddr(ctx, addr)
+	if errBlock != nil {
+		if errors.Is(errBlock, database.ErrNoResult) {
+			return Block{}, nil
+		}
+
+		return Block{}, errBlock
 	}
 
-	return nil
+	return block, ErrBlocked
+}
+
+func (a Blocker) Blocks(ctx context.Context) ([]Block, error) {
+	return a.repo.All(ctx)
 }
 
-func (a Blocker) Save(ctx context.Context, asnBan Block) error {
+func (a Blocker) Save(ctx context.Context, asnBan Block) (Block, error) {
 	if asnBan.ASNum <= 0 {
-		return ErrInvalidASNBan
+		return Block{}, ErrInvalidASNBan
 	}
 
+	asnBan.UpdatedOn = time.Now()
+
 	return a.repo.Save(ctx, asnBan)
 }
 
-func (a Blocker) Delete(ctx context.Context, asnBan Block) error {
-	if asnBan.ASNum <= 0 {
+func (a Blocker) Delete(ctx context.Context, asNum int64) error {
+	if asNum <= 0 {
 		return ErrInvalidASNBan
 	}
 
-	return a.repo.Delete(ctx, asnBan.ASNum)
+	return a.repo.Delete(ctx, asNum)
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"net/netip"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 )
 
 func NewRepository(db database.Database) Repository {
@@ -16,7 +18,10 @@ type Repository struct {
 }
 
 func (r Repository) All(ctx context.Context) ([]Block, error) {
-	rows, errRows := r.db.Query(ctx, `SELECT as_num, reason, notes, created_on, updated_on FROM as_num`)
+	rows, errRows := r.db.Query(ctx, `
+		SELECT as_num, reason, notes, author_id, created_on, updated_on
+		FROM asn_ban
+		ORDER BY as_num`)
 	if errRows != nil {
 		return nil, database.Err(errRows)
 	}
@@ -25,8 +30,8 @@ func (r Repository) All(ctx context.Context) ([]Block, error) {
 
 	var blocks []Block
 	for rows.Next() {
-		var block Block
-		if err := rows.Scan(&block.ASNum, &block.Reason, &block.Notes, &block.CreatedOn, &block.UpdatedOn); err != nil {
+		block, err := scanBlock(rows)
+		if err != nil {
 			return nil, database.Err(err)
 		}
 
@@ -36,26 +41,68 @@ func (r Repository) All(ctx context.Context) ([]Block, error) {
 	return blocks, nil
 }
 
-func (r Repository) IsBlocked(_ context.Context, _ netip.Addr) bool {
-	// const query = `SELECT as_num, ip_range FRON net_asn WHERE as_num = $1`
+// BlockByAddr returns the block for the autonomous system that owns the address, using the imported
+// ip2location asn records. database.ErrNoResult is returned when the owning system is not blocked.
+func (r Repository) BlockByAddr(ctx context.Context, addr netip.Addr) (Block, error) {
+	const query = `
+		SELECT b.as_num, b.reason, b.notes, b.author_id, b.created_on, b.updated_on
+		FROM net_asn a
+		INNER JOIN asn_ban b ON b.as_num = a.as_num
+		WHERE $1::ip4 <<= a.ip_range
+		LIMIT 1`
+
+	if !addr.Unmap().Is4() {
+		return Block{}, database.ErrNoResult
+	}
+
+	block, err := scanBlock(r.db.QueryRow(ctx, query, addr.Unmap().String()))
+	if err != nil {
+		return Block{}, database.Err(err)
+	}
 
-	return false
+	return block, nil
 }
 
-func (r Repository) Save(ctx context.Context, ban Block) error {
+func (r Repository) Save(ctx context.Context, ban Block) (Block, error) {
 	const query = `
-		INSERT INTO asn_ban (as_num, reason, notes, created_on, updated_on)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO asn_ban (as_num, reason, notes, author_id, created_on, updated_on)
+		VALUES ($1, $2, $3, $4, $5, $6)
 		ON CONFLICT (as_num) DO UPDATE
-		SET reason = $2, updated_on = $5`
+		SET reason = $2, notes = $3, updated_on = $6
+		RETURNING as_num, reason, notes, author_id, created_on, updated_on`
+
+	var authorID *int64
+	if ban.AuthorID.Valid() {
+		authorID = new(ban.AuthorID.Int64())
+	}
 
-	if err := r.db.Exec(ctx, query, ban.ASNum, ban.Reason, ban.Notes, ban.CreatedOn, ban.UpdatedOn); err != nil {
-		return database.Err(err)
+	saved, err := scanBlock(r.db.QueryRow(ctx, query, ban.ASNum, ban.Reason, ban.Notes, authorID, ban.CreatedOn, ban.UpdatedOn))
+	if err != nil {
+		return Block{}, database.Err(err)
 	}
 
-	return nil
+	return saved, nil
 }
 
-func (r Repository) Delete(ctx context.Context, asNum int) error {
-	return database.Err(r.db.Exec(ctx, `DELETE FROM ban_asn WHERE as_num = $1`, asNum))
+func (r Repository) Delete(ctx context.Context, asNum int64) error {
+	var deleted int64
+
+	return database.Err(r.db.QueryRow(ctx, `DELETE FROM asn_ban WHERE as_num = $1 RETURNING as_num`, asNum).Scan(&deleted))
+}
+
+func scanBlock(row pgx.Row) (Block, error) {
+	var (
+		block    Block
+		authorID *int64
+	)
+
+	if err := row.Scan(&block.ASNum, &block.Reason, &block.Notes, &authorID, &block.CreatedOn, &block.UpdatedOn); err != nil {
+		return Block{}, err
+	}
+
+	if authorID != nil {
+		block.AuthorID = steamid.New(*authorID)
+	}
+
+	return block, nil
 }
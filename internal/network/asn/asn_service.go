@@ -0,0 +1,89 @@
+package asn
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/database"
+	v1 "github.com/leighmacdonald/gbans/internal/network/v1"
+	"github.com/leighmacdonald/gbans/internal/network/v1/networkv1connect"
+	"github.com/leighmacdonald/gbans/internal/rpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Service struct {
+	// networkv1connect.UnimplementedASNServiceHandler
+
+	blocker Blocker
+}
+
+func NewService(blocker Blocker, authMiddleware *rpc.Middleware, option ...connect.HandlerOption) rpc.Service {
+	pattern, handler := networkv1connect.NewASNServiceHandler(Service{blocker: blocker}, option...)
+
+	authMiddleware.UserRoute(networkv1connect.ASNServiceASNBlocksProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(networkv1connect.ASNServiceASNBlockSaveProcedure, rpc.WithMinPermissions(permission.Moderator))
+	authMiddleware.UserRoute(networkv1connect.ASNServiceASNBlockDeleteProcedure, rpc.WithMinPermissions(permission.Moderator))
+
+	return rpc.Service{Pattern: pattern, Handler: handler}
+}
+
+func (s Service) ASNBlocks(ctx context.Context, _ *emptypb.Empty) (*v1.ASNBlocksResponse, error) {
+	blocks, errBlocks := s.blocker.Blocks(ctx)
+	if errBlocks != nil && !errors.Is(errBlocks, database.ErrNoResult) {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.ASNBlocksResponse{Blocks: make([]*v1.ASNBlock, len(blocks))}
+	for idx, block := range blocks {
+		resp.Blocks[idx] = toASNBlock(block)
+	}
+
+	return &resp, nil
+}
+
+func (s Service) ASNBlockSave(ctx context.Context, req *v1.ASNBlockSaveRequest) (*v1.ASNBlockSaveResponse, error) {
+	user := rpc.UserInfoFromCtx(ctx)
+
+	block, errSave := s.blocker.Save(ctx, NewBlock(req.GetAsNum(), req.GetReason(), req.GetNotes(), user.GetSteamID()))
+	if errSave != nil {
+		if errors.Is(errSave, ErrInvalidASNBan) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+		}
+
+		slog.Error("Failed to save asn block", slog.String("error", errSave.Error()), slog.Int64("as_num", req.GetAsNum()))
+
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &v1.ASNBlockSaveResponse{Block: toASNBlock(block)}, nil
+}
+
+func (s Service) ASNBlockDelete(ctx context.Context, req *v1.ASNBlockDeleteRequest) (*emptypb.Empty, error) {
+	if errDelete := s.blocker.Delete(ctx, req.GetAsNum()); errDelete != nil {
+		switch {
+		case errors.Is(errDelete, ErrInvalidASNBan):
+			return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+		case errors.Is(errDelete, database.ErrNoResult):
+			return nil, connect.NewError(connect.CodeNotFound, rpc.ErrNotFound)
+		default:
+			return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toASNBlock(block Block) *v1.ASNBlock {
+	return &v1.ASNBlock{
+		AsNum:     &block.ASNum,
+		Reason:    &block.Reason,
+		Notes:     &block.Notes,
+		AuthorId:  new(block.AuthorID.Int64()),
+		CreatedOn: timestamppb.New(block.CreatedOn),
+		UpdatedOn: timestamppb.New(block.UpdatedOn),
+	}
+}
@@ -1 +1,21 @@
 package asn_test
+
+import (
+	"testing"
+
+	"github.com/leighmacdonald/gbans/internal/network/asn"
+	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockerInvalid(t *testing.T) {
+	blocker := asn.NewBlocker(asn.Repository{})
+
+	_, errSave := blocker.Save(t.Context(), asn.NewBlock(0, "reason", "", tests.ModSID))
+	require.ErrorIs(t, errSave, asn.ErrInvalidASNBan)
+
+	_, errNegative := blocker.Save(t.Context(), asn.NewBlock(-1, "reason", "", tests.ModSID))
+	require.ErrorIs(t, errNegative, asn.ErrInvalidASNBan)
+
+	require.ErrorIs(t, blocker.Delete(t.Context(), 0), asn.ErrInvalidASNBan)
+}
@@ -0,0 +1,10 @@
+package network
+
+// Exported for tests in the network_test package.
+var (
+	IgnoredAddress = ignoredAddress //nolint:gochecknoglobals
+	AddressWeight  = addressWeight  //nolint:gochecknoglobals
+	ScoreLinks     = scoreLinks     //nolint:gochecknoglobals
+)
+
+type SharedAddress = sharedAddress
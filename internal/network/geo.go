@@ -0,0 +1,109 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/network/ip2location"
+	"github.com/leighmacdonald/gbans/internal/network/maxmind"
+)
+
+// GeoSource selects the GeoProvider used for location and ASN lookups.
+type GeoSource string
+
+const (
+	// GeoSourceIP2Location uses the ip2location databases imported into postgres.
+	GeoSourceIP2Location GeoSource = "ip2location"
+	// GeoSourceMaxMind uses local GeoLite2 mmdb files for lookups. The ip2location data is still imported for proxy
+	// lookups, ASN blocks and connection history searches.
+	GeoSourceMaxMind GeoSource = "maxmind"
+)
+
+// GeoProvider resolves the location and owning network of an address.
+type GeoProvider interface {
+	Location(ctx context.Context, addr netip.Addr) (Location, error)
+	ASN(ctx context.Context, addr netip.Addr) (ASN, error)
+}
+
+// databaseProvider looks up records from the ip2location data imported by RefreshLocationData.
+type databaseProvider struct {
+	repository Repository
+}
+
+func (p databaseProvider) Location(ctx context.Context, addr netip.Addr) (Location, error) {
+	return p.repository.GetLocationRecord(ctx, addr)
+}
+
+func (p databaseProvider) ASN(ctx context.Context, addr netip.Addr) (ASN, error) {
+	return p.repository.GetASNRecordByIP(ctx, addr)
+}
+
+// maxmindProvider looks up records from local GeoLite2 databases.
+type maxmindProvider struct {
+	reader *maxmind.Reader
+}
+
+func (p maxmindProvider) Location(_ context.Context, addr netip.Addr) (Location, error) {
+	city, errCity := p.reader.City(addr)
+	if errCity != nil {
+		return Location{}, maxmindErr(errCity)
+	}
+
+	return Location{
+		CIDR:        city.Prefix.String(),
+		CountryCode: city.CountryCode,
+		CountryName: city.CountryName,
+		RegionName:  city.RegionName,
+		CityName:    city.CityName,
+		LatLong: ip2location.LatLong{
+			Latitude:  float32(city.Latitude),
+			Longitude: float32(city.Longitude),
+		},
+	}, nil
+}
+
+func (p maxmindProvider) ASN(_ context.Context, addr netip.Addr) (ASN, error) {
+	asn, errASN := p.reader.ASN(addr)
+	if errASN != nil {
+		return ASN{}, maxmindErr(errASN)
+	}
+
+	return ASN{CIDR: asn.Prefix.String(), ASNum: asn.ASNum, ASName: asn.ASName}, nil
+}
+
+// maxmindErr maps missing records to the same error returned by the database provider.
+func maxmindErr(err error) error {
+	if errors.Is(err, maxmind.ErrNotFound) {
+		return database.ErrNoResult
+	}
+
+	return err
+}
+
+// geoProvider returns the currently configured provider.
+func (u Networks) geoProvider() GeoProvider {
+	u.RLock()
+	source := u.GeoSource
+	u.RUnlock()
+
+	if source == GeoSourceMaxMind {
+		return maxmindProvider{reader: u.maxmind}
+	}
+
+	return databaseProvider{repository: u.repository}
+}
+
+// maxmindPaths returns the configured database paths, or none when another provider is selected so the
+// files are not kept open.
+func (u Networks) maxmindPaths() (string, string) {
+	u.RLock()
+	defer u.RUnlock()
+
+	if u.GeoSource != GeoSourceMaxMind {
+		return "", ""
+	}
+
+	return u.MaxMindCityPath, u.MaxMindASNPath
+}
@@ -0,0 +1,257 @@
+// Package maxmind implements lookups against local MaxMind GeoLite2 City and ASN databases.
+package maxmind
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/netip"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/oschwald/maxminddb-golang/v2"
+)
+
+var (
+	ErrNotLoaded = errors.New("maxmind database not loaded")
+	ErrNotFound  = errors.New("address not found in maxmind database")
+	ErrDecode    = errors.New("failed to decode maxmind record")
+)
+
+// reloadInterval is how often the database files are checked for changes.
+const reloadInterval = time.Second * 30
+
+// City is the subset of a GeoLite2-City record that is used.
+type City struct {
+	Prefix      netip.Prefix
+	CountryCode string
+	CountryName string
+	RegionName  string
+	CityName    string
+	Latitude    float64
+	Longitude   float64
+}
+
+// ASN is a GeoLite2-ASN record.
+type ASN struct {
+	Prefix netip.Prefix
+	ASNum  uint64
+	ASName string
+}
+
+type names struct {
+	English string `maxminddb:"en"`
+}
+
+type cityRecord struct {
+	City struct {
+		Names names `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Country struct {
+		ISOCode string `maxminddb:"iso_code"`
+		Names   names  `maxminddb:"names"`
+	} `maxminddb:"country"`
+	Subdivisions []struct {
+		Names names `maxminddb:"names"`
+	} `maxminddb:"subdivisions"`
+	Location struct {
+		Latitude  float64 `maxminddb:"latitude"`
+		Longitude float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+}
+
+type asnRecord struct {
+	Number       uint64 `maxminddb:"autonomous_system_number"`
+	Organization string `maxminddb:"autonomous_system_organization"`
+}
+
+// database is a single opened database file along with the state used to detect changes to it.
+type database struct {
+	path    string
+	modTime time.Time
+	size    int64
+	reader  *maxminddb.Reader
+}
+
+// Paths returns the current city and asn database paths. It is called on each reload check so configuration
+// changes are picked up without a restart.
+type Paths func() (string, string)
+
+// Reader holds the opened databases. The files are memory mapped so lookups do not need to load them into memory
+// first. Files replaced on disk, eg: by geoipupdate, are reopened automatically while Watch is running.
+type Reader struct {
+	mu sync.RWMutex
+	// reload serialises reloads so a database is never opened or closed twice.
+	reload sync.Mutex
+	city   database
+	asn    database
+	paths  Paths
+}
+
+func NewReader(paths Paths) *Reader {
+	return &Reader{paths: paths}
+}
+
+// Reload opens any databases that have been changed or moved since they were last opened. A database that fails
+// to open keeps the previously loaded version in use.
+func (r *Reader) Reload() error {
+	r.reload.Lock()
+	defer r.reload.Unlock()
+
+	cityPath, asnPath := r.paths()
+
+	r.mu.RLock()
+	city, asn := r.city, r.asn
+	r.mu.RUnlock()
+
+	var errs []error
+
+	newCity, errCity := reopen(city, cityPath)
+	if errCity != nil {
+		errs = append(errs, errCity)
+	}
+
+	newAsn, errAsn := reopen(asn, asnPath)
+	if errAsn != nil {
+		errs = append(errs, errAsn)
+	}
+
+	r.mu.Lock()
+	r.city, r.asn = newCity, newAsn
+	r.mu.Unlock()
+
+	// Close after swapping so no lookups are using the old mapping.
+	for _, old := range []database{city, asn} {
+		if old.reader != nil && old.reader != newCity.reader && old.reader != newAsn.reader {
+			if errClose := old.reader.Close(); errClose != nil {
+				slog.Error("Failed to close maxmind database", slog.String("path", old.path), slog.String("error", errClose.Error()))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// reopen returns the existing database when it has not changed, otherwise the newly opened one.
+func reopen(existing database, path string) (database, error) {
+	if path == "" {
+		return database{}, nil
+	}
+
+	info, errStat := os.Stat(path)
+	if errStat != nil {
+		return existing, errStat
+	}
+
+	if existing.reader != nil && existing.path == path && existing.size == info.Size() && existing.modTime.Equal(info.ModTime()) {
+		return existing, nil
+	}
+
+	reader, errOpen := maxminddb.Open(path)
+	if errOpen != nil {
+		return existing, errOpen
+	}
+
+	slog.Info("Loaded maxmind database", slog.String("path", path),
+		slog.String("type", reader.Metadata.DatabaseType), slog.Time("built", reader.Metadata.BuildTime()))
+
+	return database{path: path, modTime: info.ModTime(), size: info.Size(), reader: reader}, nil
+}
+
+// Watch periodically reloads changed databases until the context is cancelled.
+func (r *Reader) Watch(ctx context.Context) {
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.Close()
+
+			return
+		case <-ticker.C:
+			if err := r.Reload(); err != nil {
+				slog.Error("Failed to reload maxmind database", slog.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+func (r *Reader) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, db := range []database{r.city, r.asn} {
+		if db.reader != nil {
+			_ = db.reader.Close()
+		}
+	}
+
+	r.city, r.asn = database{}, database{}
+}
+
+func (r *Reader) City(addr netip.Addr) (City, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var (
+		city   City
+		record cityRecord
+	)
+
+	prefix, errLookup := lookup(r.city.reader, addr, &record)
+	if errLookup != nil {
+		return city, errLookup
+	}
+
+	city = City{
+		Prefix:      prefix,
+		CountryCode: record.Country.ISOCode,
+		CountryName: record.Country.Names.English,
+		CityName:    record.City.Names.English,
+		Latitude:    record.Location.Latitude,
+		Longitude:   record.Location.Longitude,
+	}
+
+	if len(record.Subdivisions) > 0 {
+		city.RegionName = record.Subdivisions[0].Names.English
+	}
+
+	return city, nil
+}
+
+func (r *Reader) ASN(addr netip.Addr) (ASN, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var record asnRecord
+
+	prefix, errLookup := lookup(r.asn.reader, addr, &record)
+	if errLookup != nil {
+		return ASN{}, errLookup
+	}
+
+	return ASN{Prefix: prefix, ASNum: record.Number, ASName: record.Organization}, nil
+}
+
+func lookup(reader *maxminddb.Reader, addr netip.Addr, record any) (netip.Prefix, error) {
+	if reader == nil {
+		return netip.Prefix{}, ErrNotLoaded
+	}
+
+	result := reader.Lookup(addr.Unmap())
+	if err := result.Err(); err != nil {
+		return netip.Prefix{}, errors.Join(err, ErrDecode)
+	}
+
+	if !result.Found() {
+		return netip.Prefix{}, ErrNotFound
+	}
+
+	if err := result.Decode(record); err != nil {
+		return netip.Prefix{}, errors.Join(err, ErrDecode)
+	}
+
+	return result.Prefix(), nil
+}
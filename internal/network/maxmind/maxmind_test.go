@@ -0,0 +1,197 @@
+package maxmind_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/network/maxmind"
+	"github.com/stretchr/testify/require"
+)
+
+// mmdbEncoder writes the subset of the MaxMind DB data section format needed to build test databases.
+type mmdbEncoder struct {
+	bytes.Buffer
+}
+
+func (e *mmdbEncoder) control(kind byte, size int) {
+	extended := kind > 7
+	typeBits := kind
+	if extended {
+		typeBits = 0
+	}
+
+	switch {
+	case size < 29:
+		e.WriteByte(typeBits<<5 | byte(size))
+	default:
+		e.WriteByte(typeBits<<5 | 29)
+	}
+
+	if extended {
+		e.WriteByte(kind - 7)
+	}
+
+	if size >= 29 {
+		e.WriteByte(byte(size - 29))
+	}
+}
+
+func (e *mmdbEncoder) str(value string) {
+	e.control(2, len(value))
+	e.WriteString(value)
+}
+
+func (e *mmdbEncoder) double(value float64) {
+	e.control(3, 8)
+	_ = binary.Write(e, binary.BigEndian, math.Float64bits(value))
+}
+
+func (e *mmdbEncoder) uint(kind byte, value uint32, size int) {
+	e.control(kind, size)
+	e.Write(binary.BigEndian.AppendUint32(nil, value)[4-size:])
+}
+
+// value encodes strings, float64, uint16, uint32, maps with string keys and slices.
+func (e *mmdbEncoder) value(value any) {
+	switch val := value.(type) {
+	case string:
+		e.str(val)
+	case float64:
+		e.double(val)
+	case uint16:
+		e.uint(5, uint32(val), 2)
+	case uint32:
+		e.uint(6, val, 4)
+	case []any:
+		e.control(11, len(val))
+		for _, item := range val {
+			e.value(item)
+		}
+	case []kv:
+		e.control(7, len(val))
+		for _, pair := range val {
+			e.str(pair.key)
+			e.value(pair.value)
+		}
+	}
+}
+
+type kv struct {
+	key   string
+	value any
+}
+
+// writeDatabase writes an IPv4 database with a single node, where 128.0.0.0/1 resolves to the record and
+// 0.0.0.0/1 has no data.
+func writeDatabase(t *testing.T, path string, databaseType string, record []kv) {
+	t.Helper()
+
+	const nodeCount = 1
+
+	var data mmdbEncoder
+	data.value(record)
+
+	var file bytes.Buffer
+	// 24 bit left and right records, the right points to offset 0 of the data section.
+	file.Write([]byte{0, 0, nodeCount, 0, 0, nodeCount + 16})
+	file.Write(make([]byte, 16))
+	file.Write(data.Bytes())
+	file.WriteString("\xAB\xCD\xEFMaxMind.com")
+
+	var meta mmdbEncoder
+	meta.value([]kv{
+		{"binary_format_major_version", uint16(2)},
+		{"binary_format_minor_version", uint16(0)},
+		{"database_type", databaseType},
+		{"ip_version", uint16(4)},
+		{"node_count", uint32(nodeCount)},
+		{"record_size", uint16(24)},
+	})
+	file.Write(meta.Bytes())
+
+	require.NoError(t, os.WriteFile(path, file.Bytes(), 0o600))
+}
+
+func asnRecord(name string) []kv {
+	return []kv{
+		{"autonomous_system_number", uint32(64512)},
+		{"autonomous_system_organization", name},
+	}
+}
+
+func TestReader(t *testing.T) {
+	var (
+		dir      = t.TempDir()
+		cityPath = filepath.Join(dir, "city.mmdb")
+		asnPath  = filepath.Join(dir, "asn.mmdb")
+		found    = netip.MustParseAddr("192.0.2.1")
+		missing  = netip.MustParseAddr("10.0.0.1")
+	)
+
+	reader := maxmind.NewReader(func() (string, string) { return cityPath, asnPath })
+	defer reader.Close()
+
+	_, errNotLoaded := reader.ASN(found)
+	require.ErrorIs(t, errNotLoaded, maxmind.ErrNotLoaded)
+
+	writeDatabase(t, cityPath, "GeoLite2-City", []kv{
+		{"city", []kv{{"names", []kv{{"en", "Sydney"}}}}},
+		{"country", []kv{{"iso_code", "AU"}, {"names", []kv{{"en", "Australia"}}}}},
+		{"location", []kv{{"latitude", -33.5}, {"longitude", 151.25}}},
+		{"subdivisions", []any{[]kv{{"names", []kv{{"en", "New South Wales"}}}}}},
+	})
+	writeDatabase(t, asnPath, "GeoLite2-ASN", asnRecord("Example Networks"))
+
+	require.NoError(t, reader.Reload())
+
+	city, errCity := reader.City(found)
+	require.NoError(t, errCity)
+	require.Equal(t, maxmind.City{
+		Prefix:      netip.MustParsePrefix("128.0.0.0/1"),
+		CountryCode: "AU",
+		CountryName: "Australia",
+		RegionName:  "New South Wales",
+		CityName:    "Sydney",
+		Latitude:    -33.5,
+		Longitude:   151.25,
+	}, city)
+
+	asn, errASN := reader.ASN(netip.MustParseAddr("::ffff:192.0.2.1"))
+	require.NoError(t, errASN)
+	require.Equal(t, uint64(64512), asn.ASNum)
+	require.Equal(t, "Example Networks", asn.ASName)
+
+	_, errMissing := reader.ASN(missing)
+	require.ErrorIs(t, errMissing, maxmind.ErrNotFound)
+
+	t.Run("reload", func(t *testing.T) {
+		// Replace the file as geoipupdate does, ensuring the change is detectable.
+		replacement := filepath.Join(dir, "asn.mmdb.tmp")
+		writeDatabase(t, replacement, "GeoLite2-ASN", asnRecord("Updated Example Networks"))
+		require.NoError(t, os.Rename(replacement, asnPath))
+		require.NoError(t, os.Chtimes(asnPath, time.Now(), time.Now().Add(time.Minute)))
+
+		require.NoError(t, reader.Reload())
+
+		updated, errUpdated := reader.ASN(found)
+		require.NoError(t, errUpdated)
+		require.Equal(t, "Updated Example Networks", updated.ASName)
+	})
+
+	t.Run("failed reload keeps loaded database", func(t *testing.T) {
+		invalid := filepath.Join(dir, "asn.mmdb.tmp")
+		require.NoError(t, os.WriteFile(invalid, []byte("invalid"), 0o600))
+		require.NoError(t, os.Rename(invalid, asnPath))
+		require.Error(t, reader.Reload())
+
+		existing, errExisting := reader.ASN(found)
+		require.NoError(t, errExisting)
+		require.Equal(t, "Updated Example Networks", existing.ASName)
+	})
+}
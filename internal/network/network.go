@@ -15,6 +15,7 @@ import (
 	"github.com/leighmacdonald/gbans/internal/database/query"
 	"github.com/leighmacdonald/gbans/internal/httphelper"
 	"github.com/leighmacdonald/gbans/internal/network/ip2location"
+	"github.com/leighmacdonald/gbans/internal/network/maxmind"
 	"github.com/leighmacdonald/gbans/pkg/broadcaster"
 	"github.com/leighmacdonald/gbans/pkg/logparse"
 	"github.com/leighmacdonald/steamid/v4/steamid"
@@ -31,6 +32,14 @@ type Config struct {
 	sync.RWMutex
 
 	SDREnabled bool
+	// AltScoreThreshold is the minimum score of a linked account for its ban to apply to a connecting player,
+	// 0 to only match bans by address. Only accounts sharing addresses with the player directly are banned, bans
+	// of accounts linked through another alt are flagged for review.
+	AltScoreThreshold float64
+	GeoSource         GeoSource
+	// MaxMindCityPath and MaxMindASNPath are the paths to the GeoLite2-City.mmdb and GeoLite2-ASN.mmdb files.
+	MaxMindCityPath string
+	MaxMindASNPath  string
 }
 
 // PersonIPRecord holds a composite result of the more relevant ip2location results.
@@ -129,32 +138,44 @@ type Networks struct {
 	geoConf    *ip2location.Config
 	repository Repository
 	eb         *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]
+	maxmind    *maxmind.Reader
 }
 
 func NewNetworks(broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent],
 	repository Repository, config *Config, geoConf *ip2location.Config,
 ) Networks {
-	return Networks{
+	networks := Networks{
 		Config:     config,
 		repository: repository,
 		eb:         broadcaster,
 		geoConf:    geoConf,
 	}
+	networks.maxmind = maxmind.NewReader(networks.maxmindPaths)
+
+	return networks
 }
 
 func (u Networks) Start(ctx context.Context) {
-	serverEventChan := make(chan logparse.ServerEvent)
-	if errRegister := u.eb.Consume(serverEventChan, logparse.Connected); errRegister != nil {
-		slog.Warn("logWriter Tried to register duplicate reader channel", slog.String("error", errRegister.Error()))
+	if errLoad := u.maxmind.Reload(); errLoad != nil {
+		slog.Error("Failed to load maxmind databases", slog.String("error", errLoad.Error()))
+	}
+
+	go u.maxmind.Watch(ctx)
+
+	// Connections are used for ban evasion checks so they are never dropped, the larger queue absorbs slow inserts.
+	consumer, errRegister := u.eb.Subscribe("network", broadcaster.Options{QueueSize: 5000, Policy: broadcaster.Block}, logparse.Connected)
+	if errRegister != nil {
+		slog.Warn("Failed to register network event consumer", slog.String("error", errRegister.Error()))
 
 		return
 	}
+	defer u.eb.Unsubscribe(consumer)
 
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case evt := <-serverEventChan:
+		case evt := <-consumer.C:
 			newServerEvent, ok := evt.Event.(logparse.ConnectedEvt)
 			if !ok {
 				continue
@@ -219,6 +240,8 @@ func (u Networks) RefreshLocationData(ctx context.Context) error {
 		return errUpdate
 	}
 
+	// The location and ASN tables are imported whichever provider is selected. ASN blocks and the connection
+	// history search join against them directly.
 	for _, dbName := range []ip2location.DatabaseFile{ip2location.GeoDatabaseLocationFile4, ip2location.GeoDatabaseASNFile4, ip2location.GeoDatabaseProxyFile} {
 		if err := u.importDatabase(ctx, dbName); err != nil {
 			return err
@@ -269,12 +292,14 @@ func (u Networks) QueryNetwork(ctx context.Context, address netip.Addr) (Details
 		return details, ErrNetworkInvalidIP
 	}
 
-	location, _ := u.repository.GetLocationRecord(ctx, address)
+	provider := u.geoProvider()
+
+	location, _ := provider.Location(ctx, address)
 	if location.CIDR != "" {
 		details.Location = location
 	}
 
-	asn, _ := u.repository.GetASNRecordByIP(ctx, address)
+	asn, _ := provider.ASN(ctx, address)
 	if asn.ASNum > 0 {
 		details.Asn = asn
 	}
@@ -231,6 +231,57 @@ func (r Repository) GetPlayerMostRecentIP(ctx context.Context, steamID steamid.S
 	return addr
 }
 
+// SharedAddresses returns every address used by the player that was also used by another account, along with the
+// total amount of accounts seen on the address. Addresses of known proxies are excluded.
+func (r Repository) SharedAddresses(ctx context.Context, steamID steamid.SteamID) ([]sharedAddress, error) {
+	const query = `
+		WITH source AS (
+			SELECT ip_addr, max(created_on) AS last_seen
+			FROM person_connections
+			WHERE steam_id = $1
+			GROUP BY ip_addr
+		), accounts AS (
+			SELECT c.ip_addr, count(DISTINCT c.steam_id) AS total
+			FROM person_connections c
+			JOIN source s ON s.ip_addr = c.ip_addr
+			GROUP BY c.ip_addr
+		)
+		SELECT c.ip_addr, c.steam_id, (array_agg(c.persona_name ORDER BY c.created_on DESC))[1],
+		       max(c.created_on), s.last_seen, a.total
+		FROM person_connections c
+		JOIN source s ON s.ip_addr = c.ip_addr
+		JOIN accounts a ON a.ip_addr = c.ip_addr
+		WHERE c.steam_id != $1
+		  AND NOT EXISTS (SELECT 1 FROM net_proxy p WHERE p.ip_range >>= c.ip_addr)
+		GROUP BY c.ip_addr, c.steam_id, s.last_seen, a.total`
+
+	rows, errRows := r.Query(ctx, query, steamID.Int64())
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+
+	defer rows.Close()
+
+	var addresses []sharedAddress
+
+	for rows.Next() {
+		var (
+			shared   sharedAddress
+			linkedID int64
+		)
+
+		if errScan := rows.Scan(&shared.Addr, &linkedID, &shared.PersonaName, &shared.LastSeen,
+			&shared.SourceLastSeen, &shared.Accounts); errScan != nil {
+			return nil, database.Err(errScan)
+		}
+
+		shared.SteamID = steamid.New(linkedID)
+		addresses = append(addresses, shared)
+	}
+
+	return addresses, rows.Err()
+}
+
 func (r Repository) GetASNRecordsByNum(ctx context.Context, asNum int64) ([]ASN, error) {
 	query := r.Builder().
 		Select("cidr::text", "as_num", "as_name").
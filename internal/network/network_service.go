@@ -16,7 +16,9 @@ import (
 	v1 "github.com/leighmacdonald/gbans/internal/network/v1"
 	"github.com/leighmacdonald/gbans/internal/network/v1/networkv1connect"
 	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Service struct {
@@ -32,6 +34,7 @@ func NewNetworkService(networks Networks, authMiddleware *rpc.Middleware, option
 	authMiddleware.UserRoute(networkv1connect.NetworkServiceQueryConnectionsProcedure, rpc.WithMinPermissions(permission.Moderator))
 	authMiddleware.UserRoute(networkv1connect.NetworkServiceQueryNetworkProcedure, rpc.WithMinPermissions(permission.Moderator))
 	authMiddleware.UserRoute(networkv1connect.NetworkServiceUpdateDBProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(networkv1connect.NetworkServiceAltAccountsProcedure, rpc.WithMinPermissions(permission.Moderator))
 
 	return rpc.Service{Pattern: pattern, Handler: handler}
 }
@@ -64,6 +67,36 @@ func (s *Service) QueryConnections(ctx context.Context, req *v1.QueryConnections
 	return &resp, nil
 }
 
+func (s *Service) AltAccounts(ctx context.Context, req *v1.AltAccountsRequest) (*v1.AltAccountsResponse, error) {
+	steamID := steamid.New(req.GetSteamId())
+	if !steamID.Valid() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+	}
+
+	alts, errAlts := s.networks.AltAccounts(ctx, steamID)
+	if errAlts != nil {
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	resp := v1.AltAccountsResponse{Accounts: make([]*v1.AltAccount, len(alts))}
+	for idx, alt := range alts {
+		resp.Accounts[idx] = &v1.AltAccount{
+			SteamId:         new(alt.SteamID.Int64()),
+			PersonaName:     &alt.PersonaName,
+			Score:           &alt.Score,
+			SharedAddresses: new(int32(alt.SharedAddresses)), //nolint:gosec
+			LastShared:      timestamppb.New(alt.LastShared),
+			Depth:           new(int32(alt.Depth)), //nolint:gosec
+		}
+
+		if alt.LinkedVia.Valid() {
+			resp.Accounts[idx].LinkedVia = new(alt.LinkedVia.Int64())
+		}
+	}
+
+	return &resp, nil
+}
+
 func (s *Service) QueryNetwork(ctx context.Context, req *v1.QueryNetworkRequest) (*v1.QueryNetworkResponse, error) {
 	addr, errAddr := netip.ParseAddr(req.GetIp())
 	if errAddr != nil {
@@ -0,0 +1,402 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: network/v1/asn.proto
+
+package networkv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ASNBlock struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	AsNum *int64                 `protobuf:"varint,1,opt,name=as_num,json=asNum" json:"as_num,omitempty"`
+	// Reason is shown to players who are denied a connection.
+	Reason *string `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+	// Notes are only visible to moderators.
+	Notes         *string                `protobuf:"bytes,3,opt,name=notes" json:"notes,omitempty"`
+	AuthorId      *int64                 `protobuf:"varint,4,opt,name=author_id,json=authorId" json:"author_id,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	UpdatedOn     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_on,json=updatedOn" json:"updated_on,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ASNBlock) Reset() {
+	*x = ASNBlock{}
+	mi := &file_network_v1_asn_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ASNBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ASNBlock) ProtoMessage() {}
+
+func (x *ASNBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_asn_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ASNBlock.ProtoReflect.Descriptor instead.
+func (*ASNBlock) Descriptor() ([]byte, []int) {
+	return file_network_v1_asn_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ASNBlock) GetAsNum() int64 {
+	if x != nil && x.AsNum != nil {
+		return *x.AsNum
+	}
+	return 0
+}
+
+func (x *ASNBlock) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ASNBlock) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *ASNBlock) GetAuthorId() int64 {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return 0
+}
+
+func (x *ASNBlock) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *ASNBlock) GetUpdatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedOn
+	}
+	return nil
+}
+
+type ASNBlocksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocks        []*ASNBlock            `protobuf:"bytes,1,rep,name=blocks" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ASNBlocksResponse) Reset() {
+	*x = ASNBlocksResponse{}
+	mi := &file_network_v1_asn_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ASNBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ASNBlocksResponse) ProtoMessage() {}
+
+func (x *ASNBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_asn_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ASNBlocksResponse.ProtoReflect.Descriptor instead.
+func (*ASNBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_asn_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ASNBlocksResponse) GetBlocks() []*ASNBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type ASNBlockSaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AsNum         *int64                 `protobuf:"varint,1,opt,name=as_num,json=asNum" json:"as_num,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason" json:"reason,omitempty"`
+	Notes         *string                `protobuf:"bytes,3,opt,name=notes" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ASNBlockSaveRequest) Reset() {
+	*x = ASNBlockSaveRequest{}
+	mi := &file_network_v1_asn_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ASNBlockSaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ASNBlockSaveRequest) ProtoMessage() {}
+
+func (x *ASNBlockSaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_asn_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ASNBlockSaveRequest.ProtoReflect.Descriptor instead.
+func (*ASNBlockSaveRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_asn_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ASNBlockSaveRequest) GetAsNum() int64 {
+	if x != nil && x.AsNum != nil {
+		return *x.AsNum
+	}
+	return 0
+}
+
+func (x *ASNBlockSaveRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ASNBlockSaveRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+type ASNBlockSaveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Block         *ASNBlock              `protobuf:"bytes,1,opt,name=block" json:"block,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ASNBlockSaveResponse) Reset() {
+	*x = ASNBlockSaveResponse{}
+	mi := &file_network_v1_asn_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ASNBlockSaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ASNBlockSaveResponse) ProtoMessage() {}
+
+func (x *ASNBlockSaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_asn_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ASNBlockSaveResponse.ProtoReflect.Descriptor instead.
+func (*ASNBlockSaveResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_asn_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ASNBlockSaveResponse) GetBlock() *ASNBlock {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type ASNBlockDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AsNum         *int64                 `protobuf:"varint,1,opt,name=as_num,json=asNum" json:"as_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ASNBlockDeleteRequest) Reset() {
+	*x = ASNBlockDeleteRequest{}
+	mi := &file_network_v1_asn_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ASNBlockDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ASNBlockDeleteRequest) ProtoMessage() {}
+
+func (x *ASNBlockDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_asn_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ASNBlockDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ASNBlockDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_asn_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ASNBlockDeleteRequest) GetAsNum() int64 {
+	if x != nil && x.AsNum != nil {
+		return *x.AsNum
+	}
+	return 0
+}
+
+var File_network_v1_asn_proto protoreflect.FileDescriptor
+
+const file_network_v1_asn_proto_rawDesc = "" +
+	"\n" +
+	"\x14network/v1/asn.proto\x12\n" +
+	"network.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x02\n" +
+	"\bASNBlock\x12#\n" +
+	"\x06as_num\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\x05asNum\x12\x1e\n" +
+	"\x06reason\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06reason\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x1f\n" +
+	"\tauthor_id\x18\x04 \x01(\x03B\x020\x01R\bauthorId\x12A\n" +
+	"\n" +
+	"created_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn\x12A\n" +
+	"\n" +
+	"updated_on\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedOn\"I\n" +
+	"\x11ASNBlocksResponse\x124\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x14.network.v1.ASNBlockB\x06\xbaH\x03\xc8\x01\x01R\x06blocks\"t\n" +
+	"\x13ASNBlockSaveRequest\x12#\n" +
+	"\x06as_num\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\x05asNum\x12\"\n" +
+	"\x06reason\x18\x02 \x01(\tB\n" +
+	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\x06reason\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"J\n" +
+	"\x14ASNBlockSaveResponse\x122\n" +
+	"\x05block\x18\x01 \x01(\v2\x14.network.v1.ASNBlockB\x06\xbaH\x03\xc8\x01\x01R\x05block\"<\n" +
+	"\x15ASNBlockDeleteRequest\x12#\n" +
+	"\x06as_num\x18\x01 \x01(\x03B\f\xbaH\a\xc8\x01\x01\"\x02 \x000\x01R\x05asNum2\xf6\x01\n" +
+	"\n" +
+	"ASNService\x12D\n" +
+	"\tASNBlocks\x12\x16.google.protobuf.Empty\x1a\x1d.network.v1.ASNBlocksResponse\"\x00\x12S\n" +
+	"\fASNBlockSave\x12\x1f.network.v1.ASNBlockSaveRequest\x1a .network.v1.ASNBlockSaveResponse\"\x00\x12M\n" +
+	"\x0eASNBlockDelete\x12!.network.v1.ASNBlockDeleteRequest\x1a\x16.google.protobuf.Empty\"\x00B\xa2\x01\n" +
+	"\x0ecom.network.v1B\bAsnProtoP\x01Z=github.com/leighmacdonald/gbans/internal/network/v1;networkv1\xa2\x02\x03NXX\xaa\x02\n" +
+	"Network.V1\xca\x02\n" +
+	"Network\\V1\xe2\x02\x16Network\\V1\\GPBMetadata\xea\x02\vNetwork::V1b\beditionsp\xe8\a"
+
+var (
+	file_network_v1_asn_proto_rawDescOnce sync.Once
+	file_network_v1_asn_proto_rawDescData []byte
+)
+
+func file_network_v1_asn_proto_rawDescGZIP() []byte {
+	file_network_v1_asn_proto_rawDescOnce.Do(func() {
+		file_network_v1_asn_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_network_v1_asn_proto_rawDesc), len(file_network_v1_asn_proto_rawDesc)))
+	})
+	return file_network_v1_asn_proto_rawDescData
+}
+
+var file_network_v1_asn_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_network_v1_asn_proto_goTypes = []any{
+	(*ASNBlock)(nil),              // 0: network.v1.ASNBlock
+	(*ASNBlocksResponse)(nil),     // 1: network.v1.ASNBlocksResponse
+	(*ASNBlockSaveRequest)(nil),   // 2: network.v1.ASNBlockSaveRequest
+	(*ASNBlockSaveResponse)(nil),  // 3: network.v1.ASNBlockSaveResponse
+	(*ASNBlockDeleteRequest)(nil), // 4: network.v1.ASNBlockDeleteRequest
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 6: google.protobuf.Empty
+}
+var file_network_v1_asn_proto_depIdxs = []int32{
+	5, // 0: network.v1.ASNBlock.created_on:type_name -> google.protobuf.Timestamp
+	5, // 1: network.v1.ASNBlock.updated_on:type_name -> google.protobuf.Timestamp
+	0, // 2: network.v1.ASNBlocksResponse.blocks:type_name -> network.v1.ASNBlock
+	0, // 3: network.v1.ASNBlockSaveResponse.block:type_name -> network.v1.ASNBlock
+	6, // 4: network.v1.ASNService.ASNBlocks:input_type -> google.protobuf.Empty
+	2, // 5: network.v1.ASNService.ASNBlockSave:input_type -> network.v1.ASNBlockSaveRequest
+	4, // 6: network.v1.ASNService.ASNBlockDelete:input_type -> network.v1.ASNBlockDeleteRequest
+	1, // 7: network.v1.ASNService.ASNBlocks:output_type -> network.v1.ASNBlocksResponse
+	3, // 8: network.v1.ASNService.ASNBlockSave:output_type -> network.v1.ASNBlockSaveResponse
+	6, // 9: network.v1.ASNService.ASNBlockDelete:output_type -> google.protobuf.Empty
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_network_v1_asn_proto_init() }
+func file_network_v1_asn_proto_init() {
+	if File_network_v1_asn_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_network_v1_asn_proto_rawDesc), len(file_network_v1_asn_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_network_v1_asn_proto_goTypes,
+		DependencyIndexes: file_network_v1_asn_proto_depIdxs,
+		MessageInfos:      file_network_v1_asn_proto_msgTypes,
+	}.Build()
+	File_network_v1_asn_proto = out.File
+	file_network_v1_asn_proto_goTypes = nil
+	file_network_v1_asn_proto_depIdxs = nil
+}
@@ -205,6 +205,189 @@ func (ProxyType) EnumDescriptor() ([]byte, []int) {
 	return file_network_v1_network_proto_rawDescGZIP(), []int{2}
 }
 
+type AltAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SteamId       *int64                 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AltAccountsRequest) Reset() {
+	*x = AltAccountsRequest{}
+	mi := &file_network_v1_network_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AltAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AltAccountsRequest) ProtoMessage() {}
+
+func (x *AltAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_network_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AltAccountsRequest.ProtoReflect.Descriptor instead.
+func (*AltAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_network_v1_network_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AltAccountsRequest) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+type AltAccount struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SteamId     *int64                 `protobuf:"varint,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
+	PersonaName *string                `protobuf:"bytes,2,opt,name=persona_name,json=personaName" json:"persona_name,omitempty"`
+	// Likelihood between 0 and 1 of the account being an alt.
+	Score           *float64               `protobuf:"fixed64,3,opt,name=score" json:"score,omitempty"`
+	SharedAddresses *int32                 `protobuf:"varint,4,opt,name=shared_addresses,json=sharedAddresses" json:"shared_addresses,omitempty"`
+	LastShared      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_shared,json=lastShared" json:"last_shared,omitempty"`
+	// 1 for accounts sharing addresses directly with the player, 2 when linked through another alt.
+	Depth *int32 `protobuf:"varint,6,opt,name=depth" json:"depth,omitempty"`
+	// The account that linked this one when depth > 1.
+	LinkedVia     *int64 `protobuf:"varint,7,opt,name=linked_via,json=linkedVia" json:"linked_via,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AltAccount) Reset() {
+	*x = AltAccount{}
+	mi := &file_network_v1_network_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AltAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AltAccount) ProtoMessage() {}
+
+func (x *AltAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_network_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AltAccount.ProtoReflect.Descriptor instead.
+func (*AltAccount) Descriptor() ([]byte, []int) {
+	return file_network_v1_network_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AltAccount) GetSteamId() int64 {
+	if x != nil && x.SteamId != nil {
+		return *x.SteamId
+	}
+	return 0
+}
+
+func (x *AltAccount) GetPersonaName() string {
+	if x != nil && x.PersonaName != nil {
+		return *x.PersonaName
+	}
+	return ""
+}
+
+func (x *AltAccount) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *AltAccount) GetSharedAddresses() int32 {
+	if x != nil && x.SharedAddresses != nil {
+		return *x.SharedAddresses
+	}
+	return 0
+}
+
+func (x *AltAccount) GetLastShared() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastShared
+	}
+	return nil
+}
+
+func (x *AltAccount) GetDepth() int32 {
+	if x != nil && x.Depth != nil {
+		return *x.Depth
+	}
+	return 0
+}
+
+func (x *AltAccount) GetLinkedVia() int64 {
+	if x != nil && x.LinkedVia != nil {
+		return *x.LinkedVia
+	}
+	return 0
+}
+
+type AltAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*AltAccount          `protobuf:"bytes,1,rep,name=accounts" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AltAccountsResponse) Reset() {
+	*x = AltAccountsResponse{}
+	mi := &file_network_v1_network_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AltAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AltAccountsResponse) ProtoMessage() {}
+
+func (x *AltAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_network_v1_network_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AltAccountsResponse.ProtoReflect.Descriptor instead.
+func (*AltAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_network_v1_network_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AltAccountsResponse) GetAccounts() []*AltAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
 type QueryNetworkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filter        *v1.Filter             `protobuf:"bytes,1,opt,name=filter" json:"filter,omitempty"`
@@ -215,7 +398,7 @@ type QueryNetworkRequest struct {
 
 func (x *QueryNetworkRequest) Reset() {
 	*x = QueryNetworkRequest{}
-	mi := &file_network_v1_network_proto_msgTypes[0]
+	mi := &file_network_v1_network_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -227,7 +410,7 @@ func (x *QueryNetworkRequest) String() string {
 func (*QueryNetworkRequest) ProtoMessage() {}
 
 func (x *QueryNetworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[0]
+	mi := &file_network_v1_network_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +423,7 @@ func (x *QueryNetworkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNetworkRequest.ProtoReflect.Descriptor instead.
 func (*QueryNetworkRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{0}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *QueryNetworkRequest) GetFilter() *v1.Filter {
@@ -268,7 +451,7 @@ type Details struct {
 
 func (x *Details) Reset() {
 	*x = Details{}
-	mi := &file_network_v1_network_proto_msgTypes[1]
+	mi := &file_network_v1_network_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -280,7 +463,7 @@ func (x *Details) String() string {
 func (*Details) ProtoMessage() {}
 
 func (x *Details) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[1]
+	mi := &file_network_v1_network_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +476,7 @@ func (x *Details) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Details.ProtoReflect.Descriptor instead.
 func (*Details) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{1}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Details) GetLocation() *Location {
@@ -331,7 +514,7 @@ type Location struct {
 
 func (x *Location) Reset() {
 	*x = Location{}
-	mi := &file_network_v1_network_proto_msgTypes[2]
+	mi := &file_network_v1_network_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +526,7 @@ func (x *Location) String() string {
 func (*Location) ProtoMessage() {}
 
 func (x *Location) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[2]
+	mi := &file_network_v1_network_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +539,7 @@ func (x *Location) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Location.ProtoReflect.Descriptor instead.
 func (*Location) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{2}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Location) GetCidr() string {
@@ -412,7 +595,7 @@ type ASN struct {
 
 func (x *ASN) Reset() {
 	*x = ASN{}
-	mi := &file_network_v1_network_proto_msgTypes[3]
+	mi := &file_network_v1_network_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -424,7 +607,7 @@ func (x *ASN) String() string {
 func (*ASN) ProtoMessage() {}
 
 func (x *ASN) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[3]
+	mi := &file_network_v1_network_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +620,7 @@ func (x *ASN) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ASN.ProtoReflect.Descriptor instead.
 func (*ASN) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{3}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ASN) GetCidr() string {
@@ -482,7 +665,7 @@ type Proxy struct {
 
 func (x *Proxy) Reset() {
 	*x = Proxy{}
-	mi := &file_network_v1_network_proto_msgTypes[4]
+	mi := &file_network_v1_network_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -494,7 +677,7 @@ func (x *Proxy) String() string {
 func (*Proxy) ProtoMessage() {}
 
 func (x *Proxy) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[4]
+	mi := &file_network_v1_network_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -507,7 +690,7 @@ func (x *Proxy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Proxy.ProtoReflect.Descriptor instead.
 func (*Proxy) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{4}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Proxy) GetCidr() string {
@@ -611,7 +794,7 @@ type LatLong struct {
 
 func (x *LatLong) Reset() {
 	*x = LatLong{}
-	mi := &file_network_v1_network_proto_msgTypes[5]
+	mi := &file_network_v1_network_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -623,7 +806,7 @@ func (x *LatLong) String() string {
 func (*LatLong) ProtoMessage() {}
 
 func (x *LatLong) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[5]
+	mi := &file_network_v1_network_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +819,7 @@ func (x *LatLong) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LatLong.ProtoReflect.Descriptor instead.
 func (*LatLong) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{5}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *LatLong) GetLatitude() float32 {
@@ -662,7 +845,7 @@ type QueryNetworkResponse struct {
 
 func (x *QueryNetworkResponse) Reset() {
 	*x = QueryNetworkResponse{}
-	mi := &file_network_v1_network_proto_msgTypes[6]
+	mi := &file_network_v1_network_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -674,7 +857,7 @@ func (x *QueryNetworkResponse) String() string {
 func (*QueryNetworkResponse) ProtoMessage() {}
 
 func (x *QueryNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[6]
+	mi := &file_network_v1_network_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,7 +870,7 @@ func (x *QueryNetworkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNetworkResponse.ProtoReflect.Descriptor instead.
 func (*QueryNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{6}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *QueryNetworkResponse) GetDetails() *Details {
@@ -712,7 +895,7 @@ type QueryConnectionsRequest struct {
 
 func (x *QueryConnectionsRequest) Reset() {
 	*x = QueryConnectionsRequest{}
-	mi := &file_network_v1_network_proto_msgTypes[7]
+	mi := &file_network_v1_network_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -724,7 +907,7 @@ func (x *QueryConnectionsRequest) String() string {
 func (*QueryConnectionsRequest) ProtoMessage() {}
 
 func (x *QueryConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[7]
+	mi := &file_network_v1_network_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +920,7 @@ func (x *QueryConnectionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryConnectionsRequest.ProtoReflect.Descriptor instead.
 func (*QueryConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{7}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *QueryConnectionsRequest) GetFilter() *v1.Filter {
@@ -798,7 +981,7 @@ type QueryConnectionsResponse struct {
 
 func (x *QueryConnectionsResponse) Reset() {
 	*x = QueryConnectionsResponse{}
-	mi := &file_network_v1_network_proto_msgTypes[8]
+	mi := &file_network_v1_network_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -810,7 +993,7 @@ func (x *QueryConnectionsResponse) String() string {
 func (*QueryConnectionsResponse) ProtoMessage() {}
 
 func (x *QueryConnectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[8]
+	mi := &file_network_v1_network_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1006,7 @@ func (x *QueryConnectionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryConnectionsResponse.ProtoReflect.Descriptor instead.
 func (*QueryConnectionsResponse) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{8}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QueryConnectionsResponse) GetConnection() []*PersonConnection {
@@ -854,7 +1037,7 @@ type PersonConnection struct {
 
 func (x *PersonConnection) Reset() {
 	*x = PersonConnection{}
-	mi := &file_network_v1_network_proto_msgTypes[9]
+	mi := &file_network_v1_network_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -866,7 +1049,7 @@ func (x *PersonConnection) String() string {
 func (*PersonConnection) ProtoMessage() {}
 
 func (x *PersonConnection) ProtoReflect() protoreflect.Message {
-	mi := &file_network_v1_network_proto_msgTypes[9]
+	mi := &file_network_v1_network_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +1062,7 @@ func (x *PersonConnection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PersonConnection.ProtoReflect.Descriptor instead.
 func (*PersonConnection) Descriptor() ([]byte, []int) {
-	return file_network_v1_network_proto_rawDescGZIP(), []int{9}
+	return file_network_v1_network_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PersonConnection) GetPersonConnectionId() int64 {
@@ -978,7 +1161,22 @@ var File_network_v1_network_proto protoreflect.FileDescriptor
 const file_network_v1_network_proto_rawDesc = "" +
 	"\n" +
 	"\x18network/v1/network.proto\x12\n" +
-	"network.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1edatabase/query/v1/filter.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"X\n" +
+	"network.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1edatabase/query/v1/filter.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"9\n" +
+	"\x12AltAccountsRequest\x12#\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\b\xbaH\x03\xc8\x01\x010\x01R\asteamId\"\x85\x02\n" +
+	"\n" +
+	"AltAccount\x12\x1d\n" +
+	"\bsteam_id\x18\x01 \x01(\x03B\x020\x01R\asteamId\x12!\n" +
+	"\fpersona_name\x18\x02 \x01(\tR\vpersonaName\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x01R\x05score\x12)\n" +
+	"\x10shared_addresses\x18\x04 \x01(\x05R\x0fsharedAddresses\x12;\n" +
+	"\vlast_shared\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastShared\x12\x14\n" +
+	"\x05depth\x18\x06 \x01(\x05R\x05depth\x12!\n" +
+	"\n" +
+	"linked_via\x18\a \x01(\x03B\x020\x01R\tlinkedVia\"I\n" +
+	"\x13AltAccountsResponse\x122\n" +
+	"\baccounts\x18\x01 \x03(\v2\x16.network.v1.AltAccountR\baccounts\"X\n" +
 	"\x13QueryNetworkRequest\x121\n" +
 	"\x06filter\x18\x01 \x01(\v2\x19.database.query.v1.FilterR\x06filter\x12\x0e\n" +
 	"\x02ip\x18\x02 \x01(\tR\x02ip\"\x9f\x01\n" +
@@ -1082,11 +1280,12 @@ const file_network_v1_network_proto_rawDesc = "" +
 	"\x12\x18\n" +
 	"\x14USAGE_TYPE_EDUCATION\x10\v*+\n" +
 	"\tProxyType\x12\x1e\n" +
-	"\x1aPROXY_TYPE_PUB_UNSPECIFIED\x10\x002\x84\x02\n" +
+	"\x1aPROXY_TYPE_PUB_UNSPECIFIED\x10\x002\xd6\x02\n" +
 	"\x0eNetworkService\x12_\n" +
 	"\x10QueryConnections\x12#.network.v1.QueryConnectionsRequest\x1a$.network.v1.QueryConnectionsResponse\"\x00\x12S\n" +
 	"\fQueryNetwork\x12\x1f.network.v1.QueryNetworkRequest\x1a .network.v1.QueryNetworkResponse\"\x00\x12<\n" +
-	"\bUpdateDB\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00B\xa6\x01\n" +
+	"\bUpdateDB\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x00\x12P\n" +
+	"\vAltAccounts\x12\x1e.network.v1.AltAccountsRequest\x1a\x1f.network.v1.AltAccountsResponse\"\x00B\xa6\x01\n" +
 	"\x0ecom.network.v1B\fNetworkProtoP\x01Z=github.com/leighmacdonald/gbans/internal/network/v1;networkv1\xa2\x02\x03NXX\xaa\x02\n" +
 	"Network.V1\xca\x02\n" +
 	"Network\\V1\xe2\x02\x16Network\\V1\\GPBMetadata\xea\x02\vNetwork::V1b\beditionsp\xe8\a"
@@ -1104,51 +1303,58 @@ func file_network_v1_network_proto_rawDescGZIP() []byte {
 }
 
 var file_network_v1_network_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_network_v1_network_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_network_v1_network_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_network_v1_network_proto_goTypes = []any{
 	(ThreatType)(0),                  // 0: network.v1.ThreatType
 	(UsageType)(0),                   // 1: network.v1.UsageType
 	(ProxyType)(0),                   // 2: network.v1.ProxyType
-	(*QueryNetworkRequest)(nil),      // 3: network.v1.QueryNetworkRequest
-	(*Details)(nil),                  // 4: network.v1.Details
-	(*Location)(nil),                 // 5: network.v1.Location
-	(*ASN)(nil),                      // 6: network.v1.ASN
-	(*Proxy)(nil),                    // 7: network.v1.Proxy
-	(*LatLong)(nil),                  // 8: network.v1.LatLong
-	(*QueryNetworkResponse)(nil),     // 9: network.v1.QueryNetworkResponse
-	(*QueryConnectionsRequest)(nil),  // 10: network.v1.QueryConnectionsRequest
-	(*QueryConnectionsResponse)(nil), // 11: network.v1.QueryConnectionsResponse
-	(*PersonConnection)(nil),         // 12: network.v1.PersonConnection
-	(*v1.Filter)(nil),                // 13: database.query.v1.Filter
-	(*timestamppb.Timestamp)(nil),    // 14: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),            // 15: google.protobuf.Empty
+	(*AltAccountsRequest)(nil),       // 3: network.v1.AltAccountsRequest
+	(*AltAccount)(nil),               // 4: network.v1.AltAccount
+	(*AltAccountsResponse)(nil),      // 5: network.v1.AltAccountsResponse
+	(*QueryNetworkRequest)(nil),      // 6: network.v1.QueryNetworkRequest
+	(*Details)(nil),                  // 7: network.v1.Details
+	(*Location)(nil),                 // 8: network.v1.Location
+	(*ASN)(nil),                      // 9: network.v1.ASN
+	(*Proxy)(nil),                    // 10: network.v1.Proxy
+	(*LatLong)(nil),                  // 11: network.v1.LatLong
+	(*QueryNetworkResponse)(nil),     // 12: network.v1.QueryNetworkResponse
+	(*QueryConnectionsRequest)(nil),  // 13: network.v1.QueryConnectionsRequest
+	(*QueryConnectionsResponse)(nil), // 14: network.v1.QueryConnectionsResponse
+	(*PersonConnection)(nil),         // 15: network.v1.PersonConnection
+	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
+	(*v1.Filter)(nil),                // 17: database.query.v1.Filter
+	(*emptypb.Empty)(nil),            // 18: google.protobuf.Empty
 }
 var file_network_v1_network_proto_depIdxs = []int32{
-	13, // 0: network.v1.QueryNetworkRequest.filter:type_name -> database.query.v1.Filter
-	5,  // 1: network.v1.Details.location:type_name -> network.v1.Location
-	6,  // 2: network.v1.Details.asn:type_name -> network.v1.ASN
-	7,  // 3: network.v1.Details.proxy:type_name -> network.v1.Proxy
-	8,  // 4: network.v1.Location.lat_long:type_name -> network.v1.LatLong
-	2,  // 5: network.v1.Proxy.proxy_type:type_name -> network.v1.ProxyType
-	1,  // 6: network.v1.Proxy.usage_type:type_name -> network.v1.UsageType
-	14, // 7: network.v1.Proxy.last_seen:type_name -> google.protobuf.Timestamp
-	0,  // 8: network.v1.Proxy.threat_type:type_name -> network.v1.ThreatType
-	4,  // 9: network.v1.QueryNetworkResponse.details:type_name -> network.v1.Details
-	13, // 10: network.v1.QueryConnectionsRequest.filter:type_name -> database.query.v1.Filter
-	12, // 11: network.v1.QueryConnectionsResponse.connection:type_name -> network.v1.PersonConnection
-	8,  // 12: network.v1.PersonConnection.location:type_name -> network.v1.LatLong
-	14, // 13: network.v1.PersonConnection.created_on:type_name -> google.protobuf.Timestamp
-	10, // 14: network.v1.NetworkService.QueryConnections:input_type -> network.v1.QueryConnectionsRequest
-	3,  // 15: network.v1.NetworkService.QueryNetwork:input_type -> network.v1.QueryNetworkRequest
-	15, // 16: network.v1.NetworkService.UpdateDB:input_type -> google.protobuf.Empty
-	11, // 17: network.v1.NetworkService.QueryConnections:output_type -> network.v1.QueryConnectionsResponse
-	9,  // 18: network.v1.NetworkService.QueryNetwork:output_type -> network.v1.QueryNetworkResponse
-	15, // 19: network.v1.NetworkService.UpdateDB:output_type -> google.protobuf.Empty
-	17, // [17:20] is the sub-list for method output_type
-	14, // [14:17] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	16, // 0: network.v1.AltAccount.last_shared:type_name -> google.protobuf.Timestamp
+	4,  // 1: network.v1.AltAccountsResponse.accounts:type_name -> network.v1.AltAccount
+	17, // 2: network.v1.QueryNetworkRequest.filter:type_name -> database.query.v1.Filter
+	8,  // 3: network.v1.Details.location:type_name -> network.v1.Location
+	9,  // 4: network.v1.Details.asn:type_name -> network.v1.ASN
+	10, // 5: network.v1.Details.proxy:type_name -> network.v1.Proxy
+	11, // 6: network.v1.Location.lat_long:type_name -> network.v1.LatLong
+	2,  // 7: network.v1.Proxy.proxy_type:type_name -> network.v1.ProxyType
+	1,  // 8: network.v1.Proxy.usage_type:type_name -> network.v1.UsageType
+	16, // 9: network.v1.Proxy.last_seen:type_name -> google.protobuf.Timestamp
+	0,  // 10: network.v1.Proxy.threat_type:type_name -> network.v1.ThreatType
+	7,  // 11: network.v1.QueryNetworkResponse.details:type_name -> network.v1.Details
+	17, // 12: network.v1.QueryConnectionsRequest.filter:type_name -> database.query.v1.Filter
+	15, // 13: network.v1.QueryConnectionsResponse.connection:type_name -> network.v1.PersonConnection
+	11, // 14: network.v1.PersonConnection.location:type_name -> network.v1.LatLong
+	16, // 15: network.v1.PersonConnection.created_on:type_name -> google.protobuf.Timestamp
+	13, // 16: network.v1.NetworkService.QueryConnections:input_type -> network.v1.QueryConnectionsRequest
+	6,  // 17: network.v1.NetworkService.QueryNetwork:input_type -> network.v1.QueryNetworkRequest
+	18, // 18: network.v1.NetworkService.UpdateDB:input_type -> google.protobuf.Empty
+	3,  // 19: network.v1.NetworkService.AltAccounts:input_type -> network.v1.AltAccountsRequest
+	14, // 20: network.v1.NetworkService.QueryConnections:output_type -> network.v1.QueryConnectionsResponse
+	12, // 21: network.v1.NetworkService.QueryNetwork:output_type -> network.v1.QueryNetworkResponse
+	18, // 22: network.v1.NetworkService.UpdateDB:output_type -> google.protobuf.Empty
+	5,  // 23: network.v1.NetworkService.AltAccounts:output_type -> network.v1.AltAccountsResponse
+	20, // [20:24] is the sub-list for method output_type
+	16, // [16:20] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_network_v1_network_proto_init() }
@@ -1162,7 +1368,7 @@ func file_network_v1_network_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_network_v1_network_proto_rawDesc), len(file_network_v1_network_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   10,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
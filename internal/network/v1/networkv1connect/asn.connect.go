@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: network/v1/asn.proto
+
+package networkv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/leighmacdonald/gbans/internal/network/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ASNServiceName is the fully-qualified name of the ASNService service.
+	ASNServiceName = "network.v1.ASNService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ASNServiceASNBlocksProcedure is the fully-qualified name of the ASNService's ASNBlocks RPC.
+	ASNServiceASNBlocksProcedure = "/network.v1.ASNService/ASNBlocks"
+	// ASNServiceASNBlockSaveProcedure is the fully-qualified name of the ASNService's ASNBlockSave RPC.
+	ASNServiceASNBlockSaveProcedure = "/network.v1.ASNService/ASNBlockSave"
+	// ASNServiceASNBlockDeleteProcedure is the fully-qualified name of the ASNService's ASNBlockDelete
+	// RPC.
+	ASNServiceASNBlockDeleteProcedure = "/network.v1.ASNService/ASNBlockDelete"
+)
+
+// ASNServiceClient is a client for the network.v1.ASNService service.
+type ASNServiceClient interface {
+	ASNBlocks(context.Context, *emptypb.Empty) (*v1.ASNBlocksResponse, error)
+	ASNBlockSave(context.Context, *v1.ASNBlockSaveRequest) (*v1.ASNBlockSaveResponse, error)
+	ASNBlockDelete(context.Context, *v1.ASNBlockDeleteRequest) (*emptypb.Empty, error)
+}
+
+// NewASNServiceClient constructs a client for the network.v1.ASNService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewASNServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ASNServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	aSNServiceMethods := v1.File_network_v1_asn_proto.Services().ByName("ASNService").Methods()
+	return &aSNServiceClient{
+		aSNBlocks: connect.NewClient[emptypb.Empty, v1.ASNBlocksResponse](
+			httpClient,
+			baseURL+ASNServiceASNBlocksProcedure,
+			connect.WithSchema(aSNServiceMethods.ByName("ASNBlocks")),
+			connect.WithClientOptions(opts...),
+		),
+		aSNBlockSave: connect.NewClient[v1.ASNBlockSaveRequest, v1.ASNBlockSaveResponse](
+			httpClient,
+			baseURL+ASNServiceASNBlockSaveProcedure,
+			connect.WithSchema(aSNServiceMethods.ByName("ASNBlockSave")),
+			connect.WithClientOptions(opts...),
+		),
+		aSNBlockDelete: connect.NewClient[v1.ASNBlockDeleteRequest, emptypb.Empty](
+			httpClient,
+			baseURL+ASNServiceASNBlockDeleteProcedure,
+			connect.WithSchema(aSNServiceMethods.ByName("ASNBlockDelete")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// aSNServiceClient implements ASNServiceClient.
+type aSNServiceClient struct {
+	aSNBlocks      *connect.Client[emptypb.Empty, v1.ASNBlocksResponse]
+	aSNBlockSave   *connect.Client[v1.ASNBlockSaveRequest, v1.ASNBlockSaveResponse]
+	aSNBlockDelete *connect.Client[v1.ASNBlockDeleteRequest, emptypb.Empty]
+}
+
+// ASNBlocks calls network.v1.ASNService.ASNBlocks.
+func (c *aSNServiceClient) ASNBlocks(ctx context.Context, req *emptypb.Empty) (*v1.ASNBlocksResponse, error) {
+	response, err := c.aSNBlocks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ASNBlockSave calls network.v1.ASNService.ASNBlockSave.
+func (c *aSNServiceClient) ASNBlockSave(ctx context.Context, req *v1.ASNBlockSaveRequest) (*v1.ASNBlockSaveResponse, error) {
+	response, err := c.aSNBlockSave.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ASNBlockDelete calls network.v1.ASNService.ASNBlockDelete.
+func (c *aSNServiceClient) ASNBlockDelete(ctx context.Context, req *v1.ASNBlockDeleteRequest) (*emptypb.Empty, error) {
+	response, err := c.aSNBlockDelete.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ASNServiceHandler is an implementation of the network.v1.ASNService service.
+type ASNServiceHandler interface {
+	ASNBlocks(context.Context, *emptypb.Empty) (*v1.ASNBlocksResponse, error)
+	ASNBlockSave(context.Context, *v1.ASNBlockSaveRequest) (*v1.ASNBlockSaveResponse, error)
+	ASNBlockDelete(context.Context, *v1.ASNBlockDeleteRequest) (*emptypb.Empty, error)
+}
+
+// NewASNServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewASNServiceHandler(svc ASNServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	aSNServiceMethods := v1.File_network_v1_asn_proto.Services().ByName("ASNService").Methods()
+	aSNServiceASNBlocksHandler := connect.NewUnaryHandlerSimple(
+		ASNServiceASNBlocksProcedure,
+		svc.ASNBlocks,
+		connect.WithSchema(aSNServiceMethods.ByName("ASNBlocks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aSNServiceASNBlockSaveHandler := connect.NewUnaryHandlerSimple(
+		ASNServiceASNBlockSaveProcedure,
+		svc.ASNBlockSave,
+		connect.WithSchema(aSNServiceMethods.ByName("ASNBlockSave")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aSNServiceASNBlockDeleteHandler := connect.NewUnaryHandlerSimple(
+		ASNServiceASNBlockDeleteProcedure,
+		svc.ASNBlockDelete,
+		connect.WithSchema(aSNServiceMethods.ByName("ASNBlockDelete")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/network.v1.ASNService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ASNServiceASNBlocksProcedure:
+			aSNServiceASNBlocksHandler.ServeHTTP(w, r)
+		case ASNServiceASNBlockSaveProcedure:
+			aSNServiceASNBlockSaveHandler.ServeHTTP(w, r)
+		case ASNServiceASNBlockDeleteProcedure:
+			aSNServiceASNBlockDeleteHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedASNServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedASNServiceHandler struct{}
+
+func (UnimplementedASNServiceHandler) ASNBlocks(context.Context, *emptypb.Empty) (*v1.ASNBlocksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("network.v1.ASNService.ASNBlocks is not implemented"))
+}
+
+func (UnimplementedASNServiceHandler) ASNBlockSave(context.Context, *v1.ASNBlockSaveRequest) (*v1.ASNBlockSaveResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("network.v1.ASNService.ASNBlockSave is not implemented"))
+}
+
+func (UnimplementedASNServiceHandler) ASNBlockDelete(context.Context, *v1.ASNBlockDeleteRequest) (*emptypb.Empty, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("network.v1.ASNService.ASNBlockDelete is not implemented"))
+}
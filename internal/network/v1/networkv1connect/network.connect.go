@@ -42,6 +42,9 @@ const (
 	NetworkServiceQueryNetworkProcedure = "/network.v1.NetworkService/QueryNetwork"
 	// NetworkServiceUpdateDBProcedure is the fully-qualified name of the NetworkService's UpdateDB RPC.
 	NetworkServiceUpdateDBProcedure = "/network.v1.NetworkService/UpdateDB"
+	// NetworkServiceAltAccountsProcedure is the fully-qualified name of the NetworkService's
+	// AltAccounts RPC.
+	NetworkServiceAltAccountsProcedure = "/network.v1.NetworkService/AltAccounts"
 )
 
 // NetworkServiceClient is a client for the network.v1.NetworkService service.
@@ -49,6 +52,8 @@ type NetworkServiceClient interface {
 	QueryConnections(context.Context, *v1.QueryConnectionsRequest) (*v1.QueryConnectionsResponse, error)
 	QueryNetwork(context.Context, *v1.QueryNetworkRequest) (*v1.QueryNetworkResponse, error)
 	UpdateDB(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Accounts likely operated by the same person, based on shared connection addresses.
+	AltAccounts(context.Context, *v1.AltAccountsRequest) (*v1.AltAccountsResponse, error)
 }
 
 // NewNetworkServiceClient constructs a client for the network.v1.NetworkService service. By
@@ -80,6 +85,12 @@ func NewNetworkServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(networkServiceMethods.ByName("UpdateDB")),
 			connect.WithClientOptions(opts...),
 		),
+		altAccounts: connect.NewClient[v1.AltAccountsRequest, v1.AltAccountsResponse](
+			httpClient,
+			baseURL+NetworkServiceAltAccountsProcedure,
+			connect.WithSchema(networkServiceMethods.ByName("AltAccounts")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -88,6 +99,7 @@ type networkServiceClient struct {
 	queryConnections *connect.Client[v1.QueryConnectionsRequest, v1.QueryConnectionsResponse]
 	queryNetwork     *connect.Client[v1.QueryNetworkRequest, v1.QueryNetworkResponse]
 	updateDB         *connect.Client[emptypb.Empty, emptypb.Empty]
+	altAccounts      *connect.Client[v1.AltAccountsRequest, v1.AltAccountsResponse]
 }
 
 // QueryConnections calls network.v1.NetworkService.QueryConnections.
@@ -117,11 +129,22 @@ func (c *networkServiceClient) UpdateDB(ctx context.Context, req *emptypb.Empty)
 	return nil, err
 }
 
+// AltAccounts calls network.v1.NetworkService.AltAccounts.
+func (c *networkServiceClient) AltAccounts(ctx context.Context, req *v1.AltAccountsRequest) (*v1.AltAccountsResponse, error) {
+	response, err := c.altAccounts.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // NetworkServiceHandler is an implementation of the network.v1.NetworkService service.
 type NetworkServiceHandler interface {
 	QueryConnections(context.Context, *v1.QueryConnectionsRequest) (*v1.QueryConnectionsResponse, error)
 	QueryNetwork(context.Context, *v1.QueryNetworkRequest) (*v1.QueryNetworkResponse, error)
 	UpdateDB(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// Accounts likely operated by the same person, based on shared connection addresses.
+	AltAccounts(context.Context, *v1.AltAccountsRequest) (*v1.AltAccountsResponse, error)
 }
 
 // NewNetworkServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -149,6 +172,12 @@ func NewNetworkServiceHandler(svc NetworkServiceHandler, opts ...connect.Handler
 		connect.WithSchema(networkServiceMethods.ByName("UpdateDB")),
 		connect.WithHandlerOptions(opts...),
 	)
+	networkServiceAltAccountsHandler := connect.NewUnaryHandlerSimple(
+		NetworkServiceAltAccountsProcedure,
+		svc.AltAccounts,
+		connect.WithSchema(networkServiceMethods.ByName("AltAccounts")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/network.v1.NetworkService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case NetworkServiceQueryConnectionsProcedure:
@@ -157,6 +186,8 @@ func NewNetworkServiceHandler(svc NetworkServiceHandler, opts ...connect.Handler
 			networkServiceQueryNetworkHandler.ServeHTTP(w, r)
 		case NetworkServiceUpdateDBProcedure:
 			networkServiceUpdateDBHandler.ServeHTTP(w, r)
+		case NetworkServiceAltAccountsProcedure:
+			networkServiceAltAccountsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -177,3 +208,7 @@ func (UnimplementedNetworkServiceHandler) QueryNetwork(context.Context, *v1.Quer
 func (UnimplementedNetworkServiceHandler) UpdateDB(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("network.v1.NetworkService.UpdateDB is not implemented"))
 }
+
+func (UnimplementedNetworkServiceHandler) AltAccounts(context.Context, *v1.AltAccountsRequest) (*v1.AltAccountsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("network.v1.NetworkService.AltAccounts is not implemented"))
+}
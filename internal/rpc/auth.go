@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -27,6 +28,8 @@ const (
 	TokenDuration         = time.Hour * 24 * 31
 	FingerprintCookieName = "fingerprint"
 	JWTCookieName         = "token"
+	// APITokenPrefix starts every personal api token, separating them from JWTs in the authorization header.
+	APITokenPrefix = "gbans_"
 )
 
 var (
@@ -44,6 +47,23 @@ type UserClaimProvider interface {
 	GetName() string
 }
 
+// APITokenValidator resolves the secret of a personal api token into the user it acts as, along with the
+// procedures it is limited to. No procedures means any procedure allowed by the users privilege.
+type APITokenValidator interface {
+	ValidateAPIToken(ctx context.Context, secret string) (UserInfo, []string, error)
+}
+
+// SessionValidator checks that a browser login has not been revoked, returning the id of the session.
+type SessionValidator interface {
+	ValidateSession(ctx context.Context, steamID steamid.SteamID, fingerprint string) (int64, error)
+}
+
+// CapabilityResolver returns the capabilities granted to a user by their roles, including the default roles of
+// their privilege.
+type CapabilityResolver interface {
+	Capabilities(steamID steamid.SteamID, privilege permission.Privilege) []permission.Capability
+}
+
 // UserRouteAuthFn is a function type that determines if a user has permission to access a given RPC procedure.
 type UserRouteAuthFn = func(ctx context.Context, req *http.Request, user UserInfo) bool
 
@@ -57,6 +77,21 @@ func WithServer() ServerRouteAuthFn {
 	}
 }
 
+// WithCapability returns a UserRouteAuthFn that checks if the user has been granted the capability.
+func WithCapability(capability permission.Capability) UserRouteAuthFn {
+	return func(_ context.Context, _ *http.Request, user UserInfo) bool {
+		return user.Can(capability)
+	}
+}
+
+// WithAnyCapability returns a UserRouteAuthFn that checks if the user has been granted at least one of the
+// capabilities. The handler is expected to check which one applies.
+func WithAnyCapability(capabilities ...permission.Capability) UserRouteAuthFn {
+	return func(_ context.Context, _ *http.Request, user UserInfo) bool {
+		return slices.ContainsFunc(capabilities, user.Can)
+	}
+}
+
 // WithMinPermissions returns a UserRouteAuthFn that checks if the user has at least the specified privilege level.
 func WithMinPermissions(permission permission.Privilege) UserRouteAuthFn {
 	return func(_ context.Context, _ *http.Request, user UserInfo) bool {
@@ -91,17 +126,27 @@ type Middleware struct {
 
 	siteName        string
 	cookie          string
+	apiTokens       APITokenValidator
+	sessions        SessionValidator
+	roles           CapabilityResolver
 	userAllowList   map[string]UserRouteAuthFn
 	serverAllowList map[string]ServerRouteAuthFn
 }
 
 // NewMiddleware creates a new authentication middleware for the given site name and cookie secret.
-// The cookie secret is used as the HMAC key for signing and verifying JWT tokens.
-func NewMiddleware(siteName string, cookie string) *Middleware {
+// The cookie secret is used as the HMAC key for signing and verifying JWT tokens. Personal api tokens
+// are rejected when apiTokens is nil, and browser logins are not checked for revocation when sessions is nil.
+// Without roles, users are granted the default capabilities of their privilege.
+func NewMiddleware(siteName string, cookie string, apiTokens APITokenValidator, sessions SessionValidator,
+	roles CapabilityResolver,
+) *Middleware {
 	return &Middleware{
 		RWMutex:         sync.RWMutex{},
 		siteName:        siteName,
 		cookie:          cookie,
+		apiTokens:       apiTokens,
+		sessions:        sessions,
+		roles:           roles,
 		userAllowList:   map[string]UserRouteAuthFn{},
 		serverAllowList: map[string]ServerRouteAuthFn{},
 	}
@@ -193,7 +238,11 @@ func (m *Middleware) authUser(ctx context.Context, req *http.Request, procedure
 		return info, nil
 	}
 
-	claims, errToken := m.userClaimsFromRequest(req)
+	if token, ok := authn.BearerToken(req); ok && strings.HasPrefix(token, APITokenPrefix) {
+		return m.authAPIToken(ctx, req, procedure, token, authFn)
+	}
+
+	claims, fingerprint, errToken := m.userClaimsFromRequest(req)
 	if errToken != nil {
 		return info, errToken
 	}
@@ -203,10 +252,20 @@ func (m *Middleware) authUser(ctx context.Context, req *http.Request, procedure
 		return info, authn.Errorf("invalid authorization")
 	}
 
+	if m.sessions != nil {
+		sessionID, errSession := m.sessions.ValidateSession(ctx, sid, fingerprint)
+		if errSession != nil {
+			return info, authn.Errorf("expired authorization")
+		}
+
+		info.SessionID = sessionID
+	}
+
 	info.SteamID = sid
 	info.Privilege = claims.Privilege
 	info.AvatarHash = claims.AvatarHash
 	info.Name = claims.Name
+	info.Capabilities = m.capabilities(info)
 
 	if !authFn(ctx, req, info) {
 		return info, authn.Errorf("unauthorized")
@@ -215,6 +274,51 @@ func (m *Middleware) authUser(ctx context.Context, req *http.Request, procedure
 	return info, nil
 }
 
+func (m *Middleware) authAPIToken(ctx context.Context, req *http.Request, procedure string, secret string, authFn UserRouteAuthFn) (UserInfo, error) {
+	if m.apiTokens == nil {
+		return UserInfo{}, authn.Errorf("invalid authorization")
+	}
+
+	info, procedures, errToken := m.apiTokens.ValidateAPIToken(ctx, secret)
+	if errToken != nil {
+		return info, authn.Errorf("invalid authorization")
+	}
+
+	// Only the default roles of the capped token privilege apply, roles assigned to the owner would otherwise
+	// grant capabilities above the cap.
+	info.Capabilities = m.capabilities(UserInfo{Privilege: info.Privilege})
+
+	if !procedureInScope(procedures, procedure) || !authFn(ctx, req, info) {
+		return info, authn.Errorf("unauthorized")
+	}
+
+	return info, nil
+}
+
+func (m *Middleware) capabilities(info UserInfo) []permission.Capability {
+	if m.roles == nil {
+		return permission.DefaultCapabilities(info.Privilege)
+	}
+
+	return m.roles.Capabilities(info.SteamID, info.Privilege)
+}
+
+// procedureInScope checks the procedure against the scope of an api token. Entries ending with a slash match
+// every procedure of the service.
+func procedureInScope(procedures []string, procedure string) bool {
+	if len(procedures) == 0 {
+		return true
+	}
+
+	for _, allowed := range procedures {
+		if allowed == procedure || (strings.HasSuffix(allowed, "/") && strings.HasPrefix(procedure, allowed)) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (m *Middleware) serverClaimsFromRequest(req *http.Request) (*serverClaims, error) {
 	token, ok := authn.BearerToken(req)
 	if !ok {
@@ -243,10 +347,10 @@ func (m *Middleware) serverClaimsFromRequest(req *http.Request) (*serverClaims,
 	return &claims, nil
 }
 
-func (m *Middleware) userClaimsFromRequest(req *http.Request) (*userClaims, error) {
+func (m *Middleware) userClaimsFromRequest(req *http.Request) (*userClaims, string, error) {
 	fingerprint, errFP := m.fingerprintFromRequest(req)
 	if errFP != nil {
-		return nil, errFP
+		return nil, "", errFP
 	}
 
 	token, ok := authn.BearerToken(req)
@@ -254,34 +358,34 @@ func (m *Middleware) userClaimsFromRequest(req *http.Request) (*userClaims, erro
 		token = m.jwtTokenFromCookie(req)
 	}
 	if token == "" {
-		return nil, authn.Errorf("invalid authorization")
+		return nil, "", authn.Errorf("invalid authorization")
 	}
 
 	claims := userClaims{}
 	tkn, errParseClaims := jwt.ParseWithClaims(token, &claims, m.makeGetTokenKey())
 	if errParseClaims != nil {
 		if errors.Is(errParseClaims, jwt.ErrSignatureInvalid) {
-			return nil, authn.Errorf("invalid authorization")
+			return nil, "", authn.Errorf("invalid authorization")
 		}
 
 		if errors.Is(errParseClaims, jwt.ErrTokenExpired) {
-			return nil, authn.Errorf("expired authorization")
+			return nil, "", authn.Errorf("expired authorization")
 		}
 
-		return nil, authn.Errorf("invalid authorization")
+		return nil, "", authn.Errorf("invalid authorization")
 	}
 
 	if !tkn.Valid {
-		return nil, authn.Errorf("invalid token")
+		return nil, "", authn.Errorf("invalid token")
 	}
 
 	if claims.Fingerprint != fingerprintHash(fingerprint) {
 		slog.Error("Invalid cookie fingerprint, token rejected")
 
-		return nil, authn.Errorf("invalid token")
+		return nil, "", authn.Errorf("invalid token")
 	}
 
-	return &claims, nil
+	return &claims, fingerprint, nil
 }
 
 func (m *Middleware) jwtTokenFromCookie(req *http.Request) string {
@@ -0,0 +1,219 @@
+package rpc_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
+	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+var errUnknownToken = errors.New("unknown token")
+
+type apiToken struct {
+	privilege  permission.Privilege
+	procedures []string
+}
+
+type apiTokens map[string]apiToken
+
+func (t apiTokens) ValidateAPIToken(_ context.Context, secret string) (rpc.UserInfo, []string, error) {
+	token, found := t[secret]
+	if !found {
+		return rpc.UserInfo{}, nil, errUnknownToken
+	}
+
+	return rpc.UserInfo{SteamID: steamid.New(76561197960287930), Privilege: token.privilege, APITokenID: 1}, token.procedures, nil
+}
+
+func TestAuthenticateAPIToken(t *testing.T) {
+	t.Parallel()
+
+	const (
+		query  = "/ban.v1.BanService/Query"
+		create = "/ban.v1.BanService/Create"
+		report = "/ban.v1.ReportService/Query"
+	)
+
+	middleware := rpc.NewMiddleware("gbans", "secret", apiTokens{
+		rpc.APITokenPrefix + "all":     {privilege: permission.Moderator},
+		rpc.APITokenPrefix + "query":   {privilege: permission.Moderator, procedures: []string{query}},
+		rpc.APITokenPrefix + "service": {privilege: permission.Moderator, procedures: []string{"/ban.v1.BanService/"}},
+		rpc.APITokenPrefix + "user":    {privilege: permission.User},
+	}, nil, nil)
+
+	for _, procedure := range []string{query, create, report} {
+		middleware.UserRoute(procedure, rpc.WithMinPermissions(permission.Moderator))
+	}
+
+	for _, testCase := range []struct {
+		name      string
+		token     string
+		procedure string
+		allowed   bool
+	}{
+		{name: "unscoped", token: "all", procedure: create, allowed: true},
+		{name: "procedure scope", token: "query", procedure: query, allowed: true},
+		{name: "outside procedure scope", token: "query", procedure: create},
+		{name: "service scope", token: "service", procedure: create, allowed: true},
+		{name: "outside service scope", token: "service", procedure: report},
+		{name: "insufficient privilege", token: "user", procedure: query},
+		{name: "unknown token", token: "missing", procedure: query},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, testCase.procedure, nil)
+			req.Header.Set("Authorization", "Bearer "+rpc.APITokenPrefix+testCase.token)
+
+			info, err := middleware.Authenticate(t.Context(), req)
+			if !testCase.allowed {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			user, ok := info.(rpc.UserInfo)
+			require.True(t, ok)
+			require.Equal(t, int64(1), user.APITokenID)
+		})
+	}
+}
+
+func TestAuthenticateAPITokenDisabled(t *testing.T) {
+	t.Parallel()
+
+	middleware := rpc.NewMiddleware("gbans", "secret", nil, nil, nil)
+	middleware.UserRoute("/ban.v1.BanService/Query", rpc.WithMinPermissions(permission.User))
+
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/ban.v1.BanService/Query", nil)
+	req.Header.Set("Authorization", "Bearer "+rpc.APITokenPrefix+"all")
+
+	_, err := middleware.Authenticate(t.Context(), req)
+	require.Error(t, err)
+}
+
+type sessions map[string]int64
+
+func (s sessions) ValidateSession(_ context.Context, _ steamid.SteamID, fingerprint string) (int64, error) {
+	sessionID, found := s[fingerprint]
+	if !found {
+		return 0, errUnknownToken
+	}
+
+	return sessionID, nil
+}
+
+func TestAuthenticateSession(t *testing.T) {
+	t.Parallel()
+
+	const procedure = "/ban.v1.BanService/Query"
+
+	active := sessions{}
+	middleware := rpc.NewMiddleware("gbans", "secret", nil, active, nil)
+	middleware.UserRoute(procedure, rpc.WithMinPermissions(permission.User))
+
+	token, fingerprint, errToken := middleware.MakeUserToken(rpc.UserInfo{
+		SteamID:   steamid.New(76561197960287930),
+		Privilege: permission.User,
+	})
+	require.NoError(t, errToken)
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, procedure, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.AddCookie(&http.Cookie{Name: rpc.FingerprintCookieName, Value: fingerprint})
+
+		return req
+	}
+
+	_, errRevoked := middleware.Authenticate(t.Context(), newRequest())
+	require.Error(t, errRevoked, "tokens without a session must be rejected")
+
+	active[fingerprint] = 42
+
+	info, err := middleware.Authenticate(t.Context(), newRequest())
+	require.NoError(t, err)
+	user, ok := info.(rpc.UserInfo)
+	require.True(t, ok)
+	require.Equal(t, int64(42), user.SessionID)
+}
+
+// roles grants the capabilities of the default role of the privilege along with those assigned to the person.
+type roles struct {
+	defaults map[permission.Privilege][]permission.Capability
+	assigned map[steamid.SteamID][]permission.Capability
+}
+
+func (r roles) Capabilities(steamID steamid.SteamID, privilege permission.Privilege) []permission.Capability {
+	return slices.Concat(r.defaults[privilege], r.assigned[steamID])
+}
+
+func TestAuthenticateCapability(t *testing.T) {
+	t.Parallel()
+
+	const filters = "/chat.v1.WordfilterService/Filters"
+
+	tokens := apiTokens{
+		rpc.APITokenPrefix + "user":      {privilege: permission.User},
+		rpc.APITokenPrefix + "moderator": {privilege: permission.Moderator},
+	}
+
+	for _, testCase := range []struct {
+		name      string
+		token     string
+		procedure string
+		resolver  rpc.CapabilityResolver
+		allowed   bool
+	}{
+		{name: "default privilege", token: "moderator", allowed: true},
+		{name: "below default privilege", token: "user"},
+		{name: "granted by default role", token: "user", resolver: roles{
+			defaults: map[permission.Privilege][]permission.Capability{permission.User: {permission.CapWordFilters}},
+		}, allowed: true},
+		{name: "not granted by default role", token: "moderator", resolver: roles{
+			defaults: map[permission.Privilege][]permission.Capability{permission.Moderator: {permission.CapAppeals}},
+		}},
+		{name: "assigned roles do not apply to tokens", token: "user", resolver: roles{
+			assigned: map[steamid.SteamID][]permission.Capability{
+				steamid.New(76561197960287930): {permission.CapWordFilters},
+			},
+		}},
+		{name: "any capability", token: "user", procedure: "/ban.v1.BanService/Create", resolver: roles{
+			defaults: map[permission.Privilege][]permission.Capability{permission.User: {permission.CapGags}},
+		}, allowed: true},
+		{name: "none of the capabilities", token: "moderator", procedure: "/ban.v1.BanService/Create", resolver: roles{
+			defaults: map[permission.Privilege][]permission.Capability{permission.Moderator: {permission.CapBansView}},
+		}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			procedure := testCase.procedure
+			if procedure == "" {
+				procedure = filters
+			}
+
+			middleware := rpc.NewMiddleware("gbans", "secret", tokens, nil, testCase.resolver)
+			middleware.UserRoute(filters, rpc.WithCapability(permission.CapWordFilters))
+			middleware.UserRoute("/ban.v1.BanService/Create", rpc.WithAnyCapability(permission.CapBans, permission.CapGags))
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, procedure, nil)
+			req.Header.Set("Authorization", "Bearer "+rpc.APITokenPrefix+testCase.token)
+
+			_, err := middleware.Authenticate(t.Context(), req)
+			if testCase.allowed {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	"connectrpc.com/authn"
 	"connectrpc.com/connect"
@@ -36,6 +37,12 @@ type UserInfo struct {
 	AvatarHash person.Avatar
 	Name       string
 	Privilege  permission.Privilege
+	// APITokenID is set when authenticated with a personal api token instead of a browser session.
+	APITokenID int64
+	// SessionID is the browser login used to authenticate.
+	SessionID int64
+	// Capabilities are granted by the roles held by the user, including the default roles of their privilege.
+	Capabilities []permission.Capability
 }
 
 func (u UserInfo) Path() string {
@@ -46,6 +53,11 @@ func (u UserInfo) HasPermission(privilege permission.Privilege) bool {
 	return u.Privilege >= privilege
 }
 
+// Can implements permission.Capable.
+func (u UserInfo) Can(capability permission.Capability) bool {
+	return slices.Contains(u.Capabilities, capability)
+}
+
 func (u UserInfo) GetSteamID() steamid.SteamID {
 	return u.SteamID
 }
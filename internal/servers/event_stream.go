@@ -0,0 +1,109 @@
+package servers
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/leighmacdonald/gbans/pkg/broadcaster"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+)
+
+const subscriptionBufferSize = 256
+
+// StreamFilter limits a subscription to the matching servers and event types. Empty values match everything.
+type StreamFilter struct {
+	ServerIDs  []int32
+	EventTypes []logparse.EventType
+}
+
+func (f StreamFilter) matches(event logparse.ServerEvent) bool {
+	if len(f.ServerIDs) > 0 && !slices.Contains(f.ServerIDs, event.ServerID) {
+		return false
+	}
+
+	return len(f.EventTypes) == 0 || slices.Contains(f.EventTypes, event.EventType)
+}
+
+// Subscription receives the live events matching its filter. Events are dropped instead of queued
+// without bound when the subscriber is not reading fast enough.
+type Subscription struct {
+	C       chan logparse.ServerEvent
+	filter  StreamFilter
+	dropped atomic.Uint64
+}
+
+// Dropped returns and resets the count of events dropped since the last call.
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Swap(0)
+}
+
+// eventStreams fans out events from the broadcaster to the subscribers. It is the only consumer of the
+// broadcaster for all subscribers and never blocks on them, so slow clients cannot hold up Emit.
+type eventStreams struct {
+	broadcaster   *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]
+	subscribers   map[*Subscription]struct{}
+	subscribersMu *sync.RWMutex
+}
+
+func newEventStreams(broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]) *eventStreams {
+	return &eventStreams{
+		broadcaster:   broadcaster,
+		subscribers:   map[*Subscription]struct{}{},
+		subscribersMu: &sync.RWMutex{},
+	}
+}
+
+func (e *eventStreams) start(ctx context.Context) {
+	consumer, errRegister := e.broadcaster.Subscribe("event_stream", broadcaster.Options{Policy: broadcaster.DropOldest})
+	if errRegister != nil {
+		slog.Error("Failed to register event stream consumer", slog.String("error", errRegister.Error()))
+
+		return
+	}
+	defer e.broadcaster.Unsubscribe(consumer)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-consumer.C:
+			e.publish(event)
+		}
+	}
+}
+
+func (e *eventStreams) publish(event logparse.ServerEvent) {
+	e.subscribersMu.RLock()
+	defer e.subscribersMu.RUnlock()
+
+	for subscriber := range e.subscribers {
+		if !subscriber.filter.matches(event) {
+			continue
+		}
+
+		select {
+		case subscriber.C <- event:
+		default:
+			subscriber.dropped.Add(1)
+		}
+	}
+}
+
+func (e *eventStreams) subscribe(filter StreamFilter) *Subscription {
+	subscription := &Subscription{C: make(chan logparse.ServerEvent, subscriptionBufferSize), filter: filter}
+
+	e.subscribersMu.Lock()
+	e.subscribers[subscription] = struct{}{}
+	e.subscribersMu.Unlock()
+
+	return subscription
+}
+
+func (e *eventStreams) unsubscribe(subscription *Subscription) {
+	e.subscribersMu.Lock()
+	delete(e.subscribers, subscription)
+	e.subscribersMu.Unlock()
+}
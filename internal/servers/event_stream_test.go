@@ -0,0 +1,111 @@
+package servers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/servers"
+	"github.com/leighmacdonald/gbans/pkg/broadcaster"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/stretchr/testify/require"
+)
+
+func serverEvent(serverID int32, eventType logparse.EventType) logparse.ServerEvent {
+	return logparse.ServerEvent{ServerID: serverID, Results: logparse.Results{EventType: eventType}}
+}
+
+func received(subscription *servers.Subscription) []logparse.ServerEvent {
+	var events []logparse.ServerEvent
+
+	for {
+		select {
+		case event := <-subscription.C:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func TestEventStreamFilter(t *testing.T) {
+	var (
+		streams  = servers.NewEventStreams(broadcaster.New[logparse.EventType, logparse.ServerEvent]())
+		all      = streams.Subscribe(servers.StreamFilter{})
+		server   = streams.Subscribe(servers.StreamFilter{ServerIDs: []int32{1}})
+		chat     = streams.Subscribe(servers.StreamFilter{EventTypes: []logparse.EventType{logparse.Say, logparse.SayTeam}})
+		combined = streams.Subscribe(servers.StreamFilter{ServerIDs: []int32{2}, EventTypes: []logparse.EventType{logparse.Killed}})
+		events   = []logparse.ServerEvent{
+			serverEvent(1, logparse.Say),
+			serverEvent(1, logparse.Killed),
+			serverEvent(2, logparse.SayTeam),
+			serverEvent(2, logparse.Killed),
+			serverEvent(3, logparse.Damage),
+		}
+	)
+
+	for _, event := range events {
+		streams.Publish(event)
+	}
+
+	require.Equal(t, events, received(all))
+	require.Equal(t, events[:2], received(server))
+	require.Equal(t, []logparse.ServerEvent{events[0], events[2]}, received(chat))
+	require.Equal(t, []logparse.ServerEvent{events[3]}, received(combined))
+
+	// Removed subscribers no longer receive events.
+	streams.Unsubscribe(all)
+	streams.Publish(events[0])
+	require.Empty(t, received(all))
+	require.Len(t, received(server), 1)
+}
+
+func TestEventStreamDropped(t *testing.T) {
+	var (
+		streams = servers.NewEventStreams(broadcaster.New[logparse.EventType, logparse.ServerEvent]())
+		slow    = streams.Subscribe(servers.StreamFilter{})
+		other   = streams.Subscribe(servers.StreamFilter{ServerIDs: []int32{2}})
+	)
+
+	const overflow = 10
+
+	for range servers.SubscriptionBufferSize + overflow {
+		streams.Publish(serverEvent(1, logparse.Say))
+	}
+
+	// Events beyond the buffer are dropped instead of blocking the publisher.
+	require.Len(t, slow.C, servers.SubscriptionBufferSize)
+	require.Equal(t, uint64(overflow), slow.Dropped())
+	require.Zero(t, slow.Dropped())
+
+	// Filtered out events are not counted as dropped.
+	require.Empty(t, other.C)
+	require.Zero(t, other.Dropped())
+
+	received(slow)
+	streams.Publish(serverEvent(1, logparse.Say))
+	require.Len(t, slow.C, 1)
+	require.Zero(t, slow.Dropped())
+}
+
+func TestEventStreamStart(t *testing.T) {
+	var (
+		events       = broadcaster.New[logparse.EventType, logparse.ServerEvent]()
+		streams      = servers.NewEventStreams(events)
+		subscription = streams.Subscribe(servers.StreamFilter{ServerIDs: []int32{1}})
+	)
+
+	go streams.Start(t.Context())
+
+	// The consumer is registered asynchronously, so keep emitting until it arrives.
+	require.Eventually(t, func() bool {
+		events.Emit(logparse.Say, serverEvent(2, logparse.Say))
+		events.Emit(logparse.Say, serverEvent(1, logparse.Say))
+
+		select {
+		case event := <-subscription.C:
+			return event.ServerID == 1
+		default:
+			return false
+		}
+	}, time.Second, time.Millisecond*10)
+}
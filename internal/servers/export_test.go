@@ -0,0 +1,35 @@
+package servers
+
+import (
+	"context"
+
+	"github.com/leighmacdonald/gbans/pkg/broadcaster"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+)
+
+const SubscriptionBufferSize = subscriptionBufferSize
+
+// EventStreams exposes eventStreams for tests in the servers_test package.
+type EventStreams struct {
+	streams *eventStreams
+}
+
+func NewEventStreams(events *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]) EventStreams {
+	return EventStreams{streams: newEventStreams(events)}
+}
+
+func (e EventStreams) Start(ctx context.Context) {
+	e.streams.start(ctx)
+}
+
+func (e EventStreams) Publish(event logparse.ServerEvent) {
+	e.streams.publish(event)
+}
+
+func (e EventStreams) Subscribe(filter StreamFilter) *Subscription {
+	return e.streams.subscribe(filter)
+}
+
+func (e EventStreams) Unsubscribe(subscription *Subscription) {
+	e.streams.unsubscribe(subscription)
+}
@@ -0,0 +1,264 @@
+package servers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/leighmacdonald/gbans/internal/asset"
+	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/pkg/zstd"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+const (
+	defaultLogMaxAgeDays = 30
+	logRetentionInterval = time.Hour
+	// logArchiveDelay gives any buffered lines of a completed day time to be written before it is archived.
+	logArchiveDelay = time.Hour
+)
+
+var (
+	ErrLogArchive  = errors.New("failed to archive server logs")
+	ErrLogNotFound = errors.New("no logs found for day")
+
+	rxLogSteamID = regexp.MustCompile(`\[U:1:\d+]`)
+)
+
+// LogArchiveStore persists the compressed daily log archives.
+type LogArchiveStore interface {
+	Create(ctx context.Context, author steamid.SteamID, bucket asset.Bucket, fileName string, content io.ReadSeeker, private bool) (asset.Asset, error)
+	Get(ctx context.Context, assetID uuid.UUID) (asset.Asset, error)
+}
+
+// LogPolicy defines how long the logs of a server are kept in the database. Servers without a policy use
+// defaultLogPolicy.
+type LogPolicy struct {
+	ServerID int32
+	// MaxAgeDays removes lines older than the amount of days, 0 to disable.
+	MaxAgeDays int32
+	// MaxSizeBytes removes the oldest lines once the total size of the servers log exceeds it, 0 to disable.
+	MaxSizeBytes int64
+	// ArchiveEnabled writes each completed UTC day to a zstd compressed archive before it can be purged.
+	ArchiveEnabled bool
+	UpdatedOn      time.Time
+}
+
+func defaultLogPolicy(serverID int32) LogPolicy {
+	return LogPolicy{ServerID: serverID, MaxAgeDays: defaultLogMaxAgeDays}
+}
+
+type LogArchive struct {
+	ServerID  int32
+	Day       time.Time
+	AssetID   uuid.UUID
+	Lines     int32
+	CreatedOn time.Time
+}
+
+// LogFile is a single day of a servers log in the standard srcds format.
+type LogFile struct {
+	Name     string
+	Content  []byte
+	Archived bool
+}
+
+// logSteamIDs extracts the unique players referenced by a log line.
+func logSteamIDs(line string) []int64 {
+	ids := []int64{}
+
+	for _, match := range rxLogSteamID.FindAllString(line, -1) {
+		sid := steamid.New(match)
+		if !sid.Valid() {
+			continue
+		}
+
+		if !slices.Contains(ids, sid.Int64()) {
+			ids = append(ids, sid.Int64())
+		}
+	}
+
+	return ids
+}
+
+func logFileName(server Server, day time.Time) string {
+	return fmt.Sprintf("%s_%s.log", server.ShortName, day.Format(time.DateOnly))
+}
+
+// logRetention periodically archives and purges the server logs according to their policies.
+type logRetention struct {
+	repo   Repository
+	assets LogArchiveStore
+	// owner is recorded as the author of the archives.
+	owner steamid.SteamID
+}
+
+func (r logRetention) start(ctx context.Context) {
+	ticker := time.NewTicker(logRetentionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.apply(ctx); err != nil {
+				slog.Error("Failed to apply server log retention", slog.String("error", err.Error()))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r logRetention) apply(ctx context.Context) error {
+	servers, errServers := r.repo.Query(ctx, Query{IncludeDisabled: true, IncludeDeleted: true})
+	if errServers != nil {
+		return errServers
+	}
+
+	policies, errPolicies := r.repo.LogPolicies(ctx)
+	if errPolicies != nil {
+		return errPolicies
+	}
+
+	for _, server := range servers {
+		policy := defaultLogPolicy(server.ServerID)
+		for _, existing := range policies {
+			if existing.ServerID == server.ServerID {
+				policy = existing
+			}
+		}
+
+		if policy.ArchiveEnabled {
+			if err := r.archive(ctx, server); err != nil {
+				// Keep the lines around so that they can be archived on the next attempt.
+				slog.Error("Failed to archive server logs", slog.String("error", err.Error()),
+					slog.Int("server_id", int(server.ServerID)))
+
+				continue
+			}
+		}
+
+		if err := r.repo.purgeLogs(ctx, policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// archive writes each completed day that has not yet been archived.
+func (r logRetention) archive(ctx context.Context, server Server) error {
+	if r.assets == nil {
+		return nil
+	}
+
+	cutoff := time.Now().UTC().Add(-logArchiveDelay).Truncate(24 * time.Hour)
+
+	days, errDays := r.repo.unarchivedLogDays(ctx, server.ServerID, cutoff)
+	if errDays != nil {
+		return errDays
+	}
+
+	for _, day := range days {
+		lines, errLines := r.repo.logLines(ctx, server.ServerID, day, day.AddDate(0, 0, 1))
+		if errLines != nil {
+			return errLines
+		}
+
+		var compressed bytes.Buffer
+		if err := zstd.Compress(strings.NewReader(strings.Join(lines, "\n")+"\n"), &compressed); err != nil {
+			return errors.Join(err, ErrLogArchive)
+		}
+
+		archiveAsset, errAsset := r.assets.Create(ctx, r.owner, asset.BucketLogs,
+			logFileName(server, day)+zstd.Extension, bytes.NewReader(compressed.Bytes()), true)
+		if errAsset != nil {
+			return errors.Join(errAsset, ErrLogArchive)
+		}
+
+		if err := r.repo.SaveLogArchive(ctx, LogArchive{
+			ServerID:  server.ServerID,
+			Day:       day,
+			AssetID:   archiveAsset.AssetID,
+			Lines:     int32(len(lines)), //nolint:gosec
+			CreatedOn: time.Now(),
+		}); err != nil {
+			return err
+		}
+
+		slog.Debug("Archived server logs", slog.String("server", server.ShortName),
+			slog.String("day", day.Format(time.DateOnly)), slog.Int("lines", len(lines)))
+	}
+
+	return nil
+}
+
+// download returns the log of the day, preferring the archive as the live table may already be partially purged.
+func (r logRetention) download(ctx context.Context, server Server, day time.Time) (LogFile, error) {
+	logFile := LogFile{Name: logFileName(server, day)}
+
+	archive, errArchive := r.repo.LogArchive(ctx, server.ServerID, day)
+	if errArchive != nil && !errors.Is(errArchive, database.ErrNoResult) {
+		return logFile, errArchive
+	}
+
+	if errArchive == nil && r.assets != nil {
+		archiveAsset, errAsset := r.assets.Get(ctx, archive.AssetID)
+		if errAsset != nil {
+			return logFile, errors.Join(errAsset, ErrLogArchive)
+		}
+
+		content, errRead := readLogArchive(archiveAsset.LocalPath)
+		if errRead != nil {
+			return logFile, errRead
+		}
+
+		logFile.Content = content
+		logFile.Archived = true
+
+		return logFile, nil
+	}
+
+	lines, errLines := r.repo.logLines(ctx, server.ServerID, day, day.AddDate(0, 0, 1))
+	if errLines != nil {
+		return logFile, errLines
+	}
+
+	if len(lines) == 0 {
+		return logFile, ErrLogNotFound
+	}
+
+	logFile.Content = []byte(strings.Join(lines, "\n") + "\n")
+
+	return logFile, nil
+}
+
+// readLogArchive decompresses the archive stored at the path back into the plain srcds log format.
+func readLogArchive(path string) ([]byte, error) {
+	input, errOpen := os.Open(path)
+	if errOpen != nil {
+		return nil, errors.Join(errOpen, ErrLogArchive)
+	}
+
+	defer func() {
+		if errClose := input.Close(); errClose != nil {
+			slog.Error("Failed to close log archive", slog.String("error", errClose.Error()))
+		}
+	}()
+
+	content, errDecompress := zstd.Decompress(input)
+	if errDecompress != nil {
+		return nil, errors.Join(errDecompress, ErrLogArchive)
+	}
+
+	return content, nil
+}
@@ -5,56 +5,68 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/leighmacdonald/gbans/pkg/broadcaster"
 	"github.com/leighmacdonald/gbans/pkg/logparse"
 )
 
-func newLogEventRecorder(repo Repository) *LogEventRecorder {
-	return &LogEventRecorder{repo: repo, C: make(chan logparse.ServerEvent, 100)}
+const (
+	logRecorderQueueSize = 10000
+	logRecorderBatchSize = 1000
+	logRecorderFlushTime = time.Second * 10
+)
+
+func newLogEventRecorder(repo Repository, broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]) *LogEventRecorder {
+	return &LogEventRecorder{repo: repo, broadcaster: broadcaster}
 }
 
+// LogEventRecorder writes every log line received to the database in batches.
 type LogEventRecorder struct {
-	repo Repository
-	C    chan logparse.ServerEvent
-	logs []logparse.ServerEvent
+	repo        Repository
+	broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]
+	logs        []logparse.ServerEvent
 }
 
 func (l *LogEventRecorder) start(ctx context.Context) {
-	writeTicker := time.NewTicker(time.Second * 10)
-	purgeTicker := time.NewTicker(time.Hour * 24)
+	// The queue absorbs slow inserts, lines are never dropped.
+	consumer, errRegister := l.broadcaster.Subscribe("server_logs",
+		broadcaster.Options{QueueSize: logRecorderQueueSize, Policy: broadcaster.Block})
+	if errRegister != nil {
+		slog.Error("Failed to register server log consumer", slog.String("error", errRegister.Error()))
+
+		return
+	}
+	defer l.broadcaster.Unsubscribe(consumer)
+
+	writeTicker := time.NewTicker(logRecorderFlushTime)
+	defer writeTicker.Stop()
 
 	for {
 		select {
-		case event := <-l.C:
+		case event := <-consumer.C:
 			l.logs = append(l.logs, event)
-			slog.Debug(event.Raw)
+			if len(l.logs) >= logRecorderBatchSize {
+				l.flush(ctx)
+			}
 		case <-writeTicker.C:
 			l.flush(ctx)
-			l.logs = nil
-		case <-purgeTicker.C:
-			if err := l.repo.purgeLogs(ctx); err != nil {
-				slog.Error("Failed to purge server logs", slog.String("error", err.Error()))
-			}
 		case <-ctx.Done():
-			l.flush(ctx)
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Second*10)
+			l.flush(flushCtx)
+			cancel()
 
 			return
 		}
 	}
 }
 
-func (l *LogEventRecorder) send(event logparse.ServerEvent) {
-	select {
-	case l.C <- event:
-	default:
-		slog.Debug("Dropped log message", slog.String("reason", "queue_full"))
-	}
-}
-
 func (l *LogEventRecorder) flush(ctx context.Context) {
 	if len(l.logs) == 0 {
 		return
 	}
+
 	if err := l.repo.InsertLogs(ctx, l.logs); err != nil {
-		slog.Error("Failed to flush server logs", slog.String("error", err.Error()))
+		slog.Error("Failed to flush server logs", slog.String("error", err.Error()), slog.Int("count", len(l.logs)))
 	}
+
+	l.logs = nil
 }
@@ -319,6 +319,15 @@ func (s *Server) KickPlayerID(ctx context.Context, targetPlayerID int, reason st
 	return s.ExecDiscardF(ctx, `sm_kick #%d "%s"`, targetPlayerID, sanitizeRCONArg(reason))
 }
 
+// Gag will remove the players ability to use chat for the rest of their session.
+func (s *Server) Gag(ctx context.Context, target steamid.SteamID, reason string) error {
+	if !target.Valid() {
+		return steamid.ErrInvalidSID
+	}
+
+	return s.ExecDiscardF(ctx, `sm_gag "#%s" "%s"`, target.Steam(false), sanitizeRCONArg(reason))
+}
+
 // Silence will gag & mute a player.
 func (s *Server) Silence(ctx context.Context, target steamid.SteamID, reason string) error {
 	if !target.Valid() {
@@ -328,6 +337,15 @@ func (s *Server) Silence(ctx context.Context, target steamid.SteamID, reason str
 	return s.ExecDiscardF(ctx, `sm_silence "#%s" "%s"`, target.Steam(false), sanitizeRCONArg(reason))
 }
 
+// Unsilence will ungag & unmute a player.
+func (s *Server) Unsilence(ctx context.Context, target steamid.SteamID) error {
+	if !target.Valid() {
+		return steamid.ErrInvalidSID
+	}
+
+	return s.ExecDiscardF(ctx, `sm_unsilence "#%s"`, target.Steam(false))
+}
+
 func (s *Server) Addr() string {
 	return fmt.Sprintf("%s:%d", s.AddrInternalOrDefault(), s.Port)
 }
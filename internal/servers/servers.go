@@ -17,6 +17,7 @@ import (
 	"github.com/leighmacdonald/gbans/internal/httphelper"
 	"github.com/leighmacdonald/gbans/pkg/broadcaster"
 	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 )
 
 var (
@@ -93,9 +94,15 @@ type Servers struct {
 	broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]
 	logAddr     string
 	logRecorder *LogEventRecorder
+	retention   logRetention
+	streams     *eventStreams
 }
 
-func New(repository Repository, broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent], logAddr string) (*Servers, error) {
+// New creates the server manager. The log archive store is optional, daily log archives cannot be
+// written when it is nil. Archives are stored with the owner as their author.
+func New(repository Repository, broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent],
+	logArchives LogArchiveStore, owner steamid.SteamID, logAddr string,
+) (*Servers, error) {
 	servers := &Servers{
 		repo:        repository,
 		logFileChan: make(chan LogFilePayload),
@@ -103,7 +110,9 @@ func New(repository Repository, broadcaster *broadcaster.Broadcaster[logparse.Ev
 		serversMu:   &sync.RWMutex{},
 		broadcaster: broadcaster,
 		logAddr:     logAddr,
-		logRecorder: newLogEventRecorder(repository),
+		logRecorder: newLogEventRecorder(repository, broadcaster),
+		retention:   logRetention{repo: repository, assets: logArchives, owner: owner},
+		streams:     newEventStreams(broadcaster),
 	}
 
 	return servers, nil
@@ -111,13 +120,78 @@ func New(repository Repository, broadcaster *broadcaster.Broadcaster[logparse.Ev
 
 func (s *Servers) onLogEvent(_ logparse.EventType, event logparse.ServerEvent) {
 	s.broadcaster.Emit(event.EventType, event)
-	s.logRecorder.send(event)
+}
+
+// Subscribe registers a new live event subscription. Callers must Unsubscribe once done.
+func (s *Servers) Subscribe(filter StreamFilter) *Subscription {
+	return s.streams.subscribe(filter)
+}
+
+func (s *Servers) Unsubscribe(subscription *Subscription) {
+	s.streams.unsubscribe(subscription)
 }
 
 func (s *Servers) QueryLogs(ctx context.Context, opts QueryLogOpts) ([]ServerLog, int64, error) {
 	return s.repo.QueryLogs(ctx, opts)
 }
 
+// DownloadLog returns the full log of the server for the UTC day.
+func (s *Servers) DownloadLog(ctx context.Context, serverID int32, day time.Time) (LogFile, error) {
+	server, errServer := s.Server(ctx, serverID)
+	if errServer != nil {
+		return LogFile{}, errServer
+	}
+
+	return s.retention.download(ctx, server, day.UTC().Truncate(24*time.Hour))
+}
+
+// ApplyLogRetention runs a single pass of archiving and purging the server logs, as performed hourly once started.
+func (s *Servers) ApplyLogRetention(ctx context.Context) error {
+	return s.retention.apply(ctx)
+}
+
+func (s *Servers) LogArchives(ctx context.Context, serverID int32) ([]LogArchive, error) {
+	return s.repo.LogArchives(ctx, serverID)
+}
+
+// LogPolicies returns the retention policy of every server, including those using the default policy.
+func (s *Servers) LogPolicies(ctx context.Context) ([]LogPolicy, error) {
+	servers, errServers := s.repo.Query(ctx, Query{IncludeDisabled: true})
+	if errServers != nil {
+		return nil, errServers
+	}
+
+	saved, errSaved := s.repo.LogPolicies(ctx)
+	if errSaved != nil {
+		return nil, errSaved
+	}
+
+	policies := make([]LogPolicy, len(servers))
+	for idx, server := range servers {
+		policies[idx] = defaultLogPolicy(server.ServerID)
+		for _, policy := range saved {
+			if policy.ServerID == server.ServerID {
+				policies[idx] = policy
+			}
+		}
+	}
+
+	return policies, nil
+}
+
+func (s *Servers) SaveLogPolicy(ctx context.Context, policy LogPolicy) (LogPolicy, error) {
+	if _, errServer := s.Server(ctx, policy.ServerID); errServer != nil {
+		return policy, errServer
+	}
+
+	policy.UpdatedOn = time.Now()
+	if err := s.repo.SaveLogPolicy(ctx, policy); err != nil {
+		return policy, err
+	}
+
+	return policy, nil
+}
+
 func (s *Servers) secretAuth(ctx context.Context, secret int64, ipAddr net.IP) (int32, string, error) {
 	server, err := s.repo.ServerByLogSecret(ctx, secret)
 	if err != nil {
@@ -216,6 +290,10 @@ func (s *Servers) Start(ctx context.Context, updateFreq time.Duration) error {
 
 	go s.logRecorder.start(ctx)
 
+	go s.retention.start(ctx)
+
+	go s.streams.start(ctx)
+
 	for {
 		select {
 		case <-ticker.C:
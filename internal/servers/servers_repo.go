@@ -21,11 +21,11 @@ func NewRepository(database database.Database) Repository {
 }
 
 func (r *Repository) InsertLogs(ctx context.Context, events []logparse.ServerEvent) error {
-	const batchQuery = "INSERT INTO server_logs (server_id, body, created_on) VALUES ($1, $2, $3)"
+	const batchQuery = "INSERT INTO server_logs (server_id, body, created_on, event_type, steam_ids) VALUES ($1, $2, $3, $4, $5)"
 
 	batch := &pgx.Batch{}
 	for _, log := range events {
-		batch.Queue(batchQuery, log.ServerID, log.Raw, log.CreatedOn)
+		batch.Queue(batchQuery, log.ServerID, log.Raw, log.CreatedOn, log.EventType, logSteamIDs(log.Raw))
 	}
 
 	batchResults := r.SendBatch(ctx, batch)
@@ -36,17 +36,34 @@ func (r *Repository) InsertLogs(ctx context.Context, events []logparse.ServerEve
 	return nil
 }
 
-func (r *Repository) purgeLogs(ctx context.Context) error {
-	servers, errServers := r.Query(ctx, Query{IncludeDisabled: true, IncludeDeleted: true})
-	if errServers != nil {
-		return errServers
+// purgeLogs removes the lines of the server falling outside of the retention policy. When archiving is enabled,
+// only lines up to the end of the last archived day are removed so that lines are never lost before they have been
+// archived.
+func (r *Repository) purgeLogs(ctx context.Context, policy LogPolicy) error {
+	var archived string
+	if policy.ArchiveEnabled {
+		archived = ` AND created_on < ((SELECT max(day) + 1 FROM server_log_archive WHERE server_id = $1)::timestamp AT TIME ZONE 'UTC')`
 	}
 
-	const purgeQuery = "DELETE FROM server_logs WHERE server_id = $1 ORDER BY created_on DESC OFFSET 10000"
+	if policy.MaxAgeDays > 0 {
+		const ageQuery = "DELETE FROM server_logs WHERE server_id = $1 AND created_on < $2"
 
-	for _, server := range servers {
-		if err := r.Exec(ctx, purgeQuery, server.ServerID); err != nil {
-			return err
+		cutoff := time.Now().AddDate(0, 0, -int(policy.MaxAgeDays))
+		if err := r.Exec(ctx, ageQuery+archived, policy.ServerID, cutoff); err != nil {
+			return database.Err(err)
+		}
+	}
+
+	if policy.MaxSizeBytes > 0 {
+		const sizeQuery = `
+			DELETE FROM server_logs WHERE server_log_id IN (
+				SELECT server_log_id FROM (
+					SELECT server_log_id, SUM(octet_length(body)) OVER (ORDER BY created_on DESC, server_log_id DESC) AS total
+					FROM server_logs WHERE server_id = $1
+				) sized WHERE total > $2)`
+
+		if err := r.Exec(ctx, sizeQuery+archived, policy.ServerID, policy.MaxSizeBytes); err != nil {
+			return database.Err(err)
 		}
 	}
 
@@ -54,36 +71,63 @@ func (r *Repository) purgeLogs(ctx context.Context) error {
 }
 
 type ServerLog struct {
-	ServerID   int
-	ServerName string
-	Body       string
-	CreatedOn  time.Time
+	ServerLogID int64
+	ServerID    int
+	ServerName  string
+	Body        string
+	EventType   logparse.EventType
+	CreatedOn   time.Time
 }
 
 type QueryLogOpts struct {
 	query.Filter
 
 	ServerIDs []int
+	// Query is matched against the log lines using the postgres websearch syntax.
+	Query      string
+	EventTypes []logparse.EventType
+	SteamIDs   []int64
+	From       time.Time
+	To         time.Time
 }
 
 func (r *Repository) QueryLogs(ctx context.Context, opts QueryLogOpts) ([]ServerLog, int64, error) {
+	var constraints sq.And
+	if len(opts.ServerIDs) > 0 {
+		constraints = append(constraints, sq.Eq{"sl.server_id": opts.ServerIDs})
+	}
+	if opts.Query != "" {
+		constraints = append(constraints, sq.Expr("sl.body_search @@ websearch_to_tsquery('simple', ?)", opts.Query))
+	}
+	if len(opts.EventTypes) > 0 {
+		constraints = append(constraints, sq.Eq{"sl.event_type": opts.EventTypes})
+	}
+	if len(opts.SteamIDs) > 0 {
+		constraints = append(constraints, sq.Expr("sl.steam_ids && ?", opts.SteamIDs))
+	}
+	if !opts.From.IsZero() {
+		constraints = append(constraints, sq.GtOrEq{"sl.created_on": opts.From})
+	}
+	if !opts.To.IsZero() {
+		constraints = append(constraints, sq.Lt{"sl.created_on": opts.To})
+	}
+
 	builder := r.Builder().
-		Select("sl.server_id",
+		Select("sl.server_log_id",
+			"sl.server_id",
 			"s.name",
 			"sl.body",
+			"sl.event_type",
 			"sl.created_on").
 		From("server_logs sl").
-		LeftJoin("server s USING(server_id)")
+		LeftJoin("server s USING(server_id)").
+		Where(constraints)
 
+	builder = opts.ApplySafeOrder(builder, map[string][]string{
+		"sl.": {"server_log_id", "server_id", "event_type", "created_on"},
+	}, "created_on")
 	builder = opts.ApplyLimitOffset(builder, 1000)
 
-	var constraints sq.And
-	if len(opts.ServerIDs) > 0 {
-		constraints = append(constraints, sq.Eq{"sl.server_id": opts.ServerIDs})
-	}
-	if len(constraints) > 0 {
-		builder = builder.Where(constraints)
-	}
 	rows, err := r.QueryBuilder(ctx, builder)
 	if err != nil {
 		return nil, 0, database.Err(err)
@@ -93,13 +137,136 @@ func (r *Repository) QueryLogs(ctx context.Context, opts QueryLogOpts) ([]Server
 	var logs []ServerLog
 	for rows.Next() {
 		var log ServerLog
-		if err := rows.Scan(&log.ServerID, &log.ServerName, &log.Body, &log.CreatedOn); err != nil {
+		if err := rows.Scan(&log.ServerLogID, &log.ServerID, &log.ServerName, &log.Body, &log.EventType, &log.CreatedOn); err != nil {
 			return nil, 0, database.Err(err)
 		}
 		logs = append(logs, log)
 	}
 
-	return logs, int64(len(logs)), nil
+	count, errCount := r.GetCount(ctx, r.Builder().
+		Select("count(*)").
+		From("server_logs sl").
+		Where(constraints))
+	if errCount != nil {
+		return nil, 0, database.Err(errCount)
+	}
+
+	return logs, int64(count), nil //nolint:gosec
+}
+
+// logLines returns the raw lines of the server logged within the time range, oldest first.
+func (r *Repository) logLines(ctx context.Context, serverID int32, from time.Time, to time.Time) ([]string, error) {
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT body FROM server_logs
+		WHERE server_id = $1 AND created_on >= $2 AND created_on < $3
+		ORDER BY created_on, server_log_id`, serverID, from, to)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+	defer rows.Close()
+
+	var lines []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return nil, database.Err(err)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// unarchivedLogDays returns the days with logs before the cutoff which do not yet have an archive.
+func (r *Repository) unarchivedLogDays(ctx context.Context, serverID int32, before time.Time) ([]time.Time, error) {
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT DISTINCT (sl.created_on AT TIME ZONE 'UTC')::date AS day
+		FROM server_logs sl
+		LEFT JOIN server_log_archive a ON a.server_id = sl.server_id AND a.day = (sl.created_on AT TIME ZONE 'UTC')::date
+		WHERE sl.server_id = $1 AND sl.created_on < $2 AND a.asset_id IS NULL
+		ORDER BY day`, serverID, before)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+	defer rows.Close()
+
+	var days []time.Time
+	for rows.Next() {
+		var day time.Time
+		if err := rows.Scan(&day); err != nil {
+			return nil, database.Err(err)
+		}
+		days = append(days, day)
+	}
+
+	return days, nil
+}
+
+func (r *Repository) SaveLogArchive(ctx context.Context, archive LogArchive) error {
+	return database.Err(r.Exec(ctx, `
+		INSERT INTO server_log_archive (server_id, day, asset_id, lines, created_on)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (server_id, day) DO UPDATE SET asset_id = $3, lines = $4, created_on = $5`,
+		archive.ServerID, archive.Day, archive.AssetID, archive.Lines, archive.CreatedOn))
+}
+
+func (r *Repository) LogArchive(ctx context.Context, serverID int32, day time.Time) (LogArchive, error) {
+	archive := LogArchive{ServerID: serverID}
+	if err := r.QueryRow(ctx, `
+		SELECT day, asset_id, lines, created_on FROM server_log_archive WHERE server_id = $1 AND day = $2`,
+		serverID, day).Scan(&archive.Day, &archive.AssetID, &archive.Lines, &archive.CreatedOn); err != nil {
+		return archive, database.Err(err)
+	}
+
+	return archive, nil
+}
+
+func (r *Repository) LogArchives(ctx context.Context, serverID int32) ([]LogArchive, error) {
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT day, asset_id, lines, created_on FROM server_log_archive WHERE server_id = $1 ORDER BY day DESC`, serverID)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+	defer rows.Close()
+
+	archives := []LogArchive{}
+	for rows.Next() {
+		archive := LogArchive{ServerID: serverID}
+		if err := rows.Scan(&archive.Day, &archive.AssetID, &archive.Lines, &archive.CreatedOn); err != nil {
+			return nil, database.Err(err)
+		}
+		archives = append(archives, archive)
+	}
+
+	return archives, nil
+}
+
+func (r *Repository) LogPolicies(ctx context.Context) ([]LogPolicy, error) {
+	rows, errRows := r.Database.Query(ctx, `
+		SELECT server_id, max_age_days, max_size_bytes, archive_enabled, updated_on FROM server_log_policy`)
+	if errRows != nil {
+		return nil, database.Err(errRows)
+	}
+	defer rows.Close()
+
+	policies := []LogPolicy{}
+	for rows.Next() {
+		var policy LogPolicy
+		if err := rows.Scan(&policy.ServerID, &policy.MaxAgeDays, &policy.MaxSizeBytes, &policy.ArchiveEnabled, &policy.UpdatedOn); err != nil {
+			return nil, database.Err(err)
+		}
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+func (r *Repository) SaveLogPolicy(ctx context.Context, policy LogPolicy) error {
+	return database.Err(r.Exec(ctx, `
+		INSERT INTO server_log_policy (server_id, max_age_days, max_size_bytes, archive_enabled, updated_on)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (server_id) DO UPDATE SET max_age_days = $2, max_size_bytes = $3, archive_enabled = $4, updated_on = $5`,
+		policy.ServerID, policy.MaxAgeDays, policy.MaxSizeBytes, policy.ArchiveEnabled, policy.UpdatedOn))
 }
 
 func (r *Repository) ServerByLogSecret(ctx context.Context, secret int64) (Server, error) {
@@ -2,10 +2,14 @@ package servers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"log/slog"
 	"net"
+	"net/http"
 	"sort"
 	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/leighmacdonald/gbans/internal/auth/permission"
@@ -15,8 +19,10 @@ import (
 	"github.com/leighmacdonald/gbans/internal/rpc"
 	v1 "github.com/leighmacdonald/gbans/internal/servers/v1"
 	"github.com/leighmacdonald/gbans/internal/servers/v1/serversv1connect"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
 	"github.com/maruel/natural"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -34,8 +40,26 @@ func NewServersService(servers *Servers, authMiddleware *rpc.Middleware, option
 	authMiddleware.UserRoute(serversv1connect.ServersServiceDeleteServerProcedure, rpc.WithMinPermissions(permission.Admin))
 	authMiddleware.UserRoute(serversv1connect.ServersServiceServersAdminProcedure, rpc.WithMinPermissions(permission.Admin))
 	authMiddleware.UserRoute(serversv1connect.ServersServiceQueryLogsProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(serversv1connect.ServersServiceDownloadLogProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(serversv1connect.ServersServiceLogArchivesProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(serversv1connect.ServersServiceLogPoliciesProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(serversv1connect.ServersServiceSaveLogPolicyProcedure, rpc.WithMinPermissions(permission.Admin))
+	authMiddleware.UserRoute(serversv1connect.ServersServiceStreamEventsProcedure, rpc.WithMinPermissions(permission.Moderator))
 
-	return rpc.Service{Pattern: pattern, Handler: handler}
+	return rpc.Service{Pattern: pattern, Handler: withoutStreamDeadline(handler)}
+}
+
+// withoutStreamDeadline lifts the servers write timeout for the long-lived event stream.
+func withoutStreamDeadline(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == serversv1connect.ServersServiceStreamEventsProcedure {
+			if errDeadline := http.NewResponseController(res).SetWriteDeadline(time.Time{}); errDeadline != nil {
+				slog.Warn("Failed to clear stream write deadline", slog.String("error", errDeadline.Error()))
+			}
+		}
+
+		handler.ServeHTTP(res, req)
+	})
 }
 
 func getLatLong(ctx context.Context) (float64, float64) {
@@ -209,3 +233,178 @@ func (s Service) ServersAdmin(ctx context.Context, _ *emptypb.Empty) (*v1.Server
 
 	return &resp, nil
 }
+
+func (s Service) QueryLogs(ctx context.Context, req *v1.QueryLogsRequest) (*v1.QueryLogsResponse, error) {
+	opts := QueryLogOpts{
+		Filter:   rpc.FromRPC(req.GetFilter()),
+		Query:    req.GetQuery(),
+		SteamIDs: req.GetSteamIds(),
+	}
+	for _, serverID := range req.GetServerId() {
+		opts.ServerIDs = append(opts.ServerIDs, int(serverID))
+	}
+	for _, eventType := range req.GetEventTypes() {
+		opts.EventTypes = append(opts.EventTypes, logparse.EventType(eventType))
+	}
+	if req.GetFrom() != nil {
+		opts.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		opts.To = req.GetTo().AsTime()
+	}
+
+	logs, count, errLogs := s.servers.QueryLogs(ctx, opts)
+	if errLogs != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Join(errLogs, rpc.ErrInternal))
+	}
+
+	resp := v1.QueryLogsResponse{Count: new(int32(count)), Logs: make([]*v1.ServerLog, len(logs))} //nolint:gosec
+	for idx, log := range logs {
+		resp.Logs[idx] = &v1.ServerLog{
+			ServerLogId: &log.ServerLogID,
+			ServerId:    new(int32(log.ServerID)), //nolint:gosec
+			ServerName:  &log.ServerName,
+			Body:        &log.Body,
+			EventType:   new(int32(log.EventType)), //nolint:gosec
+			CreatedOn:   timestamppb.New(log.CreatedOn),
+		}
+	}
+
+	return &resp, nil
+}
+
+func (s Service) DownloadLog(ctx context.Context, req *v1.DownloadLogRequest) (*v1.DownloadLogResponse, error) {
+	day, errDay := time.Parse(time.DateOnly, req.GetDay())
+	if errDay != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+	}
+
+	logFile, errLog := s.servers.DownloadLog(ctx, req.GetServerId(), day)
+	if errLog != nil {
+		if errors.Is(errLog, ErrNotFound) || errors.Is(errLog, ErrLogNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, errors.Join(errLog, rpc.ErrInternal))
+	}
+
+	return &v1.DownloadLogResponse{Filename: &logFile.Name, Content: logFile.Content, Archived: &logFile.Archived}, nil
+}
+
+func (s Service) LogArchives(ctx context.Context, req *v1.LogArchivesRequest) (*v1.LogArchivesResponse, error) {
+	archives, errArchives := s.servers.LogArchives(ctx, req.GetServerId())
+	if errArchives != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Join(errArchives, rpc.ErrInternal))
+	}
+
+	resp := v1.LogArchivesResponse{Archives: make([]*v1.LogArchive, len(archives))}
+	for idx, archive := range archives {
+		resp.Archives[idx] = &v1.LogArchive{
+			ServerId:  &archive.ServerID,
+			Day:       new(archive.Day.Format(time.DateOnly)),
+			AssetId:   new(archive.AssetID.String()),
+			Lines:     &archive.Lines,
+			CreatedOn: timestamppb.New(archive.CreatedOn),
+		}
+	}
+
+	return &resp, nil
+}
+
+func (s Service) LogPolicies(ctx context.Context, _ *emptypb.Empty) (*v1.LogPoliciesResponse, error) {
+	policies, errPolicies := s.servers.LogPolicies(ctx)
+	if errPolicies != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.Join(errPolicies, rpc.ErrInternal))
+	}
+
+	resp := v1.LogPoliciesResponse{Policies: make([]*v1.LogPolicy, len(policies))}
+	for idx, policy := range policies {
+		resp.Policies[idx] = toRPCLogPolicy(policy)
+	}
+
+	return &resp, nil
+}
+
+func (s Service) SaveLogPolicy(ctx context.Context, req *v1.SaveLogPolicyRequest) (*v1.SaveLogPolicyResponse, error) {
+	policy, errSave := s.servers.SaveLogPolicy(ctx, LogPolicy{
+		ServerID:       req.GetPolicy().GetServerId(),
+		MaxAgeDays:     req.GetPolicy().GetMaxAgeDays(),
+		MaxSizeBytes:   req.GetPolicy().GetMaxSizeBytes(),
+		ArchiveEnabled: req.GetPolicy().GetArchiveEnabled(),
+	})
+	if errSave != nil {
+		if errors.Is(errSave, ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, httphelper.ErrNotFound)
+		}
+
+		return nil, connect.NewError(connect.CodeInternal, errors.Join(errSave, rpc.ErrInternal))
+	}
+
+	return &v1.SaveLogPolicyResponse{Policy: toRPCLogPolicy(policy)}, nil
+}
+
+func toRPCLogPolicy(policy LogPolicy) *v1.LogPolicy {
+	var updatedOn *timestamppb.Timestamp
+	if !policy.UpdatedOn.IsZero() {
+		updatedOn = timestamppb.New(policy.UpdatedOn)
+	}
+
+	return &v1.LogPolicy{
+		ServerId:       &policy.ServerID,
+		MaxAgeDays:     &policy.MaxAgeDays,
+		MaxSizeBytes:   &policy.MaxSizeBytes,
+		ArchiveEnabled: &policy.ArchiveEnabled,
+		UpdatedOn:      updatedOn,
+	}
+}
+
+func (s Service) StreamEvents(ctx context.Context, req *v1.StreamEventsRequest, stream *connect.ServerStream[v1.StreamEventsResponse]) error {
+	filter := StreamFilter{ServerIDs: req.GetServerIds()}
+	for _, eventType := range req.GetEventTypes() {
+		filter.EventTypes = append(filter.EventTypes, logparse.EventType(eventType))
+	}
+
+	subscription := s.servers.Subscribe(filter)
+	defer s.servers.Unsubscribe(subscription)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-subscription.C:
+			if errSend := stream.Send(&v1.StreamEventsResponse{
+				Event:   toRPCServerEvent(event),
+				Dropped: new(subscription.Dropped()),
+			}); errSend != nil {
+				return errSend
+			}
+		}
+	}
+}
+
+func toRPCServerEvent(event logparse.ServerEvent) *v1.ServerEvent {
+	out := &v1.ServerEvent{
+		ServerId:   &event.ServerID,
+		ServerName: &event.ServerName,
+		EventType:  new(int32(event.EventType)), //nolint:gosec
+		CreatedOn:  timestamppb.New(event.CreatedOn),
+		Raw:        &event.Raw,
+	}
+
+	// The parsed events are plain structs, so their json form is used for the generic struct value.
+	body, errMarshal := json.Marshal(event.Event)
+	if errMarshal != nil {
+		return out
+	}
+
+	var values map[string]any
+	if errUnmarshal := json.Unmarshal(body, &values); errUnmarshal != nil {
+		return out
+	}
+
+	if fields, errStruct := structpb.NewStruct(values); errStruct == nil {
+		out.Event = fields
+	}
+
+	return out
+}
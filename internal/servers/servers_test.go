@@ -2,10 +2,15 @@ package servers_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/leighmacdonald/gbans/internal/asset"
+	"github.com/leighmacdonald/gbans/internal/auth/permission"
 	"github.com/leighmacdonald/gbans/internal/servers"
 	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
 	"github.com/leighmacdonald/gbans/pkg/stringutil"
+	"github.com/leighmacdonald/steamid/v4/steamid"
 	"github.com/stretchr/testify/require"
 )
 
@@ -19,7 +24,7 @@ func TestMain(m *testing.M) {
 }
 
 func TestServers(t *testing.T) {
-	serversCase, _ := servers.New(servers.NewRepository(fixture.Database), nil, "")
+	serversCase, _ := servers.New(servers.NewRepository(fixture.Database), nil, nil, steamid.SteamID{}, "")
 
 	t.Run("no servers", func(t *testing.T) {
 		// no results yet
@@ -72,3 +77,154 @@ func TestServers(t *testing.T) {
 		require.NoError(t, errServers4)
 	})
 }
+
+func TestServerLogs(t *testing.T) {
+	repo := servers.NewRepository(fixture.Database)
+	serversCase, _ := servers.New(repo, nil, nil, steamid.SteamID{}, "")
+
+	server, errSave := serversCase.Save(t.Context(),
+		servers.NewServer(stringutil.SecureRandomString(10), stringutil.SecureRandomString(10)+".com", 27015))
+	require.NoError(t, errSave)
+
+	now := time.Now().UTC()
+	lines := []logparse.ServerEvent{
+		{
+			ServerID:  server.ServerID,
+			Raw:       `L 10/17/2026 - 12:00:00: "player<2><[U:1:1001]><Red>" say "hello world"`,
+			Results:   logparse.Results{EventType: logparse.Say},
+			CreatedOn: now,
+		},
+		{
+			ServerID:  server.ServerID,
+			Raw:       `L 10/17/2026 - 12:00:01: "other<3><[U:1:1002]><Blue>" killed "player<2><[U:1:1001]><Red>" with "scattergun"`,
+			Results:   logparse.Results{EventType: logparse.Killed},
+			CreatedOn: now.Add(time.Second),
+		},
+	}
+	require.NoError(t, repo.InsertLogs(t.Context(), lines))
+
+	serverIDs := []int{int(server.ServerID)}
+
+	t.Run("search", func(t *testing.T) {
+		logs, count, errQuery := serversCase.QueryLogs(t.Context(), servers.QueryLogOpts{ServerIDs: serverIDs, Query: "hello"})
+		require.NoError(t, errQuery)
+		require.Equal(t, int64(1), count)
+		require.Equal(t, lines[0].Raw, logs[0].Body)
+
+		killer := steamid.New("[U:1:1002]")
+		logs, _, errQuery = serversCase.QueryLogs(t.Context(), servers.QueryLogOpts{
+			ServerIDs: serverIDs, SteamIDs: []int64{killer.Int64()},
+		})
+		require.NoError(t, errQuery)
+		require.Len(t, logs, 1)
+		require.Equal(t, logparse.Killed, logs[0].EventType)
+
+		_, count, errQuery = serversCase.QueryLogs(t.Context(), servers.QueryLogOpts{
+			ServerIDs: serverIDs, EventTypes: []logparse.EventType{logparse.Say, logparse.Killed}, From: now.Add(-time.Minute),
+		})
+		require.NoError(t, errQuery)
+		require.Equal(t, int64(2), count)
+	})
+
+	t.Run("download", func(t *testing.T) {
+		logFile, errDownload := serversCase.DownloadLog(t.Context(), server.ServerID, now)
+		require.NoError(t, errDownload)
+		require.False(t, logFile.Archived)
+		require.Equal(t, lines[0].Raw+"\n"+lines[1].Raw+"\n", string(logFile.Content))
+
+		_, errMissing := serversCase.DownloadLog(t.Context(), server.ServerID, now.AddDate(0, 0, -2))
+		require.ErrorIs(t, errMissing, servers.ErrLogNotFound)
+	})
+
+	t.Run("policy", func(t *testing.T) {
+		saved, errPolicy := serversCase.SaveLogPolicy(t.Context(), servers.LogPolicy{
+			ServerID: server.ServerID, MaxAgeDays: 7, MaxSizeBytes: 1 << 20, ArchiveEnabled: true,
+		})
+		require.NoError(t, errPolicy)
+
+		policies, errPolicies := serversCase.LogPolicies(t.Context())
+		require.NoError(t, errPolicies)
+
+		for _, policy := range policies {
+			if policy.ServerID == saved.ServerID {
+				require.Equal(t, saved.MaxAgeDays, policy.MaxAgeDays)
+				require.Equal(t, saved.MaxSizeBytes, policy.MaxSizeBytes)
+				require.True(t, policy.ArchiveEnabled)
+			}
+		}
+	})
+}
+
+func TestServerLogArchive(t *testing.T) {
+	fixture.CreateTestPerson(t.Context(), tests.OwnerSID, permission.Admin)
+
+	repo := servers.NewRepository(fixture.Database)
+	assets := asset.NewAssets(asset.NewLocalRepository(fixture.Database, t.TempDir()))
+	serversCase, _ := servers.New(repo, nil, assets, tests.OwnerSID, "")
+
+	server, errSave := serversCase.Save(t.Context(),
+		servers.NewServer(stringutil.SecureRandomString(10), stringutil.SecureRandomString(10)+".com", 27015))
+	require.NoError(t, errSave)
+
+	_, errPolicy := serversCase.SaveLogPolicy(t.Context(), servers.LogPolicy{
+		ServerID: server.ServerID, MaxAgeDays: 7, ArchiveEnabled: true,
+	})
+	require.NoError(t, errPolicy)
+
+	// Only completed days are archived.
+	yesterday := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1).Add(12 * time.Hour)
+	lines := []logparse.ServerEvent{
+		{
+			ServerID:  server.ServerID,
+			Raw:       `L 10/16/2026 - 12:00:00: "player<2><[U:1:1001]><Red>" say "archived"`,
+			Results:   logparse.Results{EventType: logparse.Say},
+			CreatedOn: yesterday,
+		},
+		{
+			ServerID:  server.ServerID,
+			Raw:       `L 10/16/2026 - 12:00:01: "player<2><[U:1:1001]><Red>" say "again"`,
+			Results:   logparse.Results{EventType: logparse.Say},
+			CreatedOn: yesterday.Add(time.Second),
+		},
+	}
+	require.NoError(t, repo.InsertLogs(t.Context(), lines))
+	require.NoError(t, serversCase.ApplyLogRetention(t.Context()))
+
+	archives, errArchives := serversCase.LogArchives(t.Context(), server.ServerID)
+	require.NoError(t, errArchives)
+	require.Len(t, archives, 1)
+	require.Equal(t, int32(2), archives[0].Lines)
+
+	logFile, errDownload := serversCase.DownloadLog(t.Context(), server.ServerID, yesterday)
+	require.NoError(t, errDownload)
+	require.True(t, logFile.Archived)
+	require.Equal(t, lines[0].Raw+"\n"+lines[1].Raw+"\n", string(logFile.Content))
+}
+
+func TestLogPurgeKeepsUnarchived(t *testing.T) {
+	repo := servers.NewRepository(fixture.Database)
+	serversCase, _ := servers.New(repo, nil, asset.NewAssets(asset.NewLocalRepository(fixture.Database, t.TempDir())), tests.OwnerSID, "")
+
+	server, errSave := serversCase.Save(t.Context(),
+		servers.NewServer(stringutil.SecureRandomString(10), stringutil.SecureRandomString(10)+".com", 27015))
+	require.NoError(t, errSave)
+
+	// Any size limit would purge every line if not for archiving.
+	_, errPolicy := serversCase.SaveLogPolicy(t.Context(), servers.LogPolicy{
+		ServerID: server.ServerID, MaxSizeBytes: 1, ArchiveEnabled: true,
+	})
+	require.NoError(t, errPolicy)
+
+	yesterday := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1).Add(12 * time.Hour)
+	require.NoError(t, repo.InsertLogs(t.Context(), []logparse.ServerEvent{
+		{ServerID: server.ServerID, Raw: `L 10/16/2026 - 12:00:00: "player<2><[U:1:1001]><Red>" say "archived"`, CreatedOn: yesterday},
+		{ServerID: server.ServerID, Raw: `L 10/17/2026 - 00:00:01: "player<2><[U:1:1001]><Red>" say "today"`, CreatedOn: time.Now()},
+	}))
+	require.NoError(t, serversCase.ApplyLogRetention(t.Context()))
+
+	// Only the archived day is purged, today is kept until it has been archived.
+	remaining, _, errQuery := repo.QueryLogs(t.Context(), servers.QueryLogOpts{ServerIDs: []int{int(server.ServerID)}})
+	require.NoError(t, errQuery)
+	require.Len(t, remaining, 1)
+	require.Contains(t, remaining[0].Body, "today")
+}
@@ -8,10 +8,12 @@ package serversv1
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
-	v1 "github.com/leighmacdonald/gbans/internal/network/v1"
+	v1 "github.com/leighmacdonald/gbans/internal/database/query/v1"
+	v11 "github.com/leighmacdonald/gbans/internal/network/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -25,28 +27,564 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StreamEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Servers to follow, all servers when empty.
+	ServerIds []int32 `protobuf:"varint,1,rep,packed,name=server_ids,json=serverIds" json:"server_ids,omitempty"`
+	// Log event types to follow, all events when empty.
+	EventTypes    []int32 `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_servers_v1_servers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamEventsRequest) GetServerIds() []int32 {
+	if x != nil {
+		return x.ServerIds
+	}
+	return nil
+}
+
+func (x *StreamEventsRequest) GetEventTypes() []int32 {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type ServerEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ServerId   *int32                 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ServerName *string                `protobuf:"bytes,2,opt,name=server_name,json=serverName" json:"server_name,omitempty"`
+	EventType  *int32                 `protobuf:"varint,3,opt,name=event_type,json=eventType" json:"event_type,omitempty"`
+	CreatedOn  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	Raw        *string                `protobuf:"bytes,5,opt,name=raw" json:"raw,omitempty"`
+	// The parsed event fields
+	Event         *structpb.Struct `protobuf:"bytes,6,opt,name=event" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerEvent) Reset() {
+	*x = ServerEvent{}
+	mi := &file_servers_v1_servers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerEvent) ProtoMessage() {}
+
+func (x *ServerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerEvent.ProtoReflect.Descriptor instead.
+func (*ServerEvent) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServerEvent) GetServerId() int32 {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return 0
+}
+
+func (x *ServerEvent) GetServerName() string {
+	if x != nil && x.ServerName != nil {
+		return *x.ServerName
+	}
+	return ""
+}
+
+func (x *ServerEvent) GetEventType() int32 {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return 0
+}
+
+func (x *ServerEvent) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *ServerEvent) GetRaw() string {
+	if x != nil && x.Raw != nil {
+		return *x.Raw
+	}
+	return ""
+}
+
+func (x *ServerEvent) GetEvent() *structpb.Struct {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type StreamEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *ServerEvent           `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
+	// Events dropped since the previous message because the client was not keeping up.
+	Dropped       *uint64 `protobuf:"varint,2,opt,name=dropped" json:"dropped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsResponse) Reset() {
+	*x = StreamEventsResponse{}
+	mi := &file_servers_v1_servers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsResponse) ProtoMessage() {}
+
+func (x *StreamEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventsResponse) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamEventsResponse) GetEvent() *ServerEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *StreamEventsResponse) GetDropped() uint64 {
+	if x != nil && x.Dropped != nil {
+		return *x.Dropped
+	}
+	return 0
+}
+
 type QueryLogsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ServerId []int32                `protobuf:"varint,1,rep,packed,name=server_id,json=serverId" json:"server_id,omitempty"`
+	Filter   *v1.Filter             `protobuf:"bytes,2,opt,name=filter" json:"filter,omitempty"`
+	// Full text search of the log lines, using websearch syntax.
+	Query      *string `protobuf:"bytes,3,opt,name=query" json:"query,omitempty"`
+	EventTypes []int32 `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes" json:"event_types,omitempty"`
+	// Only include lines referencing any of these players.
+	SteamIds      []int64                `protobuf:"varint,5,rep,packed,name=steam_ids,json=steamIds" json:"steam_ids,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=to" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryLogsRequest) Reset() {
+	*x = QueryLogsRequest{}
+	mi := &file_servers_v1_servers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLogsRequest) ProtoMessage() {}
+
+func (x *QueryLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryLogsRequest.ProtoReflect.Descriptor instead.
+func (*QueryLogsRequest) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryLogsRequest) GetServerId() []int32 {
+	if x != nil {
+		return x.ServerId
+	}
+	return nil
+}
+
+func (x *QueryLogsRequest) GetFilter() *v1.Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *QueryLogsRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+func (x *QueryLogsRequest) GetEventTypes() []int32 {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *QueryLogsRequest) GetSteamIds() []int64 {
+	if x != nil {
+		return x.SteamIds
+	}
+	return nil
+}
+
+func (x *QueryLogsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *QueryLogsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ServerLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServerId      *int32                 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ServerName    *string                `protobuf:"bytes,2,opt,name=server_name,json=serverName" json:"server_name,omitempty"`
+	Body          *string                `protobuf:"bytes,3,opt,name=body" json:"body,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	ServerLogId   *int64                 `protobuf:"varint,5,opt,name=server_log_id,json=serverLogId" json:"server_log_id,omitempty"`
+	EventType     *int32                 `protobuf:"varint,6,opt,name=event_type,json=eventType" json:"event_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerLog) Reset() {
+	*x = ServerLog{}
+	mi := &file_servers_v1_servers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerLog) ProtoMessage() {}
+
+func (x *ServerLog) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerLog.ProtoReflect.Descriptor instead.
+func (*ServerLog) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ServerLog) GetServerId() int32 {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return 0
+}
+
+func (x *ServerLog) GetServerName() string {
+	if x != nil && x.ServerName != nil {
+		return *x.ServerName
+	}
+	return ""
+}
+
+func (x *ServerLog) GetBody() string {
+	if x != nil && x.Body != nil {
+		return *x.Body
+	}
+	return ""
+}
+
+func (x *ServerLog) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *ServerLog) GetServerLogId() int64 {
+	if x != nil && x.ServerLogId != nil {
+		return *x.ServerLogId
+	}
+	return 0
+}
+
+func (x *ServerLog) GetEventType() int32 {
+	if x != nil && x.EventType != nil {
+		return *x.EventType
+	}
+	return 0
+}
+
+type QueryLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*ServerLog           `protobuf:"bytes,1,rep,name=logs" json:"logs,omitempty"`
+	Count         *int32                 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryLogsResponse) Reset() {
+	*x = QueryLogsResponse{}
+	mi := &file_servers_v1_servers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLogsResponse) ProtoMessage() {}
+
+func (x *QueryLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryLogsResponse.ProtoReflect.Descriptor instead.
+func (*QueryLogsResponse) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryLogsResponse) GetLogs() []*ServerLog {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *QueryLogsResponse) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+type DownloadLogRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ServerId *int32                 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	// UTC day to download, formatted as YYYY-MM-DD.
+	Day           *string `protobuf:"bytes,2,opt,name=day" json:"day,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadLogRequest) Reset() {
+	*x = DownloadLogRequest{}
+	mi := &file_servers_v1_servers_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadLogRequest) ProtoMessage() {}
+
+func (x *DownloadLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadLogRequest.ProtoReflect.Descriptor instead.
+func (*DownloadLogRequest) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DownloadLogRequest) GetServerId() int32 {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return 0
+}
+
+func (x *DownloadLogRequest) GetDay() string {
+	if x != nil && x.Day != nil {
+		return *x.Day
+	}
+	return ""
+}
+
+type DownloadLogResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Filename *string                `protobuf:"bytes,1,opt,name=filename" json:"filename,omitempty"`
+	Content  []byte                 `protobuf:"bytes,2,opt,name=content" json:"content,omitempty"`
+	// Whether the log was read from the cold archive instead of the live table.
+	Archived      *bool `protobuf:"varint,3,opt,name=archived" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadLogResponse) Reset() {
+	*x = DownloadLogResponse{}
+	mi := &file_servers_v1_servers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadLogResponse) ProtoMessage() {}
+
+func (x *DownloadLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadLogResponse.ProtoReflect.Descriptor instead.
+func (*DownloadLogResponse) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DownloadLogResponse) GetFilename() string {
+	if x != nil && x.Filename != nil {
+		return *x.Filename
+	}
+	return ""
+}
+
+func (x *DownloadLogResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DownloadLogResponse) GetArchived() bool {
+	if x != nil && x.Archived != nil {
+		return *x.Archived
+	}
+	return false
+}
+
+type LogArchivesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ServerId      []int32                `protobuf:"varint,1,rep,packed,name=server_id,json=serverId" json:"server_id,omitempty"`
+	ServerId      *int32                 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryLogsRequest) Reset() {
-	*x = QueryLogsRequest{}
-	mi := &file_servers_v1_servers_proto_msgTypes[0]
+func (x *LogArchivesRequest) Reset() {
+	*x = LogArchivesRequest{}
+	mi := &file_servers_v1_servers_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryLogsRequest) String() string {
+func (x *LogArchivesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLogsRequest) ProtoMessage() {}
+func (*LogArchivesRequest) ProtoMessage() {}
 
-func (x *QueryLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[0]
+func (x *LogArchivesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,43 +595,44 @@ func (x *QueryLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryLogsRequest.ProtoReflect.Descriptor instead.
-func (*QueryLogsRequest) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use LogArchivesRequest.ProtoReflect.Descriptor instead.
+func (*LogArchivesRequest) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *QueryLogsRequest) GetServerId() []int32 {
-	if x != nil {
-		return x.ServerId
+func (x *LogArchivesRequest) GetServerId() int32 {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
 	}
-	return nil
+	return 0
 }
 
-type ServerLog struct {
+type LogArchive struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      *int32                 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
-	ServerName    *string                `protobuf:"bytes,2,opt,name=server_name,json=serverName" json:"server_name,omitempty"`
-	Body          *string                `protobuf:"bytes,3,opt,name=body" json:"body,omitempty"`
-	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
+	Day           *string                `protobuf:"bytes,2,opt,name=day" json:"day,omitempty"`
+	AssetId       *string                `protobuf:"bytes,3,opt,name=asset_id,json=assetId" json:"asset_id,omitempty"`
+	Lines         *int32                 `protobuf:"varint,4,opt,name=lines" json:"lines,omitempty"`
+	CreatedOn     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_on,json=createdOn" json:"created_on,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServerLog) Reset() {
-	*x = ServerLog{}
-	mi := &file_servers_v1_servers_proto_msgTypes[1]
+func (x *LogArchive) Reset() {
+	*x = LogArchive{}
+	mi := &file_servers_v1_servers_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerLog) String() string {
+func (x *LogArchive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerLog) ProtoMessage() {}
+func (*LogArchive) ProtoMessage() {}
 
-func (x *ServerLog) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[1]
+func (x *LogArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -104,62 +643,68 @@ func (x *ServerLog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerLog.ProtoReflect.Descriptor instead.
-func (*ServerLog) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use LogArchive.ProtoReflect.Descriptor instead.
+func (*LogArchive) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ServerLog) GetServerId() int32 {
+func (x *LogArchive) GetServerId() int32 {
 	if x != nil && x.ServerId != nil {
 		return *x.ServerId
 	}
 	return 0
 }
 
-func (x *ServerLog) GetServerName() string {
-	if x != nil && x.ServerName != nil {
-		return *x.ServerName
+func (x *LogArchive) GetDay() string {
+	if x != nil && x.Day != nil {
+		return *x.Day
 	}
 	return ""
 }
 
-func (x *ServerLog) GetBody() string {
-	if x != nil && x.Body != nil {
-		return *x.Body
+func (x *LogArchive) GetAssetId() string {
+	if x != nil && x.AssetId != nil {
+		return *x.AssetId
 	}
 	return ""
 }
 
-func (x *ServerLog) GetCreatedOn() *timestamppb.Timestamp {
+func (x *LogArchive) GetLines() int32 {
+	if x != nil && x.Lines != nil {
+		return *x.Lines
+	}
+	return 0
+}
+
+func (x *LogArchive) GetCreatedOn() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedOn
 	}
 	return nil
 }
 
-type QueryLogsResponse struct {
+type LogArchivesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logs          []*ServerLog           `protobuf:"bytes,1,rep,name=logs" json:"logs,omitempty"`
-	Count         *int32                 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	Archives      []*LogArchive          `protobuf:"bytes,1,rep,name=archives" json:"archives,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryLogsResponse) Reset() {
-	*x = QueryLogsResponse{}
-	mi := &file_servers_v1_servers_proto_msgTypes[2]
+func (x *LogArchivesResponse) Reset() {
+	*x = LogArchivesResponse{}
+	mi := &file_servers_v1_servers_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryLogsResponse) String() string {
+func (x *LogArchivesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLogsResponse) ProtoMessage() {}
+func (*LogArchivesResponse) ProtoMessage() {}
 
-func (x *QueryLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[2]
+func (x *LogArchivesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,25 +715,230 @@ func (x *QueryLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryLogsResponse.ProtoReflect.Descriptor instead.
-func (*QueryLogsResponse) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use LogArchivesResponse.ProtoReflect.Descriptor instead.
+func (*LogArchivesResponse) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryLogsResponse) GetLogs() []*ServerLog {
+func (x *LogArchivesResponse) GetArchives() []*LogArchive {
 	if x != nil {
-		return x.Logs
+		return x.Archives
 	}
 	return nil
 }
 
-func (x *QueryLogsResponse) GetCount() int32 {
-	if x != nil && x.Count != nil {
-		return *x.Count
+// LogPolicy controls how long the logs of a server are retained.
+type LogPolicy struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ServerId *int32                 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
+	// Delete lines older than this many days, 0 to disable.
+	MaxAgeDays *int32 `protobuf:"varint,2,opt,name=max_age_days,json=maxAgeDays" json:"max_age_days,omitempty"`
+	// Delete the oldest lines once the servers logs exceed this size, 0 to disable.
+	MaxSizeBytes *int64 `protobuf:"varint,3,opt,name=max_size_bytes,json=maxSizeBytes" json:"max_size_bytes,omitempty"`
+	// Write each completed day to a compressed archive before it is removed.
+	ArchiveEnabled *bool                  `protobuf:"varint,4,opt,name=archive_enabled,json=archiveEnabled" json:"archive_enabled,omitempty"`
+	UpdatedOn      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_on,json=updatedOn" json:"updated_on,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LogPolicy) Reset() {
+	*x = LogPolicy{}
+	mi := &file_servers_v1_servers_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogPolicy) ProtoMessage() {}
+
+func (x *LogPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogPolicy.ProtoReflect.Descriptor instead.
+func (*LogPolicy) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LogPolicy) GetServerId() int32 {
+	if x != nil && x.ServerId != nil {
+		return *x.ServerId
+	}
+	return 0
+}
+
+func (x *LogPolicy) GetMaxAgeDays() int32 {
+	if x != nil && x.MaxAgeDays != nil {
+		return *x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *LogPolicy) GetMaxSizeBytes() int64 {
+	if x != nil && x.MaxSizeBytes != nil {
+		return *x.MaxSizeBytes
 	}
 	return 0
 }
 
+func (x *LogPolicy) GetArchiveEnabled() bool {
+	if x != nil && x.ArchiveEnabled != nil {
+		return *x.ArchiveEnabled
+	}
+	return false
+}
+
+func (x *LogPolicy) GetUpdatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedOn
+	}
+	return nil
+}
+
+type LogPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*LogPolicy           `protobuf:"bytes,1,rep,name=policies" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogPoliciesResponse) Reset() {
+	*x = LogPoliciesResponse{}
+	mi := &file_servers_v1_servers_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogPoliciesResponse) ProtoMessage() {}
+
+func (x *LogPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*LogPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LogPoliciesResponse) GetPolicies() []*LogPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type SaveLogPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *LogPolicy             `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveLogPolicyRequest) Reset() {
+	*x = SaveLogPolicyRequest{}
+	mi := &file_servers_v1_servers_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveLogPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLogPolicyRequest) ProtoMessage() {}
+
+func (x *SaveLogPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLogPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SaveLogPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SaveLogPolicyRequest) GetPolicy() *LogPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SaveLogPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *LogPolicy             `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveLogPolicyResponse) Reset() {
+	*x = SaveLogPolicyResponse{}
+	mi := &file_servers_v1_servers_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveLogPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLogPolicyResponse) ProtoMessage() {}
+
+func (x *SaveLogPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_v1_servers_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLogPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SaveLogPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SaveLogPolicyResponse) GetPolicy() *LogPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
 type SafeServer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ServerId      *int32                 `protobuf:"varint,1,opt,name=server_id,json=serverId" json:"server_id,omitempty"`
@@ -204,7 +954,7 @@ type SafeServer struct {
 	Bot           *int32                 `protobuf:"varint,11,opt,name=bot" json:"bot,omitempty"`
 	Map           *string                `protobuf:"bytes,12,opt,name=map" json:"map,omitempty"`
 	GameTypes     []string               `protobuf:"bytes,13,rep,name=game_types,json=gameTypes" json:"game_types,omitempty"`
-	LatLong       *v1.LatLong            `protobuf:"bytes,14,opt,name=lat_long,json=latLong" json:"lat_long,omitempty"`
+	LatLong       *v11.LatLong           `protobuf:"bytes,14,opt,name=lat_long,json=latLong" json:"lat_long,omitempty"`
 	Distance      *float32               `protobuf:"fixed32,15,opt,name=distance" json:"distance,omitempty"`
 	Humans        *int32                 `protobuf:"varint,16,opt,name=humans" json:"humans,omitempty"`
 	Tags          []string               `protobuf:"bytes,17,rep,name=tags" json:"tags,omitempty"`
@@ -215,7 +965,7 @@ type SafeServer struct {
 
 func (x *SafeServer) Reset() {
 	*x = SafeServer{}
-	mi := &file_servers_v1_servers_proto_msgTypes[3]
+	mi := &file_servers_v1_servers_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -227,7 +977,7 @@ func (x *SafeServer) String() string {
 func (*SafeServer) ProtoMessage() {}
 
 func (x *SafeServer) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[3]
+	mi := &file_servers_v1_servers_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +990,7 @@ func (x *SafeServer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SafeServer.ProtoReflect.Descriptor instead.
 func (*SafeServer) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{3}
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SafeServer) GetServerId() int32 {
@@ -334,7 +1084,7 @@ func (x *SafeServer) GetGameTypes() []string {
 	return nil
 }
 
-func (x *SafeServer) GetLatLong() *v1.LatLong {
+func (x *SafeServer) GetLatLong() *v11.LatLong {
 	if x != nil {
 		return x.LatLong
 	}
@@ -384,7 +1134,7 @@ type Server struct {
 	Deleted              *bool                  `protobuf:"varint,11,opt,name=deleted" json:"deleted,omitempty"`
 	Region               *string                `protobuf:"bytes,12,opt,name=region" json:"region,omitempty"`
 	Cc                   *string                `protobuf:"bytes,13,opt,name=cc" json:"cc,omitempty"`
-	LatLong              *v1.LatLong            `protobuf:"bytes,14,opt,name=lat_long,json=latLong" json:"lat_long,omitempty"`
+	LatLong              *v11.LatLong           `protobuf:"bytes,14,opt,name=lat_long,json=latLong" json:"lat_long,omitempty"`
 	LogSecret            *uint32                `protobuf:"varint,15,opt,name=log_secret,json=logSecret" json:"log_secret,omitempty"`
 	EnableStats          *bool                  `protobuf:"varint,16,opt,name=enable_stats,json=enableStats" json:"enable_stats,omitempty"`
 	TokenCreatedOn       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=token_created_on,json=tokenCreatedOn" json:"token_created_on,omitempty"`
@@ -400,7 +1150,7 @@ type Server struct {
 
 func (x *Server) Reset() {
 	*x = Server{}
-	mi := &file_servers_v1_servers_proto_msgTypes[4]
+	mi := &file_servers_v1_servers_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -412,7 +1162,7 @@ func (x *Server) String() string {
 func (*Server) ProtoMessage() {}
 
 func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[4]
+	mi := &file_servers_v1_servers_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -425,7 +1175,7 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Server.ProtoReflect.Descriptor instead.
 func (*Server) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{4}
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Server) GetServerId() int32 {
@@ -519,7 +1269,7 @@ func (x *Server) GetCc() string {
 	return ""
 }
 
-func (x *Server) GetLatLong() *v1.LatLong {
+func (x *Server) GetLatLong() *v11.LatLong {
 	if x != nil {
 		return x.LatLong
 	}
@@ -592,14 +1342,14 @@ func (x *Server) GetStatsBucketId() int32 {
 type StateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Servers       []*SafeServer          `protobuf:"bytes,1,rep,name=servers" json:"servers,omitempty"`
-	LatLong       *v1.LatLong            `protobuf:"bytes,2,opt,name=lat_long,json=latLong" json:"lat_long,omitempty"`
+	LatLong       *v11.LatLong           `protobuf:"bytes,2,opt,name=lat_long,json=latLong" json:"lat_long,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StateResponse) Reset() {
 	*x = StateResponse{}
-	mi := &file_servers_v1_servers_proto_msgTypes[5]
+	mi := &file_servers_v1_servers_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +1361,7 @@ func (x *StateResponse) String() string {
 func (*StateResponse) ProtoMessage() {}
 
 func (x *StateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[5]
+	mi := &file_servers_v1_servers_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +1374,7 @@ func (x *StateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StateResponse.ProtoReflect.Descriptor instead.
 func (*StateResponse) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{5}
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *StateResponse) GetServers() []*SafeServer {
@@ -634,7 +1384,7 @@ func (x *StateResponse) GetServers() []*SafeServer {
 	return nil
 }
 
-func (x *StateResponse) GetLatLong() *v1.LatLong {
+func (x *StateResponse) GetLatLong() *v11.LatLong {
 	if x != nil {
 		return x.LatLong
 	}
@@ -653,7 +1403,7 @@ type ServerInfoSafe struct {
 
 func (x *ServerInfoSafe) Reset() {
 	*x = ServerInfoSafe{}
-	mi := &file_servers_v1_servers_proto_msgTypes[6]
+	mi := &file_servers_v1_servers_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -665,7 +1415,7 @@ func (x *ServerInfoSafe) String() string {
 func (*ServerInfoSafe) ProtoMessage() {}
 
 func (x *ServerInfoSafe) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[6]
+	mi := &file_servers_v1_servers_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,7 +1428,7 @@ func (x *ServerInfoSafe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInfoSafe.ProtoReflect.Descriptor instead.
 func (*ServerInfoSafe) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{6}
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ServerInfoSafe) GetServerNameLong() string {
@@ -718,7 +1468,7 @@ type ServersResponse struct {
 
 func (x *ServersResponse) Reset() {
 	*x = ServersResponse{}
-	mi := &file_servers_v1_servers_proto_msgTypes[7]
+	mi := &file_servers_v1_servers_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -730,7 +1480,7 @@ func (x *ServersResponse) String() string {
 func (*ServersResponse) ProtoMessage() {}
 
 func (x *ServersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[7]
+	mi := &file_servers_v1_servers_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +1493,7 @@ func (x *ServersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServersResponse.ProtoReflect.Descriptor instead.
 func (*ServersResponse) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{7}
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ServersResponse) GetServers() []*ServerInfoSafe {
@@ -762,7 +1512,7 @@ type EditServerRequest struct {
 
 func (x *EditServerRequest) Reset() {
 	*x = EditServerRequest{}
-	mi := &file_servers_v1_servers_proto_msgTypes[8]
+	mi := &file_servers_v1_servers_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -774,7 +1524,7 @@ func (x *EditServerRequest) String() string {
 func (*EditServerRequest) ProtoMessage() {}
 
 func (x *EditServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[8]
+	mi := &file_servers_v1_servers_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +1537,7 @@ func (x *EditServerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditServerRequest.ProtoReflect.Descriptor instead.
 func (*EditServerRequest) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{8}
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EditServerRequest) GetServer() *Server {
@@ -806,7 +1556,7 @@ type EditServerResponse struct {
 
 func (x *EditServerResponse) Reset() {
 	*x = EditServerResponse{}
-	mi := &file_servers_v1_servers_proto_msgTypes[9]
+	mi := &file_servers_v1_servers_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -818,7 +1568,7 @@ func (x *EditServerResponse) String() string {
 func (*EditServerResponse) ProtoMessage() {}
 
 func (x *EditServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[9]
+	mi := &file_servers_v1_servers_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,7 +1581,7 @@ func (x *EditServerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditServerResponse.ProtoReflect.Descriptor instead.
 func (*EditServerResponse) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{9}
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *EditServerResponse) GetServer() *Server {
@@ -850,7 +1600,7 @@ type DeleteServerRequest struct {
 
 func (x *DeleteServerRequest) Reset() {
 	*x = DeleteServerRequest{}
-	mi := &file_servers_v1_servers_proto_msgTypes[10]
+	mi := &file_servers_v1_servers_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +1612,7 @@ func (x *DeleteServerRequest) String() string {
 func (*DeleteServerRequest) ProtoMessage() {}
 
 func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[10]
+	mi := &file_servers_v1_servers_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1625,7 @@ func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteServerRequest.ProtoReflect.Descriptor instead.
 func (*DeleteServerRequest) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{10}
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DeleteServerRequest) GetServerId() int32 {
@@ -894,7 +1644,7 @@ type ServersAdminResponse struct {
 
 func (x *ServersAdminResponse) Reset() {
 	*x = ServersAdminResponse{}
-	mi := &file_servers_v1_servers_proto_msgTypes[11]
+	mi := &file_servers_v1_servers_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +1656,7 @@ func (x *ServersAdminResponse) String() string {
 func (*ServersAdminResponse) ProtoMessage() {}
 
 func (x *ServersAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_servers_v1_servers_proto_msgTypes[11]
+	mi := &file_servers_v1_servers_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1669,7 @@ func (x *ServersAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServersAdminResponse.ProtoReflect.Descriptor instead.
 func (*ServersAdminResponse) Descriptor() ([]byte, []int) {
-	return file_servers_v1_servers_proto_rawDescGZIP(), []int{11}
+	return file_servers_v1_servers_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ServersAdminResponse) GetServers() []*Server {
@@ -934,9 +1684,34 @@ var File_servers_v1_servers_proto protoreflect.FileDescriptor
 const file_servers_v1_servers_proto_rawDesc = "" +
 	"\n" +
 	"\x18servers/v1/servers.proto\x12\n" +
-	"servers.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18network/v1/network.proto\"<\n" +
+	"servers.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1edatabase/query/v1/filter.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18network/v1/network.proto\"q\n" +
+	"\x13StreamEventsRequest\x12+\n" +
+	"\n" +
+	"server_ids\x18\x01 \x03(\x05B\f\xbaH\t\x92\x01\x06\"\x04\x1a\x02 \x00R\tserverIds\x12-\n" +
+	"\vevent_types\x18\x02 \x03(\x05B\f\xbaH\t\x92\x01\x06\"\x04\x1a\x02(\x00R\n" +
+	"eventTypes\"\xe6\x01\n" +
+	"\vServerEvent\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\x05R\bserverId\x12\x1f\n" +
+	"\vserver_name\x18\x02 \x01(\tR\n" +
+	"serverName\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x03 \x01(\x05R\teventType\x129\n" +
+	"\n" +
+	"created_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\x12\x10\n" +
+	"\x03raw\x18\x05 \x01(\tR\x03raw\x12-\n" +
+	"\x05event\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x05event\"c\n" +
+	"\x14StreamEventsResponse\x12-\n" +
+	"\x05event\x18\x01 \x01(\v2\x17.servers.v1.ServerEventR\x05event\x12\x1c\n" +
+	"\adropped\x18\x02 \x01(\x04B\x020\x01R\adropped\"\xb1\x02\n" +
 	"\x10QueryLogsRequest\x12(\n" +
-	"\tserver_id\x18\x01 \x03(\x05B\v\xbaH\b\xc8\x01\x01\x92\x01\x02\b\x01R\bserverId\"\xc4\x01\n" +
+	"\tserver_id\x18\x01 \x03(\x05B\v\xbaH\b\xc8\x01\x01\x92\x01\x02\b\x01R\bserverId\x121\n" +
+	"\x06filter\x18\x02 \x01(\v2\x19.database.query.v1.FilterR\x06filter\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12-\n" +
+	"\vevent_types\x18\x04 \x03(\x05B\f\xbaH\t\x92\x01\x06\"\x04\x1a\x02(\x00R\n" +
+	"eventTypes\x12\x1f\n" +
+	"\tsteam_ids\x18\x05 \x03(\x03B\x020\x01R\bsteamIds\x12.\n" +
+	"\x04from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x8b\x02\n" +
 	"\tServerLog\x12'\n" +
 	"\tserver_id\x18\x01 \x01(\x05B\n" +
 	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\bserverId\x12+\n" +
@@ -946,10 +1721,49 @@ const file_servers_v1_servers_proto_rawDesc = "" +
 	"\x04body\x18\x03 \x01(\tB\n" +
 	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\x04body\x12A\n" +
 	"\n" +
-	"created_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn\"T\n" +
+	"created_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedOn\x12&\n" +
+	"\rserver_log_id\x18\x05 \x01(\x03B\x020\x01R\vserverLogId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x06 \x01(\x05R\teventType\"T\n" +
 	"\x11QueryLogsResponse\x12)\n" +
 	"\x04logs\x18\x01 \x03(\v2\x15.servers.v1.ServerLogR\x04logs\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\"\x8f\x05\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"w\n" +
+	"\x12DownloadLogRequest\x12'\n" +
+	"\tserver_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\bserverId\x128\n" +
+	"\x03day\x18\x02 \x01(\tB&\xbaH#\xc8\x01\x01r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\x03day\"g\n" +
+	"\x13DownloadLogResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x1a\n" +
+	"\barchived\x18\x03 \x01(\bR\barchived\"=\n" +
+	"\x12LogArchivesRequest\x12'\n" +
+	"\tserver_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\bserverId\"\xa7\x01\n" +
+	"\n" +
+	"LogArchive\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\x05R\bserverId\x12\x10\n" +
+	"\x03day\x18\x02 \x01(\tR\x03day\x12\x19\n" +
+	"\basset_id\x18\x03 \x01(\tR\aassetId\x12\x14\n" +
+	"\x05lines\x18\x04 \x01(\x05R\x05lines\x129\n" +
+	"\n" +
+	"created_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedOn\"I\n" +
+	"\x13LogArchivesResponse\x122\n" +
+	"\barchives\x18\x01 \x03(\v2\x16.servers.v1.LogArchiveR\barchives\"\xf4\x01\n" +
+	"\tLogPolicy\x12'\n" +
+	"\tserver_id\x18\x01 \x01(\x05B\n" +
+	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\bserverId\x12)\n" +
+	"\fmax_age_days\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\n" +
+	"maxAgeDays\x12/\n" +
+	"\x0emax_size_bytes\x18\x03 \x01(\x03B\t\xbaH\x04\"\x02(\x000\x01R\fmaxSizeBytes\x12'\n" +
+	"\x0farchive_enabled\x18\x04 \x01(\bR\x0earchiveEnabled\x129\n" +
+	"\n" +
+	"updated_on\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedOn\"H\n" +
+	"\x13LogPoliciesResponse\x121\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x15.servers.v1.LogPolicyR\bpolicies\"M\n" +
+	"\x14SaveLogPolicyRequest\x125\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.servers.v1.LogPolicyB\x06\xbaH\x03\xc8\x01\x01R\x06policy\"F\n" +
+	"\x15SaveLogPolicyResponse\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.servers.v1.LogPolicyR\x06policy\"\x8f\x05\n" +
 	"\n" +
 	"SafeServer\x12'\n" +
 	"\tserver_id\x18\x01 \x01(\x05B\n" +
@@ -1039,7 +1853,7 @@ const file_servers_v1_servers_proto_rawDesc = "" +
 	"\tserver_id\x18\x01 \x01(\x05B\n" +
 	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\bserverId\"L\n" +
 	"\x14ServersAdminResponse\x124\n" +
-	"\aservers\x18\x01 \x03(\v2\x12.servers.v1.ServerB\x06\xbaH\x03\xc8\x01\x01R\aservers2\xb8\x03\n" +
+	"\aservers\x18\x01 \x03(\v2\x12.servers.v1.ServerB\x06\xbaH\x03\xc8\x01\x01R\aservers2\xd5\x06\n" +
 	"\x0eServersService\x12:\n" +
 	"\x05State\x12\x16.google.protobuf.Empty\x1a\x19.servers.v1.StateResponse\x12>\n" +
 	"\aServers\x12\x16.google.protobuf.Empty\x1a\x1b.servers.v1.ServersResponse\x12K\n" +
@@ -1047,7 +1861,12 @@ const file_servers_v1_servers_proto_rawDesc = "" +
 	"EditServer\x12\x1d.servers.v1.EditServerRequest\x1a\x1e.servers.v1.EditServerResponse\x12G\n" +
 	"\fDeleteServer\x12\x1f.servers.v1.DeleteServerRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
 	"\fServersAdmin\x12\x16.google.protobuf.Empty\x1a .servers.v1.ServersAdminResponse\x12J\n" +
-	"\tQueryLogs\x12\x1c.servers.v1.QueryLogsRequest\x1a\x1d.servers.v1.QueryLogsResponse\"\x00B\xa6\x01\n" +
+	"\tQueryLogs\x12\x1c.servers.v1.QueryLogsRequest\x1a\x1d.servers.v1.QueryLogsResponse\"\x00\x12P\n" +
+	"\vDownloadLog\x12\x1e.servers.v1.DownloadLogRequest\x1a\x1f.servers.v1.DownloadLogResponse\"\x00\x12P\n" +
+	"\vLogArchives\x12\x1e.servers.v1.LogArchivesRequest\x1a\x1f.servers.v1.LogArchivesResponse\"\x00\x12H\n" +
+	"\vLogPolicies\x12\x16.google.protobuf.Empty\x1a\x1f.servers.v1.LogPoliciesResponse\"\x00\x12V\n" +
+	"\rSaveLogPolicy\x12 .servers.v1.SaveLogPolicyRequest\x1a!.servers.v1.SaveLogPolicyResponse\"\x00\x12U\n" +
+	"\fStreamEvents\x12\x1f.servers.v1.StreamEventsRequest\x1a .servers.v1.StreamEventsResponse\"\x000\x01B\xa6\x01\n" +
 	"\x0ecom.servers.v1B\fServersProtoP\x01Z=github.com/leighmacdonald/gbans/internal/servers/v1;serversv1\xa2\x02\x03SXX\xaa\x02\n" +
 	"Servers.V1\xca\x02\n" +
 	"Servers\\V1\xe2\x02\x16Servers\\V1\\GPBMetadata\xea\x02\vServers::V1b\beditionsp\xe8\a"
@@ -1064,55 +1883,91 @@ func file_servers_v1_servers_proto_rawDescGZIP() []byte {
 	return file_servers_v1_servers_proto_rawDescData
 }
 
-var file_servers_v1_servers_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_servers_v1_servers_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_servers_v1_servers_proto_goTypes = []any{
-	(*QueryLogsRequest)(nil),      // 0: servers.v1.QueryLogsRequest
-	(*ServerLog)(nil),             // 1: servers.v1.ServerLog
-	(*QueryLogsResponse)(nil),     // 2: servers.v1.QueryLogsResponse
-	(*SafeServer)(nil),            // 3: servers.v1.SafeServer
-	(*Server)(nil),                // 4: servers.v1.Server
-	(*StateResponse)(nil),         // 5: servers.v1.StateResponse
-	(*ServerInfoSafe)(nil),        // 6: servers.v1.ServerInfoSafe
-	(*ServersResponse)(nil),       // 7: servers.v1.ServersResponse
-	(*EditServerRequest)(nil),     // 8: servers.v1.EditServerRequest
-	(*EditServerResponse)(nil),    // 9: servers.v1.EditServerResponse
-	(*DeleteServerRequest)(nil),   // 10: servers.v1.DeleteServerRequest
-	(*ServersAdminResponse)(nil),  // 11: servers.v1.ServersAdminResponse
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(*v1.LatLong)(nil),            // 13: network.v1.LatLong
-	(*emptypb.Empty)(nil),         // 14: google.protobuf.Empty
+	(*StreamEventsRequest)(nil),   // 0: servers.v1.StreamEventsRequest
+	(*ServerEvent)(nil),           // 1: servers.v1.ServerEvent
+	(*StreamEventsResponse)(nil),  // 2: servers.v1.StreamEventsResponse
+	(*QueryLogsRequest)(nil),      // 3: servers.v1.QueryLogsRequest
+	(*ServerLog)(nil),             // 4: servers.v1.ServerLog
+	(*QueryLogsResponse)(nil),     // 5: servers.v1.QueryLogsResponse
+	(*DownloadLogRequest)(nil),    // 6: servers.v1.DownloadLogRequest
+	(*DownloadLogResponse)(nil),   // 7: servers.v1.DownloadLogResponse
+	(*LogArchivesRequest)(nil),    // 8: servers.v1.LogArchivesRequest
+	(*LogArchive)(nil),            // 9: servers.v1.LogArchive
+	(*LogArchivesResponse)(nil),   // 10: servers.v1.LogArchivesResponse
+	(*LogPolicy)(nil),             // 11: servers.v1.LogPolicy
+	(*LogPoliciesResponse)(nil),   // 12: servers.v1.LogPoliciesResponse
+	(*SaveLogPolicyRequest)(nil),  // 13: servers.v1.SaveLogPolicyRequest
+	(*SaveLogPolicyResponse)(nil), // 14: servers.v1.SaveLogPolicyResponse
+	(*SafeServer)(nil),            // 15: servers.v1.SafeServer
+	(*Server)(nil),                // 16: servers.v1.Server
+	(*StateResponse)(nil),         // 17: servers.v1.StateResponse
+	(*ServerInfoSafe)(nil),        // 18: servers.v1.ServerInfoSafe
+	(*ServersResponse)(nil),       // 19: servers.v1.ServersResponse
+	(*EditServerRequest)(nil),     // 20: servers.v1.EditServerRequest
+	(*EditServerResponse)(nil),    // 21: servers.v1.EditServerResponse
+	(*DeleteServerRequest)(nil),   // 22: servers.v1.DeleteServerRequest
+	(*ServersAdminResponse)(nil),  // 23: servers.v1.ServersAdminResponse
+	(*timestamppb.Timestamp)(nil), // 24: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 25: google.protobuf.Struct
+	(*v1.Filter)(nil),             // 26: database.query.v1.Filter
+	(*v11.LatLong)(nil),           // 27: network.v1.LatLong
+	(*emptypb.Empty)(nil),         // 28: google.protobuf.Empty
 }
 var file_servers_v1_servers_proto_depIdxs = []int32{
-	12, // 0: servers.v1.ServerLog.created_on:type_name -> google.protobuf.Timestamp
-	1,  // 1: servers.v1.QueryLogsResponse.logs:type_name -> servers.v1.ServerLog
-	13, // 2: servers.v1.SafeServer.lat_long:type_name -> network.v1.LatLong
-	13, // 3: servers.v1.Server.lat_long:type_name -> network.v1.LatLong
-	12, // 4: servers.v1.Server.token_created_on:type_name -> google.protobuf.Timestamp
-	12, // 5: servers.v1.Server.created_on:type_name -> google.protobuf.Timestamp
-	12, // 6: servers.v1.Server.updated_on:type_name -> google.protobuf.Timestamp
-	3,  // 7: servers.v1.StateResponse.servers:type_name -> servers.v1.SafeServer
-	13, // 8: servers.v1.StateResponse.lat_long:type_name -> network.v1.LatLong
-	6,  // 9: servers.v1.ServersResponse.servers:type_name -> servers.v1.ServerInfoSafe
-	4,  // 10: servers.v1.EditServerRequest.server:type_name -> servers.v1.Server
-	4,  // 11: servers.v1.EditServerResponse.server:type_name -> servers.v1.Server
-	4,  // 12: servers.v1.ServersAdminResponse.servers:type_name -> servers.v1.Server
-	14, // 13: servers.v1.ServersService.State:input_type -> google.protobuf.Empty
-	14, // 14: servers.v1.ServersService.Servers:input_type -> google.protobuf.Empty
-	8,  // 15: servers.v1.ServersService.EditServer:input_type -> servers.v1.EditServerRequest
-	10, // 16: servers.v1.ServersService.DeleteServer:input_type -> servers.v1.DeleteServerRequest
-	14, // 17: servers.v1.ServersService.ServersAdmin:input_type -> google.protobuf.Empty
-	0,  // 18: servers.v1.ServersService.QueryLogs:input_type -> servers.v1.QueryLogsRequest
-	5,  // 19: servers.v1.ServersService.State:output_type -> servers.v1.StateResponse
-	7,  // 20: servers.v1.ServersService.Servers:output_type -> servers.v1.ServersResponse
-	9,  // 21: servers.v1.ServersService.EditServer:output_type -> servers.v1.EditServerResponse
-	14, // 22: servers.v1.ServersService.DeleteServer:output_type -> google.protobuf.Empty
-	11, // 23: servers.v1.ServersService.ServersAdmin:output_type -> servers.v1.ServersAdminResponse
-	2,  // 24: servers.v1.ServersService.QueryLogs:output_type -> servers.v1.QueryLogsResponse
-	19, // [19:25] is the sub-list for method output_type
-	13, // [13:19] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	24, // 0: servers.v1.ServerEvent.created_on:type_name -> google.protobuf.Timestamp
+	25, // 1: servers.v1.ServerEvent.event:type_name -> google.protobuf.Struct
+	1,  // 2: servers.v1.StreamEventsResponse.event:type_name -> servers.v1.ServerEvent
+	26, // 3: servers.v1.QueryLogsRequest.filter:type_name -> database.query.v1.Filter
+	24, // 4: servers.v1.QueryLogsRequest.from:type_name -> google.protobuf.Timestamp
+	24, // 5: servers.v1.QueryLogsRequest.to:type_name -> google.protobuf.Timestamp
+	24, // 6: servers.v1.ServerLog.created_on:type_name -> google.protobuf.Timestamp
+	4,  // 7: servers.v1.QueryLogsResponse.logs:type_name -> servers.v1.ServerLog
+	24, // 8: servers.v1.LogArchive.created_on:type_name -> google.protobuf.Timestamp
+	9,  // 9: servers.v1.LogArchivesResponse.archives:type_name -> servers.v1.LogArchive
+	24, // 10: servers.v1.LogPolicy.updated_on:type_name -> google.protobuf.Timestamp
+	11, // 11: servers.v1.LogPoliciesResponse.policies:type_name -> servers.v1.LogPolicy
+	11, // 12: servers.v1.SaveLogPolicyRequest.policy:type_name -> servers.v1.LogPolicy
+	11, // 13: servers.v1.SaveLogPolicyResponse.policy:type_name -> servers.v1.LogPolicy
+	27, // 14: servers.v1.SafeServer.lat_long:type_name -> network.v1.LatLong
+	27, // 15: servers.v1.Server.lat_long:type_name -> network.v1.LatLong
+	24, // 16: servers.v1.Server.token_created_on:type_name -> google.protobuf.Timestamp
+	24, // 17: servers.v1.Server.created_on:type_name -> google.protobuf.Timestamp
+	24, // 18: servers.v1.Server.updated_on:type_name -> google.protobuf.Timestamp
+	15, // 19: servers.v1.StateResponse.servers:type_name -> servers.v1.SafeServer
+	27, // 20: servers.v1.StateResponse.lat_long:type_name -> network.v1.LatLong
+	18, // 21: servers.v1.ServersResponse.servers:type_name -> servers.v1.ServerInfoSafe
+	16, // 22: servers.v1.EditServerRequest.server:type_name -> servers.v1.Server
+	16, // 23: servers.v1.EditServerResponse.server:type_name -> servers.v1.Server
+	16, // 24: servers.v1.ServersAdminResponse.servers:type_name -> servers.v1.Server
+	28, // 25: servers.v1.ServersService.State:input_type -> google.protobuf.Empty
+	28, // 26: servers.v1.ServersService.Servers:input_type -> google.protobuf.Empty
+	20, // 27: servers.v1.ServersService.EditServer:input_type -> servers.v1.EditServerRequest
+	22, // 28: servers.v1.ServersService.DeleteServer:input_type -> servers.v1.DeleteServerRequest
+	28, // 29: servers.v1.ServersService.ServersAdmin:input_type -> google.protobuf.Empty
+	3,  // 30: servers.v1.ServersService.QueryLogs:input_type -> servers.v1.QueryLogsRequest
+	6,  // 31: servers.v1.ServersService.DownloadLog:input_type -> servers.v1.DownloadLogRequest
+	8,  // 32: servers.v1.ServersService.LogArchives:input_type -> servers.v1.LogArchivesRequest
+	28, // 33: servers.v1.ServersService.LogPolicies:input_type -> google.protobuf.Empty
+	13, // 34: servers.v1.ServersService.SaveLogPolicy:input_type -> servers.v1.SaveLogPolicyRequest
+	0,  // 35: servers.v1.ServersService.StreamEvents:input_type -> servers.v1.StreamEventsRequest
+	17, // 36: servers.v1.ServersService.State:output_type -> servers.v1.StateResponse
+	19, // 37: servers.v1.ServersService.Servers:output_type -> servers.v1.ServersResponse
+	21, // 38: servers.v1.ServersService.EditServer:output_type -> servers.v1.EditServerResponse
+	28, // 39: servers.v1.ServersService.DeleteServer:output_type -> google.protobuf.Empty
+	23, // 40: servers.v1.ServersService.ServersAdmin:output_type -> servers.v1.ServersAdminResponse
+	5,  // 41: servers.v1.ServersService.QueryLogs:output_type -> servers.v1.QueryLogsResponse
+	7,  // 42: servers.v1.ServersService.DownloadLog:output_type -> servers.v1.DownloadLogResponse
+	10, // 43: servers.v1.ServersService.LogArchives:output_type -> servers.v1.LogArchivesResponse
+	12, // 44: servers.v1.ServersService.LogPolicies:output_type -> servers.v1.LogPoliciesResponse
+	14, // 45: servers.v1.ServersService.SaveLogPolicy:output_type -> servers.v1.SaveLogPolicyResponse
+	2,  // 46: servers.v1.ServersService.StreamEvents:output_type -> servers.v1.StreamEventsResponse
+	36, // [36:47] is the sub-list for method output_type
+	25, // [25:36] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_servers_v1_servers_proto_init() }
@@ -1126,7 +1981,7 @@ func file_servers_v1_servers_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_servers_v1_servers_proto_rawDesc), len(file_servers_v1_servers_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -50,6 +50,21 @@ const (
 	// ServersServiceQueryLogsProcedure is the fully-qualified name of the ServersService's QueryLogs
 	// RPC.
 	ServersServiceQueryLogsProcedure = "/servers.v1.ServersService/QueryLogs"
+	// ServersServiceDownloadLogProcedure is the fully-qualified name of the ServersService's
+	// DownloadLog RPC.
+	ServersServiceDownloadLogProcedure = "/servers.v1.ServersService/DownloadLog"
+	// ServersServiceLogArchivesProcedure is the fully-qualified name of the ServersService's
+	// LogArchives RPC.
+	ServersServiceLogArchivesProcedure = "/servers.v1.ServersService/LogArchives"
+	// ServersServiceLogPoliciesProcedure is the fully-qualified name of the ServersService's
+	// LogPolicies RPC.
+	ServersServiceLogPoliciesProcedure = "/servers.v1.ServersService/LogPolicies"
+	// ServersServiceSaveLogPolicyProcedure is the fully-qualified name of the ServersService's
+	// SaveLogPolicy RPC.
+	ServersServiceSaveLogPolicyProcedure = "/servers.v1.ServersService/SaveLogPolicy"
+	// ServersServiceStreamEventsProcedure is the fully-qualified name of the ServersService's
+	// StreamEvents RPC.
+	ServersServiceStreamEventsProcedure = "/servers.v1.ServersService/StreamEvents"
 )
 
 // ServersServiceClient is a client for the servers.v1.ServersService service.
@@ -60,6 +75,13 @@ type ServersServiceClient interface {
 	DeleteServer(context.Context, *v1.DeleteServerRequest) (*emptypb.Empty, error)
 	ServersAdmin(context.Context, *emptypb.Empty) (*v1.ServersAdminResponse, error)
 	QueryLogs(context.Context, *v1.QueryLogsRequest) (*v1.QueryLogsResponse, error)
+	// DownloadLog returns a full day of a servers log in the standard srcds log format.
+	DownloadLog(context.Context, *v1.DownloadLogRequest) (*v1.DownloadLogResponse, error)
+	LogArchives(context.Context, *v1.LogArchivesRequest) (*v1.LogArchivesResponse, error)
+	LogPolicies(context.Context, *emptypb.Empty) (*v1.LogPoliciesResponse, error)
+	SaveLogPolicy(context.Context, *v1.SaveLogPolicyRequest) (*v1.SaveLogPolicyResponse, error)
+	// StreamEvents follows the parsed log events of the servers as they are received.
+	StreamEvents(context.Context, *v1.StreamEventsRequest) (*connect.ServerStreamForClient[v1.StreamEventsResponse], error)
 }
 
 // NewServersServiceClient constructs a client for the servers.v1.ServersService service. By
@@ -109,17 +131,52 @@ func NewServersServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(serversServiceMethods.ByName("QueryLogs")),
 			connect.WithClientOptions(opts...),
 		),
+		downloadLog: connect.NewClient[v1.DownloadLogRequest, v1.DownloadLogResponse](
+			httpClient,
+			baseURL+ServersServiceDownloadLogProcedure,
+			connect.WithSchema(serversServiceMethods.ByName("DownloadLog")),
+			connect.WithClientOptions(opts...),
+		),
+		logArchives: connect.NewClient[v1.LogArchivesRequest, v1.LogArchivesResponse](
+			httpClient,
+			baseURL+ServersServiceLogArchivesProcedure,
+			connect.WithSchema(serversServiceMethods.ByName("LogArchives")),
+			connect.WithClientOptions(opts...),
+		),
+		logPolicies: connect.NewClient[emptypb.Empty, v1.LogPoliciesResponse](
+			httpClient,
+			baseURL+ServersServiceLogPoliciesProcedure,
+			connect.WithSchema(serversServiceMethods.ByName("LogPolicies")),
+			connect.WithClientOptions(opts...),
+		),
+		saveLogPolicy: connect.NewClient[v1.SaveLogPolicyRequest, v1.SaveLogPolicyResponse](
+			httpClient,
+			baseURL+ServersServiceSaveLogPolicyProcedure,
+			connect.WithSchema(serversServiceMethods.ByName("SaveLogPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		streamEvents: connect.NewClient[v1.StreamEventsRequest, v1.StreamEventsResponse](
+			httpClient,
+			baseURL+ServersServiceStreamEventsProcedure,
+			connect.WithSchema(serversServiceMethods.ByName("StreamEvents")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // serversServiceClient implements ServersServiceClient.
 type serversServiceClient struct {
-	state        *connect.Client[emptypb.Empty, v1.StateResponse]
-	servers      *connect.Client[emptypb.Empty, v1.ServersResponse]
-	editServer   *connect.Client[v1.EditServerRequest, v1.EditServerResponse]
-	deleteServer *connect.Client[v1.DeleteServerRequest, emptypb.Empty]
-	serversAdmin *connect.Client[emptypb.Empty, v1.ServersAdminResponse]
-	queryLogs    *connect.Client[v1.QueryLogsRequest, v1.QueryLogsResponse]
+	state         *connect.Client[emptypb.Empty, v1.StateResponse]
+	servers       *connect.Client[emptypb.Empty, v1.ServersResponse]
+	editServer    *connect.Client[v1.EditServerRequest, v1.EditServerResponse]
+	deleteServer  *connect.Client[v1.DeleteServerRequest, emptypb.Empty]
+	serversAdmin  *connect.Client[emptypb.Empty, v1.ServersAdminResponse]
+	queryLogs     *connect.Client[v1.QueryLogsRequest, v1.QueryLogsResponse]
+	downloadLog   *connect.Client[v1.DownloadLogRequest, v1.DownloadLogResponse]
+	logArchives   *connect.Client[v1.LogArchivesRequest, v1.LogArchivesResponse]
+	logPolicies   *connect.Client[emptypb.Empty, v1.LogPoliciesResponse]
+	saveLogPolicy *connect.Client[v1.SaveLogPolicyRequest, v1.SaveLogPolicyResponse]
+	streamEvents  *connect.Client[v1.StreamEventsRequest, v1.StreamEventsResponse]
 }
 
 // State calls servers.v1.ServersService.State.
@@ -176,6 +233,47 @@ func (c *serversServiceClient) QueryLogs(ctx context.Context, req *v1.QueryLogsR
 	return nil, err
 }
 
+// DownloadLog calls servers.v1.ServersService.DownloadLog.
+func (c *serversServiceClient) DownloadLog(ctx context.Context, req *v1.DownloadLogRequest) (*v1.DownloadLogResponse, error) {
+	response, err := c.downloadLog.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// LogArchives calls servers.v1.ServersService.LogArchives.
+func (c *serversServiceClient) LogArchives(ctx context.Context, req *v1.LogArchivesRequest) (*v1.LogArchivesResponse, error) {
+	response, err := c.logArchives.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// LogPolicies calls servers.v1.ServersService.LogPolicies.
+func (c *serversServiceClient) LogPolicies(ctx context.Context, req *emptypb.Empty) (*v1.LogPoliciesResponse, error) {
+	response, err := c.logPolicies.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SaveLogPolicy calls servers.v1.ServersService.SaveLogPolicy.
+func (c *serversServiceClient) SaveLogPolicy(ctx context.Context, req *v1.SaveLogPolicyRequest) (*v1.SaveLogPolicyResponse, error) {
+	response, err := c.saveLogPolicy.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// StreamEvents calls servers.v1.ServersService.StreamEvents.
+func (c *serversServiceClient) StreamEvents(ctx context.Context, req *v1.StreamEventsRequest) (*connect.ServerStreamForClient[v1.StreamEventsResponse], error) {
+	return c.streamEvents.CallServerStream(ctx, connect.NewRequest(req))
+}
+
 // ServersServiceHandler is an implementation of the servers.v1.ServersService service.
 type ServersServiceHandler interface {
 	State(context.Context, *emptypb.Empty) (*v1.StateResponse, error)
@@ -184,6 +282,13 @@ type ServersServiceHandler interface {
 	DeleteServer(context.Context, *v1.DeleteServerRequest) (*emptypb.Empty, error)
 	ServersAdmin(context.Context, *emptypb.Empty) (*v1.ServersAdminResponse, error)
 	QueryLogs(context.Context, *v1.QueryLogsRequest) (*v1.QueryLogsResponse, error)
+	// DownloadLog returns a full day of a servers log in the standard srcds log format.
+	DownloadLog(context.Context, *v1.DownloadLogRequest) (*v1.DownloadLogResponse, error)
+	LogArchives(context.Context, *v1.LogArchivesRequest) (*v1.LogArchivesResponse, error)
+	LogPolicies(context.Context, *emptypb.Empty) (*v1.LogPoliciesResponse, error)
+	SaveLogPolicy(context.Context, *v1.SaveLogPolicyRequest) (*v1.SaveLogPolicyResponse, error)
+	// StreamEvents follows the parsed log events of the servers as they are received.
+	StreamEvents(context.Context, *v1.StreamEventsRequest, *connect.ServerStream[v1.StreamEventsResponse]) error
 }
 
 // NewServersServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -229,6 +334,36 @@ func NewServersServiceHandler(svc ServersServiceHandler, opts ...connect.Handler
 		connect.WithSchema(serversServiceMethods.ByName("QueryLogs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serversServiceDownloadLogHandler := connect.NewUnaryHandlerSimple(
+		ServersServiceDownloadLogProcedure,
+		svc.DownloadLog,
+		connect.WithSchema(serversServiceMethods.ByName("DownloadLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serversServiceLogArchivesHandler := connect.NewUnaryHandlerSimple(
+		ServersServiceLogArchivesProcedure,
+		svc.LogArchives,
+		connect.WithSchema(serversServiceMethods.ByName("LogArchives")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serversServiceLogPoliciesHandler := connect.NewUnaryHandlerSimple(
+		ServersServiceLogPoliciesProcedure,
+		svc.LogPolicies,
+		connect.WithSchema(serversServiceMethods.ByName("LogPolicies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serversServiceSaveLogPolicyHandler := connect.NewUnaryHandlerSimple(
+		ServersServiceSaveLogPolicyProcedure,
+		svc.SaveLogPolicy,
+		connect.WithSchema(serversServiceMethods.ByName("SaveLogPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serversServiceStreamEventsHandler := connect.NewServerStreamHandlerSimple(
+		ServersServiceStreamEventsProcedure,
+		svc.StreamEvents,
+		connect.WithSchema(serversServiceMethods.ByName("StreamEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/servers.v1.ServersService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServersServiceStateProcedure:
@@ -243,6 +378,16 @@ func NewServersServiceHandler(svc ServersServiceHandler, opts ...connect.Handler
 			serversServiceServersAdminHandler.ServeHTTP(w, r)
 		case ServersServiceQueryLogsProcedure:
 			serversServiceQueryLogsHandler.ServeHTTP(w, r)
+		case ServersServiceDownloadLogProcedure:
+			serversServiceDownloadLogHandler.ServeHTTP(w, r)
+		case ServersServiceLogArchivesProcedure:
+			serversServiceLogArchivesHandler.ServeHTTP(w, r)
+		case ServersServiceLogPoliciesProcedure:
+			serversServiceLogPoliciesHandler.ServeHTTP(w, r)
+		case ServersServiceSaveLogPolicyProcedure:
+			serversServiceSaveLogPolicyHandler.ServeHTTP(w, r)
+		case ServersServiceStreamEventsProcedure:
+			serversServiceStreamEventsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -275,3 +420,23 @@ func (UnimplementedServersServiceHandler) ServersAdmin(context.Context, *emptypb
 func (UnimplementedServersServiceHandler) QueryLogs(context.Context, *v1.QueryLogsRequest) (*v1.QueryLogsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("servers.v1.ServersService.QueryLogs is not implemented"))
 }
+
+func (UnimplementedServersServiceHandler) DownloadLog(context.Context, *v1.DownloadLogRequest) (*v1.DownloadLogResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("servers.v1.ServersService.DownloadLog is not implemented"))
+}
+
+func (UnimplementedServersServiceHandler) LogArchives(context.Context, *v1.LogArchivesRequest) (*v1.LogArchivesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("servers.v1.ServersService.LogArchives is not implemented"))
+}
+
+func (UnimplementedServersServiceHandler) LogPolicies(context.Context, *emptypb.Empty) (*v1.LogPoliciesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("servers.v1.ServersService.LogPolicies is not implemented"))
+}
+
+func (UnimplementedServersServiceHandler) SaveLogPolicy(context.Context, *v1.SaveLogPolicyRequest) (*v1.SaveLogPolicyResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("servers.v1.ServersService.SaveLogPolicy is not implemented"))
+}
+
+func (UnimplementedServersServiceHandler) StreamEvents(context.Context, *v1.StreamEventsRequest, *connect.ServerStream[v1.StreamEventsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("servers.v1.ServersService.StreamEvents is not implemented"))
+}
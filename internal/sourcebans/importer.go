@@ -0,0 +1,463 @@
+package sourcebans
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban"
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/gbans/internal/sourcemod"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// Reader provides the records of a SourceBans database.
+type Reader interface {
+	Bans(ctx context.Context) ([]Record, error)
+	Comms(ctx context.Context) ([]Record, error)
+	Admins(ctx context.Context) ([]Admin, error)
+	Groups(ctx context.Context) ([]Group, error)
+	Overrides(ctx context.Context) ([]Override, error)
+	GroupOverrides(ctx context.Context) ([]GroupOverride, error)
+}
+
+type BanImporter interface {
+	Import(ctx context.Context, ban *ban.Ban, dryRun bool) (bool, error)
+}
+
+type PersonProvider interface {
+	EnsurePerson(ctx context.Context, steamID steamid.SteamID) error
+}
+
+// AdminStore is the subset of sourcemod.Sourcemod used to import admins, groups and overrides.
+type AdminStore interface {
+	Groups(ctx context.Context) ([]sourcemod.Groups, error)
+	AddGroup(ctx context.Context, name string, flags string, immunityLevel int32) (sourcemod.Groups, error)
+	GroupOverrides(ctx context.Context, groupID int32) ([]sourcemod.GroupOverrides, error)
+	AddGroupOverride(ctx context.Context, groupID int32, name string, overrideType sourcemod.OverrideType, access sourcemod.OverrideAccess) (sourcemod.GroupOverrides, error)
+	Overrides(ctx context.Context) ([]sourcemod.Overrides, error)
+	AddOverride(ctx context.Context, name string, overrideType sourcemod.OverrideType, flags string) (sourcemod.Overrides, error)
+	Admins(ctx context.Context) ([]sourcemod.Admin, error)
+	AddAdmin(ctx context.Context, alias string, authType sourcemod.AuthType, identity string, flags string, immunity int32, password string) (sourcemod.Admin, error)
+	AddAdminGroup(ctx context.Context, adminID int32, groupID int32) (sourcemod.Admin, error)
+}
+
+// Counts tracks the outcome of importing a single kind of record.
+type Counts struct {
+	Imported int
+	// Skipped records already exist or have no usable SteamID.
+	Skipped int
+	// IPOnly are IP bans without a SteamID. Bans always target a player, so these are not imported and must be
+	// recreated as CIDR bans against a player, or blocklist entries, by hand.
+	IPOnly int
+	Failed int
+}
+
+func (c Counts) String() string {
+	return fmt.Sprintf("imported: %d skipped: %d ip only: %d failed: %d", c.Imported, c.Skipped, c.IPOnly, c.Failed)
+}
+
+type Result struct {
+	Groups         Counts
+	GroupOverrides Counts
+	Overrides      Counts
+	Admins         Counts
+	Bans           Counts
+	Comms          Counts
+}
+
+type Importer struct {
+	source  Reader
+	bans    BanImporter
+	persons PersonProvider
+	admins  AdminStore
+	// owner is used as the author for bans without a known admin, e.g. those issued via the console.
+	owner steamid.SteamID
+}
+
+func NewImporter(source Reader, bans BanImporter, persons PersonProvider, admins AdminStore, owner steamid.SteamID) Importer {
+	return Importer{source: source, bans: bans, persons: persons, admins: admins, owner: owner}
+}
+
+// Import copies everything from the source into gbans. Records which already exist are skipped so the import
+// can safely be run multiple times. When dryRun is set, nothing is written and the result reflects what
+// would have been imported.
+func (i Importer) Import(ctx context.Context, dryRun bool) (Result, error) {
+	var result Result
+
+	groupIDs, errGroups := i.importGroups(ctx, dryRun, &result)
+	if errGroups != nil {
+		return result, errGroups
+	}
+
+	if err := i.importOverrides(ctx, dryRun, groupIDs, &result); err != nil {
+		return result, err
+	}
+
+	authors, errAdmins := i.importAdmins(ctx, dryRun, &result)
+	if errAdmins != nil {
+		return result, errAdmins
+	}
+
+	banRecords, errBans := i.source.Bans(ctx)
+	if errBans != nil {
+		return result, errBans
+	}
+
+	commRecords, errComms := i.source.Comms(ctx)
+	if errComms != nil {
+		return result, errComms
+	}
+
+	now := time.Now()
+	i.importBans(ctx, dryRun, toBans(banRecords, false, authors, i.owner, now), &result.Bans)
+	i.importBans(ctx, dryRun, toBans(commRecords, true, authors, i.owner, now), &result.Comms)
+
+	return result, nil
+}
+
+// importGroups returns a mapping of the source group ids to the gbans group ids. Groups are matched by name.
+func (i Importer) importGroups(ctx context.Context, dryRun bool, result *Result) (map[int32]sourcemod.Groups, error) {
+	groups, errGroups := i.source.Groups(ctx)
+	if errGroups != nil {
+		return nil, errGroups
+	}
+
+	existing, errExisting := i.admins.Groups(ctx)
+	if errExisting != nil {
+		return nil, errExisting
+	}
+
+	groupIDs := map[int32]sourcemod.Groups{}
+	for _, group := range groups {
+		if idx := slices.IndexFunc(existing, func(g sourcemod.Groups) bool { return g.Name == group.Name }); idx >= 0 {
+			groupIDs[group.GroupID] = existing[idx]
+			result.Groups.Skipped++
+
+			continue
+		}
+
+		if dryRun {
+			groupIDs[group.GroupID] = sourcemod.Groups{Name: group.Name, Flags: group.Flags, ImmunityLevel: group.Immunity}
+			result.Groups.Imported++
+
+			continue
+		}
+
+		added, errAdd := i.admins.AddGroup(ctx, group.Name, group.Flags, group.Immunity)
+		if errAdd != nil {
+			slog.Error("Failed to import group", slog.String("name", group.Name), slog.String("error", errAdd.Error()))
+			result.Groups.Failed++
+
+			continue
+		}
+
+		existing = append(existing, added)
+		groupIDs[group.GroupID] = added
+		result.Groups.Imported++
+	}
+
+	return groupIDs, nil
+}
+
+func (i Importer) importOverrides(ctx context.Context, dryRun bool, groupIDs map[int32]sourcemod.Groups, result *Result) error {
+	overrides, errOverrides := i.source.Overrides(ctx)
+	if errOverrides != nil {
+		return errOverrides
+	}
+
+	existing, errExisting := i.admins.Overrides(ctx)
+	if errExisting != nil {
+		return errExisting
+	}
+
+	for _, override := range overrides {
+		overrideType := sourcemod.OverrideType(override.Type)
+		if slices.ContainsFunc(existing, func(o sourcemod.Overrides) bool {
+			return o.Type == overrideType && o.Name == override.Name
+		}) {
+			result.Overrides.Skipped++
+
+			continue
+		}
+
+		if !dryRun {
+			if _, errAdd := i.admins.AddOverride(ctx, override.Name, overrideType, override.Flags); errAdd != nil {
+				slog.Error("Failed to import override", slog.String("name", override.Name), slog.String("error", errAdd.Error()))
+				result.Overrides.Failed++
+
+				continue
+			}
+		}
+
+		result.Overrides.Imported++
+	}
+
+	groupOverrides, errGroupOverrides := i.source.GroupOverrides(ctx)
+	if errGroupOverrides != nil {
+		return errGroupOverrides
+	}
+
+	existingGroupOverrides := map[int32][]sourcemod.GroupOverrides{}
+	for _, override := range groupOverrides {
+		group, found := groupIDs[override.GroupID]
+		if !found {
+			result.GroupOverrides.Skipped++
+
+			continue
+		}
+
+		// Groups that only exist as part of a dry run have no overrides yet.
+		if _, loaded := existingGroupOverrides[group.GroupID]; !loaded && group.GroupID > 0 {
+			current, errCurrent := i.admins.GroupOverrides(ctx, group.GroupID)
+			if errCurrent != nil {
+				return errCurrent
+			}
+
+			existingGroupOverrides[group.GroupID] = current
+		}
+
+		overrideType := sourcemod.OverrideType(override.Type)
+		if slices.ContainsFunc(existingGroupOverrides[group.GroupID], func(o sourcemod.GroupOverrides) bool {
+			return group.GroupID > 0 && o.Type == overrideType && o.Name == override.Name
+		}) {
+			result.GroupOverrides.Skipped++
+
+			continue
+		}
+
+		if !dryRun {
+			if _, errAdd := i.admins.AddGroupOverride(ctx, group.GroupID, override.Name, overrideType,
+				sourcemod.OverrideAccess(override.Access)); errAdd != nil {
+				slog.Error("Failed to import group override", slog.String("name", override.Name), slog.String("error", errAdd.Error()))
+				result.GroupOverrides.Failed++
+
+				continue
+			}
+		}
+
+		result.GroupOverrides.Imported++
+	}
+
+	return nil
+}
+
+// importAdmins imports admins with a valid SteamID and returns a mapping of every source admin id to its
+// SteamID so that the original authors of bans are kept.
+func (i Importer) importAdmins(ctx context.Context, dryRun bool, result *Result) (map[int32]steamid.SteamID, error) {
+	admins, errAdmins := i.source.Admins(ctx)
+	if errAdmins != nil {
+		return nil, errAdmins
+	}
+
+	existing, errExisting := i.admins.Admins(ctx)
+	if errExisting != nil {
+		return nil, errExisting
+	}
+
+	groups, errGroups := i.admins.Groups(ctx)
+	if errGroups != nil {
+		return nil, errGroups
+	}
+
+	authors := map[int32]steamid.SteamID{}
+	for _, admin := range admins {
+		steamID := steamid.New(admin.AuthID)
+		if !steamID.Valid() {
+			result.Admins.Skipped++
+
+			continue
+		}
+
+		authors[admin.AdminID] = steamID
+
+		if slices.ContainsFunc(existing, func(a sourcemod.Admin) bool { return a.SteamID.Equal(steamID) }) {
+			result.Admins.Skipped++
+
+			continue
+		}
+
+		if dryRun {
+			existing = append(existing, sourcemod.Admin{SteamID: steamID})
+			result.Admins.Imported++
+
+			continue
+		}
+
+		added, errAdd := i.admins.AddAdmin(ctx, admin.User, sourcemod.AuthTypeSteam, steamID.String(), admin.Flags,
+			admin.Immunity, admin.Password)
+		if errAdd != nil {
+			slog.Error("Failed to import admin", slog.String("user", admin.User), slog.String("error", errAdd.Error()))
+			result.Admins.Failed++
+
+			continue
+		}
+
+		existing = append(existing, added)
+		result.Admins.Imported++
+
+		if admin.Group == "" {
+			continue
+		}
+
+		if idx := slices.IndexFunc(groups, func(g sourcemod.Groups) bool { return g.Name == admin.Group }); idx >= 0 {
+			if _, errGroup := i.admins.AddAdminGroup(ctx, added.AdminID, groups[idx].GroupID); errGroup != nil {
+				slog.Error("Failed to add imported admin group", slog.String("user", admin.User),
+					slog.String("group", admin.Group), slog.String("error", errGroup.Error()))
+			}
+		}
+	}
+
+	return authors, nil
+}
+
+func (i Importer) importBans(ctx context.Context, dryRun bool, bans []ban.Ban, counts *Counts) {
+	for _, imported := range bans {
+		if !imported.TargetID.Valid() {
+			if imported.CIDR != nil {
+				slog.Warn("Not importing IP only SourceBans ban", slog.String("cidr", *imported.CIDR),
+					slog.String("note", imported.Note))
+				counts.IPOnly++
+			} else {
+				counts.Skipped++
+			}
+
+			continue
+		}
+
+		if !dryRun {
+			if err := errors.Join(i.persons.EnsurePerson(ctx, imported.TargetID), i.persons.EnsurePerson(ctx, imported.SourceID)); err != nil {
+				slog.Error("Failed to create imported ban person", slog.String("error", err.Error()))
+				counts.Failed++
+
+				continue
+			}
+		}
+
+		added, errImport := i.bans.Import(ctx, &imported, dryRun)
+		if errImport != nil {
+			slog.Error("Failed to import ban", slog.String("steam_id", imported.TargetID.String()),
+				slog.String("error", errImport.Error()))
+			counts.Failed++
+
+			continue
+		}
+
+		if !added {
+			counts.Skipped++
+
+			continue
+		}
+
+		counts.Imported++
+	}
+}
+
+// toBans converts the records into bans, deduplicating by SteamID so that each player has at most one active
+// ban. The remaining active records are imported as deleted. Records without a valid SteamID are returned with
+// an invalid TargetID so that they are counted as skipped, or as IP only when they carry a CIDR.
+func toBans(records []Record, comms bool, authors map[int32]steamid.SteamID, owner steamid.SteamID, now time.Time) []ban.Ban {
+	bans := make([]ban.Ban, len(records))
+	for idx, record := range records {
+		bans[idx] = toBan(record, comms, authors, owner, now)
+	}
+
+	// Active bans first, longest first, so that the ban kept active per player is the one lasting the longest.
+	slices.SortStableFunc(bans, func(a, b ban.Ban) int {
+		if a.Deleted != b.Deleted {
+			if a.Deleted {
+				return 1
+			}
+
+			return -1
+		}
+
+		return b.ValidUntil.Compare(a.ValidUntil)
+	})
+
+	active := map[steamid.SteamID]bool{}
+	for idx := range bans {
+		if bans[idx].Deleted || !bans[idx].TargetID.Valid() {
+			continue
+		}
+
+		if active[bans[idx].TargetID] {
+			bans[idx].Deleted = true
+			bans[idx].UnbanReasonText = "Superseded by a longer SourceBans record"
+
+			continue
+		}
+
+		active[bans[idx].TargetID] = true
+	}
+
+	// Restore the original ordering so history is inserted oldest first.
+	slices.SortStableFunc(bans, func(a, b ban.Ban) int {
+		return a.CreatedOn.Compare(b.CreatedOn)
+	})
+
+	return bans
+}
+
+func toBan(record Record, comms bool, authors map[int32]steamid.SteamID, owner steamid.SteamID, now time.Time) ban.Ban {
+	created := time.Unix(record.Created, 0)
+
+	imported := ban.Ban{
+		TargetID:    steamid.New(strings.TrimSpace(record.AuthID)),
+		SourceID:    owner,
+		BanType:     bantype.Banned,
+		Reason:      reason.External,
+		ReasonText:  strings.TrimSpace(record.Reason),
+		Origin:      ban.Web,
+		Name:        record.Name,
+		ValidUntil:  now.AddDate(10, 0, 0),
+		AppealState: ban.Open,
+		CreatedOn:   created,
+		UpdatedOn:   created,
+		Note:        fmt.Sprintf("Imported from SourceBans ban #%d", record.ID),
+	}
+
+	if comms {
+		imported.BanType = bantype.NoComm
+		imported.Note = fmt.Sprintf("Imported from SourceBans comm block #%d", record.ID)
+	}
+
+	if imported.ReasonText != "" {
+		imported.Reason = reason.Custom
+	}
+
+	if author, found := authors[record.AdminID]; found {
+		imported.SourceID = author
+	}
+
+	if record.ServerID > 0 {
+		imported.Origin = ban.InGame
+	}
+
+	if !comms && record.Type == 1 {
+		if addr, errAddr := netip.ParseAddr(strings.TrimSpace(record.IP)); errAddr == nil {
+			imported.CIDR = new(netip.PrefixFrom(addr, addr.BitLen()).String())
+		}
+	}
+
+	if record.Length > 0 {
+		imported.ValidUntil = time.Unix(record.Ends, 0)
+	}
+
+	switch {
+	case record.RemoveType != "":
+		imported.Deleted = true
+		imported.UnbanReasonText = record.UnbanReason
+	case record.Length > 0 && imported.ValidUntil.Before(now):
+		imported.Deleted = true
+		imported.UnbanReasonText = "Expired"
+	}
+
+	return imported
+}
@@ -0,0 +1,126 @@
+package sourcebans_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/internal/ban"
+	"github.com/leighmacdonald/gbans/internal/ban/bantype"
+	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/gbans/internal/sourcebans"
+	"github.com/leighmacdonald/gbans/internal/sourcemod"
+	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSource struct {
+	bans   []sourcebans.Record
+	comms  []sourcebans.Record
+	admins []sourcebans.Admin
+	groups []sourcebans.Group
+}
+
+func (f fakeSource) Bans(_ context.Context) ([]sourcebans.Record, error)  { return f.bans, nil }
+func (f fakeSource) Comms(_ context.Context) ([]sourcebans.Record, error) { return f.comms, nil }
+func (f fakeSource) Admins(_ context.Context) ([]sourcebans.Admin, error) { return f.admins, nil }
+func (f fakeSource) Groups(_ context.Context) ([]sourcebans.Group, error) { return f.groups, nil }
+
+func (f fakeSource) Overrides(_ context.Context) ([]sourcebans.Override, error) {
+	return []sourcebans.Override{{Type: "command", Name: "sm_kick", Flags: "c"}}, nil
+}
+
+func (f fakeSource) GroupOverrides(_ context.Context) ([]sourcebans.GroupOverride, error) {
+	return []sourcebans.GroupOverride{{GroupID: 1, Type: "command", Name: "sm_ban", Access: "allow"}}, nil
+}
+
+type fakeBans struct {
+	imported []ban.Ban
+}
+
+func (f *fakeBans) Import(_ context.Context, imported *ban.Ban, _ bool) (bool, error) {
+	f.imported = append(f.imported, *imported)
+
+	return true, nil
+}
+
+type fakePersons struct{}
+
+func (fakePersons) EnsurePerson(_ context.Context, _ steamid.SteamID) error { return nil }
+
+type fakeAdmins struct {
+	sourcemod.Sourcemod
+
+	admins []sourcemod.Admin
+	groups []sourcemod.Groups
+}
+
+func (f fakeAdmins) Groups(_ context.Context) ([]sourcemod.Groups, error) { return f.groups, nil }
+func (f fakeAdmins) Admins(_ context.Context) ([]sourcemod.Admin, error)  { return f.admins, nil }
+
+func (f fakeAdmins) Overrides(_ context.Context) ([]sourcemod.Overrides, error) {
+	return nil, nil
+}
+
+func (f fakeAdmins) GroupOverrides(_ context.Context, _ int32) ([]sourcemod.GroupOverrides, error) {
+	return nil, nil
+}
+
+func TestImportDryRun(t *testing.T) {
+	var (
+		now     = time.Now()
+		adminID = steamid.New("STEAM_0:1:583502")
+		source  = fakeSource{
+			groups: []sourcebans.Group{{GroupID: 1, Name: "Full Admins", Flags: "z", Immunity: 100}},
+			admins: []sourcebans.Admin{
+				{AdminID: 1, User: "admin", AuthID: string(adminID.Steam(false))},
+				{AdminID: 2, User: "existing", AuthID: string(tests.ModSID.Steam(false))},
+				{AdminID: 3, User: "web only", AuthID: ""},
+			},
+			bans: []sourcebans.Record{
+				{ID: 1, AuthID: string(tests.UserSID.Steam(false)), Created: now.Add(-48 * time.Hour).Unix(), Ends: now.Add(time.Hour).Unix(), Length: 3600, Reason: "aimbot", AdminID: 1, ServerID: 2},
+				{ID: 2, AuthID: string(tests.UserSID.Steam(false)), Created: now.Add(-24 * time.Hour).Unix(), Reason: "", AdminID: 0},
+				{ID: 3, AuthID: "", IP: "192.0.2.10", Type: 1, Created: now.Unix()},
+				{ID: 4, AuthID: "", Created: now.Unix()},
+			},
+			comms: []sourcebans.Record{
+				{ID: 1, AuthID: string(tests.UserSID.Steam(false)), Created: now.Add(-72 * time.Hour).Unix(), Ends: now.Add(-time.Hour).Unix(), Length: 3600, Type: 2, AdminID: 2},
+			},
+		}
+		bans     = &fakeBans{}
+		admins   = fakeAdmins{admins: []sourcemod.Admin{{SteamID: tests.ModSID}}}
+		importer = sourcebans.NewImporter(source, bans, fakePersons{}, admins, tests.OwnerSID)
+	)
+
+	result, errImport := importer.Import(context.Background(), true)
+	require.NoError(t, errImport)
+
+	require.Equal(t, sourcebans.Counts{Imported: 1}, result.Groups)
+	require.Equal(t, sourcebans.Counts{Imported: 1}, result.Overrides)
+	require.Equal(t, sourcebans.Counts{Imported: 1}, result.GroupOverrides)
+	require.Equal(t, sourcebans.Counts{Imported: 1, Skipped: 2}, result.Admins)
+	require.Equal(t, sourcebans.Counts{Imported: 2, Skipped: 1, IPOnly: 1}, result.Bans)
+	require.Equal(t, sourcebans.Counts{Imported: 1}, result.Comms)
+
+	require.Len(t, bans.imported, 3)
+
+	// Ordered by creation time, with the longer permanent ban kept active.
+	timed, permanent, comm := bans.imported[0], bans.imported[1], bans.imported[2]
+	require.Equal(t, tests.UserSID, timed.TargetID)
+	require.Equal(t, adminID, timed.SourceID)
+	require.Equal(t, reason.Custom, timed.Reason)
+	require.Equal(t, "aimbot", timed.ReasonText)
+	require.Equal(t, ban.InGame, timed.Origin)
+	require.True(t, timed.Deleted)
+
+	require.Equal(t, tests.OwnerSID, permanent.SourceID)
+	require.Equal(t, reason.External, permanent.Reason)
+	require.False(t, permanent.Deleted)
+	require.Greater(t, permanent.ValidUntil.Year()-now.Year(), 5)
+
+	require.Equal(t, bantype.NoComm, comm.BanType)
+	require.Equal(t, tests.ModSID, comm.SourceID)
+	require.True(t, comm.Deleted)
+	require.Equal(t, "Expired", comm.UnbanReasonText)
+}
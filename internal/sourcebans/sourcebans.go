@@ -0,0 +1,244 @@
+// Package sourcebans implements importing the bans, comm blocks, admins, groups and overrides of an existing
+// SourceBans++ or MaterialAdmin installation directly from its MySQL database.
+package sourcebans
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+
+	// Registers the mysql driver used to read the SourceBans database.
+	_ "github.com/go-sql-driver/mysql"
+)
+
+var (
+	ErrOpen        = errors.New("failed to open sourcebans database")
+	ErrQuery       = errors.New("failed to query sourcebans database")
+	ErrTablePrefix = errors.New("invalid table prefix")
+)
+
+var reTablePrefix = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// Record is a single row from either the bans or comms table.
+type Record struct {
+	ID      int32
+	AuthID  string
+	IP      string
+	Name    string
+	Created int64
+	Ends    int64
+	// Length of the ban in seconds. Zero is permanent.
+	Length   int64
+	Reason   string
+	AdminID  int32
+	ServerID int32
+	// RemoveType is set when the ban was removed. D = deleted, U = unbanned, E = expired.
+	RemoveType  string
+	UnbanReason string
+	// Type is 0 = steam, 1 = ip for bans, and 1 = mute, 2 = gag, 3 = silence for comms.
+	Type int32
+}
+
+type Admin struct {
+	AdminID  int32
+	User     string
+	AuthID   string
+	Group    string
+	Flags    string
+	Immunity int32
+	Password string
+}
+
+type Group struct {
+	GroupID  int32
+	Name     string
+	Flags    string
+	Immunity int32
+}
+
+type Override struct {
+	Type  string
+	Name  string
+	Flags string
+}
+
+type GroupOverride struct {
+	GroupID int32
+	Type    string
+	Name    string
+	Access  string
+}
+
+// Source reads the records of a SourceBans database. MaterialAdmin uses the same schema for the tables that
+// are read.
+type Source struct {
+	db     *sql.DB
+	prefix string
+}
+
+// Open connects to the SourceBans database using a go-sql-driver/mysql DSN, e.g.
+// user:pass@tcp(localhost:3306)/sourcebans. The prefix is the table prefix chosen during install, sb by default.
+func Open(ctx context.Context, dsn string, prefix string) (*Source, error) {
+	if !reTablePrefix.MatchString(prefix) {
+		return nil, ErrTablePrefix
+	}
+
+	db, errOpen := sql.Open("mysql", dsn)
+	if errOpen != nil {
+		return nil, errors.Join(errOpen, ErrOpen)
+	}
+
+	if errPing := db.PingContext(ctx); errPing != nil {
+		_ = db.Close()
+
+		return nil, errors.Join(errPing, ErrOpen)
+	}
+
+	return &Source{db: db, prefix: prefix}, nil
+}
+
+func (s *Source) Close() error {
+	return s.db.Close()
+}
+
+func (s *Source) table(name string) string {
+	return fmt.Sprintf("`%s_%s`", s.prefix, name)
+}
+
+func (s *Source) Bans(ctx context.Context) ([]Record, error) {
+	return s.records(ctx, `
+		SELECT bid, COALESCE(authid, ''), COALESCE(ip, ''), COALESCE(name, ''), created, ends, length,
+		       COALESCE(reason, ''), COALESCE(aid, 0), COALESCE(sid, 0), COALESCE(RemoveType, ''),
+		       COALESCE(ureason, ''), type
+		FROM `+s.table("bans")+`
+		ORDER BY bid`)
+}
+
+func (s *Source) Comms(ctx context.Context) ([]Record, error) {
+	return s.records(ctx, `
+		SELECT bid, COALESCE(authid, ''), '', COALESCE(name, ''), created, ends, length,
+		       COALESCE(reason, ''), COALESCE(aid, 0), COALESCE(sid, 0), COALESCE(RemoveType, ''),
+		       COALESCE(ureason, ''), type
+		FROM `+s.table("comms")+`
+		ORDER BY bid`)
+}
+
+func (s *Source) records(ctx context.Context, query string) ([]Record, error) {
+	rows, errRows := s.db.QueryContext(ctx, query)
+	if errRows != nil {
+		return nil, errors.Join(errRows, ErrQuery)
+	}
+
+	defer rows.Close()
+
+	var records []Record
+	for rows.Next() {
+		var record Record
+		if err := rows.Scan(&record.ID, &record.AuthID, &record.IP, &record.Name, &record.Created, &record.Ends,
+			&record.Length, &record.Reason, &record.AdminID, &record.ServerID, &record.RemoveType,
+			&record.UnbanReason, &record.Type); err != nil {
+			return nil, errors.Join(err, ErrQuery)
+		}
+
+		records = append(records, record)
+	}
+
+	return records, rows.Err()
+}
+
+func (s *Source) Admins(ctx context.Context) ([]Admin, error) {
+	rows, errRows := s.db.QueryContext(ctx, `
+		SELECT aid, COALESCE(user, ''), COALESCE(authid, ''), COALESCE(srv_group, ''), COALESCE(srv_flags, ''),
+		       immunity, COALESCE(srv_password, '')
+		FROM `+s.table("admins")+`
+		ORDER BY aid`)
+	if errRows != nil {
+		return nil, errors.Join(errRows, ErrQuery)
+	}
+
+	defer rows.Close()
+
+	var admins []Admin
+	for rows.Next() {
+		var admin Admin
+		if err := rows.Scan(&admin.AdminID, &admin.User, &admin.AuthID, &admin.Group, &admin.Flags,
+			&admin.Immunity, &admin.Password); err != nil {
+			return nil, errors.Join(err, ErrQuery)
+		}
+
+		admins = append(admins, admin)
+	}
+
+	return admins, rows.Err()
+}
+
+func (s *Source) Groups(ctx context.Context) ([]Group, error) {
+	rows, errRows := s.db.QueryContext(ctx, `
+		SELECT id, name, COALESCE(flags, ''), immunity
+		FROM `+s.table("srvgroups")+`
+		ORDER BY id`)
+	if errRows != nil {
+		return nil, errors.Join(errRows, ErrQuery)
+	}
+
+	defer rows.Close()
+
+	var groups []Group
+	for rows.Next() {
+		var group Group
+		if err := rows.Scan(&group.GroupID, &group.Name, &group.Flags, &group.Immunity); err != nil {
+			return nil, errors.Join(err, ErrQuery)
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, rows.Err()
+}
+
+func (s *Source) Overrides(ctx context.Context) ([]Override, error) {
+	rows, errRows := s.db.QueryContext(ctx, `SELECT type, name, flags FROM `+s.table("overrides")+` ORDER BY id`)
+	if errRows != nil {
+		return nil, errors.Join(errRows, ErrQuery)
+	}
+
+	defer rows.Close()
+
+	var overrides []Override
+	for rows.Next() {
+		var override Override
+		if err := rows.Scan(&override.Type, &override.Name, &override.Flags); err != nil {
+			return nil, errors.Join(err, ErrQuery)
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides, rows.Err()
+}
+
+func (s *Source) GroupOverrides(ctx context.Context) ([]GroupOverride, error) {
+	rows, errRows := s.db.QueryContext(ctx, `
+		SELECT group_id, type, name, access
+		FROM `+s.table("srvgroups_overrides")+`
+		ORDER BY id`)
+	if errRows != nil {
+		return nil, errors.Join(errRows, ErrQuery)
+	}
+
+	defer rows.Close()
+
+	var overrides []GroupOverride
+	for rows.Next() {
+		var override GroupOverride
+		if err := rows.Scan(&override.GroupID, &override.Type, &override.Name, &override.Access); err != nil {
+			return nil, errors.Join(err, ErrQuery)
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides, rows.Err()
+}
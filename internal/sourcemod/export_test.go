@@ -0,0 +1,18 @@
+package sourcemod
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+// CheckNetworkBlocks exposes checkNetworkBlocks for tests in the sourcemod_test package.
+func (h Sourcemod) CheckNetworkBlocks(ctx context.Context, steamID steamid.SteamID, ipAddr netip.Addr) (PlayerBanState, bool) {
+	return h.checkNetworkBlocks(ctx, steamID, ipAddr)
+}
+
+// NewStacPluginService creates a PluginService that only has its stac ingester set.
+func NewStacPluginService(stac StacIngester) PluginService {
+	return PluginService{stac: stac}
+}
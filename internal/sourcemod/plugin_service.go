@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/netip"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/leighmacdonald/gbans/internal/servers"
 	v1 "github.com/leighmacdonald/gbans/internal/sourcemod/v1"
 	"github.com/leighmacdonald/gbans/internal/sourcemod/v1/sourcemodv1connect"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
 	"github.com/leighmacdonald/steamid/v4/steamid"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -34,19 +36,23 @@ type PluginService struct {
 	serverAuth              rpc.ServerAuthenticator
 	tokenGenerator          TokenGeneratorFn
 	evades                  EvadeChecker
+	alts                    AltLinker
+	stac                    StacIngester
 	logChannelID            string
 	pingHistory             map[steamid.SteamID]time.Time
 	pingHistoryMu           *sync.Mutex
 	minPingModRetryInterval time.Duration
 }
 
-func NewPluginService(sourcemod Sourcemod, persons *person.Persons, serverAuthenticator rpc.ServerAuthenticator, evades EvadeChecker, tokenGenerator TokenGeneratorFn, notifier notification.Notifier, logChannelID string, authMiddleware *rpc.Middleware, option ...connect.HandlerOption) rpc.Service {
+func NewPluginService(sourcemod Sourcemod, persons *person.Persons, serverAuthenticator rpc.ServerAuthenticator, evades EvadeChecker, alts AltLinker, stac StacIngester, tokenGenerator TokenGeneratorFn, notifier notification.Notifier, logChannelID string, authMiddleware *rpc.Middleware, option ...connect.HandlerOption) rpc.Service {
 	pattern, handler := sourcemodv1connect.NewPluginServiceHandler(PluginService{
 		sourcemod:               sourcemod,
 		persons:                 persons,
 		tokenGenerator:          tokenGenerator,
 		notifier:                notifier,
 		evades:                  evades,
+		alts:                    alts,
+		stac:                    stac,
 		logChannelID:            logChannelID,
 		serverAuth:              serverAuthenticator,
 		pingHistory:             map[steamid.SteamID]time.Time{},
@@ -61,6 +67,7 @@ func NewPluginService(sourcemod Sourcemod, persons *person.Persons, serverAuthen
 	authMiddleware.ServerRoute(sourcemodv1connect.PluginServiceSMUsersProcedure, serverAuth)
 	authMiddleware.ServerRoute(sourcemodv1connect.PluginServiceSMGroupsProcedure, serverAuth)
 	authMiddleware.ServerRoute(sourcemodv1connect.PluginServiceSMSeedProcedure, serverAuth)
+	authMiddleware.ServerRoute(sourcemodv1connect.PluginServiceSMStacLogProcedure, serverAuth)
 
 	return rpc.Service{Pattern: pattern, Handler: handler}
 }
@@ -88,6 +95,28 @@ func (s PluginService) SMPingMod(ctx context.Context, req *v1.SMPingModRequest)
 	return &emptypb.Empty{}, nil
 }
 
+func (s PluginService) SMStacLog(ctx context.Context, req *v1.SMStacLogRequest) (*v1.SMStacLogResponse, error) {
+	serverInfo := rpc.ServerInfoFromCtx(ctx)
+	if serverInfo == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, rpc.ErrPermission)
+	}
+
+	imported, errIngest := s.stac.Ingest(ctx, req.GetFileName(), strings.NewReader(req.GetLog()), serverInfo.ServerID)
+	if errIngest != nil {
+		if errors.Is(errIngest, logparse.ErrParse) || errors.Is(errIngest, logparse.ErrParseSummary) ||
+			errors.Is(errIngest, logparse.ErrParsePlayer) || errors.Is(errIngest, logparse.ErrParseFileName) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, rpc.ErrBadRequest)
+		}
+
+		slog.Error("Failed to ingest stac log", slog.String("error", errIngest.Error()),
+			slog.String("server", serverInfo.ServerName))
+
+		return nil, connect.NewError(connect.CodeInternal, rpc.ErrInternal)
+	}
+
+	return &v1.SMStacLogResponse{Imported: new(int32(imported))}, nil //nolint:gosec
+}
+
 func (s PluginService) SMAuthenticate(ctx context.Context, req *v1.SMAuthenticateRequest) (*v1.SMAuthenticateResponse, error) {
 	password := req.GetPassword()
 	if password == "" {
@@ -136,6 +165,10 @@ func (s PluginService) SMCheck(ctx context.Context, req *v1.SMCheckRequest) (*v1
 	}
 
 	if banState.BanID == 0 {
+		if s.checkLinkedEvasion(ctx, steamID) {
+			return &v1.SMCheckResponse{ClientId: defaultResponse.ClientId, BanType: toBanType(bantype.Banned), Msg: new("Evasion ban")}, nil
+		}
+
 		slog.Debug("Player connect check", slog.String("steam_id", steamID.String()), slog.Bool("success", true))
 
 		return defaultResponse, nil
@@ -175,6 +208,54 @@ func (s PluginService) SMCheck(ctx context.Context, req *v1.SMCheckRequest) (*v1
 	}, nil
 }
 
+// linkedEvasionTimeout bounds the alt account search, which runs a query for every account it visits, so that
+// it cannot hold up a connecting player.
+const linkedEvasionTimeout = 2 * time.Second
+
+// checkLinkedEvasion bans the player when one of their likely alt accounts sharing addresses with them directly is
+// banned, bans of alts found through another account are only flagged for review. Like SMCheck, it fails open,
+// including when the alt account search takes longer than linkedEvasionTimeout.
+func (s PluginService) checkLinkedEvasion(ctx context.Context, steamID steamid.SteamID) bool {
+	altsCtx, cancel := context.WithTimeout(ctx, linkedEvasionTimeout)
+	defer cancel()
+
+	alts, errAlts := s.alts.LinkedAccounts(altsCtx, steamID)
+	if errAlts != nil {
+		slog.Error("Failed to load linked accounts", slog.String("error", errAlts.Error()))
+
+		return false
+	}
+
+	if len(alts) == 0 {
+		return false
+	}
+
+	if errPlayer := s.persons.EnsurePerson(ctx, steamID); errPlayer != nil {
+		slog.Error("Failed to load or create player on connect")
+
+		return false
+	}
+
+	var direct, indirect []steamid.SteamID
+
+	for _, alt := range alts {
+		if alt.Depth == 1 {
+			direct = append(direct, alt.SteamID)
+		} else {
+			indirect = append(indirect, alt.SteamID)
+		}
+	}
+
+	evadeBanned, errEvade := s.evades.CheckLinkedEvadeStatus(ctx, steamID, direct, indirect)
+	if errEvade != nil {
+		slog.Error("Failed to check linked evade status", slog.String("error", errEvade.Error()))
+
+		return false
+	}
+
+	return evadeBanned
+}
+
 func (s PluginService) SMOverrides(ctx context.Context, _ *emptypb.Empty) (*v1.SMOverridesResponse, error) {
 	overrides, errOverrides := s.sourcemod.Overrides(ctx)
 	if errOverrides != nil {
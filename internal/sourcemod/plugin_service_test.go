@@ -0,0 +1,75 @@
+package sourcemod_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"connectrpc.com/authn"
+	"connectrpc.com/connect"
+	"github.com/leighmacdonald/gbans/internal/rpc"
+	"github.com/leighmacdonald/gbans/internal/sourcemod"
+	v1 "github.com/leighmacdonald/gbans/internal/sourcemod/v1"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeStac struct {
+	imported int
+	err      error
+	fileName string
+	log      string
+	serverID int32
+}
+
+func (f *fakeStac) Ingest(_ context.Context, fileName string, reader io.Reader, serverID int32) (int, error) {
+	body, errRead := io.ReadAll(reader)
+	if errRead != nil {
+		return 0, errRead
+	}
+
+	f.fileName = fileName
+	f.log = string(body)
+	f.serverID = serverID
+
+	return f.imported, f.err
+}
+
+func TestSMStacLog(t *testing.T) {
+	var (
+		request = &v1.SMStacLogRequest{FileName: new("stac_052224.log"), Log: new("<01:13:00>\nPlayer: JSN_<591><[U:1:118258373]><>")}
+		server  = rpc.ServerInfo{ServerID: 42, ServerName: "test-1"}
+		ctx     = authn.SetInfo(t.Context(), server)
+	)
+
+	stac := &fakeStac{imported: 3}
+	resp, errResp := sourcemod.NewStacPluginService(stac).SMStacLog(ctx, request)
+	require.NoError(t, errResp)
+	require.Equal(t, int32(3), resp.GetImported())
+	require.Equal(t, request.GetFileName(), stac.fileName)
+	require.Equal(t, request.GetLog(), stac.log)
+	require.Equal(t, server.ServerID, stac.serverID)
+
+	for _, testCase := range []struct {
+		name      string
+		anonymous bool
+		err       error
+		code      connect.Code
+	}{
+		{name: "unauthenticated", anonymous: true, code: connect.CodePermissionDenied},
+		{name: "unparsable", err: logparse.ErrParse, code: connect.CodeInvalidArgument},
+		{name: "bad file name", err: logparse.ErrParseFileName, code: connect.CodeInvalidArgument},
+		{name: "internal", err: errors.New("database gone"), code: connect.CodeInternal},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			requestCtx := ctx
+			if testCase.anonymous {
+				requestCtx = t.Context()
+			}
+
+			_, errIngest := sourcemod.NewStacPluginService(&fakeStac{err: testCase.err}).SMStacLog(requestCtx, request)
+			require.Equal(t, testCase.code, connect.CodeOf(errIngest))
+		})
+	}
+}
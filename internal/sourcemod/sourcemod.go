@@ -16,11 +16,13 @@ import (
 	"github.com/leighmacdonald/gbans/internal/auth/permission"
 	"github.com/leighmacdonald/gbans/internal/ban/bantype"
 	"github.com/leighmacdonald/gbans/internal/ban/reason"
+	"github.com/leighmacdonald/gbans/internal/blocklist"
 	"github.com/leighmacdonald/gbans/internal/config/link"
 	"github.com/leighmacdonald/gbans/internal/database"
 	"github.com/leighmacdonald/gbans/internal/discord"
 	"github.com/leighmacdonald/gbans/internal/domain/person"
 	"github.com/leighmacdonald/gbans/internal/httphelper"
+	"github.com/leighmacdonald/gbans/internal/network/asn"
 	"github.com/leighmacdonald/gbans/internal/notification"
 	"github.com/leighmacdonald/gbans/internal/rpc"
 	"github.com/leighmacdonald/gbans/internal/servers"
@@ -57,6 +59,16 @@ const (
 	BanSourceASN         BanSource = "ban_asn"
 )
 
+// NetworkBlockChecker checks addresses against the cached network blocklists without hitting the database.
+type NetworkBlockChecker interface {
+	CheckBlock(addr netip.Addr, steamID steamid.SteamID) blocklist.CheckResult
+}
+
+// ASNBlockChecker checks if the autonomous system owning an address has been blocked.
+type ASNBlockChecker interface {
+	Check(ctx context.Context, addr netip.Addr) (asn.Block, error)
+}
+
 type PlayerBanState struct {
 	SteamID    steamid.SteamID
 	BanSource  BanSource
@@ -66,6 +78,9 @@ type PlayerBanState struct {
 	EvadeOK    bool
 	IP         netip.Addr
 	ValidUntil time.Time
+	// ASNum and ASNReason are set when the connection was denied by an ASN block.
+	ASNum     int64
+	ASNReason string
 }
 
 func (p PlayerBanState) Path() string {
@@ -162,7 +177,7 @@ type ConfigEntry struct {
 	CfgValue string
 }
 
-func New(repository Repository, person person.Provider, notifier notification.Notifier, seedChannelID string, modPingChannelID string, modRoleID string, servers *servers.Servers) Sourcemod {
+func New(repository Repository, person person.Provider, notifier notification.Notifier, seedChannelID string, modPingChannelID string, modRoleID string, servers *servers.Servers, blocks NetworkBlockChecker, asnBlocks ASNBlockChecker) Sourcemod {
 	return Sourcemod{
 		seedChannelID:    seedChannelID,
 		modPingChannelID: modPingChannelID,
@@ -171,6 +186,8 @@ func New(repository Repository, person person.Provider, notifier notification.No
 		person:           person,
 		notifier:         notifier,
 		servers:          servers,
+		blocks:           blocks,
+		asnBlocks:        asnBlocks,
 		seedQueue: &SeedQueue{
 			minTime: time.Second * 300,
 			servers: make(map[int32]seedRequest),
@@ -188,6 +205,8 @@ type Sourcemod struct {
 	seedQueue        *SeedQueue
 	notifier         notification.Notifier
 	servers          *servers.Servers
+	blocks           NetworkBlockChecker
+	asnBlocks        ASNBlockChecker
 }
 
 func (h Sourcemod) PingMod(_ context.Context, _ steamid.SteamID, name string, reason string, clientID int32, serverName string) error {
@@ -260,9 +279,19 @@ func (h Sourcemod) GetBanState(ctx context.Context, steamID steamid.SteamID, ipA
 	const format = "Banned\nReason: %s (%s)\nUntil: %s\nAppeal: %s"
 
 	banState, errBanState := h.repository.QueryBanState(ctx, steamID, ipAddr)
-	if errBanState != nil || banState.BanID == 0 {
+	if errBanState != nil {
 		return banState, "", errBanState
 	}
+
+	if banState.BanID == 0 {
+		if networkState, blocked := h.checkNetworkBlocks(ctx, steamID, ipAddr); blocked {
+			banState = networkState
+		}
+	}
+
+	if banState.BanID == 0 {
+		return banState, "", nil
+	}
 	banState.IP = ipAddr
 
 	var msg string
@@ -281,7 +310,7 @@ func (h Sourcemod) GetBanState(ctx context.Context, steamID steamid.SteamID, ipA
 				msg = fmt.Sprintf(format, banState.Reason.String(), "Steam", validUntil, appealURL)
 			}
 		case BanSourceASN:
-			msg = fmt.Sprintf(format, banState.Reason.String(), "ASN", "Permanent", appealURL)
+			msg = fmt.Sprintf("Blocked Network (AS%d)\nReason: %s", banState.ASNum, banState.ASNReason)
 		case BanSourceCIDR:
 			msg = "Blocked Network/VPN\nPlease disable your VPN if you are using one."
 		case BanSourceSteamFriend:
@@ -296,6 +325,52 @@ func (h Sourcemod) GetBanState(ctx context.Context, steamID steamid.SteamID, ipA
 	return banState, msg, nil
 }
 
+// checkNetworkBlocks checks the address against the cached CIDR blocklists and then the blocked autonomous
+// systems. Whitelisted addresses and steam ids bypass both checks. Errors looking up the ASN fail open.
+func (h Sourcemod) checkNetworkBlocks(ctx context.Context, steamID steamid.SteamID, ipAddr netip.Addr) (PlayerBanState, bool) {
+	state := PlayerBanState{
+		SteamID:    steamID,
+		BanID:      1,
+		BanType:    bantype.Banned,
+		Reason:     reason.Custom,
+		ValidUntil: time.Now().AddDate(10, 0, 0),
+	}
+
+	if h.blocks != nil {
+		result := h.blocks.CheckBlock(ipAddr, steamID)
+		if result.Blocked {
+			state.BanSource = BanSourceCIDR
+
+			return state, true
+		}
+
+		if result.WhitelistSteam || result.WhitelistAddress.IsValid() {
+			return PlayerBanState{}, false
+		}
+	}
+
+	if h.asnBlocks == nil {
+		return PlayerBanState{}, false
+	}
+
+	block, errBlock := h.asnBlocks.Check(ctx, ipAddr)
+	if errBlock == nil {
+		return PlayerBanState{}, false
+	}
+
+	if !errors.Is(errBlock, asn.ErrBlocked) {
+		slog.Error("Failed to check asn block", slog.String("error", errBlock.Error()), slog.String("ip", ipAddr.String()))
+
+		return PlayerBanState{}, false
+	}
+
+	state.BanSource = BanSourceASN
+	state.ASNum = block.ASNum
+	state.ASNReason = block.Reason
+
+	return state, true
+}
+
 func (h Sourcemod) Override(ctx context.Context, overrideID int32) (Overrides, error) {
 	return h.repository.GetOverride(ctx, overrideID)
 }
@@ -505,6 +505,10 @@ func (h discordHandler) onCVARGet(ctx context.Context, session *discordgo.Sessio
 }
 
 func newCheckDenyMessage(banState PlayerBanState) *discordgo.MessageSend {
+	if banState.BanSource == BanSourceASN {
+		return newASNDenyMessage(banState)
+	}
+
 	content, errContent := discord.RenderTemplate("check_blocked", banState)
 	if errContent != nil {
 		slog.Error("Error creating check blocked message", slog.String("error", errContent.Error()))
@@ -513,3 +517,13 @@ func newCheckDenyMessage(banState PlayerBanState) *discordgo.MessageSend {
 	return discord.NewMessage(discord.Heading("Player Denied Connection"),
 		discord.BodyColouredText(discord.ColourWarn, content))
 }
+
+func newASNDenyMessage(banState PlayerBanState) *discordgo.MessageSend {
+	content, errContent := discord.RenderTemplate("asn_blocked", banState)
+	if errContent != nil {
+		slog.Error("Error creating asn blocked message", slog.String("error", errContent.Error()))
+	}
+
+	return discord.NewMessage(discord.Heading("Player Denied Connection (ASN)"),
+		discord.BodyColouredText(discord.ColourWarn, content))
+}
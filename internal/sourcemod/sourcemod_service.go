@@ -3,6 +3,7 @@ package sourcemod
 import (
 	"context"
 	"errors"
+	"io"
 	"net/netip"
 
 	"connectrpc.com/connect"
@@ -10,6 +11,7 @@ import (
 	"github.com/leighmacdonald/gbans/internal/ban/bantype"
 	banv1 "github.com/leighmacdonald/gbans/internal/ban/v1"
 	"github.com/leighmacdonald/gbans/internal/database"
+	"github.com/leighmacdonald/gbans/internal/network"
 	"github.com/leighmacdonald/gbans/internal/rpc"
 	v1 "github.com/leighmacdonald/gbans/internal/sourcemod/v1"
 	"github.com/leighmacdonald/gbans/internal/sourcemod/v1/sourcemodv1connect"
@@ -20,6 +22,17 @@ import (
 
 type EvadeChecker interface {
 	CheckEvadeStatus(ctx context.Context, steamID steamid.SteamID, address netip.Addr) (bool, error)
+	CheckLinkedEvadeStatus(ctx context.Context, steamID steamid.SteamID, direct []steamid.SteamID, indirect []steamid.SteamID) (bool, error)
+}
+
+// AltLinker finds the accounts likely operated by the same player, scoring above the configured threshold.
+type AltLinker interface {
+	LinkedAccounts(ctx context.Context, steamID steamid.SteamID) ([]network.AltAccount, error)
+}
+
+// StacIngester imports stac log entries pushed from a game server.
+type StacIngester interface {
+	Ingest(ctx context.Context, fileName string, reader io.Reader, serverID int32) (int, error)
 }
 
 type Service struct {
@@ -0,0 +1,86 @@
+package sourcemod_test
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/leighmacdonald/gbans/internal/blocklist"
+	"github.com/leighmacdonald/gbans/internal/network/asn"
+	"github.com/leighmacdonald/gbans/internal/sourcemod"
+	"github.com/leighmacdonald/gbans/internal/tests"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBlocks struct {
+	result blocklist.CheckResult
+}
+
+func (f fakeBlocks) CheckBlock(_ netip.Addr, _ steamid.SteamID) blocklist.CheckResult {
+	return f.result
+}
+
+type fakeASNBlocks struct {
+	block asn.Block
+	err   error
+	calls *int
+}
+
+func (f fakeASNBlocks) Check(_ context.Context, _ netip.Addr) (asn.Block, error) {
+	*f.calls++
+
+	return f.block, f.err
+}
+
+func TestCheckNetworkBlocks(t *testing.T) {
+	var (
+		addr    = netip.MustParseAddr("1.2.3.4")
+		asBlock = asn.Block{ASNum: 64500, Reason: "hosting provider"}
+	)
+
+	for _, testCase := range []struct {
+		name      string
+		cidr      blocklist.CheckResult
+		asnBlock  asn.Block
+		asnErr    error
+		blocked   bool
+		source    sourcemod.BanSource
+		asnCalled bool
+	}{
+		{name: "not blocked", asnCalled: true},
+		{
+			name:    "cidr blocked",
+			cidr:    blocklist.CheckResult{Matched: true, Blocked: true},
+			blocked: true, source: sourcemod.BanSourceCIDR,
+		},
+		{name: "asn blocked", asnBlock: asBlock, asnErr: asn.ErrBlocked, blocked: true, source: sourcemod.BanSourceASN, asnCalled: true},
+		{name: "whitelisted steam id skips asn", cidr: blocklist.CheckResult{WhitelistSteam: true}, asnBlock: asBlock, asnErr: asn.ErrBlocked},
+		{
+			name:     "whitelisted address skips asn",
+			cidr:     blocklist.CheckResult{Matched: true, WhitelistAddress: netip.MustParsePrefix("1.2.3.0/24")},
+			asnBlock: asBlock, asnErr: asn.ErrBlocked,
+		},
+		{name: "asn lookup error fails open", asnErr: errors.New("lookup failed"), asnCalled: true},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			var (
+				calls   int
+				asnFake = fakeASNBlocks{block: testCase.asnBlock, err: testCase.asnErr, calls: &calls}
+				smApp   = sourcemod.New(sourcemod.Repository{}, nil, nil, "", "", "", nil, fakeBlocks{result: testCase.cidr}, asnFake)
+			)
+
+			state, blocked := smApp.CheckNetworkBlocks(t.Context(), tests.UserSID, addr)
+			require.Equal(t, testCase.blocked, blocked)
+			require.Equal(t, testCase.source, state.BanSource)
+			require.Equal(t, testCase.asnCalled, calls > 0)
+
+			if testCase.source == sourcemod.BanSourceASN {
+				require.Equal(t, asBlock.ASNum, state.ASNum)
+				require.Equal(t, asBlock.Reason, state.ASNReason)
+				require.Equal(t, tests.UserSID, state.SteamID)
+			}
+		})
+	}
+}
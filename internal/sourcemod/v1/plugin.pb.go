@@ -24,6 +24,106 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SMStacLogRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the log file the entries were written to, eg: stac_052224.log. The date of the entries is derived
+	// from this.
+	FileName *string `protobuf:"bytes,1,opt,name=file_name,json=fileName" json:"file_name,omitempty"`
+	// One or more complete log entries.
+	Log           *string `protobuf:"bytes,2,opt,name=log" json:"log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SMStacLogRequest) Reset() {
+	*x = SMStacLogRequest{}
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SMStacLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMStacLogRequest) ProtoMessage() {}
+
+func (x *SMStacLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMStacLogRequest.ProtoReflect.Descriptor instead.
+func (*SMStacLogRequest) Descriptor() ([]byte, []int) {
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SMStacLogRequest) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *SMStacLogRequest) GetLog() string {
+	if x != nil && x.Log != nil {
+		return *x.Log
+	}
+	return ""
+}
+
+type SMStacLogResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Count of new entries. Entries that were previously imported are not counted.
+	Imported      *int32 `protobuf:"varint,1,opt,name=imported" json:"imported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SMStacLogResponse) Reset() {
+	*x = SMStacLogResponse{}
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SMStacLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMStacLogResponse) ProtoMessage() {}
+
+func (x *SMStacLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMStacLogResponse.ProtoReflect.Descriptor instead.
+func (*SMStacLogResponse) Descriptor() ([]byte, []int) {
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SMStacLogResponse) GetImported() int32 {
+	if x != nil && x.Imported != nil {
+		return *x.Imported
+	}
+	return 0
+}
+
 type SMPingModRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SteamId       *string                `protobuf:"bytes,1,opt,name=steam_id,json=steamId" json:"steam_id,omitempty"`
@@ -36,7 +136,7 @@ type SMPingModRequest struct {
 
 func (x *SMPingModRequest) Reset() {
 	*x = SMPingModRequest{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[0]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -48,7 +148,7 @@ func (x *SMPingModRequest) String() string {
 func (*SMPingModRequest) ProtoMessage() {}
 
 func (x *SMPingModRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[0]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,7 +161,7 @@ func (x *SMPingModRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMPingModRequest.ProtoReflect.Descriptor instead.
 func (*SMPingModRequest) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{0}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SMPingModRequest) GetSteamId() string {
@@ -100,7 +200,7 @@ type SMPingModResponse struct {
 
 func (x *SMPingModResponse) Reset() {
 	*x = SMPingModResponse{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[1]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -112,7 +212,7 @@ func (x *SMPingModResponse) String() string {
 func (*SMPingModResponse) ProtoMessage() {}
 
 func (x *SMPingModResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[1]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -125,7 +225,7 @@ func (x *SMPingModResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMPingModResponse.ProtoReflect.Descriptor instead.
 func (*SMPingModResponse) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{1}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{3}
 }
 
 type SMAuthenticateRequest struct {
@@ -137,7 +237,7 @@ type SMAuthenticateRequest struct {
 
 func (x *SMAuthenticateRequest) Reset() {
 	*x = SMAuthenticateRequest{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[2]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -149,7 +249,7 @@ func (x *SMAuthenticateRequest) String() string {
 func (*SMAuthenticateRequest) ProtoMessage() {}
 
 func (x *SMAuthenticateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[2]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -162,7 +262,7 @@ func (x *SMAuthenticateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMAuthenticateRequest.ProtoReflect.Descriptor instead.
 func (*SMAuthenticateRequest) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{2}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SMAuthenticateRequest) GetPassword() string {
@@ -181,7 +281,7 @@ type SMAuthenticateResponse struct {
 
 func (x *SMAuthenticateResponse) Reset() {
 	*x = SMAuthenticateResponse{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[3]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -193,7 +293,7 @@ func (x *SMAuthenticateResponse) String() string {
 func (*SMAuthenticateResponse) ProtoMessage() {}
 
 func (x *SMAuthenticateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[3]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -206,7 +306,7 @@ func (x *SMAuthenticateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMAuthenticateResponse.ProtoReflect.Descriptor instead.
 func (*SMAuthenticateResponse) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{3}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SMAuthenticateResponse) GetToken() string {
@@ -225,7 +325,7 @@ type SMSeedRequest struct {
 
 func (x *SMSeedRequest) Reset() {
 	*x = SMSeedRequest{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[4]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -237,7 +337,7 @@ func (x *SMSeedRequest) String() string {
 func (*SMSeedRequest) ProtoMessage() {}
 
 func (x *SMSeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[4]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +350,7 @@ func (x *SMSeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMSeedRequest.ProtoReflect.Descriptor instead.
 func (*SMSeedRequest) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{4}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SMSeedRequest) GetSteamId() string {
@@ -269,7 +369,7 @@ type SMSeedResponse struct {
 
 func (x *SMSeedResponse) Reset() {
 	*x = SMSeedResponse{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[5]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -281,7 +381,7 @@ func (x *SMSeedResponse) String() string {
 func (*SMSeedResponse) ProtoMessage() {}
 
 func (x *SMSeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[5]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +394,7 @@ func (x *SMSeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMSeedResponse.ProtoReflect.Descriptor instead.
 func (*SMSeedResponse) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{5}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SMSeedResponse) GetMessage() string {
@@ -315,7 +415,7 @@ type SMOverride struct {
 
 func (x *SMOverride) Reset() {
 	*x = SMOverride{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[6]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -327,7 +427,7 @@ func (x *SMOverride) String() string {
 func (*SMOverride) ProtoMessage() {}
 
 func (x *SMOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[6]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +440,7 @@ func (x *SMOverride) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMOverride.ProtoReflect.Descriptor instead.
 func (*SMOverride) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{6}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SMOverride) GetOverrideType() OverrideType {
@@ -373,7 +473,7 @@ type SMOverridesResponse struct {
 
 func (x *SMOverridesResponse) Reset() {
 	*x = SMOverridesResponse{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[7]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -385,7 +485,7 @@ func (x *SMOverridesResponse) String() string {
 func (*SMOverridesResponse) ProtoMessage() {}
 
 func (x *SMOverridesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[7]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -398,7 +498,7 @@ func (x *SMOverridesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMOverridesResponse.ProtoReflect.Descriptor instead.
 func (*SMOverridesResponse) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{7}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SMOverridesResponse) GetOverrides() []*SMOverride {
@@ -420,7 +520,7 @@ type SMCheckRequest struct {
 
 func (x *SMCheckRequest) Reset() {
 	*x = SMCheckRequest{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[8]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +532,7 @@ func (x *SMCheckRequest) String() string {
 func (*SMCheckRequest) ProtoMessage() {}
 
 func (x *SMCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[8]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +545,7 @@ func (x *SMCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMCheckRequest.ProtoReflect.Descriptor instead.
 func (*SMCheckRequest) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{8}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SMCheckRequest) GetSteamId() string {
@@ -487,7 +587,7 @@ type SMCheckResponse struct {
 
 func (x *SMCheckResponse) Reset() {
 	*x = SMCheckResponse{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[9]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +599,7 @@ func (x *SMCheckResponse) String() string {
 func (*SMCheckResponse) ProtoMessage() {}
 
 func (x *SMCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[9]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +612,7 @@ func (x *SMCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMCheckResponse.ProtoReflect.Descriptor instead.
 func (*SMCheckResponse) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{9}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SMCheckResponse) GetClientId() int32 {
@@ -546,7 +646,7 @@ type SMGroupImmunity struct {
 
 func (x *SMGroupImmunity) Reset() {
 	*x = SMGroupImmunity{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[10]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -558,7 +658,7 @@ func (x *SMGroupImmunity) String() string {
 func (*SMGroupImmunity) ProtoMessage() {}
 
 func (x *SMGroupImmunity) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[10]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -571,7 +671,7 @@ func (x *SMGroupImmunity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMGroupImmunity.ProtoReflect.Descriptor instead.
 func (*SMGroupImmunity) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{10}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SMGroupImmunity) GetGroupName() string {
@@ -598,7 +698,7 @@ type SMGroupsResponse struct {
 
 func (x *SMGroupsResponse) Reset() {
 	*x = SMGroupsResponse{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[11]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -610,7 +710,7 @@ func (x *SMGroupsResponse) String() string {
 func (*SMGroupsResponse) ProtoMessage() {}
 
 func (x *SMGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[11]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +723,7 @@ func (x *SMGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMGroupsResponse.ProtoReflect.Descriptor instead.
 func (*SMGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{11}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SMGroupsResponse) GetGroups() []*Group {
@@ -650,7 +750,7 @@ type SMUsersResponse struct {
 
 func (x *SMUsersResponse) Reset() {
 	*x = SMUsersResponse{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[12]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +762,7 @@ func (x *SMUsersResponse) String() string {
 func (*SMUsersResponse) ProtoMessage() {}
 
 func (x *SMUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[12]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +775,7 @@ func (x *SMUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMUsersResponse.ProtoReflect.Descriptor instead.
 func (*SMUsersResponse) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{12}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SMUsersResponse) GetUsers() []*SMUser {
@@ -707,7 +807,7 @@ type SMUser struct {
 
 func (x *SMUser) Reset() {
 	*x = SMUser{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[13]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -719,7 +819,7 @@ func (x *SMUser) String() string {
 func (*SMUser) ProtoMessage() {}
 
 func (x *SMUser) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[13]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +832,7 @@ func (x *SMUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMUser.ProtoReflect.Descriptor instead.
 func (*SMUser) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{13}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SMUser) GetId() int32 {
@@ -794,7 +894,7 @@ type SMUserGroup struct {
 
 func (x *SMUserGroup) Reset() {
 	*x = SMUserGroup{}
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[14]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -806,7 +906,7 @@ func (x *SMUserGroup) String() string {
 func (*SMUserGroup) ProtoMessage() {}
 
 func (x *SMUserGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_sourcemod_v1_plugin_proto_msgTypes[14]
+	mi := &file_sourcemod_v1_plugin_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,7 +919,7 @@ func (x *SMUserGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SMUserGroup.ProtoReflect.Descriptor instead.
 func (*SMUserGroup) Descriptor() ([]byte, []int) {
-	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{14}
+	return file_sourcemod_v1_plugin_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SMUserGroup) GetAdminId() int32 {
@@ -840,7 +940,12 @@ var File_sourcemod_v1_plugin_proto protoreflect.FileDescriptor
 
 const file_sourcemod_v1_plugin_proto_rawDesc = "" +
 	"\n" +
-	"\x19sourcemod/v1/plugin.proto\x12\fsourcemod.v1\x1a\x10ban/v1/ban.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1csourcemod/v1/sourcemod.proto\"v\n" +
+	"\x19sourcemod/v1/plugin.proto\x12\fsourcemod.v1\x1a\x10ban/v1/ban.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1csourcemod/v1/sourcemod.proto\"n\n" +
+	"\x10SMStacLogRequest\x128\n" +
+	"\tfile_name\x18\x01 \x01(\tB\x1b\xbaH\x18\xc8\x01\x01r\x132\x11^stac_\\d{6}\\.log$R\bfileName\x12 \n" +
+	"\x03log\x18\x02 \x01(\tB\x0e\xbaH\v\xc8\x01\x01r\x06\x10\x01\x18\x80\x80@R\x03log\"7\n" +
+	"\x11SMStacLogResponse\x12\"\n" +
+	"\bimported\x18\x01 \x01(\x05B\x06\xbaH\x03\xc8\x01\x01R\bimported\"v\n" +
 	"\x10SMPingModRequest\x12\x19\n" +
 	"\bsteam_id\x18\x01 \x01(\tR\asteamId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -905,7 +1010,7 @@ const file_sourcemod_v1_plugin_proto_rawDesc = "" +
 	"\xbaH\a\xc8\x01\x01\x1a\x02 \x00R\aadminId\x12)\n" +
 	"\n" +
 	"group_name\x18\x02 \x01(\tB\n" +
-	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\tgroupName2\x9c\x04\n" +
+	"\xbaH\a\xc8\x01\x01r\x02\x10\x01R\tgroupName2\xec\x04\n" +
 	"\rPluginService\x12]\n" +
 	"\x0eSMAuthenticate\x12#.sourcemod.v1.SMAuthenticateRequest\x1a$.sourcemod.v1.SMAuthenticateResponse\"\x00\x12H\n" +
 	"\aSMCheck\x12\x1c.sourcemod.v1.SMCheckRequest\x1a\x1d.sourcemod.v1.SMCheckResponse\"\x00\x12J\n" +
@@ -913,7 +1018,8 @@ const file_sourcemod_v1_plugin_proto_rawDesc = "" +
 	"\aSMUsers\x12\x16.google.protobuf.Empty\x1a\x1d.sourcemod.v1.SMUsersResponse\"\x00\x12D\n" +
 	"\bSMGroups\x12\x16.google.protobuf.Empty\x1a\x1e.sourcemod.v1.SMGroupsResponse\"\x00\x12E\n" +
 	"\x06SMSeed\x12\x1b.sourcemod.v1.SMSeedRequest\x1a\x1c.sourcemod.v1.SMSeedResponse\"\x00\x12E\n" +
-	"\tSMPingMod\x12\x1e.sourcemod.v1.SMPingModRequest\x1a\x16.google.protobuf.Empty\"\x00B\xb3\x01\n" +
+	"\tSMPingMod\x12\x1e.sourcemod.v1.SMPingModRequest\x1a\x16.google.protobuf.Empty\"\x00\x12N\n" +
+	"\tSMStacLog\x12\x1e.sourcemod.v1.SMStacLogRequest\x1a\x1f.sourcemod.v1.SMStacLogResponse\"\x00B\xb3\x01\n" +
 	"\x10com.sourcemod.v1B\vPluginProtoP\x01ZAgithub.com/leighmacdonald/gbans/internal/sourcemod/v1;sourcemodv1\xa2\x02\x03SXX\xaa\x02\fSourcemod.V1\xca\x02\fSourcemod\\V1\xe2\x02\x18Sourcemod\\V1\\GPBMetadata\xea\x02\rSourcemod::V1b\beditionsp\xe8\a"
 
 var (
@@ -928,52 +1034,56 @@ func file_sourcemod_v1_plugin_proto_rawDescGZIP() []byte {
 	return file_sourcemod_v1_plugin_proto_rawDescData
 }
 
-var file_sourcemod_v1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_sourcemod_v1_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_sourcemod_v1_plugin_proto_goTypes = []any{
-	(*SMPingModRequest)(nil),       // 0: sourcemod.v1.SMPingModRequest
-	(*SMPingModResponse)(nil),      // 1: sourcemod.v1.SMPingModResponse
-	(*SMAuthenticateRequest)(nil),  // 2: sourcemod.v1.SMAuthenticateRequest
-	(*SMAuthenticateResponse)(nil), // 3: sourcemod.v1.SMAuthenticateResponse
-	(*SMSeedRequest)(nil),          // 4: sourcemod.v1.SMSeedRequest
-	(*SMSeedResponse)(nil),         // 5: sourcemod.v1.SMSeedResponse
-	(*SMOverride)(nil),             // 6: sourcemod.v1.SMOverride
-	(*SMOverridesResponse)(nil),    // 7: sourcemod.v1.SMOverridesResponse
-	(*SMCheckRequest)(nil),         // 8: sourcemod.v1.SMCheckRequest
-	(*SMCheckResponse)(nil),        // 9: sourcemod.v1.SMCheckResponse
-	(*SMGroupImmunity)(nil),        // 10: sourcemod.v1.SMGroupImmunity
-	(*SMGroupsResponse)(nil),       // 11: sourcemod.v1.SMGroupsResponse
-	(*SMUsersResponse)(nil),        // 12: sourcemod.v1.SMUsersResponse
-	(*SMUser)(nil),                 // 13: sourcemod.v1.SMUser
-	(*SMUserGroup)(nil),            // 14: sourcemod.v1.SMUserGroup
-	(OverrideType)(0),              // 15: sourcemod.v1.OverrideType
-	(v1.BanType)(0),                // 16: ban.v1.BanType
-	(*Group)(nil),                  // 17: sourcemod.v1.Group
-	(*emptypb.Empty)(nil),          // 18: google.protobuf.Empty
+	(*SMStacLogRequest)(nil),       // 0: sourcemod.v1.SMStacLogRequest
+	(*SMStacLogResponse)(nil),      // 1: sourcemod.v1.SMStacLogResponse
+	(*SMPingModRequest)(nil),       // 2: sourcemod.v1.SMPingModRequest
+	(*SMPingModResponse)(nil),      // 3: sourcemod.v1.SMPingModResponse
+	(*SMAuthenticateRequest)(nil),  // 4: sourcemod.v1.SMAuthenticateRequest
+	(*SMAuthenticateResponse)(nil), // 5: sourcemod.v1.SMAuthenticateResponse
+	(*SMSeedRequest)(nil),          // 6: sourcemod.v1.SMSeedRequest
+	(*SMSeedResponse)(nil),         // 7: sourcemod.v1.SMSeedResponse
+	(*SMOverride)(nil),             // 8: sourcemod.v1.SMOverride
+	(*SMOverridesResponse)(nil),    // 9: sourcemod.v1.SMOverridesResponse
+	(*SMCheckRequest)(nil),         // 10: sourcemod.v1.SMCheckRequest
+	(*SMCheckResponse)(nil),        // 11: sourcemod.v1.SMCheckResponse
+	(*SMGroupImmunity)(nil),        // 12: sourcemod.v1.SMGroupImmunity
+	(*SMGroupsResponse)(nil),       // 13: sourcemod.v1.SMGroupsResponse
+	(*SMUsersResponse)(nil),        // 14: sourcemod.v1.SMUsersResponse
+	(*SMUser)(nil),                 // 15: sourcemod.v1.SMUser
+	(*SMUserGroup)(nil),            // 16: sourcemod.v1.SMUserGroup
+	(OverrideType)(0),              // 17: sourcemod.v1.OverrideType
+	(v1.BanType)(0),                // 18: ban.v1.BanType
+	(*Group)(nil),                  // 19: sourcemod.v1.Group
+	(*emptypb.Empty)(nil),          // 20: google.protobuf.Empty
 }
 var file_sourcemod_v1_plugin_proto_depIdxs = []int32{
-	15, // 0: sourcemod.v1.SMOverride.override_type:type_name -> sourcemod.v1.OverrideType
-	6,  // 1: sourcemod.v1.SMOverridesResponse.overrides:type_name -> sourcemod.v1.SMOverride
-	16, // 2: sourcemod.v1.SMCheckResponse.ban_type:type_name -> ban.v1.BanType
-	17, // 3: sourcemod.v1.SMGroupsResponse.groups:type_name -> sourcemod.v1.Group
-	10, // 4: sourcemod.v1.SMGroupsResponse.immunities:type_name -> sourcemod.v1.SMGroupImmunity
-	13, // 5: sourcemod.v1.SMUsersResponse.users:type_name -> sourcemod.v1.SMUser
-	14, // 6: sourcemod.v1.SMUsersResponse.user_groups:type_name -> sourcemod.v1.SMUserGroup
-	2,  // 7: sourcemod.v1.PluginService.SMAuthenticate:input_type -> sourcemod.v1.SMAuthenticateRequest
-	8,  // 8: sourcemod.v1.PluginService.SMCheck:input_type -> sourcemod.v1.SMCheckRequest
-	18, // 9: sourcemod.v1.PluginService.SMOverrides:input_type -> google.protobuf.Empty
-	18, // 10: sourcemod.v1.PluginService.SMUsers:input_type -> google.protobuf.Empty
-	18, // 11: sourcemod.v1.PluginService.SMGroups:input_type -> google.protobuf.Empty
-	4,  // 12: sourcemod.v1.PluginService.SMSeed:input_type -> sourcemod.v1.SMSeedRequest
-	0,  // 13: sourcemod.v1.PluginService.SMPingMod:input_type -> sourcemod.v1.SMPingModRequest
-	3,  // 14: sourcemod.v1.PluginService.SMAuthenticate:output_type -> sourcemod.v1.SMAuthenticateResponse
-	9,  // 15: sourcemod.v1.PluginService.SMCheck:output_type -> sourcemod.v1.SMCheckResponse
-	7,  // 16: sourcemod.v1.PluginService.SMOverrides:output_type -> sourcemod.v1.SMOverridesResponse
-	12, // 17: sourcemod.v1.PluginService.SMUsers:output_type -> sourcemod.v1.SMUsersResponse
-	11, // 18: sourcemod.v1.PluginService.SMGroups:output_type -> sourcemod.v1.SMGroupsResponse
-	5,  // 19: sourcemod.v1.PluginService.SMSeed:output_type -> sourcemod.v1.SMSeedResponse
-	18, // 20: sourcemod.v1.PluginService.SMPingMod:output_type -> google.protobuf.Empty
-	14, // [14:21] is the sub-list for method output_type
-	7,  // [7:14] is the sub-list for method input_type
+	17, // 0: sourcemod.v1.SMOverride.override_type:type_name -> sourcemod.v1.OverrideType
+	8,  // 1: sourcemod.v1.SMOverridesResponse.overrides:type_name -> sourcemod.v1.SMOverride
+	18, // 2: sourcemod.v1.SMCheckResponse.ban_type:type_name -> ban.v1.BanType
+	19, // 3: sourcemod.v1.SMGroupsResponse.groups:type_name -> sourcemod.v1.Group
+	12, // 4: sourcemod.v1.SMGroupsResponse.immunities:type_name -> sourcemod.v1.SMGroupImmunity
+	15, // 5: sourcemod.v1.SMUsersResponse.users:type_name -> sourcemod.v1.SMUser
+	16, // 6: sourcemod.v1.SMUsersResponse.user_groups:type_name -> sourcemod.v1.SMUserGroup
+	4,  // 7: sourcemod.v1.PluginService.SMAuthenticate:input_type -> sourcemod.v1.SMAuthenticateRequest
+	10, // 8: sourcemod.v1.PluginService.SMCheck:input_type -> sourcemod.v1.SMCheckRequest
+	20, // 9: sourcemod.v1.PluginService.SMOverrides:input_type -> google.protobuf.Empty
+	20, // 10: sourcemod.v1.PluginService.SMUsers:input_type -> google.protobuf.Empty
+	20, // 11: sourcemod.v1.PluginService.SMGroups:input_type -> google.protobuf.Empty
+	6,  // 12: sourcemod.v1.PluginService.SMSeed:input_type -> sourcemod.v1.SMSeedRequest
+	2,  // 13: sourcemod.v1.PluginService.SMPingMod:input_type -> sourcemod.v1.SMPingModRequest
+	0,  // 14: sourcemod.v1.PluginService.SMStacLog:input_type -> sourcemod.v1.SMStacLogRequest
+	5,  // 15: sourcemod.v1.PluginService.SMAuthenticate:output_type -> sourcemod.v1.SMAuthenticateResponse
+	11, // 16: sourcemod.v1.PluginService.SMCheck:output_type -> sourcemod.v1.SMCheckResponse
+	9,  // 17: sourcemod.v1.PluginService.SMOverrides:output_type -> sourcemod.v1.SMOverridesResponse
+	14, // 18: sourcemod.v1.PluginService.SMUsers:output_type -> sourcemod.v1.SMUsersResponse
+	13, // 19: sourcemod.v1.PluginService.SMGroups:output_type -> sourcemod.v1.SMGroupsResponse
+	7,  // 20: sourcemod.v1.PluginService.SMSeed:output_type -> sourcemod.v1.SMSeedResponse
+	20, // 21: sourcemod.v1.PluginService.SMPingMod:output_type -> google.protobuf.Empty
+	1,  // 22: sourcemod.v1.PluginService.SMStacLog:output_type -> sourcemod.v1.SMStacLogResponse
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
 	7,  // [7:7] is the sub-list for extension type_name
 	7,  // [7:7] is the sub-list for extension extendee
 	0,  // [0:7] is the sub-list for field type_name
@@ -991,7 +1101,7 @@ func file_sourcemod_v1_plugin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sourcemod_v1_plugin_proto_rawDesc), len(file_sourcemod_v1_plugin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -50,6 +50,8 @@ const (
 	PluginServiceSMSeedProcedure = "/sourcemod.v1.PluginService/SMSeed"
 	// PluginServiceSMPingModProcedure is the fully-qualified name of the PluginService's SMPingMod RPC.
 	PluginServiceSMPingModProcedure = "/sourcemod.v1.PluginService/SMPingMod"
+	// PluginServiceSMStacLogProcedure is the fully-qualified name of the PluginService's SMStacLog RPC.
+	PluginServiceSMStacLogProcedure = "/sourcemod.v1.PluginService/SMStacLog"
 )
 
 // PluginServiceClient is a client for the sourcemod.v1.PluginService service.
@@ -62,6 +64,8 @@ type PluginServiceClient interface {
 	SMGroups(context.Context, *emptypb.Empty) (*v1.SMGroupsResponse, error)
 	SMSeed(context.Context, *v1.SMSeedRequest) (*v1.SMSeedResponse, error)
 	SMPingMod(context.Context, *v1.SMPingModRequest) (*emptypb.Empty, error)
+	// Push StAC log entries as they are written so they can be acted upon immediately.
+	SMStacLog(context.Context, *v1.SMStacLogRequest) (*v1.SMStacLogResponse, error)
 }
 
 // NewPluginServiceClient constructs a client for the sourcemod.v1.PluginService service. By
@@ -117,6 +121,12 @@ func NewPluginServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(pluginServiceMethods.ByName("SMPingMod")),
 			connect.WithClientOptions(opts...),
 		),
+		sMStacLog: connect.NewClient[v1.SMStacLogRequest, v1.SMStacLogResponse](
+			httpClient,
+			baseURL+PluginServiceSMStacLogProcedure,
+			connect.WithSchema(pluginServiceMethods.ByName("SMStacLog")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -129,6 +139,7 @@ type pluginServiceClient struct {
 	sMGroups       *connect.Client[emptypb.Empty, v1.SMGroupsResponse]
 	sMSeed         *connect.Client[v1.SMSeedRequest, v1.SMSeedResponse]
 	sMPingMod      *connect.Client[v1.SMPingModRequest, emptypb.Empty]
+	sMStacLog      *connect.Client[v1.SMStacLogRequest, v1.SMStacLogResponse]
 }
 
 // SMAuthenticate calls sourcemod.v1.PluginService.SMAuthenticate.
@@ -194,6 +205,15 @@ func (c *pluginServiceClient) SMPingMod(ctx context.Context, req *v1.SMPingModRe
 	return nil, err
 }
 
+// SMStacLog calls sourcemod.v1.PluginService.SMStacLog.
+func (c *pluginServiceClient) SMStacLog(ctx context.Context, req *v1.SMStacLogRequest) (*v1.SMStacLogResponse, error) {
+	response, err := c.sMStacLog.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // PluginServiceHandler is an implementation of the sourcemod.v1.PluginService service.
 type PluginServiceHandler interface {
 	// Sourcemod plugin surface
@@ -204,6 +224,8 @@ type PluginServiceHandler interface {
 	SMGroups(context.Context, *emptypb.Empty) (*v1.SMGroupsResponse, error)
 	SMSeed(context.Context, *v1.SMSeedRequest) (*v1.SMSeedResponse, error)
 	SMPingMod(context.Context, *v1.SMPingModRequest) (*emptypb.Empty, error)
+	// Push StAC log entries as they are written so they can be acted upon immediately.
+	SMStacLog(context.Context, *v1.SMStacLogRequest) (*v1.SMStacLogResponse, error)
 }
 
 // NewPluginServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -255,6 +277,12 @@ func NewPluginServiceHandler(svc PluginServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(pluginServiceMethods.ByName("SMPingMod")),
 		connect.WithHandlerOptions(opts...),
 	)
+	pluginServiceSMStacLogHandler := connect.NewUnaryHandlerSimple(
+		PluginServiceSMStacLogProcedure,
+		svc.SMStacLog,
+		connect.WithSchema(pluginServiceMethods.ByName("SMStacLog")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/sourcemod.v1.PluginService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PluginServiceSMAuthenticateProcedure:
@@ -271,6 +299,8 @@ func NewPluginServiceHandler(svc PluginServiceHandler, opts ...connect.HandlerOp
 			pluginServiceSMSeedHandler.ServeHTTP(w, r)
 		case PluginServiceSMPingModProcedure:
 			pluginServiceSMPingModHandler.ServeHTTP(w, r)
+		case PluginServiceSMStacLogProcedure:
+			pluginServiceSMStacLogHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -307,3 +337,7 @@ func (UnimplementedPluginServiceHandler) SMSeed(context.Context, *v1.SMSeedReque
 func (UnimplementedPluginServiceHandler) SMPingMod(context.Context, *v1.SMPingModRequest) (*emptypb.Empty, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sourcemod.v1.PluginService.SMPingMod is not implemented"))
 }
+
+func (UnimplementedPluginServiceHandler) SMStacLog(context.Context, *v1.SMStacLogRequest) (*v1.SMStacLogResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("sourcemod.v1.PluginService.SMStacLog is not implemented"))
+}
@@ -0,0 +1,327 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/leighmacdonald/gbans/pkg/broadcaster"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+)
+
+type PersonProvider interface {
+	EnsurePerson(ctx context.Context, steamID steamid.SteamID) error
+}
+
+// Recorder follows the live log stream of every server, aggregating each game into a logparse.Match
+// from the start of the log/map until the game is over and the final scores are known, at which point it
+// is persisted.
+type Recorder struct {
+	stats       Stats
+	persons     PersonProvider
+	broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]
+	matches     map[int32]*recording
+}
+
+// recording is the match in progress on a server.
+type recording struct {
+	match *logparse.Match
+	// over is set by the game over event, the final scores of each team are logged after it.
+	over        bool
+	finalScores int
+}
+
+func NewRecorder(stats Stats, persons PersonProvider, broadcaster *broadcaster.Broadcaster[logparse.EventType, logparse.ServerEvent]) *Recorder {
+	return &Recorder{
+		stats:       stats,
+		persons:     persons,
+		broadcaster: broadcaster,
+		matches:     map[int32]*recording{},
+	}
+}
+
+func (r *Recorder) Start(ctx context.Context) {
+	// Blocking so that no events are lost from an in progress match.
+	consumer, errRegister := r.broadcaster.Subscribe("match_recorder", broadcaster.Options{Policy: broadcaster.Block})
+	if errRegister != nil {
+		slog.Warn("Match recorder failed to register consumer", slog.String("error", errRegister.Error()))
+
+		return
+	}
+	defer r.broadcaster.Unsubscribe(consumer)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case evt := <-consumer.C:
+			if match := r.Apply(evt); match != nil {
+				go r.save(ctx, match)
+			}
+		}
+	}
+}
+
+// Apply updates the current match of the events server, returning the match once it is completed. A match
+// completes once both final scores following the game over have been logged. Should they be missing, the
+// next round, map or log completes it instead.
+func (r *Recorder) Apply(evt logparse.ServerEvent) *logparse.Match {
+	var completed *logparse.Match
+
+	if current, found := r.matches[evt.ServerID]; found && current.over {
+		switch evt.EventType {
+		case logparse.WRoundStart, logparse.LogStart, logparse.MapStarted, logparse.LogStop:
+			completed = current.match
+			delete(r.matches, evt.ServerID)
+		default:
+		}
+	}
+
+	switch evt.EventType {
+	case logparse.LogStart:
+		r.begin(evt)
+	case logparse.MapStarted:
+		// The log may have started before we were listening.
+		if _, found := r.matches[evt.ServerID]; !found {
+			r.begin(evt)
+		}
+	case logparse.LogStop:
+		// Map changed before the game finished.
+		delete(r.matches, evt.ServerID)
+
+		return completed
+	default:
+	}
+
+	current, found := r.matches[evt.ServerID]
+	if !found {
+		return completed
+	}
+
+	if errApply := current.match.Apply(&evt.Results); errApply != nil && !errors.Is(errApply, logparse.ErrIgnored) &&
+		!errors.Is(errApply, logparse.ErrUnhandled) && !errors.Is(errApply, logparse.ErrUnhandledEvent) {
+		slog.Debug("Failed to apply match event", slog.Int("server_id", int(evt.ServerID)),
+			slog.String("error", errApply.Error()))
+	}
+
+	switch evt.EventType {
+	case logparse.WGameOver:
+		current.over = true
+	case logparse.WTeamFinalScore:
+		if !current.over {
+			break
+		}
+
+		current.finalScores++
+		if current.finalScores == 2 {
+			delete(r.matches, evt.ServerID)
+
+			return current.match
+		}
+	default:
+	}
+
+	return completed
+}
+
+func (r *Recorder) begin(evt logparse.ServerEvent) {
+	match := logparse.NewMatch(int(evt.ServerID), evt.ServerName)
+	match.TimeStart = &evt.CreatedOn
+
+	r.matches[evt.ServerID] = &recording{match: &match}
+}
+
+func (r *Recorder) save(ctx context.Context, logMatch *logparse.Match) {
+	match, errMatch := FromLogMatch(logMatch)
+	if errMatch != nil {
+		slog.Debug("Skipping match", slog.Int("server_id", logMatch.ServerID), slog.String("reason", errMatch.Error()))
+
+		return
+	}
+
+	for _, player := range match.Players {
+		if errPerson := r.persons.EnsurePerson(ctx, player.SteamID); errPerson != nil {
+			slog.Error("Failed to ensure match player", slog.String("error", errPerson.Error()))
+
+			return
+		}
+	}
+
+	if errSave := r.stats.SaveMatch(ctx, &match); errSave != nil {
+		slog.Error("Failed to save match", slog.Int("server_id", logMatch.ServerID), slog.String("error", errSave.Error()))
+
+		return
+	}
+
+	slog.Info("Recorded match from logs", slog.String("match_id", match.MatchID.String()),
+		slog.String("map", match.MapName), slog.Int("players", len(match.Players)))
+}
+
+// FromLogMatch converts a completed logparse.Match into a Match. The log stream only provides per match
+// player totals, so each players totals and their class and weapon variants are attributed to the final
+// round, while the rounds themselves keep their own winners and lengths.
+func FromLogMatch(logMatch *logparse.Match) (Match, error) {
+	if logMatch.TimeStart == nil || logMatch.TimeEnd == nil {
+		return Match{}, fmt.Errorf("%w: incomplete match", ErrInvalidState)
+	}
+
+	if logMatch.MapName == "" {
+		return Match{}, fmt.Errorf("%w: empty map invalid", ErrInvalidState)
+	}
+
+	duration := logMatch.TimeEnd.Sub(*logMatch.TimeStart)
+	if duration < MinDuraion*time.Second {
+		return Match{}, fmt.Errorf("%w: match too short", ErrInvalidState)
+	}
+
+	match := Match{
+		MatchID:    logMatch.MatchID,
+		ServerID:   int32(logMatch.ServerID), //nolint:gosec
+		MapName:    logMatch.MapName,
+		Hostname:   logMatch.Title,
+		ScoreRed:   uint32(max(0, logMatch.TeamScores.Red)), //nolint:gosec
+		ScoreBlu:   uint32(max(0, logMatch.TeamScores.Blu)), //nolint:gosec
+		StartTime:  *logMatch.TimeStart,
+		DurationMs: uint64(duration.Milliseconds()), //nolint:gosec
+		CreatedOn:  time.Now(),
+	}
+
+	for _, round := range logMatch.Rounds {
+		// The first round placeholder only holds the pre-game when the map has rounds.
+		if round.Length == 0 && round.RoundWinner == logparse.UNASSIGNED {
+			continue
+		}
+
+		match.Rounds = append(match.Rounds, MatchRound{
+			Winner:      strings.ToLower(round.RoundWinner.String()),
+			IsStalemate: round.RoundWinner != logparse.RED && round.RoundWinner != logparse.BLU,
+			DurationMs:  uint64(round.Length.Milliseconds()), //nolint:gosec
+		})
+	}
+
+	if len(match.Rounds) == 0 {
+		return Match{}, fmt.Errorf("%w not enough rounds", ErrInvalidState)
+	}
+
+	lastRound := uint32(len(match.Rounds) - 1) //nolint:gosec
+
+	for _, player := range logMatch.PlayerSums {
+		if !player.SteamID.Valid() || (len(player.Classes) == 0 && player.Team != logparse.RED && player.Team != logparse.BLU) {
+			continue
+		}
+
+		match.Players = append(match.Players, MatchOverallStatsRound{
+			OverallStats: overallFromLog(player),
+			Team:         strings.ToLower(player.Team.String()),
+			RoundID:      lastRound,
+		})
+
+		for weapon, weaponStats := range player.WeaponInfo {
+			if weapon == "" {
+				continue
+			}
+
+			match.Variants = append(match.Variants, MatchVariantStatsRound{
+				RoundID: lastRound,
+				VariantStats: VariantStats{
+					SteamID:   player.SteamID,
+					Variant:   string(weapon),
+					Kills:     count(weaponStats.Kills),
+					Damage:    count(weaponStats.Damage),
+					Shots:     count(weaponStats.Shots),
+					Hits:      count(weaponStats.Hits),
+					Airshots:  count(weaponStats.Airshots),
+					Headshots: count(weaponStats.Headshots),
+					Backstabs: count(weaponStats.BackStabs),
+				},
+			})
+		}
+
+		for class, classStats := range player.Classes {
+			if class == logparse.Spectator {
+				continue
+			}
+
+			match.Variants = append(match.Variants, MatchVariantStatsRound{
+				RoundID: lastRound,
+				VariantStats: VariantStats{
+					SteamID:          player.SteamID,
+					Variant:          class.String(),
+					Kills:            count(classStats.Kills),
+					Assists:          count(classStats.Assists),
+					Deaths:           count(classStats.Deaths),
+					Dominations:      count(classStats.Dominations),
+					Dominated:        count(classStats.Dominated),
+					Revenges:         count(classStats.Revenges),
+					Damage:           count(classStats.Damage),
+					DamageTaken:      count(classStats.DamageTaken),
+					Captures:         count(classStats.Captures),
+					CapturesBlocked:  count(classStats.CapturesBlocked),
+					ObjectsDestroyed: count(classStats.BuildingsDestroyed),
+				},
+			})
+		}
+	}
+
+	if len(match.Players) < MinPlayers {
+		return Match{}, fmt.Errorf("%w: not enough players", ErrInvalidState)
+	}
+
+	return match, nil
+}
+
+func overallFromLog(player *logparse.PlayerStats) OverallStats {
+	stats := OverallStats{
+		SteamID:          player.SteamID,
+		Personaname:      player.Name,
+		ConnectionCount:  1,
+		Kills:            count(player.KillCount()),
+		Assists:          count(player.Assists),
+		Deaths:           count(player.Deaths()),
+		Damage:           count(player.Damage()),
+		DamageTaken:      count(player.DamageTaken()),
+		Dominations:      count(player.DominationCount()),
+		Dominated:        count(player.DominatedCount()),
+		Revenges:         count(player.RevengeCount()),
+		Airshots:         count(player.AirShots()),
+		Headshots:        count(player.HeadShots()),
+		Backstabs:        count(player.BackStabs()),
+		Captures:         count(player.CaptureCount()),
+		CapturesBlocked:  count(player.CapturesBlockedCount()),
+		Suicides:         count(player.Suicides),
+		Extinguishes:     count(player.Extinguishes()),
+		ObjectsBuilt:     count(player.BuildingBuilt),
+		ObjectsDestroyed: count(player.BuildingDestroyed),
+	}
+
+	for _, weaponStats := range player.WeaponInfo {
+		stats.Shots += count(weaponStats.Shots)
+		stats.Hits += count(weaponStats.Hits)
+	}
+
+	if player.HealingStats != nil {
+		stats.Healing = count(player.HealingStats.Healing)
+		stats.Drops = count(player.HealingStats.DropsTotal())
+		stats.NearFullChargeDeath = count(player.HealingStats.NearFullChargeDeath)
+		stats.ChargesUber = count(player.HealingStats.Charges[logparse.Uber])
+		stats.ChargesKritz = count(player.HealingStats.Charges[logparse.Kritzkrieg])
+		stats.ChargesVacc = count(player.HealingStats.Charges[logparse.Vaccinator])
+		stats.ChargesQuickfix = count(player.HealingStats.Charges[logparse.QuickFix])
+	}
+
+	stats.ScoreboardKills = stats.Kills
+	stats.ScoreboardAssists = stats.Assists
+	stats.ScoreboardDeaths = stats.Deaths
+	stats.ScoreboardDamage = stats.Damage
+	stats.ScoreboardHealing = stats.Healing
+
+	return stats
+}
+
+func count(value int) uint64 {
+	return uint64(max(0, value)) //nolint:gosec
+}
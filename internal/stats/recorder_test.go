@@ -0,0 +1,118 @@
+package stats_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/leighmacdonald/gbans/internal/stats"
+	"github.com/leighmacdonald/gbans/pkg/logparse"
+	"github.com/leighmacdonald/steamid/v4/steamid"
+	"github.com/stretchr/testify/require"
+)
+
+// recordLog drives the lines of the log through a Recorder, returning the completed matches.
+func recordLog(t *testing.T, recorder *stats.Recorder, lines []string) []*logparse.Match {
+	t.Helper()
+
+	var (
+		parser    = logparse.NewLogParser()
+		completed []*logparse.Match
+	)
+
+	for _, line := range lines {
+		result, errParse := parser.Parse(line)
+		require.NoError(t, errParse)
+
+		evt := logparse.ServerEvent{Results: result, Raw: line, ServerID: 1, ServerName: "test server"}
+		if started, ok := result.Event.(logparse.LogStartEvt); ok {
+			evt.CreatedOn = started.CreatedOn
+		}
+
+		if match := recorder.Apply(evt); match != nil {
+			completed = append(completed, match)
+		}
+	}
+
+	return completed
+}
+
+func TestFromLogMatch(t *testing.T) {
+	body, errRead := os.ReadFile("testdata/match_1.log")
+	require.NoError(t, errRead)
+
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	recorder := stats.NewRecorder(stats.Stats{}, nil, nil)
+
+	// The final scores are logged after the game over event, so the match is only completed by the last line.
+	require.Empty(t, recordLog(t, recorder, lines[:len(lines)-1]))
+	completed := recordLog(t, recorder, lines[len(lines)-1:])
+	require.Len(t, completed, 1)
+
+	logMatch := completed[0]
+
+	match, errMatch := stats.FromLogMatch(logMatch)
+	require.NoError(t, errMatch)
+	require.Equal(t, "pl_upward", match.MapName)
+	require.Equal(t, uint32(1), match.ScoreRed)
+	require.Equal(t, uint32(1), match.ScoreBlu)
+	require.Equal(t, uint64(670000), match.DurationMs)
+
+	require.Len(t, match.Rounds, 2)
+	require.Equal(t, "red", match.Rounds[0].Winner)
+	require.Equal(t, "blu", match.Rounds[1].Winner)
+	require.False(t, match.Rounds[1].IsStalemate)
+	require.Equal(t, uint64(300000), match.Rounds[1].DurationMs)
+
+	require.Len(t, match.Players, 2)
+
+	scout := steamid.New("[U:1:68745073]")
+	for _, player := range match.Players {
+		require.Equal(t, uint32(1), player.RoundID)
+
+		if player.SteamID != scout {
+			continue
+		}
+
+		require.Equal(t, "red", player.Team)
+		require.Equal(t, uint64(1), player.Kills)
+		require.Equal(t, uint64(1), player.Deaths)
+		require.Equal(t, uint64(200), player.Damage)
+		require.Equal(t, uint64(125), player.DamageTaken)
+	}
+
+	variants := map[string]stats.VariantStats{}
+	for _, variant := range match.Variants {
+		if variant.SteamID == scout {
+			variants[variant.Variant] = variant.VariantStats
+		}
+	}
+
+	require.Contains(t, variants, "scattergun")
+	require.Equal(t, uint64(1), variants["scattergun"].Kills)
+	require.Contains(t, variants, "scout")
+	require.Equal(t, uint64(1), variants["scout"].Kills)
+
+	logMatch.TimeEnd = nil
+	_, errIncomplete := stats.FromLogMatch(logMatch)
+	require.ErrorIs(t, errIncomplete, stats.ErrInvalidState)
+}
+
+func TestRecorderMissingFinalScore(t *testing.T) {
+	body, errRead := os.ReadFile("testdata/match_1.log")
+	require.NoError(t, errRead)
+
+	lines := strings.Split(strings.TrimSpace(string(body)), "\n")
+	recorder := stats.NewRecorder(stats.Stats{}, nil, nil)
+
+	// Without the final scores, the next map completes the match with the scores it has.
+	require.Empty(t, recordLog(t, recorder, lines[:len(lines)-2]))
+	completed := recordLog(t, recorder, lines[1:2])
+	require.Len(t, completed, 1)
+	require.Equal(t, "pl_upward", completed[0].MapName)
+	require.NotNil(t, completed[0].TimeEnd)
+
+	// Changing map before the game is over discards the match.
+	require.Empty(t, recordLog(t, recorder, lines[2:len(lines)-3]))
+	require.Empty(t, recordLog(t, recorder, lines[:1]))
+}
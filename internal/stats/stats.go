@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-co-op/gocron/v2"
 	"github.com/gofrs/uuid/v5"
+	"github.com/leighmacdonald/gbans/internal/database"
 	"github.com/leighmacdonald/gbans/internal/database/query"
 	"github.com/leighmacdonald/gbans/internal/maps"
 	"github.com/leighmacdonald/gbans/internal/rpc"
@@ -246,6 +247,16 @@ func (s Stats) Import(ctx context.Context, serverID int32, demoID int32, demo *d
 		return nil, fmt.Errorf("%w: empty map invalid", ErrInvalidState)
 	}
 
+	// Prefer the match already recorded from the server logs when one exists.
+	linkedID, errLink := s.repo.LinkDemo(ctx, serverID, demoID, timeStart)
+	if errLink == nil {
+		return &linkedID, nil
+	}
+
+	if !errors.Is(errLink, database.ErrNoResult) {
+		return nil, errLink
+	}
+
 	mapInfo, errMap := s.maps.Get(ctx, demo.Map)
 	if errMap != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidState, errMap)
@@ -265,6 +276,30 @@ func (s Stats) Import(ctx context.Context, serverID int32, demoID int32, demo *d
 	return &matchID, nil
 }
 
+// SaveMatch persists a match recorded from the live server logs.
+func (s Stats) SaveMatch(ctx context.Context, match *Match) error {
+	if match.MapName == "" {
+		return fmt.Errorf("%w: empty map invalid", ErrInvalidState)
+	}
+
+	if len(match.Players) < MinPlayers {
+		return fmt.Errorf("%w: not enough players", ErrInvalidState)
+	}
+
+	if len(match.Rounds) == 0 {
+		return fmt.Errorf("%w not enough rounds", ErrInvalidState)
+	}
+
+	mapInfo, errMap := s.maps.Get(ctx, match.MapName)
+	if errMap != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidState, errMap)
+	}
+
+	match.MapID = mapInfo.MapID
+
+	return s.repo.SaveMatch(ctx, match)
+}
+
 func (s Stats) Query(ctx context.Context, opts Opts) ([]any, uint64, error) {
 	if (opts.Variant == VariantWeapons || opts.Variant == VariantClasses) && opts.VariantKey == "" {
 		return nil, 0, fmt.Errorf("%w: variantKey must be set ", rpc.ErrBadRequest)
@@ -97,7 +97,7 @@ func (r Repository) Delete(ctx context.Context, demoID int32) error {
 
 func (r Repository) Matches(ctx context.Context, opts MatchesOpts) ([]Match, uint64, error) {
 	builder := r.Builder().Select("m.match_id", "m.server_id", "m.map_id", "mp.map_name",
-		"m.demo_id", "s.stats_bucket_id", "s.bucket_name", "m.hostname",
+		"COALESCE(m.demo_id, 0)", "s.stats_bucket_id", "s.bucket_name", "m.hostname",
 		"m.score_red", "m.score_blu", "m.duration_ms", "m.created_on",
 		"srv.name", "srv.short_name", "m.start_time").
 		From("match m").
@@ -468,7 +468,7 @@ func (r Repository) getRoundPlayersVariants(ctx context.Context, match *Match) e
 func (r Repository) MatchesWithPlayer(ctx context.Context, steamID steamid.SteamID) ([]PlayerMatchHistory, error) {
 	const query = `
 		SELECT DISTINCT
-			m.match_id, m.server_id, m.map_id, mp.map_name, m.demo_id, s.stats_bucket_id,
+			m.match_id, m.server_id, m.map_id, mp.map_name, COALESCE(m.demo_id, 0), s.stats_bucket_id,
 			s.bucket_name, m.hostname, m.score_red, m.score_blu,
 			m.duration_ms, m.created_on, m.start_time, srv.name, srv.short_name
 		FROM match m
@@ -502,7 +502,7 @@ func (r Repository) MatchesWithPlayer(ctx context.Context, steamID steamid.Steam
 func (r Repository) getMatch(ctx context.Context, matchID uuid.UUID) (*Match, error) {
 	const query = `
 		SELECT
-			m.match_id, m.server_id, m.map_id, m.demo_id, m.stats_bucket_id, m.hostname, m.score_red, m.score_blu,
+			m.match_id, m.server_id, m.map_id, COALESCE(m.demo_id, 0), m.stats_bucket_id, m.hostname, m.score_red, m.score_blu,
 			m.start_time, m.duration_ms, m.created_on, COALESCE(a.asset_id, '00000000-0000-0000-0000-000000000000')
 		FROM match m
 		LEFT JOIN demo d USING (demo_id)
@@ -726,6 +726,141 @@ func (r Repository) insertRoundPlayerVariants(ctx context.Context, transaction p
 	return nil
 }
 
+// SaveMatch inserts a match built from the live server logs. The match has no demo until LinkDemo
+// attaches one. The stats bucket of the server is used, falling back to the default bucket.
+func (r Repository) SaveMatch(ctx context.Context, match *Match) error {
+	return database.Err(r.WrapTx(ctx, func(transaction pgx.Tx) error {
+		if err := transaction.QueryRow(ctx, `
+			INSERT INTO match (
+				match_id, server_id, map_id, demo_id, stats_bucket_id, hostname,
+				score_red, score_blu, start_time, duration_ms, created_on)
+			VALUES (
+				$1, $2, $3, NULL, COALESCE((SELECT stats_bucket_id FROM server WHERE server_id = $2), 1), $4,
+				$5, $6, $7, $8, $9
+			)
+			RETURNING stats_bucket_id`,
+			match.MatchID, match.ServerID, match.MapID, match.Hostname, match.ScoreRed, match.ScoreBlu,
+			match.StartTime, match.DurationMs, match.CreatedOn).Scan(&match.StatsBucketID); err != nil {
+			return err
+		}
+
+		roundIDs := make([]int64, len(match.Rounds))
+		for idx, round := range match.Rounds {
+			if err := transaction.QueryRow(ctx, `
+				INSERT INTO match_round (match_id, winner, is_stalemate, is_sudden_death, duration_ms)
+				VALUES ($1, $2, $3, $4, $5)
+				RETURNING round_id`,
+				match.MatchID, toTfTeam(round.Winner), round.IsStalemate, round.IsSuddenDeath, round.DurationMs).
+				Scan(&roundIDs[idx]); err != nil {
+				return err
+			}
+
+			match.Rounds[idx].RoundID = uint32(roundIDs[idx]) //nolint:gosec
+		}
+
+		for _, player := range match.Players {
+			if err := insertMatchPlayer(ctx, transaction, roundIDs[player.RoundID], player); err != nil {
+				return err
+			}
+		}
+
+		for _, variant := range match.Variants {
+			if err := insertMatchVariant(ctx, transaction, roundIDs[variant.RoundID], variant); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}))
+}
+
+func insertMatchPlayer(ctx context.Context, transaction pgx.Tx, roundID int64, player MatchOverallStatsRound) error {
+	const query = `
+		INSERT INTO match_round_player (
+			round_id, steam_id, team, mvp, tick_start, tick_end, kills, assists, deaths, postround_kills,
+			postround_assists, postround_deaths, preround_healing, healing, postround_healing, drops,
+			near_full_charge_death, charges_uber, charges_kritz, charges_vacc, charges_quickfix, damage,
+			damage_taken, dominations, dominated, revenges, revenged, airshots, headshots, headshot_kills,
+			backstabs, backstab_kills, captures, captures_blocked, was_headshot, was_backstabbed,
+			shots, hits, objects_built, objects_destroyed,
+			points, connection_count, bonus_points, scoreboard_kills, scoreboard_assists, scoreboard_healing, scoreboard_deaths,
+			scoreboard_damage, suicides, extinguishes, ignites)
+		VALUES(
+			$1,  $2,  $3,  $4,  $5,  $6,  $7,  $8,  $9,  $10,
+			$11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
+			$21, $22, $23, $24, $25, $26, $27, $28, $29, $30,
+			$31, $32, $33, $34, $35, $36, $37, $38, $39, $40,
+			$41, $42, $43, $44, $45, $46, $47, $48, $49, $50,
+			$51
+		)`
+
+	_, err := transaction.Exec(ctx, query,
+		roundID, player.SteamID.Int64(), toTfTeam(player.Team), player.MVP, player.TickStart, player.TickEnd,
+		player.Kills, player.Assists, player.Deaths, player.PostroundKills, player.PostroundAssists, player.PostroundDeaths,
+		player.PreroundHealing, player.Healing, player.PostroundHealing, player.Drops, player.NearFullChargeDeath,
+		player.ChargesUber, player.ChargesKritz, player.ChargesVacc, player.ChargesQuickfix, player.Damage,
+		player.DamageTaken, player.Dominations, player.Dominated, player.Revenges, player.Revenged,
+		player.Airshots, player.Headshots, player.HeadshotKills, player.Backstabs, player.BackstabKills,
+		player.Captures, player.CapturesBlocked, player.WasHeadshot, player.WasBackstabbed, player.Shots,
+		player.Hits, player.ObjectsBuilt, player.ObjectsDestroyed, player.Points, player.ConnectionCount,
+		player.BonusPoints, player.ScoreboardKills, player.ScoreboardAssists, player.ScoreboardHealing,
+		player.ScoreboardDeaths, player.ScoreboardDamage, player.Suicides, player.Extinguishes,
+		player.Ignites)
+
+	return err
+}
+
+func insertMatchVariant(ctx context.Context, transaction pgx.Tx, roundID int64, variant MatchVariantStatsRound) error {
+	const query = `
+		INSERT INTO match_round_player_variants (
+			variant, round_id, steam_id, kills, assists, deaths, postround_kills,
+			postround_assists, postround_deaths, preround_healing, healing, postround_healing, drops,
+			near_full_charge_death, charges_uber, charges_kritz, charges_vacc, charges_quickfix, damage,
+			damage_taken, dominations, dominated, revenges, revenged, airshots, headshots, headshot_kills,
+			backstabs, backstab_kills, captures, captures_blocked, was_headshot, was_backstabbed,
+			shots, hits, objects_built, objects_destroyed
+		) VALUES (
+			LOWER($1),$2,  $3,  $4,  $5,  $6,  $7,  $8,  $9,  $10,
+			$11, $12, $13, $14, $15, $16, $17, $18, $19, $20,
+			$21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31,
+			$32, $33, $34, $35, $36, $37
+		)`
+
+	_, err := transaction.Exec(ctx, query,
+		variant.Variant, roundID, variant.SteamID.Int64(), variant.Kills, variant.Assists, variant.Deaths, variant.PostroundKills,
+		variant.PostroundAssists, variant.PostroundDeaths, variant.PreroundHealing, variant.Healing, variant.PostroundHealing, variant.Drops,
+		variant.NearFullChargeDeath, variant.ChargesUber, variant.ChargesKritz, variant.ChargesVacc, variant.ChargesQuickfix, variant.Damage,
+		variant.DamageTaken, variant.Dominations, variant.Dominated, variant.Revenges, variant.Revenged, variant.Airshots, variant.Headshots,
+		variant.HeadshotKills, variant.Backstabs, variant.BackstabKills, variant.Captures, variant.CapturesBlocked, variant.WasHeadshot,
+		variant.WasBackstabbed, variant.Shots, variant.Hits, variant.ObjectsBuilt, variant.ObjectsDestroyed)
+
+	return err
+}
+
+// LinkDemo attaches the demo to the log recorded match of the server that was being played when the
+// demo started recording. database.ErrNoResult is returned when there is no such match.
+func (r Repository) LinkDemo(ctx context.Context, serverID int32, demoID int32, demoStart time.Time) (uuid.UUID, error) {
+	var matchID uuid.UUID
+	if err := r.QueryRow(ctx, `
+		UPDATE match
+		SET demo_id = $2
+		WHERE match_id = (
+			SELECT match_id
+			FROM match
+			WHERE server_id = $1
+			  AND demo_id IS NULL
+			  AND $3::timestamptz BETWEEN start_time - interval '5 minutes'
+			                          AND start_time + duration_ms * interval '1 millisecond'
+			ORDER BY abs(extract(EPOCH FROM start_time - $3::timestamptz))
+			LIMIT 1
+		)
+		RETURNING match_id`, serverID, demoID, demoStart).Scan(&matchID); err != nil {
+		return matchID, database.Err(err)
+	}
+
+	return matchID, nil
+}
+
 func (r Repository) GetBucket(ctx context.Context, statsBucketID int32) (*Bucket, error) {
 	const query = "SELECT stats_bucket_id, bucket_name FROM stats_bucket WHERE stats_bucket_id = $1"
 	var bucket Bucket
@@ -119,7 +119,7 @@ func (f Fixture) CreateTestPerson(ctx context.Context, steamID steamid.SteamID,
 }
 
 func (f Fixture) CreateTestServer(ctx context.Context) servers.Server {
-	serverCase, _ := servers.New(servers.NewRepository(f.Database), nil, "")
+	serverCase, _ := servers.New(servers.NewRepository(f.Database), nil, nil, steamid.SteamID{}, "")
 	server, errServer := serverCase.Save(ctx, servers.Server{
 		Name:               stringutil.SecureRandomString(10),
 		ShortName:          stringutil.SecureRandomString(3),
@@ -210,9 +210,6 @@ func TestConfig(ctx context.Context, dsn string) (*config.Configuration, error)
 			WarningLimit:   1,
 			Dry:            false,
 			PingDiscord:    false,
-			MaxWeight:      1,
-			CheckTimeout:   10,
-			MatchTimeout:   10,
 		},
 		Discord: &discord.Config{
 			Enabled: false,
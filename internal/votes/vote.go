@@ -85,12 +85,14 @@ func (u Votes) Start(ctx context.Context) {
 		code    logparse.VoteCode
 	}
 
-	eventChan := make(chan logparse.ServerEvent)
-	if errRegister := u.broadcaster.Consume(eventChan, logparse.VoteSuccess, logparse.VoteFailed, logparse.VoteDetails); errRegister != nil {
-		slog.Warn("logWriter Tried to register duplicate reader channel", slog.String("error", errRegister.Error()))
+	consumer, errRegister := u.broadcaster.Subscribe("votes", broadcaster.Options{Policy: broadcaster.Block},
+		logparse.VoteSuccess, logparse.VoteFailed, logparse.VoteDetails)
+	if errRegister != nil {
+		slog.Warn("Failed to register vote event consumer", slog.String("error", errRegister.Error()))
 
 		return
 	}
+	defer u.broadcaster.Unsubscribe(consumer)
 
 	// Track recent votes and reject duplicates. Sometimes vote results get logged twice
 	var recent []Result
@@ -116,7 +118,7 @@ func (u Votes) Start(ctx context.Context) {
 			}
 
 			recent = valid
-		case evt := <-eventChan:
+		case evt := <-consumer.C:
 			switch evt.EventType {
 			case logparse.VoteSuccess:
 				successEvt, ok := evt.Event.(logparse.VoteSuccessEvt)
@@ -4,106 +4,213 @@ import (
 	"errors"
 	"slices"
 	"sync"
+	"sync/atomic"
 )
 
-var ErrDuplicateChannel = errors.New("duplicate channel registration")
+var (
+	ErrDuplicateConsumer = errors.New("duplicate consumer registration")
+	ErrConsumerName      = errors.New("consumer name cannot be empty")
+)
 
-// Broadcaster implments a very trivial implementation of a fanout style event broadcaster using generics. It uses a map of
-// <-chan readers which receve events based on their on their matching key, or the all matcher, which receives all events..
-type Broadcaster[T comparable, V any] struct {
-	// Each log event can have any number of channels associated with them
-	// Events are sent to all channels in a fan-out style.
-	readerMap    map[T][]chan<- V
-	allReaders   []chan<- V
-	readerMapMu  *sync.RWMutex
-	allReadersMu *sync.RWMutex
-}
+// OverflowPolicy decides what happens to an event when a consumers queue is full.
+type OverflowPolicy int
 
-func New[T comparable, V any]() *Broadcaster[T, V] {
-	return &Broadcaster[T, V]{
-		readerMap:    map[T][]chan<- V{},
-		readerMapMu:  &sync.RWMutex{},
-		allReadersMu: &sync.RWMutex{},
+const (
+	// Block waits for the consumer to make room or unsubscribe, stalling Emit for every other consumer.
+	Block OverflowPolicy = iota
+	// DropOldest discards the oldest queued event to make room for the new one.
+	DropOldest
+	// DropNewest discards the new event, keeping the queue as is.
+	DropNewest
+)
+
+func (p OverflowPolicy) String() string {
+	switch p {
+	case DropOldest:
+		return "drop_oldest"
+	case DropNewest:
+		return "drop_newest"
+	default:
+		return "block"
 	}
 }
 
-// Consume will register a channel to receive new log events as they come in. If no event keys
-// are provided, all events will be sent.
-func (eb *Broadcaster[k, v]) Consume(serverEventChan chan v, keys ...k) error {
-	if len(keys) > 0 {
-		eb.readerMapMu.Lock()
-		for _, msgType := range keys {
-			_, found := eb.readerMap[msgType]
-			if !found {
-				eb.readerMap[msgType] = []chan<- v{}
-			}
+const DefaultQueueSize = 1000
+
+// Options configures the queue of a consumer.
+type Options struct {
+	// QueueSize is the amount of events buffered for the consumer, DefaultQueueSize when zero.
+	QueueSize int
+	Policy    OverflowPolicy
+}
+
+// Consumer receives the events it is subscribed to on C.
+type Consumer[V any] struct {
+	C <-chan V
+
+	name      string
+	policy    OverflowPolicy
+	queue     chan V
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+	// done is closed once unsubscribed, releasing any Emit blocked on a full Block queue.
+	done      chan struct{}
+	closeOnce sync.Once
+}
 
-			eb.readerMap[msgType] = append(eb.readerMap[msgType], serverEventChan)
+func (c *Consumer[V]) Name() string {
+	return c.name
+}
+
+func (c *Consumer[V]) send(value V) {
+	switch c.policy {
+	case DropNewest:
+		select {
+		case c.queue <- value:
+		default:
+			c.dropped.Add(1)
+
+			return
 		}
-		eb.readerMapMu.Unlock()
-	} else {
-		eb.allReadersMu.Lock()
-		if slices.Contains(eb.allReaders, serverEventChan) {
-			eb.allReadersMu.Unlock()
+	case DropOldest:
+		for {
+			select {
+			case c.queue <- value:
+				c.delivered.Add(1)
+
+				return
+			default:
+			}
 
-			return ErrDuplicateChannel
+			// Make room, the consumer may have drained the queue in the meantime.
+			select {
+			case <-c.queue:
+				c.dropped.Add(1)
+			default:
+			}
 		}
+	default:
+		select {
+		case c.queue <- value:
+		case <-c.done:
+			c.dropped.Add(1)
 
-		eb.allReaders = append(eb.allReaders, serverEventChan)
-		eb.allReadersMu.Unlock()
+			return
+		}
 	}
 
-	return nil
+	c.delivered.Add(1)
 }
 
-// Emit is used to send out events to all registered reader channels.
-func (eb *Broadcaster[k, v]) Emit(key k, value v) {
-	// Ensure we also send to Any handlers for all events.
-	for _, eventType := range []k{key} {
-		eb.allReadersMu.RLock()
-		eb.readerMapMu.RLock()
-		specificReaders, specificReadersFound := eb.readerMap[eventType]
+// ConsumerStats is a snapshot of the state of a consumers queue.
+type ConsumerStats struct {
+	Name   string
+	Policy OverflowPolicy
+	// Lag is the amount of events queued but not yet read by the consumer.
+	Lag       int
+	Capacity  int
+	Delivered uint64
+	Dropped   uint64
+}
 
-		readerChannels := eb.allReaders
+// Broadcaster implements a fanout style event broadcaster using generics. Each consumer has its own
+// buffered queue and overflow policy, so a slow consumer only affects Emit when it uses the Block policy.
+// Consumers receive events based on their matching keys, or all events when subscribed without keys.
+type Broadcaster[T comparable, V any] struct {
+	consumers   []*Consumer[V]
+	readerMap   map[T][]*Consumer[V]
+	allReaders  []*Consumer[V]
+	consumersMu *sync.RWMutex
+}
 
-		if specificReadersFound {
-			readerChannels = append(readerChannels, specificReaders...)
-		}
+func New[T comparable, V any]() *Broadcaster[T, V] {
+	return &Broadcaster[T, V]{
+		readerMap:   map[T][]*Consumer[V]{},
+		consumersMu: &sync.RWMutex{},
+	}
+}
 
-		for _, reader := range readerChannels {
-			reader <- value
-		}
+// Subscribe registers a new named consumer. If no event keys are provided, all events will be sent.
+func (eb *Broadcaster[k, v]) Subscribe(name string, opts Options, keys ...k) (*Consumer[v], error) {
+	if name == "" {
+		return nil, ErrConsumerName
+	}
 
-		eb.readerMapMu.RUnlock()
-		eb.allReadersMu.RUnlock()
+	queueSize := opts.QueueSize
+	if queueSize <= 0 {
+		queueSize = DefaultQueueSize
 	}
-}
 
-func (eb *Broadcaster[k, v]) removeChan(channels []chan<- v, eventChan chan<- v) []chan<- v {
-	var newChannels []chan<- v
+	queue := make(chan v, queueSize)
+	consumer := &Consumer[v]{C: queue, name: name, policy: opts.Policy, queue: queue, done: make(chan struct{})}
 
-	for _, channel := range channels {
-		if channel != eventChan {
-			newChannels = append(newChannels, channel)
-		}
+	eb.consumersMu.Lock()
+	defer eb.consumersMu.Unlock()
+
+	if slices.ContainsFunc(eb.consumers, func(existing *Consumer[v]) bool { return existing.name == name }) {
+		return nil, ErrDuplicateConsumer
+	}
+
+	eb.consumers = append(eb.consumers, consumer)
+
+	if len(keys) == 0 {
+		eb.allReaders = append(eb.allReaders, consumer)
+
+		return consumer, nil
 	}
 
-	return newChannels
+	for _, msgType := range keys {
+		eb.readerMap[msgType] = append(eb.readerMap[msgType], consumer)
+	}
+
+	return consumer, nil
+}
+
+// Emit is used to send out events to all registered consumers. The lock is only held while collecting
+// the consumers, so a blocked consumer never prevents others from subscribing or unsubscribing.
+func (eb *Broadcaster[k, v]) Emit(key k, value v) {
+	eb.consumersMu.RLock()
+	consumers := slices.Concat(eb.allReaders, eb.readerMap[key])
+	eb.consumersMu.RUnlock()
+
+	for _, consumer := range consumers {
+		consumer.send(value)
+	}
 }
 
-// Unregister will remove the channel from any matching event readers.
-func (eb *Broadcaster[k, v]) Unregister(value chan<- v) error {
-	eb.readerMapMu.Lock()
+// Unsubscribe will remove the consumer from any matching event readers.
+func (eb *Broadcaster[k, v]) Unsubscribe(consumer *Consumer[v]) {
+	eb.consumersMu.Lock()
+	defer eb.consumersMu.Unlock()
+
+	isConsumer := func(existing *Consumer[v]) bool { return existing == consumer }
 
 	for eType, eventReaders := range eb.readerMap {
-		eb.readerMap[eType] = eb.removeChan(eventReaders, value)
+		eb.readerMap[eType] = slices.DeleteFunc(eventReaders, isConsumer)
 	}
 
-	eb.readerMapMu.Unlock()
+	eb.allReaders = slices.DeleteFunc(eb.allReaders, isConsumer)
+	eb.consumers = slices.DeleteFunc(eb.consumers, isConsumer)
+
+	consumer.closeOnce.Do(func() { close(consumer.done) })
+}
 
-	eb.allReadersMu.Lock()
-	eb.allReaders = eb.removeChan(eb.allReaders, value)
-	eb.allReadersMu.Unlock()
+// Stats returns a snapshot of the queues of all consumers.
+func (eb *Broadcaster[k, v]) Stats() []ConsumerStats {
+	eb.consumersMu.RLock()
+	defer eb.consumersMu.RUnlock()
+
+	stats := make([]ConsumerStats, len(eb.consumers))
+	for idx, consumer := range eb.consumers {
+		stats[idx] = ConsumerStats{
+			Name:      consumer.name,
+			Policy:    consumer.policy,
+			Lag:       len(consumer.queue),
+			Capacity:  cap(consumer.queue),
+			Delivered: consumer.delivered.Load(),
+			Dropped:   consumer.dropped.Load(),
+		}
+	}
 
-	return nil
+	return stats
 }
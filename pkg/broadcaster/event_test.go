@@ -0,0 +1,199 @@
+package broadcaster_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/leighmacdonald/gbans/pkg/broadcaster"
+	"github.com/stretchr/testify/require"
+)
+
+func consumerStats(t *testing.T, eb *broadcaster.Broadcaster[string, int], name string) broadcaster.ConsumerStats {
+	t.Helper()
+
+	for _, stats := range eb.Stats() {
+		if stats.Name == name {
+			return stats
+		}
+	}
+
+	t.Fatalf("consumer not found: %s", name)
+
+	return broadcaster.ConsumerStats{}
+}
+
+func TestSubscribe(t *testing.T) {
+	eb := broadcaster.New[string, int]()
+
+	all, errAll := eb.Subscribe("all", broadcaster.Options{QueueSize: 10})
+	require.NoError(t, errAll)
+
+	keyed, errKeyed := eb.Subscribe("keyed", broadcaster.Options{QueueSize: 10}, "a")
+	require.NoError(t, errKeyed)
+
+	_, errDupe := eb.Subscribe("all", broadcaster.Options{})
+	require.ErrorIs(t, errDupe, broadcaster.ErrDuplicateConsumer)
+
+	_, errName := eb.Subscribe("", broadcaster.Options{})
+	require.ErrorIs(t, errName, broadcaster.ErrConsumerName)
+
+	eb.Emit("a", 1)
+	eb.Emit("b", 2)
+
+	require.Equal(t, 1, <-all.C)
+	require.Equal(t, 2, <-all.C)
+	require.Equal(t, 1, <-keyed.C)
+	require.Empty(t, keyed.C)
+
+	eb.Unsubscribe(keyed)
+	eb.Emit("a", 3)
+	require.Empty(t, keyed.C)
+	require.Len(t, eb.Stats(), 1)
+}
+
+func TestOverflowPolicy(t *testing.T) {
+	eb := broadcaster.New[string, int]()
+
+	oldest, errOldest := eb.Subscribe("oldest", broadcaster.Options{QueueSize: 2, Policy: broadcaster.DropOldest})
+	require.NoError(t, errOldest)
+
+	newest, errNewest := eb.Subscribe("newest", broadcaster.Options{QueueSize: 2, Policy: broadcaster.DropNewest})
+	require.NoError(t, errNewest)
+
+	for value := range 5 {
+		eb.Emit("a", value)
+	}
+
+	require.Equal(t, []int{3, 4}, []int{<-oldest.C, <-oldest.C})
+	require.Equal(t, []int{0, 1}, []int{<-newest.C, <-newest.C})
+
+	oldestStats := consumerStats(t, eb, "oldest")
+	require.Equal(t, uint64(3), oldestStats.Dropped)
+	require.Equal(t, uint64(5), oldestStats.Delivered)
+	require.Equal(t, 2, oldestStats.Capacity)
+
+	newestStats := consumerStats(t, eb, "newest")
+	require.Equal(t, uint64(3), newestStats.Dropped)
+	require.Equal(t, uint64(2), newestStats.Delivered)
+
+	eb.Emit("a", 5)
+	require.Equal(t, 1, consumerStats(t, eb, "newest").Lag)
+}
+
+func TestBlockPolicy(t *testing.T) {
+	eb := broadcaster.New[string, int]()
+
+	blocking, errBlocking := eb.Subscribe("block", broadcaster.Options{QueueSize: 1, Policy: broadcaster.Block})
+	require.NoError(t, errBlocking)
+
+	eb.Emit("a", 1)
+
+	done := make(chan struct{})
+
+	go func() {
+		eb.Emit("a", 2)
+		close(done)
+	}()
+
+	require.Equal(t, 1, <-blocking.C)
+	<-done
+	require.Equal(t, 2, <-blocking.C)
+	require.Equal(t, uint64(0), consumerStats(t, eb, "block").Dropped)
+}
+
+// TestBlockUnsubscribe ensures a Block consumer which stops reading and unsubscribes does not leave
+// Emit, Subscribe or Unsubscribe waiting on it.
+func TestBlockUnsubscribe(t *testing.T) {
+	eb := broadcaster.New[string, int]()
+
+	stalled, errStalled := eb.Subscribe("stalled", broadcaster.Options{QueueSize: 1, Policy: broadcaster.Block})
+	require.NoError(t, errStalled)
+
+	eb.Emit("a", 1)
+
+	emitted := make(chan struct{})
+
+	go func() {
+		eb.Emit("a", 2)
+		close(emitted)
+	}()
+
+	// Subscribing must not wait on the blocked Emit.
+	other, errOther := eb.Subscribe("other", broadcaster.Options{QueueSize: 1}, "b")
+	require.NoError(t, errOther)
+
+	eb.Unsubscribe(stalled)
+
+	select {
+	case <-emitted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("emit still blocked after unsubscribe")
+	}
+
+	eb.Emit("b", 3)
+	require.Equal(t, 3, <-other.C)
+	require.Len(t, eb.Stats(), 1)
+}
+
+func BenchmarkEmit(b *testing.B) {
+	for _, policy := range []broadcaster.OverflowPolicy{broadcaster.Block, broadcaster.DropOldest, broadcaster.DropNewest} {
+		b.Run(policy.String(), func(b *testing.B) {
+			var waitGroup sync.WaitGroup
+
+			eb := broadcaster.New[string, int]()
+			done := make(chan struct{})
+
+			for idx := range 4 {
+				consumer, errSubscribe := eb.Subscribe(fmt.Sprintf("consumer_%d", idx),
+					broadcaster.Options{QueueSize: 100, Policy: policy})
+				if errSubscribe != nil {
+					b.Fatal(errSubscribe)
+				}
+
+				waitGroup.Go(func() {
+					for {
+						select {
+						case <-consumer.C:
+						case <-done:
+							return
+						}
+					}
+				})
+			}
+
+			b.ResetTimer()
+
+			for value := range b.N {
+				eb.Emit("a", value)
+			}
+
+			b.StopTimer()
+			close(done)
+			waitGroup.Wait()
+		})
+	}
+}
+
+// BenchmarkEmitSlowConsumer measures Emit with a consumer that never reads its queue. Block is
+// excluded since it would never complete.
+func BenchmarkEmitSlowConsumer(b *testing.B) {
+	for _, policy := range []broadcaster.OverflowPolicy{broadcaster.DropOldest, broadcaster.DropNewest} {
+		b.Run(policy.String(), func(b *testing.B) {
+			eb := broadcaster.New[string, int]()
+
+			if _, errSubscribe := eb.Subscribe("slow", broadcaster.Options{QueueSize: 100, Policy: policy}); errSubscribe != nil {
+				b.Fatal(errSubscribe)
+			}
+
+			b.ResetTimer()
+
+			for value := range b.N {
+				eb.Emit("a", value)
+			}
+
+			b.ReportMetric(float64(eb.Stats()[0].Dropped)/float64(b.N), "drops/op")
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package demoparse
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrOverflow = errors.New("read past end of buffer")
+
+// bitReader reads the little-endian, least significant bit first bit streams used by the source engine.
+type bitReader struct {
+	data []byte
+	pos  int
+	end  int
+	err  error
+}
+
+func newBitReader(data []byte) *bitReader {
+	return &bitReader{data: data, end: len(data) * 8}
+}
+
+func (b *bitReader) remaining() int {
+	return b.end - b.pos
+}
+
+func (b *bitReader) fail() {
+	if b.err == nil {
+		b.err = ErrOverflow
+	}
+
+	b.pos = b.end
+}
+
+func (b *bitReader) readBool() bool {
+	if b.pos >= b.end {
+		b.fail()
+
+		return false
+	}
+
+	value := b.data[b.pos>>3]>>(b.pos&7)&1 == 1
+	b.pos++
+
+	return value
+}
+
+// readBits reads up to 32 bits as an unsigned value.
+func (b *bitReader) readBits(count int) uint32 {
+	if count <= 0 {
+		return 0
+	}
+
+	if b.remaining() < count {
+		b.fail()
+
+		return 0
+	}
+
+	var value uint32
+
+	for idx := 0; idx < count; {
+		bitOffset := b.pos & 7
+		take := min(8-bitOffset, count-idx)
+		chunk := uint32(b.data[b.pos>>3]>>bitOffset) & (1<<take - 1)
+		value |= chunk << idx
+		idx += take
+		b.pos += take
+	}
+
+	return value
+}
+
+func (b *bitReader) readSigned(count int) int32 {
+	value := b.readBits(count)
+	if count < 32 && value&(1<<(count-1)) != 0 {
+		value |= math.MaxUint32 << count
+	}
+
+	return int32(value) //nolint:gosec
+}
+
+func (b *bitReader) readByte() byte {
+	return byte(b.readBits(8))
+}
+
+func (b *bitReader) readUint16() uint16 {
+	return uint16(b.readBits(16))
+}
+
+func (b *bitReader) readUint32() uint32 {
+	return b.readBits(32)
+}
+
+func (b *bitReader) readUint64() uint64 {
+	low := uint64(b.readBits(32))
+
+	return uint64(b.readBits(32))<<32 | low
+}
+
+func (b *bitReader) readFloat() float32 {
+	return math.Float32frombits(b.readBits(32))
+}
+
+// readVarInt32 reads a protobuf style variable length integer.
+func (b *bitReader) readVarInt32() uint32 {
+	var value uint32
+
+	for shift := 0; shift < 35; shift += 7 {
+		current := b.readByte()
+		value |= uint32(current&0x7f) << shift
+
+		if current&0x80 == 0 || b.err != nil {
+			break
+		}
+	}
+
+	return value
+}
+
+// readString reads a null terminated string.
+func (b *bitReader) readString() string {
+	var out []byte
+
+	for b.err == nil {
+		current := b.readByte()
+		if current == 0 {
+			break
+		}
+
+		out = append(out, current)
+	}
+
+	return string(out)
+}
+
+// readBytes reads count whole bytes, which do not need to be aligned.
+func (b *bitReader) readBytes(count int) []byte {
+	if count < 0 || b.remaining() < count*8 {
+		b.fail()
+
+		return nil
+	}
+
+	out := make([]byte, count)
+	if b.pos&7 == 0 {
+		copy(out, b.data[b.pos>>3:])
+		b.pos += count * 8
+
+		return out
+	}
+
+	for idx := range out {
+		out[idx] = b.readByte()
+	}
+
+	return out
+}
+
+// readSub returns a new reader over the next count bits and advances past them.
+func (b *bitReader) readSub(count int) *bitReader {
+	if count < 0 || b.remaining() < count {
+		b.fail()
+
+		return &bitReader{err: ErrOverflow}
+	}
+
+	sub := &bitReader{data: b.data, pos: b.pos, end: b.pos + count}
+	b.pos += count
+
+	return sub
+}
+
+func (b *bitReader) skip(count int) {
+	if count < 0 || b.remaining() < count {
+		b.fail()
+
+		return
+	}
+
+	b.pos += count
+}
+
+// readBitCoord reads a single world coordinate component.
+func (b *bitReader) readBitCoord() {
+	hasInt := b.readBool()
+	hasFract := b.readBool()
+
+	if !hasInt && !hasFract {
+		return
+	}
+
+	// sign
+	b.skip(1)
+
+	if hasInt {
+		b.skip(coordIntegerBits)
+	}
+
+	if hasFract {
+		b.skip(coordFractionalBits)
+	}
+}
+
+func (b *bitReader) readBitVec3Coord() {
+	hasX, hasY, hasZ := b.readBool(), b.readBool(), b.readBool()
+	for _, present := range []bool{hasX, hasY, hasZ} {
+		if present {
+			b.readBitCoord()
+		}
+	}
+}
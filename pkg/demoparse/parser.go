@@ -0,0 +1,355 @@
+package demoparse
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+)
+
+var (
+	ErrInvalidDemo   = errors.New("invalid demo file")
+	ErrUnknownPacket = errors.New("unknown net message")
+)
+
+const (
+	headerSize          = 1072
+	headerStringSize    = 260
+	cmdInfoSize         = 76
+	netMessageBits      = 6
+	maxEdictBits        = 11
+	maxTableBits        = 5
+	maxUserDataBits     = 14
+	subStringBits       = 5
+	coordIntegerBits    = 14
+	coordFractionalBits = 5
+	defaultTickInterval = 0.015
+)
+
+// Demo container message types.
+const (
+	demSignon       = 1
+	demPacket       = 2
+	demSyncTick     = 3
+	demConsoleCmd   = 4
+	demUserCmd      = 5
+	demDataTables   = 6
+	demStop         = 7
+	demStringTables = 8
+)
+
+// Net messages found within signon and packet frames.
+const (
+	netNOP              = 0
+	netDisconnect       = 1
+	netFile             = 2
+	netTick             = 3
+	netStringCmd        = 4
+	netSetConVar        = 5
+	netSignonState      = 6
+	svcPrint            = 7
+	svcServerInfo       = 8
+	svcSendTable        = 9
+	svcClassInfo        = 10
+	svcSetPause         = 11
+	svcCreateStringTbl  = 12
+	svcUpdateStringTbl  = 13
+	svcVoiceInit        = 14
+	svcVoiceData        = 15
+	svcSounds           = 17
+	svcSetView          = 18
+	svcFixAngle         = 19
+	svcCrosshairAngle   = 20
+	svcBSPDecal         = 21
+	svcUserMessage      = 23
+	svcEntityMessage    = 24
+	svcGameEvent        = 25
+	svcPacketEntities   = 26
+	svcTempEntities     = 27
+	svcPrefetch         = 28
+	svcMenu             = 29
+	svcGameEventList    = 30
+	svcGetCvarValue     = 31
+	svcCmdKeyValues     = 32
+	userMessageSayText2 = 4
+)
+
+// Parse reads a source engine HL2DEMO file, such as those recorded by SourceTV, and produces the same Demo
+// summary as the external demo parser service.
+func Parse(name string, reader io.Reader) (*Demo, error) {
+	content, errRead := io.ReadAll(reader)
+	if errRead != nil && !errors.Is(errRead, io.ErrUnexpectedEOF) {
+		return nil, errors.Join(errRead, ErrInvalidDemo)
+	}
+
+	if len(content) < headerSize || !bytes.HasPrefix(content, []byte(HL2Demo+"\x00")) {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidDemo)
+	}
+
+	demo := &Demo{
+		Filename: name,
+		DemoType: HL2Demo,
+		Version:  int(int32(binary.LittleEndian.Uint32(content[8:]))),  //nolint:gosec
+		Protocol: int(int32(binary.LittleEndian.Uint32(content[12:]))), //nolint:gosec
+		Server:   fixedString(content[16 : 16+headerStringSize]),
+		Nick:     fixedString(content[16+headerStringSize : 16+headerStringSize*2]),
+		Map:      fixedString(content[16+headerStringSize*2 : 16+headerStringSize*3]),
+		Game:     fixedString(content[16+headerStringSize*3 : 16+headerStringSize*4]),
+		Rounds:   []RoundSummary{},
+		Chat:     []ChatMessage{},
+	}
+
+	offset := 16 + headerStringSize*4
+	demo.Duration = float64(math.Float32frombits(binary.LittleEndian.Uint32(content[offset:])))
+	demo.Ticks = int(int32(binary.LittleEndian.Uint32(content[offset+4:])))   //nolint:gosec
+	demo.Frames = int(int32(binary.LittleEndian.Uint32(content[offset+8:])))  //nolint:gosec
+	demo.Signon = int(int32(binary.LittleEndian.Uint32(content[offset+12:]))) //nolint:gosec
+
+	state := newGameState(demo)
+	state.readMessages(content[headerSize:])
+	state.finish()
+
+	return demo, nil
+}
+
+func fixedString(data []byte) string {
+	if idx := bytes.IndexByte(data, 0); idx >= 0 {
+		return string(data[:idx])
+	}
+
+	return string(data)
+}
+
+// readMessages walks the demo container. A truncated demo, as happens while it is still being recorded,
+// is treated as having ended at the last complete message.
+func (s *gameState) readMessages(data []byte) {
+	for pos := 0; pos+5 <= len(data); {
+		cmd := data[pos]
+		s.tick = int(int32(binary.LittleEndian.Uint32(data[pos+1:]))) //nolint:gosec
+		pos += 5
+
+		var payload []byte
+
+		switch cmd {
+		case demStop:
+			return
+		case demSyncTick:
+			continue
+		case demSignon, demPacket:
+			pos += cmdInfoSize + 8
+
+			payload, pos = readChunk(data, pos)
+			if payload == nil {
+				return
+			}
+
+			s.readPacket(payload)
+		case demUserCmd:
+			pos += 4
+
+			fallthrough
+		case demConsoleCmd, demDataTables:
+			if payload, pos = readChunk(data, pos); payload == nil {
+				return
+			}
+		case demStringTables:
+			if payload, pos = readChunk(data, pos); payload == nil {
+				return
+			}
+
+			s.readStringTables(newBitReader(payload))
+		default:
+			return
+		}
+	}
+}
+
+// readChunk reads a length prefixed block, returning nil when the demo is truncated.
+func readChunk(data []byte, pos int) ([]byte, int) {
+	if pos+4 > len(data) {
+		return nil, pos
+	}
+
+	length := int(binary.LittleEndian.Uint32(data[pos:]))
+	pos += 4
+
+	if length < 0 || pos+length > len(data) {
+		return nil, pos
+	}
+
+	return data[pos : pos+length], pos + length
+}
+
+// readPacket handles the net messages of a single frame. Messages we have no use for are skipped, a
+// malformed or unknown message causes the rest of the frame to be discarded.
+func (s *gameState) readPacket(data []byte) {
+	stream := newBitReader(data)
+
+	for stream.remaining() >= netMessageBits && stream.err == nil {
+		if errMsg := s.readNetMessage(stream, stream.readBits(netMessageBits)); errMsg != nil {
+			return
+		}
+	}
+}
+
+func (s *gameState) readNetMessage(stream *bitReader, msgType uint32) error { //nolint:cyclop,funlen,gocyclo
+	switch msgType {
+	case netNOP:
+	case netDisconnect, svcPrint, netStringCmd:
+		stream.readString()
+	case netFile:
+		stream.skip(32)
+		stream.readString()
+		stream.skip(1)
+	case netTick:
+		stream.skip(32 + 16 + 16)
+	case netSetConVar:
+		for range stream.readByte() {
+			stream.readString()
+			stream.readString()
+		}
+	case netSignonState:
+		stream.skip(8 + 32)
+	case svcServerInfo:
+		s.readServerInfo(stream)
+	case svcSendTable:
+		stream.skip(1)
+		stream.skip(int(stream.readUint16()))
+	case svcClassInfo:
+		count := int(stream.readUint16())
+		if !stream.readBool() {
+			classBits := bits.Len(uint(count))
+			for range count {
+				stream.skip(classBits)
+				stream.readString()
+				stream.readString()
+			}
+		}
+	case svcSetPause:
+		stream.skip(1)
+	case svcCreateStringTbl:
+		s.readCreateStringTable(stream)
+	case svcUpdateStringTbl:
+		tableID := int(stream.readBits(maxTableBits))
+
+		changed := 1
+		if stream.readBool() {
+			changed = int(stream.readUint16())
+		}
+
+		table := stream.readSub(int(stream.readBits(20)))
+		if tableID < len(s.tables) {
+			s.tables[tableID].update(s, table, changed)
+		}
+	case svcVoiceInit:
+		stream.readString()
+
+		if stream.readByte() == 255 {
+			stream.skip(16)
+		}
+	case svcVoiceData:
+		stream.skip(8 + 8)
+		stream.skip(int(stream.readUint16()))
+	case svcSounds:
+		if stream.readBool() {
+			stream.skip(int(stream.readBits(8)))
+		} else {
+			stream.skip(8)
+			stream.skip(int(stream.readUint16()))
+		}
+	case svcSetView:
+		stream.skip(maxEdictBits)
+	case svcFixAngle:
+		stream.skip(1 + 48)
+	case svcCrosshairAngle:
+		stream.skip(48)
+	case svcBSPDecal:
+		stream.readBitVec3Coord()
+		stream.skip(9)
+
+		if stream.readBool() {
+			stream.skip(maxEdictBits + 12)
+		}
+
+		stream.skip(1)
+	case svcUserMessage:
+		msgKind := stream.readByte()
+		message := stream.readSub(int(stream.readBits(11)))
+
+		if msgKind == userMessageSayText2 {
+			s.onSayText2(message)
+		}
+	case svcEntityMessage:
+		stream.skip(maxEdictBits + 9)
+		stream.skip(int(stream.readBits(11)))
+	case svcGameEvent:
+		s.onGameEvent(stream.readSub(int(stream.readBits(11))))
+	case svcPacketEntities:
+		stream.skip(maxEdictBits)
+
+		if stream.readBool() {
+			stream.skip(32)
+		}
+
+		stream.skip(1 + maxEdictBits)
+		length := int(stream.readBits(20))
+		stream.skip(1)
+		stream.skip(length)
+	case svcTempEntities:
+		stream.skip(8)
+		stream.skip(int(stream.readVarInt32()))
+	case svcPrefetch:
+		stream.skip(14)
+	case svcMenu:
+		stream.skip(16)
+		stream.skip(int(stream.readUint16()) * 8)
+	case svcGameEventList:
+		s.readGameEventList(stream)
+	case svcGetCvarValue:
+		stream.skip(32)
+		stream.readString()
+	case svcCmdKeyValues:
+		stream.skip(int(stream.readUint32()) * 8)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnknownPacket, msgType)
+	}
+
+	return stream.err
+}
+
+func (s *gameState) readServerInfo(stream *bitReader) {
+	protocol := stream.readUint16()
+	stream.skip(32 + 1 + 1 + 32 + 16)
+
+	if protocol > 17 {
+		stream.skip(16 * 8)
+	} else {
+		stream.skip(32)
+	}
+
+	stream.skip(8 + 8)
+
+	if interval := stream.readFloat(); interval > 0 {
+		s.tickInterval = float64(interval)
+	}
+
+	stream.skip(8)
+	stream.readString()
+
+	if mapName := stream.readString(); mapName != "" && s.demo.Map == "" {
+		s.demo.Map = mapName
+	}
+
+	stream.readString()
+
+	if serverName := stream.readString(); serverName != "" && s.demo.Server == "" {
+		s.demo.Server = serverName
+	}
+
+	if protocol > 15 {
+		stream.skip(1)
+	}
+}
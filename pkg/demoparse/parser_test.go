@@ -0,0 +1,263 @@
+package demoparse_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/leighmacdonald/gbans/internal/fs"
+	"github.com/leighmacdonald/gbans/pkg/demoparse"
+	"github.com/stretchr/testify/require"
+)
+
+// bitWriter produces the lsb first bit streams read by the parser.
+type bitWriter struct {
+	data []byte
+	pos  int
+}
+
+func (w *bitWriter) bits(value uint64, count int) *bitWriter {
+	for idx := range count {
+		if w.pos/8 >= len(w.data) {
+			w.data = append(w.data, 0)
+		}
+
+		if value>>idx&1 == 1 {
+			w.data[w.pos/8] |= 1 << (w.pos % 8)
+		}
+
+		w.pos++
+	}
+
+	return w
+}
+
+func (w *bitWriter) str(value string) *bitWriter {
+	for _, char := range []byte(value) {
+		w.bits(uint64(char), 8)
+	}
+
+	return w.bits(0, 8)
+}
+
+func (w *bitWriter) raw(data []byte) *bitWriter {
+	for _, char := range data {
+		w.bits(uint64(char), 8)
+	}
+
+	return w
+}
+
+type testEvent struct {
+	id      uint64
+	name    string
+	entries []testEntry
+}
+
+type testEntry struct {
+	name      string
+	valueType uint64
+}
+
+var (
+	evtSpawn = testEvent{1, "player_spawn", []testEntry{{"userid", 4}, {"team", 4}, {"class", 4}}}
+	evtStart = testEvent{2, "teamplay_round_start", []testEntry{{"full_reset", 6}}}
+	evtHurt  = testEvent{3, "player_hurt", []testEntry{{"userid", 4}, {"health", 4}, {"attacker", 4}, {"damageamount", 4}, {"custom", 4}}}
+	evtDeath = testEvent{4, "player_death", []testEntry{
+		{"userid", 4}, {"attacker", 4}, {"weapon", 1}, {"customkill", 4}, {"assister", 4}, {"death_flags", 4},
+	}}
+	evtWin = testEvent{5, "teamplay_round_win", []testEntry{{"team", 5}, {"winreason", 5}, {"round_time", 2}, {"was_sudden_death", 5}}}
+)
+
+func userInfo(name string, userID uint32, steamID string) []byte {
+	info := make([]byte, 132)
+	copy(info, name)
+	binary.LittleEndian.PutUint32(info[32:], userID)
+	copy(info[36:], steamID)
+
+	return info
+}
+
+func gameEvent(msg *bitWriter, event testEvent, values ...any) {
+	data := (&bitWriter{}).bits(event.id, 9)
+	for idx, entry := range event.entries {
+		switch entry.valueType {
+		case 1:
+			data.str(values[idx].(string))
+		case 2:
+			data.bits(0, 32)
+		case 4:
+			data.bits(uint64(uint16(values[idx].(int))), 16)
+		case 5:
+			data.bits(uint64(values[idx].(int)), 8)
+		case 6:
+			data.bits(0, 1)
+		}
+	}
+
+	msg.bits(25, 6).bits(uint64(data.pos), 11)
+	for idx := range data.pos {
+		msg.bits(uint64(data.data[idx/8]>>(idx%8)&1), 1)
+	}
+}
+
+func sayText2(msg *bitWriter, client int, name string, text string) {
+	data := (&bitWriter{}).bits(uint64(client), 8).bits(1, 8).str("TF_Chat_All").str(name).str(text)
+	msg.bits(23, 6).bits(4, 8).bits(uint64(data.pos), 11).raw(data.data)
+}
+
+func frame(out *bytes.Buffer, cmd byte, tick uint32, payload []byte) {
+	out.WriteByte(cmd)
+	_ = binary.Write(out, binary.LittleEndian, tick)
+	out.Write(make([]byte, 76+8))
+	_ = binary.Write(out, binary.LittleEndian, uint32(len(payload)))
+	out.Write(payload)
+}
+
+func TestParseDemo(t *testing.T) {
+	var out bytes.Buffer
+
+	header := make([]byte, 1072)
+	copy(header, "HL2DEMO\x00")
+	binary.LittleEndian.PutUint32(header[8:], 3)
+	binary.LittleEndian.PutUint32(header[12:], 24)
+	copy(header[16:], "test server")
+	copy(header[16+260:], "SourceTV Demo")
+	copy(header[16+260*2:], "koth_test")
+	copy(header[16+260*3:], "tf")
+	out.Write(header)
+
+	// Signon with the game event descriptors and the userinfo table
+	events := &bitWriter{}
+	for _, event := range []testEvent{evtSpawn, evtStart, evtHurt, evtDeath, evtWin} {
+		events.bits(event.id, 9).str(event.name)
+		for _, entry := range event.entries {
+			events.bits(entry.valueType, 3).str(entry.name)
+		}
+
+		events.bits(0, 3)
+	}
+
+	signon := (&bitWriter{}).bits(30, 6).bits(5, 9).bits(uint64(events.pos), 20)
+	for idx := range events.pos {
+		signon.bits(uint64(events.data[idx/8]>>(idx%8)&1), 1)
+	}
+
+	table := &bitWriter{}
+	for idx, info := range [][]byte{userInfo("red player", 10, "[U:1:1001]"), userInfo("blu player", 11, "[U:1:1002]")} {
+		table.bits(1, 1).bits(1, 1).bits(0, 1).str(string(rune('0'+idx))).bits(1, 1).bits(uint64(len(info)), 14).raw(info)
+	}
+
+	signon.bits(12, 6).str("userinfo").bits(256, 16).bits(2, 9)
+	for length := uint64(table.pos); ; length >>= 7 {
+		if length < 0x80 {
+			signon.bits(length, 8)
+
+			break
+		}
+
+		signon.bits(length&0x7f|0x80, 8)
+	}
+
+	signon.bits(0, 1).bits(0, 1)
+	for idx := range table.pos {
+		signon.bits(uint64(table.data[idx/8]>>(idx%8)&1), 1)
+	}
+
+	frame(&out, 1, 0, signon.data)
+
+	round := &bitWriter{}
+	gameEvent(round, evtSpawn, 10, 2, 1)
+	gameEvent(round, evtSpawn, 11, 3, 3)
+	gameEvent(round, evtStart, false)
+	gameEvent(round, evtHurt, 11, 25, 10, 100, 1)
+	gameEvent(round, evtDeath, 11, 10, "scattergun", 1, -1, 1)
+	sayText2(round, 1, "red player", "gg")
+	frame(&out, 2, 1000, round.data)
+
+	end := &bitWriter{}
+	gameEvent(end, evtWin, 2, 1, nil, 0)
+	frame(&out, 2, 3000, end.data)
+	out.Write([]byte{7, 0xb8, 0x0b, 0, 0})
+
+	demo, errParse := demoparse.Parse("test.dem", &out)
+	require.NoError(t, errParse)
+	require.Equal(t, demoparse.HL2Demo, demo.DemoType)
+	require.Equal(t, "koth_test", demo.Map)
+	require.Equal(t, "test server", demo.Server)
+	require.Equal(t, "tf", demo.Game)
+	require.Equal(t, 3000, demo.Ticks)
+
+	require.Len(t, demo.Chat, 1)
+	require.Equal(t, demoparse.ChatMessage{User: "[U:1:1001]", Tick: 1000, Message: "gg"}, demo.Chat[0])
+
+	require.Len(t, demo.Rounds, 1)
+	require.Equal(t, "red", demo.Rounds[0].Winner)
+	require.InDelta(t, 30.0, demo.Rounds[0].Time, 0.01)
+	require.Equal(t, []string{"[U:1:1001]"}, demo.Rounds[0].Winners)
+	require.Equal(t, []string{"[U:1:1002]"}, demo.Rounds[0].Losers)
+	require.Len(t, demo.Rounds[0].Players, 2)
+	require.Len(t, demo.SteamIDs(), 2)
+
+	players := map[string]demoparse.PlayerSummary{}
+	for _, player := range demo.Rounds[0].Players {
+		players[player.SteamID] = player
+	}
+
+	red := players["[U:1:1001]"]
+	require.Equal(t, "red player", red.Name)
+	require.Equal(t, 1, red.Kills)
+	require.Equal(t, 100, red.Damage)
+	require.Equal(t, 1, red.HeadshotKills)
+	require.Equal(t, 1, red.Dominations)
+	require.Equal(t, 1, red.Weapons["scattergun"].Kills)
+	require.Equal(t, 1, red.Classes["scout"].Kills)
+
+	blu := players["[U:1:1002]"]
+	require.Equal(t, 1, blu.Deaths)
+	require.Equal(t, 100, blu.DamageTaken)
+	require.Equal(t, 1, blu.Classes["soldier"].Deaths)
+	require.Equal(t, 1, blu.WasHeadshot)
+
+	_, errInvalid := demoparse.Parse("bad.dem", bytes.NewReader([]byte("not a demo")))
+	require.ErrorIs(t, errInvalid, demoparse.ErrInvalidDemo)
+}
+
+// TestParseDemoFile compares the parser with the output of the demo parser service for the same demo. The demo
+// is too large to commit, so the test is skipped unless it has been placed next to the expected output.
+func TestParseDemoFile(t *testing.T) {
+	demoFile, errOpen := os.Open(fs.FindFile("testdata/koth_ashville_final.dem", "gbans"))
+	if errOpen != nil {
+		t.Skip("testdata/koth_ashville_final.dem not found")
+	}
+
+	defer demoFile.Close()
+
+	jsonFile, errJSON := os.Open(fs.FindFile("testdata/koth_ashville_final.dem.json", "gbans"))
+	require.NoError(t, errJSON)
+
+	defer jsonFile.Close()
+
+	var expected demoparse.Demo
+	require.NoError(t, json.NewDecoder(jsonFile).Decode(&expected))
+
+	parsed, errParse := demoparse.Parse(expected.Filename, demoFile)
+	require.NoError(t, errParse)
+
+	// Times are stored as float32 in the demo, so only compare them approximately.
+	require.InDelta(t, expected.Duration, parsed.Duration, 0.01)
+	expected.Duration, parsed.Duration = 0, 0
+
+	require.Len(t, parsed.Rounds, len(expected.Rounds))
+
+	for idx := range expected.Rounds {
+		require.InDelta(t, expected.Rounds[idx].Time, parsed.Rounds[idx].Time, 0.01)
+		require.InDelta(t, expected.Rounds[idx].Duration, parsed.Rounds[idx].Duration, 0.01)
+		expected.Rounds[idx].Time, parsed.Rounds[idx].Time = 0, 0
+		expected.Rounds[idx].Duration, parsed.Rounds[idx].Duration = 0, 0
+	}
+
+	require.Equal(t, expected, *parsed)
+}
@@ -0,0 +1,637 @@
+package demoparse
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+)
+
+const (
+	botSteamID        = "BOT"
+	customKillHead    = 1
+	customKillStab    = 2
+	deathDomination   = 0x0001
+	deathAsstDominate = 0x0002
+	deathRevenge      = 0x0004
+	deathAsstRevenge  = 0x0008
+	deathFeign        = 0x0020
+)
+
+var classNames = map[PlayerClass]string{ //nolint:gochecknoglobals
+	Scout:    "scout",
+	Sniper:   "sniper",
+	Soldier:  "soldier",
+	Demoman:  "demoman",
+	Medic:    "medic",
+	Heavy:    "heavy",
+	Pyro:     "pyro",
+	Spy:      "spy",
+	Engineer: "engineer",
+}
+
+type eventEntry struct {
+	name      string
+	valueType uint32
+}
+
+type eventDescriptor struct {
+	name    string
+	entries []eventEntry
+}
+
+type gameEvent map[string]any
+
+func (e gameEvent) int(key string) int {
+	switch value := e[key].(type) {
+	case int32:
+		return int(value)
+	case uint32:
+		return int(value)
+	case uint64:
+		return int(value) //nolint:gosec
+	case bool:
+		if value {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func (e gameEvent) string(key string) string {
+	value, _ := e[key].(string)
+
+	return value
+}
+
+type demoPlayer struct {
+	name        string
+	userID      int
+	steamID     string
+	fakePlayer  bool
+	hltv        bool
+	team        Team
+	class       PlayerClass
+	tickStart   int
+	connections int
+}
+
+// roundState accumulates the summaries of each player until the round, including the post round
+// humiliation time, is over.
+type roundState struct {
+	startTick int
+	endTick   int
+	running   bool
+	ended     bool
+	summary   RoundSummary
+	players   map[int]*PlayerSummary
+	order     []int
+}
+
+// gameState tracks the players and rounds as the demo is replayed, building up the Demo summary.
+type gameState struct {
+	demo         *Demo
+	tick         int
+	tickInterval float64
+	tables       []*stringTable
+	events       map[int]eventDescriptor
+	users        map[int]*demoPlayer
+	slots        map[int]int
+	round        *roundState
+}
+
+func newGameState(demo *Demo) *gameState {
+	state := &gameState{
+		demo:         demo,
+		tickInterval: defaultTickInterval,
+		events:       map[int]eventDescriptor{},
+		users:        map[int]*demoPlayer{},
+		slots:        map[int]int{},
+	}
+	state.round = state.newRound()
+
+	return state
+}
+
+func (s *gameState) newRound() *roundState {
+	return &roundState{startTick: s.tick, players: map[int]*PlayerSummary{}}
+}
+
+// onUserInfo updates a player from their player_info_t entry in the userinfo string table. The table
+// index is the players slot, their entity index being one higher.
+func (s *gameState) onUserInfo(slot int, data []byte) {
+	const infoSize = 110
+	if len(data) < infoSize {
+		return
+	}
+
+	userID := int(int32(binary.LittleEndian.Uint32(data[32:]))) //nolint:gosec
+
+	player, found := s.users[userID]
+	if !found {
+		player = &demoPlayer{userID: userID, tickStart: s.tick}
+		s.users[userID] = player
+	}
+
+	if s.slots[slot+1] != userID || !found {
+		player.connections++
+	}
+
+	player.name = fixedString(data[:32])
+	player.steamID = fixedString(data[36:69])
+	player.fakePlayer = data[108] != 0
+	player.hltv = data[109] != 0
+
+	s.slots[slot+1] = userID
+}
+
+func (s *gameState) userByEntity(entity int) *demoPlayer {
+	userID, found := s.slots[entity]
+	if !found {
+		return nil
+	}
+
+	return s.users[userID]
+}
+
+func (s *gameState) readGameEventList(stream *bitReader) {
+	count := int(stream.readBits(9))
+	data := stream.readSub(int(stream.readBits(20)))
+
+	for range count {
+		eventID := int(data.readBits(9))
+		descriptor := eventDescriptor{name: data.readString()}
+
+		for valueType := data.readBits(3); valueType != 0 && data.err == nil; valueType = data.readBits(3) {
+			descriptor.entries = append(descriptor.entries, eventEntry{valueType: valueType, name: data.readString()})
+		}
+
+		if data.err != nil {
+			return
+		}
+
+		s.events[eventID] = descriptor
+	}
+}
+
+func (s *gameState) onGameEvent(stream *bitReader) {
+	descriptor, found := s.events[int(stream.readBits(9))]
+	if !found {
+		return
+	}
+
+	event := gameEvent{}
+
+	for _, entry := range descriptor.entries {
+		switch entry.valueType {
+		case 1:
+			event[entry.name] = stream.readString()
+		case 2:
+			event[entry.name] = stream.readFloat()
+		case 3:
+			event[entry.name] = stream.readSigned(32)
+		case 4:
+			event[entry.name] = stream.readSigned(16)
+		case 5:
+			event[entry.name] = stream.readBits(8)
+		case 6:
+			event[entry.name] = stream.readBool()
+		case 7:
+			event[entry.name] = stream.readUint64()
+		}
+	}
+
+	if stream.err != nil {
+		return
+	}
+
+	s.applyEvent(descriptor.name, event)
+}
+
+func (s *gameState) applyEvent(name string, event gameEvent) { //nolint:cyclop,funlen
+	switch name {
+	case "player_team":
+		if player := s.users[event.int("userid")]; player != nil {
+			player.team = Team(event.int("team")) //nolint:gosec
+		}
+	case "player_spawn":
+		if player := s.users[event.int("userid")]; player != nil {
+			player.team = Team(event.int("team"))          //nolint:gosec
+			player.class = PlayerClass(event.int("class")) //nolint:gosec
+		}
+	case "player_changeclass":
+		if player := s.users[event.int("userid")]; player != nil {
+			player.class = PlayerClass(event.int("class")) //nolint:gosec
+		}
+	case "player_disconnect":
+		if summary := s.summary(event.int("userid")); summary != nil {
+			summary.TickEnd = s.tick
+		}
+	case "player_death":
+		s.onDeath(event)
+	case "player_hurt":
+		s.onHurt(event)
+	case "player_healed":
+		amount := event.int("amount")
+		s.stat(event.int("healer"), func(stats *Stats) {
+			switch {
+			case s.round.ended:
+				stats.PostroundHealing += amount
+			case !s.round.running:
+				stats.PreroundHealing += amount
+			default:
+				stats.Healing += amount
+			}
+		})
+
+		if summary := s.summary(event.int("healer")); summary != nil {
+			summary.ScoreboardHealing += amount
+		}
+	case "player_chargedeployed":
+		s.stat(event.int("userid"), func(stats *Stats) { stats.ChargesUber++ })
+	case "medic_death":
+		if event.int("charged") != 0 {
+			s.stat(event.int("userid"), func(stats *Stats) { stats.Drops++ })
+		}
+	case "player_builtobject":
+		s.stat(event.int("userid"), func(stats *Stats) { stats.ObjectBuilt++ })
+	case "object_destroyed":
+		if attacker := event.int("attacker"); attacker != event.int("userid") {
+			s.stat(attacker, func(stats *Stats) { stats.ObjectDestroyed++ })
+		}
+	case "player_ignited":
+		if player := s.userByEntity(event.int("pyro_entindex")); player != nil {
+			if summary := s.summary(player.userID); summary != nil {
+				summary.Ignites++
+			}
+		}
+	case "player_extinguished":
+		if player := s.userByEntity(event.int("healer")); player != nil {
+			if summary := s.summary(player.userID); summary != nil {
+				summary.Extinguishes++
+			}
+		}
+	case "teamplay_point_captured":
+		for _, entity := range []byte(event.string("cappers")) {
+			if player := s.userByEntity(int(entity)); player != nil {
+				s.stat(player.userID, func(stats *Stats) { stats.Captures++ })
+			}
+		}
+	case "teamplay_capture_blocked":
+		if player := s.userByEntity(event.int("blocker")); player != nil {
+			s.stat(player.userID, func(stats *Stats) { stats.CapturesBlocked++ })
+		}
+	case "teamplay_round_start":
+		if s.round.ended {
+			s.endRound()
+		} else if !s.round.running {
+			s.round.startTick = s.tick
+		}
+
+		s.round.running = true
+	case "teamplay_round_win":
+		s.onRoundWin(Team(event.int("team")), event.int("was_sudden_death") != 0, false) //nolint:gosec
+	case "teamplay_round_stalemate":
+		s.onRoundWin(UNASSIGNED, false, true)
+	case "teamplay_win_panel":
+		for _, key := range []string{"player_1", "player_2", "player_3"} {
+			if player := s.userByEntity(event.int(key)); player != nil {
+				s.round.summary.Mvps = append(s.round.summary.Mvps, player.steamID)
+			}
+		}
+	}
+}
+
+func (s *gameState) onDeath(event gameEvent) {
+	if event.int("death_flags")&deathFeign != 0 {
+		return
+	}
+
+	var (
+		victimID   = event.int("userid")
+		attackerID = event.int("attacker")
+		assisterID = event.int("assister")
+		flags      = event.int("death_flags")
+		custom     = event.int("customkill")
+		weapon     = event.string("weapon")
+		postRound  = s.round.ended
+	)
+
+	s.stat(victimID, func(stats *Stats) {
+		if postRound {
+			stats.PostroundDeaths++
+		} else {
+			stats.Deaths++
+		}
+
+		switch custom {
+		case customKillHead:
+			stats.WasHeadshot++
+		case customKillStab:
+			stats.WasBackstabbed++
+		}
+
+		if flags&deathDomination != 0 {
+			stats.Dominated++
+		}
+
+		if flags&deathRevenge != 0 {
+			stats.Revenged++
+		}
+	})
+
+	if victim := s.summary(victimID); victim != nil {
+		victim.ScoreboardDeaths++
+
+		if attackerID == victimID || attackerID == 0 {
+			victim.Suicides++
+
+			return
+		}
+	}
+
+	if attacker := s.summary(attackerID); attacker != nil {
+		attacker.ScoreboardKills++
+	}
+
+	s.stat(attackerID, func(stats *Stats) {
+		if postRound {
+			stats.PostroundKills++
+		} else {
+			stats.Kills++
+		}
+
+		switch custom {
+		case customKillHead:
+			stats.HeadshotKills++
+		case customKillStab:
+			stats.BackstabKills++
+		}
+
+		if flags&deathDomination != 0 {
+			stats.Dominations++
+		}
+
+		if flags&deathRevenge != 0 {
+			stats.Revenges++
+		}
+	}, weapon)
+
+	if assisterID <= 0 || assisterID == victimID {
+		return
+	}
+
+	if assister := s.summary(assisterID); assister != nil {
+		assister.ScoreboardAssists++
+	}
+
+	s.stat(assisterID, func(stats *Stats) {
+		if postRound {
+			stats.PostroundAssists++
+		} else {
+			stats.Assists++
+		}
+
+		if flags&deathAsstDominate != 0 {
+			stats.Dominations++
+		}
+
+		if flags&deathAsstRevenge != 0 {
+			stats.Revenges++
+		}
+	})
+}
+
+func (s *gameState) onHurt(event gameEvent) {
+	var (
+		victimID   = event.int("userid")
+		attackerID = event.int("attacker")
+		amount     = event.int("damageamount")
+		custom     = event.int("custom")
+	)
+
+	if attackerID == victimID || attackerID == 0 || amount <= 0 {
+		return
+	}
+
+	s.stat(victimID, func(stats *Stats) { stats.DamageTaken += amount })
+	s.stat(attackerID, func(stats *Stats) {
+		stats.Damage += amount
+
+		switch custom {
+		case customKillHead:
+			stats.Headshots++
+		case customKillStab:
+			stats.Backstabs++
+		}
+	})
+
+	if attacker := s.summary(attackerID); attacker != nil {
+		attacker.ScoreboardDamage += amount
+	}
+}
+
+// summary returns the current round summary of the user, creating it on first use.
+func (s *gameState) summary(userID int) *PlayerSummary {
+	player, found := s.users[userID]
+	if !found || player.steamID == "" {
+		return nil
+	}
+
+	summary, found := s.round.players[userID]
+	if !found {
+		summary = &PlayerSummary{
+			Name:            player.name,
+			SteamID:         player.steamID,
+			TickStart:       max(player.tickStart, s.round.startTick),
+			ConnectionCount: player.connections,
+			IsFakePlayer:    player.fakePlayer,
+			IsHlTv:          player.hltv,
+			Classes:         map[string]Stats{},
+			Weapons:         map[string]Stats{},
+		}
+		s.round.players[userID] = summary
+		s.round.order = append(s.round.order, userID)
+	}
+
+	summary.Team = teamName(player.team)
+
+	return summary
+}
+
+// stat applies the update to the players totals, their current class and, when given, the weapon used.
+func (s *gameState) stat(userID int, update func(stats *Stats), weapon ...string) {
+	summary := s.summary(userID)
+	if summary == nil {
+		return
+	}
+
+	totals := summaryStats(summary)
+	update(&totals)
+	setSummaryStats(summary, totals)
+
+	if className, found := classNames[s.users[userID].class]; found {
+		classStats := summary.Classes[className]
+		update(&classStats)
+		summary.Classes[className] = classStats
+	}
+
+	for _, name := range weapon {
+		if name == "" {
+			continue
+		}
+
+		weaponStats := summary.Weapons[name]
+		update(&weaponStats)
+		summary.Weapons[name] = weaponStats
+	}
+}
+
+func (s *gameState) onRoundWin(winner Team, suddenDeath bool, stalemate bool) {
+	if s.round.ended {
+		return
+	}
+
+	s.round.ended = true
+	s.round.endTick = s.tick
+	s.round.summary.Winner = teamName(winner)
+	s.round.summary.IsSuddenDeath = suddenDeath
+	s.round.summary.IsStalemate = stalemate || winner != RED && winner != BLU
+
+	// Make sure everyone who played the round is included, even without any recorded stats.
+	for userID, player := range s.users {
+		if player.team != RED && player.team != BLU {
+			continue
+		}
+
+		if _, found := s.round.players[userID]; !found {
+			s.summary(userID)
+		}
+	}
+
+	for _, userID := range s.round.order {
+		player := s.users[userID]
+
+		switch {
+		case player.team != RED && player.team != BLU, s.round.summary.IsStalemate:
+			continue
+		case player.team == winner:
+			s.round.summary.Winners = append(s.round.summary.Winners, player.steamID)
+		default:
+			s.round.summary.Losers = append(s.round.summary.Losers, player.steamID)
+		}
+	}
+}
+
+func (s *gameState) endRound() {
+	endTick := s.round.endTick
+	if !s.round.ended {
+		endTick = s.tick
+	}
+
+	s.round.summary.Time = float64(endTick-s.round.startTick) * s.tickInterval
+	s.round.summary.Duration = float64(s.tick-s.round.startTick) * s.tickInterval
+
+	for _, userID := range s.round.order {
+		summary := s.round.players[userID]
+		if summary.TickEnd == 0 {
+			summary.TickEnd = s.tick
+		}
+
+		s.round.summary.Players = append(s.round.summary.Players, *summary)
+	}
+
+	s.demo.Rounds = append(s.demo.Rounds, s.round.summary)
+	s.round = s.newRound()
+}
+
+// finish closes out the final round and fills in the header values missing from demos that were not
+// cleanly stopped.
+func (s *gameState) finish() {
+	if s.round.ended || len(s.round.players) > 0 {
+		s.endRound()
+	}
+
+	if s.demo.Ticks <= 0 {
+		s.demo.Ticks = s.tick
+	}
+
+	if s.demo.Duration <= 0 {
+		s.demo.Duration = float64(s.demo.Ticks) * s.tickInterval
+	}
+}
+
+func (s *gameState) onSayText2(stream *bitReader) {
+	client := int(stream.readByte())
+	stream.skip(8)
+
+	text := stream.readString()
+
+	// Player chat is sent as a localization key followed by the name and message, while plugins send raw text.
+	if strings.HasPrefix(text, "TF_Chat") || strings.HasPrefix(text, "#TF_Chat") {
+		stream.readString()
+		text = stream.readString()
+	}
+
+	if stream.err != nil {
+		return
+	}
+
+	user := botSteamID
+	if player := s.userByEntity(client); player != nil && client > 0 {
+		user = player.steamID
+	}
+
+	s.demo.Chat = append(s.demo.Chat, ChatMessage{
+		User:    user,
+		Tick:    int32(s.tick), //nolint:gosec
+		Message: string(bytes.ToValidUTF8([]byte(text), nil)),
+	})
+}
+
+func teamName(team Team) string {
+	switch team {
+	case RED:
+		return "red"
+	case BLU:
+		return "blue"
+	case SPEC:
+		return "spec"
+	default:
+		return ""
+	}
+}
+
+func summaryStats(summary *PlayerSummary) Stats {
+	return Stats{
+		Kills: summary.Kills, Assists: summary.Assists, Deaths: summary.Deaths,
+		PostroundKills: summary.PostroundKills, PostroundAssists: summary.PostroundAssists,
+		PostroundDeaths: summary.PostroundDeaths, Damage: summary.Damage, DamageTaken: summary.DamageTaken,
+		Dominations: summary.Dominations, Dominated: summary.Dominated, Revenges: summary.Revenges,
+		Revenged: summary.Revenged, Airshots: summary.Airshots, HeadshotKills: summary.HeadshotKills,
+		BackstabKills: summary.BackstabKills, Headshots: summary.Headshots, Backstabs: summary.Backstabs,
+		WasHeadshot: summary.WasHeadshot, PreroundHealing: summary.PreroundHealing, Healing: summary.Healing,
+		PostroundHealing: summary.PostroundHealing, Drops: summary.Drops,
+		NearFullChargeDeath: summary.NearFullChargeDeath, ChargesUber: summary.ChargesUber,
+		ChargesKritz: summary.ChargesKritz, ChargesVacc: summary.ChargesVacc, ChargesQuickfix: summary.ChargesQuickfix,
+		WasBackstabbed: summary.WasBackstabbed, Captures: summary.Captures, CapturesBlocked: summary.CapturesBlocked,
+		Shots: summary.Shots, Hits: summary.Hits, ObjectBuilt: summary.ObjectBuilt, ObjectDestroyed: summary.ObjectDestroyed,
+	}
+}
+
+func setSummaryStats(summary *PlayerSummary, stats Stats) {
+	summary.Kills, summary.Assists, summary.Deaths = stats.Kills, stats.Assists, stats.Deaths
+	summary.PostroundKills, summary.PostroundAssists = stats.PostroundKills, stats.PostroundAssists
+	summary.PostroundDeaths, summary.Damage, summary.DamageTaken = stats.PostroundDeaths, stats.Damage, stats.DamageTaken
+	summary.Dominations, summary.Dominated = stats.Dominations, stats.Dominated
+	summary.Revenges, summary.Revenged, summary.Airshots = stats.Revenges, stats.Revenged, stats.Airshots
+	summary.HeadshotKills, summary.BackstabKills = stats.HeadshotKills, stats.BackstabKills
+	summary.Headshots, summary.Backstabs, summary.WasHeadshot = stats.Headshots, stats.Backstabs, stats.WasHeadshot
+	summary.PreroundHealing, summary.Healing, summary.PostroundHealing = stats.PreroundHealing, stats.Healing, stats.PostroundHealing
+	summary.Drops, summary.NearFullChargeDeath = stats.Drops, stats.NearFullChargeDeath
+	summary.ChargesUber, summary.ChargesKritz = stats.ChargesUber, stats.ChargesKritz
+	summary.ChargesVacc, summary.ChargesQuickfix = stats.ChargesVacc, stats.ChargesQuickfix
+	summary.WasBackstabbed, summary.Captures, summary.CapturesBlocked = stats.WasBackstabbed, stats.Captures, stats.CapturesBlocked
+	summary.Shots, summary.Hits = stats.Shots, stats.Hits
+	summary.ObjectBuilt, summary.ObjectDestroyed = stats.ObjectBuilt, stats.ObjectDestroyed
+}
@@ -0,0 +1,234 @@
+package demoparse
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+
+	"github.com/klauspost/compress/s2"
+)
+
+var ErrDecompress = errors.New("failed to decompress string table")
+
+const (
+	tableUserInfo     = "userinfo"
+	stringHistorySize = 32
+)
+
+type stringTable struct {
+	name          string
+	maxEntries    int
+	userDataFixed bool
+	userDataBits  int
+	entries       []string
+}
+
+func (t *stringTable) entryBits() int {
+	return bits.Len(uint(t.maxEntries)) - 1
+}
+
+// update applies the entries of a create or update string table message.
+func (t *stringTable) update(state *gameState, stream *bitReader, count int) {
+	var (
+		lastEntry = -1
+		history   []string
+	)
+
+	for range count {
+		entryIndex := lastEntry + 1
+		if !stream.readBool() {
+			entryIndex = int(stream.readBits(t.entryBits()))
+		}
+
+		lastEntry = entryIndex
+
+		var entry string
+
+		if stream.readBool() {
+			if stream.readBool() {
+				index := int(stream.readBits(5))
+				length := int(stream.readBits(subStringBits))
+
+				if index < len(history) && length <= len(history[index]) {
+					entry = history[index][:length]
+				}
+
+				entry += stream.readString()
+			} else {
+				entry = stream.readString()
+			}
+		}
+
+		var userData []byte
+
+		if stream.readBool() {
+			if t.userDataFixed {
+				userData = stream.readSub(t.userDataBits).readBytes(t.userDataBits / 8)
+			} else {
+				userData = stream.readBytes(int(stream.readBits(maxUserDataBits)))
+			}
+		}
+
+		if stream.err != nil {
+			return
+		}
+
+		if entryIndex >= len(t.entries) {
+			t.entries = append(t.entries, make([]string, entryIndex-len(t.entries)+1)...)
+		}
+
+		if entry != "" {
+			t.entries[entryIndex] = entry
+		}
+
+		if t.name == tableUserInfo && len(userData) > 0 {
+			state.onUserInfo(entryIndex, userData)
+		}
+
+		history = append(history, entry)
+		if len(history) > stringHistorySize {
+			history = history[1:]
+		}
+	}
+}
+
+func (s *gameState) readCreateStringTable(stream *bitReader) {
+	table := &stringTable{name: stream.readString(), maxEntries: int(stream.readUint16())}
+	count := int(stream.readBits(table.entryBits() + 1))
+	length := int(stream.readVarInt32())
+
+	if stream.readBool() {
+		table.userDataFixed = true
+		stream.skip(12)
+		table.userDataBits = int(stream.readBits(4))
+	}
+
+	compressed := stream.readBool()
+	data := stream.readSub(length)
+
+	s.tables = append(s.tables, table)
+
+	if compressed {
+		decompressedSize := int(data.readUint32())
+		compressedSize := int(data.readUint32())
+
+		decompressed, errDecompress := decompress(data.readBytes(compressedSize), decompressedSize)
+		if errDecompress != nil {
+			return
+		}
+
+		data = newBitReader(decompressed)
+	}
+
+	table.update(s, data, count)
+}
+
+// readStringTables handles the full string table snapshot written at the start of the demo.
+func (s *gameState) readStringTables(stream *bitReader) {
+	for range stream.readByte() {
+		name := stream.readString()
+
+		readEntries := func(userInfo bool) {
+			for idx := range int(stream.readUint16()) {
+				stream.readString()
+
+				if !stream.readBool() {
+					continue
+				}
+
+				userData := stream.readBytes(int(stream.readUint16()))
+				if userInfo && len(userData) > 0 {
+					s.onUserInfo(idx, userData)
+				}
+			}
+		}
+
+		readEntries(name == tableUserInfo)
+
+		// Client side entries
+		if stream.readBool() {
+			readEntries(false)
+		}
+
+		if stream.err != nil {
+			return
+		}
+	}
+}
+
+// decompress handles the two compression formats used by the engine, LZSS and snappy, which are identified
+// by their magic header.
+func decompress(data []byte, size int) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte("SNAP")):
+		out, errDecode := s2.Decode(nil, data[4:])
+		if errDecode != nil {
+			return nil, errors.Join(errDecode, ErrDecompress)
+		}
+
+		return out, nil
+	case bytes.HasPrefix(data, []byte("LZSS")) && len(data) >= 8:
+		return decompressLZSS(data[8:], int(binary.LittleEndian.Uint32(data[4:])))
+	case len(data) == size:
+		return data, nil
+	default:
+		return nil, ErrDecompress
+	}
+}
+
+func decompressLZSS(input []byte, size int) ([]byte, error) {
+	var (
+		out      = make([]byte, 0, size)
+		cmdByte  byte
+		cmdCount int
+		pos      int
+	)
+
+	for pos < len(input) {
+		if cmdCount == 0 {
+			cmdByte = input[pos]
+			pos++
+		}
+
+		cmdCount = (cmdCount + 1) & 0x07
+
+		if cmdByte&0x01 == 0 {
+			if pos >= len(input) {
+				return nil, ErrDecompress
+			}
+
+			out = append(out, input[pos])
+			pos++
+		} else {
+			if pos+1 >= len(input) {
+				return nil, ErrDecompress
+			}
+
+			position := int(input[pos])<<4 | int(input[pos+1])>>4
+			count := int(input[pos+1]&0x0f) + 1
+			pos += 2
+
+			if count == 1 {
+				break
+			}
+
+			source := len(out) - position - 1
+			if source < 0 {
+				return nil, ErrDecompress
+			}
+
+			for idx := range count {
+				out = append(out, out[source+idx])
+			}
+		}
+
+		cmdByte >>= 1
+	}
+
+	if len(out) != size {
+		return nil, ErrDecompress
+	}
+
+	return out, nil
+}